@@ -37,6 +37,9 @@ var ErrGetRolesForAccount = errors.New("get roles for account error")
 // ErrGetESDTNFTData signals an error in getting esdt nft data for given address, tokenID and nonce
 var ErrGetESDTNFTData = errors.New("get esdt nft data for account error")
 
+// ErrGetAddressTransactions signals an error in getting the transactions history of an account
+var ErrGetAddressTransactions = errors.New("get transactions for account error")
+
 // ErrEmptyAddress signals that an empty address was provided
 var ErrEmptyAddress = errors.New("address is empty")
 
@@ -79,15 +82,30 @@ var ErrValidationEmptyToken = errors.New("token is empty")
 // ErrGetTransaction signals an error happening when trying to fetch a transaction
 var ErrGetTransaction = errors.New("getting transaction failed")
 
+// ErrTraceTransaction signals an error happening when trying to trace a transaction
+var ErrTraceTransaction = errors.New("tracing transaction failed")
+
+// ErrGetTransactionsPool signals an error happening when trying to inspect the transactions pool
+var ErrGetTransactionsPool = errors.New("getting transactions pool failed")
+
 // ErrGetBlock signals an error happening when trying to fetch a block
 var ErrGetBlock = errors.New("getting block failed")
 
+// ErrGetLogs signals an error happening when trying to fetch log events
+var ErrGetLogs = errors.New("getting logs failed")
+
 // ErrQueryError signals a general query error
 var ErrQueryError = errors.New("query error")
 
 // ErrGetPidInfo signals that an error occurred while getting peer ID info
 var ErrGetPidInfo = errors.New("error getting peer id info")
 
+// ErrGetPeersReputation signals that an error occurred while getting the peers reputation
+var ErrGetPeersReputation = errors.New("error getting the peers reputation")
+
+// ErrGetStateStatistics signals that an error occurred while collecting the state statistics
+var ErrGetStateStatistics = errors.New("error getting the state statistics")
+
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
 
@@ -100,6 +118,9 @@ var ErrValidationEmptyAddress = errors.New("address is empty")
 // ErrValidationEmptyKey signals that an empty key was provided
 var ErrValidationEmptyKey = errors.New("key is empty")
 
+// ErrValidationEmptyKeys signals that an empty list of keys was provided
+var ErrValidationEmptyKeys = errors.New("keys are empty")
+
 // ErrGetProof signals an error happening when trying to compute a Merkle proof
 var ErrGetProof = errors.New("getting proof failed")
 
@@ -117,3 +138,12 @@ var ErrNilFacadeHandler = errors.New("nil facade handler")
 
 // ErrFacadeWrongTypeAssertion signals that a type conversion to a facade type failed
 var ErrFacadeWrongTypeAssertion = errors.New("facade - wrong type assertion")
+
+// ErrInvalidWebServerAntifloodConfig signals that an invalid web server antiflood configuration has been provided
+var ErrInvalidWebServerAntifloodConfig = errors.New("invalid web server antiflood config")
+
+// ErrReloadAntifloodConfig signals an error while reloading the antiflood configuration
+var ErrReloadAntifloodConfig = errors.New("error reloading the antiflood configuration")
+
+// ErrTxBundleTooLarge signals that the provided bundle holds more transactions than allowed
+var ErrTxBundleTooLarge = errors.New("bundle of transactions too large")
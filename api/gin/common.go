@@ -9,7 +9,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/logs"
+	apiSubscriptions "github.com/ElrondNetwork/elrond-go/api/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/gorilla/websocket"
@@ -46,6 +48,20 @@ func checkArgs(args ArgsNewWebServer) error {
 	return nil
 }
 
+func checkWebServerAntifloodConfig(cfg config.WebServerAntifloodConfig) error {
+	if cfg.SimultaneousRequests == 0 {
+		return fmt.Errorf("%w, SimultaneousRequests should not be 0", apiErrors.ErrInvalidWebServerAntifloodConfig)
+	}
+	if cfg.SameSourceRequests == 0 {
+		return fmt.Errorf("%w, SameSourceRequests should not be 0", apiErrors.ErrInvalidWebServerAntifloodConfig)
+	}
+	if cfg.SameSourceResetIntervalInSec == 0 {
+		return fmt.Errorf("%w, SameSourceResetIntervalInSec should not be 0", apiErrors.ErrInvalidWebServerAntifloodConfig)
+	}
+
+	return nil
+}
+
 func isLogRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
 	logConfig, ok := routesConfig.APIPackages["log"]
 	if !ok {
@@ -61,6 +77,21 @@ func isLogRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
 	return false
 }
 
+func isSubscribeRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
+	subscribeConfig, ok := routesConfig.APIPackages["subscribe"]
+	if !ok {
+		return false
+	}
+
+	for _, cfg := range subscribeConfig.Routes {
+		if cfg.Name == "/subscribe" && cfg.Open {
+			return true
+		}
+	}
+
+	return false
+}
+
 func registerValidators() error {
 	validators := []validatorInput{
 		{
@@ -103,3 +134,28 @@ func registerLoggerWsRoute(ws *gin.Engine, marshalizer marshal.Marshalizer) {
 		ls.StartSendingBlocking()
 	})
 }
+
+func registerSubscriptionsWsRoute(ws *gin.Engine, getHub func() subscriptions.Hub) {
+	upgrader := websocket.Upgrader{}
+
+	ws.GET("/subscribe", func(c *gin.Context) {
+		upgrader.CheckOrigin = func(r *http.Request) bool {
+			return true
+		}
+
+		conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+		if err != nil {
+			log.Error(err.Error())
+			return
+		}
+
+		ss, err := apiSubscriptions.NewSubscriptionSender(getHub(), conn, log)
+		if err != nil {
+			log.Error(err.Error())
+			_ = conn.Close()
+			return
+		}
+
+		ss.StartSendingBlocking()
+	})
+}
@@ -26,6 +26,29 @@ func TestCommon_checkArgs(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestCommon_checkWebServerAntifloodConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.WebServerAntifloodConfig{
+		SimultaneousRequests:         1,
+		SameSourceRequests:           1,
+		SameSourceResetIntervalInSec: 1,
+	}
+	require.NoError(t, checkWebServerAntifloodConfig(cfg))
+
+	invalidCfg := cfg
+	invalidCfg.SimultaneousRequests = 0
+	require.True(t, errors.Is(checkWebServerAntifloodConfig(invalidCfg), apiErrors.ErrInvalidWebServerAntifloodConfig))
+
+	invalidCfg = cfg
+	invalidCfg.SameSourceRequests = 0
+	require.True(t, errors.Is(checkWebServerAntifloodConfig(invalidCfg), apiErrors.ErrInvalidWebServerAntifloodConfig))
+
+	invalidCfg = cfg
+	invalidCfg.SameSourceResetIntervalInSec = 0
+	require.True(t, errors.Is(checkWebServerAntifloodConfig(invalidCfg), apiErrors.ErrInvalidWebServerAntifloodConfig))
+}
+
 func TestCommon_isLogRouteEnabled(t *testing.T) {
 	t.Parallel()
 
@@ -45,3 +68,26 @@ func TestCommon_isLogRouteEnabled(t *testing.T) {
 	}
 	require.True(t, isLogRouteEnabled(routesConfig))
 }
+
+func TestCommon_isSubscribeRouteEnabled(t *testing.T) {
+	t.Parallel()
+
+	routesConfigWithMissingSubscribe := config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{},
+	}
+	require.False(t, isSubscribeRouteEnabled(routesConfigWithMissingSubscribe))
+
+	routesConfig := config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"subscribe": {
+				Routes: []config.RouteConfig{
+					{Name: "/subscribe", Open: false},
+				},
+			},
+		},
+	}
+	require.False(t, isSubscribeRouteEnabled(routesConfig))
+
+	routesConfig.APIPackages["subscribe"].Routes[0].Open = true
+	require.True(t, isSubscribeRouteEnabled(routesConfig))
+}
@@ -4,3 +4,13 @@ type resetHandler interface {
 	Reset()
 	IsInterfaceNil() bool
 }
+
+type sourceLimiterHandler interface {
+	SetMaxNumRequests(maxNumRequests uint32) error
+	resetHandler
+}
+
+type globalLimiterHandler interface {
+	SetMaxConnections(maxConnections uint32) error
+	IsInterfaceNil() bool
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/facade"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-contrib/pprof"
 	"github.com/gin-gonic/gin"
@@ -37,6 +38,9 @@ type webServer struct {
 	httpServer      shared.HttpServerCloser
 	groups          map[string]shared.GroupHandler
 	cancelFunc      func()
+	mutAntiFlood    sync.RWMutex
+	sourceLimiter   sourceLimiterHandler
+	globalLimiter   globalLimiterHandler
 }
 
 // NewGinWebServerHandler returns a new instance of webServer
@@ -125,10 +129,11 @@ func (ws *webServer) StartHttpServer() error {
 		return err
 	}
 
+	antiFloodConfig := ws.getAntiFloodConfig()
 	log.Debug("starting web server",
-		"SimultaneousRequests", ws.antiFloodConfig.SimultaneousRequests,
-		"SameSourceRequests", ws.antiFloodConfig.SameSourceRequests,
-		"SameSourceResetIntervalInSec", ws.antiFloodConfig.SameSourceResetIntervalInSec,
+		"SimultaneousRequests", antiFloodConfig.SimultaneousRequests,
+		"SameSourceRequests", antiFloodConfig.SameSourceRequests,
+		"SameSourceResetIntervalInSec", antiFloodConfig.SameSourceResetIntervalInSec,
 	)
 
 	go ws.httpServer.Start()
@@ -150,6 +155,18 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["block"] = blockGroup
 
+	jsonRpcGroup, err := groups.NewJsonRpcGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["jsonrpc"] = jsonRpcGroup
+
+	logsGroup, err := groups.NewLogsGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["logs"] = logsGroup
+
 	hardforkGroup, err := groups.NewHardforkGroup(ws.facade)
 	if err != nil {
 		return err
@@ -209,11 +226,24 @@ func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
 		registerLoggerWsRoute(ginRouter, marshalizerForLogs)
 	}
 
+	if isSubscribeRouteEnabled(ws.apiConfig) {
+		registerSubscriptionsWsRoute(ginRouter, ws.getSubscriptionsHub)
+	}
+
 	if ws.facade.PprofEnabled() {
 		pprof.Register(ginRouter)
 	}
 }
 
+// getSubscriptionsHub returns the subscriptions hub of the current facade as the facade can be updated
+// after the routes have been registered
+func (ws *webServer) getSubscriptionsHub() subscriptions.Hub {
+	ws.RLock()
+	defer ws.RUnlock()
+
+	return ws.facade.GetSubscriptionsHub()
+}
+
 func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, error) {
 	middlewares := make([]shared.MiddlewareProcessor, 0)
 
@@ -222,10 +252,14 @@ func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, e
 		middlewares = append(middlewares, responseLoggerMiddleware)
 	}
 
+	ws.mutAntiFlood.Lock()
+	defer ws.mutAntiFlood.Unlock()
+
 	sourceLimiter, err := middleware.NewSourceThrottler(ws.antiFloodConfig.SameSourceRequests)
 	if err != nil {
 		return nil, err
 	}
+	ws.sourceLimiter = sourceLimiter
 
 	var ctx context.Context
 	ctx, ws.cancelFunc = context.WithCancel(context.Background())
@@ -238,6 +272,7 @@ func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, e
 	if err != nil {
 		return nil, err
 	}
+	ws.globalLimiter = globalLimiter
 
 	middlewares = append(middlewares, globalLimiter)
 
@@ -245,8 +280,9 @@ func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, e
 }
 
 func (ws *webServer) sourceLimiterReset(ctx context.Context, reset resetHandler) {
-	betweenResetDuration := time.Second * time.Duration(ws.antiFloodConfig.SameSourceResetIntervalInSec)
 	for {
+		betweenResetDuration := time.Second * time.Duration(ws.getAntiFloodConfig().SameSourceResetIntervalInSec)
+
 		select {
 		case <-time.After(betweenResetDuration):
 			log.Trace("calling reset on WS source limiter")
@@ -258,6 +294,53 @@ func (ws *webServer) sourceLimiterReset(ctx context.Context, reset resetHandler)
 	}
 }
 
+func (ws *webServer) getAntiFloodConfig() config.WebServerAntifloodConfig {
+	ws.mutAntiFlood.RLock()
+	defer ws.mutAntiFlood.RUnlock()
+
+	return ws.antiFloodConfig
+}
+
+// CheckAntifloodConfig returns an error if the web server limits defined in the provided config can not be applied
+func (ws *webServer) CheckAntifloodConfig(cfg config.AntifloodConfig) error {
+	return checkWebServerAntifloodConfig(cfg.WebServer)
+}
+
+// ApplyAntifloodConfig checks the provided config and, if valid, applies the new limits on the web server throttlers.
+// The new same source reset interval is used starting with the next reset. The endpoints throttlers are only read
+// at start-up
+func (ws *webServer) ApplyAntifloodConfig(cfg config.AntifloodConfig) error {
+	err := ws.CheckAntifloodConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	ws.mutAntiFlood.Lock()
+	defer ws.mutAntiFlood.Unlock()
+
+	if !check.IfNil(ws.sourceLimiter) {
+		err = ws.sourceLimiter.SetMaxNumRequests(cfg.WebServer.SameSourceRequests)
+		if err != nil {
+			return err
+		}
+	}
+	if !check.IfNil(ws.globalLimiter) {
+		err = ws.globalLimiter.SetMaxConnections(cfg.WebServer.SimultaneousRequests)
+		if err != nil {
+			return err
+		}
+	}
+	ws.antiFloodConfig = cfg.WebServer
+
+	log.Info("applied new web server antiflood configuration",
+		"SimultaneousRequests", cfg.WebServer.SimultaneousRequests,
+		"SameSourceRequests", cfg.WebServer.SameSourceRequests,
+		"SameSourceResetIntervalInSec", cfg.WebServer.SameSourceResetIntervalInSec,
+	)
+
+	return nil
+}
+
 // Close will handle the closing of inner components
 func (ws *webServer) Close() error {
 	if ws.cancelFunc != nil {
@@ -0,0 +1,45 @@
+package gin
+
+import (
+	"errors"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/facade/initial"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebServer_ApplyAntifloodConfig(t *testing.T) {
+	t.Parallel()
+
+	initialConfig := config.WebServerAntifloodConfig{
+		SimultaneousRequests:         10,
+		SameSourceRequests:           10,
+		SameSourceResetIntervalInSec: 1,
+	}
+	ws, err := NewGinWebServerHandler(ArgsNewWebServer{
+		Facade:          initial.NewInitialNodeFacade("api interface", false),
+		AntiFloodConfig: initialConfig,
+	})
+	require.NoError(t, err)
+
+	sourceLimiter, _ := middleware.NewSourceThrottler(initialConfig.SameSourceRequests)
+	globalLimiter, _ := middleware.NewGlobalThrottler(initialConfig.SimultaneousRequests)
+	ws.sourceLimiter = sourceLimiter
+	ws.globalLimiter = globalLimiter
+
+	err = ws.ApplyAntifloodConfig(config.AntifloodConfig{})
+	require.True(t, errors.Is(err, apiErrors.ErrInvalidWebServerAntifloodConfig))
+	require.Equal(t, initialConfig, ws.getAntiFloodConfig())
+
+	newConfig := config.WebServerAntifloodConfig{
+		SimultaneousRequests:         20,
+		SameSourceRequests:           30,
+		SameSourceResetIntervalInSec: 5,
+	}
+	err = ws.ApplyAntifloodConfig(config.AntifloodConfig{WebServer: newConfig})
+	require.NoError(t, err)
+	require.Equal(t, newConfig, ws.getAntiFloodConfig())
+}
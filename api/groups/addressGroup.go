@@ -1,9 +1,11 @@
 package groups
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -12,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/gin-gonic/gin"
 )
 
@@ -27,20 +30,29 @@ const (
 	getESDTsRolesPath         = "/:address/esdts/roles"
 	getRegisteredNFTsPath     = "/:address/registered-nfts"
 	getESDTNFTDataPath        = "/:address/nft/:tokenIdentifier/nonce/:nonce"
+	getTransactionsPath       = "/:address/transactions"
+
+	queryParamFrom       = "from"
+	queryParamSize       = "size"
+	queryParamBlockNonce = "blockNonce"
+	queryParamBlockHash  = "blockHash"
+
+	defaultAddressTransactionsPageSize = 20
 )
 
 // addressFacadeHandler defines the methods to be implemented by a facade for handling address requests
 type addressFacadeHandler interface {
-	GetBalance(address string) (*big.Int, error)
-	GetUsername(address string) (string, error)
-	GetValueForKey(address string, key string) (string, error)
-	GetAccount(address string) (api.AccountResponse, error)
-	GetESDTData(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error)
-	GetESDTsRoles(address string) (map[string][]string, error)
-	GetNFTTokenIDsRegisteredByAddress(address string) ([]string, error)
-	GetESDTsWithRole(address string, role string) ([]string, error)
-	GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error)
-	GetKeyValuePairs(address string) (map[string]string, error)
+	GetBalance(address string, options common.AccountQueryOptions) (*big.Int, error)
+	GetUsername(address string, options common.AccountQueryOptions) (string, error)
+	GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error)
+	GetAccount(address string, options common.AccountQueryOptions) (api.AccountResponse, error)
+	GetESDTData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*esdt.ESDigitalToken, error)
+	GetESDTsRoles(address string, options common.AccountQueryOptions) (map[string][]string, error)
+	GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) ([]string, error)
+	GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) ([]string, error)
+	GetAllESDTTokens(address string, options common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error)
+	GetKeyValuePairs(address string, options common.AccountQueryOptions) (map[string]string, error)
+	GetAddressTransactions(address string, from uint64, size uint64) (*common.AddressTransactionsResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -136,6 +148,11 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ag.getESDTsRoles,
 		},
+		{
+			Path:    getTransactionsPath,
+			Method:  http.MethodGet,
+			Handler: ag.getTransactions,
+		},
 	}
 	ag.endpoints = endpoints
 
@@ -145,16 +162,14 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 // addressGroup returns a response containing information about the account correlated with provided address
 func (ag *addressGroup) getAccount(c *gin.Context) {
 	addr := c.Param("address")
-	accountResponse, err := ag.getFacade().GetAccount(addr)
+	options, ok := getAccountQueryOptions(c, errors.ErrCouldNotGetAccount)
+	if !ok {
+		return
+	}
+
+	accountResponse, err := ag.getFacade().GetAccount(addr, options)
 	if err != nil {
-		c.JSON(
-			http.StatusInternalServerError,
-			shared.GenericAPIResponse{
-				Data:  nil,
-				Error: fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error()),
-				Code:  shared.ReturnCodeInternalError,
-			},
-		)
+		respondWithAccountQueryError(c, errors.ErrCouldNotGetAccount, err)
 		return
 	}
 
@@ -185,16 +200,14 @@ func (ag *addressGroup) getBalance(c *gin.Context) {
 		return
 	}
 
-	balance, err := ag.getFacade().GetBalance(addr)
+	options, ok := getAccountQueryOptions(c, errors.ErrGetBalance)
+	if !ok {
+		return
+	}
+
+	balance, err := ag.getFacade().GetBalance(addr, options)
 	if err != nil {
-		c.JSON(
-			http.StatusInternalServerError,
-			shared.GenericAPIResponse{
-				Data:  nil,
-				Error: fmt.Sprintf("%s: %s", errors.ErrGetBalance.Error(), err.Error()),
-				Code:  shared.ReturnCodeInternalError,
-			},
-		)
+		respondWithAccountQueryError(c, errors.ErrGetBalance, err)
 		return
 	}
 	c.JSON(
@@ -222,16 +235,14 @@ func (ag *addressGroup) getUsername(c *gin.Context) {
 		return
 	}
 
-	userName, err := ag.getFacade().GetUsername(addr)
+	options, ok := getAccountQueryOptions(c, errors.ErrGetUsername)
+	if !ok {
+		return
+	}
+
+	userName, err := ag.getFacade().GetUsername(addr, options)
 	if err != nil {
-		c.JSON(
-			http.StatusInternalServerError,
-			shared.GenericAPIResponse{
-				Data:  nil,
-				Error: fmt.Sprintf("%s: %s", errors.ErrGetUsername.Error(), err.Error()),
-				Code:  shared.ReturnCodeInternalError,
-			},
-		)
+		respondWithAccountQueryError(c, errors.ErrGetUsername, err)
 		return
 	}
 	c.JSON(
@@ -272,16 +283,14 @@ func (ag *addressGroup) getValueForKey(c *gin.Context) {
 		return
 	}
 
-	value, err := ag.getFacade().GetValueForKey(addr, key)
+	options, ok := getAccountQueryOptions(c, errors.ErrGetValueForKey)
+	if !ok {
+		return
+	}
+
+	value, err := ag.getFacade().GetValueForKey(addr, key, options)
 	if err != nil {
-		c.JSON(
-			http.StatusInternalServerError,
-			shared.GenericAPIResponse{
-				Data:  nil,
-				Error: fmt.Sprintf("%s: %s", errors.ErrGetValueForKey.Error(), err.Error()),
-				Code:  shared.ReturnCodeInternalError,
-			},
-		)
+		respondWithAccountQueryError(c, errors.ErrGetValueForKey, err)
 		return
 	}
 
@@ -310,16 +319,14 @@ func (ag *addressGroup) getKeyValuePairs(c *gin.Context) {
 		return
 	}
 
-	value, err := ag.getFacade().GetKeyValuePairs(addr)
+	options, ok := getAccountQueryOptions(c, errors.ErrGetKeyValuePairs)
+	if !ok {
+		return
+	}
+
+	value, err := ag.getFacade().GetKeyValuePairs(addr, options)
 	if err != nil {
-		c.JSON(
-			http.StatusInternalServerError,
-			shared.GenericAPIResponse{
-				Data:  nil,
-				Error: fmt.Sprintf("%s: %s", errors.ErrGetKeyValuePairs.Error(), err.Error()),
-				Code:  shared.ReturnCodeInternalError,
-			},
-		)
+		respondWithAccountQueryError(c, errors.ErrGetKeyValuePairs, err)
 		return
 	}
 
@@ -361,16 +368,14 @@ func (ag *addressGroup) getESDTBalance(c *gin.Context) {
 		return
 	}
 
-	esdtData, err := ag.getFacade().GetESDTData(addr, tokenIdentifier, 0)
+	options, ok := getAccountQueryOptions(c, errors.ErrGetESDTBalance)
+	if !ok {
+		return
+	}
+
+	esdtData, err := ag.getFacade().GetESDTData(addr, tokenIdentifier, 0, options)
 	if err != nil {
-		c.JSON(
-			http.StatusInternalServerError,
-			shared.GenericAPIResponse{
-				Data:  nil,
-				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTBalance.Error(), err.Error()),
-				Code:  shared.ReturnCodeInternalError,
-			},
-		)
+		respondWithAccountQueryError(c, errors.ErrGetESDTBalance, err)
 		return
 	}
 
@@ -405,16 +410,14 @@ func (ag *addressGroup) getESDTsRoles(c *gin.Context) {
 		return
 	}
 
-	tokensRoles, err := ag.getFacade().GetESDTsRoles(addr)
+	options, ok := getAccountQueryOptions(c, errors.ErrGetRolesForAccount)
+	if !ok {
+		return
+	}
+
+	tokensRoles, err := ag.getFacade().GetESDTsRoles(addr, options)
 	if err != nil {
-		c.JSON(
-			http.StatusInternalServerError,
-			shared.GenericAPIResponse{
-				Data:  nil,
-				Error: fmt.Sprintf("%s: %s", errors.ErrGetRolesForAccount.Error(), err.Error()),
-				Code:  shared.ReturnCodeInternalError,
-			},
-		)
+		respondWithAccountQueryError(c, errors.ErrGetRolesForAccount, err)
 		return
 	}
 
@@ -468,16 +471,14 @@ func (ag *addressGroup) getESDTTokensWithRole(c *gin.Context) {
 		return
 	}
 
-	tokens, err := ag.getFacade().GetESDTsWithRole(addr, role)
+	options, ok := getAccountQueryOptions(c, errors.ErrGetESDTBalance)
+	if !ok {
+		return
+	}
+
+	tokens, err := ag.getFacade().GetESDTsWithRole(addr, role, options)
 	if err != nil {
-		c.JSON(
-			http.StatusInternalServerError,
-			shared.GenericAPIResponse{
-				Data:  nil,
-				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTBalance.Error(), err.Error()),
-				Code:  shared.ReturnCodeInternalError,
-			},
-		)
+		respondWithAccountQueryError(c, errors.ErrGetESDTBalance, err)
 		return
 	}
 
@@ -506,16 +507,14 @@ func (ag *addressGroup) getNFTTokenIDsRegisteredByAddress(c *gin.Context) {
 		return
 	}
 
-	tokens, err := ag.getFacade().GetNFTTokenIDsRegisteredByAddress(addr)
+	options, ok := getAccountQueryOptions(c, errors.ErrGetESDTBalance)
+	if !ok {
+		return
+	}
+
+	tokens, err := ag.getFacade().GetNFTTokenIDsRegisteredByAddress(addr, options)
 	if err != nil {
-		c.JSON(
-			http.StatusInternalServerError,
-			shared.GenericAPIResponse{
-				Data:  nil,
-				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTBalance.Error(), err.Error()),
-				Code:  shared.ReturnCodeInternalError,
-			},
-		)
+		respondWithAccountQueryError(c, errors.ErrGetESDTBalance, err)
 		return
 	}
 
@@ -583,16 +582,14 @@ func (ag *addressGroup) getESDTNFTData(c *gin.Context) {
 		return
 	}
 
-	esdtData, err := ag.getFacade().GetESDTData(addr, tokenIdentifier, nonceAsBigInt.Uint64())
+	options, ok := getAccountQueryOptions(c, errors.ErrGetESDTNFTData)
+	if !ok {
+		return
+	}
+
+	esdtData, err := ag.getFacade().GetESDTData(addr, tokenIdentifier, nonceAsBigInt.Uint64(), options)
 	if err != nil {
-		c.JSON(
-			http.StatusInternalServerError,
-			shared.GenericAPIResponse{
-				Data:  nil,
-				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTBalance.Error(), err.Error()),
-				Code:  shared.ReturnCodeInternalError,
-			},
-		)
+		respondWithAccountQueryError(c, errors.ErrGetESDTBalance, err)
 		return
 	}
 
@@ -623,16 +620,14 @@ func (ag *addressGroup) getAllESDTData(c *gin.Context) {
 		return
 	}
 
-	tokens, err := ag.getFacade().GetAllESDTTokens(addr)
+	options, ok := getAccountQueryOptions(c, errors.ErrGetESDTTokens)
+	if !ok {
+		return
+	}
+
+	tokens, err := ag.getFacade().GetAllESDTTokens(addr, options)
 	if err != nil {
-		c.JSON(
-			http.StatusInternalServerError,
-			shared.GenericAPIResponse{
-				Data:  nil,
-				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTTokens.Error(), err.Error()),
-				Code:  shared.ReturnCodeInternalError,
-			},
-		)
+		respondWithAccountQueryError(c, errors.ErrGetESDTTokens, err)
 		return
 	}
 
@@ -653,6 +648,58 @@ func (ag *addressGroup) getAllESDTData(c *gin.Context) {
 	)
 }
 
+// getTransactions returns a page of the transactions sent or received by the address, newest first
+func (ag *addressGroup) getTransactions(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetAddressTransactions.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	from, err := parseUint64QueryParam(c, queryParamFrom)
+	if err != nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrGetAddressTransactions.Error(), err.Error()))
+		return
+	}
+	size, err := parseUint64QueryParam(c, queryParamSize)
+	if err != nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrGetAddressTransactions.Error(), err.Error()))
+		return
+	}
+	if size == 0 {
+		size = defaultAddressTransactionsPageSize
+	}
+
+	transactions, err := ag.getFacade().GetAddressTransactions(addr, from, size)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetAddressTransactions.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"transactions": transactions.Transactions, "total": transactions.Total},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 func buildTokenDataApiResponse(tokenIdentifier string, esdtData *esdt.ESDigitalToken) *esdtNFTTokenData {
 	tokenData := &esdtNFTTokenData{
 		TokenIdentifier: tokenIdentifier,
@@ -672,6 +719,57 @@ func buildTokenDataApiResponse(tokenIdentifier string, esdtData *esdt.ESDigitalT
 	return tokenData
 }
 
+// getAccountQueryOptions parses the block selection query parameters and responds with a bad request error if they
+// are not valid. The returned flag is false if the request was already answered
+func getAccountQueryOptions(c *gin.Context, scope error) (common.AccountQueryOptions, bool) {
+	options, err := parseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", scope.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return options, false
+	}
+
+	return options, true
+}
+
+func parseAccountQueryOptions(c *gin.Context) (common.AccountQueryOptions, error) {
+	options := common.AccountQueryOptions{}
+
+	blockNonceStr, hasBlockNonce := c.GetQuery(queryParamBlockNonce)
+	if hasBlockNonce {
+		blockNonce, err := strconv.ParseUint(blockNonceStr, 10, 64)
+		if err != nil {
+			return options, fmt.Errorf("%w %s", errors.ErrInvalidQueryParameter, queryParamBlockNonce)
+		}
+
+		options.BlockNonce = blockNonce
+		options.HasBlockNonce = true
+	}
+
+	blockHashStr := c.Query(queryParamBlockHash)
+	if len(blockHashStr) == 0 {
+		return options, nil
+	}
+	if hasBlockNonce {
+		return options, fmt.Errorf("%w: only one of %s and %s can be provided",
+			errors.ErrInvalidQueryParameter, queryParamBlockNonce, queryParamBlockHash)
+	}
+
+	blockHash, err := hex.DecodeString(blockHashStr)
+	if err != nil {
+		return options, fmt.Errorf("%w %s", errors.ErrInvalidQueryParameter, queryParamBlockHash)
+	}
+	options.BlockHash = blockHash
+
+	return options, nil
+}
+
 func (ag *addressGroup) getFacade() addressFacadeHandler {
 	ag.mutFacade.RLock()
 	defer ag.mutFacade.RUnlock()
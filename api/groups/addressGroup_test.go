@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -182,7 +183,7 @@ func TestGetBalance_WithCorrectAddressShouldNotReturnError(t *testing.T) {
 	amount := big.NewInt(10)
 	addr := "testAddress"
 	facade := mock.FacadeStub{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ common.AccountQueryOptions) (i *big.Int, e error) {
 			return amount, nil
 		},
 	}
@@ -211,7 +212,7 @@ func TestGetBalance_WithWrongAddressShouldError(t *testing.T) {
 	t.Parallel()
 	otherAddress := "otherAddress"
 	facade := mock.FacadeStub{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ common.AccountQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(0), nil
 		},
 	}
@@ -236,7 +237,7 @@ func TestGetBalance_NodeGetBalanceReturnsError(t *testing.T) {
 	addr := "addr"
 	balanceError := errors.New("error")
 	facade := mock.FacadeStub{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ common.AccountQueryOptions) (i *big.Int, e error) {
 			return nil, balanceError
 		},
 	}
@@ -259,7 +260,7 @@ func TestGetBalance_NodeGetBalanceReturnsError(t *testing.T) {
 func TestGetBalance_WithEmptyAddressShouldReturnError(t *testing.T) {
 	t.Parallel()
 	facade := mock.FacadeStub{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ common.AccountQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(0), errors.New("address was empty")
 		},
 	}
@@ -290,7 +291,7 @@ func TestGetValueForKey_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetValueForKeyCalled: func(_ string, _ string) (string, error) {
+		GetValueForKeyCalled: func(_ string, _ string, _ common.AccountQueryOptions) (string, error) {
 			return "", expectedErr
 		},
 	}
@@ -316,7 +317,7 @@ func TestGetValueForKey_ShouldWork(t *testing.T) {
 	testAddress := "address"
 	testValue := "value"
 	facade := mock.FacadeStub{
-		GetValueForKeyCalled: func(_ string, _ string) (string, error) {
+		GetValueForKeyCalled: func(_ string, _ string, _ common.AccountQueryOptions) (string, error) {
 			return testValue, nil
 		},
 	}
@@ -342,7 +343,7 @@ func TestGetUsername_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetUsernameCalled: func(_ string) (string, error) {
+		GetUsernameCalled: func(_ string, _ common.AccountQueryOptions) (string, error) {
 			return "", expectedErr
 		},
 	}
@@ -368,7 +369,7 @@ func TestGetUsername_ShouldWork(t *testing.T) {
 	testAddress := "address"
 	testUsername := "value"
 	facade := mock.FacadeStub{
-		GetUsernameCalled: func(_ string) (string, error) {
+		GetUsernameCalled: func(_ string, _ common.AccountQueryOptions) (string, error) {
 			return testUsername, nil
 		},
 	}
@@ -393,7 +394,7 @@ func TestGetAccount_FailWhenFacadeStubGetAccountFails(t *testing.T) {
 
 	returnedError := "i am an error"
 	facade := mock.FacadeStub{
-		GetAccountHandler: func(address string) (api.AccountResponse, error) {
+		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (api.AccountResponse, error) {
 			return api.AccountResponse{}, errors.New(returnedError)
 		},
 	}
@@ -419,7 +420,7 @@ func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 
 	facade := mock.FacadeStub{
-		GetAccountHandler: func(address string) (api.AccountResponse, error) {
+		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (api.AccountResponse, error) {
 			return api.AccountResponse{
 				Address:         "1234",
 				Balance:         big.NewInt(100).String(),
@@ -461,7 +462,7 @@ func TestGetESDTBalance_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetESDTDataCalled: func(_ string, _ string, _ uint64) (*esdt.ESDigitalToken, error) {
+		GetESDTDataCalled: func(_ string, _ string, _ uint64, _ common.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
 			return nil, expectedErr
 		},
 	}
@@ -488,7 +489,7 @@ func TestGetESDTBalance_ShouldWork(t *testing.T) {
 	testValue := big.NewInt(100).String()
 	testProperties := "frozen"
 	facade := mock.FacadeStub{
-		GetESDTDataCalled: func(_ string, _ string, _ uint64) (*esdt.ESDigitalToken, error) {
+		GetESDTDataCalled: func(_ string, _ string, _ uint64, _ common.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
 			return &esdt.ESDigitalToken{Value: big.NewInt(100), Properties: []byte(testProperties)}, nil
 		},
 	}
@@ -515,7 +516,7 @@ func TestGetESDTNFTData_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetESDTDataCalled: func(_ string, _ string, _ uint64) (*esdt.ESDigitalToken, error) {
+		GetESDTDataCalled: func(_ string, _ string, _ uint64, _ common.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
 			return nil, expectedErr
 		},
 	}
@@ -543,7 +544,7 @@ func TestGetESDTNFTData_ShouldWork(t *testing.T) {
 	testNonce := uint64(37)
 	testProperties := "frozen"
 	facade := mock.FacadeStub{
-		GetESDTDataCalled: func(_ string, _ string, _ uint64) (*esdt.ESDigitalToken, error) {
+		GetESDTDataCalled: func(_ string, _ string, _ uint64, _ common.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
 			return &esdt.ESDigitalToken{
 				Value:         big.NewInt(100),
 				Properties:    []byte(testProperties),
@@ -575,7 +576,7 @@ func TestGetESDTTokensWithRole_InvalidRoleShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetESDTsWithRoleCalled: func(_ string, _ string) ([]string, error) {
+		GetESDTsWithRoleCalled: func(_ string, _ string, _ common.AccountQueryOptions) ([]string, error) {
 			return nil, expectedErr
 		},
 	}
@@ -601,7 +602,7 @@ func TestGetESDTTokensWithRole_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetESDTsWithRoleCalled: func(_ string, _ string) ([]string, error) {
+		GetESDTsWithRoleCalled: func(_ string, _ string, _ common.AccountQueryOptions) ([]string, error) {
 			return nil, expectedErr
 		},
 	}
@@ -627,7 +628,7 @@ func TestGetESDTTokensWithRole_ShouldWork(t *testing.T) {
 	testAddress := "address"
 	expectedTokens := []string{"ABC-0o9i8u", "XYZ-r5y7i9"}
 	facade := mock.FacadeStub{
-		GetESDTsWithRoleCalled: func(address string, role string) ([]string, error) {
+		GetESDTsWithRoleCalled: func(address string, role string, _ common.AccountQueryOptions) ([]string, error) {
 			return expectedTokens, nil
 		},
 	}
@@ -653,7 +654,7 @@ func TestGetNFTTokenIDsRegisteredByAddress_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetNFTTokenIDsRegisteredByAddressCalled: func(_ string) ([]string, error) {
+		GetNFTTokenIDsRegisteredByAddressCalled: func(_ string, _ common.AccountQueryOptions) ([]string, error) {
 			return nil, expectedErr
 		},
 	}
@@ -679,7 +680,7 @@ func TestGetNFTTokenIDsRegisteredByAddress_ShouldWork(t *testing.T) {
 	testAddress := "address"
 	expectedTokens := []string{"ABC-0o9i8u", "XYZ-r5y7i9"}
 	facade := mock.FacadeStub{
-		GetNFTTokenIDsRegisteredByAddressCalled: func(address string) ([]string, error) {
+		GetNFTTokenIDsRegisteredByAddressCalled: func(address string, _ common.AccountQueryOptions) ([]string, error) {
 			return expectedTokens, nil
 		},
 	}
@@ -705,7 +706,7 @@ func TestGetFullESDTTokens_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetAllESDTTokensCalled: func(_ string) (map[string]*esdt.ESDigitalToken, error) {
+		GetAllESDTTokensCalled: func(_ string, _ common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error) {
 			return nil, expectedErr
 		},
 	}
@@ -732,7 +733,7 @@ func TestGetFullESDTTokens_ShouldWork(t *testing.T) {
 	testValue1 := "token1"
 	testValue2 := "token2"
 	facade := mock.FacadeStub{
-		GetAllESDTTokensCalled: func(address string) (map[string]*esdt.ESDigitalToken, error) {
+		GetAllESDTTokensCalled: func(address string, _ common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error) {
 			tokens := make(map[string]*esdt.ESDigitalToken)
 			tokens[testValue1] = &esdt.ESDigitalToken{Value: big.NewInt(10)}
 			tokens[testValue2] = &esdt.ESDigitalToken{Value: big.NewInt(100)}
@@ -785,7 +786,7 @@ func TestGetKeyValuePairs_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetKeyValuePairsCalled: func(_ string) (map[string]string, error) {
+		GetKeyValuePairsCalled: func(_ string, _ common.AccountQueryOptions) (map[string]string, error) {
 			return nil, expectedErr
 		},
 	}
@@ -814,7 +815,7 @@ func TestGetKeyValuePairs_ShouldWork(t *testing.T) {
 	}
 	testAddress := "address"
 	facade := mock.FacadeStub{
-		GetKeyValuePairsCalled: func(_ string) (map[string]string, error) {
+		GetKeyValuePairsCalled: func(_ string, _ common.AccountQueryOptions) (map[string]string, error) {
 			return pairs, nil
 		},
 	}
@@ -864,7 +865,7 @@ func TestGetESDTsRoles_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetESDTsRolesCalled: func(_ string) (map[string][]string, error) {
+		GetESDTsRolesCalled: func(_ string, _ common.AccountQueryOptions) (map[string][]string, error) {
 			return nil, expectedErr
 		},
 	}
@@ -893,7 +894,7 @@ func TestGetESDTsRoles_ShouldWork(t *testing.T) {
 	}
 	testAddress := "address"
 	facade := mock.FacadeStub{
-		GetESDTsRolesCalled: func(_ string) (map[string][]string, error) {
+		GetESDTsRolesCalled: func(_ string, _ common.AccountQueryOptions) (map[string][]string, error) {
 			return roles, nil
 		},
 	}
@@ -913,6 +914,210 @@ func TestGetESDTsRoles_ShouldWork(t *testing.T) {
 	assert.Equal(t, roles, response.Data.Roles)
 }
 
+type addressTransactionsResponse struct {
+	Data struct {
+		Transactions []*common.AddressTransaction `json:"transactions"`
+		Total        uint64                       `json:"total"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestGetTransactions_InvalidQueryParametersShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetAddressTransactionsCalled: func(_ string, _ uint64, _ uint64) (*common.AddressTransactionsResponse, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/address/erd1alice/transactions?from=abc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := addressTransactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+
+	req, _ = http.NewRequest("GET", "/address/erd1alice/transactions?size=-3", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response = addressTransactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetAddressTransactions.Error()))
+}
+
+func TestGetTransactions_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetAddressTransactionsCalled: func(_ string, _ uint64, _ uint64) (*common.AddressTransactionsResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/address/erd1alice/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := addressTransactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetTransactions_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedTransactions := []*common.AddressTransaction{
+		{
+			TxHash:     "aabb",
+			Type:       "TxBlock",
+			Direction:  "out",
+			Epoch:      2,
+			BlockNonce: 37,
+		},
+	}
+	testAddress := "erd1alice"
+	calledWith := make([]uint64, 0)
+	facade := mock.FacadeStub{
+		GetAddressTransactionsCalled: func(address string, from uint64, size uint64) (*common.AddressTransactionsResponse, error) {
+			assert.Equal(t, testAddress, address)
+			calledWith = append(calledWith, from, size)
+			return &common.AddressTransactionsResponse{
+				Transactions: expectedTransactions,
+				Total:        7,
+			}, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/transactions?from=5&size=10", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := addressTransactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedTransactions, response.Data.Transactions)
+	assert.Equal(t, uint64(7), response.Data.Total)
+
+	req, _ = http.NewRequest("GET", fmt.Sprintf("/address/%s/transactions", testAddress), nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	assert.Equal(t, []uint64{5, 10, 0, 20}, calledWith)
+}
+
+func TestGetBalance_AtBlockShouldPassTheAccountQueryOptions(t *testing.T) {
+	t.Parallel()
+
+	var calledWith common.AccountQueryOptions
+	facade := mock.FacadeStub{
+		BalanceHandler: func(_ string, options common.AccountQueryOptions) (*big.Int, error) {
+			calledWith = options
+			return big.NewInt(10), nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/address/addr/balance?blockNonce=0", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, common.AccountQueryOptions{BlockNonce: 0, HasBlockNonce: true}, calledWith)
+
+	req, _ = http.NewRequest("GET", "/address/addr/balance?blockHash=abcd", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, common.AccountQueryOptions{BlockHash: []byte{0xab, 0xcd}}, calledWith)
+
+	req, _ = http.NewRequest("GET", "/address/addr/balance", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.False(t, calledWith.IsHistorical())
+}
+
+func TestGetBalance_BlockNonceNotAvailableShouldReturnBadRequest(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		BalanceHandler: func(_ string, _ common.AccountQueryOptions) (*big.Int, error) {
+			return nil, fmt.Errorf("%w: nonce 5", common.ErrBlockNonceNotAvailable)
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/address/addr/balance?blockNonce=5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetBalance.Error()))
+	assert.True(t, strings.Contains(response.Error, common.ErrBlockNonceNotAvailable.Error()))
+}
+
+func TestGetAccount_InvalidAccountQueryOptionsShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetAccountHandler: func(_ string, _ common.AccountQueryOptions) (api.AccountResponse, error) {
+			require.Fail(t, "should have not called the facade")
+			return api.AccountResponse{}, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	for _, query := range []string{"blockNonce=abc", "blockHash=zz", "blockNonce=1&blockHash=abcd"} {
+		req, _ := http.NewRequest("GET", "/address/addr?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrCouldNotGetAccount.Error()))
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+	}
+}
+
 func TestAddressGroup_UpdateFacadeStub(t *testing.T) {
 	t.Parallel()
 
@@ -922,7 +1127,7 @@ func TestAddressGroup_UpdateFacadeStub(t *testing.T) {
 	}
 	testAddress := "address"
 	facade := mock.FacadeStub{
-		GetESDTsRolesCalled: func(_ string) (map[string][]string, error) {
+		GetESDTsRolesCalled: func(_ string, _ common.AccountQueryOptions) (map[string][]string, error) {
 			return roles, nil
 		},
 	}
@@ -943,7 +1148,7 @@ func TestAddressGroup_UpdateFacadeStub(t *testing.T) {
 
 	newErr := errors.New("new error")
 	newFacadeStub := mock.FacadeStub{
-		GetESDTsRolesCalled: func(_ string) (map[string][]string, error) {
+		GetESDTsRolesCalled: func(_ string, _ common.AccountQueryOptions) (map[string][]string, error) {
 			return nil, newErr
 		},
 	}
@@ -976,6 +1181,7 @@ func getAddressRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/nft/:tokenIdentifier/nonce/:nonce", Open: true},
 					{Name: "/:address/esdts-with-role/:role", Open: true},
 					{Name: "/:address/registered-nfts", Open: true},
+					{Name: "/:address/transactions", Open: true},
 				},
 			},
 		},
@@ -1,10 +1,14 @@
 package groups
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
 	"strings"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/gin-gonic/gin"
 )
@@ -73,22 +77,41 @@ func getEndpointProperties(ws *gin.RouterGroup, path string, apiConfig config.Ap
 	splitPath := strings.Split(basePath, "/")
 	basePath = splitPath[len(splitPath)-1]
 
-	group, ok := apiConfig.APIPackages[basePath]
+	return endpointProperties{
+		isOpen: isRouteOpen(apiConfig, basePath, path),
+	}
+}
+
+// isRouteOpen returns true if the route with the provided path is configured as open in the provided group
+func isRouteOpen(apiConfig config.ApiRoutesConfig, group string, path string) bool {
+	groupConfig, ok := apiConfig.APIPackages[group]
 	if !ok {
-		return endpointProperties{
-			isOpen: false,
-		}
+		return false
 	}
 
-	for _, route := range group.Routes {
+	for _, route := range groupConfig.Routes {
 		if route.Name == path {
-			return endpointProperties{
-				isOpen: route.Open,
-			}
+			return route.Open
 		}
 	}
 
-	return endpointProperties{
-		isOpen: false,
+	return false
+}
+
+// respondWithAccountQueryError responds with a bad request error if the queried block nonce is no longer kept by the
+// node and with an internal error otherwise
+func respondWithAccountQueryError(c *gin.Context, scope error, err error) {
+	if errors.Is(err, common.ErrBlockNonceNotAvailable) {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", scope.Error(), err.Error()))
+		return
 	}
+
+	c.JSON(
+		http.StatusInternalServerError,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: fmt.Sprintf("%s: %s", scope.Error(), err.Error()),
+			Code:  shared.ReturnCodeInternalError,
+		},
+	)
 }
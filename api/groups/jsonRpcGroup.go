@@ -0,0 +1,379 @@
+package groups
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	jsonRpcEndpoint = "/jsonrpc"
+	jsonRpcPath     = "/"
+
+	jsonRpcVersion      = "2.0"
+	maxJsonRpcBatchSize = 100
+)
+
+// JSON-RPC 2.0 error codes. The ones in the -32000 to -32099 range are implementation defined server errors
+const (
+	JsonRpcCodeParseError     = -32700
+	JsonRpcCodeInvalidRequest = -32600
+	JsonRpcCodeMethodNotFound = -32601
+	JsonRpcCodeInvalidParams  = -32602
+	JsonRpcCodeInternalError  = -32603
+	JsonRpcCodeServerError    = -32000
+	JsonRpcCodeLimitExceeded  = -32005
+)
+
+// jsonRpcFacadeHandler defines the methods to be implemented by a facade for handling JSON-RPC requests. It is the
+// union of the facade handlers used by the address, transaction, block, network and vm-values groups
+type jsonRpcFacadeHandler interface {
+	GetBalance(address string, options common.AccountQueryOptions) (*big.Int, error)
+	GetUsername(address string, options common.AccountQueryOptions) (string, error)
+	GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error)
+	GetAccount(address string, options common.AccountQueryOptions) (api.AccountResponse, error)
+	GetESDTData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*esdt.ESDigitalToken, error)
+	GetESDTsRoles(address string, options common.AccountQueryOptions) (map[string][]string, error)
+	GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) ([]string, error)
+	GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) ([]string, error)
+	GetAllESDTTokens(address string, options common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error)
+	GetKeyValuePairs(address string, options common.AccountQueryOptions) (map[string]string, error)
+	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
+		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
+	ValidateTransaction(tx *transaction.Transaction) error
+	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
+	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
+	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	SimulateTransactionWithOptions(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	GetBlockByHash(hash string, withTxs bool) (*api.Block, error)
+	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
+	GetBlockByRound(round uint64, withTxs bool) (*api.Block, error)
+	GetTotalStakedValue() (*api.StakeValues, error)
+	GetDirectStakedList() ([]*api.DirectStakedValue, error)
+	GetDelegatorsList() ([]*api.Delegator, error)
+	StatusMetrics() external.StatusMetricsHandler
+	GetAllIssuedESDTs(tokenType string) ([]string, error)
+	GetTokenSupply(token string) (string, error)
+	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, error)
+	DecodeAddressPubkey(pk string) ([]byte, error)
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
+	IsInterfaceNil() bool
+}
+
+// JsonRpcRequest is a JSON-RPC 2.0 request object
+type JsonRpcRequest struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+}
+
+// JsonRpcError is a JSON-RPC 2.0 error object
+type JsonRpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// JsonRpcResponse is a JSON-RPC 2.0 response object
+type JsonRpcResponse struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Result  interface{}     `json:"result"`
+	Error   *JsonRpcError   `json:"error"`
+	ID      json.RawMessage `json:"id"`
+}
+
+type jsonRpcResultResponse struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Result  interface{}     `json:"result"`
+	ID      json.RawMessage `json:"id"`
+}
+
+type jsonRpcErrorResponse struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Error   *JsonRpcError   `json:"error"`
+	ID      json.RawMessage `json:"id"`
+}
+
+// MarshalJSON encodes the response holding either the error member or the result member, as the JSON-RPC 2.0
+// specification requires. The result member is present even if it is null
+func (response *JsonRpcResponse) MarshalJSON() ([]byte, error) {
+	if response.Error != nil {
+		return json.Marshal(&jsonRpcErrorResponse{
+			JsonRpc: response.JsonRpc,
+			Error:   response.Error,
+			ID:      response.ID,
+		})
+	}
+
+	return json.Marshal(&jsonRpcResultResponse{
+		JsonRpc: response.JsonRpc,
+		Result:  response.Result,
+		ID:      response.ID,
+	})
+}
+
+type jsonRpcHandlerFunc func(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError)
+
+type jsonRpcMethod struct {
+	handler jsonRpcHandlerFunc
+	// group and path identify the REST route whose Open flag also applies to the JSON-RPC method
+	group string
+	path  string
+	// throttler holds the name of the REST endpoint throttler, if any, shared by the JSON-RPC method
+	throttler string
+	isOpen    bool
+}
+
+// jsonRpcThrottlers holds the endpoint throttlers used by the entries of one JSON-RPC request or batch. They are
+// released only after the whole batch was processed, so every entry counts against the throttlers
+type jsonRpcThrottlers struct {
+	throttlers []core.Throttler
+}
+
+func (jt *jsonRpcThrottlers) release() {
+	for _, throttler := range jt.throttlers {
+		throttler.EndProcessing()
+	}
+	jt.throttlers = nil
+}
+
+type jsonRpcGroup struct {
+	*baseGroup
+	facade     jsonRpcFacadeHandler
+	mutFacade  sync.RWMutex
+	methods    map[string]jsonRpcMethod
+	mutMethods sync.RWMutex
+}
+
+// NewJsonRpcGroup returns a new instance of jsonRpcGroup
+func NewJsonRpcGroup(facade jsonRpcFacadeHandler) (*jsonRpcGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for json-rpc group", errors.ErrNilFacadeHandler)
+	}
+
+	jg := &jsonRpcGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+		methods:   createJsonRpcMethods(),
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    jsonRpcPath,
+			Method:  http.MethodPost,
+			Handler: jg.handleRequests,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(jsonRpcEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+	}
+	jg.endpoints = endpoints
+
+	return jg, nil
+}
+
+// RegisterRoutes will register the JSON-RPC endpoint and will open only the methods whose REST routes are open
+func (jg *jsonRpcGroup) RegisterRoutes(ws *gin.RouterGroup, apiConfig config.ApiRoutesConfig) {
+	jg.mutMethods.Lock()
+	for name, method := range jg.methods {
+		method.isOpen = isRouteOpen(apiConfig, method.group, method.path)
+		jg.methods[name] = method
+	}
+	jg.mutMethods.Unlock()
+
+	jg.baseGroup.RegisterRoutes(ws, apiConfig)
+}
+
+// handleRequests will process a single JSON-RPC request or a batch of requests
+func (jg *jsonRpcGroup) handleRequests(c *gin.Context) {
+	body, err := ioutil.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusOK, newJsonRpcErrorResponse(nil, JsonRpcCodeParseError, err.Error()))
+		return
+	}
+
+	body = bytes.TrimSpace(body)
+	isBatch := len(body) > 0 && body[0] == '['
+	throttlers := &jsonRpcThrottlers{}
+	defer throttlers.release()
+
+	if !isBatch {
+		response, hasResponse := jg.processRawRequest(body, throttlers)
+		if !hasResponse {
+			c.Status(http.StatusNoContent)
+			return
+		}
+
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	var rawRequests []json.RawMessage
+	err = json.Unmarshal(body, &rawRequests)
+	if err != nil {
+		c.JSON(http.StatusOK, newJsonRpcErrorResponse(nil, JsonRpcCodeParseError, err.Error()))
+		return
+	}
+	if len(rawRequests) == 0 {
+		c.JSON(http.StatusOK, newJsonRpcErrorResponse(nil, JsonRpcCodeInvalidRequest, "empty batch"))
+		return
+	}
+	if len(rawRequests) > maxJsonRpcBatchSize {
+		message := fmt.Sprintf("batch too large: maximum %d requests allowed", maxJsonRpcBatchSize)
+		c.JSON(http.StatusOK, newJsonRpcErrorResponse(nil, JsonRpcCodeInvalidRequest, message))
+		return
+	}
+
+	responses := make([]*JsonRpcResponse, 0, len(rawRequests))
+	for _, rawRequest := range rawRequests {
+		response, hasResponse := jg.processRawRequest(rawRequest, throttlers)
+		if hasResponse {
+			responses = append(responses, response)
+		}
+	}
+	if len(responses) == 0 {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// processRawRequest returns the response for the provided request and false if the request is a notification
+func (jg *jsonRpcGroup) processRawRequest(rawRequest []byte, throttlers *jsonRpcThrottlers) (*JsonRpcResponse, bool) {
+	request := &JsonRpcRequest{}
+	err := json.Unmarshal(rawRequest, request)
+	if err != nil {
+		_, isSyntaxErr := err.(*json.SyntaxError)
+		if isSyntaxErr {
+			return newJsonRpcErrorResponse(nil, JsonRpcCodeParseError, err.Error()), true
+		}
+
+		return newJsonRpcErrorResponse(nil, JsonRpcCodeInvalidRequest, err.Error()), true
+	}
+
+	if request.JsonRpc != jsonRpcVersion || len(request.Method) == 0 {
+		return newJsonRpcErrorResponse(request.ID, JsonRpcCodeInvalidRequest, "invalid JSON-RPC 2.0 request"), true
+	}
+
+	result, rpcErr := jg.callMethod(request, throttlers)
+	isNotification := len(request.ID) == 0
+	if isNotification {
+		return nil, false
+	}
+	if rpcErr != nil {
+		return &JsonRpcResponse{
+			JsonRpc: jsonRpcVersion,
+			Error:   rpcErr,
+			ID:      request.ID,
+		}, true
+	}
+
+	return &JsonRpcResponse{
+		JsonRpc: jsonRpcVersion,
+		Result:  result,
+		ID:      request.ID,
+	}, true
+}
+
+func (jg *jsonRpcGroup) callMethod(request *JsonRpcRequest, throttlers *jsonRpcThrottlers) (interface{}, *JsonRpcError) {
+	jg.mutMethods.RLock()
+	method, ok := jg.methods[request.Method]
+	jg.mutMethods.RUnlock()
+	if !ok {
+		return nil, newJsonRpcError(JsonRpcCodeMethodNotFound, fmt.Sprintf("method %s not found", request.Method))
+	}
+	if !method.isOpen {
+		return nil, newJsonRpcError(JsonRpcCodeMethodNotFound, fmt.Sprintf("method %s is closed", request.Method))
+	}
+
+	facade := jg.getFacade()
+	if len(method.throttler) > 0 {
+		throttler, found := facade.GetThrottlerForEndpoint(method.throttler)
+		if found {
+			if !throttler.CanProcess() {
+				message := fmt.Sprintf("%s for endpoint %s", errors.ErrTooManyRequests.Error(), method.throttler)
+				return nil, newJsonRpcError(JsonRpcCodeLimitExceeded, message)
+			}
+
+			throttler.StartProcessing()
+			throttlers.throttlers = append(throttlers.throttlers, throttler)
+		}
+	}
+
+	return method.handler(facade, request.Params)
+}
+
+func newJsonRpcError(code int, message string) *JsonRpcError {
+	return &JsonRpcError{
+		Code:    code,
+		Message: message,
+	}
+}
+
+func newJsonRpcErrorResponse(id json.RawMessage, code int, message string) *JsonRpcResponse {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+
+	return &JsonRpcResponse{
+		JsonRpc: jsonRpcVersion,
+		Error:   newJsonRpcError(code, message),
+		ID:      id,
+	}
+}
+
+func (jg *jsonRpcGroup) getFacade() jsonRpcFacadeHandler {
+	jg.mutFacade.RLock()
+	defer jg.mutFacade.RUnlock()
+
+	return jg.facade
+}
+
+// UpdateFacade will update the facade
+func (jg *jsonRpcGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(jsonRpcFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	jg.mutFacade.Lock()
+	jg.facade = castFacade
+	jg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jg *jsonRpcGroup) IsInterfaceNil() bool {
+	return jg == nil
+}
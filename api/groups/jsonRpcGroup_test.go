@@ -0,0 +1,410 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/groups"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type jsonRpcBalanceResponse struct {
+	JsonRpc string               `json:"jsonrpc"`
+	Result  map[string]string    `json:"result"`
+	Error   *groups.JsonRpcError `json:"error"`
+	ID      json.RawMessage      `json:"id"`
+}
+
+func getJsonRpcRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"jsonrpc": {
+				Routes: []config.RouteConfig{
+					{Name: "/", Open: true},
+				},
+			},
+			"address": {
+				Routes: []config.RouteConfig{
+					{Name: "/:address/balance", Open: true},
+					{Name: "/:address/username", Open: false},
+				},
+			},
+			"transaction": {
+				Routes: []config.RouteConfig{
+					{Name: "/:txhash", Open: true},
+				},
+			},
+			"block": {
+				Routes: []config.RouteConfig{
+					{Name: "/by-nonce/:nonce", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func doJsonRpcRequest(t *testing.T, facade *mock.FacadeStub, body string) *httptest.ResponseRecorder {
+	jsonRpcGroup, err := groups.NewJsonRpcGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(jsonRpcGroup, "jsonrpc", getJsonRpcRoutesConfig())
+
+	req, _ := http.NewRequest("POST", "/jsonrpc/", bytes.NewBufferString(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func createBalanceFacade() *mock.FacadeStub {
+	return &mock.FacadeStub{
+		BalanceHandler: func(address string, _ common.AccountQueryOptions) (*big.Int, error) {
+			if address == "erd1bad" {
+				return nil, errors.New("bad address")
+			}
+
+			return big.NewInt(int64(len(address))), nil
+		},
+	}
+}
+
+func TestNewJsonRpcGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		jg, err := groups.NewJsonRpcGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, jg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		jg, err := groups.NewJsonRpcGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, jg)
+	})
+}
+
+func TestJsonRpcGroup_SingleRequestShouldWork(t *testing.T) {
+	t.Parallel()
+
+	body := `{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1abc"},"id":7}`
+	resp := doJsonRpcRequest(t, createBalanceFacade(), body)
+
+	response := jsonRpcBalanceResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Nil(t, response.Error)
+	assert.Equal(t, "2.0", response.JsonRpc)
+	assert.Equal(t, "7", string(response.ID))
+	assert.Equal(t, "7", response.Result["balance"])
+}
+
+func TestJsonRpcGroup_BatchRequestShouldKeepOrderAndSkipNotifications(t *testing.T) {
+	t.Parallel()
+
+	body := `[
+		{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1abc"},"id":"a"},
+		{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1bad"},"id":"b"},
+		{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1abc"}},
+		{"jsonrpc":"2.0","method":"unknown_method","id":"c"},
+		{"jsonrpc":"2.0","method":"address_getBalance","params":{},"id":"d"},
+		{"jsonrpc":"1.0","method":"address_getBalance","id":"e"},
+		1
+	]`
+	resp := doJsonRpcRequest(t, createBalanceFacade(), body)
+
+	var responses []jsonRpcBalanceResponse
+	loadResponse(resp.Body, &responses)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, 6, len(responses))
+
+	assert.Equal(t, `"a"`, string(responses[0].ID))
+	assert.Nil(t, responses[0].Error)
+	assert.Equal(t, "7", responses[0].Result["balance"])
+
+	assert.Equal(t, `"b"`, string(responses[1].ID))
+	assert.Equal(t, groups.JsonRpcCodeServerError, responses[1].Error.Code)
+	assert.True(t, strings.Contains(responses[1].Error.Message, "bad address"))
+
+	assert.Equal(t, `"c"`, string(responses[2].ID))
+	assert.Equal(t, groups.JsonRpcCodeMethodNotFound, responses[2].Error.Code)
+
+	assert.Equal(t, `"d"`, string(responses[3].ID))
+	assert.Equal(t, groups.JsonRpcCodeInvalidParams, responses[3].Error.Code)
+
+	assert.Equal(t, `"e"`, string(responses[4].ID))
+	assert.Equal(t, groups.JsonRpcCodeInvalidRequest, responses[4].Error.Code)
+
+	assert.Equal(t, "null", string(responses[5].ID))
+	assert.Equal(t, groups.JsonRpcCodeInvalidRequest, responses[5].Error.Code)
+}
+
+func TestJsonRpcGroup_NotificationsOnlyShouldReturnNoContent(t *testing.T) {
+	t.Parallel()
+
+	body := `{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1abc"}}`
+	resp := doJsonRpcRequest(t, createBalanceFacade(), body)
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+
+	body = fmt.Sprintf("[%s,%s]", body, body)
+	resp = doJsonRpcRequest(t, createBalanceFacade(), body)
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+}
+
+func TestJsonRpcGroup_MalformedRequestsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("parse error", func(t *testing.T) {
+		resp := doJsonRpcRequest(t, createBalanceFacade(), `{"jsonrpc":"2.0","method"`)
+
+		response := jsonRpcBalanceResponse{}
+		loadResponse(resp.Body, &response)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, groups.JsonRpcCodeParseError, response.Error.Code)
+		assert.Equal(t, "null", string(response.ID))
+	})
+
+	t.Run("batch parse error", func(t *testing.T) {
+		resp := doJsonRpcRequest(t, createBalanceFacade(), `[{"jsonrpc":"2.0"`)
+
+		response := jsonRpcBalanceResponse{}
+		loadResponse(resp.Body, &response)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, groups.JsonRpcCodeParseError, response.Error.Code)
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		resp := doJsonRpcRequest(t, createBalanceFacade(), `[]`)
+
+		response := jsonRpcBalanceResponse{}
+		loadResponse(resp.Body, &response)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, groups.JsonRpcCodeInvalidRequest, response.Error.Code)
+	})
+
+	t.Run("batch too large", func(t *testing.T) {
+		requests := make([]string, 101)
+		for i := range requests {
+			requests[i] = fmt.Sprintf(`{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1abc"},"id":%d}`, i)
+		}
+		resp := doJsonRpcRequest(t, createBalanceFacade(), "["+strings.Join(requests, ",")+"]")
+
+		response := jsonRpcBalanceResponse{}
+		loadResponse(resp.Body, &response)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, groups.JsonRpcCodeInvalidRequest, response.Error.Code)
+	})
+}
+
+func TestJsonRpcResponse_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil data success should keep the result member", func(t *testing.T) {
+		buff, err := json.Marshal(&groups.JsonRpcResponse{
+			JsonRpc: "2.0",
+			Result:  nil,
+			ID:      json.RawMessage("3"),
+		})
+		require.NoError(t, err)
+
+		members := make(map[string]json.RawMessage)
+		err = json.Unmarshal(buff, &members)
+		require.NoError(t, err)
+
+		result, hasResult := members["result"]
+		assert.True(t, hasResult)
+		assert.Equal(t, "null", string(result))
+		_, hasError := members["error"]
+		assert.False(t, hasError)
+		assert.Equal(t, "3", string(members["id"]))
+	})
+
+	t.Run("error should not hold the result member", func(t *testing.T) {
+		resp := doJsonRpcRequest(t, createBalanceFacade(), `{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1bad"},"id":1}`)
+
+		members := make(map[string]json.RawMessage)
+		loadResponse(resp.Body, &members)
+
+		_, hasResult := members["result"]
+		assert.False(t, hasResult)
+		_, hasError := members["error"]
+		assert.True(t, hasError)
+	})
+}
+
+func TestJsonRpcGroup_ThrottledMethodShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+			if endpoint == "/transaction/:hash" {
+				return &mock.ThrottlerStub{
+					CanProcessCalled: func() bool {
+						return false
+					},
+				}, true
+			}
+
+			return nil, false
+		},
+	}
+
+	body := `{"jsonrpc":"2.0","method":"transaction_get","params":{"hash":"aa"},"id":1}`
+	resp := doJsonRpcRequest(t, facade, body)
+
+	response := jsonRpcBalanceResponse{}
+	loadResponse(resp.Body, &response)
+	require.NotNil(t, response.Error)
+	assert.Equal(t, groups.JsonRpcCodeLimitExceeded, response.Error.Code)
+}
+
+func TestJsonRpcGroup_MethodOfClosedRouteShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetUsernameCalled: func(_ string, _ common.AccountQueryOptions) (string, error) {
+			require.Fail(t, "should have not been called")
+			return "", nil
+		},
+	}
+
+	for _, method := range []string{"address_getUsername", "address_getAccount"} {
+		body := fmt.Sprintf(`{"jsonrpc":"2.0","method":"%s","params":{"address":"erd1abc"},"id":1}`, method)
+		resp := doJsonRpcRequest(t, facade, body)
+
+		response := jsonRpcBalanceResponse{}
+		loadResponse(resp.Body, &response)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, groups.JsonRpcCodeMethodNotFound, response.Error.Code)
+		assert.True(t, strings.Contains(response.Error.Message, "closed"))
+	}
+}
+
+func TestJsonRpcGroup_BatchEntriesShouldCountAgainstTheThrottler(t *testing.T) {
+	t.Parallel()
+
+	maxNumGoRoutines := 2
+	numInProgress := 0
+	throttler := &mock.ThrottlerStub{
+		CanProcessCalled: func() bool {
+			return numInProgress < maxNumGoRoutines
+		},
+		StartProcessingCalled: func() {
+			numInProgress++
+		},
+		EndProcessingCalled: func() {
+			numInProgress--
+		},
+	}
+	facade := &mock.FacadeStub{
+		GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+			if endpoint == "/transaction/:hash" {
+				return throttler, true
+			}
+
+			return nil, false
+		},
+		GetTransactionHandler: func(hash string, _ bool) (*transaction.ApiTransactionResult, error) {
+			return &transaction.ApiTransactionResult{Hash: hash}, nil
+		},
+	}
+
+	body := `[
+		{"jsonrpc":"2.0","method":"transaction_get","params":{"hash":"aa"},"id":1},
+		{"jsonrpc":"2.0","method":"transaction_get","params":{"hash":"bb"},"id":2},
+		{"jsonrpc":"2.0","method":"transaction_get","params":{"hash":"cc"},"id":3}
+	]`
+	resp := doJsonRpcRequest(t, facade, body)
+
+	var responses []struct {
+		Error *groups.JsonRpcError `json:"error"`
+	}
+	loadResponse(resp.Body, &responses)
+	require.Equal(t, 3, len(responses))
+	assert.Nil(t, responses[0].Error)
+	assert.Nil(t, responses[1].Error)
+	require.NotNil(t, responses[2].Error)
+	assert.Equal(t, groups.JsonRpcCodeLimitExceeded, responses[2].Error.Code)
+	assert.Equal(t, 0, numInProgress)
+}
+
+func TestJsonRpcGroup_BlockByNonceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool) (*api.Block, error) {
+			return &api.Block{Nonce: nonce, Round: 2}, nil
+		},
+	}
+
+	body := `{"jsonrpc":"2.0","method":"block_getByNonce","params":{"nonce":37},"id":1}`
+	resp := doJsonRpcRequest(t, facade, body)
+
+	response := struct {
+		Result struct {
+			Block api.Block `json:"block"`
+		} `json:"result"`
+		Error *groups.JsonRpcError `json:"error"`
+	}{}
+	loadResponse(resp.Body, &response)
+	assert.Nil(t, response.Error)
+	assert.Equal(t, uint64(37), response.Result.Block.Nonce)
+}
+
+func TestJsonRpcGroup_BalanceAtBlockShouldPassTheAccountQueryOptions(t *testing.T) {
+	t.Parallel()
+
+	var calledWith common.AccountQueryOptions
+	facade := &mock.FacadeStub{
+		BalanceHandler: func(_ string, options common.AccountQueryOptions) (*big.Int, error) {
+			calledWith = options
+			return big.NewInt(1), nil
+		},
+	}
+
+	body := `{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1abc","blockNonce":0},"id":1}`
+	resp := doJsonRpcRequest(t, facade, body)
+
+	response := jsonRpcBalanceResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Nil(t, response.Error)
+	assert.Equal(t, common.AccountQueryOptions{HasBlockNonce: true}, calledWith)
+
+	body = `{"jsonrpc":"2.0","method":"address_getBalance","params":{"address":"erd1abc","blockNonce":1,"blockHash":"abcd"},"id":1}`
+	resp = doJsonRpcRequest(t, facade, body)
+
+	response = jsonRpcBalanceResponse{}
+	loadResponse(resp.Body, &response)
+	require.NotNil(t, response.Error)
+	assert.Equal(t, groups.JsonRpcCodeInvalidParams, response.Error.Code)
+}
+
+func TestJsonRpcGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	jg, _ := groups.NewJsonRpcGroup(&mock.FacadeStub{})
+
+	err := jg.UpdateFacade(nil)
+	assert.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+
+	err = jg.UpdateFacade("not a facade")
+	assert.Equal(t, apiErrors.ErrFacadeWrongTypeAssertion, err)
+
+	err = jg.UpdateFacade(&mock.FacadeStub{})
+	assert.Nil(t, err)
+}
@@ -0,0 +1,814 @@
+package groups
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/common"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/gin-gonic/gin"
+)
+
+// blockSelectionParams optionally selects the block whose state is used by an address method
+type blockSelectionParams struct {
+	BlockNonce *uint64 `json:"blockNonce"`
+	BlockHash  string  `json:"blockHash"`
+}
+
+type addressParams struct {
+	blockSelectionParams
+	Address string `json:"address"`
+}
+
+type addressKeyParams struct {
+	blockSelectionParams
+	Address string `json:"address"`
+	Key     string `json:"key"`
+}
+
+type addressTokenParams struct {
+	blockSelectionParams
+	Address         string `json:"address"`
+	TokenIdentifier string `json:"tokenIdentifier"`
+	Nonce           uint64 `json:"nonce"`
+}
+
+type addressRoleParams struct {
+	blockSelectionParams
+	Address string `json:"address"`
+	Role    string `json:"role"`
+}
+
+type sendMultipleParams struct {
+	Transactions []SendTxRequest `json:"transactions"`
+}
+
+type simulateParams struct {
+	SendTxRequest
+	CheckSignature   *bool `json:"checkSignature,omitempty"`
+	WithStateChanges bool  `json:"withStateChanges,omitempty"`
+	WithTrace        bool  `json:"withTrace,omitempty"`
+}
+
+type getTransactionParams struct {
+	Hash        string `json:"hash"`
+	WithResults bool   `json:"withResults"`
+}
+
+type blockByNonceParams struct {
+	Nonce   uint64 `json:"nonce"`
+	WithTxs bool   `json:"withTxs"`
+}
+
+type blockByRoundParams struct {
+	Round   uint64 `json:"round"`
+	WithTxs bool   `json:"withTxs"`
+}
+
+type blockByHashParams struct {
+	Hash    string `json:"hash"`
+	WithTxs bool   `json:"withTxs"`
+}
+
+type esdtsParams struct {
+	TokenType string `json:"tokenType"`
+}
+
+type tokenSupplyParams struct {
+	Token string `json:"token"`
+}
+
+// the names of the REST groups, as registered by the web server, whose routes are mirrored by the JSON-RPC methods
+const (
+	addressGroupName     = "address"
+	transactionGroupName = "transaction"
+	blockGroupName       = "block"
+	networkGroupName     = "network"
+	vmValuesGroupName    = "vm-values"
+)
+
+// createJsonRpcMethods returns the JSON-RPC methods, named <REST group>_<action>, each of them calling the same facade
+// methods as the corresponding REST endpoint and returning the same data field. A method is open only if its REST
+// route is open and it shares the REST endpoint throttler, if the REST endpoint has one
+func createJsonRpcMethods() map[string]jsonRpcMethod {
+	return map[string]jsonRpcMethod{
+		"address_getAccount":        {handler: rpcGetAccount, group: addressGroupName, path: getAccountPath},
+		"address_getBalance":        {handler: rpcGetBalance, group: addressGroupName, path: getBalancePath},
+		"address_getUsername":       {handler: rpcGetUsername, group: addressGroupName, path: getUsernamePath},
+		"address_getValueForKey":    {handler: rpcGetValueForKey, group: addressGroupName, path: getKeyPath},
+		"address_getKeyValuePairs":  {handler: rpcGetKeyValuePairs, group: addressGroupName, path: getKeysPath},
+		"address_getESDTBalance":    {handler: rpcGetESDTBalance, group: addressGroupName, path: getESDTBalancePath},
+		"address_getESDTNFTData":    {handler: rpcGetESDTNFTData, group: addressGroupName, path: getESDTNFTDataPath},
+		"address_getAllESDTTokens":  {handler: rpcGetAllESDTTokens, group: addressGroupName, path: getESDTTokensPath},
+		"address_getESDTsRoles":     {handler: rpcGetESDTsRoles, group: addressGroupName, path: getESDTsRolesPath},
+		"address_getESDTsWithRole":  {handler: rpcGetESDTsWithRole, group: addressGroupName, path: getESDTTokensWithRolePath},
+		"address_getRegisteredNFTs": {handler: rpcGetRegisteredNFTs, group: addressGroupName, path: getRegisteredNFTsPath},
+
+		"transaction_send": {
+			handler:   rpcSendTransaction,
+			group:     transactionGroupName,
+			path:      sendTransactionPath,
+			throttler: sendTransactionEndpoint,
+		},
+		"transaction_sendMultiple": {
+			handler:   rpcSendMultipleTransactions,
+			group:     transactionGroupName,
+			path:      sendMultiplePath,
+			throttler: sendMultipleTransactionsEndpoint,
+		},
+		"transaction_simulate": {
+			handler:   rpcSimulateTransaction,
+			group:     transactionGroupName,
+			path:      simulateTransactionPath,
+			throttler: simulateTransactionEndpoint,
+		},
+		"transaction_cost": {
+			handler: rpcComputeTransactionGasLimit,
+			group:   transactionGroupName,
+			path:    costPath,
+		},
+		"transaction_get": {
+			handler:   rpcGetTransaction,
+			group:     transactionGroupName,
+			path:      getTransactionPath,
+			throttler: getTransactionEndpoint,
+		},
+
+		"block_getByNonce": {handler: rpcGetBlockByNonce, group: blockGroupName, path: getBlockByNoncePath},
+		"block_getByHash":  {handler: rpcGetBlockByHash, group: blockGroupName, path: getBlockByHashPath},
+		"block_getByRound": {handler: rpcGetBlockByRound, group: blockGroupName, path: getBlockByRoundPath},
+
+		"network_getConfig":           {handler: rpcGetNetworkConfig, group: networkGroupName, path: getConfigPath},
+		"network_getStatus":           {handler: rpcGetNetworkStatus, group: networkGroupName, path: getStatusPath},
+		"network_getEconomics":        {handler: rpcGetEconomics, group: networkGroupName, path: economicsPath},
+		"network_getEnableEpochs":     {handler: rpcGetEnableEpochs, group: networkGroupName, path: enableEpochsPath},
+		"network_getESDTs":            {handler: rpcGetAllIssuedESDTs, group: networkGroupName, path: getESDTsPath},
+		"network_getESDTSupply":       {handler: rpcGetESDTSupply, group: networkGroupName, path: getESDTSupplyPath},
+		"network_getDirectStakedInfo": {handler: rpcGetDirectStakedInfo, group: networkGroupName, path: directStakedInfoPath},
+		"network_getDelegatedInfo":    {handler: rpcGetDelegatedInfo, group: networkGroupName, path: delegatedInfoPath},
+
+		"vm_query":     {handler: rpcExecuteQuery, group: vmValuesGroupName, path: queryPath},
+		"vm_getHex":    {handler: createRpcGetVMValue(vm.AsHex), group: vmValuesGroupName, path: hexPath},
+		"vm_getString": {handler: createRpcGetVMValue(vm.AsString), group: vmValuesGroupName, path: stringPath},
+		"vm_getInt":    {handler: createRpcGetVMValue(vm.AsBigIntString), group: vmValuesGroupName, path: intPath},
+	}
+}
+
+func unmarshalParams(params json.RawMessage, destination interface{}) *JsonRpcError {
+	if len(params) == 0 {
+		return nil
+	}
+
+	err := json.Unmarshal(params, destination)
+	if err != nil {
+		return newJsonRpcError(JsonRpcCodeInvalidParams, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()))
+	}
+
+	return nil
+}
+
+func unmarshalAddressParams(params json.RawMessage) (*addressParams, *JsonRpcError) {
+	p := &addressParams{}
+	rpcErr := unmarshalParams(params, p)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if len(p.Address) == 0 {
+		return nil, newInvalidParamsError(errors.ErrEmptyAddress)
+	}
+
+	return p, nil
+}
+
+func (bsp *blockSelectionParams) toAccountQueryOptions() (common.AccountQueryOptions, *JsonRpcError) {
+	options := common.AccountQueryOptions{}
+	if bsp.BlockNonce != nil {
+		options.BlockNonce = *bsp.BlockNonce
+		options.HasBlockNonce = true
+	}
+
+	if len(bsp.BlockHash) == 0 {
+		return options, nil
+	}
+	if options.HasBlockNonce {
+		return options, newInvalidParamsError(
+			fmt.Errorf("%w: only one of blockNonce and blockHash can be provided", errors.ErrInvalidQueryParameter))
+	}
+
+	blockHash, err := hex.DecodeString(bsp.BlockHash)
+	if err != nil {
+		return options, newInvalidParamsError(fmt.Errorf("%w blockHash", errors.ErrInvalidQueryParameter))
+	}
+	options.BlockHash = blockHash
+
+	return options, nil
+}
+
+func newInvalidParamsError(err error) *JsonRpcError {
+	return newJsonRpcError(JsonRpcCodeInvalidParams, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()))
+}
+
+func newServerError(scope error, err error) *JsonRpcError {
+	if scope == nil {
+		return newJsonRpcError(JsonRpcCodeServerError, err.Error())
+	}
+
+	return newJsonRpcError(JsonRpcCodeServerError, fmt.Sprintf("%s: %s", scope.Error(), err.Error()))
+}
+
+func rpcGetAccount(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+	p, rpcErr := unmarshalAddressParams(params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	options, rpcErr := p.toAccountQueryOptions()
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	accountResponse, err := facade.GetAccount(p.Address, options)
+	if err != nil {
+		return nil, newServerError(errors.ErrCouldNotGetAccount, err)
+	}
+	accountResponse.Address = p.Address
+
+	return gin.H{"account": accountResponse}, nil
+}
+
+func rpcGetBalance(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+	p, rpcErr := unmarshalAddressParams(params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	options, rpcErr := p.toAccountQueryOptions()
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	balance, err := facade.GetBalance(p.Address, options)
+	if err != nil {
+		return nil, newServerError(errors.ErrGetBalance, err)
+	}
+
+	return gin.H{"balance": balance.String()}, nil
+}
+
+func rpcGetUsername(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+	p, rpcErr := unmarshalAddressParams(params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	options, rpcErr := p.toAccountQueryOptions()
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	userName, err := facade.GetUsername(p.Address, options)
+	if err != nil {
+		return nil, newServerError(errors.ErrGetUsername, err)
+	}
+
+	return gin.H{"username": userName}, nil
+}
+
+func rpcGetValueForKey(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+	p := &addressKeyParams{}
+	rpcErr := unmarshalParams(params, p)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if len(p.Address) == 0 {
+		return nil, newInvalidParamsError(errors.ErrEmptyAddress)
+	}
+	if len(p.Key) == 0 {
+		return nil, newInvalidParamsError(errors.ErrEmptyKey)
+	}
+
+	options, rpcErr := p.toAccountQueryOptions()
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	value, err := facade.GetValueForKey(p.Address, p.Key, options)
+	if err != nil {
+		return nil, newServerError(errors.ErrGetValueForKey, err)
+	}
+
+	return gin.H{"value": value}, nil
+}
+
+func rpcGetKeyValuePairs(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+	p, rpcErr := unmarshalAddressParams(params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	options, rpcErr := p.toAccountQueryOptions()
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	pairs, err := facade.GetKeyValuePairs(p.Address, options)
+	if err != nil {
+		return nil, newServerError(errors.ErrGetKeyValuePairs, err)
+	}
+
+	return gin.H{"pairs": pairs}, nil
+}
+
+func unmarshalAddressTokenParams(params json.RawMessage) (*addressTokenParams, *JsonRpcError) {
+	p := &addressTokenParams{}
+	rpcErr := unmarshalParams(params, p)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if len(p.Address) == 0 {
+		return nil, newInvalidParamsError(errors.ErrEmptyAddress)
+	}
+	if len(p.TokenIdentifier) == 0 {
+		return nil, newInvalidParamsError(errors.ErrEmptyTokenIdentifier)
+	}
+
+	return p, nil
+}
+
+func rpcGetESDTBalance(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+	p, rpcErr := unmarshalAddressTokenParams(params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	options, rpcErr := p.toAccountQueryOptions()
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	esdtData, err := facade.GetESDTData(p.Address, p.TokenIdentifier, 0, options)
+	if err != nil {
+		return nil, newServerError(errors.ErrGetESDTBalance, err)
+	}
+
+	tokenData := esdtTokenData{
+		TokenIdentifier: p.TokenIdentifier,
+		Balance:         esdtData.Value.String(),
+		Properties:      string(esdtData.Properties),
+	}
+
+	return gin.H{"tokenData": tokenData}, nil
+}
+
+func rpcGetESDTNFTData(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+	p, rpcErr := unmarshalAddressTokenParams(params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	options, rpcErr := p.toAccountQueryOptions()
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	esdtData, err := facade.GetESDTData(p.Address, p.TokenIdentifier, p.Nonce, options)
+	if err != nil {
+		return nil, newServerError(errors.ErrGetESDTNFTData, err)
+	}
+
+	return gin.H{"tokenData": buildTokenDataApiResponse(p.TokenIdentifier, esdtData)}, nil
+}
+
+func rpcGetAllESDTTokens(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+	p, rpcErr := unmarshalAddressParams(params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	options, rpcErr := p.toAccountQueryOptions()
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	tokens, err := facade.GetAllESDTTokens(p.Address, options)
+	if err != nil {
+		return nil, newServerError(errors.ErrGetESDTTokens, err)
+	}
+
+	formattedTokens := make(map[string]*esdtNFTTokenData)
+	for tokenID, esdtData := range tokens {
+		formattedTokens[tokenID] = buildTokenDataApiResponse(tokenID, esdtData)
+	}
+
+	return gin.H{"esdts": formattedTokens}, nil
+}
+
+func rpcGetESDTsRoles(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+	p, rpcErr := unmarshalAddressParams(params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	options, rpcErr := p.toAccountQueryOptions()
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	tokensRoles, err := facade.GetESDTsRoles(p.Address, options)
+	if err != nil {
+		return nil, newServerError(errors.ErrGetRolesForAccount, err)
+	}
+
+	return gin.H{"roles": tokensRoles}, nil
+}
+
+func rpcGetESDTsWithRole(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+	p := &addressRoleParams{}
+	rpcErr := unmarshalParams(params, p)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if len(p.Address) == 0 {
+		return nil, newInvalidParamsError(errors.ErrEmptyAddress)
+	}
+	if len(p.Role) == 0 {
+		return nil, newInvalidParamsError(errors.ErrEmptyRole)
+	}
+	if !core.IsValidESDTRole(p.Role) {
+		return nil, newInvalidParamsError(fmt.Errorf("invalid role: %s", p.Role))
+	}
+
+	options, rpcErr := p.toAccountQueryOptions()
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	tokens, err := facade.GetESDTsWithRole(p.Address, p.Role, options)
+	if err != nil {
+		return nil, newServerError(errors.ErrGetESDTTokens, err)
+	}
+
+	return gin.H{"tokens": tokens}, nil
+}
+
+func rpcGetRegisteredNFTs(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+	p, rpcErr := unmarshalAddressParams(params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	options, rpcErr := p.toAccountQueryOptions()
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	tokens, err := facade.GetNFTTokenIDsRegisteredByAddress(p.Address, options)
+	if err != nil {
+		return nil, newServerError(errors.ErrGetESDTTokens, err)
+	}
+
+	return gin.H{"tokens": tokens}, nil
+}
+
+func createTransactionFromRequest(facade jsonRpcFacadeHandler, request *SendTxRequest) (*transaction.Transaction, []byte, error) {
+	return facade.CreateTransaction(
+		request.Nonce,
+		request.Value,
+		request.Receiver,
+		request.ReceiverUsername,
+		request.Sender,
+		request.SenderUsername,
+		request.GasPrice,
+		request.GasLimit,
+		request.Data,
+		request.Signature,
+		request.ChainID,
+		request.Version,
+		request.Options,
+	)
+}
+
+func rpcSendTransaction(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+	request := &SendTxRequest{}
+	rpcErr := unmarshalParams(params, request)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	tx, txHash, err := createTransactionFromRequest(facade, request)
+	if err != nil {
+		return nil, newInvalidParamsError(fmt.Errorf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()))
+	}
+
+	err = facade.ValidateTransaction(tx)
+	if err != nil {
+		return nil, newInvalidParamsError(fmt.Errorf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()))
+	}
+
+	_, err = facade.SendBulkTransactions([]*transaction.Transaction{tx})
+	if err != nil {
+		return nil, newServerError(nil, err)
+	}
+
+	return gin.H{"txHash": hex.EncodeToString(txHash)}, nil
+}
+
+func rpcSendMultipleTransactions(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+	p := &sendMultipleParams{}
+	rpcErr := unmarshalParams(params, p)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	txs := make([]*transaction.Transaction, 0, len(p.Transactions))
+	txsHashes := make(map[int]string)
+	for idx := range p.Transactions {
+		tx, txHash, err := createTransactionFromRequest(facade, &p.Transactions[idx])
+		if err != nil {
+			continue
+		}
+
+		err = facade.ValidateTransaction(tx)
+		if err != nil {
+			continue
+		}
+
+		txs = append(txs, tx)
+		txsHashes[idx] = hex.EncodeToString(txHash)
+	}
+
+	numOfSentTxs, err := facade.SendBulkTransactions(txs)
+	if err != nil {
+		return nil, newServerError(nil, err)
+	}
+
+	return gin.H{
+		"txsSent":   numOfSentTxs,
+		"txsHashes": txsHashes,
+	}, nil
+}
+
+func rpcSimulateTransaction(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+	p := &simulateParams{}
+	rpcErr := unmarshalParams(params, p)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	checkSignature := true
+	if p.CheckSignature != nil {
+		checkSignature = *p.CheckSignature
+	}
+
+	tx, txHash, err := createTransactionFromRequest(facade, &p.SendTxRequest)
+	if err != nil {
+		return nil, newInvalidParamsError(fmt.Errorf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()))
+	}
+
+	err = facade.ValidateTransactionForSimulation(tx, checkSignature)
+	if err != nil {
+		return nil, newInvalidParamsError(fmt.Errorf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()))
+	}
+
+	var executionResults *txSimData.SimulationResults
+	if p.WithStateChanges || p.WithTrace {
+		options := txSimData.SimulationOptions{
+			WithStateChanges: p.WithStateChanges,
+			WithTrace:        p.WithTrace,
+		}
+		executionResults, err = facade.SimulateTransactionWithOptions(tx, options)
+	} else {
+		executionResults, err = facade.SimulateTransactionExecution(tx)
+	}
+	if err != nil {
+		return nil, newServerError(nil, err)
+	}
+	executionResults.Hash = hex.EncodeToString(txHash)
+
+	return gin.H{"result": executionResults}, nil
+}
+
+func rpcComputeTransactionGasLimit(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+	request := &SendTxRequest{}
+	rpcErr := unmarshalParams(params, request)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	tx, _, err := createTransactionFromRequest(facade, request)
+	if err != nil {
+		return nil, newServerError(nil, err)
+	}
+
+	cost, err := facade.ComputeTransactionGasLimit(tx)
+	if err != nil {
+		return nil, newServerError(nil, err)
+	}
+
+	return cost, nil
+}
+
+func rpcGetTransaction(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+	p := &getTransactionParams{}
+	rpcErr := unmarshalParams(params, p)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if len(p.Hash) == 0 {
+		return nil, newInvalidParamsError(errors.ErrValidationEmptyTxHash)
+	}
+
+	tx, err := facade.GetTransaction(p.Hash, p.WithResults)
+	if err != nil {
+		return nil, newServerError(errors.ErrGetTransaction, err)
+	}
+
+	return gin.H{"transaction": tx}, nil
+}
+
+func rpcGetBlockByNonce(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+	p := &blockByNonceParams{}
+	rpcErr := unmarshalParams(params, p)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	block, err := facade.GetBlockByNonce(p.Nonce, p.WithTxs)
+	if err != nil {
+		return nil, newServerError(errors.ErrGetBlock, err)
+	}
+
+	return gin.H{"block": block}, nil
+}
+
+func rpcGetBlockByHash(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+	p := &blockByHashParams{}
+	rpcErr := unmarshalParams(params, p)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if len(p.Hash) == 0 {
+		return nil, newInvalidParamsError(errors.ErrValidationEmptyBlockHash)
+	}
+
+	block, err := facade.GetBlockByHash(p.Hash, p.WithTxs)
+	if err != nil {
+		return nil, newServerError(errors.ErrGetBlock, err)
+	}
+
+	return gin.H{"block": block}, nil
+}
+
+func rpcGetBlockByRound(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+	p := &blockByRoundParams{}
+	rpcErr := unmarshalParams(params, p)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	block, err := facade.GetBlockByRound(p.Round, p.WithTxs)
+	if err != nil {
+		return nil, newServerError(errors.ErrGetBlock, err)
+	}
+
+	return gin.H{"block": block}, nil
+}
+
+func rpcGetNetworkConfig(facade jsonRpcFacadeHandler, _ json.RawMessage) (interface{}, *JsonRpcError) {
+	return gin.H{"config": facade.StatusMetrics().ConfigMetrics()}, nil
+}
+
+func rpcGetNetworkStatus(facade jsonRpcFacadeHandler, _ json.RawMessage) (interface{}, *JsonRpcError) {
+	return gin.H{"status": facade.StatusMetrics().NetworkMetrics()}, nil
+}
+
+func rpcGetEnableEpochs(facade jsonRpcFacadeHandler, _ json.RawMessage) (interface{}, *JsonRpcError) {
+	return gin.H{"enableEpochs": facade.StatusMetrics().EnableEpochsMetrics()}, nil
+}
+
+func rpcGetEconomics(facade jsonRpcFacadeHandler, _ json.RawMessage) (interface{}, *JsonRpcError) {
+	stakeValues, err := facade.GetTotalStakedValue()
+	if err != nil {
+		return nil, newServerError(nil, err)
+	}
+
+	metrics := facade.StatusMetrics().EconomicsMetrics()
+	metrics[common.MetricTotalBaseStakedValue] = stakeValues.BaseStaked.String()
+	metrics[common.MetricTopUpValue] = stakeValues.TopUp.String()
+
+	return gin.H{"metrics": metrics}, nil
+}
+
+func rpcGetAllIssuedESDTs(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+	p := &esdtsParams{}
+	rpcErr := unmarshalParams(params, p)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	switch p.TokenType {
+	case "", core.FungibleESDT, core.SemiFungibleESDT, core.NonFungibleESDT:
+	default:
+		return nil, newInvalidParamsError(fmt.Errorf("invalid token type: %s", p.TokenType))
+	}
+
+	tokens, err := facade.GetAllIssuedESDTs(p.TokenType)
+	if err != nil {
+		return nil, newServerError(nil, err)
+	}
+
+	return gin.H{"tokens": tokens}, nil
+}
+
+func rpcGetESDTSupply(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+	p := &tokenSupplyParams{}
+	rpcErr := unmarshalParams(params, p)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if len(p.Token) == 0 {
+		return nil, newInvalidParamsError(errors.ErrValidationEmptyToken)
+	}
+
+	supply, err := facade.GetTokenSupply(p.Token)
+	if err != nil {
+		return nil, newServerError(nil, err)
+	}
+
+	return gin.H{"supply": supply}, nil
+}
+
+func rpcGetDirectStakedInfo(facade jsonRpcFacadeHandler, _ json.RawMessage) (interface{}, *JsonRpcError) {
+	directStakedList, err := facade.GetDirectStakedList()
+	if err != nil {
+		return nil, newServerError(nil, err)
+	}
+
+	return gin.H{"list": directStakedList}, nil
+}
+
+func rpcGetDelegatedInfo(facade jsonRpcFacadeHandler, _ json.RawMessage) (interface{}, *JsonRpcError) {
+	delegatedList, err := facade.GetDelegatorsList()
+	if err != nil {
+		return nil, newServerError(nil, err)
+	}
+
+	return gin.H{"list": delegatedList}, nil
+}
+
+// rpcExecuteVMQuery returns the VM output and, similar to the REST API, the VM execution error message, if any
+func rpcExecuteVMQuery(facade jsonRpcFacadeHandler, params json.RawMessage) (*vm.VMOutputApi, string, *JsonRpcError) {
+	request := &VMValueRequest{}
+	rpcErr := unmarshalParams(params, request)
+	if rpcErr != nil {
+		return nil, "", rpcErr
+	}
+
+	command, err := createSCQueryFromRequest(facade, request)
+	if err != nil {
+		return nil, "", newInvalidParamsError(err)
+	}
+
+	vmOutputApi, err := facade.ExecuteSCQuery(command)
+	if err != nil {
+		return nil, "", newServerError(nil, err)
+	}
+
+	vmExecErrMsg := ""
+	if len(vmOutputApi.ReturnCode) > 0 && vmOutputApi.ReturnCode != vmcommon.Ok.String() {
+		vmExecErrMsg = vmOutputApi.ReturnCode + ":" + vmOutputApi.ReturnMessage
+	}
+
+	return vmOutputApi, vmExecErrMsg, nil
+}
+
+func rpcExecuteQuery(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+	vmOutput, execErrMsg, rpcErr := rpcExecuteVMQuery(facade, params)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+
+	return gin.H{"data": vmOutput, "error": execErrMsg}, nil
+}
+
+func createRpcGetVMValue(asType vm.ReturnDataKind) jsonRpcHandlerFunc {
+	return func(facade jsonRpcFacadeHandler, params json.RawMessage) (interface{}, *JsonRpcError) {
+		vmOutput, execErrMsg, rpcErr := rpcExecuteVMQuery(facade, params)
+		if rpcErr != nil {
+			return nil, rpcErr
+		}
+
+		returnData, err := vmOutput.GetFirstReturnData(asType)
+		if err != nil {
+			execErrMsg += " " + err.Error()
+		}
+
+		return gin.H{"data": returnData, "error": execErrMsg}, nil
+	}
+}
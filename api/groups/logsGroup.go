@@ -0,0 +1,189 @@
+package groups
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	logsEndpoint = "/logs"
+	getLogsPath  = "/"
+
+	queryParamAddress    = "address"
+	queryParamIdentifier = "identifier"
+	queryParamTopic      = "topic"
+	queryParamFromNonce  = "fromNonce"
+	queryParamToNonce    = "toNonce"
+
+	queryParamFromEventIndex = "fromEventIndex"
+)
+
+// logsFacadeHandler defines the methods to be implemented by a facade for handling logs and events requests
+type logsFacadeHandler interface {
+	GetLogs(query *common.LogsQuery) (*common.LogsResponse, error)
+	IsInterfaceNil() bool
+}
+
+type logsGroup struct {
+	*baseGroup
+	facade    logsFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewLogsGroup returns a new instance of logsGroup
+func NewLogsGroup(facade logsFacadeHandler) (*logsGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for logs group", apiErrors.ErrNilFacadeHandler)
+	}
+
+	lg := &logsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    getLogsPath,
+			Method:  http.MethodGet,
+			Handler: lg.getLogs,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(logsEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+	}
+	lg.endpoints = endpoints
+
+	return lg, nil
+}
+
+// getLogs returns a page of the log events emitted by an address, optionally filtered by identifier, topic and block
+// nonces range. A truncated page points to where the next one starts
+func (lg *logsGroup) getLogs(c *gin.Context) {
+	query, err := getLogsQueryFromRequest(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", apiErrors.ErrValidation.Error(), err.Error()))
+		return
+	}
+
+	start := time.Now()
+	response, err := lg.getFacade().GetLogs(query)
+	log.Debug(fmt.Sprintf("GetLogs took %s", time.Since(start)))
+	if errors.Is(err, common.ErrBlockNonceNotAvailable) {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", apiErrors.ErrValidation.Error(), err.Error()))
+		return
+	}
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", apiErrors.ErrGetLogs.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(
+		c,
+		http.StatusOK,
+		gin.H{
+			"logs":               response.Logs,
+			"truncated":          response.Truncated,
+			"nextFromNonce":      response.NextFromNonce,
+			"nextFromEventIndex": response.NextFromEventIndex,
+		},
+		"",
+		shared.ReturnCodeSuccess,
+	)
+}
+
+func getLogsQueryFromRequest(c *gin.Context) (*common.LogsQuery, error) {
+	urlQuery := c.Request.URL.Query()
+	address := urlQuery.Get(queryParamAddress)
+	if address == "" {
+		return nil, apiErrors.ErrValidationEmptyAddress
+	}
+
+	fromNonce, err := parseUint64QueryParam(c, queryParamFromNonce)
+	if err != nil {
+		return nil, err
+	}
+	toNonce, err := parseUint64QueryParam(c, queryParamToNonce)
+	if err != nil {
+		return nil, err
+	}
+	fromEventIndex, err := parseUint64QueryParam(c, queryParamFromEventIndex)
+	if err != nil {
+		return nil, err
+	}
+	if fromEventIndex > math.MaxUint32 {
+		return nil, fmt.Errorf("%w %s", apiErrors.ErrInvalidQueryParameter, queryParamFromEventIndex)
+	}
+
+	return &common.LogsQuery{
+		Address:        address,
+		Identifier:     urlQuery.Get(queryParamIdentifier),
+		Topic:          urlQuery.Get(queryParamTopic),
+		FromNonce:      fromNonce,
+		ToNonce:        toNonce,
+		FromEventIndex: uint32(fromEventIndex),
+	}, nil
+}
+
+func parseUint64QueryParam(c *gin.Context, name string) (uint64, error) {
+	valueStr := c.Request.URL.Query().Get(name)
+	if valueStr == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseUint(valueStr, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w %s", apiErrors.ErrInvalidQueryParameter, name)
+	}
+
+	return value, nil
+}
+
+func (lg *logsGroup) getFacade() logsFacadeHandler {
+	lg.mutFacade.RLock()
+	defer lg.mutFacade.RUnlock()
+
+	return lg.facade
+}
+
+// UpdateFacade will update the facade
+func (lg *logsGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return apiErrors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(logsFacadeHandler)
+	if !ok {
+		return apiErrors.ErrFacadeWrongTypeAssertion
+	}
+
+	lg.mutFacade.Lock()
+	lg.facade = castFacade
+	lg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lg *logsGroup) IsInterfaceNil() bool {
+	return lg == nil
+}
@@ -0,0 +1,191 @@
+package groups_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/groups"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type logsResponseData struct {
+	Logs               []*common.ApiLogEvent `json:"logs"`
+	Truncated          bool                  `json:"truncated"`
+	NextFromNonce      uint64                `json:"nextFromNonce"`
+	NextFromEventIndex uint32                `json:"nextFromEventIndex"`
+}
+
+type logsResponse struct {
+	Data  logsResponseData `json:"data"`
+	Error string           `json:"error"`
+	Code  string           `json:"code"`
+}
+
+func getLogsRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"logs": {
+				Routes: []config.RouteConfig{
+					{Name: "/", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func doLogsRequest(t *testing.T, facade *mock.FacadeStub, url string) (*httptest.ResponseRecorder, logsResponse) {
+	logsGroup, err := groups.NewLogsGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(logsGroup, "logs", getLogsRoutesConfig())
+
+	req, _ := http.NewRequest("GET", url, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := logsResponse{}
+	loadResponse(resp.Body, &response)
+
+	return resp, response
+}
+
+func TestNewLogsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		lg, err := groups.NewLogsGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, lg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		lg, err := groups.NewLogsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, lg)
+	})
+}
+
+func TestGetLogs_InvalidQueryParametersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetLogsCalled: func(query *common.LogsQuery) (*common.LogsResponse, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	resp, response := doLogsRequest(t, facade, "/logs/?identifier=transfer")
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationEmptyAddress.Error()))
+
+	resp, response = doLogsRequest(t, facade, "/logs/?address=erd1abc&fromNonce=abc")
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+
+	resp, response = doLogsRequest(t, facade, "/logs/?address=erd1abc&toNonce=-1")
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, "toNonce"))
+
+	resp, response = doLogsRequest(t, facade, "/logs/?address=erd1abc&fromEventIndex=4294967296")
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, "fromEventIndex"))
+}
+
+func TestGetLogs_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.FacadeStub{
+		GetLogsCalled: func(query *common.LogsQuery) (*common.LogsResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	resp, response := doLogsRequest(t, facade, "/logs/?address=erd1abc")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetLogs.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetLogs_BlockNonceNotAvailableShouldReturnBadRequest(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetLogsCalled: func(query *common.LogsQuery) (*common.LogsResponse, error) {
+			return nil, fmt.Errorf("%w: nonce 5", common.ErrBlockNonceNotAvailable)
+		},
+	}
+
+	resp, response := doLogsRequest(t, facade, "/logs/?address=erd1abc&fromNonce=5")
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, common.ErrBlockNonceNotAvailable.Error()))
+}
+
+func TestGetLogs_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedQuery := &common.LogsQuery{
+		Address:        "erd1abc",
+		Identifier:     "transfer",
+		Topic:          "0a0b",
+		FromNonce:      10,
+		ToNonce:        20,
+		FromEventIndex: 3,
+	}
+	expectedLogs := []*common.ApiLogEvent{
+		{
+			TxHash:     "aa",
+			BlockNonce: 11,
+			Epoch:      1,
+			Address:    "erd1abc",
+			Identifier: "transfer",
+			Topics:     [][]byte{[]byte("topic")},
+			Data:       []byte("data"),
+		},
+	}
+	facade := &mock.FacadeStub{
+		GetLogsCalled: func(query *common.LogsQuery) (*common.LogsResponse, error) {
+			assert.Equal(t, expectedQuery, query)
+			return &common.LogsResponse{
+				Logs:               expectedLogs,
+				Truncated:          true,
+				NextFromNonce:      12,
+				NextFromEventIndex: 5,
+			}, nil
+		},
+	}
+
+	resp, response := doLogsRequest(t, facade, "/logs/?address=erd1abc&identifier=transfer&topic=0a0b&fromNonce=10&toNonce=20&fromEventIndex=3")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+	assert.Equal(t, expectedLogs, response.Data.Logs)
+	assert.True(t, response.Data.Truncated)
+	assert.Equal(t, uint64(12), response.Data.NextFromNonce)
+	assert.Equal(t, uint32(5), response.Data.NextFromEventIndex)
+}
+
+func TestLogsGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	lg, _ := groups.NewLogsGroup(&mock.FacadeStub{})
+
+	err := lg.UpdateFacade(nil)
+	assert.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+
+	err = lg.UpdateFacade("not a facade")
+	assert.Equal(t, apiErrors.ErrFacadeWrongTypeAssertion, err)
+
+	err = lg.UpdateFacade(&mock.FacadeStub{})
+	assert.Nil(t, err)
+}
@@ -4,25 +4,40 @@ import (
 	"fmt"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/common/expfmt"
 )
 
 const (
 	pidQueryParam       = "pid"
+	antifloodReloadPath = "/antiflood/reload"
 	debugPath           = "/debug"
 	heartbeatStatusPath = "/heartbeatstatus"
 	metricsPath         = "/metrics"
 	p2pStatusPath       = "/p2pstatus"
 	peerInfoPath        = "/peerinfo"
+	peersReputationPath = "/peers/reputation"
 	statusPath          = "/status"
+	stateStatisticsPath = "/state-statistics"
+
+	stateStatisticsEndpoint = "/node/state-statistics"
+	queryParamRootHash      = "rootHash"
+	queryParamTop           = "top"
+
+	defaultNumLargestDataTries = 20
+	maxNumLargestDataTries     = 1000
 
 	// AccStateCheckpointsKey is used as a key for the number of account state checkpoints in the api response
 	AccStateCheckpointsKey = "erd_num_accounts_state_checkpoints"
@@ -37,6 +52,9 @@ type nodeFacadeHandler interface {
 	StatusMetrics() external.StatusMetricsHandler
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetPeersReputation() ([]*common.PeerReputation, error)
+	GetStateStatistics(rootHash string, numLargestDataTries int) (*common.StateStatistics, error)
+	ReloadAntifloodConfig() (*config.AntifloodConfig, error)
 	GetNumCheckpointsFromAccountState() uint32
 	GetNumCheckpointsFromPeerState() uint32
 	IsInterfaceNil() bool
@@ -96,6 +114,27 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.peerInfo,
 		},
+		{
+			Path:    peersReputationPath,
+			Method:  http.MethodGet,
+			Handler: ng.peersReputation,
+		},
+		{
+			Path:    antifloodReloadPath,
+			Method:  http.MethodPost,
+			Handler: ng.reloadAntifloodConfig,
+		},
+		{
+			Path:    stateStatisticsPath,
+			Method:  http.MethodGet,
+			Handler: ng.stateStatistics,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(stateStatisticsEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -227,12 +266,99 @@ func (ng *nodeGroup) peerInfo(c *gin.Context) {
 	)
 }
 
+// peersReputation returns the persisted reputation of the banned peer IDs and validator public keys
+func (ng *nodeGroup) peersReputation(c *gin.Context) {
+	reputations, err := ng.getFacade().GetPeersReputation()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetPeersReputation.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"reputation": reputations},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// stateStatistics walks the accounts trie, for the provided root hash or for the current one, together with all the
+// data tries and returns the node counts, depth histograms and sizes along with the largest data tries
+func (ng *nodeGroup) stateStatistics(c *gin.Context) {
+	numLargestDataTries, err := parseUint64QueryParam(c, queryParamTop)
+	if err != nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()))
+		return
+	}
+	if numLargestDataTries == 0 {
+		numLargestDataTries = defaultNumLargestDataTries
+	}
+	if numLargestDataTries > maxNumLargestDataTries {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s should be at most %d",
+			errors.ErrValidation.Error(), queryParamTop, maxNumLargestDataTries))
+		return
+	}
+
+	rootHash := c.Request.URL.Query().Get(queryParamRootHash)
+	start := time.Now()
+	stats, err := ng.getFacade().GetStateStatistics(rootHash, int(numLargestDataTries))
+	log.Debug(fmt.Sprintf("GetStateStatistics took %s", time.Since(start)))
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetStateStatistics.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"statistics": stats}, "", shared.ReturnCodeSuccess)
+}
+
+// reloadAntifloodConfig re-reads the antiflood configuration from the main configuration file and applies it on the
+// running node, returning the applied configuration
+func (ng *nodeGroup) reloadAntifloodConfig(c *gin.Context) {
+	antifloodConfig, err := ng.getFacade().ReloadAntifloodConfig()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrReloadAntifloodConfig.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"antiflood": antifloodConfig},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // prometheusMetrics is the endpoint which will return the data in the way that prometheus expects them
 func (ng *nodeGroup) prometheusMetrics(c *gin.Context) {
 	metrics := ng.getFacade().StatusMetrics().StatusMetricsWithoutP2PPrometheusString()
-	c.String(
+	c.Data(
 		http.StatusOK,
-		metrics,
+		string(expfmt.FmtText),
+		[]byte(metrics),
 	)
 }
 
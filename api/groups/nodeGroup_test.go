@@ -19,12 +19,14 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/common/expfmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -341,6 +343,262 @@ func TestPeerInfo_PeerInfoShouldWork(t *testing.T) {
 	assert.NotNil(t, responseInfo["info"])
 }
 
+func TestPeersReputation_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetPeersReputationCalled: func() ([]*common.PeerReputation, error) {
+			return nil, expectedErr
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/peers/reputation", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetPeersReputation.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestPeersReputation_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	reputation := &common.PeerReputation{
+		Identifier:  "16Uiu2HAmRCVXdXqt8BXfhrzotczHMXXvgHPd7iwGWvS53JT1xdw6",
+		Type:        "peerID",
+		Score:       -10,
+		NumBans:     1,
+		LastSeen:    1010,
+		BannedUntil: 1060,
+		History: []*common.PeerReputationEvent{
+			{
+				Timestamp:            1000,
+				Reason:               "flooding",
+				BanDurationInSeconds: 60,
+				Score:                -10,
+			},
+		},
+	}
+	facade := mock.FacadeStub{
+		GetPeersReputationCalled: func() ([]*common.PeerReputation, error) {
+			return []*common.PeerReputation{reputation}, nil
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/peers/reputation", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Reputation []*common.PeerReputation `json:"reputation"`
+		} `json:"data"`
+		Error string `json:"error"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.Equal(t, []*common.PeerReputation{reputation}, response.Data.Reputation)
+}
+
+func TestReloadAntifloodConfig_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		ReloadAntifloodConfigCalled: func() (*config.AntifloodConfig, error) {
+			return nil, expectedErr
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("POST", "/node/antiflood/reload", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrReloadAntifloodConfig.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestReloadAntifloodConfig_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	antifloodConfig := &config.AntifloodConfig{
+		Enabled: true,
+		WebServer: config.WebServerAntifloodConfig{
+			SimultaneousRequests: 100,
+		},
+	}
+	facade := mock.FacadeStub{
+		ReloadAntifloodConfigCalled: func() (*config.AntifloodConfig, error) {
+			return antifloodConfig, nil
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("POST", "/node/antiflood/reload", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Antiflood *config.AntifloodConfig `json:"antiflood"`
+		} `json:"data"`
+		Error string `json:"error"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.Equal(t, antifloodConfig, response.Data.Antiflood)
+}
+
+func TestStateStatistics_InvalidTopShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nodeGroup, err := groups.NewNodeGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	for _, top := range []string{"invalid", "-1", "1001"} {
+		req, _ := http.NewRequest("GET", "/node/state-statistics?top="+top, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	}
+}
+
+func TestStateStatistics_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetStateStatisticsCalled: func(_ string, _ int) (*common.StateStatistics, error) {
+			return nil, expectedErr
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/state-statistics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetStateStatistics.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestStateStatistics_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	stats := &common.StateStatistics{
+		RootHash: "0102",
+		AccountsTrie: &common.TrieStatistics{
+			NumBranchNodes:   1,
+			NumLeafNodes:     2,
+			TotalSizeInBytes: 300,
+			MaxDepth:         1,
+			DepthHistogram:   []uint64{1, 2},
+		},
+		DataTries: &common.TrieStatistics{
+			NumLeafNodes:     1,
+			TotalSizeInBytes: 50,
+			DepthHistogram:   []uint64{1},
+		},
+		NumAccounts:             2,
+		NumAccountsWithDataTrie: 1,
+		LargestDataTries: []*common.AccountDataTrieStatistics{
+			{
+				Address:          "erd1",
+				RootHash:         "0304",
+				NumKeys:          1,
+				NumNodes:         1,
+				TotalSizeInBytes: 50,
+			},
+		},
+	}
+	facade := mock.FacadeStub{
+		GetStateStatisticsCalled: func(rootHash string, numLargestDataTries int) (*common.StateStatistics, error) {
+			if rootHash == "0102" && numLargestDataTries == 5 {
+				return stats, nil
+			}
+			if rootHash == "" && numLargestDataTries == 20 {
+				return &common.StateStatistics{}, nil
+			}
+
+			return nil, errors.New("unexpected arguments")
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/state-statistics?rootHash=0102&top=5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Statistics *common.StateStatistics `json:"statistics"`
+		} `json:"data"`
+		Error string `json:"error"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.Equal(t, stats, response.Data.Statistics)
+
+	req, _ = http.NewRequest("GET", "/node/state-statistics", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
 func TestPrometheusMetrics_ShouldWork(t *testing.T) {
 	statusMetricsProvider := statusHandler.NewStatusMetrics()
 	key := "test-key"
@@ -367,6 +625,8 @@ func TestPrometheusMetrics_ShouldWork(t *testing.T) {
 
 	keyAndValueFoundInResponse := strings.Contains(respStr, key) && strings.Contains(respStr, fmt.Sprintf("%d", value))
 	assert.True(t, keyAndValueFoundInResponse)
+	assert.True(t, strings.Contains(respStr, fmt.Sprintf("# TYPE %s gauge", key)))
+	assert.Equal(t, string(expfmt.FmtText), resp.Header().Get("Content-Type"))
 }
 
 func loadResponseAsString(rsp io.Reader, response *statusResponse) {
@@ -390,6 +650,9 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/p2pstatus", Open: true},
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
+					{Name: "/peers/reputation", Open: true},
+					{Name: "/antiflood/reload", Open: true},
+					{Name: "/state-statistics", Open: true},
 				},
 			},
 		},
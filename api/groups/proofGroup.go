@@ -16,14 +16,26 @@ import (
 )
 
 const (
-	getProofCurrentRootHashEndpoint = "/proof/address/:address"
-	getProofEndpoint                = "/proof/root-hash/:roothash/address/:address"
-	getProofDataTrieEndpoint        = "/proof/root-hash/:roothash/address/:address/key/:key"
-	verifyProofEndpoint             = "/proof/verify"
-	getProofCurrentRootHashPath     = "/address/:address"
-	getProofPath                    = "/root-hash/:roothash/address/:address"
-	getProofDataTriePath            = "/root-hash/:roothash/address/:address/key/:key"
-	verifyProofPath                 = "/verify"
+	getProofCurrentRootHashEndpoint  = "/proof/address/:address"
+	getProofEndpoint                 = "/proof/root-hash/:roothash/address/:address"
+	getProofDataTrieEndpoint         = "/proof/root-hash/:roothash/address/:address/key/:key"
+	getProofDataTrieKeysEndpoint     = "/proof/root-hash/:roothash/address/:address/keys"
+	getProofDataTrieRangeEndpoint    = "/proof/root-hash/:roothash/address/:address/range"
+	verifyProofEndpoint              = "/proof/verify"
+	verifyProofDataTrieKeysEndpoint  = "/proof/verify-keys"
+	verifyProofDataTrieRangeEndpoint = "/proof/verify-range"
+	getProofCurrentRootHashPath      = "/address/:address"
+	getProofPath                     = "/root-hash/:roothash/address/:address"
+	getProofDataTriePath             = "/root-hash/:roothash/address/:address/key/:key"
+	getProofDataTrieKeysPath         = "/root-hash/:roothash/address/:address/keys"
+	getProofDataTrieRangePath        = "/root-hash/:roothash/address/:address/range"
+	verifyProofPath                  = "/verify"
+	verifyProofDataTrieKeysPath      = "/verify-keys"
+	verifyProofDataTrieRangePath     = "/verify-range"
+
+	queryParamStartKey   = "startKey"
+	queryParamEndKey     = "endKey"
+	queryParamMaxNumKeys = "maxNumKeys"
 )
 
 // proofFacadeHandler defines the methods to be implemented by a facade for proof requests
@@ -32,6 +44,10 @@ type proofFacadeHandler interface {
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetProofDataTrieMultiKey(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiKeyProofResponse, error)
+	GetProofDataTrieRange(rootHash string, address string, startKey string, endKey string, maxNumKeys int) (*common.GetProofResponse, *common.GetRangeProofResponse, error)
+	VerifyProofDataTrieMultiKey(rootHash string, address string, keys []string, proof [][]byte) ([]core.KeyValueHolder, error)
+	VerifyProofDataTrieRange(rootHash string, address string, startKey string, endKey string, proof [][]byte) ([]core.KeyValueHolder, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
 }
@@ -98,6 +114,50 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 				},
 			},
 		},
+		{
+			Path:    getProofDataTrieKeysPath,
+			Method:  http.MethodPost,
+			Handler: pg.getProofDataTrieKeys,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getProofDataTrieKeysEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getProofDataTrieRangePath,
+			Method:  http.MethodGet,
+			Handler: pg.getProofDataTrieRange,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getProofDataTrieRangeEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    verifyProofDataTrieKeysPath,
+			Method:  http.MethodPost,
+			Handler: pg.verifyProofDataTrieKeys,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(verifyProofDataTrieKeysEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    verifyProofDataTrieRangePath,
+			Method:  http.MethodPost,
+			Handler: pg.verifyProofDataTrieRange,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(verifyProofDataTrieRangeEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	pg.endpoints = endpoints
 
@@ -111,6 +171,28 @@ type VerifyProofRequest struct {
 	Proof    []string `json:"proof"`
 }
 
+// GetProofDataTrieKeysRequest represents the parameters needed to compute a single Merkle proof for several keys of a dataTrie
+type GetProofDataTrieKeysRequest struct {
+	Keys []string `json:"keys"`
+}
+
+// VerifyProofDataTrieKeysRequest represents the parameters needed to verify a multi-key Merkle proof of a dataTrie
+type VerifyProofDataTrieKeysRequest struct {
+	RootHash string   `json:"roothash"`
+	Address  string   `json:"address"`
+	Keys     []string `json:"keys"`
+	Proof    []string `json:"proof"`
+}
+
+// VerifyProofDataTrieRangeRequest represents the parameters needed to verify a range Merkle proof of a dataTrie
+type VerifyProofDataTrieRangeRequest struct {
+	RootHash string   `json:"roothash"`
+	Address  string   `json:"address"`
+	StartKey string   `json:"startKey"`
+	EndKey   string   `json:"endKey"`
+	Proof    []string `json:"proof"`
+}
+
 // getProof will receive a rootHash and an address from the client, and it will return the Merkle proof
 func (pg *proofGroup) getProof(c *gin.Context) {
 	rootHash := c.Param("roothash")
@@ -311,22 +393,17 @@ func (pg *proofGroup) verifyProof(c *gin.Context) {
 		return
 	}
 
-	proof := make([][]byte, 0)
-	for _, hexProof := range verifyProofParams.Proof {
-		bytesProof, err := hex.DecodeString(hexProof)
-		if err != nil {
-			c.JSON(
-				http.StatusBadRequest,
-				shared.GenericAPIResponse{
-					Data:  nil,
-					Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
-					Code:  shared.ReturnCodeRequestError,
-				},
-			)
-			return
-		}
-
-		proof = append(proof, bytesProof)
+	proof, err := hexToBytes(verifyProofParams.Proof)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
 	}
 
 	var proofOk bool
@@ -353,6 +430,307 @@ func (pg *proofGroup) verifyProof(c *gin.Context) {
 	)
 }
 
+// getProofDataTrieKeys will receive a rootHash and an address from the client, together with a list of keys in the
+// request body, and it will return the Merkle proof for the address and a single Merkle proof for all the keys
+func (pg *proofGroup) getProofDataTrieKeys(c *gin.Context) {
+	rootHash, address, ok := getRootHashAndAddressParams(c)
+	if !ok {
+		return
+	}
+
+	var request = &GetProofDataTrieKeysRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+	if len(request.Keys) == 0 {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyKeys.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	mainTrieResponse, dataTrieResponse, err := pg.getFacade().GetProofDataTrieMultiKey(rootHash, address, request.Keys)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	proofs := make(map[string]interface{})
+	proofs["mainProof"] = bytesToHex(mainTrieResponse.Proof)
+	proofs["dataTrieProof"] = bytesToHex(dataTrieResponse.Proof)
+
+	values := make([]gin.H, 0, len(request.Keys))
+	for i, key := range request.Keys {
+		values = append(values, gin.H{
+			"key":   key,
+			"value": hex.EncodeToString(dataTrieResponse.Values[i]),
+		})
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data: gin.H{
+				"proofs":           proofs,
+				"values":           values,
+				"dataTrieRootHash": dataTrieResponse.RootHash,
+			},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getProofDataTrieRange will receive a rootHash, an address and an optional range of keys from the client, and it will
+// return the Merkle proof for the address and a Merkle proof for all the keys of its dataTrie placed in the range.
+// The range follows the trie order, in which the nibbles of the keys are reversed, and not the byte order of the keys
+func (pg *proofGroup) getProofDataTrieRange(c *gin.Context) {
+	rootHash, address, ok := getRootHashAndAddressParams(c)
+	if !ok {
+		return
+	}
+
+	maxNumKeys, err := parseUint64QueryParam(c, queryParamMaxNumKeys)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	startKey := c.Query(queryParamStartKey)
+	endKey := c.Query(queryParamEndKey)
+	mainTrieResponse, dataTrieResponse, err := pg.getFacade().GetProofDataTrieRange(rootHash, address, startKey, endKey, int(maxNumKeys))
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	proofs := make(map[string]interface{})
+	proofs["mainProof"] = bytesToHex(mainTrieResponse.Proof)
+	proofs["dataTrieProof"] = bytesToHex(dataTrieResponse.Proof)
+
+	values := make([]gin.H, 0, len(dataTrieResponse.Keys))
+	for i, key := range dataTrieResponse.Keys {
+		values = append(values, gin.H{
+			"key":   hex.EncodeToString(key),
+			"value": hex.EncodeToString(dataTrieResponse.Values[i]),
+		})
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data: gin.H{
+				"proofs":           proofs,
+				"values":           values,
+				"startKey":         hex.EncodeToString(dataTrieResponse.StartKey),
+				"endKey":           hex.EncodeToString(dataTrieResponse.EndKey),
+				"isTruncated":      dataTrieResponse.IsTruncated,
+				"dataTrieRootHash": dataTrieResponse.RootHash,
+			},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// verifyProofDataTrieKeys will receive the root hash of a dataTrie, the address it belongs to, a list of keys and a
+// multi-key Merkle proof from the client, and it will return the values proven for the keys
+func (pg *proofGroup) verifyProofDataTrieKeys(c *gin.Context) {
+	var request = &VerifyProofDataTrieKeysRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	proof, err := hexToBytes(request.Proof)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	provenValues, err := pg.getFacade().VerifyProofDataTrieMultiKey(request.RootHash, request.Address, request.Keys, proof)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrVerifyProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"values": keyValuesToHex(provenValues)},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// verifyProofDataTrieRange will receive the root hash of a dataTrie, the address it belongs to, a range of keys and a
+// range Merkle proof from the client, and it will return all the key-value pairs proven to be in the range. As for
+// getProofDataTrieRange, the range follows the trie order and not the byte order of the keys
+func (pg *proofGroup) verifyProofDataTrieRange(c *gin.Context) {
+	var request = &VerifyProofDataTrieRangeRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	proof, err := hexToBytes(request.Proof)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	provenValues, err := pg.getFacade().VerifyProofDataTrieRange(request.RootHash, request.Address, request.StartKey, request.EndKey, proof)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrVerifyProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"values": keyValuesToHex(provenValues)},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func getRootHashAndAddressParams(c *gin.Context) (string, string, bool) {
+	rootHash := c.Param("roothash")
+	if rootHash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyRootHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return "", "", false
+	}
+
+	address := c.Param("address")
+	if address == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return "", "", false
+	}
+
+	return rootHash, address, true
+}
+
+func hexToBytes(hexValues []string) ([][]byte, error) {
+	bytesValues := make([][]byte, 0, len(hexValues))
+	for _, hexValue := range hexValues {
+		bytesValue, err := hex.DecodeString(hexValue)
+		if err != nil {
+			return nil, err
+		}
+
+		bytesValues = append(bytesValues, bytesValue)
+	}
+
+	return bytesValues, nil
+}
+
+func keyValuesToHex(keyValues []core.KeyValueHolder) []gin.H {
+	hexKeyValues := make([]gin.H, 0, len(keyValues))
+	for _, keyValue := range keyValues {
+		hexKeyValues = append(hexKeyValues, gin.H{
+			"key":   hex.EncodeToString(keyValue.Key()),
+			"value": hex.EncodeToString(keyValue.Value()),
+		})
+	}
+
+	return hexKeyValues
+}
+
 func (pg *proofGroup) getFacade() proofFacadeHandler {
 	pg.mutFacade.RLock()
 	defer pg.mutFacade.RUnlock()
@@ -11,6 +11,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/keyValStorage"
 	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
@@ -379,6 +381,358 @@ func TestVerifyProof(t *testing.T) {
 	assert.True(t, isValid)
 }
 
+func TestGetProofDataTrieKeys_EmptyKeysShouldErr(t *testing.T) {
+	t.Parallel()
+
+	proofGroup, err := groups.NewProofGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	requestBytes, _ := json.Marshal(groups.GetProofDataTrieKeysRequest{})
+	req, _ := http.NewRequest("POST", "/proof/root-hash/roothash/address/addr/keys", bytes.NewBuffer(requestBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationEmptyKeys.Error()))
+}
+
+func TestGetProofDataTrieKeys_GetProofError(t *testing.T) {
+	t.Parallel()
+
+	getProofErr := fmt.Errorf("GetProofDataTrieMultiKey error")
+	facade := &mock.FacadeStub{
+		GetProofDataTrieMultiKeyCalled: func(_ string, _ string, _ []string) (*common.GetProofResponse, *common.GetMultiKeyProofResponse, error) {
+			return nil, nil, getProofErr
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	requestBytes, _ := json.Marshal(groups.GetProofDataTrieKeysRequest{Keys: []string{"aa"}})
+	req, _ := http.NewRequest("POST", "/proof/root-hash/roothash/address/addr/keys", bytes.NewBuffer(requestBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetProof.Error()))
+	assert.True(t, strings.Contains(response.Error, getProofErr.Error()))
+}
+
+func TestGetProofDataTrieKeys(t *testing.T) {
+	t.Parallel()
+
+	keys := []string{"aa", "bb"}
+	facade := &mock.FacadeStub{
+		GetProofDataTrieMultiKeyCalled: func(rootHash string, address string, providedKeys []string) (*common.GetProofResponse, *common.GetMultiKeyProofResponse, error) {
+			assert.Equal(t, "roothash", rootHash)
+			assert.Equal(t, "addr", address)
+			assert.Equal(t, keys, providedKeys)
+			return &common.GetProofResponse{Proof: [][]byte{[]byte("main")}},
+				&common.GetMultiKeyProofResponse{
+					Proof:    [][]byte{[]byte("data")},
+					Values:   [][]byte{[]byte("value"), nil},
+					RootHash: "dataTrieRootHash",
+				},
+				nil
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	requestBytes, _ := json.Marshal(groups.GetProofDataTrieKeysRequest{Keys: keys})
+	req, _ := http.NewRequest("POST", "/proof/root-hash/roothash/address/addr/keys", bytes.NewBuffer(requestBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	require.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+	responseMap, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "dataTrieRootHash", responseMap["dataTrieRootHash"])
+
+	proofsResponseMap, ok := responseMap["proofs"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, []interface{}{hex.EncodeToString([]byte("main"))}, proofsResponseMap["mainProof"])
+	assert.Equal(t, []interface{}{hex.EncodeToString([]byte("data"))}, proofsResponseMap["dataTrieProof"])
+
+	expectedValues := []interface{}{
+		map[string]interface{}{"key": "aa", "value": hex.EncodeToString([]byte("value"))},
+		map[string]interface{}{"key": "bb", "value": ""},
+	}
+	assert.Equal(t, expectedValues, responseMap["values"])
+}
+
+func TestGetProofDataTrieRange_InvalidMaxNumKeysShouldErr(t *testing.T) {
+	t.Parallel()
+
+	proofGroup, err := groups.NewProofGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/proof/root-hash/roothash/address/addr/range?maxNumKeys=invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+}
+
+func TestGetProofDataTrieRange_GetProofError(t *testing.T) {
+	t.Parallel()
+
+	getProofErr := fmt.Errorf("GetProofDataTrieRange error")
+	facade := &mock.FacadeStub{
+		GetProofDataTrieRangeCalled: func(_ string, _ string, _ string, _ string, _ int) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+			return nil, nil, getProofErr
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/proof/root-hash/roothash/address/addr/range", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, getProofErr.Error()))
+}
+
+func TestGetProofDataTrieRange(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetProofDataTrieRangeCalled: func(rootHash string, address string, startKey string, endKey string, maxNumKeys int) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+			assert.Equal(t, "roothash", rootHash)
+			assert.Equal(t, "addr", address)
+			assert.Equal(t, "aa", startKey)
+			assert.Equal(t, "ff", endKey)
+			assert.Equal(t, 10, maxNumKeys)
+			return &common.GetProofResponse{Proof: [][]byte{[]byte("main")}},
+				&common.GetRangeProofResponse{
+					Proof:       [][]byte{[]byte("data")},
+					Keys:        [][]byte{{0xaa}, {0xbb}},
+					Values:      [][]byte{[]byte("value1"), []byte("value2")},
+					StartKey:    []byte{0xaa},
+					EndKey:      []byte{0xbb},
+					IsTruncated: true,
+					RootHash:    "dataTrieRootHash",
+				},
+				nil
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/proof/root-hash/roothash/address/addr/range?startKey=aa&endKey=ff&maxNumKeys=10", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	require.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+	responseMap, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "aa", responseMap["startKey"])
+	assert.Equal(t, "bb", responseMap["endKey"])
+	assert.Equal(t, true, responseMap["isTruncated"])
+	assert.Equal(t, "dataTrieRootHash", responseMap["dataTrieRootHash"])
+
+	expectedValues := []interface{}{
+		map[string]interface{}{"key": "aa", "value": hex.EncodeToString([]byte("value1"))},
+		map[string]interface{}{"key": "bb", "value": hex.EncodeToString([]byte("value2"))},
+	}
+	assert.Equal(t, expectedValues, responseMap["values"])
+}
+
+func TestVerifyProofDataTrieKeys_CanNotDecodeProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	proofGroup, err := groups.NewProofGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	requestBytes, _ := json.Marshal(groups.VerifyProofDataTrieKeysRequest{Proof: []string{"invalid hex"}})
+	req, _ := http.NewRequest("POST", "/proof/verify-keys", bytes.NewBuffer(requestBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+}
+
+func TestVerifyProofDataTrieKeys_VerifyProofErr(t *testing.T) {
+	t.Parallel()
+
+	verifyProofErr := fmt.Errorf("VerifyProofDataTrieMultiKey err")
+	facade := &mock.FacadeStub{
+		VerifyProofDataTrieMultiKeyCalled: func(_ string, _ string, _ []string, _ [][]byte) ([]core.KeyValueHolder, error) {
+			return nil, verifyProofErr
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	requestBytes, _ := json.Marshal(groups.VerifyProofDataTrieKeysRequest{Keys: []string{"aa"}})
+	req, _ := http.NewRequest("POST", "/proof/verify-keys", bytes.NewBuffer(requestBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrVerifyProof.Error()))
+}
+
+func TestVerifyProofDataTrieKeys(t *testing.T) {
+	t.Parallel()
+
+	request := groups.VerifyProofDataTrieKeysRequest{
+		RootHash: "rootHash",
+		Address:  "address",
+		Keys:     []string{"aa", "bb"},
+		Proof:    []string{hex.EncodeToString([]byte("proof"))},
+	}
+	facade := &mock.FacadeStub{
+		VerifyProofDataTrieMultiKeyCalled: func(rootHash string, address string, keys []string, proof [][]byte) ([]core.KeyValueHolder, error) {
+			assert.Equal(t, request.RootHash, rootHash)
+			assert.Equal(t, request.Address, address)
+			assert.Equal(t, request.Keys, keys)
+			assert.Equal(t, [][]byte{[]byte("proof")}, proof)
+
+			return []core.KeyValueHolder{
+				keyValStorage.NewKeyValStorage([]byte{0xaa}, []byte("value")),
+				keyValStorage.NewKeyValStorage([]byte{0xbb}, nil),
+			}, nil
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	requestBytes, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/proof/verify-keys", bytes.NewBuffer(requestBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	require.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+	responseMap, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	expectedValues := []interface{}{
+		map[string]interface{}{"key": "aa", "value": hex.EncodeToString([]byte("value"))},
+		map[string]interface{}{"key": "bb", "value": ""},
+	}
+	assert.Equal(t, expectedValues, responseMap["values"])
+}
+
+func TestVerifyProofDataTrieRange_VerifyProofErr(t *testing.T) {
+	t.Parallel()
+
+	verifyProofErr := fmt.Errorf("VerifyProofDataTrieRange err")
+	facade := &mock.FacadeStub{
+		VerifyProofDataTrieRangeCalled: func(_ string, _ string, _ string, _ string, _ [][]byte) ([]core.KeyValueHolder, error) {
+			return nil, verifyProofErr
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	requestBytes, _ := json.Marshal(groups.VerifyProofDataTrieRangeRequest{})
+	req, _ := http.NewRequest("POST", "/proof/verify-range", bytes.NewBuffer(requestBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, verifyProofErr.Error()))
+}
+
+func TestVerifyProofDataTrieRange(t *testing.T) {
+	t.Parallel()
+
+	request := groups.VerifyProofDataTrieRangeRequest{
+		RootHash: "rootHash",
+		Address:  "address",
+		StartKey: "aa",
+		EndKey:   "ff",
+		Proof:    []string{hex.EncodeToString([]byte("proof"))},
+	}
+	facade := &mock.FacadeStub{
+		VerifyProofDataTrieRangeCalled: func(rootHash string, address string, startKey string, endKey string, proof [][]byte) ([]core.KeyValueHolder, error) {
+			assert.Equal(t, request.RootHash, rootHash)
+			assert.Equal(t, request.Address, address)
+			assert.Equal(t, request.StartKey, startKey)
+			assert.Equal(t, request.EndKey, endKey)
+			assert.Equal(t, [][]byte{[]byte("proof")}, proof)
+
+			return []core.KeyValueHolder{
+				keyValStorage.NewKeyValStorage([]byte{0xaa}, []byte("value")),
+			}, nil
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	requestBytes, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/proof/verify-range", bytes.NewBuffer(requestBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	require.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+	responseMap, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	expectedValues := []interface{}{
+		map[string]interface{}{"key": "aa", "value": hex.EncodeToString([]byte("value"))},
+	}
+	assert.Equal(t, expectedValues, responseMap["values"])
+}
+
 func getProofRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -387,7 +741,11 @@ func getProofRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/root-hash/:roothash/address/:address", Open: true},
 					{Name: "/root-hash/:roothash/address/:address/key/:key", Open: true},
 					{Name: "/address/:address", Open: true},
+					{Name: "/root-hash/:roothash/address/:address/keys", Open: true},
+					{Name: "/root-hash/:roothash/address/:address/range", Open: true},
 					{Name: "/verify", Open: true},
+					{Name: "/verify-keys", Open: true},
+					{Name: "/verify-range", Open: true},
 				},
 			},
 		},
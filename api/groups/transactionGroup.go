@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/gin-gonic/gin"
 )
@@ -21,16 +22,32 @@ import (
 const (
 	sendTransactionEndpoint          = "/transaction/send"
 	simulateTransactionEndpoint      = "/transaction/simulate"
+	simulateBundleEndpoint           = "/transaction/simulate-bundle"
 	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
 	getTransactionEndpoint           = "/transaction/:hash"
+	traceTransactionEndpoint         = "/transaction/:txhash/trace"
 	sendTransactionPath              = "/send"
 	simulateTransactionPath          = "/simulate"
+	simulateBundlePath               = "/simulate-bundle"
 	costPath                         = "/cost"
 	sendMultiplePath                 = "/send-multiple"
 	getTransactionPath               = "/:txhash"
+	traceTransactionPath             = "/:txhash/trace"
+	getTransactionsPoolPath          = "/pool"
+	getTransactionsPoolForSenderPath = "/pool/by-sender/:address"
+	getTransactionsPoolStatsPath     = "/pool/stats"
+	getEvictedTransactionPath        = "/pool/evicted/:txhash"
 
 	queryParamWithResults    = "withResults"
 	queryParamCheckSignature = "checkSignature"
+	queryParamStateChanges   = "withStateChanges"
+	queryParamWithTrace      = "withTrace"
+	queryParamSender         = "sender"
+	queryParamSourceShard    = "sourceShard"
+	queryParamDestShard      = "destinationShard"
+	queryParamMinGasPrice    = "minGasPrice"
+
+	defaultTxPoolPageSize = 20
 )
 
 // transactionFacadeHandler defines the methods to be implemented by a facade for transaction requests
@@ -41,8 +58,16 @@ type transactionFacadeHandler interface {
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	SimulateTransactionWithOptions(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	SimulateTransactionBundleExecution(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	GetMaxTxBundleSize() uint32
+	TraceTransaction(hash string) (*txSimData.SimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	GetTransactionsPool(options common.TxPoolQueryOptions) (*common.TxPoolTransactionsResponse, error)
+	GetTransactionsPoolForSender(address string) (*common.TxPoolSenderResponse, error)
+	GetTransactionsPoolStatistics() (*common.TxPoolStatisticsResponse, error)
+	GetEvictedTransactionFromPool(txHash string) (*common.TxPoolEvictedTransaction, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
@@ -88,6 +113,17 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    simulateBundlePath,
+			Method:  http.MethodPost,
+			Handler: tg.simulateTransactionBundle,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(simulateBundleEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 		{
 			Path:    costPath,
 			Method:  http.MethodPost,
@@ -115,6 +151,37 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    traceTransactionPath,
+			Method:  http.MethodGet,
+			Handler: tg.traceTransaction,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(traceTransactionEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getTransactionsPoolPath,
+			Method:  http.MethodGet,
+			Handler: tg.getTransactionsPool,
+		},
+		{
+			Path:    getTransactionsPoolForSenderPath,
+			Method:  http.MethodGet,
+			Handler: tg.getTransactionsPoolForSender,
+		},
+		{
+			Path:    getTransactionsPoolStatsPath,
+			Method:  http.MethodGet,
+			Handler: tg.getTransactionsPoolStatistics,
+		},
+		{
+			Path:    getEvictedTransactionPath,
+			Method:  http.MethodGet,
+			Handler: tg.getEvictedTransactionFromPool,
+		},
 	}
 	tg.endpoints = endpoints
 
@@ -192,6 +259,32 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
+	withStateChanges, err := getQueryParamWithStateChanges(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	withTrace, err := getQueryParamWithTrace(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	tx, txHash, err := tg.getFacade().CreateTransaction(
 		gtx.Nonce,
 		gtx.Value,
@@ -232,7 +325,16 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
-	executionResults, err := tg.getFacade().SimulateTransactionExecution(tx)
+	var executionResults *txSimData.SimulationResults
+	if withStateChanges || withTrace {
+		options := txSimData.SimulationOptions{
+			WithStateChanges: withStateChanges,
+			WithTrace:        withTrace,
+		}
+		executionResults, err = tg.getFacade().SimulateTransactionWithOptions(tx, options)
+	} else {
+		executionResults, err = tg.getFacade().SimulateTransactionExecution(tx)
+	}
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -256,6 +358,114 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 	)
 }
 
+// simulateTransactionBundle will receive an ordered list of transactions from the client and will simulate their
+// execution over the same state, returning the results of each transaction and the cumulative state changes
+func (tg *transactionGroup) simulateTransactionBundle(c *gin.Context) {
+	var gtx []SendTxRequest
+	err := c.ShouldBindJSON(&gtx)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	checkSignature, err := getQueryParameterCheckSignature(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	maxTxBundleSize := tg.getFacade().GetMaxTxBundleSize()
+	if len(gtx) > int(maxTxBundleSize) {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s, provided: %d, maximum: %d", errors.ErrValidation.Error(), errors.ErrTxBundleTooLarge.Error(), len(gtx), maxTxBundleSize),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	txs := make([]*transaction.Transaction, 0, len(gtx))
+	txsHashes := make([]string, 0, len(gtx))
+	for idx, receivedTx := range gtx {
+		tx, txHash, errCreate := tg.getFacade().CreateTransaction(
+			receivedTx.Nonce,
+			receivedTx.Value,
+			receivedTx.Receiver,
+			receivedTx.ReceiverUsername,
+			receivedTx.Sender,
+			receivedTx.SenderUsername,
+			receivedTx.GasPrice,
+			receivedTx.GasLimit,
+			receivedTx.Data,
+			receivedTx.Signature,
+			receivedTx.ChainID,
+			receivedTx.Version,
+			receivedTx.Options,
+		)
+		if errCreate == nil {
+			errCreate = tg.getFacade().ValidateTransactionForSimulation(tx, checkSignature)
+		}
+		if errCreate != nil {
+			c.JSON(
+				http.StatusBadRequest,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s for transaction with index %d: %s", errors.ErrTxGenerationFailed.Error(), idx, errCreate.Error()),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		txs = append(txs, tx)
+		txsHashes = append(txsHashes, hex.EncodeToString(txHash))
+	}
+
+	bundleResults, err := tg.getFacade().SimulateTransactionBundleExecution(txs)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	for idx, txResults := range bundleResults.Results {
+		if idx < len(txsHashes) {
+			txResults.Hash = txsHashes[idx]
+		}
+	}
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"result": bundleResults},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // sendTransaction will receive a transaction from the client and propagate it for processing
 func (tg *transactionGroup) sendTransaction(c *gin.Context) {
 	var gtx = SendTxRequest{}
@@ -465,6 +675,45 @@ func (tg *transactionGroup) getTransaction(c *gin.Context) {
 	)
 }
 
+// traceTransaction re-executes an already executed transaction on top of the state its block was executed on and returns
+// the results along with the call tree of the smart contract executions
+func (tg *transactionGroup) traceTransaction(c *gin.Context) {
+	txhash := c.Param("txhash")
+	if txhash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	traceResults, err := tg.getFacade().TraceTransaction(txhash)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrTraceTransaction.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"result": traceResults},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // computeTransactionGasLimit returns how many gas units a transaction wil consume
 func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
 	var gtx SendTxRequest
@@ -531,6 +780,173 @@ func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
 	)
 }
 
+// getTransactionsPool returns a page of the transactions from the pool, optionally filtered by sender, shards and
+// minimum gas price
+func (tg *transactionGroup) getTransactionsPool(c *gin.Context) {
+	options, err := parseTxPoolQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPool.Error(), err.Error()))
+		return
+	}
+
+	response, err := tg.getFacade().GetTransactionsPool(options)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPool.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"transactions": response.Transactions, "total": response.Total},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getTransactionsPoolForSender returns the nonce-ordered transactions of a sender from the pool, along with the
+// nonce gaps that prevent them from being selected
+func (tg *transactionGroup) getTransactionsPoolForSender(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPool.Error(), errors.ErrEmptyAddress.Error()))
+		return
+	}
+
+	response, err := tg.getFacade().GetTransactionsPoolForSender(addr)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPool.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"sender": response},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getTransactionsPoolStatistics returns aggregate information about the transactions pool
+func (tg *transactionGroup) getTransactionsPoolStatistics(c *gin.Context) {
+	response, err := tg.getFacade().GetTransactionsPoolStatistics()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPool.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"statistics": response},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getEvictedTransactionFromPool returns the details about a transaction recently evicted from the pool, if any
+func (tg *transactionGroup) getEvictedTransactionFromPool(c *gin.Context) {
+	txhash := c.Param("txhash")
+	if txhash == "" {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPool.Error(), errors.ErrValidationEmptyTxHash.Error()))
+		return
+	}
+
+	response, err := tg.getFacade().GetEvictedTransactionFromPool(txhash)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPool.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"evicted": response},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func parseTxPoolQueryOptions(c *gin.Context) (common.TxPoolQueryOptions, error) {
+	options := common.TxPoolQueryOptions{
+		Sender: c.Request.URL.Query().Get(queryParamSender),
+	}
+
+	var err error
+	options.SourceShard, options.HasSourceShard, err = parseOptionalUint32QueryParam(c, queryParamSourceShard)
+	if err != nil {
+		return common.TxPoolQueryOptions{}, err
+	}
+	options.DestinationShard, options.HasDestinationShard, err = parseOptionalUint32QueryParam(c, queryParamDestShard)
+	if err != nil {
+		return common.TxPoolQueryOptions{}, err
+	}
+	options.MinGasPrice, err = parseUint64QueryParam(c, queryParamMinGasPrice)
+	if err != nil {
+		return common.TxPoolQueryOptions{}, err
+	}
+	options.From, err = parseUint64QueryParam(c, queryParamFrom)
+	if err != nil {
+		return common.TxPoolQueryOptions{}, err
+	}
+	options.Size, err = parseUint64QueryParam(c, queryParamSize)
+	if err != nil {
+		return common.TxPoolQueryOptions{}, err
+	}
+	if options.Size == 0 {
+		options.Size = defaultTxPoolPageSize
+	}
+
+	return options, nil
+}
+
+func parseOptionalUint32QueryParam(c *gin.Context, name string) (uint32, bool, error) {
+	valueStr := c.Request.URL.Query().Get(name)
+	if valueStr == "" {
+		return 0, false, nil
+	}
+
+	value, err := strconv.ParseUint(valueStr, 10, 32)
+	if err != nil {
+		return 0, false, fmt.Errorf("%w %s", errors.ErrInvalidQueryParameter, name)
+	}
+
+	return uint32(value), true, nil
+}
+
 func getQueryParamWithResults(c *gin.Context) (bool, error) {
 	withResultsStr := c.Request.URL.Query().Get(queryParamWithResults)
 	if withResultsStr == "" {
@@ -540,6 +956,24 @@ func getQueryParamWithResults(c *gin.Context) (bool, error) {
 	return strconv.ParseBool(withResultsStr)
 }
 
+func getQueryParamWithStateChanges(c *gin.Context) (bool, error) {
+	withStateChangesStr := c.Request.URL.Query().Get(queryParamStateChanges)
+	if withStateChangesStr == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(withStateChangesStr)
+}
+
+func getQueryParamWithTrace(c *gin.Context) (bool, error) {
+	withTraceStr := c.Request.URL.Query().Get(queryParamWithTrace)
+	if withTraceStr == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(withTraceStr)
+}
+
 func getQueryParameterCheckSignature(c *gin.Context) (bool, error) {
 	bypassSignatureStr := c.Request.URL.Query().Get(queryParamCheckSignature)
 	if bypassSignatureStr == "" {
@@ -18,7 +18,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -67,6 +69,67 @@ type simulateTxResponse struct {
 	Code  string      `json:"code"`
 }
 
+type simulateTxBundleResponseData struct {
+	Result txSimData.BundleSimulationResults `json:"result"`
+}
+
+type simulateTxBundleResponse struct {
+	Data  simulateTxBundleResponseData `json:"data"`
+	Error string                       `json:"error"`
+	Code  string                       `json:"code"`
+}
+
+type traceTxResponseData struct {
+	Result txSimData.SimulationResults `json:"result"`
+}
+
+type traceTxResponse struct {
+	Data  traceTxResponseData `json:"data"`
+	Error string              `json:"error"`
+	Code  string              `json:"code"`
+}
+
+type txPoolResponseData struct {
+	Transactions []*common.TxPoolTransaction `json:"transactions"`
+	Total        uint64                      `json:"total"`
+}
+
+type txPoolResponse struct {
+	Data  txPoolResponseData `json:"data"`
+	Error string             `json:"error"`
+	Code  string             `json:"code"`
+}
+
+type txPoolForSenderResponseData struct {
+	Sender common.TxPoolSenderResponse `json:"sender"`
+}
+
+type txPoolForSenderResponse struct {
+	Data  txPoolForSenderResponseData `json:"data"`
+	Error string                      `json:"error"`
+	Code  string                      `json:"code"`
+}
+
+type txPoolStatisticsResponseData struct {
+	Statistics common.TxPoolStatisticsResponse `json:"statistics"`
+}
+
+type txPoolStatisticsResponse struct {
+	Data  txPoolStatisticsResponseData `json:"data"`
+	Error string                       `json:"error"`
+	Code  string                       `json:"code"`
+}
+
+type txPoolEvictedTransactionResponseData struct {
+	Evicted common.TxPoolEvictedTransaction `json:"evicted"`
+}
+
+type txPoolEvictedTransactionResponse struct {
+	Data  txPoolEvictedTransactionResponseData `json:"data"`
+	Error string                               `json:"error"`
+	Code  string                               `json:"code"`
+}
+
 type sendSingleTxResponseData struct {
 	TxHash string `json:"txHash"`
 }
@@ -823,6 +886,688 @@ func TestSimulateTransaction(t *testing.T) {
 	assert.Equal(t, string(shared.ReturnCodeSuccess), simulateResponse.Code)
 }
 
+func TestSimulateTransaction_WithStateChangesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+		SimulateTxWithOptionsCalled: func(tx *dataTx.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+			require.True(t, options.WithStateChanges)
+			require.False(t, options.WithTrace)
+			return &txSimData.SimulationResults{
+				Status:       dataTx.TxStatusSuccess,
+				StateChanges: []*txSimData.AccountStateDiff{{Address: "addr", BalanceDelta: "-10"}},
+			}, nil
+		},
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{}, []byte("hash"), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			return nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	jsonBytes, _ := json.Marshal(groups.SendTxRequest{Sender: "sender1", Receiver: "receiver1", Value: "100"})
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate?withStateChanges=true", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := struct {
+		Data struct {
+			Result txSimData.SimulationResults `json:"result"`
+		} `json:"data"`
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.Len(t, simulateResponse.Data.Result.StateChanges, 1)
+	assert.Equal(t, "-10", simulateResponse.Data.Result.StateChanges[0].BalanceDelta)
+	assert.Equal(t, hex.EncodeToString([]byte("hash")), simulateResponse.Data.Result.Hash)
+}
+
+func TestSimulateTransaction_InvalidWithStateChangesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	transactionGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	jsonBytes, _ := json.Marshal(groups.SendTxRequest{Sender: "sender1", Receiver: "receiver1", Value: "100"})
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate?withStateChanges=tttt", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, apiErrors.ErrValidation.Error(), simulateResponse.Error)
+}
+
+func TestSimulateTransaction_WithTraceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		SimulateTxWithOptionsCalled: func(tx *dataTx.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+			require.False(t, options.WithStateChanges)
+			require.True(t, options.WithTrace)
+			return &txSimData.SimulationResults{
+				Status: dataTx.TxStatusSuccess,
+				Trace:  []*tracing.CallFrame{{Type: tracing.FrameTypeCall, Function: "add", GasUsed: 100}},
+			}, nil
+		},
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{}, []byte("hash"), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			return nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	jsonBytes, _ := json.Marshal(groups.SendTxRequest{Sender: "sender1", Receiver: "receiver1", Value: "100"})
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate?withTrace=true", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := traceTxResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.Len(t, simulateResponse.Data.Result.Trace, 1)
+	assert.Equal(t, "add", simulateResponse.Data.Result.Trace[0].Function)
+	assert.Equal(t, uint64(100), simulateResponse.Data.Result.Trace[0].GasUsed)
+}
+
+func TestSimulateTransaction_InvalidWithTraceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	transactionGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	jsonBytes, _ := json.Marshal(groups.SendTxRequest{Sender: "sender1", Receiver: "receiver1", Value: "100"})
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate?withTrace=tttt", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, apiErrors.ErrValidation.Error(), simulateResponse.Error)
+}
+
+func TestTraceTransaction_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		TraceTransactionCalled: func(hash string) (*txSimData.SimulationResults, error) {
+			return nil, expectedErr
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/transaction/aabb/trace", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	traceResponse := traceTxResponse{}
+	loadResponse(resp.Body, &traceResponse)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(traceResponse.Error, apiErrors.ErrTraceTransaction.Error()))
+	assert.True(t, strings.Contains(traceResponse.Error, expectedErr.Error()))
+}
+
+func TestTraceTransaction_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		TraceTransactionCalled: func(hash string) (*txSimData.SimulationResults, error) {
+			require.Equal(t, "aabb", hash)
+			return &txSimData.SimulationResults{
+				Status: dataTx.TxStatusSuccess,
+				Hash:   hash,
+				Trace:  []*tracing.CallFrame{{Type: tracing.FrameTypeCall, Function: "add"}},
+			}, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/transaction/aabb/trace", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	traceResponse := traceTxResponse{}
+	loadResponse(resp.Body, &traceResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "aabb", traceResponse.Data.Result.Hash)
+	require.Len(t, traceResponse.Data.Result.Trace, 1)
+	assert.Equal(t, "add", traceResponse.Data.Result.Trace[0].Function)
+}
+
+func TestGetTransactionsPool_InvalidQueryParametersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	transactionGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	for _, query := range []string{"size=x", "from=-1", "minGasPrice=abc", "sourceShard=x", "destinationShard=4294967296"} {
+		req, _ := http.NewRequest("GET", "/transaction/pool?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txPoolResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionsPool.Error()), query)
+	}
+}
+
+func TestGetTransactionsPool_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetTransactionsPoolCalled: func(options common.TxPoolQueryOptions) (*common.TxPoolTransactionsResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/transaction/pool", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := txPoolResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionsPool.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetTransactionsPool_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default options", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetTransactionsPoolCalled: func(options common.TxPoolQueryOptions) (*common.TxPoolTransactionsResponse, error) {
+				require.Equal(t, common.TxPoolQueryOptions{Size: 20}, options)
+				return &common.TxPoolTransactionsResponse{
+					Transactions: []*common.TxPoolTransaction{{TxHash: "aa", Nonce: 3}},
+					Total:        1,
+				}, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/pool", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txPoolResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, uint64(1), response.Data.Total)
+		require.Len(t, response.Data.Transactions, 1)
+		assert.Equal(t, "aa", response.Data.Transactions[0].TxHash)
+		assert.Equal(t, uint64(3), response.Data.Transactions[0].Nonce)
+	})
+	t.Run("with filters", func(t *testing.T) {
+		t.Parallel()
+
+		expectedOptions := common.TxPoolQueryOptions{
+			Sender:              "erd1alice",
+			SourceShard:         1,
+			HasSourceShard:      true,
+			DestinationShard:    core.MetachainShardId,
+			HasDestinationShard: true,
+			MinGasPrice:         1000,
+			From:                10,
+			Size:                5,
+		}
+		facade := mock.FacadeStub{
+			GetTransactionsPoolCalled: func(options common.TxPoolQueryOptions) (*common.TxPoolTransactionsResponse, error) {
+				require.Equal(t, expectedOptions, options)
+				return &common.TxPoolTransactionsResponse{}, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		query := fmt.Sprintf("sender=erd1alice&sourceShard=1&destinationShard=%d&minGasPrice=1000&from=10&size=5", core.MetachainShardId)
+		req, _ := http.NewRequest("GET", "/transaction/pool?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+}
+
+func TestGetTransactionsPoolForSender_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetTransactionsPoolForSenderCalled: func(address string) (*common.TxPoolSenderResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/transaction/pool/by-sender/erd1alice", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := txPoolForSenderResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionsPool.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetTransactionsPoolForSender_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetTransactionsPoolForSenderCalled: func(address string) (*common.TxPoolSenderResponse, error) {
+			require.Equal(t, "erd1alice", address)
+			return &common.TxPoolSenderResponse{
+				Sender:       address,
+				AccountNonce: 4,
+				Transactions: []*common.TxPoolSenderTransaction{
+					{TxPoolTransaction: &common.TxPoolTransaction{TxHash: "aa", Nonce: 6}, PrecededByGap: true},
+				},
+				NonceGaps: []*common.TxPoolNonceGap{{From: 4, To: 5}},
+			}, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/transaction/pool/by-sender/erd1alice", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := txPoolForSenderResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "erd1alice", response.Data.Sender.Sender)
+	assert.Equal(t, uint64(4), response.Data.Sender.AccountNonce)
+	require.Len(t, response.Data.Sender.Transactions, 1)
+	assert.Equal(t, "aa", response.Data.Sender.Transactions[0].TxHash)
+	assert.True(t, response.Data.Sender.Transactions[0].PrecededByGap)
+	assert.Equal(t, []*common.TxPoolNonceGap{{From: 4, To: 5}}, response.Data.Sender.NonceGaps)
+}
+
+func TestGetTransactionsPoolStatistics_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetTransactionsPoolStatisticsCalled: func() (*common.TxPoolStatisticsResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/transaction/pool/stats", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := txPoolStatisticsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionsPool.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetTransactionsPoolStatistics_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetTransactionsPoolStatisticsCalled: func() (*common.TxPoolStatisticsResponse, error) {
+			return &common.TxPoolStatisticsResponse{
+				NumTxs:     3,
+				NumSenders: 2,
+				Caches: []*common.TxPoolCacheStatistics{
+					{CacheID: "0", NumTxs: 3, NumSenders: 2, ScoreDistribution: []uint32{1, 1}},
+				},
+			}, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/transaction/pool/stats", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := txPoolStatisticsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint64(3), response.Data.Statistics.NumTxs)
+	assert.Equal(t, uint64(2), response.Data.Statistics.NumSenders)
+	require.Len(t, response.Data.Statistics.Caches, 1)
+	assert.Equal(t, []uint32{1, 1}, response.Data.Statistics.Caches[0].ScoreDistribution)
+}
+
+func TestGetEvictedTransactionFromPool_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetEvictedTransactionFromPoolCalled: func(txHash string) (*common.TxPoolEvictedTransaction, error) {
+			return nil, expectedErr
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/transaction/pool/evicted/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := txPoolEvictedTransactionResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionsPool.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetEvictedTransactionFromPool_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetEvictedTransactionFromPoolCalled: func(txHash string) (*common.TxPoolEvictedTransaction, error) {
+			return &common.TxPoolEvictedTransaction{
+				TxHash:    txHash,
+				Sender:    "alice",
+				CacheID:   "0",
+				Reason:    "swept",
+				Timestamp: 1000,
+			}, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/transaction/pool/evicted/aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := txPoolEvictedTransactionResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "aabb", response.Data.Evicted.TxHash)
+	assert.Equal(t, "alice", response.Data.Evicted.Sender)
+	assert.Equal(t, "swept", response.Data.Evicted.Reason)
+	assert.Equal(t, int64(1000), response.Data.Evicted.Timestamp)
+}
+
+func TestSimulateTransactionBundle_BadRequestShouldErr(t *testing.T) {
+	t.Parallel()
+
+	transactionGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate-bundle", bytes.NewBuffer([]byte("invalid bytes")))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxBundleResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, simulateResponse.Error, apiErrors.ErrValidation.Error())
+}
+
+func getMaxTxBundleSize() uint32 {
+	return 10
+}
+
+func TestSimulateTransactionBundle_TooLargeBundleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetMaxTxBundleSizeCalled: func() uint32 {
+			return 1
+		},
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	jsonBytes, _ := json.Marshal([]groups.SendTxRequest{{Nonce: 0}, {Nonce: 1}})
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate-bundle", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxBundleResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, simulateResponse.Error, apiErrors.ErrTxBundleTooLarge.Error())
+}
+
+func TestSimulateTransactionBundle_ValidateErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetMaxTxBundleSizeCalled: getMaxTxBundleSize,
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{Nonce: nonce}, []byte("hash"), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			if tx.Nonce == 1 {
+				return expectedErr
+			}
+			return nil
+		},
+		SimulateTxBundleExecutionCalled: func(txs []*dataTx.Transaction) (*txSimData.BundleSimulationResults, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	txs := []groups.SendTxRequest{{Nonce: 0}, {Nonce: 1}}
+	jsonBytes, _ := json.Marshal(txs)
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate-bundle", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxBundleResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, simulateResponse.Error, "index 1")
+	assert.Contains(t, simulateResponse.Error, expectedErr.Error())
+}
+
+func TestSimulateTransactionBundle_ProcessErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetMaxTxBundleSizeCalled: getMaxTxBundleSize,
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{}, []byte("hash"), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			return nil
+		},
+		SimulateTxBundleExecutionCalled: func(txs []*dataTx.Transaction) (*txSimData.BundleSimulationResults, error) {
+			return nil, expectedErr
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	jsonBytes, _ := json.Marshal([]groups.SendTxRequest{{Nonce: 0}})
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate-bundle", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxBundleResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, simulateResponse.Error, expectedErr.Error())
+}
+
+func TestSimulateTransactionBundle(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetMaxTxBundleSizeCalled: getMaxTxBundleSize,
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{Nonce: nonce}, []byte(fmt.Sprintf("hash%d", nonce)), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			return nil
+		},
+		SimulateTxBundleExecutionCalled: func(txs []*dataTx.Transaction) (*txSimData.BundleSimulationResults, error) {
+			require.Len(t, txs, 2)
+			require.Equal(t, uint64(3), txs[0].Nonce)
+			require.Equal(t, uint64(4), txs[1].Nonce)
+
+			return &txSimData.BundleSimulationResults{
+				Status: dataTx.TxStatusFail,
+				Results: []*txSimData.BundleTxSimulationResults{
+					{SimulationResults: &txSimData.SimulationResults{Status: dataTx.TxStatusSuccess}},
+					{
+						SimulationResults:          &txSimData.SimulationResults{Status: dataTx.TxStatusFail},
+						FailedBecauseOfPreviousTxs: true,
+					},
+				},
+				StateDiffs: []*txSimData.AccountStateDiff{{Address: "addr", BalanceBefore: "10", BalanceAfter: "5"}},
+			}, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	jsonBytes, _ := json.Marshal([]groups.SendTxRequest{{Nonce: 3}, {Nonce: 4}})
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate-bundle", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxBundleResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, string(shared.ReturnCodeSuccess), simulateResponse.Code)
+
+	result := simulateResponse.Data.Result
+	require.Len(t, result.Results, 2)
+	assert.Equal(t, dataTx.TxStatusFail, result.Status)
+	assert.Equal(t, hex.EncodeToString([]byte("hash3")), result.Results[0].Hash)
+	assert.Equal(t, hex.EncodeToString([]byte("hash4")), result.Results[1].Hash)
+	assert.False(t, result.Results[0].FailedBecauseOfPreviousTxs)
+	assert.True(t, result.Results[1].FailedBecauseOfPreviousTxs)
+	require.Len(t, result.StateDiffs, 1)
+	assert.Equal(t, "5", result.StateDiffs[0].BalanceAfter)
+}
+
 func getTransactionRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -834,6 +1579,12 @@ func getTransactionRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
 					{Name: "/simulate", Open: true},
+					{Name: "/simulate-bundle", Open: true},
+					{Name: "/:txhash/trace", Open: true},
+					{Name: "/pool", Open: true},
+					{Name: "/pool/by-sender/:address", Open: true},
+					{Name: "/pool/stats", Open: true},
+					{Name: "/pool/evicted/:txhash", Open: true},
 				},
 			},
 		},
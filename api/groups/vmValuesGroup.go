@@ -30,6 +30,10 @@ type vmValuesFacadeHandler interface {
 	IsInterfaceNil() bool
 }
 
+type addressDecoder interface {
+	DecodeAddressPubkey(pk string) ([]byte, error)
+}
+
 type vmValuesGroup struct {
 	*baseGroup
 	facade    vmValuesFacadeHandler
@@ -81,6 +85,8 @@ type VMValueRequest struct {
 	CallerAddr string   `form:"caller" json:"caller"`
 	CallValue  string   `form:"value" json:"value"`
 	Args       []string `form:"args"  json:"args"`
+	BlockNonce *uint64  `form:"blockNonce" json:"blockNonce"`
+	BlockHash  string   `form:"blockHash" json:"blockHash"`
 }
 
 // getHex returns the data as bytes, hex-encoded
@@ -151,7 +157,11 @@ func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi,
 }
 
 func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQuery, error) {
-	decodedAddress, err := vvg.getFacade().DecodeAddressPubkey(request.ScAddress)
+	return createSCQueryFromRequest(vvg.getFacade(), request)
+}
+
+func createSCQueryFromRequest(decoder addressDecoder, request *VMValueRequest) (*process.SCQuery, error) {
+	decodedAddress, err := decoder.DecodeAddressPubkey(request.ScAddress)
 	if err != nil {
 		return nil, fmt.Errorf("'%s' is not a valid address: %s", request.ScAddress, err.Error())
 	}
@@ -174,7 +184,7 @@ func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQue
 	}
 
 	if len(request.CallerAddr) > 0 {
-		callerAddress, errDecodeCaller := vvg.getFacade().DecodeAddressPubkey(request.CallerAddr)
+		callerAddress, errDecodeCaller := decoder.DecodeAddressPubkey(request.CallerAddr)
 		if errDecodeCaller != nil {
 			return nil, errDecodeCaller
 		}
@@ -190,6 +200,22 @@ func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQue
 		scQuery.CallValue = callValue
 	}
 
+	if request.BlockNonce != nil && len(request.BlockHash) > 0 {
+		return nil, fmt.Errorf("only one of blockNonce and blockHash can be provided")
+	}
+	if request.BlockNonce != nil {
+		scQuery.BlockNonce = *request.BlockNonce
+		scQuery.HasBlockNonce = true
+	}
+	if len(request.BlockHash) > 0 {
+		blockHash, errDecodeHash := hex.DecodeString(request.BlockHash)
+		if errDecodeHash != nil {
+			return nil, fmt.Errorf("'%s' is not a valid block hash: %s", request.BlockHash, errDecodeHash.Error())
+		}
+
+		scQuery.BlockHash = blockHash
+	}
+
 	return scQuery, nil
 }
 
@@ -163,6 +163,65 @@ func TestQuery_ShouldWork(t *testing.T) {
 	require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.ReturnData[0]).Int64())
 }
 
+func TestQuery_AtBlockShouldPassTheBlockToTheQuery(t *testing.T) {
+	t.Parallel()
+
+	var receivedQuery *process.SCQuery
+	facade := mock.FacadeStub{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+			receivedQuery = query
+
+			return &vm.VMOutputApi{}, nil
+		},
+	}
+
+	blockNonce := uint64(37)
+	request := groups.VMValueRequest{
+		ScAddress:  dummyScAddress,
+		FuncName:   "getUserActiveStake",
+		BlockNonce: &blockNonce,
+	}
+
+	response := vmOutputResponse{}
+	statusCode := doPost(t, &facade, "/vm-values/query", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, uint64(37), receivedQuery.BlockNonce)
+	require.True(t, receivedQuery.HasBlockNonce)
+	require.Nil(t, receivedQuery.BlockHash)
+
+	request.BlockNonce = nil
+	request.BlockHash = "abcd"
+	statusCode = doPost(t, &facade, "/vm-values/query", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.False(t, receivedQuery.HasBlockNonce)
+	require.Equal(t, []byte{0xab, 0xcd}, receivedQuery.BlockHash)
+}
+
+func TestCreateSCQuery_InvalidBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	group, _ := groups.NewVmValuesGroup(&mock.FacadeStub{})
+
+	blockNonce := uint64(37)
+	request := groups.VMValueRequest{
+		ScAddress:  dummyScAddress,
+		FuncName:   "function",
+		BlockNonce: &blockNonce,
+		BlockHash:  "abcd",
+	}
+	_, err := group.CreateSCQuery(&request)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "only one of blockNonce and blockHash can be provided")
+
+	request.BlockNonce = nil
+	request.BlockHash = "not hex"
+	_, err = group.CreateSCQuery(&request)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "'not hex' is not a valid block hash")
+}
+
 func TestCreateSCQuery_ArgumentIsNotHexShouldErr(t *testing.T) {
 	request := groups.VMValueRequest{
 		ScAddress: dummyScAddress,
@@ -15,7 +15,9 @@ var log = logger.GetOrCreate("api/middleware")
 
 // globalThrottler is a middleware global limiter used to limit total number of simultaneous requests
 type globalThrottler struct {
-	queue            chan struct{}
+	mutRequests      sync.Mutex
+	numInFlight      uint32
+	maxConnections   uint32
 	mutDebugRequests sync.Mutex
 	debugRequests    map[string]int
 }
@@ -27,8 +29,8 @@ func NewGlobalThrottler(maxConnections uint32) (*globalThrottler, error) {
 	}
 
 	return &globalThrottler{
-		queue:         make(chan struct{}, maxConnections),
-		debugRequests: make(map[string]int),
+		maxConnections: maxConnections,
+		debugRequests:  make(map[string]int),
 	}, nil
 }
 
@@ -37,12 +39,7 @@ func (gt *globalThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		path := c.Request.URL.Path
 
-		select {
-		case gt.queue <- struct{}{}:
-			gt.mutDebugRequests.Lock()
-			gt.debugRequests[path]++
-			gt.mutDebugRequests.Unlock()
-		default:
+		if !gt.tryStartProcessing() {
 			c.AbortWithStatusJSON(
 				http.StatusTooManyRequests,
 				shared.GenericAPIResponse{
@@ -57,12 +54,43 @@ func (gt *globalThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 			return
 		}
 
+		gt.mutDebugRequests.Lock()
+		gt.debugRequests[path]++
+		gt.mutDebugRequests.Unlock()
+
 		defer gt.finish(path)
 
 		c.Next()
 	}
 }
 
+func (gt *globalThrottler) tryStartProcessing() bool {
+	gt.mutRequests.Lock()
+	defer gt.mutRequests.Unlock()
+
+	if gt.numInFlight >= gt.maxConnections {
+		return false
+	}
+
+	gt.numInFlight++
+
+	return true
+}
+
+// SetMaxConnections replaces the maximum number of simultaneous requests. The requests already being processed keep
+// counting against the new limit, so no new request is accepted until their number drops below it
+func (gt *globalThrottler) SetMaxConnections(maxConnections uint32) error {
+	if maxConnections == 0 {
+		return ErrInvalidMaxNumRequests
+	}
+
+	gt.mutRequests.Lock()
+	gt.maxConnections = maxConnections
+	gt.mutRequests.Unlock()
+
+	return nil
+}
+
 func (gt *globalThrottler) finish(path string) {
 	gt.mutDebugRequests.Lock()
 	gt.debugRequests[path]--
@@ -71,7 +99,9 @@ func (gt *globalThrottler) finish(path string) {
 	}
 	gt.mutDebugRequests.Unlock()
 
-	<-gt.queue
+	gt.mutRequests.Lock()
+	gt.numInFlight--
+	gt.mutRequests.Unlock()
 }
 
 func (gt *globalThrottler) printDebugInfo() {
@@ -102,6 +102,71 @@ func TestGlobalThrottler_LimitOverShouldError(t *testing.T) {
 	mutResponses.Unlock()
 }
 
+func TestGlobalThrottler_SetMaxConnections(t *testing.T) {
+	t.Parallel()
+
+	chanRelease := make(chan struct{})
+	chanStarted := make(chan struct{}, 10)
+	handlerFunc := func(c *gin.Context) {
+		chanStarted <- struct{}{}
+		<-chanRelease
+	}
+
+	ws := gin.New()
+	globalThrottler, _ := middleware.NewGlobalThrottler(1)
+	ws.Use(globalThrottler.MiddlewareHandlerFunc())
+	ws.Group("/address").Handle(http.MethodGet, "/:address/balance", handlerFunc)
+
+	err := globalThrottler.SetMaxConnections(0)
+	assert.Equal(t, middleware.ErrInvalidMaxNumRequests, err)
+
+	mutResponses := sync.Mutex{}
+	responses := make(map[int]int)
+	wg := sync.WaitGroup{}
+	makeRequests := func(numRequests int) {
+		for i := 0; i < numRequests; i++ {
+			wg.Add(1)
+			go func() {
+				makeRequestGlobalThrottler(ws, &mutResponses, responses)
+				wg.Done()
+			}()
+		}
+	}
+
+	// one request is in progress while the limit is raised: only one more request can be accepted
+	makeRequests(1)
+	<-chanStarted
+
+	err = globalThrottler.SetMaxConnections(2)
+	assert.Nil(t, err)
+
+	makeRequests(1)
+	<-chanStarted
+
+	makeRequestGlobalThrottler(ws, &mutResponses, responses)
+
+	// two requests are in progress while the limit is lowered: no request can be accepted until both end
+	err = globalThrottler.SetMaxConnections(1)
+	assert.Nil(t, err)
+
+	makeRequestGlobalThrottler(ws, &mutResponses, responses)
+
+	chanRelease <- struct{}{}
+	chanRelease <- struct{}{}
+	wg.Wait()
+
+	makeRequests(1)
+	<-chanStarted
+	makeRequestGlobalThrottler(ws, &mutResponses, responses)
+	close(chanRelease)
+	wg.Wait()
+
+	mutResponses.Lock()
+	assert.Equal(t, 3, responses[http.StatusOK])
+	assert.Equal(t, 3, responses[http.StatusTooManyRequests])
+	mutResponses.Unlock()
+}
+
 func makeRequestGlobalThrottler(ws *gin.Engine, mutResponses *sync.Mutex, responses map[int]int) {
 	addr := "testAddress"
 	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/balance", addr), nil)
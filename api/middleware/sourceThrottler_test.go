@@ -121,6 +121,32 @@ func TestSourceThrottler_LimitResetShouldWork(t *testing.T) {
 	mutResponses.Unlock()
 }
 
+func TestSourceThrottler_SetMaxNumRequests(t *testing.T) {
+	t.Parallel()
+
+	ws := gin.New()
+	sourceThrottler, _ := middleware.NewSourceThrottler(1)
+	ws.Use(sourceThrottler.MiddlewareHandlerFunc())
+	ws.Group("/address").Handle(http.MethodGet, "/:address/balance", func(c *gin.Context) {})
+
+	err := sourceThrottler.SetMaxNumRequests(0)
+	assert.Equal(t, middleware.ErrInvalidMaxNumRequests, err)
+
+	err = sourceThrottler.SetMaxNumRequests(3)
+	assert.Nil(t, err)
+
+	mutResponses := sync.Mutex{}
+	responses := make(map[int]int)
+	for i := 0; i < 4; i++ {
+		makeRequestSourceThrottler(ws, &mutResponses, responses)
+	}
+
+	mutResponses.Lock()
+	assert.Equal(t, 3, responses[http.StatusOK])
+	assert.Equal(t, 1, responses[http.StatusTooManyRequests])
+	mutResponses.Unlock()
+}
+
 func makeRequestSourceThrottler(ws *gin.Engine, mutResponses *sync.Mutex, responses map[int]int) {
 	addr := "testAddress"
 	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/balance", addr), nil)
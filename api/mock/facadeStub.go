@@ -10,9 +10,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -23,8 +25,8 @@ type FacadeStub struct {
 	ShouldErrorStart           bool
 	ShouldErrorStop            bool
 	GetHeartbeatsHandler       func() ([]data.PubKeyHeartbeat, error)
-	BalanceHandler             func(string) (*big.Int, error)
-	GetAccountHandler          func(address string) (api.AccountResponse, error)
+	BalanceHandler             func(string, common.AccountQueryOptions) (*big.Int, error)
+	GetAccountHandler          func(address string, options common.AccountQueryOptions) (api.AccountResponse, error)
 	GenerateTransactionHandler func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
 	GetTransactionHandler      func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
@@ -38,19 +40,25 @@ type FacadeStub struct {
 	ComputeTransactionGasLimitHandler       func(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	NodeConfigCalled                        func() map[string]interface{}
 	GetQueryHandlerCalled                   func(name string) (debug.QueryHandler, error)
-	GetValueForKeyCalled                    func(address string, key string) (string, error)
+	GetValueForKeyCalled                    func(address string, key string, options common.AccountQueryOptions) (string, error)
 	GetPeerInfoCalled                       func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetPeersReputationCalled                func() ([]*common.PeerReputation, error)
+	ReloadAntifloodConfigCalled             func() (*config.AntifloodConfig, error)
 	GetThrottlerForEndpointCalled           func(endpoint string) (core.Throttler, bool)
-	GetUsernameCalled                       func(address string) (string, error)
-	GetKeyValuePairsCalled                  func(address string) (map[string]string, error)
+	GetUsernameCalled                       func(address string, options common.AccountQueryOptions) (string, error)
+	GetKeyValuePairsCalled                  func(address string, options common.AccountQueryOptions) (map[string]string, error)
 	SimulateTransactionExecutionHandler     func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	SimulateTxBundleExecutionCalled         func(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	GetMaxTxBundleSizeCalled                func() uint32
+	SimulateTxWithOptionsCalled             func(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	TraceTransactionCalled                  func(hash string) (*txSimData.SimulationResults, error)
 	GetNumCheckpointsFromAccountStateCalled func() uint32
 	GetNumCheckpointsFromPeerStateCalled    func() uint32
-	GetESDTDataCalled                       func(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error)
-	GetAllESDTTokensCalled                  func(address string) (map[string]*esdt.ESDigitalToken, error)
-	GetESDTsWithRoleCalled                  func(address string, role string) ([]string, error)
-	GetESDTsRolesCalled                     func(address string) (map[string][]string, error)
-	GetNFTTokenIDsRegisteredByAddressCalled func(address string) ([]string, error)
+	GetESDTDataCalled                       func(address string, key string, nonce uint64, options common.AccountQueryOptions) (*esdt.ESDigitalToken, error)
+	GetAllESDTTokensCalled                  func(address string, options common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error)
+	GetESDTsWithRoleCalled                  func(address string, role string, options common.AccountQueryOptions) ([]string, error)
+	GetESDTsRolesCalled                     func(address string, options common.AccountQueryOptions) (map[string][]string, error)
+	GetNFTTokenIDsRegisteredByAddressCalled func(address string, options common.AccountQueryOptions) ([]string, error)
 	GetBlockByHashCalled                    func(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonceCalled                   func(nonce uint64, withTxs bool) (*api.Block, error)
 	GetBlockByRoundCalled                   func(round uint64, withTxs bool) (*api.Block, error)
@@ -62,7 +70,55 @@ type FacadeStub struct {
 	GetProofCurrentRootHashCalled           func(string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                  func(string, string, string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                       func(string, string, [][]byte) (bool, error)
+	GetProofDataTrieMultiKeyCalled          func(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiKeyProofResponse, error)
+	GetProofDataTrieRangeCalled             func(rootHash string, address string, startKey string, endKey string, maxNumKeys int) (*common.GetProofResponse, *common.GetRangeProofResponse, error)
+	VerifyProofDataTrieMultiKeyCalled       func(rootHash string, address string, keys []string, proof [][]byte) ([]core.KeyValueHolder, error)
+	VerifyProofDataTrieRangeCalled          func(rootHash string, address string, startKey string, endKey string, proof [][]byte) ([]core.KeyValueHolder, error)
+	GetStateStatisticsCalled                func(rootHash string, numLargestDataTries int) (*common.StateStatistics, error)
 	GetTokenSupplyCalled                    func(token string) (string, error)
+	GetSubscriptionsHubCalled               func() subscriptions.Hub
+	GetLogsCalled                           func(query *common.LogsQuery) (*common.LogsResponse, error)
+	GetAddressTransactionsCalled            func(address string, from uint64, size uint64) (*common.AddressTransactionsResponse, error)
+	GetTransactionsPoolCalled               func(options common.TxPoolQueryOptions) (*common.TxPoolTransactionsResponse, error)
+	GetTransactionsPoolForSenderCalled      func(address string) (*common.TxPoolSenderResponse, error)
+	GetTransactionsPoolStatisticsCalled     func() (*common.TxPoolStatisticsResponse, error)
+	GetEvictedTransactionFromPoolCalled     func(txHash string) (*common.TxPoolEvictedTransaction, error)
+}
+
+// GetProofDataTrieMultiKey -
+func (f *FacadeStub) GetProofDataTrieMultiKey(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiKeyProofResponse, error) {
+	if f.GetProofDataTrieMultiKeyCalled != nil {
+		return f.GetProofDataTrieMultiKeyCalled(rootHash, address, keys)
+	}
+
+	return nil, nil, nil
+}
+
+// GetProofDataTrieRange -
+func (f *FacadeStub) GetProofDataTrieRange(rootHash string, address string, startKey string, endKey string, maxNumKeys int) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+	if f.GetProofDataTrieRangeCalled != nil {
+		return f.GetProofDataTrieRangeCalled(rootHash, address, startKey, endKey, maxNumKeys)
+	}
+
+	return nil, nil, nil
+}
+
+// VerifyProofDataTrieMultiKey -
+func (f *FacadeStub) VerifyProofDataTrieMultiKey(rootHash string, address string, keys []string, proof [][]byte) ([]core.KeyValueHolder, error) {
+	if f.VerifyProofDataTrieMultiKeyCalled != nil {
+		return f.VerifyProofDataTrieMultiKeyCalled(rootHash, address, keys, proof)
+	}
+
+	return nil, nil
+}
+
+// VerifyProofDataTrieRange -
+func (f *FacadeStub) VerifyProofDataTrieRange(rootHash string, address string, startKey string, endKey string, proof [][]byte) ([]core.KeyValueHolder, error) {
+	if f.VerifyProofDataTrieRangeCalled != nil {
+		return f.VerifyProofDataTrieRangeCalled(rootHash, address, startKey, endKey, proof)
+	}
+
+	return nil, nil
 }
 
 // GetTokenSupply -
@@ -110,10 +166,19 @@ func (f *FacadeStub) VerifyProof(rootHash string, address string, proof [][]byte
 	return false, nil
 }
 
+// GetStateStatistics -
+func (f *FacadeStub) GetStateStatistics(rootHash string, numLargestDataTries int) (*common.StateStatistics, error) {
+	if f.GetStateStatisticsCalled != nil {
+		return f.GetStateStatisticsCalled(rootHash, numLargestDataTries)
+	}
+
+	return nil, nil
+}
+
 // GetUsername -
-func (f *FacadeStub) GetUsername(address string) (string, error) {
+func (f *FacadeStub) GetUsername(address string, options common.AccountQueryOptions) (string, error) {
 	if f.GetUsernameCalled != nil {
-		return f.GetUsernameCalled(address)
+		return f.GetUsernameCalled(address, options)
 	}
 
 	return "", nil
@@ -143,74 +208,83 @@ func (f *FacadeStub) PprofEnabled() bool {
 	return false
 }
 
+// GetSubscriptionsHub -
+func (f *FacadeStub) GetSubscriptionsHub() subscriptions.Hub {
+	if f.GetSubscriptionsHubCalled != nil {
+		return f.GetSubscriptionsHubCalled()
+	}
+
+	return subscriptions.NewDisabledSubscriptionsHub()
+}
+
 // GetHeartbeats returns the slice of heartbeat info
 func (f *FacadeStub) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 	return f.GetHeartbeatsHandler()
 }
 
 // GetBalance is the mock implementation of a handler's GetBalance method
-func (f *FacadeStub) GetBalance(address string) (*big.Int, error) {
-	return f.BalanceHandler(address)
+func (f *FacadeStub) GetBalance(address string, options common.AccountQueryOptions) (*big.Int, error) {
+	return f.BalanceHandler(address, options)
 }
 
 // GetValueForKey is the mock implementation of a handler's GetValueForKey method
-func (f *FacadeStub) GetValueForKey(address string, key string) (string, error) {
+func (f *FacadeStub) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
 	if f.GetValueForKeyCalled != nil {
-		return f.GetValueForKeyCalled(address, key)
+		return f.GetValueForKeyCalled(address, key, options)
 	}
 
 	return "", nil
 }
 
 // GetKeyValuePairs -
-func (f *FacadeStub) GetKeyValuePairs(address string) (map[string]string, error) {
+func (f *FacadeStub) GetKeyValuePairs(address string, options common.AccountQueryOptions) (map[string]string, error) {
 	if f.GetKeyValuePairsCalled != nil {
-		return f.GetKeyValuePairsCalled(address)
+		return f.GetKeyValuePairsCalled(address, options)
 	}
 
 	return nil, nil
 }
 
 // GetESDTData -
-func (f *FacadeStub) GetESDTData(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error) {
+func (f *FacadeStub) GetESDTData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
 	if f.GetESDTDataCalled != nil {
-		return f.GetESDTDataCalled(address, key, nonce)
+		return f.GetESDTDataCalled(address, key, nonce, options)
 	}
 
 	return &esdt.ESDigitalToken{Value: big.NewInt(0)}, nil
 }
 
 // GetESDTsRoles -
-func (f *FacadeStub) GetESDTsRoles(address string) (map[string][]string, error) {
+func (f *FacadeStub) GetESDTsRoles(address string, options common.AccountQueryOptions) (map[string][]string, error) {
 	if f.GetESDTsRolesCalled != nil {
-		return f.GetESDTsRolesCalled(address)
+		return f.GetESDTsRolesCalled(address, options)
 	}
 
 	return map[string][]string{}, nil
 }
 
 // GetAllESDTTokens -
-func (f *FacadeStub) GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error) {
+func (f *FacadeStub) GetAllESDTTokens(address string, options common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error) {
 	if f.GetAllESDTTokensCalled != nil {
-		return f.GetAllESDTTokensCalled(address)
+		return f.GetAllESDTTokensCalled(address, options)
 	}
 
 	return make(map[string]*esdt.ESDigitalToken), nil
 }
 
 // GetNFTTokenIDsRegisteredByAddress -
-func (f *FacadeStub) GetNFTTokenIDsRegisteredByAddress(address string) ([]string, error) {
+func (f *FacadeStub) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) ([]string, error) {
 	if f.GetNFTTokenIDsRegisteredByAddressCalled != nil {
-		return f.GetNFTTokenIDsRegisteredByAddressCalled(address)
+		return f.GetNFTTokenIDsRegisteredByAddressCalled(address, options)
 	}
 
 	return make([]string, 0), nil
 }
 
 // GetESDTsWithRole -
-func (f *FacadeStub) GetESDTsWithRole(address string, role string) ([]string, error) {
+func (f *FacadeStub) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) ([]string, error) {
 	if f.GetESDTsWithRoleCalled != nil {
-		return f.GetESDTsWithRoleCalled(address, role)
+		return f.GetESDTsWithRoleCalled(address, role, options)
 	}
 
 	return make([]string, 0), nil
@@ -226,8 +300,8 @@ func (f *FacadeStub) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 }
 
 // GetAccount -
-func (f *FacadeStub) GetAccount(address string) (api.AccountResponse, error) {
-	return f.GetAccountHandler(address)
+func (f *FacadeStub) GetAccount(address string, options common.AccountQueryOptions) (api.AccountResponse, error) {
+	return f.GetAccountHandler(address, options)
 }
 
 // CreateTransaction is  mock implementation of a handler's CreateTransaction method
@@ -259,6 +333,42 @@ func (f *FacadeStub) SimulateTransactionExecution(tx *transaction.Transaction) (
 	return f.SimulateTransactionExecutionHandler(tx)
 }
 
+// SimulateTransactionWithOptions -
+func (f *FacadeStub) SimulateTransactionWithOptions(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+	if f.SimulateTxWithOptionsCalled != nil {
+		return f.SimulateTxWithOptionsCalled(tx, options)
+	}
+
+	return nil, nil
+}
+
+// TraceTransaction -
+func (f *FacadeStub) TraceTransaction(hash string) (*txSimData.SimulationResults, error) {
+	if f.TraceTransactionCalled != nil {
+		return f.TraceTransactionCalled(hash)
+	}
+
+	return nil, nil
+}
+
+// GetMaxTxBundleSize -
+func (f *FacadeStub) GetMaxTxBundleSize() uint32 {
+	if f.GetMaxTxBundleSizeCalled != nil {
+		return f.GetMaxTxBundleSizeCalled()
+	}
+
+	return 0
+}
+
+// SimulateTransactionBundleExecution -
+func (f *FacadeStub) SimulateTransactionBundleExecution(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	if f.SimulateTxBundleExecutionCalled != nil {
+		return f.SimulateTxBundleExecutionCalled(txs)
+	}
+
+	return nil, nil
+}
+
 // SendBulkTransactions is the mock implementation of a handler's SendBulkTransactions method
 func (f *FacadeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return f.SendBulkTransactionsHandler(txs)
@@ -334,6 +444,24 @@ func (f *FacadeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return f.GetPeerInfoCalled(pid)
 }
 
+// ReloadAntifloodConfig -
+func (f *FacadeStub) ReloadAntifloodConfig() (*config.AntifloodConfig, error) {
+	if f.ReloadAntifloodConfigCalled != nil {
+		return f.ReloadAntifloodConfigCalled()
+	}
+
+	return nil, nil
+}
+
+// GetPeersReputation -
+func (f *FacadeStub) GetPeersReputation() ([]*common.PeerReputation, error) {
+	if f.GetPeersReputationCalled != nil {
+		return f.GetPeersReputationCalled()
+	}
+
+	return make([]*common.PeerReputation, 0), nil
+}
+
 // GetNumCheckpointsFromAccountState -
 func (f *FacadeStub) GetNumCheckpointsFromAccountState() uint32 {
 	if f.GetNumCheckpointsFromAccountStateCalled != nil {
@@ -370,6 +498,54 @@ func (f *FacadeStub) GetBlockByRound(round uint64, withTxs bool) (*api.Block, er
 	return nil, nil
 }
 
+// GetLogs -
+func (f *FacadeStub) GetLogs(query *common.LogsQuery) (*common.LogsResponse, error) {
+	if f.GetLogsCalled != nil {
+		return f.GetLogsCalled(query)
+	}
+	return nil, nil
+}
+
+// GetAddressTransactions -
+func (f *FacadeStub) GetAddressTransactions(address string, from uint64, size uint64) (*common.AddressTransactionsResponse, error) {
+	if f.GetAddressTransactionsCalled != nil {
+		return f.GetAddressTransactionsCalled(address, from, size)
+	}
+	return nil, nil
+}
+
+// GetTransactionsPool -
+func (f *FacadeStub) GetTransactionsPool(options common.TxPoolQueryOptions) (*common.TxPoolTransactionsResponse, error) {
+	if f.GetTransactionsPoolCalled != nil {
+		return f.GetTransactionsPoolCalled(options)
+	}
+	return nil, nil
+}
+
+// GetTransactionsPoolForSender -
+func (f *FacadeStub) GetTransactionsPoolForSender(address string) (*common.TxPoolSenderResponse, error) {
+	if f.GetTransactionsPoolForSenderCalled != nil {
+		return f.GetTransactionsPoolForSenderCalled(address)
+	}
+	return nil, nil
+}
+
+// GetTransactionsPoolStatistics -
+func (f *FacadeStub) GetTransactionsPoolStatistics() (*common.TxPoolStatisticsResponse, error) {
+	if f.GetTransactionsPoolStatisticsCalled != nil {
+		return f.GetTransactionsPoolStatisticsCalled()
+	}
+	return nil, nil
+}
+
+// GetEvictedTransactionFromPool -
+func (f *FacadeStub) GetEvictedTransactionFromPool(txHash string) (*common.TxPoolEvictedTransaction, error) {
+	if f.GetEvictedTransactionFromPoolCalled != nil {
+		return f.GetEvictedTransactionFromPoolCalled(txHash)
+	}
+	return nil, nil
+}
+
 // Trigger -
 func (f *FacadeStub) Trigger(_ uint32, _ bool) error {
 	return nil
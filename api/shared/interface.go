@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -44,6 +45,8 @@ type ApiFacadeHandler interface {
 type UpgradeableHttpServerHandler interface {
 	StartHttpServer() error
 	UpdateFacade(facade FacadeHandler) error
+	CheckAntifloodConfig(cfg config.AntifloodConfig) error
+	ApplyAntifloodConfig(cfg config.AntifloodConfig) error
 	Close() error
 	IsInterfaceNil() bool
 }
@@ -60,19 +63,25 @@ type GroupHandler interface {
 
 // FacadeHandler defines all the methods that a facade should implement
 type FacadeHandler interface {
-	GetBalance(address string) (*big.Int, error)
-	GetUsername(address string) (string, error)
-	GetValueForKey(address string, key string) (string, error)
-	GetAccount(address string) (api.AccountResponse, error)
-	GetESDTData(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error)
-	GetESDTsRoles(address string) (map[string][]string, error)
-	GetNFTTokenIDsRegisteredByAddress(address string) ([]string, error)
-	GetESDTsWithRole(address string, role string) ([]string, error)
-	GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error)
-	GetKeyValuePairs(address string) (map[string]string, error)
+	GetBalance(address string, options common.AccountQueryOptions) (*big.Int, error)
+	GetUsername(address string, options common.AccountQueryOptions) (string, error)
+	GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error)
+	GetAccount(address string, options common.AccountQueryOptions) (api.AccountResponse, error)
+	GetESDTData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*esdt.ESDigitalToken, error)
+	GetESDTsRoles(address string, options common.AccountQueryOptions) (map[string][]string, error)
+	GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) ([]string, error)
+	GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) ([]string, error)
+	GetAllESDTTokens(address string, options common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error)
+	GetKeyValuePairs(address string, options common.AccountQueryOptions) (map[string]string, error)
 	GetBlockByHash(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
 	GetBlockByRound(round uint64, withTxs bool) (*api.Block, error)
+	GetLogs(query *common.LogsQuery) (*common.LogsResponse, error)
+	GetAddressTransactions(address string, from uint64, size uint64) (*common.AddressTransactionsResponse, error)
+	GetTransactionsPool(options common.TxPoolQueryOptions) (*common.TxPoolTransactionsResponse, error)
+	GetTransactionsPoolForSender(address string) (*common.TxPoolSenderResponse, error)
+	GetTransactionsPoolStatistics() (*common.TxPoolStatisticsResponse, error)
+	GetEvictedTransactionFromPool(txHash string) (*common.TxPoolEvictedTransaction, error)
 	Trigger(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTrigger() bool
 	GetTotalStakedValue() (*api.StakeValues, error)
@@ -84,12 +93,19 @@ type FacadeHandler interface {
 	GetHeartbeats() ([]data.PubKeyHeartbeat, error)
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetPeersReputation() ([]*common.PeerReputation, error)
+	ReloadAntifloodConfig() (*config.AntifloodConfig, error)
 	GetNumCheckpointsFromAccountState() uint32
 	GetNumCheckpointsFromPeerState() uint32
 	GetProof(rootHash string, address string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetProofDataTrieMultiKey(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiKeyProofResponse, error)
+	GetProofDataTrieRange(rootHash string, address string, startKey string, endKey string, maxNumKeys int) (*common.GetProofResponse, *common.GetRangeProofResponse, error)
+	VerifyProofDataTrieMultiKey(rootHash string, address string, keys []string, proof [][]byte) ([]core.KeyValueHolder, error)
+	VerifyProofDataTrieRange(rootHash string, address string, startKey string, endKey string, proof [][]byte) ([]core.KeyValueHolder, error)
+	GetStateStatistics(rootHash string, numLargestDataTries int) (*common.StateStatistics, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
@@ -97,6 +113,10 @@ type FacadeHandler interface {
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	SimulateTransactionWithOptions(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	SimulateTransactionBundleExecution(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	GetMaxTxBundleSize() uint32
+	TraceTransaction(hash string) (*txSimData.SimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
@@ -106,5 +126,6 @@ type FacadeHandler interface {
 	RestApiInterface() string
 	RestAPIServerDebugMode() bool
 	PprofEnabled() bool
+	GetSubscriptionsHub() subscriptions.Hub
 	IsInterfaceNil() bool
 }
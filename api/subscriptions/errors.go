@@ -0,0 +1,15 @@
+package subscriptions
+
+import "errors"
+
+// ErrNilSubscriptionsHub signals that a nil subscriptions hub has been provided
+var ErrNilSubscriptionsHub = errors.New("nil subscriptions hub")
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilWsConn signals that a nil web socket connection has been provided
+var ErrNilWsConn = errors.New("nil web socket connection")
+
+// ErrSubscriptionDropped signals that the subscription was dropped by the hub because the client could not keep up
+var ErrSubscriptionDropped = errors.New("subscription dropped: the client can not keep up with the events")
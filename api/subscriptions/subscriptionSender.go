@@ -0,0 +1,145 @@
+package subscriptions
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
+	"github.com/gorilla/websocket"
+)
+
+const disconnectMessage = -1
+
+type errorMessage struct {
+	Error string `json:"error"`
+}
+
+type subscriptionSender struct {
+	hub  subscriptions.Hub
+	conn wsConn
+	log  logger.Logger
+}
+
+// NewSubscriptionSender returns a new component that is able to push the events of a subscription towards a
+// WebSocket client. The first message sent by the client should contain the JSON encoded subscription request
+func NewSubscriptionSender(hub subscriptions.Hub, conn wsConn, log logger.Logger) (*subscriptionSender, error) {
+	if check.IfNil(hub) {
+		return nil, ErrNilSubscriptionsHub
+	}
+	if check.IfNil(log) {
+		return nil, ErrNilLogger
+	}
+	if conn == nil {
+		return nil, ErrNilWsConn
+	}
+
+	return &subscriptionSender{
+		hub:  hub,
+		conn: conn,
+		log:  log,
+	}, nil
+}
+
+// StartSendingBlocking waits for the subscription request, registers it on the hub and then sends the matching
+// events until either the connection is closed or the hub drops the subscription
+func (ss *subscriptionSender) StartSendingBlocking() {
+	defer func() {
+		_ = ss.conn.Close()
+	}()
+
+	subscription, err := ss.subscribe()
+	if err != nil {
+		ss.log.Debug("websocket subscription rejected", "error", err.Error())
+		ss.sendError(err)
+		return
+	}
+	defer subscription.Close()
+
+	chanConnectionClosed := make(chan struct{})
+	go ss.monitorConnection(chanConnectionClosed)
+	ss.doSendContinuously(subscription, chanConnectionClosed)
+}
+
+func (ss *subscriptionSender) subscribe() (subscriptions.Subscription, error) {
+	_, message, err := ss.conn.ReadMessage()
+	if err != nil {
+		return nil, err
+	}
+
+	request := &subscriptions.SubscriptionRequest{}
+	err = json.Unmarshal(message, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return ss.hub.Subscribe(request)
+}
+
+func (ss *subscriptionSender) monitorConnection(chanConnectionClosed chan struct{}) {
+	defer close(chanConnectionClosed)
+
+	for {
+		mt, _, err := ss.conn.ReadMessage()
+		if mt == websocket.CloseMessage || mt == disconnectMessage {
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (ss *subscriptionSender) doSendContinuously(subscription subscriptions.Subscription, chanConnectionClosed chan struct{}) {
+	for {
+		select {
+		case event, ok := <-subscription.Events():
+			if !ok {
+				ss.sendError(ErrSubscriptionDropped)
+				return
+			}
+
+			shouldStop := ss.sendEvent(event)
+			if shouldStop {
+				return
+			}
+		case <-chanConnectionClosed:
+			ss.log.Debug("web socket", "subscription connection", "closed")
+			return
+		}
+	}
+}
+
+func (ss *subscriptionSender) sendEvent(event *subscriptions.Event) (shouldStop bool) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		ss.log.Warn("cannot marshal subscription event", "error", err.Error())
+		return false
+	}
+
+	return ss.writeMessage(data)
+}
+
+func (ss *subscriptionSender) sendError(errToSend error) {
+	data, err := json.Marshal(&errorMessage{Error: errToSend.Error()})
+	if err != nil {
+		return
+	}
+
+	_ = ss.writeMessage(data)
+}
+
+func (ss *subscriptionSender) writeMessage(data []byte) (shouldStop bool) {
+	err := ss.conn.WriteMessage(websocket.TextMessage, data)
+	if err != nil {
+		isConnectionClosed := strings.Contains(err.Error(), "websocket: close sent")
+		if !isConnectionClosed {
+			ss.log.Debug("web socket error", "error", err.Error())
+		}
+
+		return true
+	}
+
+	return false
+}
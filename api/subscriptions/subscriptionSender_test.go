@@ -0,0 +1,221 @@
+package subscriptions_test
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	apiSubscriptions "github.com/ElrondNetwork/elrond-go/api/subscriptions"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type wsConnMock struct {
+	request        []byte
+	mut            sync.Mutex
+	requestRead    bool
+	written        [][]byte
+	closed         bool
+	chanClientGone chan struct{}
+}
+
+func newWsConnMock(request string) *wsConnMock {
+	return &wsConnMock{
+		request:        []byte(request),
+		chanClientGone: make(chan struct{}),
+	}
+}
+
+func (conn *wsConnMock) ReadMessage() (messageType int, p []byte, err error) {
+	conn.mut.Lock()
+	if !conn.requestRead {
+		conn.requestRead = true
+		conn.mut.Unlock()
+		return websocket.TextMessage, conn.request, nil
+	}
+	conn.mut.Unlock()
+
+	<-conn.chanClientGone
+	return websocket.CloseMessage, nil, nil
+}
+
+func (conn *wsConnMock) WriteMessage(_ int, data []byte) error {
+	conn.mut.Lock()
+	defer conn.mut.Unlock()
+
+	conn.written = append(conn.written, data)
+	return nil
+}
+
+func (conn *wsConnMock) Close() error {
+	conn.mut.Lock()
+	defer conn.mut.Unlock()
+
+	conn.closed = true
+	return nil
+}
+
+func (conn *wsConnMock) getWritten() [][]byte {
+	conn.mut.Lock()
+	defer conn.mut.Unlock()
+
+	return conn.written
+}
+
+func (conn *wsConnMock) isClosed() bool {
+	conn.mut.Lock()
+	defer conn.mut.Unlock()
+
+	return conn.closed
+}
+
+func createHub(t *testing.T) subscriptionsHubDriver {
+	hub, err := subscriptions.NewSubscriptionsHub(subscriptions.ArgsSubscriptionsHub{
+		PubKeyConverter:  testscommon.NewPubkeyConverterMock(32),
+		Marshalizer:      &testscommon.MarshalizerMock{},
+		Hasher:           &testscommon.HasherMock{},
+		MaxSubscribers:   10,
+		EventsBufferSize: 10,
+	})
+	require.Nil(t, err)
+
+	return hub
+}
+
+type subscriptionsHubDriver interface {
+	subscriptions.Hub
+	FinalizedBlock(headerHash []byte) error
+	Close() error
+}
+
+func startSending(t *testing.T, hub subscriptions.Hub, conn *wsConnMock) chan struct{} {
+	ss, err := apiSubscriptions.NewSubscriptionSender(hub, conn, &mock.LoggerStub{})
+	require.Nil(t, err)
+
+	chanDone := make(chan struct{})
+	go func() {
+		ss.StartSendingBlocking()
+		close(chanDone)
+	}()
+
+	return chanDone
+}
+
+func waitDone(t *testing.T, chanDone chan struct{}) {
+	select {
+	case <-chanDone:
+	case <-time.After(time.Second * 2):
+		require.Fail(t, "timeout waiting for the sender to stop")
+	}
+}
+
+func waitNumSubscribers(t *testing.T, hub subscriptions.Hub, numSubscribers int) {
+	for i := 0; i < 200; i++ {
+		if hub.NumSubscribers() == numSubscribers {
+			return
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	require.Fail(t, "timeout waiting for the subscribers")
+}
+
+func TestNewSubscriptionSender(t *testing.T) {
+	t.Parallel()
+
+	ss, err := apiSubscriptions.NewSubscriptionSender(nil, newWsConnMock(""), &mock.LoggerStub{})
+	assert.Nil(t, ss)
+	assert.Equal(t, apiSubscriptions.ErrNilSubscriptionsHub, err)
+
+	ss, err = apiSubscriptions.NewSubscriptionSender(subscriptions.NewDisabledSubscriptionsHub(), newWsConnMock(""), nil)
+	assert.Nil(t, ss)
+	assert.Equal(t, apiSubscriptions.ErrNilLogger, err)
+
+	ss, err = apiSubscriptions.NewSubscriptionSender(subscriptions.NewDisabledSubscriptionsHub(), nil, &mock.LoggerStub{})
+	assert.Nil(t, ss)
+	assert.Equal(t, apiSubscriptions.ErrNilWsConn, err)
+
+	ss, err = apiSubscriptions.NewSubscriptionSender(subscriptions.NewDisabledSubscriptionsHub(), newWsConnMock(""), &mock.LoggerStub{})
+	assert.NotNil(t, ss)
+	assert.Nil(t, err)
+}
+
+func TestSubscriptionSender_InvalidRequestShouldSendErrorAndClose(t *testing.T) {
+	t.Parallel()
+
+	conn := newWsConnMock("not a json")
+	waitDone(t, startSending(t, createHub(t), conn))
+
+	written := conn.getWritten()
+	require.Equal(t, 1, len(written))
+	assert.Contains(t, string(written[0]), `"error"`)
+	assert.True(t, conn.isClosed())
+}
+
+func TestSubscriptionSender_DisabledHubShouldSendErrorAndClose(t *testing.T) {
+	t.Parallel()
+
+	conn := newWsConnMock(`{"topics":["blocks"]}`)
+	waitDone(t, startSending(t, subscriptions.NewDisabledSubscriptionsHub(), conn))
+
+	written := conn.getWritten()
+	require.Equal(t, 1, len(written))
+	assert.Contains(t, string(written[0]), subscriptions.ErrSubscriptionsDisabled.Error())
+	assert.True(t, conn.isClosed())
+}
+
+func TestSubscriptionSender_ShouldSendEventsUntilClientLeaves(t *testing.T) {
+	t.Parallel()
+
+	hub := createHub(t)
+	conn := newWsConnMock(`{"topics":["finalizedBlocks"]}`)
+	chanDone := startSending(t, hub, conn)
+	waitNumSubscribers(t, hub, 1)
+
+	_ = hub.FinalizedBlock([]byte("hash"))
+	for i := 0; i < 200 && len(conn.getWritten()) == 0; i++ {
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	written := conn.getWritten()
+	require.Equal(t, 1, len(written))
+	event := &subscriptions.Event{}
+	err := json.Unmarshal(written[0], event)
+	require.Nil(t, err)
+	assert.Equal(t, subscriptions.TopicFinalizedBlocks, event.Topic)
+	assert.Equal(t, "68617368", event.Block.Hash)
+
+	close(conn.chanClientGone)
+	waitDone(t, chanDone)
+	assert.True(t, conn.isClosed())
+	assert.Equal(t, 0, hub.NumSubscribers())
+}
+
+func TestSubscriptionSender_DroppedSubscriptionShouldSendErrorAndClose(t *testing.T) {
+	t.Parallel()
+
+	hub := createHub(t)
+	conn := newWsConnMock(`{"topics":["blocks"]}`)
+	chanDone := startSending(t, hub, conn)
+	waitNumSubscribers(t, hub, 1)
+
+	_ = hub.Close()
+	waitDone(t, chanDone)
+
+	written := conn.getWritten()
+	require.Equal(t, 1, len(written))
+	errMessage := struct {
+		Error string `json:"error"`
+	}{}
+	err := json.Unmarshal(written[0], &errMessage)
+	require.Nil(t, err)
+	assert.Equal(t, apiSubscriptions.ErrSubscriptionDropped.Error(), errMessage.Error)
+	assert.True(t, conn.isClosed())
+
+	close(conn.chanClientGone)
+}
@@ -6,6 +6,8 @@ generate() {
     generateForTermUi
     generateForLogViewer
     generateForSeedNode
+    generateForDbMigrator
+    generateForDbTool
 }
 
 generateForNode() {
@@ -78,6 +80,34 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForDbMigrator() {
+    HELP="
+# Elrond DB Migrator CLI
+
+The **DB migration Tool** exposes the following Command Line Interface:
+$(code)
+\$ dbmigrator --help
+
+$(./dbmigrator/dbmigrator --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./dbmigrator/CLI.md
+}
+
+generateForDbTool() {
+    HELP="
+# Elrond DB Tool CLI
+
+The **DB inspection Tool** exposes the following Command Line Interface:
+$(code)
+\$ dbtool --help
+
+$(./dbtool/dbtool --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./dbtool/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
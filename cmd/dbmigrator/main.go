@@ -0,0 +1,339 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/urfave/cli"
+)
+
+// levelDBMarkerFile is the file that every LevelDB directory contains
+const levelDBMarkerFile = "CURRENT"
+
+const progressLogInterval = 100000
+
+var errValueMismatch = errors.New("the value read back from the destination differs from the source")
+
+var errNumKeysMismatch = errors.New("the number of keys differs between the source and the destination")
+
+type cfg struct {
+	source          string
+	destination     string
+	sourceType      string
+	destinationType string
+	maxBatchSize    int
+	maxOpenFiles    int
+	recursive       bool
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// source defines a flag for setting the path of the storage unit to be migrated
+	source = cli.StringFlag{
+		Name:        "source",
+		Usage:       "The path of the existing storage unit (or, in recursive mode, of the db directory) to be copied",
+		Destination: &argsConfig.source,
+	}
+	// destination defines a flag for setting the path where the migrated storage unit will be written
+	destination = cli.StringFlag{
+		Name:        "destination",
+		Usage:       "The path where the new storage unit(s) will be created. Must not contain any data",
+		Destination: &argsConfig.destination,
+	}
+	// sourceType defines a flag for setting the DB type of the source
+	sourceType = cli.StringFlag{
+		Name:        "source-type",
+		Usage:       "The DB type of the source storage unit",
+		Value:       string(storageUnit.LvlDBSerial),
+		Destination: &argsConfig.sourceType,
+	}
+	// destinationType defines a flag for setting the DB type of the destination
+	destinationType = cli.StringFlag{
+		Name:        "destination-type",
+		Usage:       "The DB type of the destination storage unit",
+		Value:       string(storageUnit.BadgerDB),
+		Destination: &argsConfig.destinationType,
+	}
+	// maxBatchSize defines a flag for setting how many entries are written at once in the destination
+	maxBatchSize = cli.IntFlag{
+		Name:        "max-batch-size",
+		Usage:       "The maximum number of entries written in one batch in the destination storage unit",
+		Value:       10000,
+		Destination: &argsConfig.maxBatchSize,
+	}
+	// maxOpenFiles defines a flag for setting the maximum number of files kept open by LevelDB units
+	maxOpenFiles = cli.IntFlag{
+		Name:        "max-open-files",
+		Usage:       "The maximum number of files a LevelDB storage unit will keep open",
+		Value:       10,
+		Destination: &argsConfig.maxOpenFiles,
+	}
+	// recursive defines a flag that enables migrating every LevelDB unit found under the source path
+	recursive = cli.BoolFlag{
+		Name: "recursive",
+		Usage: "Boolean option that will migrate every LevelDB storage unit found under the source directory " +
+			"(for example a whole node db directory, including all the epoch folders), keeping the relative paths",
+		Destination: &argsConfig.recursive,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("dbmigrator")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "DB migration Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary will copy, offline, the content of existing storage units into storage units of another DB type"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		source,
+		destination,
+		sourceType,
+		destinationType,
+		maxBatchSize,
+		maxOpenFiles,
+		recursive,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return process()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error migrating the database", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func process() error {
+	if len(argsConfig.source) == 0 || len(argsConfig.destination) == 0 {
+		return fmt.Errorf("both the source and the destination paths should be provided")
+	}
+	if filepath.Clean(argsConfig.source) == filepath.Clean(argsConfig.destination) {
+		return fmt.Errorf("the source and the destination paths should be different")
+	}
+
+	if !argsConfig.recursive {
+		return migrateUnit(*argsConfig, argsConfig.source, argsConfig.destination)
+	}
+
+	units, err := findLevelDBUnits(argsConfig.source)
+	if err != nil {
+		return err
+	}
+	if len(units) == 0 {
+		return fmt.Errorf("no storage unit found in %s", argsConfig.source)
+	}
+
+	for _, relativePath := range units {
+		err = migrateUnit(
+			*argsConfig,
+			filepath.Join(argsConfig.source, relativePath),
+			filepath.Join(argsConfig.destination, relativePath),
+		)
+		if err != nil {
+			return fmt.Errorf("%w while migrating %s", err, relativePath)
+		}
+	}
+
+	log.Info("migration finished", "num units", len(units))
+
+	return nil
+}
+
+func findLevelDBUnits(root string) ([]string, error) {
+	units := make([]string, 0)
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || info.Name() != levelDBMarkerFile {
+			return nil
+		}
+
+		relativePath, errRel := filepath.Rel(root, filepath.Dir(path))
+		if errRel != nil {
+			return errRel
+		}
+
+		units = append(units, relativePath)
+		return nil
+	})
+
+	return units, err
+}
+
+func migrateUnit(config cfg, sourcePath string, destinationPath string) error {
+	_, err := os.Stat(sourcePath)
+	if err != nil {
+		return err
+	}
+	err = checkDestinationIsEmpty(destinationPath)
+	if err != nil {
+		return err
+	}
+
+	sourceDB, err := openUnit(config, config.sourceType, sourcePath, 1)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = sourceDB.Close()
+	}()
+
+	destinationDB, err := openUnit(config, config.destinationType, destinationPath, config.maxBatchSize)
+	if err != nil {
+		return err
+	}
+
+	log.Info("migrating storage unit", "source", sourcePath, "destination", destinationPath)
+	startTime := time.Now()
+
+	numKeys, err := copyKeys(sourceDB, destinationDB)
+	errClose := destinationDB.Close()
+	if err != nil {
+		return err
+	}
+	if errClose != nil {
+		return errClose
+	}
+
+	// the destination is reopened so the keys are read back from what was written on disk
+	destinationDB, err = openUnit(config, config.destinationType, destinationPath, 1)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = destinationDB.Close()
+	}()
+
+	err = verifyKeys(sourceDB, destinationDB, numKeys)
+	if err != nil {
+		return fmt.Errorf("%w, the destination %s should be removed", err, destinationPath)
+	}
+
+	log.Info("storage unit migrated",
+		"source", sourcePath,
+		"num keys", numKeys,
+		"duration", time.Since(startTime),
+	)
+
+	return nil
+}
+
+func openUnit(config cfg, dbType string, path string, maxBatchSize int) (storage.Persister, error) {
+	return storageUnit.NewDB(storageUnit.ArgDB{
+		DBType:            storageUnit.DBType(dbType),
+		Path:              path,
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      maxBatchSize,
+		MaxOpenFiles:      config.maxOpenFiles,
+	})
+}
+
+func copyKeys(sourceDB storage.Persister, destinationDB storage.Persister) (int, error) {
+	numKeys := 0
+	var errPut error
+	sourceDB.RangeKeys(func(key []byte, val []byte) bool {
+		errPut = destinationDB.Put(key, val)
+		if errPut != nil {
+			return false
+		}
+
+		numKeys++
+		if numKeys%progressLogInterval == 0 {
+			log.Info("migration in progress", "num keys", numKeys)
+		}
+
+		return true
+	})
+
+	return numKeys, errPut
+}
+
+// verifyKeys checks that the destination holds exactly the keys of the source, with the same values. As RangeKeys
+// stops silently on an iteration error, the keys of both units are counted again and compared with the number of
+// copied keys
+func verifyKeys(sourceDB storage.Persister, destinationDB storage.Persister, numCopiedKeys int) error {
+	numSourceKeys := 0
+	var errVerify error
+	sourceDB.RangeKeys(func(key []byte, val []byte) bool {
+		numSourceKeys++
+
+		destinationVal, errGet := destinationDB.Get(key)
+		if errGet != nil {
+			errVerify = fmt.Errorf("%w while reading back the key %s", errGet, hex.EncodeToString(key))
+			return false
+		}
+		if !bytes.Equal(val, destinationVal) {
+			errVerify = fmt.Errorf("%w for the key %s", errValueMismatch, hex.EncodeToString(key))
+			return false
+		}
+
+		return true
+	})
+	if errVerify != nil {
+		return errVerify
+	}
+
+	numDestinationKeys := 0
+	destinationDB.RangeKeys(func(_ []byte, _ []byte) bool {
+		numDestinationKeys++
+		return true
+	})
+
+	if numSourceKeys != numCopiedKeys || numDestinationKeys != numCopiedKeys {
+		return fmt.Errorf("%w: copied %d, source %d, destination %d",
+			errNumKeysMismatch, numCopiedKeys, numSourceKeys, numDestinationKeys)
+	}
+
+	return nil
+}
+
+func checkDestinationIsEmpty(path string) error {
+	entries, err := ioutil.ReadDir(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(entries) > 0 {
+		return fmt.Errorf("destination %s is not empty", path)
+	}
+
+	return nil
+}
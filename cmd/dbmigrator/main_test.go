@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestConfig() cfg {
+	return cfg{
+		sourceType:      string(storageUnit.LvlDBSerial),
+		destinationType: string(storageUnit.BadgerDB),
+		maxBatchSize:    3,
+		maxOpenFiles:    10,
+	}
+}
+
+func createLevelDBUnit(t *testing.T, config cfg, path string, numKeys int) map[string]string {
+	unit, err := openUnit(config, config.sourceType, path, 1)
+	require.Nil(t, err)
+
+	keys := make(map[string]string)
+	for i := 0; i < numKeys; i++ {
+		key, val := fmt.Sprintf("key%d", i), fmt.Sprintf("value%d", i)
+		require.Nil(t, unit.Put([]byte(key), []byte(val)))
+		keys[key] = val
+	}
+	require.Nil(t, unit.Close())
+
+	return keys
+}
+
+func TestMigrateUnit_ShouldCopyEveryKeyOfALevelDBUnitIntoBadger(t *testing.T) {
+	t.Parallel()
+
+	config := createTestConfig()
+	sourcePath := filepath.Join(t.TempDir(), "source")
+	destinationPath := filepath.Join(t.TempDir(), "destination")
+	keys := createLevelDBUnit(t, config, sourcePath, 10)
+
+	err := migrateUnit(config, sourcePath, destinationPath)
+	require.Nil(t, err)
+
+	destinationDB, err := openUnit(config, config.destinationType, destinationPath, 1)
+	require.Nil(t, err)
+	defer func() {
+		_ = destinationDB.Close()
+	}()
+
+	for key, val := range keys {
+		readVal, errGet := destinationDB.Get([]byte(key))
+		require.Nil(t, errGet)
+		require.Equal(t, []byte(val), readVal)
+	}
+
+	numKeys := 0
+	destinationDB.RangeKeys(func(_ []byte, _ []byte) bool {
+		numKeys++
+		return true
+	})
+	require.Equal(t, len(keys), numKeys)
+}
+
+func TestMigrateUnit_NotEmptyDestinationShouldError(t *testing.T) {
+	t.Parallel()
+
+	config := createTestConfig()
+	sourcePath := filepath.Join(t.TempDir(), "source")
+	destinationPath := filepath.Join(t.TempDir(), "destination")
+	_ = createLevelDBUnit(t, config, sourcePath, 1)
+	_ = createLevelDBUnit(t, config, destinationPath, 1)
+
+	err := migrateUnit(config, sourcePath, destinationPath)
+	require.NotNil(t, err)
+}
+
+func TestVerifyKeys(t *testing.T) {
+	t.Parallel()
+
+	t.Run("same keys should work", func(t *testing.T) {
+		t.Parallel()
+
+		source, destination := memorydb.New(), memorydb.New()
+		_ = source.Put([]byte("key"), []byte("value"))
+		_ = destination.Put([]byte("key"), []byte("value"))
+
+		require.Nil(t, verifyKeys(source, destination, 1))
+	})
+	t.Run("missing key should error", func(t *testing.T) {
+		t.Parallel()
+
+		source, destination := memorydb.New(), memorydb.New()
+		_ = source.Put([]byte("key"), []byte("value"))
+
+		require.NotNil(t, verifyKeys(source, destination, 1))
+	})
+	t.Run("different value should error", func(t *testing.T) {
+		t.Parallel()
+
+		source, destination := memorydb.New(), memorydb.New()
+		_ = source.Put([]byte("key"), []byte("value"))
+		_ = destination.Put([]byte("key"), []byte("other value"))
+
+		err := verifyKeys(source, destination, 1)
+		require.ErrorIs(t, err, errValueMismatch)
+	})
+	t.Run("extra key in the destination should error", func(t *testing.T) {
+		t.Parallel()
+
+		source, destination := memorydb.New(), memorydb.New()
+		_ = source.Put([]byte("key"), []byte("value"))
+		_ = destination.Put([]byte("key"), []byte("value"))
+		_ = destination.Put([]byte("extra"), []byte("value"))
+
+		err := verifyKeys(source, destination, 1)
+		require.ErrorIs(t, err, errNumKeysMismatch)
+	})
+	t.Run("keys not copied should error", func(t *testing.T) {
+		t.Parallel()
+
+		source, destination := memorydb.New(), memorydb.New()
+		_ = source.Put([]byte("key"), []byte("value"))
+		_ = destination.Put([]byte("key"), []byte("value"))
+
+		err := verifyKeys(source, destination, 2)
+		require.ErrorIs(t, err, errNumKeysMismatch)
+	})
+}
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// the names of the storage units, as set in the FilePath fields of config.toml
+const (
+	headersUnitName           = "BlockHeaders"
+	metaBlocksUnitName        = "MetaBlock"
+	miniBlocksUnitName        = "MiniBlocks"
+	transactionsUnitName      = "Transactions"
+	unsignedTxsUnitName       = "UnsignedTransactions"
+	rewardTransactionUnitName = "RewardTransactions"
+)
+
+// blocksVerifier checks that every block header saved by a node references miniblocks and transactions that are
+// also saved, and that the headers and the miniblocks are stored under their own hashes
+type blocksVerifier struct {
+	marshalizer  marshal.Marshalizer
+	hasher       hashing.Hasher
+	headers      *multiUnit
+	miniBlocks   *multiUnit
+	txUnits      map[block.Type]*multiUnit
+	isMetachain  bool
+	numHeaders   int
+	numProblems  int
+	maxProblems  int
+	openedUnits  []*multiUnit
+	maxOpenFiles int
+}
+
+func newBlocksVerifier(
+	units []*unitInfo,
+	shardID uint32,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	maxOpenFiles int,
+	maxProblems int,
+) (*blocksVerifier, error) {
+	bv := &blocksVerifier{
+		marshalizer:  marshalizer,
+		hasher:       hasher,
+		txUnits:      make(map[block.Type]*multiUnit),
+		isMetachain:  shardID == core.MetachainShardId,
+		maxProblems:  maxProblems,
+		maxOpenFiles: maxOpenFiles,
+	}
+
+	shard := core.GetShardIDString(shardID)
+	headersUnit := headersUnitName
+	if bv.isMetachain {
+		headersUnit = metaBlocksUnitName
+	}
+
+	var err error
+	bv.headers, err = bv.openUnits(units, headersUnit, shard)
+	if err != nil {
+		return nil, err
+	}
+	if len(bv.headers.persisters) == 0 {
+		_ = bv.close()
+		return nil, fmt.Errorf("no %s unit found for shard %s", headersUnit, shard)
+	}
+
+	bv.miniBlocks, err = bv.openUnits(units, miniBlocksUnitName, shard)
+	if err != nil {
+		return nil, err
+	}
+
+	txUnitNames := map[block.Type]string{
+		block.TxBlock:                  transactionsUnitName,
+		block.InvalidBlock:             transactionsUnitName,
+		block.SmartContractResultBlock: unsignedTxsUnitName,
+		block.RewardsBlock:             rewardTransactionUnitName,
+	}
+	for blockType, unitName := range txUnitNames {
+		bv.txUnits[blockType], err = bv.openUnits(units, unitName, shard)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return bv, nil
+}
+
+func (bv *blocksVerifier) openUnits(units []*unitInfo, name string, shard string) (*multiUnit, error) {
+	mu, err := openMultiUnit(selectUnits(units, name, shard), bv.maxOpenFiles)
+	if err != nil {
+		_ = bv.close()
+		return nil, err
+	}
+
+	bv.openedUnits = append(bv.openedUnits, mu)
+
+	return mu, nil
+}
+
+// verify checks all the stored headers and returns an error if any problem was found
+func (bv *blocksVerifier) verify() error {
+	bv.headers.RangeKeys(func(hash []byte, headerBytes []byte) bool {
+		bv.numHeaders++
+		bv.verifyHeader(hash, headerBytes)
+		if bv.numHeaders%progressLogInterval == 0 {
+			log.Info("verification in progress", "num headers", bv.numHeaders, "num problems", bv.numProblems)
+		}
+
+		return bv.maxProblems == 0 || bv.numProblems < bv.maxProblems
+	})
+
+	log.Info("blocks verification finished", "num headers", bv.numHeaders, "num problems", bv.numProblems)
+	if bv.numProblems > 0 {
+		return fmt.Errorf("found %d problems in the stored blocks", bv.numProblems)
+	}
+
+	return nil
+}
+
+func (bv *blocksVerifier) verifyHeader(hash []byte, headerBytes []byte) {
+	if !bytes.Equal(bv.hasher.Compute(string(headerBytes)), hash) {
+		bv.reportProblem("header stored under a different hash", "hash", hash)
+	}
+
+	var nonce uint64
+	var miniBlockHeaders []block.MiniBlockHeader
+	if bv.isMetachain {
+		metaBlock := &block.MetaBlock{}
+		err := bv.marshalizer.Unmarshal(metaBlock, headerBytes)
+		if err != nil {
+			bv.reportProblem("cannot decode header", "hash", hash, "error", err)
+			return
+		}
+		nonce, miniBlockHeaders = metaBlock.Nonce, metaBlock.MiniBlockHeaders
+	} else {
+		header := &block.Header{}
+		err := bv.marshalizer.Unmarshal(header, headerBytes)
+		if err != nil {
+			bv.reportProblem("cannot decode header", "hash", hash, "error", err)
+			return
+		}
+		nonce, miniBlockHeaders = header.Nonce, header.MiniBlockHeaders
+	}
+
+	for _, miniBlockHeader := range miniBlockHeaders {
+		bv.verifyMiniBlock(nonce, miniBlockHeader.Hash)
+	}
+}
+
+func (bv *blocksVerifier) verifyMiniBlock(nonce uint64, hash []byte) {
+	miniBlockBytes, err := bv.miniBlocks.Get(hash)
+	if errors.Is(err, storage.ErrKeyNotFound) {
+		bv.reportProblem("missing miniblock", "header nonce", nonce, "miniblock hash", hash)
+		return
+	}
+	if err != nil {
+		bv.reportProblem("cannot read miniblock", "header nonce", nonce, "miniblock hash", hash, "error", err)
+		return
+	}
+	if !bytes.Equal(bv.hasher.Compute(string(miniBlockBytes)), hash) {
+		bv.reportProblem("miniblock stored under a different hash", "header nonce", nonce, "miniblock hash", hash)
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = bv.marshalizer.Unmarshal(miniBlock, miniBlockBytes)
+	if err != nil {
+		bv.reportProblem("cannot decode miniblock", "header nonce", nonce, "miniblock hash", hash, "error", err)
+		return
+	}
+
+	txUnit, ok := bv.txUnits[miniBlock.Type]
+	if !ok {
+		return
+	}
+
+	for _, txHash := range miniBlock.TxHashes {
+		_, err = txUnit.Get(txHash)
+		if errors.Is(err, storage.ErrKeyNotFound) {
+			bv.reportProblem("missing transaction",
+				"header nonce", nonce,
+				"miniblock hash", hash,
+				"miniblock type", miniBlock.Type.String(),
+				"tx hash", txHash,
+			)
+			continue
+		}
+		if err != nil {
+			bv.reportProblem("cannot read transaction", "header nonce", nonce, "tx hash", txHash, "error", err)
+		}
+	}
+}
+
+func (bv *blocksVerifier) reportProblem(message string, args ...interface{}) {
+	bv.numProblems++
+	log.Warn(message, args...)
+}
+
+func (bv *blocksVerifier) close() error {
+	var lastErr error
+	for _, mu := range bv.openedUnits {
+		err := mu.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
@@ -0,0 +1,223 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/require"
+)
+
+type testBlocksStorage struct {
+	headers      *memoryUnit
+	miniBlocks   *memoryUnit
+	transactions *memoryUnit
+	scrs         *memoryUnit
+}
+
+// memoryUnit is an in-memory persister reporting the missing keys as the read-only persisters do
+type memoryUnit struct {
+	storage.Persister
+}
+
+func newMemoryUnit() *memoryUnit {
+	return &memoryUnit{Persister: memorydb.New()}
+}
+
+// Get returns storage.ErrKeyNotFound if the key is missing
+func (mu *memoryUnit) Get(key []byte) ([]byte, error) {
+	if mu.Has(key) != nil {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	return mu.Persister.Get(key)
+}
+
+func newMultiUnit(persisters ...readOnlyPersister) *multiUnit {
+	return &multiUnit{persisters: persisters}
+}
+
+func putWithHash(t *testing.T, persister *memoryUnit, object interface{}) []byte {
+	buff, err := internalMarshalizer.Marshal(object)
+	require.Nil(t, err)
+	hash := hasher.Compute(string(buff))
+	require.Nil(t, persister.Put(hash, buff))
+
+	return hash
+}
+
+// createTestBlocksStorage stores a shard header referencing a transactions miniblock with the transactions "tx1" and
+// "tx2" and a smart contract results miniblock with the result "scr1"
+func createTestBlocksStorage(t *testing.T) (*testBlocksStorage, *block.Header) {
+	blocksStorage := &testBlocksStorage{
+		headers:      newMemoryUnit(),
+		miniBlocks:   newMemoryUnit(),
+		transactions: newMemoryUnit(),
+		scrs:         newMemoryUnit(),
+	}
+
+	require.Nil(t, blocksStorage.transactions.Put([]byte("tx1"), []byte("tx")))
+	require.Nil(t, blocksStorage.transactions.Put([]byte("tx2"), []byte("tx")))
+	require.Nil(t, blocksStorage.scrs.Put([]byte("scr1"), []byte("scr")))
+	txMiniBlockHash := putWithHash(t, blocksStorage.miniBlocks, &block.MiniBlock{
+		Type:     block.TxBlock,
+		TxHashes: [][]byte{[]byte("tx1"), []byte("tx2")},
+	})
+	scrMiniBlockHash := putWithHash(t, blocksStorage.miniBlocks, &block.MiniBlock{
+		Type:     block.SmartContractResultBlock,
+		TxHashes: [][]byte{[]byte("scr1")},
+	})
+
+	header := &block.Header{
+		Nonce: 5,
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{Hash: txMiniBlockHash, Type: block.TxBlock},
+			{Hash: scrMiniBlockHash, Type: block.SmartContractResultBlock},
+		},
+	}
+	putWithHash(t, blocksStorage.headers, header)
+
+	return blocksStorage, header
+}
+
+func createTestBlocksVerifier(blocksStorage *testBlocksStorage) *blocksVerifier {
+	return &blocksVerifier{
+		marshalizer: internalMarshalizer,
+		hasher:      hasher,
+		headers:     newMultiUnit(blocksStorage.headers),
+		miniBlocks:  newMultiUnit(blocksStorage.miniBlocks),
+		txUnits: map[block.Type]*multiUnit{
+			block.TxBlock:                  newMultiUnit(blocksStorage.transactions),
+			block.SmartContractResultBlock: newMultiUnit(blocksStorage.scrs),
+		},
+	}
+}
+
+func TestBlocksVerifier_Verify(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                string
+		alterStorage        func(t *testing.T, blocksStorage *testBlocksStorage, header *block.Header)
+		expectedNumProblems int
+	}{
+		{
+			name:                "consistent blocks",
+			alterStorage:        func(_ *testing.T, _ *testBlocksStorage, _ *block.Header) {},
+			expectedNumProblems: 0,
+		},
+		{
+			name: "missing miniblock",
+			alterStorage: func(t *testing.T, blocksStorage *testBlocksStorage, header *block.Header) {
+				require.Nil(t, blocksStorage.miniBlocks.Remove(header.MiniBlockHeaders[1].Hash))
+			},
+			expectedNumProblems: 1,
+		},
+		{
+			name: "missing transaction",
+			alterStorage: func(t *testing.T, blocksStorage *testBlocksStorage, _ *block.Header) {
+				require.Nil(t, blocksStorage.transactions.Remove([]byte("tx2")))
+			},
+			expectedNumProblems: 1,
+		},
+		{
+			name: "missing smart contract result",
+			alterStorage: func(t *testing.T, blocksStorage *testBlocksStorage, _ *block.Header) {
+				require.Nil(t, blocksStorage.scrs.Remove([]byte("scr1")))
+			},
+			expectedNumProblems: 1,
+		},
+		{
+			name: "header stored under a wrong hash",
+			alterStorage: func(t *testing.T, blocksStorage *testBlocksStorage, header *block.Header) {
+				headerBytes, err := internalMarshalizer.Marshal(header)
+				require.Nil(t, err)
+				require.Nil(t, blocksStorage.headers.Put([]byte("wrong hash"), headerBytes))
+			},
+			expectedNumProblems: 1,
+		},
+		{
+			name: "miniblock stored under a wrong hash",
+			alterStorage: func(t *testing.T, blocksStorage *testBlocksStorage, header *block.Header) {
+				miniBlockBytes, err := internalMarshalizer.Marshal(&block.MiniBlock{Type: block.TxBlock})
+				require.Nil(t, err)
+				require.Nil(t, blocksStorage.miniBlocks.Put(header.MiniBlockHeaders[0].Hash, miniBlockBytes))
+			},
+			expectedNumProblems: 1,
+		},
+		{
+			name: "header which cannot be decoded",
+			alterStorage: func(t *testing.T, blocksStorage *testBlocksStorage, _ *block.Header) {
+				headerBytes := []byte("not a header")
+				require.Nil(t, blocksStorage.headers.Put(hasher.Compute(string(headerBytes)), headerBytes))
+			},
+			expectedNumProblems: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		blocksStorage, header := createTestBlocksStorage(t)
+		tt.alterStorage(t, blocksStorage, header)
+		bv := createTestBlocksVerifier(blocksStorage)
+
+		err := bv.verify()
+		require.Equal(t, tt.expectedNumProblems, bv.numProblems, tt.name)
+		require.Equal(t, tt.expectedNumProblems > 0, err != nil, tt.name)
+	}
+}
+
+func TestBlocksVerifier_VerifyShouldStopAtMaxProblems(t *testing.T) {
+	t.Parallel()
+
+	blocksStorage, _ := createTestBlocksStorage(t)
+	blocksStorage.headers = newMemoryUnit()
+	for i := 0; i < 3; i++ {
+		putWithHash(t, blocksStorage.headers, &block.Header{
+			Nonce:            uint64(i),
+			MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte{byte(i)}}},
+		})
+	}
+	bv := createTestBlocksVerifier(blocksStorage)
+	bv.maxProblems = 2
+
+	err := bv.verify()
+	require.NotNil(t, err)
+	require.Equal(t, 2, bv.numProblems)
+	require.Equal(t, 2, bv.numHeaders)
+}
+
+func TestBlocksVerifier_VerifyShouldSearchTheMiniBlocksInAllTheEpochs(t *testing.T) {
+	t.Parallel()
+
+	blocksStorage, header := createTestBlocksStorage(t)
+	olderEpochMiniBlocks := newMemoryUnit()
+	miniBlockBytes, err := blocksStorage.miniBlocks.Get(header.MiniBlockHeaders[1].Hash)
+	require.Nil(t, err)
+	require.Nil(t, olderEpochMiniBlocks.Put(header.MiniBlockHeaders[1].Hash, miniBlockBytes))
+	require.Nil(t, blocksStorage.miniBlocks.Remove(header.MiniBlockHeaders[1].Hash))
+	bv := createTestBlocksVerifier(blocksStorage)
+	bv.miniBlocks = newMultiUnit(blocksStorage.miniBlocks, olderEpochMiniBlocks)
+
+	err = bv.verify()
+	require.Nil(t, err)
+	require.Equal(t, 0, bv.numProblems)
+}
+
+func TestBlocksVerifier_VerifyMetachainHeaders(t *testing.T) {
+	t.Parallel()
+
+	blocksStorage, header := createTestBlocksStorage(t)
+	blocksStorage.headers = newMemoryUnit()
+	putWithHash(t, blocksStorage.headers, &block.MetaBlock{
+		Nonce:            header.Nonce,
+		MiniBlockHeaders: append(header.MiniBlockHeaders, block.MiniBlockHeader{Hash: []byte("missing")}),
+	})
+	bv := createTestBlocksVerifier(blocksStorage)
+	bv.isMetachain = true
+
+	err := bv.verify()
+	require.NotNil(t, err)
+	require.Equal(t, 1, bv.numProblems)
+	require.Equal(t, 1, bv.numHeaders)
+}
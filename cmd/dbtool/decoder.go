@@ -0,0 +1,239 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/batch"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/trie"
+)
+
+// the last byte of an encoded trie node holds the node type, with the values defined in the trie package
+const (
+	trieExtensionNode = iota
+	trieLeafNode
+	trieBranchNode
+)
+
+const rawType = "raw"
+
+// valueTypes maps the types accepted by the get command to the constructors of the stored objects
+var valueTypes = map[string]func() interface{}{
+	"header":    func() interface{} { return &block.Header{} },
+	"metablock": func() interface{} { return &block.MetaBlock{} },
+	"miniblock": func() interface{} { return &block.MiniBlock{} },
+	"tx":        func() interface{} { return &transaction.Transaction{} },
+	"scr":       func() interface{} { return &smartContractResult.SmartContractResult{} },
+	"reward":    func() interface{} { return &rewardTx.RewardTx{} },
+	"receipt":   func() interface{} { return &receipt.Receipt{} },
+	"receipts":  func() interface{} { return &batch.Batch{} },
+	"log":       func() interface{} { return &transaction.Log{} },
+	"account":   func() interface{} { return &state.UserAccountData{} },
+}
+
+func supportedValueTypes() string {
+	types := []string{rawType, "trienode"}
+	for valueType := range valueTypes {
+		types = append(types, valueType)
+	}
+	sort.Strings(types)
+
+	return strings.Join(types, ", ")
+}
+
+// decodeValue unmarshals a stored value as the provided type and returns it in a form that can be printed as JSON
+func decodeValue(marshalizer marshal.Marshalizer, valueType string, value []byte) (interface{}, error) {
+	switch valueType {
+	case rawType:
+		return hex.EncodeToString(value), nil
+	case "trienode":
+		return decodeTrieNode(marshalizer, value)
+	case "receipts":
+		return decodeReceipts(marshalizer, value)
+	}
+
+	createObject, ok := valueTypes[valueType]
+	if !ok {
+		return nil, fmt.Errorf("unknown value type %s, supported types: %s", valueType, supportedValueTypes())
+	}
+
+	obj := createObject()
+	err := marshalizer.Unmarshal(obj, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDisplayable(reflect.ValueOf(obj)), nil
+}
+
+// decodeReceipts decodes the batch saved in the receipts unit, which holds marshalized miniblocks
+func decodeReceipts(marshalizer marshal.Marshalizer, value []byte) (interface{}, error) {
+	receiptsBatch := &batch.Batch{}
+	err := marshalizer.Unmarshal(receiptsBatch, value)
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlocks := make([]interface{}, 0, len(receiptsBatch.Data))
+	for _, miniBlockBytes := range receiptsBatch.Data {
+		miniBlock := &block.MiniBlock{}
+		err = marshalizer.Unmarshal(miniBlock, miniBlockBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		miniBlocks = append(miniBlocks, toDisplayable(reflect.ValueOf(miniBlock)))
+	}
+
+	return miniBlocks, nil
+}
+
+// trieNode is the decoded form of an encoded trie node. Only the fields matching the node type are set
+type trieNode struct {
+	nodeType       string
+	key            []byte
+	value          []byte
+	childrenHashes [][]byte
+}
+
+func (tn *trieNode) displayable() interface{} {
+	childrenHashes := make([]string, 0, len(tn.childrenHashes))
+	for _, childHash := range tn.childrenHashes {
+		childrenHashes = append(childrenHashes, hex.EncodeToString(childHash))
+	}
+
+	return map[string]interface{}{
+		"Type":           tn.nodeType,
+		"Key":            hex.EncodeToString(tn.key),
+		"Value":          hex.EncodeToString(tn.value),
+		"ChildrenHashes": childrenHashes,
+	}
+}
+
+func unmarshalTrieNode(marshalizer marshal.Marshalizer, encodedNode []byte) (*trieNode, error) {
+	if len(encodedNode) == 0 {
+		return nil, trie.ErrInvalidEncoding
+	}
+
+	nodeType := encodedNode[len(encodedNode)-1]
+	encodedNode = encodedNode[:len(encodedNode)-1]
+
+	switch nodeType {
+	case trieExtensionNode:
+		en := &trie.CollapsedEn{}
+		err := marshalizer.Unmarshal(en, encodedNode)
+		if err != nil {
+			return nil, err
+		}
+
+		return &trieNode{nodeType: "extension", key: en.Key, childrenHashes: [][]byte{en.EncodedChild}}, nil
+	case trieLeafNode:
+		ln := &trie.CollapsedLn{}
+		err := marshalizer.Unmarshal(ln, encodedNode)
+		if err != nil {
+			return nil, err
+		}
+
+		return &trieNode{nodeType: "leaf", key: ln.Key, value: ln.Value}, nil
+	case trieBranchNode:
+		bn := &trie.CollapsedBn{}
+		err := marshalizer.Unmarshal(bn, encodedNode)
+		if err != nil {
+			return nil, err
+		}
+
+		childrenHashes := make([][]byte, 0, len(bn.EncodedChildren))
+		for _, childHash := range bn.EncodedChildren {
+			if len(childHash) > 0 {
+				childrenHashes = append(childrenHashes, childHash)
+			}
+		}
+
+		return &trieNode{nodeType: "branch", childrenHashes: childrenHashes}, nil
+	default:
+		return nil, trie.ErrInvalidNode
+	}
+}
+
+func decodeTrieNode(marshalizer marshal.Marshalizer, encodedNode []byte) (interface{}, error) {
+	tn, err := unmarshalTrieNode(marshalizer, encodedNode)
+	if err != nil {
+		return nil, err
+	}
+
+	return tn.displayable(), nil
+}
+
+// toDisplayable converts the decoded objects in maps and slices that are printed as JSON with the byte slices in hex
+// and the big integers in base 10
+func toDisplayable(value reflect.Value) interface{} {
+	if value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil
+		}
+		if bigInt, ok := value.Interface().(*big.Int); ok {
+			return bigInt.String()
+		}
+
+		return toDisplayable(value.Elem())
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		fields := make(map[string]interface{})
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if field.PkgPath != "" || strings.HasPrefix(field.Name, "XXX_") {
+				continue
+			}
+
+			fields[field.Name] = toDisplayable(value.Field(i))
+		}
+
+		return fields
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return hex.EncodeToString(value.Bytes())
+		}
+
+		elements := make([]interface{}, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			elements = append(elements, toDisplayable(value.Index(i)))
+		}
+
+		return elements
+	case reflect.Map:
+		entries := make(map[string]interface{})
+		iterator := value.MapRange()
+		for iterator.Next() {
+			entries[fmt.Sprintf("%v", toDisplayable(iterator.Key()))] = toDisplayable(iterator.Value())
+		}
+
+		return entries
+	default:
+		return value.Interface()
+	}
+}
+
+func printJSON(obj interface{}) error {
+	buff, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(buff))
+
+	return nil
+}
@@ -0,0 +1,467 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"text/tabwriter"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/snapshot"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/urfave/cli"
+)
+
+const (
+	progressLogInterval = 100000
+	addressLength       = 32
+)
+
+type cfg struct {
+	dbPath         string
+	unitPath       string
+	unitName       string
+	shard          string
+	key            string
+	valueType      string
+	rootHash       string
+	checkDataTries bool
+	maxOpenFiles   int
+	maxProblems    int
+	epoch          uint64
+	snapshotFile   string
+	numLargest     int
+	csvFile        string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}{{if .Commands}} command [command options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// dbPath defines a flag for setting the path of the db directory of a node
+	dbPath = cli.StringFlag{
+		Name:        "db",
+		Usage:       "The path of the db directory of a node, the one holding the Epoch_* and Static folders",
+		Destination: &argsConfig.dbPath,
+	}
+	// unitPath defines a flag for setting the path of a single storage unit
+	unitPath = cli.StringFlag{
+		Name:        "path",
+		Usage:       "The path of a single storage unit, for example db/1/Epoch_3/Shard_0/MiniBlocks",
+		Destination: &argsConfig.unitPath,
+	}
+	// unitName defines a flag for setting the name of the storage unit holding the trie nodes
+	unitName = cli.StringFlag{
+		Name:        "unit",
+		Usage:       "The name of the storage unit holding the trie nodes, searched in all the epoch folders",
+		Value:       "AccountsTrie/MainDB",
+		Destination: &argsConfig.unitName,
+	}
+	// shard defines a flag for setting the shard of the inspected units
+	shard = cli.StringFlag{
+		Name:        "shard",
+		Usage:       "The shard of the inspected storage units, as a number or metachain",
+		Value:       "0",
+		Destination: &argsConfig.shard,
+	}
+	// key defines a flag for setting the key to be read
+	key = cli.StringFlag{
+		Name:        "key",
+		Usage:       "The hex encoded key to be read",
+		Destination: &argsConfig.key,
+	}
+	// valueType defines a flag for setting the type used to decode the read value
+	valueType = cli.StringFlag{
+		Name:        "type",
+		Usage:       "The type used to decode the value. One of: " + supportedValueTypes(),
+		Value:       rawType,
+		Destination: &argsConfig.valueType,
+	}
+	// rootHash defines a flag for setting the root hash of the checked trie
+	rootHash = cli.StringFlag{
+		Name:        "root-hash",
+		Usage:       "The hex encoded root hash of the trie to be checked",
+		Destination: &argsConfig.rootHash,
+	}
+	// checkDataTries defines a flag that enables checking the data tries of the accounts
+	checkDataTries = cli.BoolFlag{
+		Name:        "data-tries",
+		Usage:       "Boolean option that will decode the leaves as accounts and will also check their data tries",
+		Destination: &argsConfig.checkDataTries,
+	}
+	// maxOpenFiles defines a flag for setting the maximum number of files kept open by LevelDB units
+	maxOpenFiles = cli.IntFlag{
+		Name:        "max-open-files",
+		Usage:       "The maximum number of files a LevelDB storage unit will keep open",
+		Value:       10,
+		Destination: &argsConfig.maxOpenFiles,
+	}
+	// maxProblems defines a flag for setting after how many problems a verification stops
+	maxProblems = cli.IntFlag{
+		Name:        "max-problems",
+		Usage:       "The number of problems after which a verification stops. 0 means the whole data is verified",
+		Value:       100,
+		Destination: &argsConfig.maxProblems,
+	}
+	// epoch defines a flag for setting the epoch of the exported state snapshot
+	epoch = cli.Uint64Flag{
+		Name:        "epoch",
+		Usage:       "The epoch whose start of epoch state is exported",
+		Destination: &argsConfig.epoch,
+	}
+	// snapshotFile defines a flag for setting the path of the state snapshot archive
+	snapshotFile = cli.StringFlag{
+		Name:        "file",
+		Usage:       "The path of the state snapshot archive. An exported archive must not exist yet",
+		Destination: &argsConfig.snapshotFile,
+	}
+	// numLargest defines a flag for setting how many of the largest data tries are reported
+	numLargest = cli.IntFlag{
+		Name:        "top",
+		Usage:       "The number of accounts with the largest data tries to be reported",
+		Value:       20,
+		Destination: &argsConfig.numLargest,
+	}
+	// csvFile defines a flag for setting the path of the CSV file holding the statistics of every data trie
+	csvFile = cli.StringFlag{
+		Name:        "csv",
+		Usage:       "Optional path of a new CSV file in which the statistics of every data trie are written",
+		Destination: &argsConfig.csvFile,
+	}
+
+	argsConfig = &cfg{}
+
+	internalMarshalizer = &marshal.GogoProtoMarshalizer{}
+	hasher              = blake2b.NewBlake2b()
+	addressConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(addressLength, log)
+
+	log = logger.GetOrCreate("dbtool")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "DB inspection Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary will inspect, offline and read-only, the storage units of a node and can recover damaged LevelDB units"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		maxOpenFiles,
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:   "units",
+			Usage:  "lists the storage units found in the db directory, with their epochs and shards",
+			Flags:  []cli.Flag{dbPath},
+			Action: listUnits,
+		},
+		{
+			Name:   "count",
+			Usage:  "counts the keys of a storage unit",
+			Flags:  []cli.Flag{unitPath},
+			Action: countKeys,
+		},
+		{
+			Name:   "get",
+			Usage:  "prints the value stored under a key, decoded as JSON",
+			Flags:  []cli.Flag{unitPath, key, valueType},
+			Action: getValue,
+		},
+		{
+			Name:   "verify-blocks",
+			Usage:  "verifies that every stored block header references stored miniblocks and transactions",
+			Flags:  []cli.Flag{dbPath, shard, maxProblems},
+			Action: verifyBlocks,
+		},
+		{
+			Name:   "check-trie",
+			Usage:  "verifies that all the nodes of a trie are stored and valid, starting from its root hash",
+			Flags:  []cli.Flag{dbPath, unitName, shard, rootHash, checkDataTries, maxProblems},
+			Action: checkTrie,
+		},
+		{
+			Name:   "trie-statistics",
+			Usage:  "prints the node counts, depth histograms and sizes of an accounts trie and of its data tries, with the largest data tries",
+			Flags:  []cli.Flag{dbPath, unitName, shard, rootHash, numLargest, csvFile},
+			Action: printTrieStatistics,
+		},
+		{
+			Name:   "export-snapshot",
+			Usage:  "exports the tries of a shard at the start of an epoch in a state snapshot archive, to be imported by a node",
+			Flags:  []cli.Flag{dbPath, shard, epoch, snapshotFile},
+			Action: exportSnapshot,
+		},
+		{
+			Name:   "verify-snapshot",
+			Usage:  "verifies the hashes of all the entries of a state snapshot archive and prints its manifest",
+			Flags:  []cli.Flag{snapshotFile},
+			Action: verifySnapshot,
+		},
+		{
+			Name:   "recover",
+			Usage:  "rebuilds the manifest of a damaged LevelDB storage unit. This is the only command writing data",
+			Flags:  []cli.Flag{unitPath},
+			Action: recoverUnit,
+		},
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error inspecting the database", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func listUnits(_ *cli.Context) error {
+	units, err := findDBUnits()
+	if err != nil {
+		return err
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "UNIT\tSHARD\tLOCATION\tTYPE\tPATH")
+	for _, ui := range units {
+		_, _ = fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", ui.name, ui.shard, ui.location(), ui.dbType, ui.path)
+	}
+
+	return writer.Flush()
+}
+
+func countKeys(_ *cli.Context) error {
+	persister, err := openUnitFromPath()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = persister.Close()
+	}()
+
+	numKeys := 0
+	persister.RangeKeys(func(_ []byte, _ []byte) bool {
+		numKeys++
+		if numKeys%progressLogInterval == 0 {
+			log.Info("counting in progress", "num keys", numKeys)
+		}
+
+		return true
+	})
+
+	fmt.Println(numKeys)
+
+	return nil
+}
+
+func getValue(_ *cli.Context) error {
+	keyBytes, err := hex.DecodeString(argsConfig.key)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the key", err)
+	}
+
+	persister, err := openUnitFromPath()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = persister.Close()
+	}()
+
+	value, err := persister.Get(keyBytes)
+	if err != nil {
+		return err
+	}
+
+	decoded, err := decodeValue(internalMarshalizer, argsConfig.valueType, value)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the value as %s", err, argsConfig.valueType)
+	}
+
+	return printJSON(decoded)
+}
+
+func verifyBlocks(_ *cli.Context) error {
+	units, err := findDBUnits()
+	if err != nil {
+		return err
+	}
+	shardID, err := core.ConvertShardIDToUint32(argsConfig.shard)
+	if err != nil {
+		return err
+	}
+
+	verifier, err := newBlocksVerifier(units, shardID, internalMarshalizer, hasher, argsConfig.maxOpenFiles, argsConfig.maxProblems)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = verifier.close()
+	}()
+
+	return verifier.verify()
+}
+
+func checkTrie(_ *cli.Context) error {
+	rootHashBytes, err := hex.DecodeString(argsConfig.rootHash)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the root hash", err)
+	}
+	if len(rootHashBytes) == 0 {
+		return errors.New("the root hash should be provided")
+	}
+
+	db, err := openTrieUnits()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	checker := newTrieChecker(db, internalMarshalizer, hasher, argsConfig.checkDataTries, argsConfig.maxProblems)
+
+	return checker.check(rootHashBytes)
+}
+
+func printTrieStatistics(_ *cli.Context) error {
+	rootHashBytes, err := hex.DecodeString(argsConfig.rootHash)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the root hash", err)
+	}
+	if len(rootHashBytes) == 0 {
+		return errors.New("the root hash should be provided")
+	}
+
+	db, err := openTrieUnits()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	collector := newTrieStatisticsCollector(db, internalMarshalizer, hasher, addressConverter, argsConfig.numLargest)
+	stats, err := collector.collect(rootHashBytes, argsConfig.csvFile)
+	if err != nil {
+		return err
+	}
+
+	return printJSON(stats)
+}
+
+func exportSnapshot(_ *cli.Context) error {
+	if len(argsConfig.snapshotFile) == 0 {
+		return errors.New("the path of the state snapshot archive should be provided")
+	}
+
+	units, err := findDBUnits()
+	if err != nil {
+		return err
+	}
+	shardID, err := core.ConvertShardIDToUint32(argsConfig.shard)
+	if err != nil {
+		return err
+	}
+
+	exporter := newSnapshotExporter(units, shardID, uint32(argsConfig.epoch), internalMarshalizer, hasher, argsConfig.maxOpenFiles)
+
+	return exporter.export(argsConfig.snapshotFile)
+}
+
+func verifySnapshot(_ *cli.Context) error {
+	manifest, err := snapshot.VerifyArchive(argsConfig.snapshotFile, internalMarshalizer, hasher)
+	if err != nil {
+		return err
+	}
+
+	return printJSON(toDisplayable(reflect.ValueOf(manifest)))
+}
+
+func recoverUnit(_ *cli.Context) error {
+	if len(argsConfig.unitPath) == 0 {
+		return errors.New("the path of the storage unit should be provided")
+	}
+
+	err := checkRecoverableUnit(argsConfig.unitPath)
+	if err != nil {
+		return err
+	}
+
+	log.Info("recovering storage unit", "path", argsConfig.unitPath)
+	db, err := leveldb.RecoverFile(argsConfig.unitPath, nil)
+	if err != nil {
+		return err
+	}
+
+	log.Info("storage unit recovered", "path", argsConfig.unitPath)
+
+	return db.Close()
+}
+
+func findDBUnits() ([]*unitInfo, error) {
+	if len(argsConfig.dbPath) == 0 {
+		return nil, errors.New("the path of the db directory should be provided")
+	}
+
+	units, err := findUnits(argsConfig.dbPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(units) == 0 {
+		return nil, fmt.Errorf("no storage unit found in %s", argsConfig.dbPath)
+	}
+
+	return units, nil
+}
+
+func openTrieUnits() (*multiUnit, error) {
+	units, err := findDBUnits()
+	if err != nil {
+		return nil, err
+	}
+
+	trieUnits := selectUnits(units, argsConfig.unitName, argsConfig.shard)
+	if len(trieUnits) == 0 {
+		return nil, fmt.Errorf("no %s unit found for shard %s", argsConfig.unitName, argsConfig.shard)
+	}
+
+	return openMultiUnit(trieUnits, argsConfig.maxOpenFiles)
+}
+
+func openUnitFromPath() (readOnlyPersister, error) {
+	if len(argsConfig.unitPath) == 0 {
+		return nil, errors.New("the path of the storage unit should be provided")
+	}
+
+	dbType, isUnit := detectDBType(argsConfig.unitPath)
+	if !isUnit {
+		return nil, fmt.Errorf("%s is not a LevelDB or a BadgerDB storage unit", argsConfig.unitPath)
+	}
+
+	return openReadOnly(argsConfig.unitPath, dbType, argsConfig.maxOpenFiles)
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+// readOnlyPersister is the subset of storage.Persister needed to inspect a storage unit without altering it
+type readOnlyPersister interface {
+	Get(key []byte) ([]byte, error)
+	RangeKeys(handler func(key []byte, value []byte) bool)
+	Close() error
+}
+
+// openReadOnly opens the storage unit found at the provided path without allowing any write, so a damaged unit is
+// not made worse by the inspection. The regular persisters always open the units in read-write mode
+func openReadOnly(path string, dbType storageUnit.DBType, maxOpenFiles int) (readOnlyPersister, error) {
+	switch dbType {
+	case storageUnit.LvlDB, storageUnit.LvlDBSerial:
+		db, err := leveldb.OpenFile(path, &opt.Options{
+			ReadOnly:               true,
+			ErrorIfMissing:         true,
+			OpenFilesCacheCapacity: maxOpenFiles,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%w for path %s", err, path)
+		}
+
+		return &readOnlyLevelDB{db: db}, nil
+	case storageUnit.BadgerDB:
+		db, err := badger.Open(badger.DefaultOptions(path).WithReadOnly(true).WithLogger(nil))
+		if err != nil {
+			return nil, fmt.Errorf("%w for path %s", err, path)
+		}
+
+		return &readOnlyBadgerDB{db: db}, nil
+	default:
+		return nil, storage.ErrNotSupportedDBType
+	}
+}
+
+type readOnlyLevelDB struct {
+	db *leveldb.DB
+}
+
+// Get returns the value associated with the key
+func (ro *readOnlyLevelDB) Get(key []byte) ([]byte, error) {
+	value, err := ro.db.Get(key, nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	return value, err
+}
+
+// RangeKeys iterates over all the (key, value) pairs, stopping when the handler returns false
+func (ro *readOnlyLevelDB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	iterator := ro.db.NewIterator(nil, nil)
+	defer iterator.Release()
+
+	for iterator.Next() {
+		key := append([]byte{}, iterator.Key()...)
+		value := append([]byte{}, iterator.Value()...)
+		if !handler(key, value) {
+			return
+		}
+	}
+}
+
+// Close closes the underlying LevelDB
+func (ro *readOnlyLevelDB) Close() error {
+	return ro.db.Close()
+}
+
+type readOnlyBadgerDB struct {
+	db *badger.DB
+}
+
+// Get returns the value associated with the key
+func (ro *readOnlyBadgerDB) Get(key []byte) ([]byte, error) {
+	var value []byte
+	err := ro.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(key)
+		if err != nil {
+			return err
+		}
+
+		value, err = item.ValueCopy(nil)
+		return err
+	})
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	return value, err
+}
+
+// RangeKeys iterates over all the (key, value) pairs, stopping when the handler returns false
+func (ro *readOnlyBadgerDB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	_ = ro.db.View(func(txn *badger.Txn) error {
+		iterator := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer iterator.Close()
+
+		for iterator.Rewind(); iterator.Valid(); iterator.Next() {
+			item := iterator.Item()
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				log.Warn("cannot read value", "key", item.KeyCopy(nil), "error", err)
+				continue
+			}
+			if !handler(item.KeyCopy(nil), value) {
+				return nil
+			}
+		}
+
+		return nil
+	})
+}
+
+// Close closes the underlying BadgerDB
+func (ro *readOnlyBadgerDB) Close() error {
+	return ro.db.Close()
+}
+
+// multiUnit searches a key in several units of the same kind, in order, the way a pruning storer searches in its
+// epoch persisters
+type multiUnit struct {
+	persisters []readOnlyPersister
+}
+
+func openMultiUnit(units []*unitInfo, maxOpenFiles int) (*multiUnit, error) {
+	mu := &multiUnit{
+		persisters: make([]readOnlyPersister, 0, len(units)),
+	}
+	for _, ui := range units {
+		persister, err := openReadOnly(ui.path, ui.dbType, maxOpenFiles)
+		if err != nil {
+			_ = mu.Close()
+			return nil, err
+		}
+
+		mu.persisters = append(mu.persisters, persister)
+	}
+
+	return mu, nil
+}
+
+// Get returns the value found in the first unit holding the key
+func (mu *multiUnit) Get(key []byte) ([]byte, error) {
+	for _, persister := range mu.persisters {
+		value, err := persister.Get(key)
+		if errors.Is(err, storage.ErrKeyNotFound) {
+			continue
+		}
+
+		return value, err
+	}
+
+	return nil, storage.ErrKeyNotFound
+}
+
+// RangeKeys iterates over the (key, value) pairs of all the units
+func (mu *multiUnit) RangeKeys(handler func(key []byte, value []byte) bool) {
+	shouldContinue := true
+	for _, persister := range mu.persisters {
+		persister.RangeKeys(func(key []byte, value []byte) bool {
+			shouldContinue = handler(key, value)
+			return shouldContinue
+		})
+		if !shouldContinue {
+			return
+		}
+	}
+}
+
+// Close closes all the units
+func (mu *multiUnit) Close() error {
+	var lastErr error
+	for _, persister := range mu.persisters {
+		err := persister.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
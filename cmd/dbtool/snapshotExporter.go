@@ -0,0 +1,230 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/snapshot"
+)
+
+// the names of the storage units holding the bootstrap data and the tries
+const (
+	bootstrapUnitName        = "BootstrapData"
+	userAccountsTrieUnitName = "AccountsTrie/MainDB"
+	peerAccountsTrieUnitName = "PeerAccountsTrie/MainDB"
+)
+
+// snapshotExporter writes, in a state snapshot archive, the tries of a shard at the start of an epoch together with
+// the headers needed to verify their root hashes
+type snapshotExporter struct {
+	units        []*unitInfo
+	shardID      uint32
+	shard        string
+	epoch        uint32
+	marshalizer  marshal.Marshalizer
+	hasher       hashing.Hasher
+	maxOpenFiles int
+	writer       snapshotWriter
+}
+
+// snapshotWriter is the part of the state snapshot archive writer used by the exporter
+type snapshotWriter interface {
+	AddEntry(entryType snapshot.EntryType, key []byte, value []byte) error
+}
+
+func newSnapshotExporter(
+	units []*unitInfo,
+	shardID uint32,
+	epoch uint32,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	maxOpenFiles int,
+) *snapshotExporter {
+	return &snapshotExporter{
+		units:        units,
+		shardID:      shardID,
+		shard:        core.GetShardIDString(shardID),
+		epoch:        epoch,
+		marshalizer:  marshalizer,
+		hasher:       hasher,
+		maxOpenFiles: maxOpenFiles,
+	}
+}
+
+// export creates the archive at the provided path. The archive is removed if any problem is found, so an existing
+// archive is always complete
+func (se *snapshotExporter) export(outputPath string) error {
+	w, err := snapshot.NewWriter(outputPath)
+	if err != nil {
+		return err
+	}
+	se.writer = w
+
+	manifest, err := se.exportEntries()
+	if err != nil {
+		_ = w.Discard()
+		return err
+	}
+
+	err = w.Close(manifest)
+	if err != nil {
+		return err
+	}
+
+	log.Info("state snapshot exported",
+		"file", outputPath,
+		"epoch", manifest.Epoch,
+		"shard", se.shard,
+		"epoch start meta block hash", manifest.EpochStartMetaBlockHash,
+		"user accounts root hash", manifest.UserAccountsRootHash,
+		"peer accounts root hash", manifest.PeerAccountsRootHash,
+	)
+
+	return nil
+}
+
+func (se *snapshotExporter) exportEntries() (*snapshot.Manifest, error) {
+	epochStartMetaBlock, epochStartMetaBlockHash, err := se.exportEpochStartMetaBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &snapshot.Manifest{
+		ChainID:                 string(epochStartMetaBlock.ChainID),
+		Epoch:                   se.epoch,
+		ShardID:                 se.shardID,
+		EpochStartMetaBlockHash: epochStartMetaBlockHash,
+	}
+
+	err = se.exportNodesCoordinatorRegistry(epochStartMetaBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	if se.shardID == core.MetachainShardId {
+		manifest.UserAccountsRootHash = epochStartMetaBlock.RootHash
+		manifest.PeerAccountsRootHash = epochStartMetaBlock.ValidatorStatsRootHash
+	} else {
+		manifest.UserAccountsRootHash, err = se.exportShardHeader(epochStartMetaBlock)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = se.exportTrie(userAccountsTrieUnitName, manifest.UserAccountsRootHash, snapshot.UserAccountsTrieNodeEntry, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(manifest.PeerAccountsRootHash) == 0 {
+		return manifest, nil
+	}
+
+	err = se.exportTrie(peerAccountsTrieUnitName, manifest.PeerAccountsRootHash, snapshot.PeerAccountsTrieNodeEntry, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func (se *snapshotExporter) exportEpochStartMetaBlock() (*block.MetaBlock, []byte, error) {
+	metaBlockBytes, err := se.getFromUnits(metaBlocksUnitName, []byte(core.EpochStartIdentifier(se.epoch)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w while reading the start of epoch %d meta block", err, se.epoch)
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = se.marshalizer.Unmarshal(metaBlock, metaBlockBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !metaBlock.IsStartOfEpochBlock() || metaBlock.Epoch != se.epoch {
+		return nil, nil, fmt.Errorf("the stored meta block is not the start of epoch %d", se.epoch)
+	}
+
+	metaBlockHash := se.hasher.Compute(string(metaBlockBytes))
+	err = se.writer.AddEntry(snapshot.MetaBlockEntry, metaBlockHash, metaBlockBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return metaBlock, metaBlockHash, nil
+}
+
+func (se *snapshotExporter) exportShardHeader(epochStartMetaBlock *block.MetaBlock) ([]byte, error) {
+	for _, shardData := range epochStartMetaBlock.EpochStart.LastFinalizedHeaders {
+		if shardData.ShardID != se.shardID {
+			continue
+		}
+
+		headerBytes, err := se.getFromUnits(headersUnitName, shardData.HeaderHash)
+		if err != nil {
+			return nil, fmt.Errorf("%w while reading the shard header %x", err, shardData.HeaderHash)
+		}
+
+		header := &block.Header{}
+		err = se.marshalizer.Unmarshal(header, headerBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		err = se.writer.AddEntry(snapshot.ShardHeaderEntry, shardData.HeaderHash, headerBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		return header.RootHash, nil
+	}
+
+	return nil, fmt.Errorf("the start of epoch %d meta block has no data for shard %s", se.epoch, se.shard)
+}
+
+func (se *snapshotExporter) exportNodesCoordinatorRegistry(epochStartMetaBlock *block.MetaBlock) error {
+	registryKey := append([]byte(common.NodesCoordinatorRegistryKeyPrefix), epochStartMetaBlock.PrevRandSeed...)
+	registryBytes, err := se.getFromUnits(bootstrapUnitName, registryKey)
+	if err != nil {
+		log.Warn("the nodes coordinator registry is not exported", "error", err)
+		return nil
+	}
+
+	return se.writer.AddEntry(snapshot.NodesCoordinatorRegistryEntry, registryKey, registryBytes)
+}
+
+func (se *snapshotExporter) exportTrie(unitName string, rootHash []byte, entryType snapshot.EntryType, withDataTries bool) error {
+	trieUnits := selectUnits(se.units, unitName, se.shard)
+	if len(trieUnits) == 0 {
+		return fmt.Errorf("no %s unit found for shard %s", unitName, se.shard)
+	}
+
+	db, err := openMultiUnit(trieUnits, se.maxOpenFiles)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	// the export stops at the first problem, a partial trie is useless to the importing node
+	checker := newTrieChecker(db, se.marshalizer, se.hasher, withDataTries, 1)
+	checker.nodeHandler = func(hash []byte, encodedNode []byte) error {
+		return se.writer.AddEntry(entryType, hash, encodedNode)
+	}
+
+	return checker.check(rootHash)
+}
+
+func (se *snapshotExporter) getFromUnits(unitName string, key []byte) ([]byte, error) {
+	db, err := openMultiUnit(selectUnits(se.units, unitName, se.shard), se.maxOpenFiles)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	return db.Get(key)
+}
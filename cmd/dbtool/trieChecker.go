@@ -0,0 +1,162 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/trie"
+)
+
+// trieChecker walks a trie from its root hash and checks that every node is present in storage, can be decoded and
+// is stored under its own hash. Optionally, the leaves are decoded as accounts and their data tries are walked too
+type trieChecker struct {
+	db              readOnlyPersister
+	marshalizer     marshal.Marshalizer
+	hasher          hashing.Hasher
+	checkDataTries  bool
+	maxProblems     int
+	numNodes        int
+	numLeaves       int
+	numDataTries    int
+	numProblems     int
+	checkedDataRoot map[string]struct{}
+	// nodeHandler, if set, is called for every valid node found while walking the tries
+	nodeHandler func(hash []byte, encodedNode []byte) error
+}
+
+func newTrieChecker(
+	db readOnlyPersister,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	checkDataTries bool,
+	maxProblems int,
+) *trieChecker {
+	return &trieChecker{
+		db:              db,
+		marshalizer:     marshalizer,
+		hasher:          hasher,
+		checkDataTries:  checkDataTries,
+		maxProblems:     maxProblems,
+		checkedDataRoot: make(map[string]struct{}),
+	}
+}
+
+// check walks the trie having the provided root hash and returns an error if any problem was found
+func (tc *trieChecker) check(rootHash []byte) error {
+	tc.walk(rootHash, tc.checkDataTries)
+
+	log.Info("trie check finished",
+		"root hash", rootHash,
+		"num nodes", tc.numNodes,
+		"num leaves", tc.numLeaves,
+		"num data tries", tc.numDataTries,
+		"num problems", tc.numProblems,
+	)
+	if tc.numProblems > 0 {
+		return fmt.Errorf("found %d problems in the trie with root hash %x", tc.numProblems, rootHash)
+	}
+
+	return nil
+}
+
+// walk traverses the trie depth first, with an explicit stack of hashes to be visited
+func (tc *trieChecker) walk(rootHash []byte, leavesAreAccounts bool) {
+	if bytes.Equal(rootHash, trie.EmptyTrieHash) {
+		return
+	}
+
+	hashes := [][]byte{rootHash}
+	for len(hashes) > 0 {
+		if tc.hasReachedMaxProblems() {
+			return
+		}
+
+		hash := hashes[len(hashes)-1]
+		hashes = hashes[:len(hashes)-1]
+
+		node, ok := tc.getNode(hash)
+		if !ok {
+			continue
+		}
+
+		hashes = append(hashes, node.childrenHashes...)
+		if node.nodeType == "leaf" {
+			tc.numLeaves++
+			if leavesAreAccounts {
+				tc.checkAccountDataTrie(hash, node.value)
+			}
+		}
+
+		if tc.numNodes%progressLogInterval == 0 {
+			log.Info("trie check in progress", "num nodes", tc.numNodes, "num problems", tc.numProblems)
+		}
+	}
+}
+
+func (tc *trieChecker) getNode(hash []byte) (*trieNode, bool) {
+	tc.numNodes++
+
+	encodedNode, err := tc.db.Get(hash)
+	if errors.Is(err, storage.ErrKeyNotFound) {
+		tc.reportProblem("missing trie node", "hash", hash)
+		return nil, false
+	}
+	if err != nil {
+		tc.reportProblem("cannot read trie node", "hash", hash, "error", err)
+		return nil, false
+	}
+	if !bytes.Equal(tc.hasher.Compute(string(encodedNode)), hash) {
+		tc.reportProblem("trie node stored under a different hash", "hash", hash)
+	}
+
+	node, err := unmarshalTrieNode(tc.marshalizer, encodedNode)
+	if err != nil {
+		tc.reportProblem("cannot decode trie node", "hash", hash, "error", err)
+		return nil, false
+	}
+
+	if tc.nodeHandler != nil {
+		err = tc.nodeHandler(hash, encodedNode)
+		if err != nil {
+			tc.reportProblem("cannot handle trie node", "hash", hash, "error", err)
+		}
+	}
+
+	return node, true
+}
+
+func (tc *trieChecker) checkAccountDataTrie(leafHash []byte, value []byte) {
+	account := &state.UserAccountData{}
+	err := tc.marshalizer.Unmarshal(account, value)
+	if err != nil {
+		tc.reportProblem("cannot decode account", "leaf hash", leafHash, "error", err)
+		return
+	}
+	if len(account.RootHash) == 0 {
+		return
+	}
+
+	// identical data tries have the same root hash, so each one is walked only once
+	_, isChecked := tc.checkedDataRoot[string(account.RootHash)]
+	if isChecked {
+		return
+	}
+	tc.checkedDataRoot[string(account.RootHash)] = struct{}{}
+
+	tc.numDataTries++
+	tc.walk(account.RootHash, false)
+}
+
+func (tc *trieChecker) hasReachedMaxProblems() bool {
+	return tc.maxProblems > 0 && tc.numProblems >= tc.maxProblems
+}
+
+func (tc *trieChecker) reportProblem(message string, args ...interface{}) {
+	tc.numProblems++
+	log.Warn(message, args...)
+}
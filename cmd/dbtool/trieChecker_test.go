@@ -0,0 +1,201 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/require"
+)
+
+type testAccountsTrie struct {
+	db           *memoryUnit
+	rootHash     []byte
+	dataRootHash []byte
+	leafHash     []byte
+}
+
+func createTrie(t *testing.T, db *memoryUnit, keysValues map[string][]byte) []byte {
+	storageManager, err := trie.NewTrieStorageManagerWithoutPruning(db)
+	require.Nil(t, err)
+	tr, err := trie.NewTrie(storageManager, internalMarshalizer, hasher, 5)
+	require.Nil(t, err)
+
+	for key, value := range keysValues {
+		require.Nil(t, tr.Update([]byte(key), value))
+	}
+	require.Nil(t, tr.Commit())
+
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+// createTestAccountsTrie commits an accounts trie holding 3 accounts, 2 of them sharing the same data trie
+func createTestAccountsTrie(t *testing.T) *testAccountsTrie {
+	db := newMemoryUnit()
+	dataRootHash := createTrie(t, db, map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+	})
+
+	accounts := make(map[string][]byte)
+	for _, address := range []string{"alice", "bob", "carol"} {
+		account := &state.UserAccountData{Nonce: 1, Balance: big.NewInt(10), Address: []byte(address)}
+		if address != "carol" {
+			account.RootHash = dataRootHash
+		}
+
+		accountBytes, err := internalMarshalizer.Marshal(account)
+		require.Nil(t, err)
+		accounts[address] = accountBytes
+	}
+	rootHash := createTrie(t, db, accounts)
+
+	var leafHash []byte
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		node, err := unmarshalTrieNode(internalMarshalizer, value)
+		if err == nil && node.nodeType == "leaf" {
+			leafHash = key
+			return false
+		}
+
+		return true
+	})
+	require.NotNil(t, leafHash)
+
+	return &testAccountsTrie{
+		db:           db,
+		rootHash:     rootHash,
+		dataRootHash: dataRootHash,
+		leafHash:     leafHash,
+	}
+}
+
+func TestTrieChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                 string
+		checkDataTries       bool
+		alterTrie            func(t *testing.T, accountsTrie *testAccountsTrie)
+		expectedNumProblems  int
+		expectedNumDataTries int
+	}{
+		{
+			name:                 "consistent tries",
+			checkDataTries:       true,
+			alterTrie:            func(_ *testing.T, _ *testAccountsTrie) {},
+			expectedNumProblems:  0,
+			expectedNumDataTries: 1,
+		},
+		{
+			name:           "missing data trie node",
+			checkDataTries: true,
+			alterTrie: func(t *testing.T, accountsTrie *testAccountsTrie) {
+				require.Nil(t, accountsTrie.db.Remove(accountsTrie.dataRootHash))
+			},
+			expectedNumProblems:  1,
+			expectedNumDataTries: 1,
+		},
+		{
+			name:           "missing data trie node without checking the data tries",
+			checkDataTries: false,
+			alterTrie: func(t *testing.T, accountsTrie *testAccountsTrie) {
+				require.Nil(t, accountsTrie.db.Remove(accountsTrie.dataRootHash))
+			},
+			expectedNumProblems:  0,
+			expectedNumDataTries: 0,
+		},
+		{
+			name:           "node stored under a wrong hash",
+			checkDataTries: true,
+			alterTrie: func(t *testing.T, accountsTrie *testAccountsTrie) {
+				leaf, err := accountsTrie.db.Get(accountsTrie.leafHash)
+				require.Nil(t, err)
+				require.Nil(t, accountsTrie.db.Put(accountsTrie.dataRootHash, leaf))
+			},
+			expectedNumProblems:  1,
+			expectedNumDataTries: 1,
+		},
+		{
+			name:           "node which cannot be decoded",
+			checkDataTries: true,
+			alterTrie: func(t *testing.T, accountsTrie *testAccountsTrie) {
+				require.Nil(t, accountsTrie.db.Put(accountsTrie.dataRootHash, []byte("not a node")))
+			},
+			// the node is not stored under its hash either
+			expectedNumProblems:  2,
+			expectedNumDataTries: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		accountsTrie := createTestAccountsTrie(t)
+		tt.alterTrie(t, accountsTrie)
+		tc := newTrieChecker(accountsTrie.db, internalMarshalizer, hasher, tt.checkDataTries, 0)
+
+		err := tc.check(accountsTrie.rootHash)
+		require.Equal(t, tt.expectedNumProblems, tc.numProblems, tt.name)
+		require.Equal(t, tt.expectedNumProblems > 0, err != nil, tt.name)
+		require.Equal(t, tt.expectedNumDataTries, tc.numDataTries, tt.name)
+	}
+}
+
+func TestTrieChecker_CheckShouldCountTheNodes(t *testing.T) {
+	t.Parallel()
+
+	accountsTrie := createTestAccountsTrie(t)
+	numHandledNodes := 0
+	tc := newTrieChecker(accountsTrie.db, internalMarshalizer, hasher, true, 0)
+	tc.nodeHandler = func(_ []byte, _ []byte) error {
+		numHandledNodes++
+		return nil
+	}
+
+	err := tc.check(accountsTrie.rootHash)
+	require.Nil(t, err)
+	// 3 account leaves and 2 data trie leaves, the shared data trie being walked once
+	require.Equal(t, 5, tc.numLeaves)
+	require.Equal(t, tc.numNodes, numHandledNodes)
+}
+
+func TestTrieChecker_CheckEmptyTrieShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tc := newTrieChecker(newMemoryUnit(), internalMarshalizer, hasher, true, 0)
+
+	err := tc.check(trie.EmptyTrieHash)
+	require.Nil(t, err)
+	require.Equal(t, 0, tc.numNodes)
+}
+
+func TestTrieChecker_CheckShouldStopAtMaxProblems(t *testing.T) {
+	t.Parallel()
+
+	accountsTrie := createTestAccountsTrie(t)
+	leafHashes := make([][]byte, 0)
+	accountsTrie.db.RangeKeys(func(key []byte, value []byte) bool {
+		node, err := unmarshalTrieNode(internalMarshalizer, value)
+		if err == nil && node.nodeType == "leaf" {
+			leafHashes = append(leafHashes, key)
+		}
+
+		return true
+	})
+	for _, leafHash := range leafHashes {
+		require.Nil(t, accountsTrie.db.Remove(leafHash))
+	}
+
+	tc := newTrieChecker(accountsTrie.db, internalMarshalizer, hasher, true, 0)
+	err := tc.check(accountsTrie.rootHash)
+	require.NotNil(t, err)
+	require.Equal(t, 3, tc.numProblems)
+
+	tc = newTrieChecker(accountsTrie.db, internalMarshalizer, hasher, true, 1)
+	err = tc.check(accountsTrie.rootHash)
+	require.NotNil(t, err)
+	require.Equal(t, 1, tc.numProblems)
+}
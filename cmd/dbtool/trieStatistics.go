@@ -0,0 +1,140 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"os"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/ElrondNetwork/elrond-go/trie/statistics"
+)
+
+// the tries are only walked, so keeping more levels in memory would not speed up anything
+const maxTrieLevelInMemory = 1
+
+var errReadOnlyStorage = errors.New("the trie storage is read only")
+
+// readOnlyTrieStorage adapts a read-only persister to the storage expected by a trie. Any write is rejected
+type readOnlyTrieStorage struct {
+	readOnlyPersister
+}
+
+// Put returns an error as the storage is read only
+func (ros *readOnlyTrieStorage) Put(_, _ []byte) error {
+	return errReadOnlyStorage
+}
+
+// Remove returns an error as the storage is read only
+func (ros *readOnlyTrieStorage) Remove(_ []byte) error {
+	return errReadOnlyStorage
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ros *readOnlyTrieStorage) IsInterfaceNil() bool {
+	return ros == nil
+}
+
+// trieStatisticsCollector computes the statistics of an accounts trie and of its data tries, optionally writing the
+// statistics of every data trie in a CSV file
+type trieStatisticsCollector struct {
+	db                  readOnlyPersister
+	marshalizer         marshal.Marshalizer
+	hasher              hashing.Hasher
+	addressConverter    core.PubkeyConverter
+	numLargestDataTries int
+}
+
+func newTrieStatisticsCollector(
+	db readOnlyPersister,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	addressConverter core.PubkeyConverter,
+	numLargestDataTries int,
+) *trieStatisticsCollector {
+	return &trieStatisticsCollector{
+		db:                  db,
+		marshalizer:         marshalizer,
+		hasher:              hasher,
+		addressConverter:    addressConverter,
+		numLargestDataTries: numLargestDataTries,
+	}
+}
+
+// collect walks the accounts trie having the provided root hash. If csvPath is not empty, a line with the statistics
+// of each data trie is written in that file
+func (tsc *trieStatisticsCollector) collect(rootHash []byte, csvPath string) (*common.StateStatistics, error) {
+	storageManager, err := trie.NewTrieStorageManagerWithoutPruning(&readOnlyTrieStorage{tsc.db})
+	if err != nil {
+		return nil, err
+	}
+	accountsTrie, err := trie.NewTrie(storageManager, tsc.marshalizer, tsc.hasher, maxTrieLevelInMemory)
+	if err != nil {
+		return nil, err
+	}
+
+	args := statistics.ArgsStateStatisticsCollector{
+		AccountsTrie:        accountsTrie,
+		Marshalizer:         tsc.marshalizer,
+		AddressConverter:    tsc.addressConverter,
+		NumLargestDataTries: tsc.numLargestDataTries,
+	}
+	if len(csvPath) == 0 {
+		return tsc.collectWithArgs(args, rootHash)
+	}
+
+	file, err := os.OpenFile(csvPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	csvWriter := csv.NewWriter(file)
+	err = csvWriter.Write([]string{"address", "root hash", "num keys", "num nodes", "size in bytes"})
+	if err != nil {
+		return nil, err
+	}
+	args.DataTrieHandler = func(dataTrieStatistics *common.AccountDataTrieStatistics) {
+		// the write errors are kept by the csv writer and checked when flushing
+		_ = csvWriter.Write([]string{
+			dataTrieStatistics.Address,
+			dataTrieStatistics.RootHash,
+			strconv.FormatUint(dataTrieStatistics.NumKeys, 10),
+			strconv.FormatUint(dataTrieStatistics.NumNodes, 10),
+			strconv.FormatUint(dataTrieStatistics.TotalSizeInBytes, 10),
+		})
+	}
+
+	stats, err := tsc.collectWithArgs(args, rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	csvWriter.Flush()
+	err = csvWriter.Error()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("data tries statistics written", "file", csvPath)
+
+	return stats, nil
+}
+
+func (tsc *trieStatisticsCollector) collectWithArgs(
+	args statistics.ArgsStateStatisticsCollector,
+	rootHash []byte,
+) (*common.StateStatistics, error) {
+	collector, err := statistics.NewStateStatisticsCollector(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return collector.Collect(rootHash)
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+// levelDBMarkerFile is the file that every LevelDB directory contains
+const levelDBMarkerFile = "CURRENT"
+
+// badgerDBMarkerFile is the file that every BadgerDB directory contains
+const badgerDBMarkerFile = "KEYREGISTRY"
+
+// the extensions of the table and of the journal files of a LevelDB directory
+const (
+	levelDBTableExtension   = ".ldb"
+	levelDBJournalExtension = ".log"
+)
+
+// unitInfo describes a storage unit found on disk. Units living in the static folder have isStatic set and no epoch
+type unitInfo struct {
+	path     string
+	name     string
+	shard    string
+	epoch    uint32
+	isStatic bool
+	dbType   storageUnit.DBType
+}
+
+func (ui *unitInfo) location() string {
+	if ui.isStatic {
+		return common.DefaultStaticDbString
+	}
+
+	return fmt.Sprintf("%s_%d", common.DefaultEpochString, ui.epoch)
+}
+
+// findUnits walks the db directory of a node and returns all the storage units found in it, be it the epoch folders
+// created by the pruning storers or the static folder, sorted by name, shard and epoch
+func findUnits(root string) ([]*unitInfo, error) {
+	units := make([]*unitInfo, 0)
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+
+		dbType, isUnit := detectDBType(path)
+		if !isUnit {
+			return nil
+		}
+
+		relativePath, errRel := filepath.Rel(root, path)
+		if errRel != nil {
+			return errRel
+		}
+
+		units = append(units, newUnitInfo(path, relativePath, dbType))
+		return filepath.SkipDir
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(units, func(i, j int) bool {
+		if units[i].name != units[j].name {
+			return units[i].name < units[j].name
+		}
+		if units[i].shard != units[j].shard {
+			return units[i].shard < units[j].shard
+		}
+		if units[i].isStatic != units[j].isStatic {
+			return units[j].isStatic
+		}
+
+		return units[i].epoch < units[j].epoch
+	})
+
+	return units, nil
+}
+
+// newUnitInfo splits a path like Epoch_3/Shard_0/AccountsTrie/MainDB or Static/Shard_0/MiniblocksMetadata in its
+// components. Paths not following this layout are kept as they are, in the name of the unit
+func newUnitInfo(path string, relativePath string, dbType storageUnit.DBType) *unitInfo {
+	ui := &unitInfo{
+		path:   path,
+		name:   filepath.ToSlash(relativePath),
+		dbType: dbType,
+	}
+
+	components := strings.Split(filepath.ToSlash(relativePath), "/")
+	if len(components) < 3 {
+		return ui
+	}
+
+	shardPrefix := common.DefaultShardString + "_"
+	if !strings.HasPrefix(components[1], shardPrefix) {
+		return ui
+	}
+
+	epochPrefix := common.DefaultEpochString + "_"
+	switch {
+	case components[0] == common.DefaultStaticDbString:
+		ui.isStatic = true
+	case strings.HasPrefix(components[0], epochPrefix):
+		epoch, err := strconv.ParseUint(strings.TrimPrefix(components[0], epochPrefix), 10, 32)
+		if err != nil {
+			return ui
+		}
+		ui.epoch = uint32(epoch)
+	default:
+		return ui
+	}
+
+	ui.shard = strings.TrimPrefix(components[1], shardPrefix)
+	ui.name = strings.Join(components[2:], "/")
+
+	return ui
+}
+
+func detectDBType(path string) (storageUnit.DBType, bool) {
+	if fileExists(filepath.Join(path, levelDBMarkerFile)) {
+		return storageUnit.LvlDBSerial, true
+	}
+	if fileExists(filepath.Join(path, badgerDBMarkerFile)) {
+		return storageUnit.BadgerDB, true
+	}
+
+	return "", false
+}
+
+// checkRecoverableUnit returns an error if the provided path is not an existing directory holding the table (.ldb) or
+// the journal (.log) files of a LevelDB storage unit. The LevelDB recovery rebuilds the unit from these files, so it
+// would otherwise create an empty unit at a mistyped path
+func checkRecoverableUnit(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", path)
+	}
+
+	dbType, isUnit := detectDBType(path)
+	if isUnit && dbType == storageUnit.BadgerDB {
+		return fmt.Errorf("only LevelDB storage units can be recovered, %s is a %s unit", path, dbType)
+	}
+
+	entries, err := ioutil.ReadDir(path)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		extension := filepath.Ext(entry.Name())
+		if extension == levelDBTableExtension || extension == levelDBJournalExtension {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s does not hold any LevelDB table (%s) or journal (%s) file", path, levelDBTableExtension, levelDBJournalExtension)
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+
+	return !info.IsDir()
+}
+
+// selectUnits returns the epoch units having the provided name and shard, newest epoch first, so the lookups done
+// across them hit the most recent data first
+func selectUnits(units []*unitInfo, name string, shard string) []*unitInfo {
+	selected := make([]*unitInfo, 0)
+	for _, ui := range units {
+		if ui.isStatic || ui.name != name || ui.shard != shard {
+			continue
+		}
+
+		selected = append(selected, ui)
+	}
+
+	sort.SliceStable(selected, func(i, j int) bool {
+		return selected[i].epoch > selected[j].epoch
+	})
+
+	return selected
+}
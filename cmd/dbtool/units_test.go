@@ -0,0 +1,145 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/require"
+)
+
+func createDir(t *testing.T, files ...string) string {
+	dir, err := ioutil.TempDir("", "dbtool")
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+
+	for _, file := range files {
+		require.Nil(t, ioutil.WriteFile(filepath.Join(dir, file), []byte("content"), 0644))
+	}
+
+	return dir
+}
+
+func TestDetectDBType(t *testing.T) {
+	t.Parallel()
+
+	dirWithMarkerDir := createDir(t)
+	require.Nil(t, os.Mkdir(filepath.Join(dirWithMarkerDir, levelDBMarkerFile), 0755))
+
+	tests := []struct {
+		name           string
+		path           string
+		expectedType   storageUnit.DBType
+		expectedIsUnit bool
+	}{
+		{name: "LevelDB unit", path: createDir(t, levelDBMarkerFile, "000001.ldb"), expectedType: storageUnit.LvlDBSerial, expectedIsUnit: true},
+		{name: "BadgerDB unit", path: createDir(t, badgerDBMarkerFile), expectedType: storageUnit.BadgerDB, expectedIsUnit: true},
+		{name: "empty directory", path: createDir(t), expectedType: "", expectedIsUnit: false},
+		{name: "marker is a directory", path: dirWithMarkerDir, expectedType: "", expectedIsUnit: false},
+		{name: "missing directory", path: filepath.Join(createDir(t), "missing"), expectedType: "", expectedIsUnit: false},
+	}
+
+	for _, tt := range tests {
+		dbType, isUnit := detectDBType(tt.path)
+		require.Equal(t, tt.expectedType, dbType, tt.name)
+		require.Equal(t, tt.expectedIsUnit, isUnit, tt.name)
+	}
+}
+
+func TestSelectUnits(t *testing.T) {
+	t.Parallel()
+
+	units := []*unitInfo{
+		{name: "MiniBlocks", shard: "0", epoch: 1},
+		{name: "MiniBlocks", shard: "0", epoch: 3},
+		{name: "MiniBlocks", shard: "1", epoch: 2},
+		{name: "MiniBlocks", shard: "0", isStatic: true},
+		{name: "Transactions", shard: "0", epoch: 2},
+		{name: "MiniBlocks", shard: "0", epoch: 2},
+	}
+
+	tests := []struct {
+		name           string
+		unitName       string
+		shard          string
+		expectedEpochs []uint32
+	}{
+		{name: "newest epoch first, without the static unit", unitName: "MiniBlocks", shard: "0", expectedEpochs: []uint32{3, 2, 1}},
+		{name: "other shard", unitName: "MiniBlocks", shard: "1", expectedEpochs: []uint32{2}},
+		{name: "other name", unitName: "Transactions", shard: "0", expectedEpochs: []uint32{2}},
+		{name: "unknown name", unitName: "BlockHeaders", shard: "0", expectedEpochs: []uint32{}},
+	}
+
+	for _, tt := range tests {
+		selected := selectUnits(units, tt.unitName, tt.shard)
+		epochs := make([]uint32, 0, len(selected))
+		for _, ui := range selected {
+			require.Equal(t, tt.unitName, ui.name, tt.name)
+			require.Equal(t, tt.shard, ui.shard, tt.name)
+			require.False(t, ui.isStatic, tt.name)
+			epochs = append(epochs, ui.epoch)
+		}
+		require.Equal(t, tt.expectedEpochs, epochs, tt.name)
+	}
+}
+
+func TestFindUnits(t *testing.T) {
+	t.Parallel()
+
+	root := createDir(t)
+	unitPaths := []string{
+		"Epoch_2/Shard_0/MiniBlocks",
+		"Epoch_1/Shard_0/MiniBlocks",
+		"Static/Shard_0/MiniBlocks",
+		"Epoch_1/Shard_0/AccountsTrie/MainDB",
+	}
+	for _, unitPath := range unitPaths {
+		path := filepath.Join(root, filepath.FromSlash(unitPath))
+		require.Nil(t, os.MkdirAll(path, 0755))
+		require.Nil(t, ioutil.WriteFile(filepath.Join(path, levelDBMarkerFile), []byte("MANIFEST-000001"), 0644))
+	}
+	require.Nil(t, os.MkdirAll(filepath.Join(root, "Epoch_1", "Shard_0", "NotAUnit"), 0755))
+
+	units, err := findUnits(root)
+	require.Nil(t, err)
+	require.Len(t, units, 4)
+	require.Equal(t, "AccountsTrie/MainDB", units[0].name)
+	require.Equal(t, "MiniBlocks", units[1].name)
+	require.Equal(t, uint32(1), units[1].epoch)
+	require.Equal(t, uint32(2), units[2].epoch)
+	require.True(t, units[3].isStatic)
+	for _, ui := range units {
+		require.Equal(t, "0", ui.shard)
+		require.Equal(t, storageUnit.LvlDBSerial, ui.dbType)
+	}
+}
+
+func TestCheckRecoverableUnit(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(createDir(t, "000001.ldb"), "000001.ldb")
+
+	tests := []struct {
+		name          string
+		path          string
+		expectedError bool
+	}{
+		{name: "missing directory", path: filepath.Join(createDir(t), "missing"), expectedError: true},
+		{name: "file", path: file, expectedError: true},
+		{name: "empty directory", path: createDir(t), expectedError: true},
+		{name: "directory without LevelDB files", path: createDir(t, "notes.txt"), expectedError: true},
+		{name: "BadgerDB unit", path: createDir(t, badgerDBMarkerFile, "000001.log"), expectedError: true},
+		{name: "LevelDB table file", path: createDir(t, "000002.ldb"), expectedError: false},
+		{name: "LevelDB journal file", path: createDir(t, "000003.log"), expectedError: false},
+		{name: "LevelDB unit", path: createDir(t, levelDBMarkerFile, "000002.ldb"), expectedError: false},
+	}
+
+	for _, tt := range tests {
+		err := checkRecoverableUnit(tt.path)
+		require.Equal(t, tt.expectedError, err != nil, tt.name)
+	}
+}
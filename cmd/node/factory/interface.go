@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -50,6 +51,7 @@ type FileLoggingHandler interface {
 type StatusHandlersUtils interface {
 	StatusHandler() core.AppStatusHandler
 	Metrics() external.StatusMetricsHandler
+	MetricsHandler() common.MetricsHandler
 	UpdateStorerAndMetricsForPersistentHandler(store storage.Storer) error
 	IsInterfaceNil() bool
 }
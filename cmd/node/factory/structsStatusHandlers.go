@@ -8,8 +8,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/metrics"
 	"github.com/ElrondNetwork/elrond-go/statusHandler/persister"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
@@ -21,6 +23,7 @@ type statusHandlersInfo struct {
 	AppStatusHandler  core.AppStatusHandler
 	StatusMetrics     external.StatusMetricsHandler
 	PersistentHandler *persister.PersistentStatusHandler
+	TypedMetrics      common.MetricsHandler
 }
 
 type statusHandlerUtilsFactory struct {
@@ -48,7 +51,15 @@ func (shuf *statusHandlerUtilsFactory) Create(
 		return nil, fmt.Errorf("%s: nil uint64 byte slice converter", baseErrMessage)
 	}
 
-	statusMetrics := statusHandler.NewStatusMetrics()
+	typedMetrics, err := metrics.NewPrometheusMetricsHandler()
+	if err != nil {
+		return nil, err
+	}
+
+	statusMetrics, err := statusHandler.NewStatusMetricsWithTypedMetrics(typedMetrics)
+	if err != nil {
+		return nil, err
+	}
 	appStatusHandlers = append(appStatusHandlers, statusMetrics)
 
 	persistentHandler, err := persister.NewPersistentStatusHandler(marshalizer, uint64ByteSliceConverter)
@@ -71,6 +82,7 @@ func (shuf *statusHandlerUtilsFactory) Create(
 	statusHandlersInfoObject.AppStatusHandler = handler
 	statusHandlersInfoObject.StatusMetrics = statusMetrics
 	statusHandlersInfoObject.PersistentHandler = persistentHandler
+	statusHandlersInfoObject.TypedMetrics = typedMetrics
 
 	return statusHandlersInfoObject, nil
 }
@@ -95,6 +107,11 @@ func (shi *statusHandlersInfo) Metrics() external.StatusMetricsHandler {
 	return shi.StatusMetrics
 }
 
+// MetricsHandler returns the handler of the typed metrics
+func (shi *statusHandlersInfo) MetricsHandler() common.MetricsHandler {
+	return shi.TypedMetrics
+}
+
 // IsInterfaceNil returns true if the interface is nil
 func (shi *statusHandlersInfo) IsInterfaceNil() bool {
 	return shi == nil
@@ -230,6 +230,21 @@ var (
 		Usage: "This flag specifies the round `index` from which node should bootstrap from storage.",
 		Value: math.MaxUint64,
 	}
+	// outportReplayStartNonce defines a flag for the nonce of the first block re-emitted towards the outport drivers
+	outportReplayStartNonce = cli.Uint64Flag{
+		Name: "outport-replay-start-nonce",
+		Usage: "This flag specifies the `nonce` of the first block which is read from storage and re-emitted towards " +
+			"the outport drivers (indexers) when the node starts. It is used together with outport-replay-end-nonce. " +
+			"The websocket subscribers do not receive the replayed blocks.",
+		Value: 1,
+	}
+	// outportReplayEndNonce defines a flag for the nonce of the last block re-emitted towards the outport drivers
+	outportReplayEndNonce = cli.Uint64Flag{
+		Name: "outport-replay-end-nonce",
+		Usage: "This flag specifies the `nonce` of the last block which is read from storage and re-emitted towards " +
+			"the outport drivers (indexers) when the node starts. The blocks are re-emitted only if this flag is set.",
+		Value: 0,
+	}
 	// workingDirectory defines a flag for the path for the working directory.
 	workingDirectory = cli.StringFlag{
 		Name:  "working-directory",
@@ -288,6 +303,15 @@ var (
 		Value: 0,
 		Usage: "This flag will specify the start in epoch value in import-db process",
 	}
+	// importSnapshot defines a flag for the optional state snapshot archive used when bootstrapping from an epoch start
+	importSnapshot = cli.StringFlag{
+		Name: "import-snapshot",
+		Usage: "This flag, if set, will make the node load the tries from the provided state snapshot archive `" +
+			filePathPlaceholder + "` when it starts in epoch. The archive is verified against the epoch start meta block " +
+			"received from the network and the missing trie nodes are then synced as usual. The archives are created " +
+			"by the dbtool export-snapshot command",
+		Value: "",
+	}
 	// redundancyLevel defines a flag that specifies the level of redundancy used by the current instance for the node (-1 = disabled, 0 = main instance (default), 1 = first backup, 2 = second backup, etc.)
 	redundancyLevel = cli.Int64Flag{
 		Name:  "redundancy-level",
@@ -344,6 +368,8 @@ func getFlags() []cli.Flag {
 		logWithLoggerName,
 		useLogView,
 		bootstrapRoundIndex,
+		outportReplayStartNonce,
+		outportReplayEndNonce,
 		workingDirectory,
 		destinationShardAsObserver,
 		numEpochsToSave,
@@ -353,6 +379,7 @@ func getFlags() []cli.Flag {
 		importDbNoSigCheck,
 		importDbSaveEpochRootHash,
 		importDbStartInEpoch,
+		importSnapshot,
 		redundancyLevel,
 		fullArchive,
 		memBallast,
@@ -373,6 +400,9 @@ func getFlagsConfig(ctx *cli.Context, log logger.Logger) *config.ContextFlagsCon
 	flagsConfig.CleanupStorage = ctx.GlobalBool(storageCleanup.Name)
 	flagsConfig.UseHealthService = ctx.GlobalBool(useHealthService.Name)
 	flagsConfig.BootstrapRoundIndex = ctx.GlobalUint64(bootstrapRoundIndex.Name)
+	flagsConfig.OutportReplayStartNonce = ctx.GlobalUint64(outportReplayStartNonce.Name)
+	flagsConfig.OutportReplayEndNonce = ctx.GlobalUint64(outportReplayEndNonce.Name)
+	flagsConfig.ImportSnapshotFilePath = ctx.GlobalString(importSnapshot.Name)
 	flagsConfig.EnableRestAPIServerDebugMode = ctx.GlobalBool(restApiDebug.Name)
 	flagsConfig.RestApiInterface = ctx.GlobalString(restApiInterface.Name)
 	flagsConfig.EnablePprof = ctx.GlobalBool(profileMode.Name)
@@ -451,6 +481,14 @@ func applyCompatibleConfigs(log logger.Logger, configs *config.Configs) error {
 	importDbFlags.ImportDbNoSigCheckFlag = importDbFlags.ImportDbNoSigCheckFlag && importDbFlags.IsImportDBMode
 	importDbFlags.ImportDbSaveTrieEpochRootHash = importDbFlags.ImportDbSaveTrieEpochRootHash && importDbFlags.IsImportDBMode
 
+	isImportSnapshotMode := len(configs.FlagsConfig.ImportSnapshotFilePath) > 0
+	if isImportSnapshotMode && importDbFlags.IsImportDBMode {
+		return fmt.Errorf("the %s flag can not be used together with the %s flag", importSnapshot.Name, importDbDirectory.Name)
+	}
+	if isImportSnapshotMode && configs.PreferencesConfig.Preferences.FullArchive {
+		return fmt.Errorf("the %s flag can not be used on a full archive node", importSnapshot.Name)
+	}
+
 	if importDbFlags.IsImportDBMode {
 		return processConfigImportDBMode(log, configs)
 	}
@@ -460,6 +498,24 @@ func applyCompatibleConfigs(log logger.Logger, configs *config.Configs) error {
 		return processConfigFullArchiveMode(log, configs)
 	}
 
+	if isImportSnapshotMode {
+		return processConfigImportSnapshotMode(log, configs)
+	}
+
+	return nil
+}
+
+func processConfigImportSnapshotMode(log logger.Logger, configs *config.Configs) error {
+	generalConfigs := configs.GeneralConfig
+
+	// the snapshot is loaded only while bootstrapping from an epoch start
+	generalConfigs.GeneralSettings.StartInEpochEnabled = true
+
+	log.Warn("the node will import a state snapshot! Will auto-set some config values",
+		"snapshot file", configs.FlagsConfig.ImportSnapshotFilePath,
+		"GeneralSettings.StartInEpochEnabled", generalConfigs.GeneralSettings.StartInEpochEnabled,
+	)
+
 	return nil
 }
 
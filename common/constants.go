@@ -660,3 +660,25 @@ const TimeoutGettingTrieNodesInHardfork = time.Minute * 10
 
 // RetrialIntervalForOutportDriver is the interval in which the outport driver should try to call the driver again
 const RetrialIntervalForOutportDriver = time.Second * 10
+
+// BlockOperation defines the block operations whose duration is measured
+type BlockOperation string
+
+const (
+	// BlockOperationProcess is the processing of a received block
+	BlockOperationProcess BlockOperation = "process"
+	// BlockOperationCommit is the commit of a processed block
+	BlockOperationCommit BlockOperation = "commit"
+)
+
+// AntifloodReason defines the reasons for which the antiflood component rejects messages
+type AntifloodReason string
+
+const (
+	// AntifloodReasonFlood signals that the peer sent too many messages or bytes
+	AntifloodReasonFlood AntifloodReason = "flood"
+	// AntifloodReasonTopicFlood signals that the peer sent too many messages on a topic
+	AntifloodReasonTopicFlood AntifloodReason = "topic_flood"
+	// AntifloodReasonBlacklistedOriginator signals that the message originator is blacklisted
+	AntifloodReasonBlacklistedOriginator AntifloodReason = "blacklisted_originator"
+)
@@ -6,3 +6,233 @@ type GetProofResponse struct {
 	Value    []byte
 	RootHash string
 }
+
+// RangeProof holds a Merkle proof for all the keys of a trie placed between StartKey and EndKey, both included.
+// IsTruncated is set when the requested range held too many keys and EndKey was moved to the last returned key.
+// The keys are ordered as in the trie, by their reversed nibbles, and not by their bytes
+type RangeProof struct {
+	Proof       [][]byte
+	Keys        [][]byte
+	Values      [][]byte
+	StartKey    []byte
+	EndKey      []byte
+	IsTruncated bool
+}
+
+// GetMultiKeyProofResponse is a struct that stores the response of a multi-key GetProof API request
+type GetMultiKeyProofResponse struct {
+	Proof    [][]byte
+	Values   [][]byte
+	RootHash string
+}
+
+// GetRangeProofResponse is a struct that stores the response of a range GetProof API request
+type GetRangeProofResponse struct {
+	Proof       [][]byte
+	Keys        [][]byte
+	Values      [][]byte
+	StartKey    []byte
+	EndKey      []byte
+	IsTruncated bool
+	RootHash    string
+}
+
+// LogsQuery holds the filters of a logs and events API request. FromEventIndex is the number of matching events of
+// the FromNonce block to skip, as returned by a truncated response
+type LogsQuery struct {
+	Address        string
+	Identifier     string
+	Topic          string
+	FromNonce      uint64
+	ToNonce        uint64
+	FromEventIndex uint32
+}
+
+// LogsResponse holds a page of the log events matching a query. When truncated, the next page starts at
+// NextFromNonce, from the NextFromEventIndex matching event of that block
+type LogsResponse struct {
+	Logs               []*ApiLogEvent `json:"logs"`
+	Truncated          bool           `json:"truncated"`
+	NextFromNonce      uint64         `json:"nextFromNonce,omitempty"`
+	NextFromEventIndex uint32         `json:"nextFromEventIndex,omitempty"`
+}
+
+// ApiLogEvent is a log event returned by the logs and events API
+type ApiLogEvent struct {
+	TxHash     string   `json:"txHash"`
+	BlockNonce uint64   `json:"blockNonce"`
+	Epoch      uint32   `json:"epoch"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+}
+
+// AddressTransaction is an entry of the transactions history of an address
+type AddressTransaction struct {
+	TxHash     string `json:"txHash"`
+	Type       string `json:"type"`
+	Direction  string `json:"direction"`
+	Epoch      uint32 `json:"epoch"`
+	BlockNonce uint64 `json:"blockNonce"`
+}
+
+// AddressTransactionsResponse holds a page of the transactions history of an address
+type AddressTransactionsResponse struct {
+	Transactions []*AddressTransaction `json:"transactions"`
+	Total        uint64                `json:"total"`
+}
+
+// AccountQueryOptions selects the block whose state is used when resolving an account. When neither a block nonce
+// nor a block hash is set, the latest state is used
+type AccountQueryOptions struct {
+	BlockNonce    uint64
+	HasBlockNonce bool
+	BlockHash     []byte
+}
+
+// IsHistorical returns true if the options select a specific block instead of the latest state
+func (options AccountQueryOptions) IsHistorical() bool {
+	return options.HasBlockNonce || len(options.BlockHash) > 0
+}
+
+// PeerReputationEvent is an entry of the reputation history of a peer
+type PeerReputationEvent struct {
+	Timestamp            int64   `json:"timestamp"`
+	Reason               string  `json:"reason"`
+	BanDurationInSeconds int64   `json:"banDurationInSeconds"`
+	Score                float64 `json:"score"`
+}
+
+// PeerReputation holds the reputation of a peer ID or of a validator public key. The timestamps are unix seconds
+type PeerReputation struct {
+	Identifier  string                 `json:"identifier"`
+	Type        string                 `json:"type"`
+	Score       float64                `json:"score"`
+	NumBans     uint32                 `json:"numBans"`
+	LastSeen    int64                  `json:"lastSeen"`
+	BannedUntil int64                  `json:"bannedUntil"`
+	History     []*PeerReputationEvent `json:"history"`
+}
+
+// TxPoolQueryOptions holds the filters and the paging parameters of a transactions pool query
+type TxPoolQueryOptions struct {
+	Sender              string
+	SourceShard         uint32
+	HasSourceShard      bool
+	DestinationShard    uint32
+	HasDestinationShard bool
+	MinGasPrice         uint64
+	From                uint64
+	Size                uint64
+}
+
+// TxPoolTransaction is an entry of the transactions pool
+type TxPoolTransaction struct {
+	TxHash           string `json:"txHash"`
+	Sender           string `json:"sender"`
+	Receiver         string `json:"receiver"`
+	Nonce            uint64 `json:"nonce"`
+	Value            string `json:"value"`
+	GasPrice         uint64 `json:"gasPrice"`
+	GasLimit         uint64 `json:"gasLimit"`
+	SourceShard      uint32 `json:"sourceShard"`
+	DestinationShard uint32 `json:"destinationShard"`
+	Size             int64  `json:"size"`
+}
+
+// TxPoolTransactionsResponse holds a page of the (filtered) transactions pool
+type TxPoolTransactionsResponse struct {
+	Transactions []*TxPoolTransaction `json:"transactions"`
+	Total        uint64               `json:"total"`
+}
+
+// TxPoolNonceGap is a range of nonces missing from the transactions of a sender, both ends included
+type TxPoolNonceGap struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
+
+// TxPoolSenderTransaction is a transaction of a sender in the pool. PrecededByGap is set when the transaction
+// cannot be selected until the missing nonces before it arrive
+type TxPoolSenderTransaction struct {
+	*TxPoolTransaction
+	PrecededByGap bool `json:"precededByGap"`
+}
+
+// TxPoolSenderResponse holds the nonce-ordered transactions of a sender in the pool, along with what the pool knows
+// about the sender. A low score means the sender is among the first to be evicted when the pool is full
+type TxPoolSenderResponse struct {
+	Sender              string                     `json:"sender"`
+	AccountNonce        uint64                     `json:"accountNonce"`
+	Score               uint32                     `json:"score"`
+	NumFailedSelections uint64                     `json:"numFailedSelections"`
+	IsInGracePeriod     bool                       `json:"isInGracePeriod"`
+	NumBytes            uint64                     `json:"numBytes"`
+	Transactions        []*TxPoolSenderTransaction `json:"transactions"`
+	NonceGaps           []*TxPoolNonceGap          `json:"nonceGaps"`
+}
+
+// TxPoolCacheStatistics holds aggregate information about a cache of the transactions pool. The score distribution
+// holds the number of senders for each score (0-99) and is only available for the caches of the own shard
+type TxPoolCacheStatistics struct {
+	CacheID           string   `json:"cacheId"`
+	NumTxs            uint64   `json:"numTxs"`
+	NumBytes          uint64   `json:"numBytes"`
+	NumSenders        uint64   `json:"numSenders"`
+	NumEvictedTxs     uint64   `json:"numEvictedTxs"`
+	NumSweptTxs       uint64   `json:"numSweptTxs"`
+	NumReplacedTxs    uint64   `json:"numReplacedTxs"`
+	NumExpiredTxs     uint64   `json:"numExpiredTxs"`
+	ScoreDistribution []uint32 `json:"scoreDistribution,omitempty"`
+}
+
+// TxPoolStatisticsResponse holds aggregate information about the transactions pool
+type TxPoolStatisticsResponse struct {
+	NumTxs     uint64                   `json:"numTxs"`
+	NumBytes   uint64                   `json:"numBytes"`
+	NumSenders uint64                   `json:"numSenders"`
+	Caches     []*TxPoolCacheStatistics `json:"caches"`
+}
+
+// TxPoolEvictedTransaction holds the details about a transaction recently removed from the pool without being
+// included in a block. The reason is one of poolFull, senderLimit, swept and replaced
+type TxPoolEvictedTransaction struct {
+	TxHash    string `json:"txHash"`
+	Sender    string `json:"sender"`
+	CacheID   string `json:"cacheId"`
+	Reason    string `json:"reason"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// TrieStatistics holds the structure and the size of one or more tries. The depth histogram holds the number of
+// nodes found at each depth, the root nodes being at depth 0
+type TrieStatistics struct {
+	NumBranchNodes    uint64   `json:"numBranchNodes"`
+	NumExtensionNodes uint64   `json:"numExtensionNodes"`
+	NumLeafNodes      uint64   `json:"numLeafNodes"`
+	TotalSizeInBytes  uint64   `json:"totalSizeInBytes"`
+	MaxDepth          uint32   `json:"maxDepth"`
+	DepthHistogram    []uint64 `json:"depthHistogram"`
+}
+
+// AccountDataTrieStatistics holds the size of the data trie of an account. The number of keys is the number of
+// leaves of the data trie
+type AccountDataTrieStatistics struct {
+	Address          string `json:"address"`
+	RootHash         string `json:"rootHash"`
+	NumKeys          uint64 `json:"numKeys"`
+	NumNodes         uint64 `json:"numNodes"`
+	TotalSizeInBytes uint64 `json:"totalSizeInBytes"`
+}
+
+// StateStatistics holds the statistics of an accounts trie and of the data tries of its accounts. The data tries
+// statistics are summed over all the accounts and the largest data tries are sorted by their size, descending
+type StateStatistics struct {
+	RootHash                string                       `json:"rootHash"`
+	AccountsTrie            *TrieStatistics              `json:"accountsTrie"`
+	DataTries               *TrieStatistics              `json:"dataTries"`
+	NumAccounts             uint64                       `json:"numAccounts"`
+	NumAccountsWithDataTrie uint64                       `json:"numAccountsWithDataTrie"`
+	LargestDataTries        []*AccountDataTrieStatistics `json:"largestDataTries"`
+}
@@ -5,5 +5,8 @@ import "errors"
 // ErrInvalidTimeout signals that an invalid timeout period has been provided
 var ErrInvalidTimeout = errors.New("invalid timeout value")
 
+// ErrBlockNonceNotAvailable signals that the block with the provided nonce is no longer kept by the node
+var ErrBlockNonceNotAvailable = errors.New("block nonce not available")
+
 // ErrNilArwenChangeLocker signals that a nil arwen change locker has been provided
 var ErrNilArwenChangeLocker = errors.New("nil arwen change locker")
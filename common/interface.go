@@ -1,6 +1,10 @@
 package common
 
-import "github.com/ElrondNetwork/elrond-go-core/core"
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+)
 
 // NumNodesDTO represents the DTO structure that will hold the number of nodes split by category and other
 // trie structure relevant data such as maximum number of trie levels including the roothash node and all leaves
@@ -30,11 +34,23 @@ type Trie interface {
 	GetAllHashes() ([][]byte, error)
 	GetProof(key []byte) ([][]byte, []byte, error)
 	VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
+	GetMultiProof(keys [][]byte) ([][]byte, [][]byte, error)
+	GetRangeProof(startKey []byte, endKey []byte, maxNumLeaves int) (*RangeProof, error)
+	CollectStatistics(rootHash []byte, handler TrieStatisticsHandler) error
 	GetStorageManager() StorageManager
 	Close() error
 	IsInterfaceNil() bool
 }
 
+// TrieStatisticsHandler is used to collect the statistics of a trie while its nodes are visited. The depth of the
+// root node is 0 and the size is the one of the encoded node, as saved in the storage
+type TrieStatisticsHandler interface {
+	AddBranchNode(depth int, size uint64)
+	AddExtensionNode(depth int, size uint64)
+	AddLeafNode(depth int, size uint64)
+	IsInterfaceNil() bool
+}
+
 // StorageManager manages all trie storage operations
 type StorageManager interface {
 	Database() DBWriteCacher
@@ -84,3 +100,20 @@ type Locker interface {
 type MerkleProofVerifier interface {
 	VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
 }
+
+// MetricsHandler defines the typed metrics recorded by the node components
+type MetricsHandler interface {
+	ObserveBlockOperation(operation BlockOperation, duration time.Duration)
+	ObserveConsensusSubround(subround string, done bool, duration time.Duration)
+	AddInterceptedMessage(topic string, accepted bool, size int)
+	AddAntifloodRejection(topic string, reason AntifloodReason)
+	AddTrieSyncReceivedNodes(numNodes int)
+	AddTrieSyncLargeNodes(numNodes int)
+	AddTrieSyncMissingNodes(difference int)
+	SetTrieSyncEstimatedTimeLeft(duration time.Duration)
+	AddStorageCacheRequest(unit string, hit bool)
+	SetOutportQueueSize(driver string, numRecords uint64, numBytes uint64)
+	AddOutportQueueRejection(driver string)
+	SetShardID(shardID uint32)
+	IsInterfaceNil() bool
+}
@@ -9,6 +9,11 @@ type CacheConfig struct {
 	SizeInBytes          uint64
 	SizeInBytesPerSender uint32
 	Shards               uint32
+	// ReplacementGasPriceBumpPercent is only used by the transactions pool
+	ReplacementGasPriceBumpPercent uint32
+	// ValidityWindowEnabled and ValidityWindowInRounds are only used by the transactions pool
+	ValidityWindowEnabled  bool
+	ValidityWindowInRounds uint64
 }
 
 // HeadersPoolConfig will map the headers cache configuration
@@ -164,6 +169,7 @@ type Config struct {
 	PeerHonesty           CacheConfig
 
 	Antiflood           AntifloodConfig
+	PeerReputation      PeerReputationConfig
 	ResourceStats       ResourceStatsConfig
 	Heartbeat           HeartbeatConfig
 	ValidatorStatistics ValidatorStatisticsConfig
@@ -188,6 +194,12 @@ type Config struct {
 	TrieSync              TrieSyncConfig
 	Resolvers             ResolverConfig
 	VMOutputCacher        CacheConfig
+	TxSimulator           TxSimulatorConfig
+}
+
+// TxSimulatorConfig will hold settings related to the transactions simulator
+type TxSimulatorConfig struct {
+	MaxTxBundleSize uint32
 }
 
 // LogsConfig will hold settings related to the logging sub-system
@@ -333,6 +345,18 @@ type FloodPreventerConfig struct {
 	BlackList         BlackListConfig
 }
 
+// PeerReputationConfig will hold the settings of the persistent peer reputation store
+type PeerReputationConfig struct {
+	Enabled                         bool
+	MaxHistoryEntries               uint32
+	BanPenalty                      float64
+	DecayCoefficient                float64
+	SweepIntervalInSeconds          uint32
+	RecordExpiryInSeconds           uint32
+	LastSeenUpdateIntervalInSeconds uint32
+	Storage                         StorageConfig
+}
+
 // AntifloodLimitsConfig will hold the maximum antiflood limits in both number of messages and total
 // size of the messages
 type AntifloodLimitsConfig struct {
@@ -407,6 +431,9 @@ type DbLookupExtensionsConfig struct {
 	ResultsHashesByTxHashStorageConfig StorageConfig
 	ESDTSuppliesStorageConfig          StorageConfig
 	RoundHashStorageConfig             StorageConfig
+	LogsIndexStorageConfig             StorageConfig
+	AddressHistoryStorageConfig        StorageConfig
+	LogsQueryMaxNumBuckets             uint32
 }
 
 // DebugConfig will hold debugging configuration
@@ -415,6 +442,17 @@ type DebugConfig struct {
 	Antiflood           AntifloodDebugConfig
 	ShuffleOut          ShuffleOutDebugConfig
 	EpochStart          EpochStartDebugConfig
+	Tracing             TracingDebugConfig
+}
+
+// TracingDebugConfig will hold the configuration of the block processing spans
+type TracingDebugConfig struct {
+	Enabled      bool
+	Exporter     string
+	OtlpEndpoint string
+	FilePath     string
+	ServiceName  string
+	QueueSize    int
 }
 
 // HealthServiceConfig will hold health service (monitoring) configuration
@@ -530,9 +568,14 @@ type ConfigurationPathsHolder struct {
 
 // TrieSyncConfig represents the trie synchronization configuration area
 type TrieSyncConfig struct {
-	NumConcurrentTrieSyncers  int
-	MaxHardCapForMissingNodes int
-	TrieSyncerVersion         int
+	NumConcurrentTrieSyncers           int
+	MaxHardCapForMissingNodes          int
+	TrieSyncerVersion                  int
+	MaxInFlightTrieNodes               int
+	MinRequestBatchSize                int
+	MaxRequestBatchSize                int
+	TargetRequestLatencyInMilliseconds int
+	RequestTimeoutInMilliseconds       int
 }
 
 // ResolverConfig represents the config options to be used when setting up the resolver instances
@@ -14,6 +14,9 @@ type ContextFlagsConfig struct {
 	SessionInfoFileOutput        string
 	EnableTxIndexing             bool
 	BootstrapRoundIndex          uint64
+	OutportReplayStartNonce      uint64
+	OutportReplayEndNonce        uint64
+	ImportSnapshotFilePath       string
 	RestApiInterface             string
 	EnablePprof                  bool
 	UseLogView                   bool
@@ -5,6 +5,9 @@ type ExternalConfig struct {
 	ElasticSearchConnector ElasticSearchConfig
 	EventNotifierConnector EventNotifierConfig
 	CovalentConnector      CovalentConfig
+	SubscriptionsConnector SubscriptionsConfig
+	GrpcOutportConnector   GrpcOutportConfig
+	OutportQueue           OutportQueueConfig
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
@@ -34,3 +37,25 @@ type CovalentConfig struct {
 	RouteSendData        string
 	RouteAcknowledgeData string
 }
+
+// SubscriptionsConfig will hold the configuration for the in-process subscriptions hub
+type SubscriptionsConfig struct {
+	Enabled          bool
+	MaxSubscribers   int
+	EventsBufferSize int
+}
+
+// GrpcOutportConfig will hold the configuration for the driver forwarding the outport data over a local gRPC stream
+type GrpcOutportConfig struct {
+	Enabled             bool
+	Target              string
+	AckTimeoutInSeconds uint32
+}
+
+// OutportQueueConfig will hold the configuration for the on-disk queues placed in front of the outport drivers
+type OutportQueueConfig struct {
+	Enabled               bool
+	MaxPendingRecords     uint64
+	MaxPendingSizeInBytes uint64
+	DB                    DBConfig
+}
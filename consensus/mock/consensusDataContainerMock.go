@@ -5,6 +5,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/ntp"
@@ -35,6 +36,7 @@ type ConsensusCoreMock struct {
 	headerSigVerifier       consensus.HeaderSigVerifier
 	fallbackHeaderValidator consensus.FallbackHeaderValidator
 	nodeRedundancyHandler   consensus.NodeRedundancyHandler
+	metricsHandler          common.MetricsHandler
 }
 
 // GetAntiFloodHandler -
@@ -217,6 +219,16 @@ func (ccm *ConsensusCoreMock) NodeRedundancyHandler() consensus.NodeRedundancyHa
 	return ccm.nodeRedundancyHandler
 }
 
+// MetricsHandler -
+func (ccm *ConsensusCoreMock) MetricsHandler() common.MetricsHandler {
+	return ccm.metricsHandler
+}
+
+// SetMetricsHandler -
+func (ccm *ConsensusCoreMock) SetMetricsHandler(metricsHandler common.MetricsHandler) {
+	ccm.metricsHandler = metricsHandler
+}
+
 // SetNodeRedundancyHandler -
 func (ccm *ConsensusCoreMock) SetNodeRedundancyHandler(nodeRedundancyHandler consensus.NodeRedundancyHandler) {
 	ccm.nodeRedundancyHandler = nodeRedundancyHandler
@@ -5,6 +5,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/ntp"
@@ -35,6 +36,7 @@ type ConsensusCore struct {
 	headerSigVerifier             consensus.HeaderSigVerifier
 	fallbackHeaderValidator       consensus.FallbackHeaderValidator
 	nodeRedundancyHandler         consensus.NodeRedundancyHandler
+	metricsHandler                common.MetricsHandler
 }
 
 // ConsensusCoreArgs store all arguments that are needed to create a ConsensusCore object
@@ -59,6 +61,7 @@ type ConsensusCoreArgs struct {
 	HeaderSigVerifier             consensus.HeaderSigVerifier
 	FallbackHeaderValidator       consensus.FallbackHeaderValidator
 	NodeRedundancyHandler         consensus.NodeRedundancyHandler
+	MetricsHandler                common.MetricsHandler
 }
 
 // NewConsensusCore creates a new ConsensusCore instance
@@ -86,6 +89,7 @@ func NewConsensusCore(
 		headerSigVerifier:             args.HeaderSigVerifier,
 		fallbackHeaderValidator:       args.FallbackHeaderValidator,
 		nodeRedundancyHandler:         args.NodeRedundancyHandler,
+		metricsHandler:                args.MetricsHandler,
 	}
 
 	err := ValidateConsensusCore(consensusCore)
@@ -196,6 +200,11 @@ func (cc *ConsensusCore) NodeRedundancyHandler() consensus.NodeRedundancyHandler
 	return cc.nodeRedundancyHandler
 }
 
+// MetricsHandler will return the metrics handler which will be used in subrounds
+func (cc *ConsensusCore) MetricsHandler() common.MetricsHandler {
+	return cc.metricsHandler
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (cc *ConsensusCore) IsInterfaceNil() bool {
 	return cc == nil
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/metrics/disabled"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/stretchr/testify/assert"
@@ -29,6 +30,7 @@ func initConsensusDataContainer() *ConsensusCore {
 	headerSigVerifier := &mock.HeaderSigVerifierStub{}
 	fallbackHeaderValidator := &testscommon.FallBackHeaderValidatorStub{}
 	nodeRedundancyHandler := &mock.NodeRedundancyHandlerStub{}
+	metricsHandler := &disabled.MetricsHandler{}
 
 	return &ConsensusCore{
 		blockChain:              blockChain,
@@ -50,6 +52,7 @@ func initConsensusDataContainer() *ConsensusCore {
 		headerSigVerifier:       headerSigVerifier,
 		fallbackHeaderValidator: fallbackHeaderValidator,
 		nodeRedundancyHandler:   nodeRedundancyHandler,
+		metricsHandler:          metricsHandler,
 	}
 }
 
@@ -229,6 +232,17 @@ func TestConsensusContainerValidator_ValidateNilNodeRedundancyHandlerShouldFail(
 	assert.Equal(t, ErrNilNodeRedundancyHandler, err)
 }
 
+func TestConsensusContainerValidator_ValidateNilMetricsHandlerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := initConsensusDataContainer()
+	container.metricsHandler = nil
+
+	err := ValidateConsensusCore(container)
+
+	assert.Equal(t, ErrNilMetricsHandler, err)
+}
+
 func TestConsensusContainerValidator_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -216,3 +216,6 @@ var ErrNilFallbackHeaderValidator = errors.New("nil fallback header validator")
 
 // ErrNilNodeRedundancyHandler signals that provided node redundancy handler is nil
 var ErrNilNodeRedundancyHandler = errors.New("nil node redundancy handler")
+
+// ErrNilMetricsHandler signals that a nil metrics handler has been provided
+var ErrNilMetricsHandler = errors.New("nil metrics handler")
@@ -1,6 +1,7 @@
 package spos
 
 import (
+	"strings"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -125,6 +126,14 @@ func (sr *Subround) DoWork(roundHandler consensus.RoundHandler) bool {
 		return false
 	}
 
+	jobStartTime := time.Now()
+	done := sr.doWork(roundHandler)
+	sr.MetricsHandler().ObserveConsensusSubround(sr.metricsName(), done, time.Since(jobStartTime))
+
+	return done
+}
+
+func (sr *Subround) doWork(roundHandler consensus.RoundHandler) bool {
 	// execute stored messages which were received in this new round but before this initialisation
 	go sr.executeStoredMessages()
 
@@ -153,6 +162,11 @@ func (sr *Subround) DoWork(roundHandler consensus.RoundHandler) bool {
 	}
 }
 
+// metricsName returns the name of the Subround as a metrics label, e.g. "(START_ROUND)" becomes "start_round"
+func (sr *Subround) metricsName() string {
+	return strings.ToLower(strings.Trim(sr.name, "()"))
+}
+
 // Previous method returns the ID of the previous Subround
 func (sr *Subround) Previous() int {
 	return sr.previous
@@ -113,6 +113,9 @@ var ErrCacheConfigInvalidEconomics = errors.New("cache-economics parameter is no
 // ErrCacheConfigInvalidSharding signals that a sharding parameter required by the cache is invalid
 var ErrCacheConfigInvalidSharding = errors.New("cache-sharding parameter is not valid")
 
+// ErrCacheConfigInvalidValidityWindow signals that the cache parameter "validityWindowInRounds" is invalid
+var ErrCacheConfigInvalidValidityWindow = errors.New("cache parameter [validityWindowInRounds] is not valid, it must be a positive number when the validity window is enabled")
+
 // ErrNilTrieNodesPool signals that a nil trie nodes data pool was provided
 var ErrNilTrieNodesPool = errors.New("nil trie nodes data pool")
 
@@ -217,3 +220,6 @@ var ErrNilTrieStorageManager = errors.New("nil trie storage manager")
 
 // ErrNilPathManager signals that a nil path manager has been provided
 var ErrNilPathManager = errors.New("nil path manager")
+
+// ErrNilPeersThroughputHandler signals that a nil peers throughput handler has been provided
+var ErrNilPeersThroughputHandler = errors.New("nil peers throughput handler")
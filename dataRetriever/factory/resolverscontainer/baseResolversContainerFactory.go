@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers/topicResolverSender"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/throughput/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -37,6 +38,7 @@ type baseResolversContainerFactory struct {
 	isFullHistoryNode           bool
 	currentNetworkEpochProvider dataRetriever.CurrentNetworkEpochProviderHandler
 	preferredPeersHolder        dataRetriever.PreferredPeersHolderHandler
+	peersThroughputHandler      dataRetriever.PeersThroughputHandler
 	numCrossShardPeers          int
 	numIntraShardPeers          int
 	numFullHistoryPeers         int
@@ -82,6 +84,9 @@ func (brcf *baseResolversContainerFactory) checkParams() error {
 	if check.IfNil(brcf.preferredPeersHolder) {
 		return dataRetriever.ErrNilPreferredPeersHolder
 	}
+	if check.IfNil(brcf.peersThroughputHandler) {
+		return dataRetriever.ErrNilPeersThroughputHandler
+	}
 	if brcf.numCrossShardPeers <= 0 {
 		return fmt.Errorf("%w for numCrossShardPeers", dataRetriever.ErrInvalidValue)
 	}
@@ -262,6 +267,7 @@ func (brcf *baseResolversContainerFactory) createOneResolverSender(
 		brcf.numIntraShardPeers,
 		brcf.numFullHistoryPeers,
 		brcf.currentNetworkEpochProvider,
+		disabled.NewDisabledPeersThroughputTracker(),
 	)
 }
 
@@ -273,6 +279,7 @@ func (brcf *baseResolversContainerFactory) createOneResolverSenderWithSpecifiedN
 	numIntraShard int,
 	numFullHistory int,
 	currentNetworkEpochProvider dataRetriever.CurrentNetworkEpochProviderHandler,
+	peersThroughputHandler dataRetriever.PeersThroughputHandler,
 ) (dataRetriever.TopicResolverSender, error) {
 
 	peerListCreator, err := topicResolverSender.NewDiffPeerListCreator(brcf.messenger, topic, brcf.intraShardTopic, excludedTopic)
@@ -294,6 +301,7 @@ func (brcf *baseResolversContainerFactory) createOneResolverSenderWithSpecifiedN
 		CurrentNetworkEpochProvider: currentNetworkEpochProvider,
 		PreferredPeersHolder:        brcf.preferredPeersHolder,
 		SelfShardIdProvider:         brcf.shardCoordinator,
+		PeersThroughputHandler:      peersThroughputHandler,
 	}
 	//TODO instantiate topic sender resolver with the shard IDs for which this resolver is supposed to serve the data
 	// this will improve the serving of transactions as the searching will be done only on 2 sharded data units
@@ -322,6 +330,7 @@ func (brcf *baseResolversContainerFactory) createTrieNodesResolver(
 		numIntraShard,
 		numFullHistory,
 		currentNetworkEpochProviderHandler,
+		brcf.peersThroughputHandler,
 	)
 	if err != nil {
 		return nil, err
@@ -52,6 +52,7 @@ func NewMetaResolversContainerFactory(
 		isFullHistoryNode:           args.IsFullHistoryNode,
 		currentNetworkEpochProvider: args.CurrentNetworkEpochProvider,
 		preferredPeersHolder:        args.PreferredPeersHolder,
+		peersThroughputHandler:      args.PeersThroughputHandler,
 		numCrossShardPeers:          int(args.ResolverConfig.NumCrossShardPeers),
 		numIntraShardPeers:          int(args.ResolverConfig.NumIntraShardPeers),
 		numFullHistoryPeers:         int(args.ResolverConfig.NumFullHistoryPeers),
@@ -93,6 +93,10 @@ const (
 	ESDTSuppliesUnit UnitType = 18
 	// RoundHdrHashDataUnit is the round- block header hash storage data unit identifier
 	RoundHdrHashDataUnit UnitType = 19
+	// LogsIndexUnit is the (address, identifier) -> transactions with log events storage unit identifier
+	LogsIndexUnit UnitType = 20
+	// AddressHistoryUnit is the address -> transactions history storage unit identifier
+	AddressHistoryUnit UnitType = 21
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -260,6 +264,9 @@ type ShardedDataCacherNotifier interface {
 	Clear()
 	ClearShardStore(cacheId string)
 	GetCounts() counting.CountsWithSize
+	CheckTxReplacement(txHash []byte, sender []byte, nonce uint64, gasPrice uint64) error
+	NotifyRound(round uint64)
+	CheckTxExpiry(txHash []byte) error
 	IsInterfaceNil() bool
 }
 
@@ -402,3 +409,12 @@ type SelfShardIDProvider interface {
 	SelfId() uint32
 	IsInterfaceNil() bool
 }
+
+// PeersThroughputHandler defines the behavior of a component able to measure the throughput of the peers that answer
+// the requests and to order the peers accordingly
+type PeersThroughputHandler interface {
+	AddRequestedPeer(peer core.PeerID)
+	AddReceivedData(peer core.PeerID, numBytes int)
+	SortPeers(peers []core.PeerID) []core.PeerID
+	IsInterfaceNil() bool
+}
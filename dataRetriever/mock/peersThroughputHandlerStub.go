@@ -0,0 +1,40 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+)
+
+// PeersThroughputHandlerStub -
+type PeersThroughputHandlerStub struct {
+	AddRequestedPeerCalled func(peer core.PeerID)
+	AddReceivedDataCalled  func(peer core.PeerID, numBytes int)
+	SortPeersCalled        func(peers []core.PeerID) []core.PeerID
+}
+
+// AddRequestedPeer -
+func (stub *PeersThroughputHandlerStub) AddRequestedPeer(peer core.PeerID) {
+	if stub.AddRequestedPeerCalled != nil {
+		stub.AddRequestedPeerCalled(peer)
+	}
+}
+
+// AddReceivedData -
+func (stub *PeersThroughputHandlerStub) AddReceivedData(peer core.PeerID, numBytes int) {
+	if stub.AddReceivedDataCalled != nil {
+		stub.AddReceivedDataCalled(peer, numBytes)
+	}
+}
+
+// SortPeers -
+func (stub *PeersThroughputHandlerStub) SortPeers(peers []core.PeerID) []core.PeerID {
+	if stub.SortPeersCalled != nil {
+		return stub.SortPeersCalled(peers)
+	}
+
+	return peers
+}
+
+// IsInterfaceNil -
+func (stub *PeersThroughputHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
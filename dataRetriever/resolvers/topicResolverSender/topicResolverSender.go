@@ -39,6 +39,7 @@ type ArgTopicResolverSender struct {
 	CurrentNetworkEpochProvider dataRetriever.CurrentNetworkEpochProviderHandler
 	PreferredPeersHolder        dataRetriever.PreferredPeersHolderHandler
 	SelfShardIdProvider         dataRetriever.SelfShardIDProvider
+	PeersThroughputHandler      dataRetriever.PeersThroughputHandler
 	TargetShardId               uint32
 }
 
@@ -57,6 +58,7 @@ type topicResolverSender struct {
 	resolverDebugHandler               dataRetriever.ResolverDebugHandler
 	currentNetworkEpochProviderHandler dataRetriever.CurrentNetworkEpochProviderHandler
 	preferredPeersHolderHandler        dataRetriever.PreferredPeersHolderHandler
+	peersThroughputHandler             dataRetriever.PeersThroughputHandler
 	selfShardId                        uint32
 	targetShardId                      uint32
 }
@@ -87,6 +89,9 @@ func NewTopicResolverSender(arg ArgTopicResolverSender) (*topicResolverSender, e
 	if check.IfNil(arg.SelfShardIdProvider) {
 		return nil, dataRetriever.ErrNilSelfShardIDProvider
 	}
+	if check.IfNil(arg.PeersThroughputHandler) {
+		return nil, dataRetriever.ErrNilPeersThroughputHandler
+	}
 	if arg.NumIntraShardPeers < 0 {
 		return nil, fmt.Errorf("%w for NumIntraShardPeers as the value should be greater or equal than 0",
 			dataRetriever.ErrInvalidValue)
@@ -118,6 +123,7 @@ func NewTopicResolverSender(arg ArgTopicResolverSender) (*topicResolverSender, e
 		numFullHistoryPeers:                arg.NumFullHistoryPeers,
 		currentNetworkEpochProviderHandler: arg.CurrentNetworkEpochProvider,
 		preferredPeersHolderHandler:        arg.PreferredPeersHolder,
+		peersThroughputHandler:             arg.PeersThroughputHandler,
 	}
 	resolver.resolverDebugHandler = resolverDebug.NewDisabledInterceptorResolver()
 
@@ -197,6 +203,7 @@ func (trs *topicResolverSender) sendOnTopic(
 
 	indexes := createIndexList(len(peerList))
 	shuffledIndexes := random.FisherYatesShuffle(indexes, trs.randomizer)
+	shuffledIndexes = trs.sortIndexesByThroughput(shuffledIndexes, peerList)
 	logData := make([]interface{}, 0)
 	msgSentCounter := 0
 	shouldSendToPreferredPeer := preferredPeer != "" && maxToSend > 1
@@ -212,6 +219,7 @@ func (trs *topicResolverSender) sendOnTopic(
 			continue
 		}
 
+		trs.peersThroughputHandler.AddRequestedPeer(peer)
 		logData = append(logData, peerType)
 		logData = append(logData, peer.Pretty())
 		msgSentCounter++
@@ -225,6 +233,33 @@ func (trs *topicResolverSender) sendOnTopic(
 	return msgSentCounter
 }
 
+// sortIndexesByThroughput reorders the shuffled indexes so that the peers with a higher measured throughput are more
+// likely to be requested first
+func (trs *topicResolverSender) sortIndexesByThroughput(shuffledIndexes []int, peerList []core.PeerID) []int {
+	shuffledPeers := make([]core.PeerID, 0, len(shuffledIndexes))
+	peersIndexes := make(map[core.PeerID]int, len(shuffledIndexes))
+	for _, index := range shuffledIndexes {
+		shuffledPeers = append(shuffledPeers, peerList[index])
+		peersIndexes[peerList[index]] = index
+	}
+
+	sortedPeers := trs.peersThroughputHandler.SortPeers(shuffledPeers)
+	if len(sortedPeers) != len(shuffledIndexes) || len(peersIndexes) != len(shuffledIndexes) {
+		return shuffledIndexes
+	}
+
+	sortedIndexes := make([]int, 0, len(sortedPeers))
+	for _, peer := range sortedPeers {
+		index, found := peersIndexes[peer]
+		if !found {
+			return shuffledIndexes
+		}
+		sortedIndexes = append(sortedIndexes, index)
+	}
+
+	return sortedIndexes
+}
+
 func getPeerID(index int, peersList []core.PeerID, preferredPeer core.PeerID, peerType string, topic string, histogramMap map[string]int) core.PeerID {
 	if index == preferredPeerIndex {
 		histogramMap["preferred"]++
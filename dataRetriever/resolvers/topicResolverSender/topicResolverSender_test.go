@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"testing"
 
@@ -34,6 +35,7 @@ func createMockArgTopicResolverSender() topicResolverSender.ArgTopicResolverSend
 		NumFullHistoryPeers:         3,
 		CurrentNetworkEpochProvider: &mock.CurrentNetworkEpochProviderStub{},
 		SelfShardIdProvider:         mock.NewMultipleShardsCoordinatorMock(),
+		PeersThroughputHandler:      &mock.PeersThroughputHandlerStub{},
 		PreferredPeersHolder: &p2pmocks.PeersHolderStub{
 			GetCalled: func() map[uint32][]core.PeerID {
 				return map[uint32][]core.PeerID{}
@@ -132,6 +134,17 @@ func TestNewTopicResolverSender_NilSelfShardIDProviderShouldErr(t *testing.T) {
 	assert.Equal(t, dataRetriever.ErrNilSelfShardIDProvider, err)
 }
 
+func TestNewTopicResolverSender_NilPeersThroughputHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgTopicResolverSender()
+	arg.PeersThroughputHandler = nil
+	trs, err := topicResolverSender.NewTopicResolverSender(arg)
+
+	assert.True(t, check.IfNil(trs))
+	assert.Equal(t, dataRetriever.ErrNilPeersThroughputHandler, err)
+}
+
 func TestNewTopicResolverSender_InvalidNumIntraShardPeersShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -609,6 +622,93 @@ func TestTopicResolverSender_SendOnRequestShouldStopAfterSendingToRequiredNum(t
 	assert.Equal(t, arg.NumCrossShardPeers+arg.NumIntraShardPeers, numSent)
 }
 
+func TestTopicResolverSender_SendOnRequestShouldSendInTheOrderGivenByThePeersThroughputHandler(t *testing.T) {
+	t.Parallel()
+
+	pIDs := []core.PeerID{"pid1", "pid2", "pid3", "pid4", "pid5"}
+
+	sentPeers := make([]core.PeerID, 0)
+	requestedPeers := make([]core.PeerID, 0)
+	arg := createMockArgTopicResolverSender()
+	arg.NumIntraShardPeers = 0
+	arg.Messenger = &mock.MessageHandlerStub{
+		SendToConnectedPeerCalled: func(topic string, buff []byte, peerID core.PeerID) error {
+			sentPeers = append(sentPeers, peerID)
+			if peerID == "pid4" {
+				return errors.New("expected error")
+			}
+
+			return nil
+		},
+	}
+	arg.PeerListCreator = &mock.PeerListCreatorStub{
+		CrossShardPeerListCalled: func() []core.PeerID {
+			return pIDs
+		},
+		IntraShardPeerListCalled: func() []core.PeerID {
+			return make([]core.PeerID, 0)
+		},
+	}
+	arg.PeersThroughputHandler = &mock.PeersThroughputHandlerStub{
+		SortPeersCalled: func(peers []core.PeerID) []core.PeerID {
+			sortedPeers := make([]core.PeerID, len(peers))
+			copy(sortedPeers, peers)
+			sort.Slice(sortedPeers, func(i, j int) bool {
+				return sortedPeers[i] > sortedPeers[j]
+			})
+
+			return sortedPeers
+		},
+		AddRequestedPeerCalled: func(peer core.PeerID) {
+			requestedPeers = append(requestedPeers, peer)
+		},
+	}
+	trs, _ := topicResolverSender.NewTopicResolverSender(arg)
+
+	err := trs.SendOnRequestTopic(&dataRetriever.RequestData{}, defaultHashes)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []core.PeerID{"pid5", "pid4", "pid3"}, sentPeers)
+	assert.Equal(t, []core.PeerID{"pid5", "pid3"}, requestedPeers)
+}
+
+func TestTopicResolverSender_SendOnRequestShouldIgnoreAnInvalidOrderFromThePeersThroughputHandler(t *testing.T) {
+	t.Parallel()
+
+	pIDs := []core.PeerID{"pid1", "pid2", "pid3"}
+
+	sentPeers := make(map[core.PeerID]int)
+	arg := createMockArgTopicResolverSender()
+	arg.NumIntraShardPeers = 0
+	arg.NumCrossShardPeers = 3
+	arg.Messenger = &mock.MessageHandlerStub{
+		SendToConnectedPeerCalled: func(topic string, buff []byte, peerID core.PeerID) error {
+			sentPeers[peerID]++
+
+			return nil
+		},
+	}
+	arg.PeerListCreator = &mock.PeerListCreatorStub{
+		CrossShardPeerListCalled: func() []core.PeerID {
+			return pIDs
+		},
+		IntraShardPeerListCalled: func() []core.PeerID {
+			return make([]core.PeerID, 0)
+		},
+	}
+	arg.PeersThroughputHandler = &mock.PeersThroughputHandlerStub{
+		SortPeersCalled: func(peers []core.PeerID) []core.PeerID {
+			return []core.PeerID{"pid1", "unknown", "pid1"}
+		},
+	}
+	trs, _ := topicResolverSender.NewTopicResolverSender(arg)
+
+	err := trs.SendOnRequestTopic(&dataRetriever.RequestData{}, defaultHashes)
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[core.PeerID]int{"pid1": 1, "pid2": 1, "pid3": 1}, sentPeers)
+}
+
 func TestTopicResolverSender_SendOnRequestNoIntraShardShouldNotCallIntraShard(t *testing.T) {
 	t.Parallel()
 
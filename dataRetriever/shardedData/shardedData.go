@@ -271,6 +271,20 @@ func (sd *shardedData) GetCounts() counting.CountsWithSize {
 	return counts
 }
 
+// CheckTxReplacement returns nil, since the replacement of transactions is only handled by the transactions pool
+func (sd *shardedData) CheckTxReplacement(_ []byte, _ []byte, _ uint64, _ uint64) error {
+	return nil
+}
+
+// NotifyRound does nothing, since the validity window of transactions is only handled by the transactions pool
+func (sd *shardedData) NotifyRound(_ uint64) {
+}
+
+// CheckTxExpiry returns nil, since the validity window of transactions is only handled by the transactions pool
+func (sd *shardedData) CheckTxExpiry(_ []byte) error {
+	return nil
+}
+
 // Diagnose diagnoses the internal caches
 func (sd *shardedData) Diagnose(deep bool) {
 	log.Debug("shardedData.Diagnose()", "counts", sd.GetCounts().String())
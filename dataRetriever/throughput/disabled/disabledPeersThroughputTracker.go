@@ -0,0 +1,31 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+)
+
+type disabledPeersThroughputTracker struct {
+}
+
+// NewDisabledPeersThroughputTracker returns a new instance of a peers throughput tracker that does nothing
+func NewDisabledPeersThroughputTracker() *disabledPeersThroughputTracker {
+	return &disabledPeersThroughputTracker{}
+}
+
+// AddRequestedPeer does nothing
+func (d *disabledPeersThroughputTracker) AddRequestedPeer(_ core.PeerID) {
+}
+
+// AddReceivedData does nothing
+func (d *disabledPeersThroughputTracker) AddReceivedData(_ core.PeerID, _ int) {
+}
+
+// SortPeers returns the provided peers unchanged
+func (d *disabledPeersThroughputTracker) SortPeers(peers []core.PeerID) []core.PeerID {
+	return peers
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledPeersThroughputTracker) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -0,0 +1,210 @@
+package throughput
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+const (
+	measurementWindow = time.Second
+	// throughputSmoothingFactor is the weight of the newest window in the moving average of a peer's throughput
+	throughputSmoothingFactor = 0.3
+	// stalePeerDuration is the time after which a peer that was neither requested nor answered is forgotten
+	stalePeerDuration = 5 * time.Minute
+	// minWeightFactor makes the slowest peers still receive a part of the requests, so their throughput stays measured
+	minWeightFactor = 0.1
+	randomRange     = 1 << 30
+)
+
+var _ dataRetriever.PeersThroughputHandler = (*peersThroughputTracker)(nil)
+
+type peerThroughput struct {
+	numRequestsInWindow int
+	numBytesInWindow    int
+	bytesPerSecond      float64
+	isMeasured          bool
+	lastActivity        time.Time
+}
+
+// peersThroughputTracker measures, for each peer, the rate at which it sends the requested data. The measurement is done
+// over windows of one second and smoothed with a moving average. A peer's throughput is updated only in the windows in
+// which the peer was requested or sent data, so an idle peer keeps its last known value
+type peersThroughputTracker struct {
+	mut            sync.Mutex
+	peers          map[core.PeerID]*peerThroughput
+	windowStart    time.Time
+	randomizer     dataRetriever.IntRandomizer
+	getTimeHandler func() time.Time
+}
+
+// NewPeersThroughputTracker creates a new peers throughput tracker
+func NewPeersThroughputTracker(randomizer dataRetriever.IntRandomizer) (*peersThroughputTracker, error) {
+	if check.IfNil(randomizer) {
+		return nil, dataRetriever.ErrNilRandomizer
+	}
+
+	return &peersThroughputTracker{
+		peers:          make(map[core.PeerID]*peerThroughput),
+		windowStart:    time.Now(),
+		randomizer:     randomizer,
+		getTimeHandler: time.Now,
+	}, nil
+}
+
+// AddRequestedPeer records that a request was sent to the provided peer
+func (ptt *peersThroughputTracker) AddRequestedPeer(peer core.PeerID) {
+	ptt.mut.Lock()
+	defer ptt.mut.Unlock()
+
+	now := ptt.getTimeHandler()
+	ptt.updateWindow(now)
+
+	pt := ptt.getOrCreatePeer(peer)
+	pt.numRequestsInWindow++
+	pt.lastActivity = now
+}
+
+// AddReceivedData records the number of bytes received from the provided peer
+func (ptt *peersThroughputTracker) AddReceivedData(peer core.PeerID, numBytes int) {
+	if numBytes <= 0 {
+		return
+	}
+
+	ptt.mut.Lock()
+	defer ptt.mut.Unlock()
+
+	now := ptt.getTimeHandler()
+	ptt.updateWindow(now)
+
+	pt := ptt.getOrCreatePeer(peer)
+	pt.numBytesInWindow += numBytes
+	pt.lastActivity = now
+}
+
+func (ptt *peersThroughputTracker) getOrCreatePeer(peer core.PeerID) *peerThroughput {
+	pt, found := ptt.peers[peer]
+	if !found {
+		pt = &peerThroughput{}
+		ptt.peers[peer] = pt
+	}
+
+	return pt
+}
+
+func (ptt *peersThroughputTracker) updateWindow(now time.Time) {
+	elapsed := now.Sub(ptt.windowStart)
+	if elapsed < measurementWindow {
+		return
+	}
+
+	for peer, pt := range ptt.peers {
+		if now.Sub(pt.lastActivity) > stalePeerDuration {
+			delete(ptt.peers, peer)
+			continue
+		}
+		if pt.numRequestsInWindow == 0 && pt.numBytesInWindow == 0 {
+			continue
+		}
+
+		bytesPerSecond := float64(pt.numBytesInWindow) / elapsed.Seconds()
+		if pt.isMeasured {
+			bytesPerSecond = throughputSmoothingFactor*bytesPerSecond + (1-throughputSmoothingFactor)*pt.bytesPerSecond
+		}
+		pt.bytesPerSecond = bytesPerSecond
+		pt.isMeasured = true
+		pt.numRequestsInWindow = 0
+		pt.numBytesInWindow = 0
+	}
+
+	ptt.windowStart = now
+}
+
+// SortPeers returns the provided peers in a random order weighted by their throughput: a faster peer is more likely to
+// be placed before a slower one, but every peer can be placed first. The peers not yet measured get the average
+// throughput of the measured ones. If none of the peers is measured, the provided slice is returned unchanged
+func (ptt *peersThroughputTracker) SortPeers(peers []core.PeerID) []core.PeerID {
+	if len(peers) < 2 {
+		return peers
+	}
+
+	ptt.mut.Lock()
+	defer ptt.mut.Unlock()
+
+	ptt.updateWindow(ptt.getTimeHandler())
+
+	weights, ok := ptt.computeWeights(peers)
+	if !ok {
+		return peers
+	}
+
+	// weighted random sampling without replacement (Efraimidis-Spirakis): each peer gets the key ln(u)/weight, with
+	// u uniformly distributed in (0, 1], and the peers are ordered by descending keys
+	keys := make([]float64, len(peers))
+	for i, weight := range weights {
+		u := float64(ptt.randomizer.Intn(randomRange)+1) / float64(randomRange)
+		keys[i] = math.Log(u) / weight
+	}
+
+	indexes := make([]int, len(peers))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return keys[indexes[i]] > keys[indexes[j]]
+	})
+
+	sortedPeers := make([]core.PeerID, 0, len(peers))
+	for _, index := range indexes {
+		sortedPeers = append(sortedPeers, peers[index])
+	}
+
+	return sortedPeers
+}
+
+func (ptt *peersThroughputTracker) computeWeights(peers []core.PeerID) ([]float64, bool) {
+	weights := make([]float64, len(peers))
+	sum := 0.0
+	maxWeight := 0.0
+	numMeasured := 0
+	for i, peer := range peers {
+		pt, found := ptt.peers[peer]
+		if !found || !pt.isMeasured {
+			weights[i] = -1
+			continue
+		}
+
+		weights[i] = pt.bytesPerSecond
+		sum += pt.bytesPerSecond
+		numMeasured++
+		if pt.bytesPerSecond > maxWeight {
+			maxWeight = pt.bytesPerSecond
+		}
+	}
+	if numMeasured == 0 || maxWeight == 0 {
+		return nil, false
+	}
+
+	average := sum / float64(numMeasured)
+	minWeight := maxWeight * minWeightFactor
+	for i := range weights {
+		if weights[i] < 0 {
+			weights[i] = average
+		}
+		if weights[i] < minWeight {
+			weights[i] = minWeight
+		}
+	}
+
+	return weights, true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ptt *peersThroughputTracker) IsInterfaceNil() bool {
+	return ptt == nil
+}
@@ -0,0 +1,148 @@
+package throughput
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/random"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTrackerWithTime(randomizer dataRetriever.IntRandomizer) (*peersThroughputTracker, *time.Time) {
+	currentTime := time.Unix(1000, 0)
+	ptt, _ := NewPeersThroughputTracker(randomizer)
+	ptt.windowStart = currentTime
+	ptt.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+
+	return ptt, &currentTime
+}
+
+func TestNewPeersThroughputTracker(t *testing.T) {
+	t.Parallel()
+
+	ptt, err := NewPeersThroughputTracker(nil)
+	assert.True(t, check.IfNil(ptt))
+	assert.Equal(t, dataRetriever.ErrNilRandomizer, err)
+
+	ptt, err = NewPeersThroughputTracker(&mock.IntRandomizerStub{})
+	assert.False(t, check.IfNil(ptt))
+	assert.Nil(t, err)
+}
+
+func TestPeersThroughputTracker_SortPeersWithoutMeasurementsShouldNotChangeTheOrder(t *testing.T) {
+	t.Parallel()
+
+	ptt, currentTime := createTrackerWithTime(&mock.IntRandomizerStub{})
+	peers := []core.PeerID{"a", "b", "c"}
+
+	assert.Equal(t, peers, ptt.SortPeers(peers))
+
+	ptt.AddRequestedPeer("a")
+	assert.Equal(t, peers, ptt.SortPeers(peers))
+
+	// a peer requested but which did not answer is measured with 0 throughput
+	*currentTime = currentTime.Add(measurementWindow)
+	assert.Equal(t, peers, ptt.SortPeers(peers))
+}
+
+func TestPeersThroughputTracker_ThroughputShouldBeMeasuredOnWindows(t *testing.T) {
+	t.Parallel()
+
+	ptt, currentTime := createTrackerWithTime(&mock.IntRandomizerStub{})
+
+	ptt.AddRequestedPeer("a")
+	ptt.AddReceivedData("a", 1000)
+	ptt.AddReceivedData("a", 0)
+	assert.False(t, ptt.peers["a"].isMeasured)
+
+	*currentTime = currentTime.Add(measurementWindow)
+	ptt.AddReceivedData("b", 100)
+	assert.True(t, ptt.peers["a"].isMeasured)
+	assert.Equal(t, 1000.0, ptt.peers["a"].bytesPerSecond)
+	assert.False(t, ptt.peers["b"].isMeasured)
+
+	ptt.AddRequestedPeer("a")
+	*currentTime = currentTime.Add(2 * measurementWindow)
+	ptt.AddRequestedPeer("c")
+	expected := (1-throughputSmoothingFactor)*1000.0 + throughputSmoothingFactor*0
+	assert.InDelta(t, expected, ptt.peers["a"].bytesPerSecond, 0.001)
+	assert.Equal(t, 50.0, ptt.peers["b"].bytesPerSecond)
+}
+
+func TestPeersThroughputTracker_StalePeersShouldBeRemoved(t *testing.T) {
+	t.Parallel()
+
+	ptt, currentTime := createTrackerWithTime(&mock.IntRandomizerStub{})
+
+	ptt.AddReceivedData("a", 1000)
+	*currentTime = currentTime.Add(measurementWindow)
+	ptt.AddReceivedData("b", 1000)
+	assert.Equal(t, 2, len(ptt.peers))
+
+	*currentTime = currentTime.Add(stalePeerDuration)
+	ptt.AddReceivedData("b", 1000)
+	_, found := ptt.peers["a"]
+	assert.False(t, found)
+	_, found = ptt.peers["b"]
+	assert.True(t, found)
+}
+
+func TestPeersThroughputTracker_SortPeersShouldPreferTheFasterPeers(t *testing.T) {
+	t.Parallel()
+
+	ptt, currentTime := createTrackerWithTime(&random.ConcurrentSafeIntRandomizer{})
+	ptt.AddReceivedData("fast", 10000)
+	ptt.AddReceivedData("slow", 100)
+	*currentTime = currentTime.Add(measurementWindow)
+
+	peers := []core.PeerID{"slow", "unknown", "fast"}
+	numFirst := make(map[core.PeerID]int)
+	numTries := 10000
+	for i := 0; i < numTries; i++ {
+		sortedPeers := ptt.SortPeers(peers)
+		assert.Equal(t, len(peers), len(sortedPeers))
+		assert.ElementsMatch(t, peers, sortedPeers)
+		numFirst[sortedPeers[0]]++
+	}
+
+	// weights: fast 10000, unknown 5050 (the average), slow 1000 (the minimum weight)
+	assert.True(t, numFirst["fast"] > numFirst["unknown"])
+	assert.True(t, numFirst["unknown"] > numFirst["slow"])
+	assert.True(t, numFirst["slow"] > 0)
+	assert.Equal(t, []core.PeerID{"slow", "unknown", "fast"}, peers)
+}
+
+func TestPeersThroughputTracker_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	ptt, _ := NewPeersThroughputTracker(&random.ConcurrentSafeIntRandomizer{})
+	peers := []core.PeerID{"a", "b", "c", "d"}
+
+	numCalls := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			peer := core.PeerID(fmt.Sprintf("%d", idx%4))
+			switch idx % 3 {
+			case 0:
+				ptt.AddRequestedPeer(peer)
+			case 1:
+				ptt.AddReceivedData(peer, idx)
+			case 2:
+				_ = ptt.SortPeers(peers)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
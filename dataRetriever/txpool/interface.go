@@ -15,4 +15,10 @@ type txCache interface {
 	ForEachTransaction(function txcache.ForEachTransaction)
 	NumBytes() int
 	Diagnose(deep bool)
+	GetSenderSnapshot(sender []byte) (*txcache.SenderSnapshot, bool)
+	GetStatistics() txcache.Statistics
+	GetEvictedTransaction(txHash []byte) (*txcache.EvictedTransaction, bool)
+	CheckTxReplacement(txHash []byte, sender []byte, nonce uint64, gasPrice uint64) error
+	NotifyRound(round uint64)
+	CheckTxExpiry(txHash []byte) error
 }
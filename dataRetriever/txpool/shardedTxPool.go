@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
 	"github.com/ElrondNetwork/elrond-go-core/core/counting"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -28,6 +29,7 @@ type shardedTxPool struct {
 	configPrototypeSourceMe      txcache.ConfigSourceMe
 	selfShardID                  uint32
 	txGasHandler                 txcache.TxGasHandler
+	currentRound                 atomic.Uint64
 }
 
 type txPoolShard struct {
@@ -49,13 +51,16 @@ func NewShardedTxPool(args ArgShardedTxPool) (*shardedTxPool, error) {
 	halfOfCapacity := args.Config.Capacity / 2
 
 	configPrototypeSourceMe := txcache.ConfigSourceMe{
-		NumChunks:                     args.Config.Shards,
-		EvictionEnabled:               true,
-		NumBytesThreshold:             uint32(halfOfSizeInBytes),
-		CountThreshold:                halfOfCapacity,
-		NumBytesPerSenderThreshold:    args.Config.SizeInBytesPerSender,
-		CountPerSenderThreshold:       args.Config.SizePerSender,
-		NumSendersToPreemptivelyEvict: dataRetriever.TxPoolNumSendersToPreemptivelyEvict,
+		NumChunks:                      args.Config.Shards,
+		EvictionEnabled:                true,
+		NumBytesThreshold:              uint32(halfOfSizeInBytes),
+		CountThreshold:                 halfOfCapacity,
+		NumBytesPerSenderThreshold:     args.Config.SizeInBytesPerSender,
+		CountPerSenderThreshold:        args.Config.SizePerSender,
+		NumSendersToPreemptivelyEvict:  dataRetriever.TxPoolNumSendersToPreemptivelyEvict,
+		ReplacementGasPriceBumpPercent: args.Config.ReplacementGasPriceBumpPercent,
+		ValidityWindowEnabled:          args.Config.ValidityWindowEnabled,
+		ValidityWindowInRounds:         args.Config.ValidityWindowInRounds,
 	}
 
 	// We do not reserve cross tx cache capacity for [metachain] -> [me] (no transactions), [me] -> me (already reserved above).
@@ -63,10 +68,11 @@ func NewShardedTxPool(args ArgShardedTxPool) (*shardedTxPool, error) {
 	numCrossTxCaches := core.MaxUint32(1, args.NumberOfShards-1)
 
 	configPrototypeDestinationMe := txcache.ConfigDestinationMe{
-		NumChunks:                   args.Config.Shards,
-		MaxNumBytes:                 uint32(halfOfSizeInBytes) / numCrossTxCaches,
-		MaxNumItems:                 halfOfCapacity / numCrossTxCaches,
-		NumItemsToPreemptivelyEvict: dataRetriever.TxPoolNumTxsToPreemptivelyEvict,
+		NumChunks:                      args.Config.Shards,
+		MaxNumBytes:                    uint32(halfOfSizeInBytes) / numCrossTxCaches,
+		MaxNumItems:                    halfOfCapacity / numCrossTxCaches,
+		NumItemsToPreemptivelyEvict:    dataRetriever.TxPoolNumTxsToPreemptivelyEvict,
+		ReplacementGasPriceBumpPercent: args.Config.ReplacementGasPriceBumpPercent,
 	}
 
 	shardedTxPoolObject := &shardedTxPool{
@@ -117,6 +123,7 @@ func (txPool *shardedTxPool) createShard(cacheID string) *txPoolShard {
 	shard, ok := txPool.backingMap[cacheID]
 	if !ok {
 		cache := txPool.createTxCache(cacheID)
+		cache.NotifyRound(txPool.currentRound.Get())
 		shard = &txPoolShard{
 			CacheID: cacheID,
 			Cache:   cache,
@@ -339,6 +346,108 @@ func (txPool *shardedTxPool) Diagnose(deep bool) {
 	}
 }
 
+// ForEachTransaction iterates over the transactions of all caches of the pool
+func (txPool *shardedTxPool) ForEachTransaction(function txcache.ForEachTransaction) {
+	if function == nil {
+		return
+	}
+
+	for _, shard := range txPool.getShards() {
+		shard.Cache.ForEachTransaction(function)
+	}
+}
+
+// GetSenderSnapshot returns the nonce-ordered transactions of a sender from the own shard, along with the sender's
+// state as seen by the cache
+func (txPool *shardedTxPool) GetSenderSnapshot(sender []byte) (*txcache.SenderSnapshot, bool) {
+	cacheID := strconv.Itoa(int(txPool.selfShardID))
+
+	txPool.mutexBackingMap.RLock()
+	shard, ok := txPool.backingMap[cacheID]
+	txPool.mutexBackingMap.RUnlock()
+	if !ok {
+		return nil, false
+	}
+
+	return shard.Cache.GetSenderSnapshot(sender)
+}
+
+// GetStatistics returns aggregate information about each cache of the pool, by cache ID
+func (txPool *shardedTxPool) GetStatistics() map[string]txcache.Statistics {
+	statistics := make(map[string]txcache.Statistics)
+	for _, shard := range txPool.getShards() {
+		statistics[shard.CacheID] = shard.Cache.GetStatistics()
+	}
+
+	return statistics
+}
+
+// GetEvictedTransaction returns the details about a transaction recently evicted from the pool, along with the ID of
+// the cache it was evicted from. If evicted from more caches, the latest eviction is returned
+func (txPool *shardedTxPool) GetEvictedTransaction(txHash []byte) (*txcache.EvictedTransaction, string, bool) {
+	var latest *txcache.EvictedTransaction
+	latestCacheID := ""
+	for _, shard := range txPool.getShards() {
+		evicted, ok := shard.Cache.GetEvictedTransaction(txHash)
+		if !ok {
+			continue
+		}
+		if latest == nil || evicted.Timestamp > latest.Timestamp {
+			latest = evicted
+			latestCacheID = shard.CacheID
+		}
+	}
+
+	return latest, latestCacheID, latest != nil
+}
+
+// CheckTxReplacement verifies that a transaction having the same sender and nonce as a pending one is priced high
+// enough to replace it (all caches are checked)
+func (txPool *shardedTxPool) CheckTxReplacement(txHash []byte, sender []byte, nonce uint64, gasPrice uint64) error {
+	for _, shard := range txPool.getShards() {
+		err := shard.Cache.CheckTxReplacement(txHash, sender, nonce, gasPrice)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NotifyRound informs all caches about the round of the last committed block, so that they can drop the transactions
+// which stayed in the pool for more than the validity window
+func (txPool *shardedTxPool) NotifyRound(round uint64) {
+	txPool.currentRound.Set(round)
+	for _, shard := range txPool.getShards() {
+		shard.Cache.NotifyRound(round)
+	}
+}
+
+// CheckTxExpiry returns an error if the transaction was recently dropped from one of the caches because it stayed in
+// the pool for more than the validity window
+func (txPool *shardedTxPool) CheckTxExpiry(txHash []byte) error {
+	for _, shard := range txPool.getShards() {
+		err := shard.Cache.CheckTxExpiry(txHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (txPool *shardedTxPool) getShards() []*txPoolShard {
+	txPool.mutexBackingMap.RLock()
+	defer txPool.mutexBackingMap.RUnlock()
+
+	shards := make([]*txPoolShard, 0, len(txPool.backingMap))
+	for _, shard := range txPool.backingMap {
+		shards = append(shards, shard)
+	}
+
+	return shards
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (txPool *shardedTxPool) IsInterfaceNil() bool {
 	return txPool == nil
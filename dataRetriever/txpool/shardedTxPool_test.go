@@ -1,6 +1,7 @@
 package txpool
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -11,7 +12,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
 	"github.com/stretchr/testify/require"
 )
@@ -76,6 +79,13 @@ func Test_NewShardedTxPool_WhenBadConfig(t *testing.T) {
 	require.NotNil(t, err)
 	require.Errorf(t, err, dataRetriever.ErrCacheConfigInvalidShards.Error())
 
+	args = goodArgs
+	args.Config.ValidityWindowEnabled = true
+	args.Config.ValidityWindowInRounds = 0
+	pool, err = NewShardedTxPool(args)
+	require.Nil(t, pool)
+	require.True(t, errors.Is(err, dataRetriever.ErrCacheConfigInvalidValidityWindow))
+
 	args = goodArgs
 	args.TxGasHandler = &txcachemocks.TxGasHandlerMock{
 		MinimumGasMove:       50000,
@@ -333,6 +343,199 @@ func Test_GetCounts(t *testing.T) {
 	require.Equal(t, int64(0), pool.GetCounts().GetTotal())
 }
 
+func Test_ForEachTransaction(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("alice", 43), 0, "0_1")
+	pool.AddData([]byte("hash-z"), createTx("bob", 15), 0, "1_0")
+
+	pool.ForEachTransaction(nil)
+
+	visited := make(map[string]*txcache.WrappedTransaction)
+	pool.ForEachTransaction(func(txHash []byte, tx *txcache.WrappedTransaction) {
+		visited[string(txHash)] = tx
+	})
+	require.Len(t, visited, 3)
+	require.Equal(t, uint32(0), visited["hash-y"].SenderShardID)
+	require.Equal(t, uint32(1), visited["hash-y"].ReceiverShardID)
+	require.Equal(t, uint32(1), visited["hash-z"].SenderShardID)
+	require.Equal(t, uint32(0), visited["hash-z"].ReceiverShardID)
+}
+
+func Test_GetSenderSnapshot(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	snapshot, ok := pool.GetSenderSnapshot([]byte("alice"))
+	require.False(t, ok)
+	require.Nil(t, snapshot)
+	require.Len(t, pool.getShards(), 0)
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 43), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("alice", 42), 0, "0_1")
+	pool.AddData([]byte("hash-z"), createTx("bob", 15), 0, "1_0")
+
+	snapshot, ok = pool.GetSenderSnapshot([]byte("alice"))
+	require.True(t, ok)
+	require.Len(t, snapshot.Transactions, 2)
+	require.Equal(t, []byte("hash-y"), snapshot.Transactions[0].TxHash)
+	require.Equal(t, []byte("hash-x"), snapshot.Transactions[1].TxHash)
+
+	snapshot, ok = pool.GetSenderSnapshot([]byte("bob"))
+	require.False(t, ok)
+	require.Nil(t, snapshot)
+}
+
+func Test_GetStatistics(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	require.Len(t, pool.GetStatistics(), 0)
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("alice", 43), 0, "0_1")
+	pool.AddData([]byte("hash-z"), createTx("bob", 15), 0, "1_0")
+
+	statistics := pool.GetStatistics()
+	require.Len(t, statistics, 2)
+	require.Equal(t, uint64(2), statistics["0"].NumTxs)
+	require.Equal(t, uint64(1), statistics["0"].NumSenders)
+	require.Equal(t, uint64(1), statistics["1_0"].NumTxs)
+	require.Equal(t, uint64(0), statistics["1_0"].NumSenders)
+}
+
+func Test_GetEvictedTransaction(t *testing.T) {
+	config := storageUnit.CacheConfig{
+		Capacity:             100,
+		SizePerSender:        1,
+		SizeInBytes:          409600,
+		SizeInBytesPerSender: 40960,
+		Shards:               1,
+	}
+	args := ArgShardedTxPool{
+		Config: config,
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      200000000000,
+			GasProcessingDivisor: 100,
+		},
+		NumberOfShards: 4,
+		SelfShardID:    0,
+	}
+	pool, _ := NewShardedTxPool(args)
+
+	evicted, cacheID, ok := pool.GetEvictedTransaction([]byte("hash-y"))
+	require.False(t, ok)
+	require.Nil(t, evicted)
+	require.Equal(t, "", cacheID)
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("alice", 43), 0, "0")
+	pool.AddData([]byte("hash-z"), createTx("bob", 15), 0, "1_0")
+
+	evicted, cacheID, ok = pool.GetEvictedTransaction([]byte("hash-y"))
+	require.True(t, ok)
+	require.Equal(t, "0", cacheID)
+	require.Equal(t, []byte("hash-y"), evicted.TxHash)
+	require.Equal(t, txcache.EvictionReasonSenderLimit, evicted.Reason)
+
+	_, _, ok = pool.GetEvictedTransaction([]byte("hash-x"))
+	require.False(t, ok)
+	_, _, ok = pool.GetEvictedTransaction([]byte("hash-z"))
+	require.False(t, ok)
+}
+
+func Test_AddDataShouldReplaceAndCheckTxReplacement(t *testing.T) {
+	config := storageUnit.CacheConfig{
+		Capacity:                       100,
+		SizePerSender:                  10,
+		SizeInBytes:                    409600,
+		SizeInBytesPerSender:           40960,
+		Shards:                         1,
+		ReplacementGasPriceBumpPercent: 10,
+	}
+	args := ArgShardedTxPool{
+		Config: config,
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      200000000000,
+			GasProcessingDivisor: 100,
+		},
+		NumberOfShards: 4,
+		SelfShardID:    0,
+	}
+	pool, err := NewShardedTxPool(args)
+	require.Nil(t, err)
+
+	require.Nil(t, pool.CheckTxReplacement([]byte("hash-y"), []byte("alice"), 42, 1000))
+
+	pool.AddData([]byte("hash-x"), createTxWithGasPrice("alice", 42, 1000), 0, "0")
+	err = pool.CheckTxReplacement([]byte("hash-y"), []byte("alice"), 42, 1050)
+	require.True(t, errors.Is(err, storage.ErrTxReplacementUnderpriced))
+	require.Nil(t, pool.CheckTxReplacement([]byte("hash-y"), []byte("alice"), 42, 1100))
+	require.Nil(t, pool.CheckTxReplacement([]byte("hash-x"), []byte("alice"), 42, 1000))
+
+	pool.AddData([]byte("hash-y"), createTxWithGasPrice("alice", 42, 1100), 0, "0_1")
+	_, ok := pool.SearchFirstData([]byte("hash-x"))
+	require.False(t, ok)
+	_, ok = pool.SearchFirstData([]byte("hash-y"))
+	require.True(t, ok)
+
+	evicted, cacheID, ok := pool.GetEvictedTransaction([]byte("hash-x"))
+	require.True(t, ok)
+	require.Equal(t, "0", cacheID)
+	require.Equal(t, []byte("alice"), evicted.Sender)
+	require.Equal(t, txcache.EvictionReasonReplaced, evicted.Reason)
+}
+
+func Test_NotifyRoundShouldDropTheExpiredTxsAndCheckTxExpiry(t *testing.T) {
+	config := storageUnit.CacheConfig{
+		Capacity:               100,
+		SizePerSender:          10,
+		SizeInBytes:            409600,
+		SizeInBytesPerSender:   40960,
+		Shards:                 1,
+		ValidityWindowEnabled:  true,
+		ValidityWindowInRounds: 5,
+	}
+	args := ArgShardedTxPool{
+		Config: config,
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      200000000000,
+			GasProcessingDivisor: 100,
+		},
+		NumberOfShards: 4,
+		SelfShardID:    0,
+	}
+	pool, err := NewShardedTxPool(args)
+	require.Nil(t, err)
+
+	pool.NotifyRound(10)
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("bob", 7), 0, "1_0")
+	pool.NotifyRound(15)
+	require.Nil(t, pool.CheckTxExpiry([]byte("hash-x")))
+
+	pool.NotifyRound(16)
+	_, ok := pool.SearchFirstData([]byte("hash-x"))
+	require.False(t, ok)
+	err = pool.CheckTxExpiry([]byte("hash-x"))
+	require.True(t, errors.Is(err, storage.ErrTxExpired))
+
+	// The cross shard transactions are not subject to the validity window
+	_, ok = pool.SearchFirstData([]byte("hash-y"))
+	require.True(t, ok)
+	require.Nil(t, pool.CheckTxExpiry([]byte("hash-y")))
+
+	evicted, cacheID, ok := pool.GetEvictedTransaction([]byte("hash-x"))
+	require.True(t, ok)
+	require.Equal(t, "0", cacheID)
+	require.Equal(t, txcache.EvictionReasonExpired, evicted.Reason)
+}
+
 func Test_IsInterfaceNil(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	require.False(t, check.IfNil(poolAsInterface))
@@ -381,6 +584,14 @@ func createTx(sender string, nonce uint64) data.TransactionHandler {
 	}
 }
 
+func createTxWithGasPrice(sender string, nonce uint64, gasPrice uint64) data.TransactionHandler {
+	return &transaction.Transaction{
+		SndAddr:  []byte(sender),
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+	}
+}
+
 func waitABit() {
 	time.Sleep(10 * time.Millisecond)
 }
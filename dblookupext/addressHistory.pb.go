@@ -0,0 +1,971 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: addressHistory.proto
+
+package dblookupext
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AddressHistoryEntry is used to store a transaction (regular transaction, smart contract result or reward)
+// that was sent or received by an address
+type AddressHistoryEntry struct {
+	TxHash        []byte `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+	Epoch         uint32 `protobuf:"varint,2,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	BlockNonce    uint64 `protobuf:"varint,3,opt,name=BlockNonce,proto3" json:"BlockNonce,omitempty"`
+	Direction     uint32 `protobuf:"varint,4,opt,name=Direction,proto3" json:"Direction,omitempty"`
+	MiniblockType int32  `protobuf:"varint,5,opt,name=MiniblockType,proto3" json:"MiniblockType,omitempty"`
+}
+
+func (m *AddressHistoryEntry) Reset()      { *m = AddressHistoryEntry{} }
+func (*AddressHistoryEntry) ProtoMessage() {}
+func (*AddressHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3a475f4d12d5066, []int{0}
+}
+func (m *AddressHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AddressHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressHistoryEntry.Merge(m, src)
+}
+func (m *AddressHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressHistoryEntry proto.InternalMessageInfo
+
+func (m *AddressHistoryEntry) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *AddressHistoryEntry) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *AddressHistoryEntry) GetBlockNonce() uint64 {
+	if m != nil {
+		return m.BlockNonce
+	}
+	return 0
+}
+
+func (m *AddressHistoryEntry) GetDirection() uint32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+func (m *AddressHistoryEntry) GetMiniblockType() int32 {
+	if m != nil {
+		return m.MiniblockType
+	}
+	return 0
+}
+
+// AddressHistoryCounter is used to store the number of history entries of an address
+type AddressHistoryCounter struct {
+	NumEntries uint64 `protobuf:"varint,1,opt,name=NumEntries,proto3" json:"NumEntries,omitempty"`
+}
+
+func (m *AddressHistoryCounter) Reset()      { *m = AddressHistoryCounter{} }
+func (*AddressHistoryCounter) ProtoMessage() {}
+func (*AddressHistoryCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3a475f4d12d5066, []int{1}
+}
+func (m *AddressHistoryCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressHistoryCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AddressHistoryCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressHistoryCounter.Merge(m, src)
+}
+func (m *AddressHistoryCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressHistoryCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressHistoryCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressHistoryCounter proto.InternalMessageInfo
+
+func (m *AddressHistoryCounter) GetNumEntries() uint64 {
+	if m != nil {
+		return m.NumEntries
+	}
+	return 0
+}
+
+// AddressesInBlock is used to store the addresses that received history entries in a block, so that the entries
+// can be removed when the block is reverted
+type AddressesInBlock struct {
+	Addresses [][]byte `protobuf:"bytes,1,rep,name=Addresses,proto3" json:"Addresses,omitempty"`
+}
+
+func (m *AddressesInBlock) Reset()      { *m = AddressesInBlock{} }
+func (*AddressesInBlock) ProtoMessage() {}
+func (*AddressesInBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3a475f4d12d5066, []int{2}
+}
+func (m *AddressesInBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressesInBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AddressesInBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressesInBlock.Merge(m, src)
+}
+func (m *AddressesInBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressesInBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressesInBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressesInBlock proto.InternalMessageInfo
+
+func (m *AddressesInBlock) GetAddresses() [][]byte {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AddressHistoryEntry)(nil), "proto.AddressHistoryEntry")
+	proto.RegisterType((*AddressHistoryCounter)(nil), "proto.AddressHistoryCounter")
+	proto.RegisterType((*AddressesInBlock)(nil), "proto.AddressesInBlock")
+}
+
+func init() { proto.RegisterFile("addressHistory.proto", fileDescriptor_e3a475f4d12d5066) }
+
+var fileDescriptor_e3a475f4d12d5066 = []byte{
+	// 319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x31, 0x4f, 0xc2, 0x40,
+	0x14, 0xc7, 0xfb, 0x84, 0x92, 0x78, 0x42, 0x62, 0x2a, 0x9a, 0xc6, 0x98, 0x97, 0x86, 0x38, 0x74,
+	0x11, 0x4c, 0x1c, 0x9c, 0x45, 0x49, 0x70, 0x90, 0xa1, 0x61, 0x72, 0xa3, 0xe5, 0x84, 0x0b, 0xd0,
+	0xd7, 0xf4, 0xae, 0x09, 0x6c, 0x7e, 0x04, 0xbf, 0x85, 0x7e, 0x14, 0x47, 0x46, 0x46, 0x39, 0x16,
+	0x47, 0x3e, 0x82, 0xe9, 0x41, 0x04, 0xa6, 0xbb, 0xff, 0x2f, 0xf7, 0xbf, 0xf7, 0xcb, 0x63, 0xd5,
+	0x5e, 0xbf, 0x9f, 0x72, 0x29, 0xdb, 0x42, 0x2a, 0x4a, 0x67, 0xf5, 0x24, 0x25, 0x45, 0x8e, 0x6d,
+	0x8e, 0xcb, 0x9b, 0x81, 0x50, 0xc3, 0x2c, 0xac, 0x47, 0x34, 0x69, 0x0c, 0x68, 0x40, 0x0d, 0x83,
+	0xc3, 0xec, 0xcd, 0x24, 0x13, 0xcc, 0x6d, 0xd3, 0xaa, 0x7d, 0x02, 0x3b, 0x7b, 0x38, 0xf8, 0xae,
+	0x15, 0xab, 0x74, 0xe6, 0x5c, 0xb0, 0x52, 0x77, 0xda, 0xee, 0xc9, 0xa1, 0x0b, 0x1e, 0xf8, 0xe5,
+	0x60, 0x9b, 0x9c, 0x2a, 0xb3, 0x5b, 0x09, 0x45, 0x43, 0xf7, 0xc8, 0x03, 0xbf, 0x12, 0x6c, 0x82,
+	0x83, 0x8c, 0x35, 0xc7, 0x14, 0x8d, 0x3a, 0x14, 0x47, 0xdc, 0x2d, 0x78, 0xe0, 0x17, 0x83, 0x3d,
+	0xe2, 0x5c, 0xb1, 0xe3, 0x27, 0x91, 0xf2, 0x48, 0x09, 0x8a, 0xdd, 0xa2, 0x69, 0xee, 0x80, 0x73,
+	0xcd, 0x2a, 0x2f, 0x22, 0x16, 0x61, 0xfe, 0xbe, 0x3b, 0x4b, 0xb8, 0x6b, 0x7b, 0xe0, 0xdb, 0xc1,
+	0x21, 0xac, 0xdd, 0xb3, 0xf3, 0x43, 0xd1, 0x47, 0xca, 0x62, 0xc5, 0xd3, 0x7c, 0x78, 0x27, 0x9b,
+	0xe4, 0xda, 0x82, 0x4b, 0xa3, 0x5b, 0x0c, 0xf6, 0x48, 0xed, 0x96, 0x9d, 0x6e, 0x8b, 0x5c, 0x3e,
+	0xc7, 0xc6, 0x2a, 0x17, 0xfa, 0x67, 0x2e, 0x78, 0x05, 0xbf, 0x1c, 0xec, 0x40, 0xb3, 0x35, 0x5f,
+	0xa2, 0xb5, 0x58, 0xa2, 0xb5, 0x5e, 0x22, 0xbc, 0x6b, 0x84, 0x2f, 0x8d, 0xf0, 0xad, 0x11, 0xe6,
+	0x1a, 0x61, 0xa1, 0x11, 0x7e, 0x34, 0xc2, 0xaf, 0x46, 0x6b, 0xad, 0x11, 0x3e, 0x56, 0x68, 0xcd,
+	0x57, 0x68, 0x2d, 0x56, 0x68, 0xbd, 0x9e, 0xf4, 0xc3, 0x31, 0xd1, 0x28, 0x4b, 0xf8, 0x54, 0x85,
+	0x25, 0xb3, 0xe2, 0xbb, 0xbf, 0x01, 0x00, 0x80, 0xac, 0x33, 0xd0, 0xb0, 0x01, 0x00, 0x00,
+}
+
+func (this *AddressHistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressHistoryEntry)
+	if !ok {
+		that2, ok := that.(AddressHistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.BlockNonce != that1.BlockNonce {
+		return false
+	}
+	if this.Direction != that1.Direction {
+		return false
+	}
+	if this.MiniblockType != that1.MiniblockType {
+		return false
+	}
+	return true
+}
+func (this *AddressHistoryCounter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressHistoryCounter)
+	if !ok {
+		that2, ok := that.(AddressHistoryCounter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NumEntries != that1.NumEntries {
+		return false
+	}
+	return true
+}
+func (this *AddressesInBlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressesInBlock)
+	if !ok {
+		that2, ok := that.(AddressesInBlock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if !bytes.Equal(this.Addresses[i], that1.Addresses[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AddressHistoryEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&dblookupext.AddressHistoryEntry{")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "BlockNonce: "+fmt.Sprintf("%#v", this.BlockNonce)+",\n")
+	s = append(s, "Direction: "+fmt.Sprintf("%#v", this.Direction)+",\n")
+	s = append(s, "MiniblockType: "+fmt.Sprintf("%#v", this.MiniblockType)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddressHistoryCounter) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&dblookupext.AddressHistoryCounter{")
+	s = append(s, "NumEntries: "+fmt.Sprintf("%#v", this.NumEntries)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddressesInBlock) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&dblookupext.AddressesInBlock{")
+	s = append(s, "Addresses: "+fmt.Sprintf("%#v", this.Addresses)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringAddressHistory(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *AddressHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MiniblockType != 0 {
+		i = encodeVarintAddressHistory(dAtA, i, uint64(m.MiniblockType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Direction != 0 {
+		i = encodeVarintAddressHistory(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockNonce != 0 {
+		i = encodeVarintAddressHistory(dAtA, i, uint64(m.BlockNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintAddressHistory(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintAddressHistory(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressHistoryCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressHistoryCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressHistoryCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumEntries != 0 {
+		i = encodeVarintAddressHistory(dAtA, i, uint64(m.NumEntries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressesInBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressesInBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressesInBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintAddressHistory(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAddressHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAddressHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddressHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovAddressHistory(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovAddressHistory(uint64(m.Epoch))
+	}
+	if m.BlockNonce != 0 {
+		n += 1 + sovAddressHistory(uint64(m.BlockNonce))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovAddressHistory(uint64(m.Direction))
+	}
+	if m.MiniblockType != 0 {
+		n += 1 + sovAddressHistory(uint64(m.MiniblockType))
+	}
+	return n
+}
+
+func (m *AddressHistoryCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumEntries != 0 {
+		n += 1 + sovAddressHistory(uint64(m.NumEntries))
+	}
+	return n
+}
+
+func (m *AddressesInBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, b := range m.Addresses {
+			l = len(b)
+			n += 1 + l + sovAddressHistory(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAddressHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAddressHistory(x uint64) (n int) {
+	return sovAddressHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AddressHistoryEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddressHistoryEntry{`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`BlockNonce:` + fmt.Sprintf("%v", this.BlockNonce) + `,`,
+		`Direction:` + fmt.Sprintf("%v", this.Direction) + `,`,
+		`MiniblockType:` + fmt.Sprintf("%v", this.MiniblockType) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddressHistoryCounter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddressHistoryCounter{`,
+		`NumEntries:` + fmt.Sprintf("%v", this.NumEntries) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddressesInBlock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddressesInBlock{`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringAddressHistory(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AddressHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAddressHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNonce", wireType)
+			}
+			m.BlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniblockType", wireType)
+			}
+			m.MiniblockType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MiniblockType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAddressHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressHistoryCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAddressHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressHistoryCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressHistoryCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEntries", wireType)
+			}
+			m.NumEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAddressHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressesInBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAddressHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressesInBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressesInBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
+			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAddressHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAddressHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAddressHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAddressHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAddressHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAddressHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAddressHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAddressHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAddressHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAddressHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAddressHistory = fmt.Errorf("proto: unexpected end of group")
+)
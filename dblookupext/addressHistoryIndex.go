@@ -0,0 +1,255 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. addressHistory.proto
+
+package dblookupext
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	// DirectionIncoming marks a history entry of a transaction received by the address
+	DirectionIncoming uint32 = 1
+	// DirectionOutgoing marks a history entry of a transaction sent by the address
+	DirectionOutgoing uint32 = 2
+	// DirectionSelf marks a history entry of a transaction sent by the address to itself
+	DirectionSelf uint32 = 3
+)
+
+var addressesInBlockKeyPrefix = []byte("addressesInBlock")
+
+// the order in which the transactions of a block are appended to the history of an address
+var addressHistoryTxTypes = []block.Type{block.TxBlock, block.SmartContractResultBlock, block.RewardsBlock}
+
+// addressHistoryIndex keeps, for each address, the ordered list of transactions sent or received by that address.
+// The entries of an address are stored under (address + index) keys, while the number of entries is stored under
+// the address key, so that a page of the history can be fetched without reading the whole list
+type addressHistoryIndex struct {
+	marshalizer marshal.Marshalizer
+	storer      storage.Storer
+}
+
+func newAddressHistoryIndex(storer storage.Storer, marshalizer marshal.Marshalizer) *addressHistoryIndex {
+	return &addressHistoryIndex{
+		marshalizer: marshalizer,
+		storer:      storer,
+	}
+}
+
+// saveTransactions appends to the history of each involved address the provided transactions, grouped by
+// their default miniblock type. The type found in the block body (e.g. for invalid transactions) takes precedence
+func (ahi *addressHistoryIndex) saveTransactions(
+	blockNonce uint64,
+	epoch uint32,
+	body *block.Body,
+	txsByType map[block.Type]map[string]data.TransactionHandler,
+) error {
+	// the same block nonce might have been recorded before, in case of a rollback
+	err := ahi.removeBlock(blockNonce)
+	if err != nil {
+		return err
+	}
+
+	miniblockTypeByTxHash := make(map[string]block.Type)
+	for _, mb := range body.MiniBlocks {
+		for _, txHash := range mb.TxHashes {
+			miniblockTypeByTxHash[string(txHash)] = mb.Type
+		}
+	}
+
+	entriesByAddress := make(map[string][]*AddressHistoryEntry)
+	addresses := make([][]byte, 0)
+	addEntry := func(address []byte, entry *AddressHistoryEntry) {
+		_, found := entriesByAddress[string(address)]
+		if !found {
+			addresses = append(addresses, address)
+		}
+		entriesByAddress[string(address)] = append(entriesByAddress[string(address)], entry)
+	}
+
+	for _, txType := range addressHistoryTxTypes {
+		txs := txsByType[txType]
+		for _, txHash := range sortedTxHashes(txs) {
+			tx := txs[txHash]
+			if check.IfNil(tx) {
+				continue
+			}
+
+			miniblockType, found := miniblockTypeByTxHash[txHash]
+			if !found {
+				miniblockType = txType
+			}
+			newEntry := func(direction uint32) *AddressHistoryEntry {
+				return &AddressHistoryEntry{
+					TxHash:        []byte(txHash),
+					Epoch:         epoch,
+					BlockNonce:    blockNonce,
+					Direction:     direction,
+					MiniblockType: int32(miniblockType),
+				}
+			}
+
+			sender := tx.GetSndAddr()
+			receiver := tx.GetRcvAddr()
+			if len(sender) > 0 && bytes.Equal(sender, receiver) {
+				addEntry(sender, newEntry(DirectionSelf))
+				continue
+			}
+			if len(sender) > 0 {
+				addEntry(sender, newEntry(DirectionOutgoing))
+			}
+			if len(receiver) > 0 {
+				addEntry(receiver, newEntry(DirectionIncoming))
+			}
+		}
+	}
+
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	for _, address := range addresses {
+		err = ahi.appendEntries(address, entriesByAddress[string(address)])
+		if err != nil {
+			return err
+		}
+	}
+
+	return ahi.putObject(buildAddressesInBlockKey(blockNonce), &AddressesInBlock{Addresses: addresses})
+}
+
+func sortedTxHashes(txs map[string]data.TransactionHandler) []string {
+	txHashes := make([]string, 0, len(txs))
+	for txHash := range txs {
+		txHashes = append(txHashes, txHash)
+	}
+	sort.Strings(txHashes)
+
+	return txHashes
+}
+
+func (ahi *addressHistoryIndex) appendEntries(address []byte, entries []*AddressHistoryEntry) error {
+	numEntries := ahi.getNumEntries(address)
+	for _, entry := range entries {
+		err := ahi.putObject(buildAddressHistoryEntryKey(address, numEntries), entry)
+		if err != nil {
+			return err
+		}
+		numEntries++
+	}
+
+	return ahi.putObject(address, &AddressHistoryCounter{NumEntries: numEntries})
+}
+
+// removeBlock removes the history entries recorded for the provided block nonce
+func (ahi *addressHistoryIndex) removeBlock(blockNonce uint64) error {
+	blockKey := buildAddressesInBlockKey(blockNonce)
+	addressesInBlock := &AddressesInBlock{}
+	err := ahi.getObject(blockKey, addressesInBlock)
+	if err != nil {
+		// nothing recorded for this block
+		return nil
+	}
+
+	for _, address := range addressesInBlock.Addresses {
+		err = ahi.removeEntriesOfBlock(address, blockNonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	return ahi.storer.Remove(blockKey)
+}
+
+func (ahi *addressHistoryIndex) removeEntriesOfBlock(address []byte, blockNonce uint64) error {
+	numEntries := ahi.getNumEntries(address)
+	for numEntries > 0 {
+		entryKey := buildAddressHistoryEntryKey(address, numEntries-1)
+		entry := &AddressHistoryEntry{}
+		err := ahi.getObject(entryKey, entry)
+		if err != nil || entry.BlockNonce != blockNonce {
+			break
+		}
+
+		err = ahi.storer.Remove(entryKey)
+		if err != nil {
+			return err
+		}
+		numEntries--
+	}
+
+	return ahi.putObject(address, &AddressHistoryCounter{NumEntries: numEntries})
+}
+
+// getEntries returns at most maxEntries history entries of the address, newest first, skipping the newest
+// numSkipped ones. It also returns the total number of history entries of the address
+func (ahi *addressHistoryIndex) getEntries(address []byte, numSkipped uint64, maxEntries uint64) ([]*AddressHistoryEntry, uint64, error) {
+	numEntries := ahi.getNumEntries(address)
+	entries := make([]*AddressHistoryEntry, 0)
+	if numSkipped >= numEntries {
+		return entries, numEntries, nil
+	}
+
+	for index := numEntries - numSkipped; index > 0 && uint64(len(entries)) < maxEntries; index-- {
+		entry := &AddressHistoryEntry{}
+		err := ahi.getObject(buildAddressHistoryEntryKey(address, index-1), entry)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, numEntries, nil
+}
+
+func (ahi *addressHistoryIndex) getNumEntries(address []byte) uint64 {
+	counter := &AddressHistoryCounter{}
+	err := ahi.getObject(address, counter)
+	if err != nil {
+		return 0
+	}
+
+	return counter.NumEntries
+}
+
+func (ahi *addressHistoryIndex) getObject(key []byte, obj interface{}) error {
+	rawBytes, err := ahi.storer.Get(key)
+	if err != nil {
+		return err
+	}
+
+	return ahi.marshalizer.Unmarshal(obj, rawBytes)
+}
+
+func (ahi *addressHistoryIndex) putObject(key []byte, obj interface{}) error {
+	rawBytes, err := ahi.marshalizer.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	return ahi.storer.Put(key, rawBytes)
+}
+
+func buildAddressHistoryEntryKey(address []byte, index uint64) []byte {
+	key := make([]byte, len(address)+8)
+	copy(key, address)
+	binary.BigEndian.PutUint64(key[len(address):], index)
+
+	return key
+}
+
+func buildAddressesInBlockKey(blockNonce uint64) []byte {
+	key := make([]byte, len(addressesInBlockKeyPrefix)+8)
+	copy(key, addressesInBlockKeyPrefix)
+	binary.BigEndian.PutUint64(key[len(addressesInBlockKeyPrefix):], blockNonce)
+
+	return key
+}
@@ -0,0 +1,156 @@
+package dblookupext
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createTxsForAddressHistory() map[block.Type]map[string]data.TransactionHandler {
+	return map[block.Type]map[string]data.TransactionHandler{
+		block.TxBlock: {
+			"tx1": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+			"tx2": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("alice")},
+			"tx3": nil,
+		},
+		block.SmartContractResultBlock: {
+			"scr1": &smartContractResult.SmartContractResult{SndAddr: []byte("contract"), RcvAddr: []byte("bob")},
+		},
+		block.RewardsBlock: {
+			"reward1": &rewardTx.RewardTx{RcvAddr: []byte("alice"), Value: big.NewInt(1)},
+		},
+	}
+}
+
+func getTxHashes(entries []*AddressHistoryEntry) []string {
+	txHashes := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		txHashes = append(txHashes, string(entry.TxHash))
+	}
+
+	return txHashes
+}
+
+func TestAddressHistoryIndex_GetEntriesOfUnknownAddress(t *testing.T) {
+	t.Parallel()
+
+	index := newAddressHistoryIndex(genericMocks.NewStorerMock("AddressHistory", 0), &mock.MarshalizerMock{})
+
+	entries, total, err := index.getEntries([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), total)
+	require.Equal(t, 0, len(entries))
+}
+
+func TestAddressHistoryIndex_SaveTransactionsAndGetEntries(t *testing.T) {
+	t.Parallel()
+
+	index := newAddressHistoryIndex(genericMocks.NewStorerMock("AddressHistory", 0), &mock.MarshalizerMock{})
+
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{Type: block.InvalidBlock, TxHashes: [][]byte{[]byte("tx2")}},
+		},
+	}
+	err := index.saveTransactions(5, 1, body, createTxsForAddressHistory())
+	require.Nil(t, err)
+
+	entries, total, err := index.getEntries([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), total)
+	require.Equal(t, []string{"reward1", "tx2", "tx1"}, getTxHashes(entries))
+	require.Equal(t, DirectionIncoming, entries[0].Direction)
+	require.Equal(t, int32(block.RewardsBlock), entries[0].MiniblockType)
+	require.Equal(t, DirectionSelf, entries[1].Direction)
+	require.Equal(t, int32(block.InvalidBlock), entries[1].MiniblockType)
+	require.Equal(t, DirectionOutgoing, entries[2].Direction)
+	require.Equal(t, uint64(5), entries[2].BlockNonce)
+	require.Equal(t, uint32(1), entries[2].Epoch)
+
+	entries, total, err = index.getEntries([]byte("bob"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), total)
+	require.Equal(t, []string{"scr1", "tx1"}, getTxHashes(entries))
+
+	entries, _, err = index.getEntries([]byte("contract"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, DirectionOutgoing, entries[0].Direction)
+	require.Equal(t, int32(block.SmartContractResultBlock), entries[0].MiniblockType)
+}
+
+func TestAddressHistoryIndex_GetEntriesPaging(t *testing.T) {
+	t.Parallel()
+
+	index := newAddressHistoryIndex(genericMocks.NewStorerMock("AddressHistory", 0), &mock.MarshalizerMock{})
+
+	for nonce := uint64(1); nonce <= 5; nonce++ {
+		txs := map[block.Type]map[string]data.TransactionHandler{
+			block.TxBlock: {
+				string([]byte{byte(nonce)}): &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+			},
+		}
+		err := index.saveTransactions(nonce, 0, &block.Body{}, txs)
+		require.Nil(t, err)
+	}
+
+	entries, total, err := index.getEntries([]byte("alice"), 1, 2)
+	require.Nil(t, err)
+	require.Equal(t, uint64(5), total)
+	require.Equal(t, 2, len(entries))
+	require.Equal(t, uint64(4), entries[0].BlockNonce)
+	require.Equal(t, uint64(3), entries[1].BlockNonce)
+
+	entries, _, err = index.getEntries([]byte("alice"), 3, 10)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(entries))
+	require.Equal(t, uint64(1), entries[1].BlockNonce)
+
+	entries, total, err = index.getEntries([]byte("alice"), 5, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(5), total)
+	require.Equal(t, 0, len(entries))
+}
+
+func TestAddressHistoryIndex_RemoveBlock(t *testing.T) {
+	t.Parallel()
+
+	index := newAddressHistoryIndex(genericMocks.NewStorerMock("AddressHistory", 0), &mock.MarshalizerMock{})
+
+	err := index.saveTransactions(5, 0, &block.Body{}, createTxsForAddressHistory())
+	require.Nil(t, err)
+	txs := map[block.Type]map[string]data.TransactionHandler{
+		block.TxBlock: {
+			"tx4": &transaction.Transaction{SndAddr: []byte("bob"), RcvAddr: []byte("alice")},
+		},
+	}
+	err = index.saveTransactions(6, 0, &block.Body{}, txs)
+	require.Nil(t, err)
+
+	err = index.removeBlock(6)
+	require.Nil(t, err)
+
+	entries, total, err := index.getEntries([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), total)
+	require.Equal(t, []string{"reward1", "tx2", "tx1"}, getTxHashes(entries))
+
+	// recording the same block nonce again should replace the previous entries
+	err = index.saveTransactions(5, 0, &block.Body{}, txs)
+	require.Nil(t, err)
+
+	entries, total, err = index.getEntries([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), total)
+	require.Equal(t, []string{"tx4"}, getTxHashes(entries))
+
+	err = index.removeBlock(100)
+	require.Nil(t, err)
+}
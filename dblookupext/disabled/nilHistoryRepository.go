@@ -18,7 +18,7 @@ func NewNilHistoryRepository() (*nilHistoryRepository, error) {
 }
 
 // RecordBlock returns a not implemented error
-func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _ map[string]data.TransactionHandler, _ map[string]data.LogHandler) error {
+func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _, _, _ map[string]data.TransactionHandler, _ map[string]data.LogHandler) error {
 	return nil
 }
 
@@ -56,6 +56,16 @@ func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*
 	return nil, nil
 }
 
+// GetLogsTxHashes -
+func (nhr *nilHistoryRepository) GetLogsTxHashes(_ []byte, _ []byte, _ uint64, _ uint32) (*dblookupext.LogsIndexRecord, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
+// GetAddressHistory -
+func (nhr *nilHistoryRepository) GetAddressHistory(_ []byte, _ uint64, _ uint64) ([]*dblookupext.AddressHistoryEntry, uint64, error) {
+	return nil, 0, errorDisabledHistoryRepository
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nhr *nilHistoryRepository) IsInterfaceNil() bool {
 	return nhr == nil
@@ -84,6 +84,9 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		EpochByHashStorer:           hpf.store.GetStorer(dataRetriever.EpochByHashUnit),
 		MiniblockHashByTxHashStorer: hpf.store.GetStorer(dataRetriever.MiniblockHashByTxHashUnit),
 		EventsHashesByTxHashStorer:  hpf.store.GetStorer(dataRetriever.ResultsHashesByTxHashUnit),
+		LogsIndexStorer:             hpf.store.GetStorer(dataRetriever.LogsIndexUnit),
+		TxLogsStorer:                hpf.store.GetStorer(dataRetriever.TxLogsUnit),
+		AddressHistoryStorer:        hpf.store.GetStorer(dataRetriever.AddressHistoryUnit),
 		ESDTSuppliesHandler:         esdtSuppliesHandler,
 	}
 	return dblookupext.NewHistoryRepository(historyRepArgs)
@@ -33,6 +33,9 @@ type HistoryRepositoryArguments struct {
 	Uint64ByteSliceConverter    typeConverters.Uint64ByteSliceConverter
 	EpochByHashStorer           storage.Storer
 	EventsHashesByTxHashStorer  storage.Storer
+	LogsIndexStorer             storage.Storer
+	TxLogsStorer                storage.Storer
+	AddressHistoryStorer        storage.Storer
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 	ESDTSuppliesHandler         SuppliesHandler
@@ -46,6 +49,8 @@ type historyRepository struct {
 	uint64ByteSliceConverter   typeConverters.Uint64ByteSliceConverter
 	epochByHashIndex           *epochByHashIndex
 	eventsHashesByTxHashIndex  *eventsHashesByTxHash
+	logsIndex                  *logsIndex
+	addressHistoryIndex        *addressHistoryIndex
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 	esdtSuppliesHandler        SuppliesHandler
@@ -89,6 +94,15 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	if check.IfNil(arguments.EventsHashesByTxHashStorer) {
 		return nil, core.ErrNilStore
 	}
+	if check.IfNil(arguments.LogsIndexStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(arguments.TxLogsStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(arguments.AddressHistoryStorer) {
+		return nil, core.ErrNilStore
+	}
 	if check.IfNil(arguments.ESDTSuppliesHandler) {
 		return nil, errNilESDTSuppliesHandler
 	}
@@ -100,6 +114,7 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	deduplicationCacheForInsertMiniblockMetadata, _ := lrucache.NewCache(sizeOfDeduplicationCache)
 
 	eventsHashesToTxHashIndex := newEventsHashesByTxHash(arguments.EventsHashesByTxHashStorer, arguments.Marshalizer)
+	logsIndexInstance := newLogsIndex(arguments.LogsIndexStorer, arguments.TxLogsStorer, arguments.Marshalizer)
 
 	return &historyRepository{
 		selfShardID:                           arguments.SelfShardID,
@@ -114,6 +129,8 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		pendingNotarizedAtBothNotifications:          container.NewMutexMap(),
 		deduplicationCacheForInsertMiniblockMetadata: deduplicationCacheForInsertMiniblockMetadata,
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
+		logsIndex:                                    logsIndexInstance,
+		addressHistoryIndex:                          newAddressHistoryIndex(arguments.AddressHistoryStorer, arguments.Marshalizer),
 		esdtSuppliesHandler:                          arguments.ESDTSuppliesHandler,
 		uint64ByteSliceConverter:                     arguments.Uint64ByteSliceConverter,
 	}, nil
@@ -125,7 +142,9 @@ func (hr *historyRepository) RecordBlock(
 	blockHeaderHash []byte,
 	blockHeader data.HeaderHandler,
 	blockBody data.BodyHandler,
+	txsFromPool map[string]data.TransactionHandler,
 	scrResultsFromPool map[string]data.TransactionHandler,
+	rewardsFromPool map[string]data.TransactionHandler,
 	receiptsFromPool map[string]data.TransactionHandler,
 	logs map[string]data.LogHandler,
 ) error {
@@ -167,6 +186,21 @@ func (hr *historyRepository) RecordBlock(
 		return err
 	}
 
+	err = hr.logsIndex.saveLogs(blockHeader.GetNonce(), epoch, logs)
+	if err != nil {
+		return err
+	}
+
+	txsByType := map[block.Type]map[string]data.TransactionHandler{
+		block.TxBlock:                  txsFromPool,
+		block.SmartContractResultBlock: scrResultsFromPool,
+		block.RewardsBlock:             rewardsFromPool,
+	}
+	err = hr.addressHistoryIndex.saveTransactions(blockHeader.GetNonce(), epoch, body, txsByType)
+	if err != nil {
+		return err
+	}
+
 	err = hr.putHashByRound(blockHeaderHash, blockHeader)
 	if err != nil {
 		return err
@@ -467,7 +501,33 @@ func (hr *historyRepository) IsEnabled() bool {
 
 // RevertBlock will return the modification for the current block header
 func (hr *historyRepository) RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error {
-	return hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	err := hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	if err != nil {
+		return err
+	}
+
+	hr.recordBlockMutex.Lock()
+	defer hr.recordBlockMutex.Unlock()
+
+	err = hr.logsIndex.revertLogs(blockHeader, blockBody)
+	if err != nil {
+		return err
+	}
+
+	return hr.addressHistoryIndex.removeBlock(blockHeader.GetNonce())
+}
+
+// GetAddressHistory returns at most maxEntries transactions sent or received by the address, newest first, after
+// skipping the newest numSkipped ones. It also returns the total number of transactions of the address
+func (hr *historyRepository) GetAddressHistory(address []byte, numSkipped uint64, maxEntries uint64) ([]*AddressHistoryEntry, uint64, error) {
+	return hr.addressHistoryIndex.getEntries(address, numSkipped, maxEntries)
+}
+
+// GetLogsTxHashes will return, for the given epoch, the hashes of the transactions that generated log events
+// for the provided address and identifier in the bucket of LogsIndexNumBlocksPerBucket blocks holding the provided
+// block nonce. An empty identifier matches all the events of the address
+func (hr *historyRepository) GetLogsTxHashes(address []byte, identifier []byte, blockNonce uint64, epoch uint32) (*LogsIndexRecord, error) {
+	return hr.logsIndex.getRecord(buildLogsIndexKey(address, identifier, blockNonce), epoch)
 }
 
 // GetESDTSupply will return the supply from the storage for the given token
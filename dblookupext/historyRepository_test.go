@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	dataMock "github.com/ElrondNetwork/elrond-go-core/data/mock"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/common/mock"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -33,6 +34,9 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 		MiniblockHashByTxHashStorer: genericMocks.NewStorerMock("MiniblockHashByTxHash", epoch),
 		EpochByHashStorer:           genericMocks.NewStorerMock("EpochByHash", epoch),
 		EventsHashesByTxHashStorer:  genericMocks.NewStorerMock("EventsHashesByTxHash", epoch),
+		LogsIndexStorer:             genericMocks.NewStorerMock("LogsIndex", epoch),
+		TxLogsStorer:                genericMocks.NewStorerMock("TxLogs", epoch),
+		AddressHistoryStorer:        genericMocks.NewStorerMock("AddressHistory", epoch),
 		BlockHashByRound:            genericMocks.NewStorerMock("BlockHashByRound", epoch),
 		Marshalizer:                 &mock.MarshalizerMock{},
 		Hasher:                      &mock.HasherMock{},
@@ -70,6 +74,24 @@ func TestNewHistoryRepository(t *testing.T) {
 	require.Nil(t, repo)
 	require.Equal(t, core.ErrNilStore, err)
 
+	args = createMockHistoryRepoArgs(0)
+	args.LogsIndexStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.TxLogsStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.AddressHistoryStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
 	args = createMockHistoryRepoArgs(0)
 	args.Hasher = nil
 	repo, err = NewHistoryRepository(args)
@@ -108,7 +130,7 @@ func TestHistoryRepository_RecordBlockInvalidBlockRoundByHashStorerExpectError(t
 	repo, err := NewHistoryRepository(args)
 	require.Nil(t, err)
 
-	err = repo.RecordBlock([]byte("headerHash"), &block.Header{}, &block.Body{}, nil, nil, nil)
+	err = repo.RecordBlock([]byte("headerHash"), &block.Header{}, &block.Body{}, nil, nil, nil, nil, nil)
 	require.Equal(t, err, errPut)
 }
 
@@ -140,7 +162,7 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 		},
 	}
 
-	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil, nil)
+	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil, nil, nil, nil)
 	require.Nil(t, err)
 	// Two miniblocks
 	require.Equal(t, 2, repo.miniblocksMetadataStorer.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
@@ -152,6 +174,50 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 	require.Equal(t, 1, repo.blockHashByRound.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
 }
 
+func TestHistoryRepository_RecordBlockAndRevertShouldUpdateAddressHistory(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(0)
+	keyNotFoundStorer := &testscommon.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return nil, storage.ErrKeyNotFound
+		},
+	}
+	args.ESDTSuppliesHandler, _ = esdtSupply.NewSuppliesProcessor(&mock.MarshalizerMock{}, keyNotFoundStorer, keyNotFoundStorer)
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	blockHeader := &block.Header{Nonce: 4, Round: 5}
+	blockBody := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				Type:     block.TxBlock,
+				TxHashes: [][]byte{[]byte("txA")},
+			},
+		},
+	}
+	txs := map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	}
+
+	err = repo.RecordBlock([]byte("headerHash"), blockHeader, blockBody, txs, nil, nil, nil, nil)
+	require.Nil(t, err)
+
+	entries, total, err := repo.GetAddressHistory([]byte("bob"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), total)
+	require.Equal(t, []byte("txA"), entries[0].TxHash)
+	require.Equal(t, DirectionIncoming, entries[0].Direction)
+
+	err = repo.RevertBlock(blockHeader, blockBody)
+	require.Nil(t, err)
+
+	entries, total, err = repo.GetAddressHistory([]byte("bob"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), total)
+	require.Equal(t, 0, len(entries))
+}
+
 func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 	t.Parallel()
 
@@ -176,7 +242,7 @@ func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 				miniblockB,
 			},
 		},
-		nil, nil, nil,
+		nil, nil, nil, nil, nil,
 	)
 
 	metadata, err := repo.GetMiniblockMetadataByTxHash([]byte("txA"))
@@ -214,7 +280,7 @@ func TestHistoryRepository_GetEpochForHash(t *testing.T) {
 			miniblockA,
 			miniblockB,
 		},
-	}, nil, nil, nil)
+	}, nil, nil, nil, nil, nil)
 
 	// Get epoch by block hash
 	epoch, err := repo.GetEpochByHash([]byte("fooblock"))
@@ -272,7 +338,7 @@ func TestHistoryRepository_OnNotarizedBlocks(t *testing.T) {
 				miniblockB,
 				miniblockC,
 			},
-		}, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 
 	// Check "notarization coordinates"
@@ -472,7 +538,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 	_ = repo.RecordBlock([]byte("barBlock"),
 		&block.Header{Epoch: 42, Round: 4322},
@@ -480,7 +546,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockB,
 			},
-		}, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 
 	// Notifications have not been cleared after record block
@@ -527,7 +593,7 @@ func TestHistoryRepository_OnNotarizedBlocksCrossEpoch(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification, in the next epoch
@@ -584,7 +650,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 
 	// Let's go to next epoch
@@ -597,7 +663,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification
@@ -711,7 +777,7 @@ func TestHistoryRepository_ConcurrentlyRecordAndNotarizeSameBlockMultipleTimes(t
 					MiniBlocks: []*block.MiniBlock{
 						miniblock,
 					},
-				}, nil, nil, nil,
+				}, nil, nil, nil, nil, nil,
 			)
 		}
 
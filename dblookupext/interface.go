@@ -15,7 +15,9 @@ type HistoryRepository interface {
 	RecordBlock(blockHeaderHash []byte,
 		blockHeader data.HeaderHandler,
 		blockBody data.BodyHandler,
+		txsFromPool map[string]data.TransactionHandler,
 		scrResultsFromPool map[string]data.TransactionHandler,
+		rewardsFromPool map[string]data.TransactionHandler,
 		receiptsFromPool map[string]data.TransactionHandler,
 		logs map[string]data.LogHandler,
 	) error
@@ -24,6 +26,8 @@ type HistoryRepository interface {
 	GetMiniblockMetadataByTxHash(hash []byte) (*MiniblockMetadata, error)
 	GetEpochByHash(hash []byte) (uint32, error)
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
+	GetLogsTxHashes(address []byte, identifier []byte, blockNonce uint64, epoch uint32) (*LogsIndexRecord, error)
+	GetAddressHistory(address []byte, numSkipped uint64, maxEntries uint64) ([]*AddressHistoryEntry, uint64, error)
 	RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
 	GetESDTSupply(token string) (string, error)
 	IsEnabled() bool
@@ -0,0 +1,207 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. logsIndex.proto
+
+package dblookupext
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// LogsIndexNumBlocksPerBucket is the number of consecutive blocks covered by a logs index record. Bucketing the blocks
+// keeps the records of the busy addresses small, since each saved block rewrites its record
+const LogsIndexNumBlocksPerBucket = 100
+
+// logsIndex is the secondary index (address + identifier + bucket of block nonces) -> (block nonce, tx hashes) kept
+// for each epoch. Each log event is recorded twice: under the address + identifier key and under the address only key,
+// so that the queries that do not specify an identifier can also be served
+type logsIndex struct {
+	marshalizer marshal.Marshalizer
+	storer      storage.Storer
+	logsStorer  storage.Storer
+}
+
+func newLogsIndex(storer storage.Storer, logsStorer storage.Storer, marshalizer marshal.Marshalizer) *logsIndex {
+	return &logsIndex{
+		marshalizer: marshalizer,
+		storer:      storer,
+		logsStorer:  logsStorer,
+	}
+}
+
+func buildLogsIndexKey(address []byte, identifier []byte, blockNonce uint64) []byte {
+	bucket := make([]byte, 8)
+	binary.BigEndian.PutUint64(bucket, blockNonce/LogsIndexNumBlocksPerBucket)
+
+	key := make([]byte, 0, len(address)+len(identifier)+len(bucket))
+	key = append(key, address...)
+	key = append(key, identifier...)
+	return append(key, bucket...)
+}
+
+func (li *logsIndex) saveLogs(blockNonce uint64, epoch uint32, logs map[string]data.LogHandler) error {
+	txHashesByKey := groupTxHashesByLogsIndexKey(logs, blockNonce)
+
+	for key, txHashes := range txHashesByKey {
+		record := li.getRecordOrEmpty([]byte(key), epoch)
+		record.Blocks = removeBlockFromRecord(record.Blocks, blockNonce)
+		record.Blocks = append(record.Blocks, &TxHashesInBlock{
+			BlockNonce: blockNonce,
+			TxHashes:   txHashes,
+		})
+
+		err := li.putRecord([]byte(key), record, epoch)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (li *logsIndex) revertLogs(header data.HeaderHandler, blockBody data.BodyHandler) error {
+	logs, err := li.getLogsBasedOnBody(blockBody)
+	if err != nil {
+		return err
+	}
+
+	txHashesByKey := groupTxHashesByLogsIndexKey(logs, header.GetNonce())
+	for key := range txHashesByKey {
+		record := li.getRecordOrEmpty([]byte(key), header.GetEpoch())
+		record.Blocks = removeBlockFromRecord(record.Blocks, header.GetNonce())
+
+		err = li.putRecord([]byte(key), record, header.GetEpoch())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func groupTxHashesByLogsIndexKey(logs map[string]data.LogHandler, blockNonce uint64) map[string][][]byte {
+	txHashesByKey := make(map[string][][]byte)
+	addTxHash := func(key []byte, txHash []byte) {
+		txHashes := txHashesByKey[string(key)]
+		for _, existing := range txHashes {
+			if bytes.Equal(existing, txHash) {
+				return
+			}
+		}
+		txHashesByKey[string(key)] = append(txHashes, txHash)
+	}
+
+	for txHash, txLog := range logs {
+		if check.IfNil(txLog) {
+			continue
+		}
+
+		for _, event := range txLog.GetLogEvents() {
+			if check.IfNil(event) || len(event.GetAddress()) == 0 {
+				continue
+			}
+
+			addTxHash(buildLogsIndexKey(event.GetAddress(), nil, blockNonce), []byte(txHash))
+			if len(event.GetIdentifier()) > 0 {
+				addTxHash(buildLogsIndexKey(event.GetAddress(), event.GetIdentifier(), blockNonce), []byte(txHash))
+			}
+		}
+	}
+
+	for _, txHashes := range txHashesByKey {
+		sort.Slice(txHashes, func(i, j int) bool {
+			return bytes.Compare(txHashes[i], txHashes[j]) < 0
+		})
+	}
+
+	return txHashesByKey
+}
+
+func removeBlockFromRecord(blocks []*TxHashesInBlock, blockNonce uint64) []*TxHashesInBlock {
+	result := make([]*TxHashesInBlock, 0, len(blocks))
+	for _, txHashesInBlock := range blocks {
+		if txHashesInBlock.BlockNonce == blockNonce {
+			continue
+		}
+		result = append(result, txHashesInBlock)
+	}
+
+	return result
+}
+
+func (li *logsIndex) getRecordOrEmpty(key []byte, epoch uint32) *LogsIndexRecord {
+	record, err := li.getRecord(key, epoch)
+	if err != nil {
+		return &LogsIndexRecord{}
+	}
+
+	return record
+}
+
+func (li *logsIndex) getRecord(key []byte, epoch uint32) (*LogsIndexRecord, error) {
+	rawBytes, err := li.storer.GetFromEpoch(key, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &LogsIndexRecord{}
+	err = li.marshalizer.Unmarshal(record, rawBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (li *logsIndex) putRecord(key []byte, record *LogsIndexRecord, epoch uint32) error {
+	rawBytes, err := li.marshalizer.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return li.storer.PutInEpoch(key, rawBytes, epoch)
+}
+
+func (li *logsIndex) getLogsBasedOnBody(blockBody data.BodyHandler) (map[string]data.LogHandler, error) {
+	body, ok := blockBody.(*block.Body)
+	if !ok {
+		return nil, errCannotCastToBlockBody
+	}
+
+	logs := make(map[string]data.LogHandler)
+	for _, mb := range body.MiniBlocks {
+		shouldIgnore := mb.Type != block.TxBlock && mb.Type != block.SmartContractResultBlock
+		if shouldIgnore {
+			continue
+		}
+
+		for _, txHash := range mb.TxHashes {
+			logBytes, err := li.logsStorer.Get(txHash)
+			if err != nil {
+				continue
+			}
+
+			txLog := &transaction.Log{}
+			err = li.marshalizer.Unmarshal(txLog, logBytes)
+			if err != nil {
+				log.Warn("logsIndex.getLogsBasedOnBody cannot unmarshal log",
+					"error", err,
+					"txHash", hex.EncodeToString(txHash),
+				)
+				continue
+			}
+
+			logs[string(txHash)] = txLog
+		}
+	}
+
+	return logs, nil
+}
@@ -0,0 +1,670 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: logsIndex.proto
+
+package dblookupext
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TxHashesInBlock is used to store the hashes of the transactions that generated log events in a block
+type TxHashesInBlock struct {
+	BlockNonce uint64   `protobuf:"varint,1,opt,name=BlockNonce,proto3" json:"BlockNonce,omitempty"`
+	TxHashes   [][]byte `protobuf:"bytes,2,rep,name=TxHashes,proto3" json:"TxHashes,omitempty"`
+}
+
+func (m *TxHashesInBlock) Reset()      { *m = TxHashesInBlock{} }
+func (*TxHashesInBlock) ProtoMessage() {}
+func (*TxHashesInBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505aca59a81bc846, []int{0}
+}
+func (m *TxHashesInBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxHashesInBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TxHashesInBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxHashesInBlock.Merge(m, src)
+}
+func (m *TxHashesInBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxHashesInBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxHashesInBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxHashesInBlock proto.InternalMessageInfo
+
+func (m *TxHashesInBlock) GetBlockNonce() uint64 {
+	if m != nil {
+		return m.BlockNonce
+	}
+	return 0
+}
+
+func (m *TxHashesInBlock) GetTxHashes() [][]byte {
+	if m != nil {
+		return m.TxHashes
+	}
+	return nil
+}
+
+// LogsIndexRecord is used to store, for an epoch, the blocks and the hashes of the transactions that generated
+// log events for an address and identifier pair, within a bucket of consecutive blocks
+type LogsIndexRecord struct {
+	Blocks []*TxHashesInBlock `protobuf:"bytes,1,rep,name=Blocks,proto3" json:"Blocks,omitempty"`
+}
+
+func (m *LogsIndexRecord) Reset()      { *m = LogsIndexRecord{} }
+func (*LogsIndexRecord) ProtoMessage() {}
+func (*LogsIndexRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_505aca59a81bc846, []int{1}
+}
+func (m *LogsIndexRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogsIndexRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LogsIndexRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogsIndexRecord.Merge(m, src)
+}
+func (m *LogsIndexRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogsIndexRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogsIndexRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogsIndexRecord proto.InternalMessageInfo
+
+func (m *LogsIndexRecord) GetBlocks() []*TxHashesInBlock {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TxHashesInBlock)(nil), "proto.TxHashesInBlock")
+	proto.RegisterType((*LogsIndexRecord)(nil), "proto.LogsIndexRecord")
+}
+
+func init() { proto.RegisterFile("logsIndex.proto", fileDescriptor_505aca59a81bc846) }
+
+var fileDescriptor_505aca59a81bc846 = []byte{
+	// 241 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xcf, 0xc9, 0x4f, 0x2f,
+	0xf6, 0xcc, 0x4b, 0x49, 0xad, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x05, 0x53, 0x52,
+	0xba, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xe9, 0xf9, 0xe9, 0xf9,
+	0xfa, 0x60, 0xe1, 0xa4, 0xd2, 0x34, 0x30, 0x0f, 0xcc, 0x01, 0xb3, 0x20, 0xba, 0x94, 0x7c, 0xb9,
+	0xf8, 0x43, 0x2a, 0x3c, 0x12, 0x8b, 0x33, 0x52, 0x8b, 0x3d, 0xf3, 0x9c, 0x72, 0xf2, 0x93, 0xb3,
+	0x85, 0xe4, 0xb8, 0xb8, 0xc0, 0x0c, 0xbf, 0xfc, 0xbc, 0xe4, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d,
+	0x96, 0x20, 0x24, 0x11, 0x21, 0x29, 0x2e, 0x0e, 0x98, 0x16, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x9e,
+	0x20, 0x38, 0x5f, 0xc9, 0x91, 0x8b, 0xdf, 0x07, 0xe6, 0xae, 0xa0, 0xd4, 0xe4, 0xfc, 0xa2, 0x14,
+	0x21, 0x3d, 0x2e, 0x36, 0xb0, 0xe6, 0x62, 0x09, 0x46, 0x05, 0x66, 0x0d, 0x6e, 0x23, 0x31, 0x88,
+	0xcd, 0x7a, 0x68, 0xd6, 0x06, 0x41, 0x55, 0x39, 0xb9, 0x5e, 0x78, 0x28, 0xc7, 0x70, 0xe3, 0xa1,
+	0x1c, 0xc3, 0x87, 0x87, 0x72, 0x8c, 0x0d, 0x8f, 0xe4, 0x18, 0x57, 0x3c, 0x92, 0x63, 0x3c, 0xf1,
+	0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x1b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x7c,
+	0xf1, 0x48, 0x8e, 0xe1, 0xc3, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63,
+	0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x8a, 0x3b, 0x25, 0x29, 0x27, 0x3f, 0x3f, 0xbb, 0xb4, 0x20, 0xb5,
+	0xa2, 0x24, 0x89, 0x0d, 0x6c, 0x8b, 0x31, 0x60, 0x00, 0x4e, 0x3a, 0xca, 0x4d, 0x28, 0x01, 0x00,
+	0x00,
+}
+
+func (this *TxHashesInBlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxHashesInBlock)
+	if !ok {
+		that2, ok := that.(TxHashesInBlock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BlockNonce != that1.BlockNonce {
+		return false
+	}
+	if len(this.TxHashes) != len(that1.TxHashes) {
+		return false
+	}
+	for i := range this.TxHashes {
+		if !bytes.Equal(this.TxHashes[i], that1.TxHashes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *LogsIndexRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LogsIndexRecord)
+	if !ok {
+		that2, ok := that.(LogsIndexRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Blocks) != len(that1.Blocks) {
+		return false
+	}
+	for i := range this.Blocks {
+		if !this.Blocks[i].Equal(that1.Blocks[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *TxHashesInBlock) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&dblookupext.TxHashesInBlock{")
+	s = append(s, "BlockNonce: "+fmt.Sprintf("%#v", this.BlockNonce)+",\n")
+	s = append(s, "TxHashes: "+fmt.Sprintf("%#v", this.TxHashes)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *LogsIndexRecord) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&dblookupext.LogsIndexRecord{")
+	if this.Blocks != nil {
+		s = append(s, "Blocks: "+fmt.Sprintf("%#v", this.Blocks)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringLogsIndex(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *TxHashesInBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxHashesInBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxHashesInBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHashes) > 0 {
+		for iNdEx := len(m.TxHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxHashes[iNdEx])
+			copy(dAtA[i:], m.TxHashes[iNdEx])
+			i = encodeVarintLogsIndex(dAtA, i, uint64(len(m.TxHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BlockNonce != 0 {
+		i = encodeVarintLogsIndex(dAtA, i, uint64(m.BlockNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogsIndexRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogsIndexRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogsIndexRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLogsIndex(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLogsIndex(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLogsIndex(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TxHashesInBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockNonce != 0 {
+		n += 1 + sovLogsIndex(uint64(m.BlockNonce))
+	}
+	if len(m.TxHashes) > 0 {
+		for _, b := range m.TxHashes {
+			l = len(b)
+			n += 1 + l + sovLogsIndex(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LogsIndexRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovLogsIndex(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovLogsIndex(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLogsIndex(x uint64) (n int) {
+	return sovLogsIndex(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *TxHashesInBlock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxHashesInBlock{`,
+		`BlockNonce:` + fmt.Sprintf("%v", this.BlockNonce) + `,`,
+		`TxHashes:` + fmt.Sprintf("%v", this.TxHashes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LogsIndexRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForBlocks := "[]*TxHashesInBlock{"
+	for _, f := range this.Blocks {
+		repeatedStringForBlocks += strings.Replace(f.String(), "TxHashesInBlock", "TxHashesInBlock", 1) + ","
+	}
+	repeatedStringForBlocks += "}"
+	s := strings.Join([]string{`&LogsIndexRecord{`,
+		`Blocks:` + repeatedStringForBlocks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringLogsIndex(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *TxHashesInBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogsIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxHashesInBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxHashesInBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNonce", wireType)
+			}
+			m.BlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLogsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHashes = append(m.TxHashes, make([]byte, postIndex-iNdEx))
+			copy(m.TxHashes[len(m.TxHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogsIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLogsIndex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLogsIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogsIndexRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogsIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogsIndexRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogsIndexRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogsIndex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, &TxHashesInBlock{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogsIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLogsIndex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLogsIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLogsIndex(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLogsIndex
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLogsIndex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLogsIndex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLogsIndex
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLogsIndex
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLogsIndex
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLogsIndex        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLogsIndex          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLogsIndex = fmt.Errorf("proto: unexpected end of group")
+)
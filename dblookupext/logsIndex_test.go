@@ -0,0 +1,159 @@
+package dblookupext
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createLogsForLogsIndex() map[string]data.LogHandler {
+	return map[string]data.LogHandler{
+		"txHash2": &transaction.Log{
+			Events: []*transaction.Event{
+				{Address: []byte("addr1"), Identifier: []byte("transfer")},
+				{Address: []byte("addr2"), Identifier: []byte("mint")},
+			},
+		},
+		"txHash1": &transaction.Log{
+			Events: []*transaction.Event{
+				{Address: []byte("addr1"), Identifier: []byte("transfer")},
+				{Address: []byte("addr1"), Identifier: []byte("burn")},
+				{Address: nil, Identifier: []byte("ignored")},
+			},
+		},
+		"txHash3": nil,
+	}
+}
+
+func TestLogsIndex_GetRecordNotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	index := newLogsIndex(genericMocks.NewStorerMock("LogsIndex", 0), genericMocks.NewStorerMock("TxLogs", 0), &mock.MarshalizerMock{})
+
+	record, err := index.getRecord(buildLogsIndexKey([]byte("addr1"), nil, 10), 0)
+	require.Nil(t, record)
+	require.Error(t, err)
+}
+
+func TestLogsIndex_SaveLogsAndGetRecord(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(2)
+	index := newLogsIndex(genericMocks.NewStorerMock("LogsIndex", epoch), genericMocks.NewStorerMock("TxLogs", epoch), &mock.MarshalizerMock{})
+
+	err := index.saveLogs(10, epoch, createLogsForLogsIndex())
+	require.Nil(t, err)
+	err = index.saveLogs(11, epoch, map[string]data.LogHandler{
+		"txHash4": &transaction.Log{
+			Events: []*transaction.Event{
+				{Address: []byte("addr1"), Identifier: []byte("transfer")},
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	record, err := index.getRecord(buildLogsIndexKey([]byte("addr1"), []byte("transfer"), 10), epoch)
+	require.Nil(t, err)
+	require.Equal(t, []*TxHashesInBlock{
+		{BlockNonce: 10, TxHashes: [][]byte{[]byte("txHash1"), []byte("txHash2")}},
+		{BlockNonce: 11, TxHashes: [][]byte{[]byte("txHash4")}},
+	}, record.Blocks)
+
+	record, err = index.getRecord(buildLogsIndexKey([]byte("addr1"), nil, 10), epoch)
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{[]byte("txHash1"), []byte("txHash2")}, record.Blocks[0].TxHashes)
+
+	record, err = index.getRecord(buildLogsIndexKey([]byte("addr2"), []byte("mint"), 10), epoch)
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{[]byte("txHash2")}, record.Blocks[0].TxHashes)
+
+	_, err = index.getRecord(buildLogsIndexKey([]byte("addr1"), []byte("mint"), 10), epoch)
+	require.Error(t, err)
+}
+
+func TestLogsIndex_SaveLogsInDifferentBucketsShouldKeepSeparateRecords(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(0)
+	index := newLogsIndex(genericMocks.NewStorerMock("LogsIndex", epoch), genericMocks.NewStorerMock("TxLogs", epoch), &mock.MarshalizerMock{})
+
+	lastNonceOfFirstBucket := uint64(LogsIndexNumBlocksPerBucket - 1)
+	err := index.saveLogs(lastNonceOfFirstBucket, epoch, createLogsForLogsIndex())
+	require.Nil(t, err)
+	err = index.saveLogs(lastNonceOfFirstBucket+1, epoch, createLogsForLogsIndex())
+	require.Nil(t, err)
+
+	record, err := index.getRecord(buildLogsIndexKey([]byte("addr1"), nil, 0), epoch)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(record.Blocks))
+	require.Equal(t, lastNonceOfFirstBucket, record.Blocks[0].BlockNonce)
+
+	record, err = index.getRecord(buildLogsIndexKey([]byte("addr1"), nil, 2*LogsIndexNumBlocksPerBucket-1), epoch)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(record.Blocks))
+	require.Equal(t, lastNonceOfFirstBucket+1, record.Blocks[0].BlockNonce)
+}
+
+func TestLogsIndex_SaveLogsTwiceForTheSameBlockShouldOverwrite(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(0)
+	index := newLogsIndex(genericMocks.NewStorerMock("LogsIndex", epoch), genericMocks.NewStorerMock("TxLogs", epoch), &mock.MarshalizerMock{})
+
+	err := index.saveLogs(10, epoch, createLogsForLogsIndex())
+	require.Nil(t, err)
+	err = index.saveLogs(10, epoch, createLogsForLogsIndex())
+	require.Nil(t, err)
+
+	record, err := index.getRecord(buildLogsIndexKey([]byte("addr1"), []byte("burn"), 10), epoch)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(record.Blocks))
+}
+
+func TestLogsIndex_RevertLogs(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(0)
+	marshalizer := &mock.MarshalizerMock{}
+	logsStorer := genericMocks.NewStorerMock("TxLogs", epoch)
+	index := newLogsIndex(genericMocks.NewStorerMock("LogsIndex", epoch), logsStorer, marshalizer)
+
+	logs := createLogsForLogsIndex()
+	for txHash, txLog := range logs {
+		if txLog == nil {
+			continue
+		}
+		logBytes, _ := marshalizer.Marshal(txLog)
+		_ = logsStorer.Put([]byte(txHash), logBytes)
+	}
+
+	err := index.saveLogs(9, epoch, logs)
+	require.Nil(t, err)
+	err = index.saveLogs(10, epoch, logs)
+	require.Nil(t, err)
+
+	header := &block.Header{Nonce: 10, Epoch: epoch}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				Type:     block.TxBlock,
+				TxHashes: [][]byte{[]byte("txHash1"), []byte("txHash2"), []byte("txHash3")},
+			},
+		},
+	}
+	err = index.revertLogs(header, body)
+	require.Nil(t, err)
+
+	record, err := index.getRecord(buildLogsIndexKey([]byte("addr1"), []byte("transfer"), 10), epoch)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(record.Blocks))
+	require.Equal(t, uint64(9), record.Blocks[0].BlockNonce)
+
+	err = index.revertLogs(header, nil)
+	require.Equal(t, errCannotCastToBlockBody, err)
+}
@@ -0,0 +1,15 @@
+package tracing
+
+import "errors"
+
+// ErrUnknownExporter signals that the configured exporter is not known
+var ErrUnknownExporter = errors.New("unknown tracing exporter")
+
+// ErrEmptyOtlpEndpoint signals that the OTLP exporter was configured without an endpoint
+var ErrEmptyOtlpEndpoint = errors.New("empty OTLP endpoint")
+
+// ErrEmptyFilePath signals that the file exporter was configured without a file path
+var ErrEmptyFilePath = errors.New("empty file path")
+
+// ErrExportFailed signals that the collector did not accept the exported spans
+var ErrExportFailed = errors.New("export failed")
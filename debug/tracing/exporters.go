@@ -0,0 +1,105 @@
+package tracing
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	otlpContentType       = "application/json"
+	otlpExportTimeout     = 5 * time.Second
+	traceFilePermissions  = 0644
+	maxErrorResponseBytes = 1024
+)
+
+type spansExporter interface {
+	Export(payload []byte) error
+	Close() error
+}
+
+// otlpHttpExporter sends the spans to a collector through the OTLP/HTTP protocol, JSON encoded
+type otlpHttpExporter struct {
+	endpoint   string
+	httpClient *http.Client
+}
+
+func newOtlpHttpExporter(endpoint string) (*otlpHttpExporter, error) {
+	if len(endpoint) == 0 {
+		return nil, ErrEmptyOtlpEndpoint
+	}
+
+	return &otlpHttpExporter{
+		endpoint:   endpoint,
+		httpClient: &http.Client{Timeout: otlpExportTimeout},
+	}, nil
+}
+
+// Export posts the payload to the collector
+func (exporter *otlpHttpExporter) Export(payload []byte) error {
+	response, err := exporter.httpClient.Post(exporter.endpoint, otlpContentType, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode >= http.StatusMultipleChoices {
+		body, _ := ioutil.ReadAll(io.LimitReader(response.Body, maxErrorResponseBytes))
+		return fmt.Errorf("%w: status %d, response %s", ErrExportFailed, response.StatusCode, string(body))
+	}
+
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+
+	return nil
+}
+
+// Close does nothing as the exporter keeps no open resources
+func (exporter *otlpHttpExporter) Close() error {
+	return nil
+}
+
+// fileExporter appends the spans to a file, one OTLP JSON request per line
+type fileExporter struct {
+	mutFile sync.Mutex
+	file    *os.File
+}
+
+func newFileExporter(filePath string) (*fileExporter, error) {
+	if len(filePath) == 0 {
+		return nil, ErrEmptyFilePath
+	}
+
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, traceFilePermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileExporter{
+		file: file,
+	}, nil
+}
+
+// Export writes the payload as a new line in the file
+func (exporter *fileExporter) Export(payload []byte) error {
+	exporter.mutFile.Lock()
+	defer exporter.mutFile.Unlock()
+
+	_, err := exporter.file.Write(append(payload, '\n'))
+
+	return err
+}
+
+// Close closes the file
+func (exporter *fileExporter) Close() error {
+	exporter.mutFile.Lock()
+	defer exporter.mutFile.Unlock()
+
+	return exporter.file.Close()
+}
@@ -0,0 +1,148 @@
+package tracing
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+)
+
+// the structures below follow the JSON encoding of the OTLP ExportTraceServiceRequest message
+
+const (
+	instrumentationScope = "github.com/ElrondNetwork/elrond-go"
+	spanKindInternal     = 1
+	statusCodeOk         = 1
+	statusCodeError      = 2
+	serviceNameKey       = "service.name"
+	shardKey             = "elrond.shard"
+)
+
+type otlpExportRequest struct {
+	ResourceSpans []*otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   *otlpResource     `json:"resource"`
+	ScopeSpans []*otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []*otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope *otlpScope  `json:"scope"`
+	Spans []*otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []*otlpKeyValue `json:"attributes,omitempty"`
+	Status            *otlpStatus     `json:"status"`
+}
+
+type otlpKeyValue struct {
+	Key   string        `json:"key"`
+	Value *otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+func newOtlpResource(serviceName string, shardID uint32) *otlpResource {
+	return &otlpResource{
+		Attributes: []*otlpKeyValue{
+			newStringKeyValue(serviceNameKey, serviceName),
+			newIntKeyValue(shardKey, int64(shardID)),
+		},
+	}
+}
+
+func newStringKeyValue(key string, value string) *otlpKeyValue {
+	return &otlpKeyValue{
+		Key:   key,
+		Value: &otlpAnyValue{StringValue: &value},
+	}
+}
+
+// newIntKeyValue encodes the value as a string, as the JSON mapping of the 64 bits integers requires
+func newIntKeyValue(key string, value int64) *otlpKeyValue {
+	intValue := strconv.FormatInt(value, 10)
+
+	return &otlpKeyValue{
+		Key:   key,
+		Value: &otlpAnyValue{IntValue: &intValue},
+	}
+}
+
+func createOtlpPayload(resource *otlpResource, spans []*Span) ([]byte, error) {
+	otlpSpans := make([]*otlpSpan, 0, len(spans))
+	for _, span := range spans {
+		otlpSpans = append(otlpSpans, convertSpan(span))
+	}
+
+	request := &otlpExportRequest{
+		ResourceSpans: []*otlpResourceSpans{
+			{
+				Resource: resource,
+				ScopeSpans: []*otlpScopeSpans{
+					{
+						Scope: &otlpScope{Name: instrumentationScope},
+						Spans: otlpSpans,
+					},
+				},
+			},
+		},
+	}
+
+	return json.Marshal(request)
+}
+
+func convertSpan(span *Span) *otlpSpan {
+	converted := &otlpSpan{
+		TraceID:           hex.EncodeToString(span.traceID[:]),
+		SpanID:            hex.EncodeToString(span.spanID[:]),
+		Name:              span.name,
+		Kind:              spanKindInternal,
+		StartTimeUnixNano: strconv.FormatInt(span.startTime.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(span.endTime.UnixNano(), 10),
+		Status:            &otlpStatus{Code: statusCodeOk},
+	}
+	if span.hasParent {
+		converted.ParentSpanID = hex.EncodeToString(span.parentSpanID[:])
+	}
+	if span.hasError {
+		converted.Status = &otlpStatus{
+			Code:    statusCodeError,
+			Message: span.errMessage,
+		}
+	}
+
+	for _, attr := range span.attributes {
+		if attr.isInt {
+			converted.Attributes = append(converted.Attributes, newIntKeyValue(attr.key, attr.intValue))
+			continue
+		}
+
+		converted.Attributes = append(converted.Attributes, newStringKeyValue(attr.key, attr.stringValue))
+	}
+
+	return converted
+}
@@ -0,0 +1,108 @@
+package tracing
+
+import (
+	"sync"
+	"time"
+)
+
+type attribute struct {
+	key         string
+	stringValue string
+	intValue    int64
+	isInt       bool
+}
+
+type traceData struct {
+	mutSpans   sync.Mutex
+	spans      []*Span
+	isExported bool
+}
+
+// addSpan records a finished span. When the root span ends, the trace is complete and its spans are returned. The
+// children ending after their root are not exported
+func (td *traceData) addSpan(span *Span) ([]*Span, bool) {
+	td.mutSpans.Lock()
+	defer td.mutSpans.Unlock()
+
+	if td.isExported {
+		log.Trace("tracing: span ended after its root span, ignored", "span", span.name)
+		return nil, false
+	}
+
+	td.spans = append(td.spans, span)
+	if span.hasParent {
+		return nil, false
+	}
+
+	td.isExported = true
+	return td.spans, true
+}
+
+// Span records a named and timed operation. A nil Span, as returned when tracing is disabled, ignores all the calls
+type Span struct {
+	tracer       *tracer
+	trace        *traceData
+	traceID      [16]byte
+	spanID       [8]byte
+	parentSpanID [8]byte
+	hasParent    bool
+	name         string
+	startTime    time.Time
+	endTime      time.Time
+	attributes   []attribute
+	errMessage   string
+	hasError     bool
+}
+
+// StartChild opens a new span as the child of this span. The child of a nil span is nil
+func (s *Span) StartChild(name string) *Span {
+	if s == nil {
+		return nil
+	}
+
+	return s.tracer.startSpan(name, s)
+}
+
+// SetStringAttribute adds a string attribute to the span
+func (s *Span) SetStringAttribute(key string, value string) {
+	if s == nil {
+		return
+	}
+
+	s.attributes = append(s.attributes, attribute{key: key, stringValue: value})
+}
+
+// SetUint64Attribute adds a numeric attribute to the span
+func (s *Span) SetUint64Attribute(key string, value uint64) {
+	if s == nil {
+		return
+	}
+
+	s.attributes = append(s.attributes, attribute{key: key, intValue: int64(value), isInt: true})
+}
+
+// SetError marks the span as failed with the provided error. A nil error is ignored
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+
+	s.hasError = true
+	s.errMessage = err.Error()
+}
+
+// End finishes the span. The trace is exported when its root span ends
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+
+	s.endTime = time.Now()
+	s.tracer.endSpan(s)
+}
+
+// EndWithError marks the span as failed with the provided error, if any, and finishes it
+func (s *Span) EndWithError(err error) {
+	s.SetError(err)
+	s.End()
+}
@@ -0,0 +1,211 @@
+package tracing
+
+import (
+	"crypto/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+)
+
+const (
+	// ExporterOTLP is the exporter sending the spans to an OTLP/HTTP collector
+	ExporterOTLP = "otlp"
+	// ExporterFile is the exporter appending the spans to a file, one OTLP JSON request per line
+	ExporterFile = "file"
+
+	defaultServiceName = "elrond-node"
+	defaultQueueSize   = 100
+)
+
+var log = logger.GetOrCreate("debug/tracing")
+
+var (
+	isEnabled   uint32
+	mutTracer   sync.RWMutex
+	localTracer *tracer
+)
+
+// ArgsTracer holds the arguments needed to start the tracer
+type ArgsTracer struct {
+	Config  config.TracingDebugConfig
+	ShardID uint32
+}
+
+// tracer sends the finished traces to the exporter. The parent of a span is always given explicitly, so that the
+// spans started by concurrent components do not get mixed
+type tracer struct {
+	mutClosed  sync.RWMutex
+	exporter   spansExporter
+	resource   *otlpResource
+	chanTraces chan []*Span
+	chanDone   chan struct{}
+	closed     bool
+}
+
+// Start creates the tracer as configured and enables the spans. If tracing is disabled in the configuration, the
+// spans remain disabled. Calling Start again replaces the running tracer
+func Start(args ArgsTracer) error {
+	err := Close()
+	if err != nil {
+		return err
+	}
+	if !args.Config.Enabled {
+		return nil
+	}
+
+	exporter, err := createExporter(args.Config)
+	if err != nil {
+		return err
+	}
+
+	serviceName := args.Config.ServiceName
+	if len(serviceName) == 0 {
+		serviceName = defaultServiceName
+	}
+	queueSize := args.Config.QueueSize
+	if queueSize <= 0 {
+		queueSize = defaultQueueSize
+	}
+
+	t := &tracer{
+		exporter:   exporter,
+		resource:   newOtlpResource(serviceName, args.ShardID),
+		chanTraces: make(chan []*Span, queueSize),
+		chanDone:   make(chan struct{}),
+	}
+	go t.processTraces()
+
+	mutTracer.Lock()
+	localTracer = t
+	atomic.StoreUint32(&isEnabled, 1)
+	mutTracer.Unlock()
+
+	log.Debug("tracing started", "exporter", args.Config.Exporter)
+
+	return nil
+}
+
+func createExporter(cfg config.TracingDebugConfig) (spansExporter, error) {
+	switch cfg.Exporter {
+	case ExporterOTLP:
+		return newOtlpHttpExporter(cfg.OtlpEndpoint)
+	case ExporterFile:
+		return newFileExporter(cfg.FilePath)
+	default:
+		return nil, ErrUnknownExporter
+	}
+}
+
+// Close disables the spans, exports the traces still in the queue and closes the exporter
+func Close() error {
+	mutTracer.Lock()
+	t := localTracer
+	localTracer = nil
+	atomic.StoreUint32(&isEnabled, 0)
+	mutTracer.Unlock()
+
+	if t == nil {
+		return nil
+	}
+
+	return t.close()
+}
+
+// StartSpan opens the root span of a new trace. The nested operations are traced with the StartChild method of the
+// returned span. It returns nil, on which all the span methods are no-ops, when tracing is disabled
+func StartSpan(name string) *Span {
+	if atomic.LoadUint32(&isEnabled) == 0 {
+		return nil
+	}
+
+	mutTracer.RLock()
+	t := localTracer
+	mutTracer.RUnlock()
+	if t == nil {
+		return nil
+	}
+
+	return t.startSpan(name, nil)
+}
+
+func (t *tracer) startSpan(name string, parent *Span) *Span {
+	span := &Span{
+		tracer:    t,
+		name:      name,
+		startTime: time.Now(),
+	}
+	_, _ = rand.Read(span.spanID[:])
+
+	if parent == nil {
+		_, _ = rand.Read(span.traceID[:])
+		span.trace = &traceData{}
+
+		return span
+	}
+
+	span.traceID = parent.traceID
+	span.parentSpanID = parent.spanID
+	span.hasParent = true
+	span.trace = parent.trace
+
+	return span
+}
+
+func (t *tracer) endSpan(span *Span) {
+	spans, isComplete := span.trace.addSpan(span)
+	if !isComplete {
+		return
+	}
+
+	t.mutClosed.RLock()
+	defer t.mutClosed.RUnlock()
+
+	if t.closed {
+		return
+	}
+
+	select {
+	case t.chanTraces <- spans:
+	default:
+		log.Debug("tracing queue is full, trace dropped", "root span", span.name)
+	}
+}
+
+func (t *tracer) processTraces() {
+	defer close(t.chanDone)
+
+	for spans := range t.chanTraces {
+		t.exportSpans(spans)
+	}
+}
+
+func (t *tracer) exportSpans(spans []*Span) {
+	payload, err := createOtlpPayload(t.resource, spans)
+	if err != nil {
+		log.Debug("tracing: cannot create the OTLP payload", "error", err)
+		return
+	}
+
+	err = t.exporter.Export(payload)
+	if err != nil {
+		log.Debug("tracing: cannot export the spans", "error", err)
+	}
+}
+
+func (t *tracer) close() error {
+	t.mutClosed.Lock()
+	if t.closed {
+		t.mutClosed.Unlock()
+		return nil
+	}
+	t.closed = true
+	close(t.chanTraces)
+	t.mutClosed.Unlock()
+
+	<-t.chanDone
+
+	return t.exporter.Close()
+}
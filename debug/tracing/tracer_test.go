@@ -0,0 +1,198 @@
+package tracing
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+// the tests below are not parallel as the tracer is shared by the whole process
+
+func TestStartSpan_DisabledShouldReturnNilSpan(t *testing.T) {
+	err := Start(ArgsTracer{Config: config.TracingDebugConfig{Enabled: false}})
+	require.Nil(t, err)
+
+	span := StartSpan("span")
+	require.Nil(t, span)
+	require.Nil(t, span.StartChild("child"))
+
+	span.SetStringAttribute("key", "value")
+	span.SetUint64Attribute("key", 1)
+	span.EndWithError(errors.New("error"))
+}
+
+func TestStart_InvalidConfigShouldErr(t *testing.T) {
+	err := Start(ArgsTracer{Config: config.TracingDebugConfig{Enabled: true, Exporter: "unknown"}})
+	require.Equal(t, ErrUnknownExporter, err)
+
+	err = Start(ArgsTracer{Config: config.TracingDebugConfig{Enabled: true, Exporter: ExporterOTLP}})
+	require.Equal(t, ErrEmptyOtlpEndpoint, err)
+
+	err = Start(ArgsTracer{Config: config.TracingDebugConfig{Enabled: true, Exporter: ExporterFile}})
+	require.Equal(t, ErrEmptyFilePath, err)
+
+	require.Nil(t, StartSpan("span"))
+}
+
+func TestTracer_FileExporterShouldWriteNestedSpans(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "traces.json")
+	err := Start(ArgsTracer{
+		Config: config.TracingDebugConfig{
+			Enabled:  true,
+			Exporter: ExporterFile,
+			FilePath: filePath,
+		},
+		ShardID: 1,
+	})
+	require.Nil(t, err)
+
+	root := StartSpan("root")
+	root.SetUint64Attribute("nonce", 37)
+	child := root.StartChild("child")
+	grandChild := child.StartChild("grandChild")
+	grandChild.End()
+	child.EndWithError(errors.New("child error"))
+	sibling := root.StartChild("sibling")
+	sibling.End()
+	root.End()
+
+	err = Close()
+	require.Nil(t, err)
+	require.Nil(t, StartSpan("span after close"))
+
+	content, err := ioutil.ReadFile(filePath)
+	require.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, 1)
+
+	request := &otlpExportRequest{}
+	err = json.Unmarshal([]byte(lines[0]), request)
+	require.Nil(t, err)
+	require.Len(t, request.ResourceSpans, 1)
+	require.Equal(t, serviceNameKey, request.ResourceSpans[0].Resource.Attributes[0].Key)
+	require.Equal(t, defaultServiceName, *request.ResourceSpans[0].Resource.Attributes[0].Value.StringValue)
+	require.Equal(t, "1", *request.ResourceSpans[0].Resource.Attributes[1].Value.IntValue)
+
+	spans := make(map[string]*otlpSpan)
+	for _, span := range request.ResourceSpans[0].ScopeSpans[0].Spans {
+		spans[span.Name] = span
+	}
+	require.Len(t, spans, 4)
+
+	require.Empty(t, spans["root"].ParentSpanID)
+	require.Equal(t, spans["root"].SpanID, spans["child"].ParentSpanID)
+	require.Equal(t, spans["child"].SpanID, spans["grandChild"].ParentSpanID)
+	require.Equal(t, spans["root"].SpanID, spans["sibling"].ParentSpanID)
+	for _, span := range spans {
+		require.Equal(t, spans["root"].TraceID, span.TraceID)
+	}
+
+	require.Equal(t, "nonce", spans["root"].Attributes[0].Key)
+	require.Equal(t, "37", *spans["root"].Attributes[0].Value.IntValue)
+	require.Equal(t, statusCodeOk, spans["root"].Status.Code)
+	require.Equal(t, statusCodeError, spans["child"].Status.Code)
+	require.Equal(t, "child error", spans["child"].Status.Message)
+}
+
+func TestTracer_ConcurrentTracesShouldNotMix(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "traces.json")
+	err := Start(ArgsTracer{
+		Config: config.TracingDebugConfig{
+			Enabled:  true,
+			Exporter: ExporterFile,
+			FilePath: filePath,
+		},
+	})
+	require.Nil(t, err)
+
+	// interleaved, as when started by concurrent components
+	first := StartSpan("first")
+	second := StartSpan("second")
+	childOfFirst := first.StartChild("child of first")
+	childOfSecond := second.StartChild("child of second")
+	first.End()
+	childOfSecond.End()
+	second.End()
+	// ended after its root, not exported
+	childOfFirst.End()
+
+	err = Close()
+	require.Nil(t, err)
+
+	content, err := ioutil.ReadFile(filePath)
+	require.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, 2)
+
+	names := make([][]string, 0, len(lines))
+	for _, line := range lines {
+		request := &otlpExportRequest{}
+		err = json.Unmarshal([]byte(line), request)
+		require.Nil(t, err)
+
+		spansNames := make([]string, 0)
+		for _, span := range request.ResourceSpans[0].ScopeSpans[0].Spans {
+			spansNames = append(spansNames, span.Name)
+		}
+		names = append(names, spansNames)
+	}
+	require.Equal(t, [][]string{{"first"}, {"child of second", "second"}}, names)
+}
+
+func TestTracer_OtlpExporterShouldPostTheSpans(t *testing.T) {
+	chanPayloads := make(chan []byte, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		require.Equal(t, http.MethodPost, request.Method)
+		require.Equal(t, otlpContentType, request.Header.Get("Content-Type"))
+
+		payload, _ := ioutil.ReadAll(request.Body)
+		chanPayloads <- payload
+	}))
+	defer server.Close()
+
+	err := Start(ArgsTracer{
+		Config: config.TracingDebugConfig{
+			Enabled:      true,
+			Exporter:     ExporterOTLP,
+			OtlpEndpoint: server.URL + "/v1/traces",
+			ServiceName:  "test-service",
+		},
+	})
+	require.Nil(t, err)
+
+	StartSpan("first trace").End()
+	StartSpan("second trace").End()
+
+	err = Close()
+	require.Nil(t, err)
+	require.Len(t, chanPayloads, 2)
+
+	request := &otlpExportRequest{}
+	err = json.Unmarshal(<-chanPayloads, request)
+	require.Nil(t, err)
+	require.Equal(t, "test-service", *request.ResourceSpans[0].Resource.Attributes[0].Value.StringValue)
+	require.Equal(t, "first trace", request.ResourceSpans[0].ScopeSpans[0].Spans[0].Name)
+}
+
+func TestOtlpHttpExporter_ExportShouldErrOnRejectedRequest(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusBadRequest)
+		_, _ = writer.Write([]byte("malformed"))
+	}))
+	defer server.Close()
+
+	exporter, _ := newOtlpHttpExporter(server.URL)
+	err := exporter.Export([]byte("{}"))
+	require.True(t, errors.Is(err, ErrExportFailed))
+	require.True(t, strings.Contains(err.Error(), "malformed"))
+}
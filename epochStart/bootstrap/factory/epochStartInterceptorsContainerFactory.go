@@ -40,6 +40,7 @@ type ArgsEpochStartInterceptorContainer struct {
 	EnableSignTxWithHashEpoch uint32
 	EpochNotifier             process.EpochNotifier
 	RequestHandler            process.RequestHandler
+	PeersThroughputRecorder   process.PeersThroughputRecorder
 }
 
 // NewEpochStartInterceptorsContainer will return a real interceptors container factory, but with many disabled components
@@ -96,6 +97,7 @@ func NewEpochStartInterceptorsContainer(args ArgsEpochStartInterceptorContainer)
 		EnableSignTxWithHashEpoch: args.EnableSignTxWithHashEpoch,
 		PreferredPeersHolder:      disabled.NewPreferredPeersHolder(),
 		RequestHandler:            args.RequestHandler,
+		PeersThroughputRecorder:   args.PeersThroughputRecorder,
 	}
 
 	interceptorsContainerFactory, err := interceptorscontainer.NewMetaInterceptorsContainerFactory(containerFactoryArgs)
@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/epochStart/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	disabledMetrics "github.com/ElrondNetwork/elrond-go/statusHandler/metrics/disabled"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/factory"
 )
@@ -47,6 +48,7 @@ func NewMetaStorageHandler(
 		pathManagerHandler,
 		epochStartNotifier,
 		nodeTypeProvider,
+		&disabledMetrics.MetricsHandler{},
 		currentEpoch,
 		false,
 	)
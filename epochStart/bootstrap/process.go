@@ -3,6 +3,7 @@ package bootstrap
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/core/partitioning"
+	"github.com/ElrondNetwork/elrond-go-core/core/random"
 	"github.com/ElrondNetwork/elrond-go-core/core/throttler"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
@@ -22,9 +24,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/containers"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/resolverscontainer"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestHandlers"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/throughput"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/disabled"
 	factoryInterceptors "github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/factory"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/snapshot"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
 	disabledInterceptors "github.com/ElrondNetwork/elrond-go/process/interceptors/disabled"
@@ -35,6 +39,7 @@ import (
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/timecache"
+	"github.com/ElrondNetwork/elrond-go/trie"
 	"github.com/ElrondNetwork/elrond-go/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/update"
 	updateSync "github.com/ElrondNetwork/elrond-go/update/sync"
@@ -98,9 +103,12 @@ type epochStartBootstrap struct {
 	numConcurrentTrieSyncers   int
 	maxHardCapForMissingNodes  int
 	trieSyncerVersion          int
+	importSnapshotFilePath     string
 
 	// created components
 	requestHandler            process.RequestHandler
+	trieSyncRequestsBudget    trie.SyncRequestsBudget
+	peersThroughputTracker    dataRetriever.PeersThroughputHandler
 	interceptorContainer      process.InterceptorsContainer
 	dataPool                  dataRetriever.PoolsHolder
 	miniBlocksSyncer          epochStart.PendingMiniBlocksSyncHandler
@@ -155,6 +163,7 @@ type ArgsEpochStartBootstrap struct {
 	ArgumentsParser            process.ArgumentsParser
 	StatusHandler              core.AppStatusHandler
 	HeaderIntegrityVerifier    process.HeaderIntegrityVerifier
+	ImportSnapshotFilePath     string
 }
 
 // NewEpochStartBootstrap will return a new instance of epochStartBootstrap
@@ -190,6 +199,7 @@ func NewEpochStartBootstrap(args ArgsEpochStartBootstrap) (*epochStartBootstrap,
 		maxHardCapForMissingNodes:  args.GeneralConfig.TrieSync.MaxHardCapForMissingNodes,
 		trieSyncerVersion:          args.GeneralConfig.TrieSync.TrieSyncerVersion,
 		waitingListFixEnableEpoch:  args.EpochConfig.EnableEpochs.WaitingListFixEnableEpoch,
+		importSnapshotFilePath:     args.ImportSnapshotFilePath,
 	}
 
 	log.Debug("process: enable epoch for transaction signed with tx hash", "epoch", epochStartProvider.enableSignTxWithHashEpoch)
@@ -209,6 +219,16 @@ func NewEpochStartBootstrap(args ArgsEpochStartBootstrap) (*epochStartBootstrap,
 		return nil, err
 	}
 
+	epochStartProvider.trieSyncRequestsBudget, err = factory.CreateSyncRequestsBudget(args.GeneralConfig.TrieSync)
+	if err != nil {
+		return nil, err
+	}
+
+	epochStartProvider.peersThroughputTracker, err = throughput.NewPeersThroughputTracker(&random.ConcurrentSafeIntRandomizer{})
+	if err != nil {
+		return nil, err
+	}
+
 	epochStartProvider.trieContainer = state.NewDataTriesHolder()
 	epochStartProvider.trieStorageManagers = make(map[string]common.StorageManager)
 
@@ -514,6 +534,7 @@ func (e *epochStartBootstrap) createSyncers() error {
 		EnableSignTxWithHashEpoch: e.enableSignTxWithHashEpoch,
 		EpochNotifier:             e.epochNotifier,
 		RequestHandler:            e.requestHandler,
+		PeersThroughputRecorder:   e.peersThroughputTracker,
 	}
 
 	e.interceptorContainer, err = factoryInterceptors.NewEpochStartInterceptorsContainer(args)
@@ -698,7 +719,14 @@ func (e *epochStartBootstrap) processNodesConfig(pubKey []byte) error {
 }
 
 func (e *epochStartBootstrap) requestAndProcessForMeta() error {
-	var err error
+	rootHashes := map[string][]byte{
+		factory.UserAccountTrie: e.epochStartMeta.RootHash,
+		factory.PeerAccountTrie: e.epochStartMeta.ValidatorStatsRootHash,
+	}
+	err := e.importSnapshotIfNeeded(rootHashes)
+	if err != nil {
+		return err
+	}
 
 	log.Debug("start in epoch bootstrap: started syncValidatorAccountsState")
 	err = e.syncValidatorAccountsState(e.epochStartMeta.ValidatorStatsRootHash)
@@ -804,6 +832,11 @@ func (e *epochStartBootstrap) requestAndProcessForShard() error {
 		return epochStart.ErrWrongTypeAssertion
 	}
 
+	err = e.importSnapshotIfNeeded(map[string][]byte{factory.UserAccountTrie: ownShardHdr.RootHash})
+	if err != nil {
+		return err
+	}
+
 	log.Debug("start in epoch bootstrap: started syncUserAccountsState")
 	err = e.syncUserAccountsState(ownShardHdr.RootHash)
 	if err != nil {
@@ -844,6 +877,38 @@ func (e *epochStartBootstrap) requestAndProcessForShard() error {
 	return nil
 }
 
+// importSnapshotIfNeeded loads the tries of the state snapshot archive, if one was provided, after checking them against
+// the epoch start meta block received from the network. The trie sync that follows will only request the missing nodes
+func (e *epochStartBootstrap) importSnapshotIfNeeded(rootHashes map[string][]byte) error {
+	if len(e.importSnapshotFilePath) == 0 {
+		return nil
+	}
+
+	snapshotImporter, err := snapshot.NewImporter(snapshot.ArgsImporter{
+		FilePath:    e.importSnapshotFilePath,
+		Marshalizer: e.coreComponentsHolder.InternalMarshalizer(),
+		Hasher:      e.coreComponentsHolder.Hasher(),
+	})
+	if err != nil {
+		return fmt.Errorf("%w while opening the state snapshot archive %s", err, e.importSnapshotFilePath)
+	}
+	defer func() {
+		_ = snapshotImporter.Close()
+	}()
+
+	_, storageManagers := e.GetTriesComponents()
+	err = snapshotImporter.ImportTries(e.epochStartMeta, e.shardCoordinator.SelfId(), rootHashes, storageManagers)
+	if errors.Is(err, snapshot.ErrEpochMismatch) || errors.Is(err, snapshot.ErrShardMismatch) {
+		log.Warn("the state snapshot archive can not be used, the state will be synced from the network", "error", err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%w while importing the state snapshot archive %s", err, e.importSnapshotFilePath)
+	}
+
+	return nil
+}
+
 func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 	thr, err := throttler.NewNumGoRoutinesThrottler(int32(e.numConcurrentTrieSyncers))
 	if err != nil {
@@ -865,6 +930,8 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 			MaxTrieLevelInMemory:      e.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
 			MaxHardCapForMissingNodes: e.maxHardCapForMissingNodes,
 			TrieSyncerVersion:         e.trieSyncerVersion,
+			RequestsBudget:            e.trieSyncRequestsBudget,
+			MetricsHandler:            e.coreComponentsHolder.MetricsHandler(),
 		},
 		ShardId:   e.shardCoordinator.SelfId(),
 		Throttler: thr,
@@ -963,6 +1030,8 @@ func (e *epochStartBootstrap) syncValidatorAccountsState(rootHash []byte) error
 			MaxTrieLevelInMemory:      e.generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory,
 			MaxHardCapForMissingNodes: e.maxHardCapForMissingNodes,
 			TrieSyncerVersion:         e.trieSyncerVersion,
+			RequestsBudget:            e.trieSyncRequestsBudget,
+			MetricsHandler:            e.coreComponentsHolder.MetricsHandler(),
 		},
 	}
 	accountsDBSyncer, err := syncer.NewValidatorAccountsSyncer(argsValidatorAccountsSyncer)
@@ -1001,6 +1070,7 @@ func (e *epochStartBootstrap) createRequestHandler() error {
 		OutputAntifloodHandler:      disabled.NewAntiFloodHandler(),
 		CurrentNetworkEpochProvider: disabled.NewCurrentNetworkEpochProviderHandler(),
 		PreferredPeersHolder:        disabled.NewPreferredPeersHolder(),
+		PeersThroughputHandler:      e.peersThroughputTracker,
 		ResolverConfig:              e.generalConfig.Resolvers,
 	}
 	resolverFactory, err := resolverscontainer.NewMetaResolversContainerFactory(resolversContainerArgs)
@@ -55,6 +55,7 @@ func createComponentsForEpochStart() (*mock.CoreComponentsMock, *mock.CryptoComp
 			EpochNotifierField:    &mock.EpochNotifierStub{},
 			TxVersionCheckField:   versioning.NewTxVersionChecker(1),
 			NodeTypeProviderField: &nodeTypeProviderMock.NodeTypeProviderStub{},
+			MetricsHandlerField:   &statusHandlerMock.MetricsHandlerStub{},
 		}, &mock.CryptoComponentsMock{
 			PubKey:   &cryptoMocks.PublicKeyStub{},
 			BlockSig: &cryptoMocks.SignerStub{},
@@ -20,6 +20,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/epochStart/shardchain"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	disabledMetrics "github.com/ElrondNetwork/elrond-go/statusHandler/metrics/disabled"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/factory"
 )
@@ -48,6 +49,7 @@ func NewShardStorageHandler(
 		pathManagerHandler,
 		epochStartNotifier,
 		nodeTypeProvider,
+		&disabledMetrics.MetricsHandler{},
 		currentEpoch,
 		false,
 	)
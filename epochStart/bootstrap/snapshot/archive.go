@@ -0,0 +1,196 @@
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// archive reads a state snapshot archive. The manifest is read when the archive is opened, the entries are streamed
+// by Iterate
+type archive struct {
+	file           *os.File
+	manifest       *Manifest
+	manifestOffset uint64
+}
+
+// OpenArchive opens the archive found at the provided path and reads its manifest
+func OpenArchive(path string) (*archive, error) {
+	if len(path) == 0 {
+		return nil, ErrEmptyFilePath
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &archive{
+		file: file,
+	}
+	err = a.readManifest()
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func (a *archive) readManifest() error {
+	info, err := a.file.Stat()
+	if err != nil {
+		return err
+	}
+
+	size := info.Size()
+	if size < int64(len(archiveMagic)+footerSize) {
+		return ErrInvalidArchive
+	}
+
+	footer := make([]byte, footerSize)
+	_, err = a.file.ReadAt(footer, size-int64(footerSize))
+	if err != nil {
+		return err
+	}
+	if string(footer[16:]) != archiveMagic {
+		return ErrInvalidArchive
+	}
+
+	manifestOffset := binary.BigEndian.Uint64(footer[:8])
+	manifestLength := binary.BigEndian.Uint64(footer[8:16])
+	isManifestInFile := manifestOffset >= uint64(len(archiveMagic)) &&
+		manifestLength <= maxManifestLength &&
+		manifestOffset+manifestLength == uint64(size)-uint64(footerSize)
+	if !isManifestInFile {
+		return ErrInvalidArchive
+	}
+
+	magic := make([]byte, len(archiveMagic))
+	_, err = a.file.ReadAt(magic, 0)
+	if err != nil {
+		return err
+	}
+	if string(magic) != archiveMagic {
+		return ErrInvalidArchive
+	}
+
+	manifestBytes := make([]byte, manifestLength)
+	_, err = a.file.ReadAt(manifestBytes, int64(manifestOffset))
+	if err != nil {
+		return err
+	}
+
+	manifest := &Manifest{}
+	err = json.Unmarshal(manifestBytes, manifest)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidArchive, err.Error())
+	}
+	if manifest.Version != Version {
+		return fmt.Errorf("%w: %d", ErrUnsupportedVersion, manifest.Version)
+	}
+
+	a.manifest = manifest
+	a.manifestOffset = manifestOffset
+
+	return nil
+}
+
+// Manifest returns the manifest of the archive. The manifest is trusted only after a successful Iterate call
+func (a *archive) Manifest() *Manifest {
+	return a.manifest
+}
+
+// Iterate calls the handler for every entry of the archive, in the order they were written, stopping at the first
+// error. After the last entry, the content hash and the number of entries are checked against the manifest, so the
+// handler must be prepared to discard what it received if an error is returned
+func (a *archive) Iterate(handler func(entryType EntryType, key []byte, value []byte) error) error {
+	_, err := a.file.Seek(int64(len(archiveMagic)), io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	contentHasher := sha256.New()
+	content := io.TeeReader(io.LimitReader(a.file, int64(a.manifestOffset)-int64(len(archiveMagic))), contentHasher)
+	reader := bufio.NewReader(content)
+	numEntries := make(map[string]uint64)
+
+	for {
+		entryType, key, value, errRead := readEntry(reader)
+		if errRead == io.EOF {
+			break
+		}
+		if errRead != nil {
+			return errRead
+		}
+
+		err = handler(entryType, key, value)
+		if err != nil {
+			return err
+		}
+		numEntries[entryType.String()]++
+	}
+
+	if !bytes.Equal(contentHasher.Sum(nil), a.manifest.ContentHash) {
+		return ErrContentHashMismatch
+	}
+	for name, num := range a.manifest.NumEntries {
+		if numEntries[name] != num {
+			return fmt.Errorf("%w: expected %d %s entries, found %d", ErrInvalidArchive, num, name, numEntries[name])
+		}
+	}
+
+	return nil
+}
+
+func readEntry(reader *bufio.Reader) (EntryType, []byte, []byte, error) {
+	typeByte, err := reader.ReadByte()
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	entryType := EntryType(typeByte)
+	if !entryType.isValid() {
+		return 0, nil, nil, fmt.Errorf("%w: type %d", ErrInvalidEntry, typeByte)
+	}
+
+	key, err := readBytes(reader, maxKeyLength)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	value, err := readBytes(reader, maxValueLength)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	return entryType, key, value, nil
+}
+
+func readBytes(reader *bufio.Reader, maxLength uint64) ([]byte, error) {
+	length, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidEntry, err.Error())
+	}
+	if length > maxLength {
+		return nil, fmt.Errorf("%w: length %d exceeds %d", ErrInvalidEntry, length, maxLength)
+	}
+
+	buff := make([]byte, length)
+	_, err = io.ReadFull(reader, buff)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidEntry, err.Error())
+	}
+
+	return buff, nil
+}
+
+// Close closes the archive file
+func (a *archive) Close() error {
+	return a.file.Close()
+}
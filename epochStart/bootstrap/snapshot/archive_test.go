@@ -0,0 +1,216 @@
+package snapshot
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEntry struct {
+	entryType EntryType
+	key       []byte
+	value     []byte
+}
+
+func newHashedEntry(entryType EntryType, value []byte) testEntry {
+	return testEntry{
+		entryType: entryType,
+		key:       blake2b.NewBlake2b().Compute(string(value)),
+		value:     value,
+	}
+}
+
+func writeTestArchive(t *testing.T, manifest *Manifest, entries []testEntry) string {
+	path := filepath.Join(t.TempDir(), "snapshot.bin")
+	w, err := NewWriter(path)
+	require.Nil(t, err)
+
+	for _, entry := range entries {
+		err = w.AddEntry(entry.entryType, entry.key, entry.value)
+		require.Nil(t, err)
+	}
+
+	err = w.Close(manifest)
+	require.Nil(t, err)
+
+	return path
+}
+
+func readTestArchive(path string) ([]testEntry, *Manifest, error) {
+	a, err := OpenArchive(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		_ = a.Close()
+	}()
+
+	entries := make([]testEntry, 0)
+	err = a.Iterate(func(entryType EntryType, key []byte, value []byte) error {
+		entries = append(entries, testEntry{entryType: entryType, key: key, value: value})
+		return nil
+	})
+
+	return entries, a.Manifest(), err
+}
+
+func flipByte(t *testing.T, path string, offset int64) {
+	file, err := os.OpenFile(path, os.O_RDWR, 0644)
+	require.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	buff := make([]byte, 1)
+	_, err = file.ReadAt(buff, offset)
+	require.Nil(t, err)
+	buff[0] ^= 0xFF
+	_, err = file.WriteAt(buff, offset)
+	require.Nil(t, err)
+}
+
+func TestNewWriter_EmptyPathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	w, err := NewWriter("")
+	assert.Nil(t, w)
+	assert.Equal(t, ErrEmptyFilePath, err)
+}
+
+func TestNewWriter_ExistingFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	path := writeTestArchive(t, &Manifest{}, nil)
+
+	w, err := NewWriter(path)
+	assert.Nil(t, w)
+	assert.True(t, os.IsExist(err))
+}
+
+func TestWriter_AddEntryInvalidEntryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	w, err := NewWriter(filepath.Join(t.TempDir(), "snapshot.bin"))
+	require.Nil(t, err)
+	defer func() {
+		_ = w.Discard()
+	}()
+
+	err = w.AddEntry(EntryType(0), []byte("key"), []byte("value"))
+	assert.True(t, errors.Is(err, ErrInvalidEntry))
+
+	err = w.AddEntry(MetaBlockEntry, make([]byte, maxKeyLength+1), []byte("value"))
+	assert.True(t, errors.Is(err, ErrInvalidEntry))
+}
+
+func TestWriter_DiscardShouldRemoveTheFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "snapshot.bin")
+	w, err := NewWriter(path)
+	require.Nil(t, err)
+
+	err = w.Discard()
+	assert.Nil(t, err)
+
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestArchive_WriteAndReadShouldWork(t *testing.T) {
+	t.Parallel()
+
+	entries := []testEntry{
+		newHashedEntry(MetaBlockEntry, []byte("meta block")),
+		{entryType: NodesCoordinatorRegistryEntry, key: []byte("registry key"), value: []byte("registry")},
+		newHashedEntry(UserAccountsTrieNodeEntry, []byte("user node 1")),
+		newHashedEntry(UserAccountsTrieNodeEntry, []byte("user node 2")),
+		newHashedEntry(PeerAccountsTrieNodeEntry, make([]byte, 0)),
+	}
+	manifest := &Manifest{
+		ChainID:              "chain",
+		Epoch:                7,
+		ShardID:              1,
+		UserAccountsRootHash: []byte("root hash"),
+	}
+	path := writeTestArchive(t, manifest, entries)
+
+	readEntries, readManifest, err := readTestArchive(path)
+	require.Nil(t, err)
+	assert.Equal(t, len(entries), len(readEntries))
+	for i := range entries {
+		assert.Equal(t, entries[i].entryType, readEntries[i].entryType)
+		assert.Equal(t, entries[i].key, readEntries[i].key)
+		assert.Equal(t, len(entries[i].value), len(readEntries[i].value))
+	}
+
+	assert.Equal(t, uint32(Version), readManifest.Version)
+	assert.Equal(t, "chain", readManifest.ChainID)
+	assert.Equal(t, uint32(7), readManifest.Epoch)
+	assert.Equal(t, uint32(1), readManifest.ShardID)
+	assert.Equal(t, []byte("root hash"), readManifest.UserAccountsRootHash)
+	assert.Equal(t, uint64(2), readManifest.NumEntries[UserAccountsTrieNodeEntry.String()])
+	assert.Equal(t, uint64(1), readManifest.NumEntries[MetaBlockEntry.String()])
+}
+
+func TestOpenArchive_EmptyPathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	a, err := OpenArchive("")
+	assert.Nil(t, a)
+	assert.Equal(t, ErrEmptyFilePath, err)
+}
+
+func TestOpenArchive_NotAnArchiveShouldErr(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "snapshot.bin")
+	err := ioutil.WriteFile(path, []byte("this is not a state snapshot archive, only some text"), 0644)
+	require.Nil(t, err)
+
+	a, err := OpenArchive(path)
+	assert.Nil(t, a)
+	assert.Equal(t, ErrInvalidArchive, err)
+}
+
+func TestOpenArchive_TruncatedArchiveShouldErr(t *testing.T) {
+	t.Parallel()
+
+	path := writeTestArchive(t, &Manifest{}, []testEntry{newHashedEntry(MetaBlockEntry, []byte("meta block"))})
+	info, err := os.Stat(path)
+	require.Nil(t, err)
+	err = os.Truncate(path, info.Size()-1)
+	require.Nil(t, err)
+
+	a, err := OpenArchive(path)
+	assert.Nil(t, a)
+	assert.Equal(t, ErrInvalidArchive, err)
+}
+
+func TestArchive_IterateCorruptedContentShouldErr(t *testing.T) {
+	t.Parallel()
+
+	entry := newHashedEntry(UserAccountsTrieNodeEntry, []byte("user node"))
+	path := writeTestArchive(t, &Manifest{}, []testEntry{entry})
+	// the last byte of the value of the only entry
+	flipByte(t, path, int64(len(archiveMagic)+1+1+len(entry.key)+1+len(entry.value)-1))
+
+	_, _, err := readTestArchive(path)
+	assert.Equal(t, ErrContentHashMismatch, err)
+}
+
+func TestArchive_IterateInvalidEntryTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	path := writeTestArchive(t, &Manifest{}, []testEntry{newHashedEntry(UserAccountsTrieNodeEntry, []byte("user node"))})
+	flipByte(t, path, int64(len(archiveMagic)))
+
+	_, _, err := readTestArchive(path)
+	assert.True(t, errors.Is(err, ErrInvalidEntry))
+}
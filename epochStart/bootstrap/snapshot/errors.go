@@ -0,0 +1,45 @@
+package snapshot
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrEmptyFilePath signals that an empty archive file path has been provided
+var ErrEmptyFilePath = errors.New("empty archive file path")
+
+// ErrInvalidArchive signals that the file is not a state snapshot archive or that it is truncated
+var ErrInvalidArchive = errors.New("invalid state snapshot archive")
+
+// ErrUnsupportedVersion signals that the archive was written with an unsupported format version
+var ErrUnsupportedVersion = errors.New("unsupported state snapshot archive version")
+
+// ErrInvalidEntry signals that an archive entry could not be read or has an unknown type
+var ErrInvalidEntry = errors.New("invalid state snapshot archive entry")
+
+// ErrContentHashMismatch signals that the content of the archive does not match the hash written in its manifest
+var ErrContentHashMismatch = errors.New("state snapshot archive content hash mismatch")
+
+// ErrHashMismatch signals that an archive entry is not stored under its own hash
+var ErrHashMismatch = errors.New("state snapshot archive entry hash mismatch")
+
+// ErrMissingHeader signals that a header required to verify the archive is missing
+var ErrMissingHeader = errors.New("missing header in state snapshot archive")
+
+// ErrRootHashMismatch signals that the root hashes of the archive do not match the ones committed in the headers
+var ErrRootHashMismatch = errors.New("state snapshot archive root hash mismatch")
+
+// ErrEpochStartMetaBlockMismatch signals that the archive was not created for the provided epoch start meta block
+var ErrEpochStartMetaBlockMismatch = errors.New("state snapshot archive epoch start meta block mismatch")
+
+// ErrEpochMismatch signals that the archive was created for another epoch
+var ErrEpochMismatch = errors.New("state snapshot archive epoch mismatch")
+
+// ErrShardMismatch signals that the archive was created for another shard
+var ErrShardMismatch = errors.New("state snapshot archive shard mismatch")
+
+// ErrMissingStorageManager signals that no trie storage manager was provided for a trie contained in the archive
+var ErrMissingStorageManager = errors.New("missing trie storage manager")
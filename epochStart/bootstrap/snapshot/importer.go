@@ -0,0 +1,139 @@
+package snapshot
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+var log = logger.GetOrCreate("epochStart/bootstrap/snapshot")
+
+const progressLogInterval = 1000000
+
+// ArgsImporter holds the arguments needed to create a state snapshot importer
+type ArgsImporter struct {
+	FilePath    string
+	Marshalizer marshal.Marshalizer
+	Hasher      hashing.Hasher
+}
+
+// importer loads the tries of a state snapshot archive into the trie storage managers of a bootstrapping node
+type importer struct {
+	archive     *archive
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+// NewImporter opens the archive and creates a new state snapshot importer
+func NewImporter(args ArgsImporter) (*importer, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	a, err := OpenArchive(args.FilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &importer{
+		archive:     a,
+		marshalizer: args.Marshalizer,
+		hasher:      args.Hasher,
+	}, nil
+}
+
+// Manifest returns the manifest of the archive
+func (i *importer) Manifest() *Manifest {
+	return i.archive.Manifest()
+}
+
+// ImportTries checks that the archive was created for the provided epoch start meta block, received from the network,
+// and for the provided shard, that its root hashes are the expected ones and then writes all its trie nodes in the
+// databases of the trie storage managers. Every node is checked against its hash before being written, so the nodes
+// written before an error is found are valid, even if the archive was tampered with. The tries are not required to be
+// complete: the missing nodes are fetched by the regular trie sync that follows. The headers and the nodes coordinator
+// registry are not imported, the node computes them from the network data
+func (i *importer) ImportTries(
+	epochStartMetaBlock *block.MetaBlock,
+	shardID uint32,
+	rootHashes map[string][]byte,
+	storageManagers map[string]common.StorageManager,
+) error {
+	manifest := i.archive.Manifest()
+	if manifest.Epoch != epochStartMetaBlock.Epoch {
+		return fmt.Errorf("%w: archive epoch %d, network epoch %d", ErrEpochMismatch, manifest.Epoch, epochStartMetaBlock.Epoch)
+	}
+	if manifest.ShardID != shardID {
+		return fmt.Errorf("%w: archive shard %d, node shard %d", ErrShardMismatch, manifest.ShardID, shardID)
+	}
+
+	metaBlockBytes, err := i.marshalizer.Marshal(epochStartMetaBlock)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(i.hasher.Compute(string(metaBlockBytes)), manifest.EpochStartMetaBlockHash) {
+		return ErrEpochStartMetaBlockMismatch
+	}
+
+	for trieIdentifier, rootHash := range manifest.rootHashes() {
+		if !bytes.Equal(rootHash, rootHashes[trieIdentifier]) {
+			return fmt.Errorf("%w for the %s trie", ErrRootHashMismatch, trieIdentifier)
+		}
+		if check.IfNil(storageManagers[trieIdentifier]) {
+			return fmt.Errorf("%w for the %s trie", ErrMissingStorageManager, trieIdentifier)
+		}
+	}
+
+	log.Info("importing the tries from the state snapshot archive",
+		"epoch", manifest.Epoch,
+		"shard", manifest.ShardID,
+		"user accounts root hash", manifest.UserAccountsRootHash,
+		"peer accounts root hash", manifest.PeerAccountsRootHash,
+	)
+
+	numNodes := 0
+	err = i.archive.Iterate(func(entryType EntryType, key []byte, value []byte) error {
+		errCheck := checkEntry(i.hasher, entryType, key, value)
+		if errCheck != nil {
+			return errCheck
+		}
+
+		storageManager, isTrieNode := storageManagers[entryType.trieIdentifier()]
+		if !isTrieNode || check.IfNil(storageManager) {
+			return nil
+		}
+
+		numNodes++
+		if numNodes%progressLogInterval == 0 {
+			log.Info("importing the tries in progress", "num nodes", numNodes)
+		}
+
+		return storageManager.Database().Put(key, value)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("imported the tries from the state snapshot archive", "num nodes", numNodes)
+
+	return nil
+}
+
+// Close closes the archive
+func (i *importer) Close() error {
+	return i.archive.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (i *importer) IsInterfaceNil() bool {
+	return i == nil
+}
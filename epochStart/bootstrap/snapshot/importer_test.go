@@ -0,0 +1,210 @@
+package snapshot
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/trie/factory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createStorageManager(db common.DBWriteCacher) common.StorageManager {
+	return &testscommon.StorageManagerStub{
+		DatabaseCalled: func() common.DBWriteCacher {
+			return db
+		},
+	}
+}
+
+func createImporter(t *testing.T, path string) *importer {
+	snapshotImporter, err := NewImporter(ArgsImporter{
+		FilePath:    path,
+		Marshalizer: testMarshalizer,
+		Hasher:      testHasher,
+	})
+	require.Nil(t, err)
+
+	return snapshotImporter
+}
+
+func TestNewImporter_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	snapshotImporter, err := NewImporter(ArgsImporter{FilePath: "path", Hasher: testHasher})
+	assert.True(t, check.IfNil(snapshotImporter))
+	assert.Equal(t, ErrNilMarshalizer, err)
+}
+
+func TestNewImporter_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	snapshotImporter, err := NewImporter(ArgsImporter{FilePath: "path", Marshalizer: testMarshalizer})
+	assert.True(t, check.IfNil(snapshotImporter))
+	assert.Equal(t, ErrNilHasher, err)
+}
+
+func TestNewImporter_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	path, metaBlock := createTestMetaArchive(t)
+
+	snapshotImporter := createImporter(t, path)
+	assert.False(t, check.IfNil(snapshotImporter))
+	assert.Equal(t, metaBlock.Epoch, snapshotImporter.Manifest().Epoch)
+	assert.Nil(t, snapshotImporter.Close())
+}
+
+func TestImporter_ImportTriesMetaShouldWriteTheNodes(t *testing.T) {
+	t.Parallel()
+
+	path, metaBlock := createTestMetaArchive(t)
+	snapshotImporter := createImporter(t, path)
+	defer func() {
+		_ = snapshotImporter.Close()
+	}()
+
+	userDb := testscommon.NewMemDbMock()
+	peerDb := testscommon.NewMemDbMock()
+	rootHashes := map[string][]byte{
+		factory.UserAccountTrie: metaBlock.RootHash,
+		factory.PeerAccountTrie: metaBlock.ValidatorStatsRootHash,
+	}
+	storageManagers := map[string]common.StorageManager{
+		factory.UserAccountTrie: createStorageManager(userDb),
+		factory.PeerAccountTrie: createStorageManager(peerDb),
+	}
+
+	err := snapshotImporter.ImportTries(metaBlock, core.MetachainShardId, rootHashes, storageManagers)
+	require.Nil(t, err)
+
+	for _, value := range []string{"user node 1", "user node 2"} {
+		storedValue, errGet := userDb.Get(testHasher.Compute(value))
+		assert.Nil(t, errGet)
+		assert.Equal(t, []byte(value), storedValue)
+	}
+	storedValue, err := peerDb.Get(testHasher.Compute("peer node"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("peer node"), storedValue)
+
+	_, err = userDb.Get(testHasher.Compute("peer node"))
+	assert.NotNil(t, err)
+}
+
+func TestImporter_ImportTriesShardShouldWriteTheNodes(t *testing.T) {
+	t.Parallel()
+
+	path, metaBlock := createTestShardArchive(t, []byte("shard root hash"))
+	snapshotImporter := createImporter(t, path)
+	defer func() {
+		_ = snapshotImporter.Close()
+	}()
+
+	userDb := testscommon.NewMemDbMock()
+	rootHashes := map[string][]byte{
+		factory.UserAccountTrie: []byte("shard root hash"),
+	}
+	storageManagers := map[string]common.StorageManager{
+		factory.UserAccountTrie: createStorageManager(userDb),
+		factory.PeerAccountTrie: createStorageManager(testscommon.NewMemDbMock()),
+	}
+
+	err := snapshotImporter.ImportTries(metaBlock, 1, rootHashes, storageManagers)
+	require.Nil(t, err)
+
+	storedValue, err := userDb.Get(testHasher.Compute("user node"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("user node"), storedValue)
+}
+
+func TestImporter_ImportTriesEpochMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	path, metaBlock := createTestMetaArchive(t)
+	snapshotImporter := createImporter(t, path)
+	defer func() {
+		_ = snapshotImporter.Close()
+	}()
+
+	metaBlock.Epoch++
+	err := snapshotImporter.ImportTries(metaBlock, core.MetachainShardId, nil, nil)
+	assert.True(t, errors.Is(err, ErrEpochMismatch))
+}
+
+func TestImporter_ImportTriesShardMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	path, metaBlock := createTestMetaArchive(t)
+	snapshotImporter := createImporter(t, path)
+	defer func() {
+		_ = snapshotImporter.Close()
+	}()
+
+	err := snapshotImporter.ImportTries(metaBlock, 0, nil, nil)
+	assert.True(t, errors.Is(err, ErrShardMismatch))
+}
+
+func TestImporter_ImportTriesDifferentEpochStartMetaBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	path, metaBlock := createTestMetaArchive(t)
+	snapshotImporter := createImporter(t, path)
+	defer func() {
+		_ = snapshotImporter.Close()
+	}()
+
+	metaBlock.Nonce++
+	err := snapshotImporter.ImportTries(metaBlock, core.MetachainShardId, nil, nil)
+	assert.Equal(t, ErrEpochStartMetaBlockMismatch, err)
+}
+
+func TestImporter_ImportTriesRootHashMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	path, metaBlock := createTestMetaArchive(t)
+	snapshotImporter := createImporter(t, path)
+	defer func() {
+		_ = snapshotImporter.Close()
+	}()
+
+	userDb := testscommon.NewMemDbMock()
+	rootHashes := map[string][]byte{
+		factory.UserAccountTrie: metaBlock.RootHash,
+		factory.PeerAccountTrie: []byte("another root hash"),
+	}
+	storageManagers := map[string]common.StorageManager{
+		factory.UserAccountTrie: createStorageManager(userDb),
+		factory.PeerAccountTrie: createStorageManager(testscommon.NewMemDbMock()),
+	}
+
+	err := snapshotImporter.ImportTries(metaBlock, core.MetachainShardId, rootHashes, storageManagers)
+	assert.True(t, errors.Is(err, ErrRootHashMismatch))
+
+	_, err = userDb.Get(testHasher.Compute("user node 1"))
+	assert.NotNil(t, err)
+}
+
+func TestImporter_ImportTriesMissingStorageManagerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	path, metaBlock := createTestMetaArchive(t)
+	snapshotImporter := createImporter(t, path)
+	defer func() {
+		_ = snapshotImporter.Close()
+	}()
+
+	rootHashes := map[string][]byte{
+		factory.UserAccountTrie: metaBlock.RootHash,
+		factory.PeerAccountTrie: metaBlock.ValidatorStatsRootHash,
+	}
+	storageManagers := map[string]common.StorageManager{
+		factory.UserAccountTrie: createStorageManager(testscommon.NewMemDbMock()),
+	}
+
+	err := snapshotImporter.ImportTries(metaBlock, core.MetachainShardId, rootHashes, storageManagers)
+	assert.True(t, errors.Is(err, ErrMissingStorageManager))
+}
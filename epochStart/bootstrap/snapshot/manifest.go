@@ -0,0 +1,101 @@
+package snapshot
+
+import (
+	"github.com/ElrondNetwork/elrond-go/trie/factory"
+)
+
+// Version is the current format version of the state snapshot archives
+const Version = 1
+
+// archiveMagic is written at the beginning and at the end of every archive
+const archiveMagic = "ELRDSNAP"
+
+// footerSize is the size of the footer: the manifest offset, the manifest length and the magic
+const footerSize = 8 + 8 + len(archiveMagic)
+
+// maxKeyLength and maxValueLength bound the entries read from an archive, so a malicious archive can not make the
+// reader allocate huge buffers
+const maxKeyLength = 1024
+const maxValueLength = 64 * 1024 * 1024
+const maxManifestLength = 1024 * 1024
+
+// EntryType defines the type of an archive entry
+type EntryType byte
+
+const (
+	// MetaBlockEntry is a meta block, stored under its hash
+	MetaBlockEntry EntryType = iota + 1
+	// ShardHeaderEntry is a shard header, stored under its hash
+	ShardHeaderEntry
+	// NodesCoordinatorRegistryEntry is the nodes coordinator registry, stored under its bootstrap storage key. It is
+	// kept for inspection only, as it can not be verified against the headers
+	NodesCoordinatorRegistryEntry
+	// UserAccountsTrieNodeEntry is a node of the user accounts trie or of a data trie, stored under its hash
+	UserAccountsTrieNodeEntry
+	// PeerAccountsTrieNodeEntry is a node of the peer accounts trie, stored under its hash
+	PeerAccountsTrieNodeEntry
+)
+
+// String returns the human readable name of the entry type
+func (et EntryType) String() string {
+	switch et {
+	case MetaBlockEntry:
+		return "meta block"
+	case ShardHeaderEntry:
+		return "shard header"
+	case NodesCoordinatorRegistryEntry:
+		return "nodes coordinator registry"
+	case UserAccountsTrieNodeEntry:
+		return "user accounts trie node"
+	case PeerAccountsTrieNodeEntry:
+		return "peer accounts trie node"
+	default:
+		return "unknown"
+	}
+}
+
+func (et EntryType) isValid() bool {
+	return et >= MetaBlockEntry && et <= PeerAccountsTrieNodeEntry
+}
+
+func (et EntryType) isHashed() bool {
+	return et != NodesCoordinatorRegistryEntry
+}
+
+// trieIdentifier returns the trie factory identifier of the trie holding the node, or an empty string for the entries
+// that are not trie nodes
+func (et EntryType) trieIdentifier() string {
+	switch et {
+	case UserAccountsTrieNodeEntry:
+		return factory.UserAccountTrie
+	case PeerAccountsTrieNodeEntry:
+		return factory.PeerAccountTrie
+	default:
+		return ""
+	}
+}
+
+// Manifest describes the content of a state snapshot archive. It is written, as JSON, after the archive entries
+type Manifest struct {
+	Version                 uint32
+	ChainID                 string
+	Epoch                   uint32
+	ShardID                 uint32
+	EpochStartMetaBlockHash []byte
+	UserAccountsRootHash    []byte
+	PeerAccountsRootHash    []byte
+	NumEntries              map[string]uint64
+	ContentHash             []byte
+}
+
+// rootHashes returns the root hashes of the tries contained in the archive, by trie factory identifier
+func (m *Manifest) rootHashes() map[string][]byte {
+	rootHashes := map[string][]byte{
+		factory.UserAccountTrie: m.UserAccountsRootHash,
+	}
+	if len(m.PeerAccountsRootHash) > 0 {
+		rootHashes[factory.PeerAccountTrie] = m.PeerAccountsRootHash
+	}
+
+	return rootHashes
+}
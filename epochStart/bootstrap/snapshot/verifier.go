@@ -0,0 +1,134 @@
+package snapshot
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+)
+
+// checkEntry verifies that the entries that should be stored under their hash really are
+func checkEntry(hasher hashing.Hasher, entryType EntryType, key []byte, value []byte) error {
+	if !entryType.isHashed() {
+		return nil
+	}
+	if !bytes.Equal(hasher.Compute(string(value)), key) {
+		return fmt.Errorf("%w: %s %x", ErrHashMismatch, entryType.String(), key)
+	}
+
+	return nil
+}
+
+// checkRootHashes verifies that the root hashes written in the manifest are the ones committed by the epoch start
+// meta block: directly for the metachain and through the last finalized header of the shard otherwise
+func checkRootHashes(
+	manifest *Manifest,
+	epochStartMetaBlock *block.MetaBlock,
+	getShardHeader func(hash []byte) (*block.Header, error),
+) error {
+	if manifest.ShardID == core.MetachainShardId {
+		if !bytes.Equal(manifest.UserAccountsRootHash, epochStartMetaBlock.RootHash) {
+			return fmt.Errorf("%w for the user accounts trie", ErrRootHashMismatch)
+		}
+		if !bytes.Equal(manifest.PeerAccountsRootHash, epochStartMetaBlock.ValidatorStatsRootHash) {
+			return fmt.Errorf("%w for the peer accounts trie", ErrRootHashMismatch)
+		}
+
+		return nil
+	}
+
+	if len(manifest.PeerAccountsRootHash) > 0 {
+		return fmt.Errorf("%w: the peer accounts trie exists only on metachain", ErrRootHashMismatch)
+	}
+
+	for _, shardData := range epochStartMetaBlock.EpochStart.LastFinalizedHeaders {
+		if shardData.ShardID != manifest.ShardID {
+			continue
+		}
+
+		shardHeader, err := getShardHeader(shardData.HeaderHash)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(manifest.UserAccountsRootHash, shardHeader.RootHash) {
+			return fmt.Errorf("%w for the user accounts trie", ErrRootHashMismatch)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("%w: no epoch start data for shard %d", ErrMissingHeader, manifest.ShardID)
+}
+
+// VerifyArchive fully verifies, offline, the archive found at the provided path: the content hash, the hash of every
+// header and trie node and the root hashes against the headers included in the archive. The returned manifest can
+// then be trusted as much as its epoch start meta block hash, which should be compared with a trusted source
+func VerifyArchive(path string, marshalizer marshal.Marshalizer, hasher hashing.Hasher) (*Manifest, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	a, err := OpenArchive(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = a.Close()
+	}()
+
+	headers := make(map[string][]byte)
+	err = a.Iterate(func(entryType EntryType, key []byte, value []byte) error {
+		errCheck := checkEntry(hasher, entryType, key, value)
+		if errCheck != nil {
+			return errCheck
+		}
+		if entryType == MetaBlockEntry || entryType == ShardHeaderEntry {
+			headers[string(key)] = value
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := a.Manifest()
+	metaBlockBytes, ok := headers[string(manifest.EpochStartMetaBlockHash)]
+	if !ok {
+		return nil, fmt.Errorf("%w: epoch start meta block %x", ErrMissingHeader, manifest.EpochStartMetaBlockHash)
+	}
+
+	epochStartMetaBlock := &block.MetaBlock{}
+	err = marshalizer.Unmarshal(epochStartMetaBlock, metaBlockBytes)
+	if err != nil {
+		return nil, err
+	}
+	if epochStartMetaBlock.Epoch != manifest.Epoch || !epochStartMetaBlock.IsStartOfEpochBlock() {
+		return nil, fmt.Errorf("%w: the meta block is not the start of epoch %d", ErrEpochStartMetaBlockMismatch, manifest.Epoch)
+	}
+
+	getShardHeader := func(hash []byte) (*block.Header, error) {
+		headerBytes, found := headers[string(hash)]
+		if !found {
+			return nil, fmt.Errorf("%w: shard header %x", ErrMissingHeader, hash)
+		}
+
+		header := &block.Header{}
+		errUnmarshal := marshalizer.Unmarshal(header, headerBytes)
+
+		return header, errUnmarshal
+	}
+	err = checkRootHashes(manifest, epochStartMetaBlock, getShardHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
@@ -0,0 +1,179 @@
+package snapshot
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMarshalizer = &marshal.GogoProtoMarshalizer{}
+var testHasher = blake2b.NewBlake2b()
+
+func marshalTestEntry(t *testing.T, entryType EntryType, obj interface{}) testEntry {
+	buff, err := testMarshalizer.Marshal(obj)
+	require.Nil(t, err)
+
+	return newHashedEntry(entryType, buff)
+}
+
+func createTestEpochStartMetaBlock(shardHeaderHash []byte) *block.MetaBlock {
+	return &block.MetaBlock{
+		Nonce:                  100,
+		Epoch:                  5,
+		ChainID:                []byte("chain"),
+		RootHash:               []byte("meta user accounts root hash"),
+		ValidatorStatsRootHash: []byte("meta peer accounts root hash"),
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{
+				{
+					ShardID:    1,
+					Epoch:      5,
+					HeaderHash: shardHeaderHash,
+					RootHash:   []byte("shard root hash"),
+				},
+			},
+		},
+	}
+}
+
+// createTestMetaArchive returns the path of a valid metachain archive and its epoch start meta block
+func createTestMetaArchive(t *testing.T) (string, *block.MetaBlock) {
+	metaBlock := createTestEpochStartMetaBlock([]byte("shard header hash"))
+	metaBlockEntry := marshalTestEntry(t, MetaBlockEntry, metaBlock)
+	manifest := &Manifest{
+		Epoch:                   metaBlock.Epoch,
+		ShardID:                 core.MetachainShardId,
+		EpochStartMetaBlockHash: metaBlockEntry.key,
+		UserAccountsRootHash:    metaBlock.RootHash,
+		PeerAccountsRootHash:    metaBlock.ValidatorStatsRootHash,
+	}
+	entries := []testEntry{
+		metaBlockEntry,
+		newHashedEntry(UserAccountsTrieNodeEntry, []byte("user node 1")),
+		newHashedEntry(UserAccountsTrieNodeEntry, []byte("user node 2")),
+		newHashedEntry(PeerAccountsTrieNodeEntry, []byte("peer node")),
+	}
+
+	return writeTestArchive(t, manifest, entries), metaBlock
+}
+
+// createTestShardArchive returns the path of a valid shard 1 archive and its epoch start meta block
+func createTestShardArchive(t *testing.T, shardRootHash []byte) (string, *block.MetaBlock) {
+	shardHeader := &block.Header{
+		Nonce:    80,
+		ShardID:  1,
+		Epoch:    5,
+		RootHash: []byte("shard root hash"),
+	}
+	shardHeaderEntry := marshalTestEntry(t, ShardHeaderEntry, shardHeader)
+	metaBlock := createTestEpochStartMetaBlock(shardHeaderEntry.key)
+	metaBlockEntry := marshalTestEntry(t, MetaBlockEntry, metaBlock)
+	manifest := &Manifest{
+		Epoch:                   metaBlock.Epoch,
+		ShardID:                 1,
+		EpochStartMetaBlockHash: metaBlockEntry.key,
+		UserAccountsRootHash:    shardRootHash,
+	}
+	entries := []testEntry{
+		metaBlockEntry,
+		shardHeaderEntry,
+		newHashedEntry(UserAccountsTrieNodeEntry, []byte("user node")),
+	}
+
+	return writeTestArchive(t, manifest, entries), metaBlock
+}
+
+func TestVerifyArchive_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	manifest, err := VerifyArchive("path", nil, testHasher)
+	assert.Nil(t, manifest)
+	assert.Equal(t, ErrNilMarshalizer, err)
+}
+
+func TestVerifyArchive_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	manifest, err := VerifyArchive("path", testMarshalizer, nil)
+	assert.Nil(t, manifest)
+	assert.Equal(t, ErrNilHasher, err)
+}
+
+func TestVerifyArchive_MetaArchiveShouldWork(t *testing.T) {
+	t.Parallel()
+
+	path, metaBlock := createTestMetaArchive(t)
+
+	manifest, err := VerifyArchive(path, testMarshalizer, testHasher)
+	require.Nil(t, err)
+	assert.Equal(t, metaBlock.RootHash, manifest.UserAccountsRootHash)
+	assert.Equal(t, metaBlock.ValidatorStatsRootHash, manifest.PeerAccountsRootHash)
+}
+
+func TestVerifyArchive_ShardArchiveShouldWork(t *testing.T) {
+	t.Parallel()
+
+	path, _ := createTestShardArchive(t, []byte("shard root hash"))
+
+	manifest, err := VerifyArchive(path, testMarshalizer, testHasher)
+	require.Nil(t, err)
+	assert.Equal(t, uint32(1), manifest.ShardID)
+}
+
+func TestVerifyArchive_ShardRootHashMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	path, _ := createTestShardArchive(t, []byte("another root hash"))
+
+	manifest, err := VerifyArchive(path, testMarshalizer, testHasher)
+	assert.Nil(t, manifest)
+	assert.True(t, errors.Is(err, ErrRootHashMismatch))
+}
+
+func TestVerifyArchive_EntryStoredUnderWrongHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	entries := []testEntry{
+		{entryType: UserAccountsTrieNodeEntry, key: []byte("not the hash"), value: []byte("user node")},
+	}
+	path := writeTestArchive(t, &Manifest{}, entries)
+
+	manifest, err := VerifyArchive(path, testMarshalizer, testHasher)
+	assert.Nil(t, manifest)
+	assert.True(t, errors.Is(err, ErrHashMismatch))
+}
+
+func TestVerifyArchive_MissingEpochStartMetaBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	entries := []testEntry{
+		newHashedEntry(UserAccountsTrieNodeEntry, []byte("user node")),
+	}
+	path := writeTestArchive(t, &Manifest{EpochStartMetaBlockHash: []byte("meta block hash")}, entries)
+
+	manifest, err := VerifyArchive(path, testMarshalizer, testHasher)
+	assert.Nil(t, manifest)
+	assert.True(t, errors.Is(err, ErrMissingHeader))
+}
+
+func TestVerifyArchive_NotAnEpochStartMetaBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	metaBlockEntry := marshalTestEntry(t, MetaBlockEntry, &block.MetaBlock{Nonce: 100, Epoch: 5})
+	manifest := &Manifest{
+		Epoch:                   5,
+		ShardID:                 core.MetachainShardId,
+		EpochStartMetaBlockHash: metaBlockEntry.key,
+	}
+	path := writeTestArchive(t, manifest, []testEntry{metaBlockEntry})
+
+	verifiedManifest, err := VerifyArchive(path, testMarshalizer, testHasher)
+	assert.Nil(t, verifiedManifest)
+	assert.True(t, errors.Is(err, ErrEpochStartMetaBlockMismatch))
+}
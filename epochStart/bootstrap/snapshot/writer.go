@@ -0,0 +1,145 @@
+package snapshot
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+)
+
+// writer creates a state snapshot archive. The entries are streamed to the file, so the tries do not need to fit
+// in memory, and the manifest is appended when the writer is closed
+type writer struct {
+	path          string
+	file          *os.File
+	buffer        *bufio.Writer
+	contentHasher hash.Hash
+	output        io.Writer
+	offset        uint64
+	numEntries    map[string]uint64
+}
+
+// NewWriter creates the archive file at the provided path. The file must not exist
+func NewWriter(path string) (*writer, error) {
+	if len(path) == 0 {
+		return nil, ErrEmptyFilePath
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &writer{
+		path:          path,
+		file:          file,
+		buffer:        bufio.NewWriter(file),
+		contentHasher: sha256.New(),
+		numEntries:    make(map[string]uint64),
+	}
+	w.output = io.MultiWriter(w.buffer, w.contentHasher)
+
+	_, err = w.buffer.WriteString(archiveMagic)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	w.offset = uint64(len(archiveMagic))
+
+	return w, nil
+}
+
+// AddEntry appends an entry to the archive
+func (w *writer) AddEntry(entryType EntryType, key []byte, value []byte) error {
+	if !entryType.isValid() {
+		return fmt.Errorf("%w: type %d", ErrInvalidEntry, entryType)
+	}
+	if len(key) > maxKeyLength || len(value) > maxValueLength {
+		return fmt.Errorf("%w: %s entry too large", ErrInvalidEntry, entryType.String())
+	}
+
+	buff := make([]byte, 0, 1+2*binary.MaxVarintLen64+len(key)+len(value))
+	buff = append(buff, byte(entryType))
+	buff = appendUvarint(buff, uint64(len(key)))
+	buff = append(buff, key...)
+	buff = appendUvarint(buff, uint64(len(value)))
+	buff = append(buff, value...)
+
+	_, err := w.output.Write(buff)
+	if err != nil {
+		return err
+	}
+
+	w.offset += uint64(len(buff))
+	w.numEntries[entryType.String()]++
+
+	return nil
+}
+
+// Close completes the manifest with the number of entries and the content hash, writes it at the end of the archive
+// and closes the file
+func (w *writer) Close(manifest *Manifest) error {
+	err := w.writeManifest(manifest)
+	errClose := w.file.Close()
+	if err != nil {
+		return err
+	}
+
+	return errClose
+}
+
+// Discard closes and removes the incomplete archive
+func (w *writer) Discard() error {
+	_ = w.file.Close()
+
+	return os.Remove(w.path)
+}
+
+func (w *writer) writeManifest(manifest *Manifest) error {
+	manifest.Version = Version
+	manifest.NumEntries = w.numEntries
+	manifest.ContentHash = w.contentHasher.Sum(nil)
+
+	manifestBytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	footer := make([]byte, 0, footerSize)
+	footer = appendUint64(footer, w.offset)
+	footer = appendUint64(footer, uint64(len(manifestBytes)))
+	footer = append(footer, archiveMagic...)
+
+	_, err = w.buffer.Write(manifestBytes)
+	if err != nil {
+		return err
+	}
+	_, err = w.buffer.Write(footer)
+	if err != nil {
+		return err
+	}
+	err = w.buffer.Flush()
+	if err != nil {
+		return err
+	}
+
+	return w.file.Sync()
+}
+
+func appendUvarint(buff []byte, value uint64) []byte {
+	varint := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(varint, value)
+
+	return append(buff, varint[:n]...)
+}
+
+func appendUint64(buff []byte, value uint64) []byte {
+	encoded := make([]byte, 8)
+	binary.BigEndian.PutUint64(encoded, value)
+
+	return append(buff, encoded...)
+}
@@ -269,6 +269,7 @@ func (sesb *storageEpochStartBootstrap) createStoreForStorageResolvers(shardCoor
 		pathManager,
 		mesn,
 		sesb.coreComponentsHolder.NodeTypeProvider(),
+		sesb.coreComponentsHolder.MetricsHandler(),
 		sesb.importDbConfig.ImportDBStartInEpoch,
 		sesb.importDbConfig.ImportDbSaveTrieEpochRootHash,
 	)
@@ -97,6 +97,7 @@ func NewEpochStartMetaSyncer(args ArgsNewEpochStartMetaSyncer) (*epochStartMetaS
 			Throttler:            disabled.NewThrottler(),
 			AntifloodHandler:     disabled.NewAntiFloodHandler(),
 			WhiteListRequest:     args.WhitelistHandler,
+			MetricsHandler:       args.CoreComponentsHolder.MetricsHandler(),
 			CurrentPeerId:        args.Messenger.ID(),
 			PreferredPeersHolder: disabled.NewPreferredPeersHolder(),
 		},
@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/cryptoMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
+	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -136,6 +137,7 @@ func getEpochStartSyncerArgs() ArgsNewEpochStartMetaSyncer {
 			UInt64ByteSliceConv: &mock.Uint64ByteSliceConverterMock{},
 			AddrPubKeyConv:      mock.NewPubkeyConverterMock(32),
 			PathHdl:             &testscommon.PathManagerStub{},
+			MetricsHandlerField: &statusHandlerMock.MetricsHandlerStub{},
 			ChainIdCalled: func() string {
 				return "chain-ID"
 			},
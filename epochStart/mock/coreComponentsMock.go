@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -27,6 +28,7 @@ type CoreComponentsMock struct {
 	ChainIdCalled               func() string
 	MinTransactionVersionCalled func() uint32
 	StatusHandlerCalled         func() core.AppStatusHandler
+	MetricsHandlerField         common.MetricsHandler
 	GenesisNodesSetupCalled     func() sharding.GenesisNodesSetupHandler
 	TxVersionCheckField         process.TxVersionCheckerHandler
 	ChanStopNode                chan endProcess.ArgEndProcess
@@ -137,6 +139,11 @@ func (ccm *CoreComponentsMock) StatusHandler() core.AppStatusHandler {
 	return nil
 }
 
+// MetricsHandler -
+func (ccm *CoreComponentsMock) MetricsHandler() common.MetricsHandler {
+	return ccm.MetricsHandlerField
+}
+
 // GenesisNodesSetup -
 func (ccm *CoreComponentsMock) GenesisNodesSetup() sharding.GenesisNodesSetupHandler {
 	if ccm.GenesisNodesSetupCalled != nil {
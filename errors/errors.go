@@ -260,6 +260,12 @@ var ErrNilPeerBlackListHandler = errors.New("nil peer black list handler")
 // ErrNilPeerHonestyHandler signals that a nil peer honesty handler was provided
 var ErrNilPeerHonestyHandler = errors.New("nil peer honesty handler")
 
+// ErrNilPeerReputationHandler signals that a nil peer reputation handler was provided
+var ErrNilPeerReputationHandler = errors.New("nil peer reputation handler")
+
+// ErrNilAntifloodConfigHandler signals that a nil antiflood config handler was provided
+var ErrNilAntifloodConfigHandler = errors.New("nil antiflood config handler")
+
 // ErrNilPeerShardMapper signals that a nil peer shard mapper was provided
 var ErrNilPeerShardMapper = errors.New("nil peer shard mapper")
 
@@ -457,3 +463,6 @@ var ErrNilLocker = errors.New("nil locker")
 
 // ErrNilCurrentEpochProvider signals that a nil current epoch provider was provided
 var ErrNilCurrentEpochProvider = errors.New("nil current epoch provider")
+
+// ErrNilMetricsHandler signals that a nil metrics handler was provided
+var ErrNilMetricsHandler = errors.New("nil metrics handler")
@@ -29,8 +29,14 @@ var ErrNilTransactionSimulatorProcessor = errors.New("nil transaction simulator
 // ErrNilBlockchain signals that a nil blockchain has been provided
 var ErrNilBlockchain = errors.New("nil blockchain")
 
+// ErrNilSubscriptionsHub signals that a nil subscriptions hub has been provided
+var ErrNilSubscriptionsHub = errors.New("nil subscriptions hub")
+
 // ErrNilBlockHeader signals that the current block header is nil
 var ErrNilBlockHeader = errors.New("nil block header")
 
 // ErrNilMarshalizer signals that an operation has been attempted to or with a nil Marshalizer implementation
 var ErrNilMarshalizer = errors.New("nil Marshalizer")
+
+// ErrNilAntifloodConfigReloader signals that a nil antiflood config reloader has been provided
+var ErrNilAntifloodConfigReloader = errors.New("nil antiflood config reloader")
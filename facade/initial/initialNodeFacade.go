@@ -10,10 +10,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -58,6 +60,26 @@ func (inf *initialNodeFacade) VerifyProof(_ string, _ string, _ [][]byte) (bool,
 	return false, errNodeStarting
 }
 
+// GetProofDataTrieMultiKey -
+func (inf *initialNodeFacade) GetProofDataTrieMultiKey(_ string, _ string, _ []string) (*common.GetProofResponse, *common.GetMultiKeyProofResponse, error) {
+	return nil, nil, errNodeStarting
+}
+
+// GetProofDataTrieRange -
+func (inf *initialNodeFacade) GetProofDataTrieRange(_ string, _ string, _ string, _ string, _ int) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+	return nil, nil, errNodeStarting
+}
+
+// VerifyProofDataTrieMultiKey -
+func (inf *initialNodeFacade) VerifyProofDataTrieMultiKey(_ string, _ string, _ []string, _ [][]byte) ([]core.KeyValueHolder, error) {
+	return nil, errNodeStarting
+}
+
+// VerifyProofDataTrieRange -
+func (inf *initialNodeFacade) VerifyProofDataTrieRange(_ string, _ string, _ string, _ string, _ [][]byte) ([]core.KeyValueHolder, error) {
+	return nil, errNodeStarting
+}
+
 // SetSyncer does nothing
 func (inf *initialNodeFacade) SetSyncer(_ ntp.SyncTimer) {
 }
@@ -74,17 +96,17 @@ func (inf *initialNodeFacade) RestApiInterface() string {
 }
 
 // GetBalance returns nil and error
-func (inf *initialNodeFacade) GetBalance(_ string) (*big.Int, error) {
+func (inf *initialNodeFacade) GetBalance(_ string, _ common.AccountQueryOptions) (*big.Int, error) {
 	return nil, errNodeStarting
 }
 
 // GetUsername returns empty string and error
-func (inf *initialNodeFacade) GetUsername(_ string) (string, error) {
+func (inf *initialNodeFacade) GetUsername(_ string, _ common.AccountQueryOptions) (string, error) {
 	return emptyString, errNodeStarting
 }
 
 // GetValueForKey returns an empty string and error
-func (inf *initialNodeFacade) GetValueForKey(_ string, _ string) (string, error) {
+func (inf *initialNodeFacade) GetValueForKey(_ string, _ string, _ common.AccountQueryOptions) (string, error) {
 	return emptyString, errNodeStarting
 }
 
@@ -94,17 +116,17 @@ func (inf *initialNodeFacade) GetESDTBalance(_ string, _ string) (string, string
 }
 
 // GetAllESDTTokens returns nil and error
-func (inf *initialNodeFacade) GetAllESDTTokens(_ string) (map[string]*esdt.ESDigitalToken, error) {
+func (inf *initialNodeFacade) GetAllESDTTokens(_ string, _ common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error) {
 	return nil, errNodeStarting
 }
 
 // GetNFTTokenIDsRegisteredByAddress returns nil and error
-func (inf *initialNodeFacade) GetNFTTokenIDsRegisteredByAddress(_ string) ([]string, error) {
+func (inf *initialNodeFacade) GetNFTTokenIDsRegisteredByAddress(_ string, _ common.AccountQueryOptions) ([]string, error) {
 	return nil, errNodeStarting
 }
 
 // GetESDTsWithRole returns nil and error
-func (inf *initialNodeFacade) GetESDTsWithRole(_ string, _ string) ([]string, error) {
+func (inf *initialNodeFacade) GetESDTsWithRole(_ string, _ string, _ common.AccountQueryOptions) ([]string, error) {
 	return nil, errNodeStarting
 }
 
@@ -151,6 +173,26 @@ func (inf *initialNodeFacade) SimulateTransactionExecution(_ *transaction.Transa
 	return nil, errNodeStarting
 }
 
+// SimulateTransactionWithOptions returns nil and error
+func (inf *initialNodeFacade) SimulateTransactionWithOptions(_ *transaction.Transaction, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+	return nil, errNodeStarting
+}
+
+// TraceTransaction returns nil and error
+func (inf *initialNodeFacade) TraceTransaction(_ string) (*txSimData.SimulationResults, error) {
+	return nil, errNodeStarting
+}
+
+// SimulateTransactionBundleExecution returns nil and error
+func (inf *initialNodeFacade) SimulateTransactionBundleExecution(_ []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	return nil, errNodeStarting
+}
+
+// GetMaxTxBundleSize returns 0
+func (inf *initialNodeFacade) GetMaxTxBundleSize() uint32 {
+	return 0
+}
+
 // GetTransaction returns nil and error
 func (inf *initialNodeFacade) GetTransaction(_ string, _ bool) (*transaction.ApiTransactionResult, error) {
 	return nil, errNodeStarting
@@ -162,7 +204,7 @@ func (inf *initialNodeFacade) ComputeTransactionGasLimit(_ *transaction.Transact
 }
 
 // GetAccount returns nil and error
-func (inf *initialNodeFacade) GetAccount(_ string) (api.AccountResponse, error) {
+func (inf *initialNodeFacade) GetAccount(_ string, _ common.AccountQueryOptions) (api.AccountResponse, error) {
 	return api.AccountResponse{}, errNodeStarting
 }
 
@@ -201,6 +243,11 @@ func (inf *initialNodeFacade) PprofEnabled() bool {
 	return inf.pprofEnabled
 }
 
+// GetSubscriptionsHub returns a disabled subscriptions hub
+func (inf *initialNodeFacade) GetSubscriptionsHub() subscriptions.Hub {
+	return subscriptions.NewDisabledSubscriptionsHub()
+}
+
 // Trigger returns error
 func (inf *initialNodeFacade) Trigger(_ uint32, _ bool) error {
 	return errNodeStarting
@@ -231,6 +278,21 @@ func (inf *initialNodeFacade) GetPeerInfo(_ string) ([]core.QueryP2PPeerInfo, er
 	return nil, errNodeStarting
 }
 
+// ReloadAntifloodConfig returns nil and error
+func (inf *initialNodeFacade) ReloadAntifloodConfig() (*config.AntifloodConfig, error) {
+	return nil, errNodeStarting
+}
+
+// GetPeersReputation returns nil and error
+func (inf *initialNodeFacade) GetPeersReputation() ([]*common.PeerReputation, error) {
+	return nil, errNodeStarting
+}
+
+// GetStateStatistics returns nil and error
+func (inf *initialNodeFacade) GetStateStatistics(_ string, _ int) (*common.StateStatistics, error) {
+	return nil, errNodeStarting
+}
+
 // GetThrottlerForEndpoint returns nil and false
 func (inf *initialNodeFacade) GetThrottlerForEndpoint(_ string) (core.Throttler, bool) {
 	return nil, false
@@ -251,6 +313,36 @@ func (inf *initialNodeFacade) GetBlockByRound(_ uint64, _ bool) (*api.Block, err
 	return nil, errNodeStarting
 }
 
+// GetLogs returns nil and error
+func (inf *initialNodeFacade) GetLogs(_ *common.LogsQuery) (*common.LogsResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetAddressTransactions returns nil and error
+func (inf *initialNodeFacade) GetAddressTransactions(_ string, _ uint64, _ uint64) (*common.AddressTransactionsResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionsPool returns nil and error
+func (inf *initialNodeFacade) GetTransactionsPool(_ common.TxPoolQueryOptions) (*common.TxPoolTransactionsResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionsPoolForSender returns nil and error
+func (inf *initialNodeFacade) GetTransactionsPoolForSender(_ string) (*common.TxPoolSenderResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionsPoolStatistics returns nil and error
+func (inf *initialNodeFacade) GetTransactionsPoolStatistics() (*common.TxPoolStatisticsResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetEvictedTransactionFromPool returns nil and error
+func (inf *initialNodeFacade) GetEvictedTransactionFromPool(_ string) (*common.TxPoolEvictedTransaction, error) {
+	return nil, errNodeStarting
+}
+
 // Close returns error
 func (inf *initialNodeFacade) Close() error {
 	return errNodeStarting
@@ -267,7 +359,7 @@ func (inf *initialNodeFacade) GetNumCheckpointsFromPeerState() uint32 {
 }
 
 // GetKeyValuePairs nil map
-func (inf *initialNodeFacade) GetKeyValuePairs(_ string) (map[string]string, error) {
+func (inf *initialNodeFacade) GetKeyValuePairs(_ string, _ common.AccountQueryOptions) (map[string]string, error) {
 	return nil, errNodeStarting
 }
 
@@ -282,12 +374,12 @@ func (inf *initialNodeFacade) GetDelegatorsList() ([]*api.Delegator, error) {
 }
 
 // GetESDTData returns nil and error
-func (inf *initialNodeFacade) GetESDTData(_ string, _ string, _ uint64) (*esdt.ESDigitalToken, error) {
+func (inf *initialNodeFacade) GetESDTData(_ string, _ string, _ uint64, _ common.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
 	return nil, errNodeStarting
 }
 
 // GetESDTsRoles return nil and error
-func (inf *initialNodeFacade) GetESDTsRoles(_ string) (map[string][]string, error) {
+func (inf *initialNodeFacade) GetESDTsRoles(_ string, _ common.AccountQueryOptions) (map[string][]string, error) {
 	return nil, errNodeStarting
 }
 
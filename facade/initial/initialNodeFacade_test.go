@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -29,19 +30,19 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	s1, s2, err := inf.GetESDTBalance("", "")
 	assert.Equal(t, emptyString, s1+s2)
 	assert.Equal(t, errNodeStarting, err)
-	v, err := inf.GetBalance("")
+	v, err := inf.GetBalance("", common.AccountQueryOptions{})
 	assert.Nil(t, v)
 	assert.Equal(t, errNodeStarting, err)
 
-	s1, err = inf.GetUsername("")
+	s1, err = inf.GetUsername("", common.AccountQueryOptions{})
 	assert.Equal(t, emptyString, s1)
 	assert.Equal(t, errNodeStarting, err)
 
-	s1, err = inf.GetValueForKey("", "")
+	s1, err = inf.GetValueForKey("", "", common.AccountQueryOptions{})
 	assert.Equal(t, emptyString, s1)
 	assert.Equal(t, errNodeStarting, err)
 
-	s3, err := inf.GetAllESDTTokens("")
+	s3, err := inf.GetAllESDTTokens("", common.AccountQueryOptions{})
 	assert.Nil(t, s3)
 	assert.Equal(t, errNodeStarting, err)
 
@@ -77,7 +78,7 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, resp)
 	assert.Equal(t, errNodeStarting, err)
 
-	uac, err := inf.GetAccount("")
+	uac, err := inf.GetAccount("", common.AccountQueryOptions{})
 	assert.Equal(t, api.AccountResponse{}, uac)
 	assert.Equal(t, errNodeStarting, err)
 
@@ -117,6 +118,14 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, qp)
 	assert.Equal(t, errNodeStarting, err)
 
+	reputations, err := inf.GetPeersReputation()
+	assert.Nil(t, reputations)
+	assert.Equal(t, errNodeStarting, err)
+
+	antifloodConfig, err := inf.ReloadAntifloodConfig()
+	assert.Nil(t, antifloodConfig)
+	assert.Equal(t, errNodeStarting, err)
+
 	th, b := inf.GetThrottlerForEndpoint("")
 	assert.Nil(t, th)
 	assert.False(t, b)
@@ -157,11 +166,29 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.False(t, b)
 	assert.Equal(t, errNodeStarting, err)
 
-	sa, err := inf.GetNFTTokenIDsRegisteredByAddress("")
+	mainProof, multiKeyProof, err := inf.GetProofDataTrieMultiKey("", "", nil)
+	assert.Nil(t, mainProof)
+	assert.Nil(t, multiKeyProof)
+	assert.Equal(t, errNodeStarting, err)
+
+	mainProof, rangeProof, err := inf.GetProofDataTrieRange("", "", "", "", 0)
+	assert.Nil(t, mainProof)
+	assert.Nil(t, rangeProof)
+	assert.Equal(t, errNodeStarting, err)
+
+	provenValues, err := inf.VerifyProofDataTrieMultiKey("", "", nil, nil)
+	assert.Nil(t, provenValues)
+	assert.Equal(t, errNodeStarting, err)
+
+	provenValues, err = inf.VerifyProofDataTrieRange("", "", "", "", nil)
+	assert.Nil(t, provenValues)
+	assert.Equal(t, errNodeStarting, err)
+
+	sa, err := inf.GetNFTTokenIDsRegisteredByAddress("", common.AccountQueryOptions{})
 	assert.Nil(t, sa)
 	assert.Equal(t, errNodeStarting, err)
 
-	sa, err = inf.GetESDTsWithRole("", "")
+	sa, err = inf.GetESDTsWithRole("", "", common.AccountQueryOptions{})
 	assert.Nil(t, sa)
 	assert.Equal(t, errNodeStarting, err)
 
@@ -172,7 +199,7 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, asv)
 	assert.Equal(t, errNodeStarting, err)
 
-	mss, err := inf.GetKeyValuePairs("")
+	mss, err := inf.GetKeyValuePairs("", common.AccountQueryOptions{})
 	assert.Nil(t, mss)
 	assert.Equal(t, errNodeStarting, err)
 
@@ -180,7 +207,7 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, ds)
 	assert.Equal(t, errNodeStarting, err)
 
-	mssa, err := inf.GetESDTsRoles("")
+	mssa, err := inf.GetESDTsRoles("", common.AccountQueryOptions{})
 	assert.Nil(t, mssa)
 	assert.Equal(t, errNodeStarting, err)
 
@@ -192,5 +219,21 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Empty(t, s1)
 	assert.Equal(t, errNodeStarting, err)
 
+	txPool, err := inf.GetTransactionsPool(common.TxPoolQueryOptions{})
+	assert.Nil(t, txPool)
+	assert.Equal(t, errNodeStarting, err)
+
+	txPoolForSender, err := inf.GetTransactionsPoolForSender("")
+	assert.Nil(t, txPoolForSender)
+	assert.Equal(t, errNodeStarting, err)
+
+	txPoolStatistics, err := inf.GetTransactionsPoolStatistics()
+	assert.Nil(t, txPoolStatistics)
+	assert.Equal(t, errNodeStarting, err)
+
+	evictedTx, err := inf.GetEvictedTransactionFromPool("")
+	assert.Nil(t, evictedTx)
+	assert.Equal(t, errNodeStarting, err)
+
 	assert.False(t, check.IfNil(inf))
 }
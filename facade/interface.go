@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -20,34 +21,34 @@ import (
 // NodeHandler contains all functions that a node should contain.
 type NodeHandler interface {
 	// GetBalance returns the balance for a specific address
-	GetBalance(address string) (*big.Int, error)
+	GetBalance(address string, options common.AccountQueryOptions) (*big.Int, error)
 
 	// GetUsername returns the username for a specific address
-	GetUsername(address string) (string, error)
+	GetUsername(address string, options common.AccountQueryOptions) (string, error)
 
 	// GetValueForKey returns the value of a key from a given account
-	GetValueForKey(address string, key string) (string, error)
+	GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error)
 
 	// GetKeyValuePairs returns the key-value pairs under a given address
-	GetKeyValuePairs(address string) (map[string]string, error)
+	GetKeyValuePairs(address string, options common.AccountQueryOptions) (map[string]string, error)
 
 	// GetAllIssuedESDTs returns all the issued esdt tokens from esdt system smart contract
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
 
 	// GetESDTData returns the esdt data from a given account, given key and given nonce
-	GetESDTData(address, tokenID string, nonce uint64) (*esdt.ESDigitalToken, error)
+	GetESDTData(address, tokenID string, nonce uint64, options common.AccountQueryOptions) (*esdt.ESDigitalToken, error)
 
 	// GetESDTsRoles returns the the token identifiers and the roles for a given address
-	GetESDTsRoles(address string) (map[string][]string, error)
+	GetESDTsRoles(address string, options common.AccountQueryOptions) (map[string][]string, error)
 
 	// GetNFTTokenIDsRegisteredByAddress returns all the token identifiers for semi or non fungible tokens registered by the address
-	GetNFTTokenIDsRegisteredByAddress(address string) ([]string, error)
+	GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) ([]string, error)
 
 	// GetESDTsWithRole returns the token identifiers where the specified address has the given role
-	GetESDTsWithRole(address string, role string) ([]string, error)
+	GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) ([]string, error)
 
 	// GetAllESDTTokens returns the value of a key from a given account
-	GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error)
+	GetAllESDTTokens(address string, options common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error)
 
 	// GetTokenSupply returns the provided token supply from current shard
 	GetTokenSupply(token string) (string, error)
@@ -66,9 +67,12 @@ type NodeHandler interface {
 	// GetTransaction will return a transaction based on the hash
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 
+	// GetTransactionReplayData returns what is needed for re-executing an already executed transaction
+	GetTransactionReplayData(hash string) (*txSimData.ReplayData, error)
+
 	// GetAccount returns an accountResponse containing information
 	//  about the account correlated with provided address
-	GetAccount(address string) (api.AccountResponse, error)
+	GetAccount(address string, options common.AccountQueryOptions) (api.AccountResponse, error)
 
 	// GetCode returns the code for the given code hash
 	GetCode(codeHash []byte) []byte
@@ -89,19 +93,34 @@ type NodeHandler interface {
 
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetPeersReputation() []*common.PeerReputation
 
 	GetBlockByHash(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
 	GetBlockByRound(round uint64, withTxs bool) (*api.Block, error)
+	GetLogs(query *common.LogsQuery) (*common.LogsResponse, error)
+	GetAddressTransactions(address string, from uint64, size uint64) (*common.AddressTransactionsResponse, error)
+	GetTransactionsPool(options common.TxPoolQueryOptions) (*common.TxPoolTransactionsResponse, error)
+	GetTransactionsPoolForSender(address string) (*common.TxPoolSenderResponse, error)
+	GetTransactionsPoolStatistics() (*common.TxPoolStatisticsResponse, error)
+	GetEvictedTransactionFromPool(txHash string) (*common.TxPoolEvictedTransaction, error)
 
 	GetProof(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetProofDataTrieMultiKey(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiKeyProofResponse, error)
+	GetProofDataTrieRange(rootHash string, address string, startKey string, endKey string, maxNumKeys int) (*common.GetProofResponse, *common.GetRangeProofResponse, error)
+	VerifyProofDataTrieMultiKey(rootHash string, address string, keys []string, proof [][]byte) ([]core.KeyValueHolder, error)
+	VerifyProofDataTrieRange(rootHash string, address string, startKey string, endKey string, proof [][]byte) ([]core.KeyValueHolder, error)
+	GetStateStatistics(rootHash string, numLargestDataTries int) (*common.StateStatistics, error)
 }
 
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
 	ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxWithOptions(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	ProcessTxBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	ReplayTx(replayData *txSimData.ReplayData) (*txSimData.SimulationResults, error)
 	IsInterfaceNil() bool
 }
 
@@ -117,6 +136,12 @@ type ApiResolver interface {
 	IsInterfaceNil() bool
 }
 
+// AntifloodConfigReloader defines the behavior of a component able to reload the antiflood configuration at runtime
+type AntifloodConfigReloader interface {
+	Reload() (*config.AntifloodConfig, error)
+	IsInterfaceNil() bool
+}
+
 // HardforkTrigger defines the structure used to trigger hardforks
 type HardforkTrigger interface {
 	Trigger(epoch uint32, withEarlyEndOfEpoch bool) error
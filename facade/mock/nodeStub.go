@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
 )
 
@@ -18,7 +19,7 @@ import (
 type NodeStub struct {
 	AddressHandler             func() (string, error)
 	ConnectToAddressesHandler  func([]string) error
-	GetBalanceHandler          func(address string) (*big.Int, error)
+	GetBalanceHandler          func(address string, options common.AccountQueryOptions) (*big.Int, error)
 	GenerateTransactionHandler func(sender string, receiver string, amount string, code string) (*transaction.Transaction, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version, options uint32) (*transaction.Transaction, []byte, error)
@@ -26,7 +27,7 @@ type NodeStub struct {
 	ValidateTransactionForSimulationCalled         func(tx *transaction.Transaction, bypassSignature bool) error
 	GetTransactionHandler                          func(hash string, withEvents bool) (*transaction.ApiTransactionResult, error)
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
-	GetAccountHandler                              func(address string) (api.AccountResponse, error)
+	GetAccountHandler                              func(address string, options common.AccountQueryOptions) (api.AccountResponse, error)
 	GetCodeCalled                                  func(codeHash []byte) []byte
 	GetCurrentPublicKeyHandler                     func() string
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
@@ -36,22 +37,35 @@ type NodeStub struct {
 	DirectTriggerCalled                            func(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTriggerCalled                            func() bool
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
-	GetValueForKeyCalled                           func(address string, key string) (string, error)
+	GetValueForKeyCalled                           func(address string, key string, options common.AccountQueryOptions) (string, error)
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetPeersReputationCalled                       func() []*common.PeerReputation
 	GetBlockByHashCalled                           func(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonceCalled                          func(nonce uint64, withTxs bool) (*api.Block, error)
 	GetBlockByRoundCalled                          func(round uint64, withTxs bool) (*api.Block, error)
-	GetUsernameCalled                              func(address string) (string, error)
-	GetESDTDataCalled                              func(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error)
-	GetAllESDTTokensCalled                         func(address string) (map[string]*esdt.ESDigitalToken, error)
-	GetNFTTokenIDsRegisteredByAddressCalled        func(address string) ([]string, error)
-	GetESDTsWithRoleCalled                         func(address string, role string) ([]string, error)
-	GetESDTsRolesCalled                            func(address string) (map[string][]string, error)
-	GetKeyValuePairsCalled                         func(address string) (map[string]string, error)
+	GetLogsCalled                                  func(query *common.LogsQuery) (*common.LogsResponse, error)
+	GetAddressTransactionsCalled                   func(address string, from uint64, size uint64) (*common.AddressTransactionsResponse, error)
+	GetTransactionsPoolCalled                      func(options common.TxPoolQueryOptions) (*common.TxPoolTransactionsResponse, error)
+	GetTransactionsPoolForSenderCalled             func(address string) (*common.TxPoolSenderResponse, error)
+	GetTransactionsPoolStatisticsCalled            func() (*common.TxPoolStatisticsResponse, error)
+	GetEvictedTransactionFromPoolCalled            func(txHash string) (*common.TxPoolEvictedTransaction, error)
+	GetTransactionReplayDataCalled                 func(hash string) (*txSimData.ReplayData, error)
+	GetUsernameCalled                              func(address string, options common.AccountQueryOptions) (string, error)
+	GetESDTDataCalled                              func(address string, key string, nonce uint64, options common.AccountQueryOptions) (*esdt.ESDigitalToken, error)
+	GetAllESDTTokensCalled                         func(address string, options common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error)
+	GetNFTTokenIDsRegisteredByAddressCalled        func(address string, options common.AccountQueryOptions) ([]string, error)
+	GetESDTsWithRoleCalled                         func(address string, role string, options common.AccountQueryOptions) ([]string, error)
+	GetESDTsRolesCalled                            func(address string, options common.AccountQueryOptions) (map[string][]string, error)
+	GetKeyValuePairsCalled                         func(address string, options common.AccountQueryOptions) (map[string]string, error)
 	GetAllIssuedESDTsCalled                        func(tokenType string) ([]string, error)
 	GetProofCalled                                 func(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                         func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                              func(rootHash string, address string, proof [][]byte) (bool, error)
+	GetProofDataTrieMultiKeyCalled                 func(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiKeyProofResponse, error)
+	GetProofDataTrieRangeCalled                    func(rootHash string, address string, startKey string, endKey string, maxNumKeys int) (*common.GetProofResponse, *common.GetRangeProofResponse, error)
+	VerifyProofDataTrieMultiKeyCalled              func(rootHash string, address string, keys []string, proof [][]byte) ([]core.KeyValueHolder, error)
+	VerifyProofDataTrieRangeCalled                 func(rootHash string, address string, startKey string, endKey string, proof [][]byte) ([]core.KeyValueHolder, error)
+	GetStateStatisticsCalled                       func(rootHash string, numLargestDataTries int) (*common.StateStatistics, error)
 }
 
 // GetProof -
@@ -81,28 +95,64 @@ func (ns *NodeStub) VerifyProof(rootHash string, address string, proof [][]byte)
 	return false, nil
 }
 
+// GetProofDataTrieMultiKey -
+func (ns *NodeStub) GetProofDataTrieMultiKey(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiKeyProofResponse, error) {
+	if ns.GetProofDataTrieMultiKeyCalled != nil {
+		return ns.GetProofDataTrieMultiKeyCalled(rootHash, address, keys)
+	}
+
+	return nil, nil, nil
+}
+
+// GetProofDataTrieRange -
+func (ns *NodeStub) GetProofDataTrieRange(rootHash string, address string, startKey string, endKey string, maxNumKeys int) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+	if ns.GetProofDataTrieRangeCalled != nil {
+		return ns.GetProofDataTrieRangeCalled(rootHash, address, startKey, endKey, maxNumKeys)
+	}
+
+	return nil, nil, nil
+}
+
+// VerifyProofDataTrieMultiKey -
+func (ns *NodeStub) VerifyProofDataTrieMultiKey(rootHash string, address string, keys []string, proof [][]byte) ([]core.KeyValueHolder, error) {
+	if ns.VerifyProofDataTrieMultiKeyCalled != nil {
+		return ns.VerifyProofDataTrieMultiKeyCalled(rootHash, address, keys, proof)
+	}
+
+	return nil, nil
+}
+
+// VerifyProofDataTrieRange -
+func (ns *NodeStub) VerifyProofDataTrieRange(rootHash string, address string, startKey string, endKey string, proof [][]byte) ([]core.KeyValueHolder, error) {
+	if ns.VerifyProofDataTrieRangeCalled != nil {
+		return ns.VerifyProofDataTrieRangeCalled(rootHash, address, startKey, endKey, proof)
+	}
+
+	return nil, nil
+}
+
 // GetUsername -
-func (ns *NodeStub) GetUsername(address string) (string, error) {
+func (ns *NodeStub) GetUsername(address string, options common.AccountQueryOptions) (string, error) {
 	if ns.GetUsernameCalled != nil {
-		return ns.GetUsernameCalled(address)
+		return ns.GetUsernameCalled(address, options)
 	}
 
 	return "", nil
 }
 
 // GetKeyValuePairs -
-func (ns *NodeStub) GetKeyValuePairs(address string) (map[string]string, error) {
+func (ns *NodeStub) GetKeyValuePairs(address string, options common.AccountQueryOptions) (map[string]string, error) {
 	if ns.GetKeyValuePairsCalled != nil {
-		return ns.GetKeyValuePairsCalled(address)
+		return ns.GetKeyValuePairsCalled(address, options)
 	}
 
 	return nil, nil
 }
 
 // GetValueForKey -
-func (ns *NodeStub) GetValueForKey(address string, key string) (string, error) {
+func (ns *NodeStub) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
 	if ns.GetValueForKeyCalled != nil {
-		return ns.GetValueForKeyCalled(address, key)
+		return ns.GetValueForKeyCalled(address, key, options)
 	}
 
 	return "", nil
@@ -131,14 +181,70 @@ func (ns *NodeStub) GetBlockByRound(round uint64, withTxs bool) (*api.Block, err
 	return nil, nil
 }
 
+// GetLogs -
+func (ns *NodeStub) GetLogs(query *common.LogsQuery) (*common.LogsResponse, error) {
+	if ns.GetLogsCalled != nil {
+		return ns.GetLogsCalled(query)
+	}
+	return nil, nil
+}
+
+// GetAddressTransactions -
+func (ns *NodeStub) GetAddressTransactions(address string, from uint64, size uint64) (*common.AddressTransactionsResponse, error) {
+	if ns.GetAddressTransactionsCalled != nil {
+		return ns.GetAddressTransactionsCalled(address, from, size)
+	}
+	return nil, nil
+}
+
+// GetTransactionsPool -
+func (ns *NodeStub) GetTransactionsPool(options common.TxPoolQueryOptions) (*common.TxPoolTransactionsResponse, error) {
+	if ns.GetTransactionsPoolCalled != nil {
+		return ns.GetTransactionsPoolCalled(options)
+	}
+	return nil, nil
+}
+
+// GetTransactionsPoolForSender -
+func (ns *NodeStub) GetTransactionsPoolForSender(address string) (*common.TxPoolSenderResponse, error) {
+	if ns.GetTransactionsPoolForSenderCalled != nil {
+		return ns.GetTransactionsPoolForSenderCalled(address)
+	}
+	return nil, nil
+}
+
+// GetTransactionsPoolStatistics -
+func (ns *NodeStub) GetTransactionsPoolStatistics() (*common.TxPoolStatisticsResponse, error) {
+	if ns.GetTransactionsPoolStatisticsCalled != nil {
+		return ns.GetTransactionsPoolStatisticsCalled()
+	}
+	return nil, nil
+}
+
+// GetEvictedTransactionFromPool -
+func (ns *NodeStub) GetEvictedTransactionFromPool(txHash string) (*common.TxPoolEvictedTransaction, error) {
+	if ns.GetEvictedTransactionFromPoolCalled != nil {
+		return ns.GetEvictedTransactionFromPoolCalled(txHash)
+	}
+	return nil, nil
+}
+
+// GetTransactionReplayData -
+func (ns *NodeStub) GetTransactionReplayData(hash string) (*txSimData.ReplayData, error) {
+	if ns.GetTransactionReplayDataCalled != nil {
+		return ns.GetTransactionReplayDataCalled(hash)
+	}
+	return nil, nil
+}
+
 // DecodeAddressPubkey -
 func (ns *NodeStub) DecodeAddressPubkey(pk string) ([]byte, error) {
 	return hex.DecodeString(pk)
 }
 
 // GetBalance -
-func (ns *NodeStub) GetBalance(address string) (*big.Int, error) {
-	return ns.GetBalanceHandler(address)
+func (ns *NodeStub) GetBalance(address string, options common.AccountQueryOptions) (*big.Int, error) {
+	return ns.GetBalanceHandler(address, options)
 }
 
 // CreateTransaction -
@@ -148,7 +254,7 @@ func (ns *NodeStub) CreateTransaction(nonce uint64, value string, receiver strin
 	return ns.CreateTransactionHandler(nonce, value, receiver, receiverUsername, sender, senderUsername, gasPrice, gasLimit, data, signatureHex, chainID, version, options)
 }
 
-//ValidateTransaction -
+// ValidateTransaction -
 func (ns *NodeStub) ValidateTransaction(tx *transaction.Transaction) error {
 	return ns.ValidateTransactionHandler(tx)
 }
@@ -169,8 +275,8 @@ func (ns *NodeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64
 }
 
 // GetAccount -
-func (ns *NodeStub) GetAccount(address string) (api.AccountResponse, error) {
-	return ns.GetAccountHandler(address)
+func (ns *NodeStub) GetAccount(address string, options common.AccountQueryOptions) (api.AccountResponse, error) {
+	return ns.GetAccountHandler(address, options)
 }
 
 // GetCode -
@@ -220,37 +326,55 @@ func (ns *NodeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return make([]core.QueryP2PPeerInfo, 0), nil
 }
 
+// GetPeersReputation -
+func (ns *NodeStub) GetPeersReputation() []*common.PeerReputation {
+	if ns.GetPeersReputationCalled != nil {
+		return ns.GetPeersReputationCalled()
+	}
+
+	return make([]*common.PeerReputation, 0)
+}
+
+// GetStateStatistics -
+func (ns *NodeStub) GetStateStatistics(rootHash string, numLargestDataTries int) (*common.StateStatistics, error) {
+	if ns.GetStateStatisticsCalled != nil {
+		return ns.GetStateStatisticsCalled(rootHash, numLargestDataTries)
+	}
+
+	return &common.StateStatistics{}, nil
+}
+
 // GetESDTData -
-func (ns *NodeStub) GetESDTData(address, tokenID string, nonce uint64) (*esdt.ESDigitalToken, error) {
+func (ns *NodeStub) GetESDTData(address, tokenID string, nonce uint64, options common.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
 	if ns.GetESDTDataCalled != nil {
-		return ns.GetESDTDataCalled(address, tokenID, nonce)
+		return ns.GetESDTDataCalled(address, tokenID, nonce, options)
 	}
 
 	return &esdt.ESDigitalToken{Value: big.NewInt(0)}, nil
 }
 
 // GetESDTsRoles -
-func (ns *NodeStub) GetESDTsRoles(address string) (map[string][]string, error) {
+func (ns *NodeStub) GetESDTsRoles(address string, options common.AccountQueryOptions) (map[string][]string, error) {
 	if ns.GetESDTsRolesCalled != nil {
-		return ns.GetESDTsRolesCalled(address)
+		return ns.GetESDTsRolesCalled(address, options)
 	}
 
 	return map[string][]string{}, nil
 }
 
 // GetESDTsWithRole -
-func (ns *NodeStub) GetESDTsWithRole(address string, role string) ([]string, error) {
+func (ns *NodeStub) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) ([]string, error) {
 	if ns.GetESDTsWithRoleCalled != nil {
-		return ns.GetESDTsWithRoleCalled(address, role)
+		return ns.GetESDTsWithRoleCalled(address, role, options)
 	}
 
 	return make([]string, 0), nil
 }
 
 // GetAllESDTTokens -
-func (ns *NodeStub) GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error) {
+func (ns *NodeStub) GetAllESDTTokens(address string, options common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error) {
 	if ns.GetAllESDTTokensCalled != nil {
-		return ns.GetAllESDTTokensCalled(address)
+		return ns.GetAllESDTTokensCalled(address, options)
 	}
 
 	return make(map[string]*esdt.ESDigitalToken), nil
@@ -270,9 +394,9 @@ func (ns *NodeStub) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 }
 
 // GetNFTTokenIDsRegisteredByAddress -
-func (ns *NodeStub) GetNFTTokenIDsRegisteredByAddress(address string) ([]string, error) {
+func (ns *NodeStub) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) ([]string, error) {
 	if ns.GetNFTTokenIDsRegisteredByAddressCalled != nil {
-		return ns.GetNFTTokenIDsRegisteredByAddressCalled(address)
+		return ns.GetNFTTokenIDsRegisteredByAddressCalled(address, options)
 	}
 
 	return make([]string, 0), nil
@@ -7,7 +7,10 @@ import (
 
 // TxExecutionSimulatorStub -
 type TxExecutionSimulatorStub struct {
-	ProcessTxCalled func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxCalled            func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxBundleCalled      func(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	ProcessTxWithOptionsCalled func(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	ReplayTxCalled             func(replayData *txSimData.ReplayData) (*txSimData.SimulationResults, error)
 }
 
 // ProcessTx -
@@ -19,6 +22,33 @@ func (t *TxExecutionSimulatorStub) ProcessTx(tx *transaction.Transaction) (*txSi
 	return &txSimData.SimulationResults{}, nil
 }
 
+// ProcessTxWithOptions -
+func (t *TxExecutionSimulatorStub) ProcessTxWithOptions(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+	if t.ProcessTxWithOptionsCalled != nil {
+		return t.ProcessTxWithOptionsCalled(tx, options)
+	}
+
+	return &txSimData.SimulationResults{}, nil
+}
+
+// ProcessTxBundle -
+func (t *TxExecutionSimulatorStub) ProcessTxBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	if t.ProcessTxBundleCalled != nil {
+		return t.ProcessTxBundleCalled(txs)
+	}
+
+	return &txSimData.BundleSimulationResults{}, nil
+}
+
+// ReplayTx -
+func (t *TxExecutionSimulatorStub) ReplayTx(replayData *txSimData.ReplayData) (*txSimData.SimulationResults, error) {
+	if t.ReplayTxCalled != nil {
+		return t.ReplayTxCalled(replayData)
+	}
+
+	return &txSimData.SimulationResults{}, nil
+}
+
 // IsInterfaceNil -
 func (t *TxExecutionSimulatorStub) IsInterfaceNil() bool {
 	return t == nil
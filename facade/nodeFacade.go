@@ -22,6 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -46,9 +47,12 @@ type ArgNodeFacade struct {
 	WsAntifloodConfig      config.WebServerAntifloodConfig
 	FacadeConfig           config.FacadeConfig
 	ApiRoutesConfig        config.ApiRoutesConfig
+	TxSimulatorConfig      config.TxSimulatorConfig
 	AccountsState          state.AccountsAdapter
 	PeerState              state.AccountsAdapter
 	Blockchain             chainData.ChainHandler
+	SubscriptionsHub       subscriptions.Hub
+	AntifloodReloader      AntifloodConfigReloader
 }
 
 // nodeFacade represents a facade for grouping the functionality for the node
@@ -61,10 +65,13 @@ type nodeFacade struct {
 	apiRoutesConfig        config.ApiRoutesConfig
 	endpointsThrottlers    map[string]core.Throttler
 	wsAntifloodConfig      config.WebServerAntifloodConfig
+	maxTxBundleSize        uint32
 	restAPIServerDebugMode bool
 	accountsState          state.AccountsAdapter
 	peerState              state.AccountsAdapter
 	blockchain             chainData.ChainHandler
+	subscriptionsHub       subscriptions.Hub
+	antifloodReloader      AntifloodConfigReloader
 	ctx                    context.Context
 	cancelFunc             func()
 }
@@ -92,6 +99,9 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 	if arg.WsAntifloodConfig.SameSourceResetIntervalInSec == 0 {
 		return nil, fmt.Errorf("%w, SameSourceResetIntervalInSec should not be 0", ErrInvalidValue)
 	}
+	if arg.TxSimulatorConfig.MaxTxBundleSize == 0 {
+		return nil, fmt.Errorf("%w, MaxTxBundleSize should not be 0", ErrInvalidValue)
+	}
 	if check.IfNil(arg.AccountsState) {
 		return nil, ErrNilAccountState
 	}
@@ -101,6 +111,12 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 	if check.IfNil(arg.Blockchain) {
 		return nil, ErrNilBlockchain
 	}
+	if check.IfNil(arg.SubscriptionsHub) {
+		return nil, ErrNilSubscriptionsHub
+	}
+	if check.IfNil(arg.AntifloodReloader) {
+		return nil, ErrNilAntifloodConfigReloader
+	}
 
 	throttlersMap := computeEndpointsNumGoRoutinesThrottlers(arg.WsAntifloodConfig)
 
@@ -110,12 +126,15 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 		restAPIServerDebugMode: arg.RestAPIServerDebugMode,
 		txSimulatorProc:        arg.TxSimulatorProcessor,
 		wsAntifloodConfig:      arg.WsAntifloodConfig,
+		maxTxBundleSize:        arg.TxSimulatorConfig.MaxTxBundleSize,
 		config:                 arg.FacadeConfig,
 		apiRoutesConfig:        arg.ApiRoutesConfig,
 		endpointsThrottlers:    throttlersMap,
 		accountsState:          arg.AccountsState,
 		peerState:              arg.PeerState,
 		blockchain:             arg.Blockchain,
+		subscriptionsHub:       arg.SubscriptionsHub,
+		antifloodReloader:      arg.AntifloodReloader,
 	}
 	nf.ctx, nf.cancelFunc = context.WithCancel(context.Background())
 
@@ -162,48 +181,48 @@ func (nf *nodeFacade) RestApiInterface() string {
 }
 
 // GetBalance gets the current balance for a specified address
-func (nf *nodeFacade) GetBalance(address string) (*big.Int, error) {
-	return nf.node.GetBalance(address)
+func (nf *nodeFacade) GetBalance(address string, options common.AccountQueryOptions) (*big.Int, error) {
+	return nf.node.GetBalance(address, options)
 }
 
 // GetUsername gets the username for a specified address
-func (nf *nodeFacade) GetUsername(address string) (string, error) {
-	return nf.node.GetUsername(address)
+func (nf *nodeFacade) GetUsername(address string, options common.AccountQueryOptions) (string, error) {
+	return nf.node.GetUsername(address, options)
 }
 
 // GetValueForKey gets the value for a key in a given address
-func (nf *nodeFacade) GetValueForKey(address string, key string) (string, error) {
-	return nf.node.GetValueForKey(address, key)
+func (nf *nodeFacade) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
+	return nf.node.GetValueForKey(address, key, options)
 }
 
 // GetESDTData returns the ESDT data for the given address, tokenID and nonce
-func (nf *nodeFacade) GetESDTData(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error) {
-	return nf.node.GetESDTData(address, key, nonce)
+func (nf *nodeFacade) GetESDTData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
+	return nf.node.GetESDTData(address, key, nonce, options)
 }
 
 // GetESDTsRoles returns all the tokens identifiers and roles for the given address
-func (nf *nodeFacade) GetESDTsRoles(address string) (map[string][]string, error) {
-	return nf.node.GetESDTsRoles(address)
+func (nf *nodeFacade) GetESDTsRoles(address string, options common.AccountQueryOptions) (map[string][]string, error) {
+	return nf.node.GetESDTsRoles(address, options)
 }
 
 // GetNFTTokenIDsRegisteredByAddress returns all the token identifiers for semi or non fungible tokens registered by the address
-func (nf *nodeFacade) GetNFTTokenIDsRegisteredByAddress(address string) ([]string, error) {
-	return nf.node.GetNFTTokenIDsRegisteredByAddress(address)
+func (nf *nodeFacade) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) ([]string, error) {
+	return nf.node.GetNFTTokenIDsRegisteredByAddress(address, options)
 }
 
 // GetESDTsWithRole returns all the tokens with the given role for the given address
-func (nf *nodeFacade) GetESDTsWithRole(address string, role string) ([]string, error) {
-	return nf.node.GetESDTsWithRole(address, role)
+func (nf *nodeFacade) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) ([]string, error) {
+	return nf.node.GetESDTsWithRole(address, role, options)
 }
 
 // GetKeyValuePairs returns all the key-value pairs under the provided address
-func (nf *nodeFacade) GetKeyValuePairs(address string) (map[string]string, error) {
-	return nf.node.GetKeyValuePairs(address)
+func (nf *nodeFacade) GetKeyValuePairs(address string, options common.AccountQueryOptions) (map[string]string, error) {
+	return nf.node.GetKeyValuePairs(address, options)
 }
 
 // GetAllESDTTokens returns all the esdt tokens for a given address
-func (nf *nodeFacade) GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error) {
-	return nf.node.GetAllESDTTokens(address)
+func (nf *nodeFacade) GetAllESDTTokens(address string, options common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error) {
+	return nf.node.GetAllESDTTokens(address, options)
 }
 
 // GetTokenSupply returns the provided token supply
@@ -261,6 +280,40 @@ func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction)
 	return nf.txSimulatorProc.ProcessTx(tx)
 }
 
+// SimulateTransactionWithOptions will simulate a transaction's execution and will return the results, along with the
+// requested optional outputs, such as the state changes of the affected accounts or the execution trace
+func (nf *nodeFacade) SimulateTransactionWithOptions(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+	return nf.txSimulatorProc.ProcessTxWithOptions(tx, options)
+}
+
+// TraceTransaction will re-execute an already executed transaction on top of the state its block was executed on and
+// will return the results, along with the execution trace and the state changes
+func (nf *nodeFacade) TraceTransaction(hash string) (*txSimData.SimulationResults, error) {
+	replayData, err := nf.node.GetTransactionReplayData(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := nf.txSimulatorProc.ReplayTx(replayData)
+	if err != nil {
+		return nil, err
+	}
+	results.Hash = hash
+
+	return results, nil
+}
+
+// SimulateTransactionBundleExecution will simulate the execution of the transactions, in the provided order, over the
+// same state and will return the results
+func (nf *nodeFacade) SimulateTransactionBundleExecution(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	return nf.txSimulatorProc.ProcessTxBundle(txs)
+}
+
+// GetMaxTxBundleSize returns the maximum number of transactions of a simulated bundle
+func (nf *nodeFacade) GetMaxTxBundleSize() uint32 {
+	return nf.maxTxBundleSize
+}
+
 // GetTransaction gets the transaction with a specified hash
 func (nf *nodeFacade) GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	return nf.node.GetTransaction(hash, withResults)
@@ -272,8 +325,8 @@ func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*
 }
 
 // GetAccount returns a response containing information about the account correlated with provided address
-func (nf *nodeFacade) GetAccount(address string) (apiData.AccountResponse, error) {
-	accountResponse, err := nf.node.GetAccount(address)
+func (nf *nodeFacade) GetAccount(address string, options common.AccountQueryOptions) (apiData.AccountResponse, error) {
+	accountResponse, err := nf.node.GetAccount(address, options)
 	if err != nil {
 		return apiData.AccountResponse{}, err
 	}
@@ -359,6 +412,16 @@ func (nf *nodeFacade) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return nf.node.GetPeerInfo(pid)
 }
 
+// ReloadAntifloodConfig re-reads the antiflood configuration and applies it on the running node
+func (nf *nodeFacade) ReloadAntifloodConfig() (*config.AntifloodConfig, error) {
+	return nf.antifloodReloader.Reload()
+}
+
+// GetPeersReputation returns the persisted reputation of the banned peers
+func (nf *nodeFacade) GetPeersReputation() ([]*common.PeerReputation, error) {
+	return nf.node.GetPeersReputation(), nil
+}
+
 // GetThrottlerForEndpoint returns the throttler for a given endpoint if found
 func (nf *nodeFacade) GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool) {
 	throttlerForEndpoint, ok := nf.endpointsThrottlers[endpoint]
@@ -382,6 +445,36 @@ func (nf *nodeFacade) GetBlockByRound(round uint64, withTxs bool) (*apiData.Bloc
 	return nf.node.GetBlockByRound(round, withTxs)
 }
 
+// GetLogs returns a page of the log events matching the provided query
+func (nf *nodeFacade) GetLogs(query *common.LogsQuery) (*common.LogsResponse, error) {
+	return nf.node.GetLogs(query)
+}
+
+// GetAddressTransactions returns a page of the transactions sent or received by the provided address
+func (nf *nodeFacade) GetAddressTransactions(address string, from uint64, size uint64) (*common.AddressTransactionsResponse, error) {
+	return nf.node.GetAddressTransactions(address, from, size)
+}
+
+// GetTransactionsPool returns a page of the transactions from the pool matching the provided filters
+func (nf *nodeFacade) GetTransactionsPool(options common.TxPoolQueryOptions) (*common.TxPoolTransactionsResponse, error) {
+	return nf.node.GetTransactionsPool(options)
+}
+
+// GetTransactionsPoolForSender returns the nonce-ordered transactions of a sender from the pool
+func (nf *nodeFacade) GetTransactionsPoolForSender(address string) (*common.TxPoolSenderResponse, error) {
+	return nf.node.GetTransactionsPoolForSender(address)
+}
+
+// GetTransactionsPoolStatistics returns aggregate information about the transactions pool
+func (nf *nodeFacade) GetTransactionsPoolStatistics() (*common.TxPoolStatisticsResponse, error) {
+	return nf.node.GetTransactionsPoolStatistics()
+}
+
+// GetEvictedTransactionFromPool returns the details about a transaction recently evicted from the pool
+func (nf *nodeFacade) GetEvictedTransactionFromPool(txHash string) (*common.TxPoolEvictedTransaction, error) {
+	return nf.node.GetEvictedTransactionFromPool(txHash)
+}
+
 // Close will cleanup started go routines
 func (nf *nodeFacade) Close() error {
 	log.LogIfError(nf.apiResolver.Close())
@@ -420,11 +513,48 @@ func (nf *nodeFacade) GetProofCurrentRootHash(address string) (*common.GetProofR
 	return nf.node.GetProof(hexRootHash, address)
 }
 
+// GetProofDataTrieMultiKey returns the Merkle Proof for the given address, and a single Merkle Proof for all the
+// given keys of its dataTrie
+func (nf *nodeFacade) GetProofDataTrieMultiKey(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiKeyProofResponse, error) {
+	return nf.node.GetProofDataTrieMultiKey(rootHash, address, keys)
+}
+
+// GetProofDataTrieRange returns the Merkle Proof for the given address, and a Merkle Proof for all the keys of its
+// dataTrie placed between the start key and the end key
+func (nf *nodeFacade) GetProofDataTrieRange(rootHash string, address string, startKey string, endKey string, maxNumKeys int) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+	return nf.node.GetProofDataTrieRange(rootHash, address, startKey, endKey, maxNumKeys)
+}
+
+// VerifyProofDataTrieMultiKey verifies the given multi-key Merkle proof of a dataTrie and returns the proven values
+func (nf *nodeFacade) VerifyProofDataTrieMultiKey(rootHash string, address string, keys []string, proof [][]byte) ([]core.KeyValueHolder, error) {
+	return nf.node.VerifyProofDataTrieMultiKey(rootHash, address, keys, proof)
+}
+
+// VerifyProofDataTrieRange verifies the given range Merkle proof of a dataTrie and returns the proven key-value pairs
+func (nf *nodeFacade) VerifyProofDataTrieRange(rootHash string, address string, startKey string, endKey string, proof [][]byte) ([]core.KeyValueHolder, error) {
+	return nf.node.VerifyProofDataTrieRange(rootHash, address, startKey, endKey, proof)
+}
+
 // VerifyProof verifies the given Merkle proof
 func (nf *nodeFacade) VerifyProof(rootHash string, address string, proof [][]byte) (bool, error) {
 	return nf.node.VerifyProof(rootHash, address, proof)
 }
 
+// GetStateStatistics returns the statistics of the accounts trie having the provided root hash and of its data tries.
+// An empty root hash stands for the root hash of the current block
+func (nf *nodeFacade) GetStateStatistics(rootHash string, numLargestDataTries int) (*common.StateStatistics, error) {
+	if len(rootHash) == 0 {
+		currentBlockHeader := nf.blockchain.GetCurrentBlockHeader()
+		if check.IfNil(currentBlockHeader) {
+			return nil, ErrNilBlockHeader
+		}
+
+		rootHash = hex.EncodeToString(currentBlockHeader.GetRootHash())
+	}
+
+	return nf.node.GetStateStatistics(rootHash, numLargestDataTries)
+}
+
 // GetNumCheckpointsFromPeerState returns the number of checkpoints of the peer state
 func (nf *nodeFacade) GetNumCheckpointsFromPeerState() uint32 {
 	return nf.peerState.GetNumCheckpoints()
@@ -512,6 +642,11 @@ func (nf *nodeFacade) convertVmOutputToApiResponse(input *vmcommon.VMOutput) *vm
 	}
 }
 
+// GetSubscriptionsHub returns the hub used by the WebSocket clients to subscribe to blocks and log events
+func (nf *nodeFacade) GetSubscriptionsHub() subscriptions.Hub {
+	return nf.subscriptionsHub
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nf *nodeFacade) IsInterfaceNil() bool {
 	return nf == nil
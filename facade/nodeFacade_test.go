@@ -12,8 +12,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	atomicCore "github.com/ElrondNetwork/elrond-go-core/core/atomic"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/keyValStorage"
 	nodeData "github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/common"
@@ -22,8 +24,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/facade/mock"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/process"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
@@ -54,9 +59,12 @@ func createMockArguments() ArgNodeFacade {
 				},
 			},
 		}},
-		AccountsState: &stateMock.AccountsStub{},
-		PeerState:     &stateMock.AccountsStub{},
-		Blockchain:    &mock.ChainHandlerStub{},
+		TxSimulatorConfig: config.TxSimulatorConfig{MaxTxBundleSize: 10},
+		AccountsState:     &stateMock.AccountsStub{},
+		PeerState:         &stateMock.AccountsStub{},
+		Blockchain:        &mock.ChainHandlerStub{},
+		SubscriptionsHub:  subscriptions.NewDisabledSubscriptionsHub(),
+		AntifloodReloader: &testscommon.AntifloodConfigReloaderStub{},
 	}
 }
 
@@ -84,6 +92,28 @@ func TestNewNodeFacade_WithNilApiResolverShouldErr(t *testing.T) {
 	assert.Equal(t, ErrNilApiResolver, err)
 }
 
+func TestNewNodeFacade_WithNilSubscriptionsHubShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.SubscriptionsHub = nil
+	nf, err := NewNodeFacade(arg)
+
+	assert.True(t, check.IfNil(nf))
+	assert.Equal(t, ErrNilSubscriptionsHub, err)
+}
+
+func TestNewNodeFacade_WithNilAntifloodReloaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.AntifloodReloader = nil
+	nf, err := NewNodeFacade(arg)
+
+	assert.True(t, check.IfNil(nf))
+	assert.Equal(t, ErrNilAntifloodConfigReloader, err)
+}
+
 func TestNewNodeFacade_WithInvalidSimultaneousRequestsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -106,6 +136,17 @@ func TestNewNodeFacade_WithInvalidSameSourceResetIntervalInSecShouldErr(t *testi
 	assert.True(t, errors.Is(err, ErrInvalidValue))
 }
 
+func TestNewNodeFacade_WithInvalidMaxTxBundleSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.TxSimulatorConfig.MaxTxBundleSize = 0
+	nf, err := NewNodeFacade(arg)
+
+	assert.True(t, check.IfNil(nf))
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+}
+
 func TestNewNodeFacade_WithInvalidSameSourceRequestsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -146,7 +187,7 @@ func TestNodeFacade_GetBalanceWithValidAddressShouldReturnBalance(t *testing.T)
 	balance := big.NewInt(10)
 	addr := "testAddress"
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ common.AccountQueryOptions) (*big.Int, error) {
 			if addr == address {
 				return balance, nil
 			}
@@ -158,12 +199,33 @@ func TestNodeFacade_GetBalanceWithValidAddressShouldReturnBalance(t *testing.T)
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(addr)
+	amount, err := nf.GetBalance(addr, common.AccountQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, balance, amount)
 }
 
+func TestNodeFacade_GetBalanceAtBlockShouldPassTheAccountQueryOptions(t *testing.T) {
+	t.Parallel()
+
+	options := common.AccountQueryOptions{BlockNonce: 37, HasBlockNonce: true}
+	node := &mock.NodeStub{
+		GetBalanceHandler: func(_ string, providedOptions common.AccountQueryOptions) (*big.Int, error) {
+			assert.Equal(t, options, providedOptions)
+			return big.NewInt(10), nil
+		},
+	}
+
+	arg := createMockArguments()
+	arg.Node = node
+	nf, _ := NewNodeFacade(arg)
+
+	amount, err := nf.GetBalance("testAddress", options)
+
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), amount)
+}
+
 func TestNodeFacade_GetBalanceWithUnknownAddressShouldReturnZeroBalance(t *testing.T) {
 	t.Parallel()
 
@@ -173,7 +235,7 @@ func TestNodeFacade_GetBalanceWithUnknownAddressShouldReturnZeroBalance(t *testi
 	zeroBalance := big.NewInt(0)
 
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ common.AccountQueryOptions) (*big.Int, error) {
 			if addr == address {
 				return balance, nil
 			}
@@ -185,7 +247,7 @@ func TestNodeFacade_GetBalanceWithUnknownAddressShouldReturnZeroBalance(t *testi
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(unknownAddr)
+	amount, err := nf.GetBalance(unknownAddr, common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, zeroBalance, amount)
 }
@@ -197,7 +259,7 @@ func TestNodeFacade_GetBalanceWithErrorOnNodeShouldReturnZeroBalanceAndError(t *
 	zeroBalance := big.NewInt(0)
 
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ common.AccountQueryOptions) (*big.Int, error) {
 			return big.NewInt(0), errors.New("error on getBalance on node")
 		},
 	}
@@ -206,7 +268,7 @@ func TestNodeFacade_GetBalanceWithErrorOnNodeShouldReturnZeroBalanceAndError(t *
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(addr)
+	amount, err := nf.GetBalance(addr, common.AccountQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, zeroBalance, amount)
 }
@@ -273,7 +335,7 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 
 	getAccountCalled := false
 	node := &mock.NodeStub{}
-	node.GetAccountHandler = func(address string) (api.AccountResponse, error) {
+	node.GetAccountHandler = func(address string, _ common.AccountQueryOptions) (api.AccountResponse, error) {
 		getAccountCalled = true
 		return api.AccountResponse{}, nil
 	}
@@ -282,7 +344,7 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	_, _ = nf.GetAccount("test")
+	_, _ = nf.GetAccount("test", common.AccountQueryOptions{})
 	assert.True(t, getAccountCalled)
 }
 
@@ -291,7 +353,7 @@ func TestNodeFacade_GetUsername(t *testing.T) {
 
 	expectedUsername := "username"
 	node := &mock.NodeStub{}
-	node.GetUsernameCalled = func(address string) (string, error) {
+	node.GetUsernameCalled = func(address string, _ common.AccountQueryOptions) (string, error) {
 		return expectedUsername, nil
 	}
 
@@ -299,7 +361,7 @@ func TestNodeFacade_GetUsername(t *testing.T) {
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	username, err := nf.GetUsername("test")
+	username, err := nf.GetUsername("test", common.AccountQueryOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, expectedUsername, username)
 }
@@ -600,6 +662,42 @@ func TestNodeFacade_GetPeerInfo(t *testing.T) {
 	assert.Equal(t, []core.QueryP2PPeerInfo{pinfo}, val)
 }
 
+func TestNodeFacade_ReloadAntifloodConfig(t *testing.T) {
+	t.Parallel()
+
+	antifloodConfig := &config.AntifloodConfig{Enabled: true}
+	arg := createMockArguments()
+	arg.AntifloodReloader = &testscommon.AntifloodConfigReloaderStub{
+		ReloadCalled: func() (*config.AntifloodConfig, error) {
+			return antifloodConfig, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	val, err := nf.ReloadAntifloodConfig()
+
+	assert.Nil(t, err)
+	assert.Equal(t, antifloodConfig, val)
+}
+
+func TestNodeFacade_GetPeersReputation(t *testing.T) {
+	t.Parallel()
+
+	reputations := []*common.PeerReputation{{Identifier: "pid"}}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetPeersReputationCalled: func() []*common.PeerReputation {
+			return reputations
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	val, err := nf.GetPeersReputation()
+
+	assert.Nil(t, err)
+	assert.Equal(t, reputations, val)
+}
+
 func TestNodeFacade_GetThrottlerForEndpointNoConfigShouldReturnNilAndFalse(t *testing.T) {
 	t.Parallel()
 
@@ -655,14 +753,14 @@ func TestNodeFacade_GetKeyValuePairs(t *testing.T) {
 	expectedPairs := map[string]string{"k": "v"}
 	arg := createMockArguments()
 	arg.Node = &mock.NodeStub{
-		GetKeyValuePairsCalled: func(address string) (map[string]string, error) {
+		GetKeyValuePairsCalled: func(address string, _ common.AccountQueryOptions) (map[string]string, error) {
 			return expectedPairs, nil
 		},
 	}
 
 	nf, _ := NewNodeFacade(arg)
 
-	res, err := nf.GetKeyValuePairs("addr")
+	res, err := nf.GetKeyValuePairs("addr", common.AccountQueryOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, expectedPairs, res)
 }
@@ -676,14 +774,14 @@ func TestNodeFacade_GetAllESDTTokens(t *testing.T) {
 	}
 	arg := createMockArguments()
 	arg.Node = &mock.NodeStub{
-		GetAllESDTTokensCalled: func(_ string) (map[string]*esdt.ESDigitalToken, error) {
+		GetAllESDTTokensCalled: func(_ string, _ common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error) {
 			return expectedTokens, nil
 		},
 	}
 
 	nf, _ := NewNodeFacade(arg)
 
-	res, err := nf.GetAllESDTTokens("addr")
+	res, err := nf.GetAllESDTTokens("addr", common.AccountQueryOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTokens, res)
 }
@@ -696,14 +794,14 @@ func TestNodeFacade_GetESDTData(t *testing.T) {
 	}
 	arg := createMockArguments()
 	arg.Node = &mock.NodeStub{
-		GetESDTDataCalled: func(_ string, _ string, _ uint64) (*esdt.ESDigitalToken, error) {
+		GetESDTDataCalled: func(_ string, _ string, _ uint64, _ common.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
 			return expectedData, nil
 		},
 	}
 
 	nf, _ := NewNodeFacade(arg)
 
-	res, err := nf.GetESDTData("addr", "tkn", 0)
+	res, err := nf.GetESDTData("addr", "tkn", 0, common.AccountQueryOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, expectedData, res)
 }
@@ -714,14 +812,14 @@ func TestNodeFacade_GetValueForKey(t *testing.T) {
 	expectedValue := "value"
 	arg := createMockArguments()
 	arg.Node = &mock.NodeStub{
-		GetValueForKeyCalled: func(_ string, _ string) (string, error) {
+		GetValueForKeyCalled: func(_ string, _ string, _ common.AccountQueryOptions) (string, error) {
 			return expectedValue, nil
 		},
 	}
 
 	nf, _ := NewNodeFacade(arg)
 
-	res, err := nf.GetValueForKey("addr", "key")
+	res, err := nf.GetValueForKey("addr", "key", common.AccountQueryOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, expectedValue, res)
 }
@@ -751,14 +849,14 @@ func TestNodeFacade_GetESDTsWithRole(t *testing.T) {
 	args := createMockArguments()
 
 	args.Node = &mock.NodeStub{
-		GetESDTsWithRoleCalled: func(address string, role string) ([]string, error) {
+		GetESDTsWithRoleCalled: func(address string, role string, _ common.AccountQueryOptions) ([]string, error) {
 			return expectedResponse, nil
 		},
 	}
 
 	nf, _ := NewNodeFacade(args)
 
-	res, err := nf.GetESDTsWithRole("address", "role")
+	res, err := nf.GetESDTsWithRole("address", "role", common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Equal(t, expectedResponse, res)
 }
@@ -770,14 +868,14 @@ func TestNodeFacade_GetNFTTokenIDsRegisteredByAddress(t *testing.T) {
 	args := createMockArguments()
 
 	args.Node = &mock.NodeStub{
-		GetNFTTokenIDsRegisteredByAddressCalled: func(address string) ([]string, error) {
+		GetNFTTokenIDsRegisteredByAddressCalled: func(address string, _ common.AccountQueryOptions) ([]string, error) {
 			return expectedResponse, nil
 		},
 	}
 
 	nf, _ := NewNodeFacade(args)
 
-	res, err := nf.GetNFTTokenIDsRegisteredByAddress("address")
+	res, err := nf.GetNFTTokenIDsRegisteredByAddress("address", common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Equal(t, expectedResponse, res)
 }
@@ -817,6 +915,104 @@ func TestNodeFacade_ValidateTransactionForSimulation(t *testing.T) {
 	assert.True(t, called)
 }
 
+func TestNodeFacade_SimulateTransactionWithOptions(t *testing.T) {
+	t.Parallel()
+
+	expectedResults := &txSimData.SimulationResults{Status: transaction.TxStatusSuccess}
+	expectedOptions := txSimData.SimulationOptions{WithStateChanges: true, WithTrace: true}
+	arg := createMockArguments()
+	arg.TxSimulatorProcessor = &mock.TxExecutionSimulatorStub{
+		ProcessTxWithOptionsCalled: func(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+			require.Equal(t, expectedOptions, options)
+			return expectedResults, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	results, err := nf.SimulateTransactionWithOptions(&transaction.Transaction{Nonce: 1}, expectedOptions)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResults, results)
+}
+
+func TestNodeFacade_TraceTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("replay data error should err", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		arg := createMockArguments()
+		arg.Node = &mock.NodeStub{
+			GetTransactionReplayDataCalled: func(hash string) (*txSimData.ReplayData, error) {
+				return nil, expectedErr
+			},
+		}
+		arg.TxSimulatorProcessor = &mock.TxExecutionSimulatorStub{
+			ReplayTxCalled: func(replayData *txSimData.ReplayData) (*txSimData.SimulationResults, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		nf, _ := NewNodeFacade(arg)
+
+		results, err := nf.TraceTransaction("aabb")
+		assert.Nil(t, results)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		replayData := &txSimData.ReplayData{RootHash: []byte("root"), Tx: &transaction.Transaction{Nonce: 1}}
+		arg := createMockArguments()
+		arg.Node = &mock.NodeStub{
+			GetTransactionReplayDataCalled: func(hash string) (*txSimData.ReplayData, error) {
+				require.Equal(t, "aabb", hash)
+				return replayData, nil
+			},
+		}
+		arg.TxSimulatorProcessor = &mock.TxExecutionSimulatorStub{
+			ReplayTxCalled: func(providedReplayData *txSimData.ReplayData) (*txSimData.SimulationResults, error) {
+				require.True(t, replayData == providedReplayData)
+				return &txSimData.SimulationResults{Status: transaction.TxStatusSuccess}, nil
+			},
+		}
+		nf, _ := NewNodeFacade(arg)
+
+		results, err := nf.TraceTransaction("aabb")
+		assert.Nil(t, err)
+		assert.Equal(t, transaction.TxStatusSuccess, results.Status)
+		assert.Equal(t, "aabb", results.Hash)
+	})
+}
+
+func TestNodeFacade_SimulateTransactionBundleExecution(t *testing.T) {
+	t.Parallel()
+
+	expectedResults := &txSimData.BundleSimulationResults{Status: transaction.TxStatusSuccess}
+	arg := createMockArguments()
+	arg.TxSimulatorProcessor = &mock.TxExecutionSimulatorStub{
+		ProcessTxBundleCalled: func(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+			require.Len(t, txs, 2)
+			return expectedResults, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	results, err := nf.SimulateTransactionBundleExecution([]*transaction.Transaction{{Nonce: 1}, {Nonce: 2}})
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResults, results)
+}
+
+func TestNodeFacade_GetMaxTxBundleSize(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.TxSimulatorConfig.MaxTxBundleSize = 37
+	nf, _ := NewNodeFacade(arg)
+
+	assert.Equal(t, uint32(37), nf.GetMaxTxBundleSize())
+}
+
 func TestNodeFacade_GetTotalStakedValue(t *testing.T) {
 	t.Parallel()
 
@@ -956,6 +1152,54 @@ func TestNodeFacade_GetProofDataTrie(t *testing.T) {
 	assert.Equal(t, expectedResponseDataTrie, dataTrieResponse)
 }
 
+func TestNodeFacade_GetStateStatisticsNilHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.Blockchain = &mock.ChainHandlerStub{
+		GetCurrentBlockHeaderCalled: func() nodeData.HeaderHandler {
+			return nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	stats, err := nf.GetStateStatistics("", 10)
+	assert.Nil(t, stats)
+	assert.Equal(t, ErrNilBlockHeader, err)
+}
+
+func TestNodeFacade_GetStateStatistics(t *testing.T) {
+	t.Parallel()
+
+	currentRootHash := []byte("current root hash")
+	expectedStats := &common.StateStatistics{NumAccounts: 37}
+	arg := createMockArguments()
+	arg.Blockchain = &mock.ChainHandlerStub{
+		GetCurrentBlockHeaderCalled: func() nodeData.HeaderHandler {
+			return &block.Header{RootHash: currentRootHash}
+		},
+	}
+	requestedRootHashes := make([]string, 0)
+	arg.Node = &mock.NodeStub{
+		GetStateStatisticsCalled: func(rootHash string, numLargestDataTries int) (*common.StateStatistics, error) {
+			assert.Equal(t, 10, numLargestDataTries)
+			requestedRootHashes = append(requestedRootHashes, rootHash)
+			return expectedStats, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	stats, err := nf.GetStateStatistics("0102", 10)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedStats, stats)
+
+	stats, err = nf.GetStateStatistics("", 10)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedStats, stats)
+
+	assert.Equal(t, []string{"0102", hex.EncodeToString(currentRootHash)}, requestedRootHashes)
+}
+
 func TestNodeFacade_VerifyProof(t *testing.T) {
 	t.Parallel()
 
@@ -972,6 +1216,82 @@ func TestNodeFacade_VerifyProof(t *testing.T) {
 	assert.True(t, response)
 }
 
+func TestNodeFacade_GetProofDataTrieMultiKey(t *testing.T) {
+	t.Parallel()
+
+	expectedResponseMainTrie := &common.GetProofResponse{RootHash: "rootHash"}
+	expectedResponseDataTrie := &common.GetMultiKeyProofResponse{RootHash: "dataTrieRootHash"}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetProofDataTrieMultiKeyCalled: func(_ string, _ string, keys []string) (*common.GetProofResponse, *common.GetMultiKeyProofResponse, error) {
+			assert.Equal(t, []string{"key1", "key2"}, keys)
+			return expectedResponseMainTrie, expectedResponseDataTrie, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	mainTrieResponse, dataTrieResponse, err := nf.GetProofDataTrieMultiKey("hash", "addr", []string{"key1", "key2"})
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponseMainTrie, mainTrieResponse)
+	assert.Equal(t, expectedResponseDataTrie, dataTrieResponse)
+}
+
+func TestNodeFacade_GetProofDataTrieRange(t *testing.T) {
+	t.Parallel()
+
+	expectedResponseMainTrie := &common.GetProofResponse{RootHash: "rootHash"}
+	expectedResponseDataTrie := &common.GetRangeProofResponse{RootHash: "dataTrieRootHash", IsTruncated: true}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetProofDataTrieRangeCalled: func(_ string, _ string, startKey string, endKey string, maxNumKeys int) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+			assert.Equal(t, "start", startKey)
+			assert.Equal(t, "end", endKey)
+			assert.Equal(t, 10, maxNumKeys)
+			return expectedResponseMainTrie, expectedResponseDataTrie, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	mainTrieResponse, dataTrieResponse, err := nf.GetProofDataTrieRange("hash", "addr", "start", "end", 10)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponseMainTrie, mainTrieResponse)
+	assert.Equal(t, expectedResponseDataTrie, dataTrieResponse)
+}
+
+func TestNodeFacade_VerifyProofDataTrieMultiKey(t *testing.T) {
+	t.Parallel()
+
+	expectedValues := []core.KeyValueHolder{keyValStorage.NewKeyValStorage([]byte("key"), []byte("value"))}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		VerifyProofDataTrieMultiKeyCalled: func(_ string, _ string, _ []string, _ [][]byte) ([]core.KeyValueHolder, error) {
+			return expectedValues, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	provenValues, err := nf.VerifyProofDataTrieMultiKey("hash", "addr", []string{"key"}, [][]byte{[]byte("proof")})
+	assert.Nil(t, err)
+	assert.Equal(t, expectedValues, provenValues)
+}
+
+func TestNodeFacade_VerifyProofDataTrieRange(t *testing.T) {
+	t.Parallel()
+
+	expectedValues := []core.KeyValueHolder{keyValStorage.NewKeyValStorage([]byte("key"), []byte("value"))}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		VerifyProofDataTrieRangeCalled: func(_ string, _ string, _ string, _ string, _ [][]byte) ([]core.KeyValueHolder, error) {
+			return expectedValues, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	provenValues, err := nf.VerifyProofDataTrieRange("hash", "addr", "", "", [][]byte{[]byte("proof")})
+	assert.Nil(t, err)
+	assert.Equal(t, expectedValues, provenValues)
+}
+
 func TestNodeFacade_ExecuteSCQuery(t *testing.T) {
 	t.Parallel()
 
@@ -1032,3 +1352,150 @@ func TestNodeFacade_GetBlockByRoundShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, ret, blk)
 }
+
+func TestNodeFacade_GetAddressTransactionsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	expectedResponse := &common.AddressTransactionsResponse{
+		Transactions: []*common.AddressTransaction{{TxHash: "txHash"}},
+		Total:        1,
+	}
+
+	arg.Node = &mock.NodeStub{
+		GetAddressTransactionsCalled: func(address string, from uint64, size uint64) (*common.AddressTransactionsResponse, error) {
+			assert.Equal(t, "address", address)
+			assert.Equal(t, uint64(1), from)
+			assert.Equal(t, uint64(2), size)
+			return expectedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	response, err := nf.GetAddressTransactions("address", 1, 2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
+
+func TestNodeFacade_GetTransactionsPoolShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	options := common.TxPoolQueryOptions{Sender: "address", Size: 10}
+	expectedResponse := &common.TxPoolTransactionsResponse{
+		Transactions: []*common.TxPoolTransaction{{TxHash: "txHash"}},
+		Total:        1,
+	}
+
+	arg.Node = &mock.NodeStub{
+		GetTransactionsPoolCalled: func(opts common.TxPoolQueryOptions) (*common.TxPoolTransactionsResponse, error) {
+			assert.Equal(t, options, opts)
+			return expectedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	response, err := nf.GetTransactionsPool(options)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
+
+func TestNodeFacade_GetTransactionsPoolForSenderShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	expectedResponse := &common.TxPoolSenderResponse{
+		Sender:    "address",
+		NonceGaps: []*common.TxPoolNonceGap{{From: 1, To: 2}},
+	}
+
+	arg.Node = &mock.NodeStub{
+		GetTransactionsPoolForSenderCalled: func(address string) (*common.TxPoolSenderResponse, error) {
+			assert.Equal(t, "address", address)
+			return expectedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	response, err := nf.GetTransactionsPoolForSender("address")
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
+
+func TestNodeFacade_GetTransactionsPoolStatisticsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	expectedResponse := &common.TxPoolStatisticsResponse{
+		NumTxs: 3,
+	}
+
+	arg.Node = &mock.NodeStub{
+		GetTransactionsPoolStatisticsCalled: func() (*common.TxPoolStatisticsResponse, error) {
+			return expectedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	response, err := nf.GetTransactionsPoolStatistics()
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
+
+func TestNodeFacade_GetEvictedTransactionFromPoolShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	expectedResponse := &common.TxPoolEvictedTransaction{
+		TxHash: "aabb",
+		Reason: "swept",
+	}
+
+	arg.Node = &mock.NodeStub{
+		GetEvictedTransactionFromPoolCalled: func(txHash string) (*common.TxPoolEvictedTransaction, error) {
+			assert.Equal(t, "aabb", txHash)
+			return expectedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	response, err := nf.GetEvictedTransactionFromPool("aabb")
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
+
+func TestNodeFacade_GetLogsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	query := &common.LogsQuery{
+		Address:   "address",
+		FromNonce: 1,
+	}
+	expectedLogs := &common.LogsResponse{
+		Logs: []*common.ApiLogEvent{
+			{
+				TxHash:     "txHash",
+				Identifier: "transfer",
+			},
+		},
+	}
+
+	arg.Node = &mock.NodeStub{
+		GetLogsCalled: func(q *common.LogsQuery) (*common.LogsResponse, error) {
+			assert.Equal(t, query, q)
+			return expectedLogs, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	logs, err := nf.GetLogs(query)
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedLogs, logs)
+}
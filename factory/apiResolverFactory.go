@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/forking"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -20,11 +21,16 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
+	factoryState "github.com/ElrondNetwork/elrond-go/state/factory"
+	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager/disabled"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	trieFactory "github.com/ElrondNetwork/elrond-go/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	vmcommonBuiltInFunctions "github.com/ElrondNetwork/elrond-vm-common/builtInFunctions"
@@ -225,14 +231,27 @@ func createScQueryElement(
 	args *scQueryElementArgs,
 ) (process.SCQueryService, error) {
 	var vmFactory process.VirtualMachinesContainerFactory
+	var arwenVersionProvider process.ArwenVersionProvider
+	var validatorAccountsAdapter state.AccountsAdapter
 	var err error
 
+	// each query element works on its own accounts adapter, epoch notifier and Arwen change locker so that the state
+	// and the Arwen version can be moved to a past block without affecting the block processing or the other query
+	// elements
+	accountsAdapter, err := createAccountsAdapterForQueries(args)
+	if err != nil {
+		return nil, err
+	}
+	epochNotifier := forking.NewGenericEpochNotifier()
+	epochNotifier.CheckEpoch(args.dataComponents.Blockchain().GetCurrentBlockHeader())
+	arwenChangeLocker := &sync.RWMutex{}
+
 	builtInFuncs, err := createBuiltinFuncs(
 		args.gasScheduleNotifier,
 		args.coreComponents.InternalMarshalizer(),
-		args.stateComponents.AccountsAdapter(),
+		accountsAdapter,
 		args.processComponents.ShardCoordinator(),
-		args.coreComponents.EpochNotifier(),
+		epochNotifier,
 		args.epochConfig.EnableEpochs.ESDTMultiTransferEnableEpoch,
 		args.epochConfig.EnableEpochs.GlobalMintBurnDisableEpoch,
 		args.epochConfig.EnableEpochs.ESDTTransferRoleEnableEpoch,
@@ -252,7 +271,7 @@ func createScQueryElement(
 	scStorage := args.generalConfig.SmartContractsStorageForSCQuery
 	scStorage.DB.FilePath += fmt.Sprintf("%d", args.index)
 	argsHook := hooks.ArgBlockChainHook{
-		Accounts:           args.stateComponents.AccountsAdapter(),
+		Accounts:           accountsAdapter,
 		PubkeyConv:         args.coreComponents.AddressPubKeyConverter(),
 		StorageService:     args.dataComponents.StorageService(),
 		BlockChain:         args.dataComponents.Blockchain(),
@@ -265,9 +284,15 @@ func createScQueryElement(
 		CompiledSCPool:     smartContractsCache,
 		WorkingDir:         args.workingDir,
 		NilCompiledSCStore: true,
+		ExecutionTracer:    tracing.NewDisabledExecutionTracer(),
 	}
 
 	if args.processComponents.ShardCoordinator().SelfId() == core.MetachainShardId {
+		validatorAccountsAdapter, err = createPeerAccountsAdapterForQueries(args)
+		if err != nil {
+			return nil, err
+		}
+
 		argsNewVmFactory := metachain.ArgsNewVMContainerFactory{
 			ArgBlockChainHook:   argsHook,
 			Economics:           args.coreComponents.EconomicsData(),
@@ -277,16 +302,18 @@ func createScQueryElement(
 			Hasher:              args.coreComponents.Hasher(),
 			Marshalizer:         args.coreComponents.InternalMarshalizer(),
 			SystemSCConfig:      args.systemSCConfig,
-			ValidatorAccountsDB: args.stateComponents.PeerAccounts(),
+			ValidatorAccountsDB: validatorAccountsAdapter,
 			ChanceComputer:      args.coreComponents.Rater(),
-			EpochNotifier:       args.coreComponents.EpochNotifier(),
+			EpochNotifier:       epochNotifier,
 			EpochConfig:         args.epochConfig,
 			ShardCoordinator:    args.processComponents.ShardCoordinator(),
 		}
-		vmFactory, err = metachain.NewVMContainerFactory(argsNewVmFactory)
-		if err != nil {
-			return nil, err
+		metaVmFactory, errCreate := metachain.NewVMContainerFactory(argsNewVmFactory)
+		if errCreate != nil {
+			return nil, errCreate
 		}
+		vmFactory = metaVmFactory
+		arwenVersionProvider = metaVmFactory
 	} else {
 		queryVirtualMachineConfig := args.generalConfig.VirtualMachine.Querying.VirtualMachineConfig
 		esdtTransferParser, errParser := parsers.NewESDTTransferParser(args.coreComponents.InternalMarshalizer())
@@ -298,9 +325,9 @@ func createScQueryElement(
 			BlockGasLimit:      args.coreComponents.EconomicsData().MaxGasLimitPerBlock(args.processComponents.ShardCoordinator().SelfId()),
 			GasSchedule:        args.gasScheduleNotifier,
 			ArgBlockChainHook:  argsHook,
-			EpochNotifier:      args.coreComponents.EpochNotifier(),
+			EpochNotifier:      epochNotifier,
 			EpochConfig:        args.epochConfig.EnableEpochs,
-			ArwenChangeLocker:  args.coreComponents.ArwenChangeLocker(),
+			ArwenChangeLocker:  arwenChangeLocker,
 			ESDTTransferParser: esdtTransferParser,
 		}
 
@@ -308,10 +335,12 @@ func createScQueryElement(
 		log.Debug("apiResolver: enable epoch for ahead of time gas usage", "epoch", args.epochConfig.EnableEpochs.AheadOfTimeGasUsageEnableEpoch)
 		log.Debug("apiResolver: enable epoch for repair callback", "epoch", args.epochConfig.EnableEpochs.RepairCallbackEnableEpoch)
 
-		vmFactory, err = shard.NewVMContainerFactory(argsNewVMFactory)
-		if err != nil {
-			return nil, err
+		shardVmFactory, errCreate := shard.NewVMContainerFactory(argsNewVMFactory)
+		if errCreate != nil {
+			return nil, errCreate
 		}
+		vmFactory = shardVmFactory
+		arwenVersionProvider = shardVmFactory
 	}
 
 	vmContainer, err := vmFactory.Create()
@@ -325,17 +354,59 @@ func createScQueryElement(
 	}
 
 	argsNewSCQueryService := smartContract.ArgsNewSCQueryService{
-		VmContainer:       vmContainer,
-		EconomicsFee:      args.coreComponents.EconomicsData(),
-		BlockChainHook:    vmFactory.BlockChainHookImpl(),
-		BlockChain:        args.dataComponents.Blockchain(),
-		ArwenChangeLocker: args.coreComponents.ArwenChangeLocker(),
+		VmContainer:              vmContainer,
+		EconomicsFee:             args.coreComponents.EconomicsData(),
+		BlockChainHook:           vmFactory.BlockChainHookImpl(),
+		BlockChain:               args.dataComponents.Blockchain(),
+		ArwenChangeLocker:        arwenChangeLocker,
+		AccountsAdapter:          accountsAdapter,
+		EpochNotifier:            epochNotifier,
+		StorageService:           args.dataComponents.StorageService(),
+		Marshalizer:              args.coreComponents.InternalMarshalizer(),
+		Uint64ByteSliceConverter: args.coreComponents.Uint64ByteSliceConverter(),
+		ShardCoordinator:         args.processComponents.ShardCoordinator(),
+		ArwenVersionProvider:     arwenVersionProvider,
+		ValidatorAccountsAdapter: validatorAccountsAdapter,
 	}
 	scQueryService, err := smartContract.NewSCQueryService(argsNewSCQueryService)
 
 	return scQueryService, err
 }
 
+// createAccountsAdapterForQueries creates an accounts adapter working on its own trie instance, which only shares the
+// storage with the trie used by the block processing. The query service moves it to the root hash of the queried block
+func createAccountsAdapterForQueries(args *scQueryElementArgs) (state.AccountsAdapter, error) {
+	merkleTrie := args.stateComponents.TriesContainer().Get([]byte(trieFactory.UserAccountTrie))
+	queriesTrie, err := merkleTrie.Recreate(trie.EmptyTrieHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.NewAccountsDB(
+		queriesTrie,
+		args.coreComponents.Hasher(),
+		args.coreComponents.InternalMarshalizer(),
+		factoryState.NewAccountCreator(),
+		disabled.NewDisabledStoragePruningManager(),
+	)
+}
+
+func createPeerAccountsAdapterForQueries(args *scQueryElementArgs) (state.AccountsAdapter, error) {
+	merkleTrie := args.stateComponents.TriesContainer().Get([]byte(trieFactory.PeerAccountTrie))
+	queriesTrie, err := merkleTrie.Recreate(trie.EmptyTrieHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.NewPeerAccountsDB(
+		queriesTrie,
+		args.coreComponents.Hasher(),
+		args.coreComponents.InternalMarshalizer(),
+		factoryState.NewPeerAccountCreator(),
+		disabled.NewDisabledStoragePruningManager(),
+	)
+}
+
 func createBuiltinFuncs(
 	gasScheduleNotifier core.GasScheduleNotifier,
 	marshalizer marshal.Marshalizer,
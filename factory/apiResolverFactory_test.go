@@ -0,0 +1,81 @@
+package factory_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	arwenConfig "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/factory"
+	"github.com/ElrondNetwork/elrond-go/factory/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	processFactory "github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateScQueryElement_HistoricalQueryShouldNotTakeTheProcessingArwenChangeLocker(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	coreComponents := getCoreComponents()
+	networkComponents := getNetworkComponents()
+	dataComponents := getDataComponents(coreComponents, shardCoordinator)
+	cryptoComponents := getCryptoComponents(coreComponents)
+	stateComponents := getStateComponents(coreComponents, shardCoordinator)
+	processComponents := getProcessComponents(
+		shardCoordinator,
+		coreComponents,
+		networkComponents,
+		dataComponents,
+		cryptoComponents,
+		stateComponents,
+	)
+
+	generalConfig := testscommon.GetGeneralConfig()
+	scQueryService, err := factory.CreateScQueryElement(
+		&generalConfig,
+		&config.EpochConfig{},
+		coreComponents,
+		stateComponents,
+		dataComponents,
+		processComponents,
+		&mock.GasScheduleNotifierMock{GasSchedule: arwenConfig.MakeGasMapForTests()},
+		t.TempDir(),
+	)
+	require.Nil(t, err)
+
+	// the block processing holds the Arwen change locker, for example while replacing the Arwen instance
+	coreComponents.ArwenChangeLocker().Lock()
+	defer coreComponents.ArwenChangeLocker().Unlock()
+
+	scAddress := make([]byte, 32)
+	copy(scAddress[core.NumInitCharactersForScAddress-core.VMTypeLen:], processFactory.ArwenVirtualMachine)
+
+	chErr := make(chan error, 1)
+	go func() {
+		vmOutput, errQuery := scQueryService.ExecuteQuery(&process.SCQuery{
+			ScAddress:     scAddress,
+			FuncName:      "function",
+			BlockNonce:    0,
+			HasBlockNonce: true,
+		})
+		if errQuery == nil && vmOutput.ReturnCode == vmcommon.Ok {
+			errQuery = errors.New("the query of a missing contract should have failed")
+		}
+		if errQuery == nil {
+			errQuery = errors.New(vmOutput.ReturnMessage)
+		}
+		chErr <- errQuery
+	}()
+
+	select {
+	case errQuery := <-chErr:
+		require.NotContains(t, errQuery.Error(), "vm container")
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "the historical query waited for the Arwen change locker of the block processing")
+	}
+}
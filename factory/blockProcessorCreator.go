@@ -27,6 +27,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	"github.com/ElrondNetwork/elrond-go/process/throttle"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
@@ -113,7 +114,14 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 	log.Debug("blockProcessorCreator: enable epoch for ahead of time gas usage", "epoch", pcf.epochConfig.EnableEpochs.AheadOfTimeGasUsageEnableEpoch)
 	log.Debug("blockProcessorCreator: enable epoch for repair callback", "epoch", pcf.epochConfig.EnableEpochs.RepairCallbackEnableEpoch)
 
-	vmFactory, err := pcf.createVMFactoryShard(pcf.state.AccountsAdapter(), builtInFuncs, esdtTransferParser, arwenChangeLocker, pcf.config.SmartContractsStorage)
+	vmFactory, err := pcf.createVMFactoryShard(
+		pcf.state.AccountsAdapter(),
+		builtInFuncs,
+		esdtTransferParser,
+		arwenChangeLocker,
+		pcf.config.SmartContractsStorage,
+		tracing.NewDisabledExecutionTracer(),
+	)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -214,6 +222,7 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		VMOutputCacher:      txcache.NewDisabledCache(),
 		ArwenChangeLocker:   arwenChangeLocker,
 		EnableEpochs:        enableEpochs,
+		ExecutionTracer:     tracing.NewDisabledExecutionTracer(),
 	}
 	scProcessor, err := smartContract.NewSmartContractProcessor(argsNewScProcessor)
 	if err != nil {
@@ -394,7 +403,7 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 
 	argsParser := smartContract.NewArgumentParser()
 
-	vmFactory, err := pcf.createVMFactoryMeta(pcf.state.AccountsAdapter(), builtInFuncs, pcf.config.SmartContractsStorage)
+	vmFactory, err := pcf.createVMFactoryMeta(pcf.state.AccountsAdapter(), builtInFuncs, pcf.config.SmartContractsStorage, tracing.NewDisabledExecutionTracer())
 	if err != nil {
 		return nil, nil, err
 	}
@@ -489,6 +498,7 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		VMOutputCacher:      txcache.NewDisabledCache(),
 		ArwenChangeLocker:   arwenChangeLocker,
 		EnableEpochs:        enableEpochs,
+		ExecutionTracer:     tracing.NewDisabledExecutionTracer(),
 	}
 	scProcessor, err := smartContract.NewSmartContractProcessor(argsNewScProcessor)
 	if err != nil {
@@ -781,7 +791,11 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 	arwenChangeLocker common.Locker,
 	mapDNSAddresses map[string]struct{},
 ) (process.VirtualMachinesContainerFactory, error) {
-	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(pcf.state.AccountsAdapter())
+	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(
+		pcf.state.AccountsAdapter(),
+		pcf.coreData.InternalMarshalizer(),
+		pcf.coreData.Hasher(),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -804,8 +818,20 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 		return nil, err
 	}
 
+	executionTracer, err := tracing.NewExecutionTracer(pcf.coreData.AddressPubKeyConverter())
+	if err != nil {
+		return nil, err
+	}
+
 	smartContractStorageSimulate := pcf.config.SmartContractsStorageSimulate
-	vmFactory, err := pcf.createVMFactoryShard(readOnlyAccountsDB, builtInFuncs, esdtTransferParser, arwenChangeLocker, smartContractStorageSimulate)
+	vmFactory, err := pcf.createVMFactoryShard(
+		readOnlyAccountsDB,
+		builtInFuncs,
+		esdtTransferParser,
+		arwenChangeLocker,
+		smartContractStorageSimulate,
+		executionTracer,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -847,6 +873,7 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 
 	scProcArgs.AccountsDB = readOnlyAccountsDB
 	scProcArgs.VMOutputCacher = txSimulatorProcessorArgs.VMOutputCacher
+	scProcArgs.ExecutionTracer = executionTracer
 	scProcessor, err := smartContract.NewSmartContractProcessor(scProcArgs)
 	if err != nil {
 		return nil, err
@@ -861,6 +888,8 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 	}
 
 	txSimulatorProcessorArgs.IntermediateProcContainer = interimProcContainer
+	txSimulatorProcessorArgs.AccountsOverlay = readOnlyAccountsDB
+	txSimulatorProcessorArgs.ExecutionTracer = executionTracer
 
 	return vmFactory, nil
 }
@@ -905,7 +934,11 @@ func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 
 	scProcArgs.VMOutputCacher = txSimulatorProcessorArgs.VMOutputCacher
 
-	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(pcf.state.AccountsAdapter())
+	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(
+		pcf.state.AccountsAdapter(),
+		pcf.coreData.InternalMarshalizer(),
+		pcf.coreData.Hasher(),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -915,7 +948,12 @@ func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 		return nil, err
 	}
 
-	vmFactory, err := pcf.createVMFactoryMeta(readOnlyAccountsDB, builtInFuncs, pcf.config.SmartContractsStorageSimulate)
+	executionTracer, err := tracing.NewExecutionTracer(pcf.coreData.AddressPubKeyConverter())
+	if err != nil {
+		return nil, err
+	}
+
+	vmFactory, err := pcf.createVMFactoryMeta(readOnlyAccountsDB, builtInFuncs, pcf.config.SmartContractsStorageSimulate, executionTracer)
 	if err != nil {
 		return nil, err
 	}
@@ -927,6 +965,7 @@ func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 
 	scProcArgs.VmContainer = vmContainer
 	scProcArgs.BlockChainHook = vmFactory.BlockChainHookImpl()
+	scProcArgs.ExecutionTracer = executionTracer
 
 	scProcessor, err := smartContract.NewSmartContractProcessor(scProcArgs)
 	if err != nil {
@@ -953,6 +992,8 @@ func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 	}
 
 	txSimulatorProcessorArgs.IntermediateProcContainer = interimProcContainer
+	txSimulatorProcessorArgs.AccountsOverlay = readOnlyAccountsDB
+	txSimulatorProcessorArgs.ExecutionTracer = executionTracer
 
 	return vmFactory, nil
 }
@@ -963,6 +1004,7 @@ func (pcf *processComponentsFactory) createVMFactoryShard(
 	esdtTransferParser vmcommon.ESDTTransferParser,
 	arwenChangeLocker common.Locker,
 	configSCStorage config.StorageConfig,
+	executionTracer process.ExecutionTracer,
 ) (process.VirtualMachinesContainerFactory, error) {
 	argsHook := hooks.ArgBlockChainHook{
 		Accounts:           accounts,
@@ -978,6 +1020,7 @@ func (pcf *processComponentsFactory) createVMFactoryShard(
 		WorkingDir:         pcf.workingDir,
 		NilCompiledSCStore: false,
 		ConfigSCStorage:    configSCStorage,
+		ExecutionTracer:    executionTracer,
 	}
 
 	argsNewVMFactory := shard.ArgVMContainerFactory{
@@ -998,6 +1041,7 @@ func (pcf *processComponentsFactory) createVMFactoryMeta(
 	accounts state.AccountsAdapter,
 	builtInFuncs vmcommon.BuiltInFunctionContainer,
 	configSCStorage config.StorageConfig,
+	executionTracer process.ExecutionTracer,
 ) (process.VirtualMachinesContainerFactory, error) {
 	argsHook := hooks.ArgBlockChainHook{
 		Accounts:           accounts,
@@ -1013,6 +1057,7 @@ func (pcf *processComponentsFactory) createVMFactoryMeta(
 		ConfigSCStorage:    configSCStorage,
 		WorkingDir:         pcf.workingDir,
 		NilCompiledSCStore: false,
+		ExecutionTracer:    executionTracer,
 	}
 
 	argsNewVMContainer := metachain.ArgsNewVMContainerFactory{
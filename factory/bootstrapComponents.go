@@ -23,25 +23,27 @@ import (
 
 // BootstrapComponentsFactoryArgs holds the arguments needed to create a botstrap components factory
 type BootstrapComponentsFactoryArgs struct {
-	Config            config.Config
-	EpochConfig       config.EpochConfig
-	PrefConfig        config.Preferences
-	ImportDbConfig    config.ImportDbConfig
-	WorkingDir        string
-	CoreComponents    CoreComponentsHolder
-	CryptoComponents  CryptoComponentsHolder
-	NetworkComponents NetworkComponentsHolder
+	Config                 config.Config
+	EpochConfig            config.EpochConfig
+	PrefConfig             config.Preferences
+	ImportDbConfig         config.ImportDbConfig
+	ImportSnapshotFilePath string
+	WorkingDir             string
+	CoreComponents         CoreComponentsHolder
+	CryptoComponents       CryptoComponentsHolder
+	NetworkComponents      NetworkComponentsHolder
 }
 
 type bootstrapComponentsFactory struct {
-	config            config.Config
-	epochConfig       config.EpochConfig
-	prefConfig        config.Preferences
-	importDbConfig    config.ImportDbConfig
-	workingDir        string
-	coreComponents    CoreComponentsHolder
-	cryptoComponents  CryptoComponentsHolder
-	networkComponents NetworkComponentsHolder
+	config                 config.Config
+	epochConfig            config.EpochConfig
+	prefConfig             config.Preferences
+	importDbConfig         config.ImportDbConfig
+	importSnapshotFilePath string
+	workingDir             string
+	coreComponents         CoreComponentsHolder
+	cryptoComponents       CryptoComponentsHolder
+	networkComponents      NetworkComponentsHolder
 }
 
 type bootstrapComponents struct {
@@ -57,6 +59,9 @@ func NewBootstrapComponentsFactory(args BootstrapComponentsFactoryArgs) (*bootst
 	if check.IfNil(args.CoreComponents) {
 		return nil, errors.ErrNilCoreComponentsHolder
 	}
+	if check.IfNil(args.CoreComponents.MetricsHandler()) {
+		return nil, errors.ErrNilMetricsHandler
+	}
 	if check.IfNil(args.CryptoComponents) {
 		return nil, errors.ErrNilCryptoComponentsHolder
 	}
@@ -68,14 +73,15 @@ func NewBootstrapComponentsFactory(args BootstrapComponentsFactoryArgs) (*bootst
 	}
 
 	return &bootstrapComponentsFactory{
-		config:            args.Config,
-		epochConfig:       args.EpochConfig,
-		prefConfig:        args.PrefConfig,
-		importDbConfig:    args.ImportDbConfig,
-		workingDir:        args.WorkingDir,
-		coreComponents:    args.CoreComponents,
-		cryptoComponents:  args.CryptoComponents,
-		networkComponents: args.NetworkComponents,
+		config:                 args.Config,
+		epochConfig:            args.EpochConfig,
+		prefConfig:             args.PrefConfig,
+		importDbConfig:         args.ImportDbConfig,
+		importSnapshotFilePath: args.ImportSnapshotFilePath,
+		workingDir:             args.WorkingDir,
+		coreComponents:         args.CoreComponents,
+		cryptoComponents:       args.CryptoComponents,
+		networkComponents:      args.NetworkComponents,
 	}, nil
 }
 
@@ -109,6 +115,7 @@ func (bcf *bootstrapComponentsFactory) Create() (*bootstrapComponents, error) {
 	if err != nil {
 		return nil, err
 	}
+	bcf.coreComponents.MetricsHandler().SetShardID(genesisShardCoordinator.SelfId())
 
 	bootstrapDataProvider, err := storageFactory.NewBootstrapDataProvider(bcf.coreComponents.InternalMarshalizer())
 	if err != nil {
@@ -161,6 +168,7 @@ func (bcf *bootstrapComponentsFactory) Create() (*bootstrapComponents, error) {
 		ArgumentsParser:            smartContract.NewArgumentParser(),
 		StatusHandler:              bcf.coreComponents.StatusHandler(),
 		HeaderIntegrityVerifier:    headerIntegrityVerifier,
+		ImportSnapshotFilePath:     bcf.importSnapshotFilePath,
 	}
 
 	var epochStartBootstrapper EpochStartBootstrapper
@@ -200,6 +208,7 @@ func (bcf *bootstrapComponentsFactory) Create() (*bootstrapComponents, error) {
 	if err != nil {
 		return nil, err
 	}
+	bcf.coreComponents.MetricsHandler().SetShardID(shardCoordinator.SelfId())
 
 	return &bootstrapComponents{
 		epochStartBootstrapper: epochStartBootstrapper,
@@ -38,6 +38,20 @@ func TestNewBootstrapComponentsFactory_NilCoreComponents(t *testing.T) {
 	require.Equal(t, errorsErd.ErrNilCoreComponentsHolder, err)
 }
 
+func TestNewBootstrapComponentsFactory_NilMetricsHandler(t *testing.T) {
+	t.Parallel()
+
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.MetricsHandlerField = nil
+	args := getBootStrapArgs()
+	args.CoreComponents = coreComponents
+
+	bcf, err := factory.NewBootstrapComponentsFactory(args)
+
+	require.Nil(t, bcf)
+	require.Equal(t, errorsErd.ErrNilMetricsHandler, err)
+}
+
 func TestNewBootstrapComponentsFactory_NilCryptoComponents(t *testing.T) {
 	t.Parallel()
 
@@ -163,9 +177,10 @@ func getDefaultCoreComponents() *mock.CoreComponentsMock {
 		RoundHandlerField: &testscommon.RoundHandlerMock{},
 		//TODO: uncomment this
 		//EconomicsHandler: &testscommon.EconomicsHandlerMock{},
-		RatingsConfig: &testscommon.RatingsInfoMock{},
-		RatingHandler: &testscommon.RaterMock{},
-		NodesConfig:   &testscommon.NodesSetupStub{},
-		StartTime:     time.Time{},
+		RatingsConfig:       &testscommon.RatingsInfoMock{},
+		RatingHandler:       &testscommon.RaterMock{},
+		NodesConfig:         &testscommon.NodesSetupStub{},
+		StartTime:           time.Time{},
+		MetricsHandlerField: &statusHandler.MetricsHandlerStub{},
 	}
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/sync/storageBootstrap"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state/syncer"
+	"github.com/ElrondNetwork/elrond-go/trie"
 	"github.com/ElrondNetwork/elrond-go/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/update"
 )
@@ -49,6 +50,8 @@ type consensusComponentsFactory struct {
 	stateComponents     StateComponentsHolder
 	statusComponents    StatusComponentsHolder
 	isInImportMode      bool
+
+	trieSyncRequestsBudget trie.SyncRequestsBudget
 }
 
 type consensusComponents struct {
@@ -237,6 +240,7 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		HeaderSigVerifier:             ccf.processComponents.HeaderSigVerifier(),
 		FallbackHeaderValidator:       ccf.processComponents.FallbackHeaderValidator(),
 		NodeRedundancyHandler:         ccf.processComponents.NodeRedundancyHandler(),
+		MetricsHandler:                ccf.coreComponents.MetricsHandler(),
 	}
 
 	consensusDataContainer, err := spos.NewConsensusCore(
@@ -379,6 +383,13 @@ func (ccf *consensusComponentsFactory) createBootstrapper() (process.Bootstrappe
 		return nil, errors.ErrNilShardCoordinator
 	}
 
+	// all the accounts syncers share the same requests budget so the in-flight trie nodes are bounded globally
+	var err error
+	ccf.trieSyncRequestsBudget, err = factory.CreateSyncRequestsBudget(ccf.config.TrieSync)
+	if err != nil {
+		return nil, err
+	}
+
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
 		return ccf.createShardBootstrapper()
 	}
@@ -472,6 +483,8 @@ func (ccf *consensusComponentsFactory) createArgsBaseAccountsSyncer(trieStorageM
 		MaxTrieLevelInMemory:      ccf.config.StateTriesConfig.MaxStateTrieLevelInMemory,
 		MaxHardCapForMissingNodes: ccf.config.TrieSync.MaxHardCapForMissingNodes,
 		TrieSyncerVersion:         ccf.config.TrieSync.TrieSyncerVersion,
+		RequestsBudget:            ccf.trieSyncRequestsBudget,
+		MetricsHandler:            ccf.coreComponents.MetricsHandler(),
 	}
 }
 
@@ -112,6 +112,9 @@ func (mcc *managedCoreComponents) CheckSubcomponents() error {
 	if check.IfNil(mcc.statusHandlersUtils) {
 		return errors.ErrNilStatusHandler
 	}
+	if check.IfNil(mcc.statusHandlersUtils.MetricsHandler()) {
+		return errors.ErrNilMetricsHandler
+	}
 	if check.IfNil(mcc.pathHandler) {
 		return errors.ErrNilPathHandler
 	}
@@ -286,6 +289,18 @@ func (mcc *managedCoreComponents) StatusHandler() core.AppStatusHandler {
 	return mcc.coreComponents.statusHandlersUtils.StatusHandler()
 }
 
+// MetricsHandler returns the handler of the typed metrics
+func (mcc *managedCoreComponents) MetricsHandler() common.MetricsHandler {
+	mcc.mutCoreComponents.RLock()
+	defer mcc.mutCoreComponents.RUnlock()
+
+	if mcc.coreComponents == nil {
+		return nil
+	}
+
+	return mcc.coreComponents.statusHandlersUtils.MetricsHandler()
+}
+
 // PathHandler returns the core components path handler
 func (mcc *managedCoreComponents) PathHandler() storage.PathManagerHandler {
 	mcc.mutCoreComponents.RLock()
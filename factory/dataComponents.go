@@ -144,6 +144,7 @@ func (dcf *dataComponentsFactory) createDataStoreFromConfig() (dataRetriever.Sto
 		dcf.core.PathHandler(),
 		dcf.epochStartNotifier,
 		dcf.core.NodeTypeProvider(),
+		dcf.core.MetricsHandler(),
 		dcf.currentEpoch,
 		dcf.createTrieEpochRootHashStorer,
 	)
@@ -1,9 +1,11 @@
 package factory
 
 import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
@@ -88,3 +90,27 @@ func SetShardCoordinator(shardCoordinator sharding.Coordinator, holder Bootstrap
 
 	mbf.bootstrapComponents.shardCoordinator = shardCoordinator
 }
+
+// CreateScQueryElement -
+func CreateScQueryElement(
+	generalConfig *config.Config,
+	epochConfig *config.EpochConfig,
+	coreComponents CoreComponentsHolder,
+	stateComponents StateComponentsHolder,
+	dataComponents DataComponentsHolder,
+	processComponents ProcessComponentsHolder,
+	gasScheduleNotifier core.GasScheduleNotifier,
+	workingDir string,
+) (process.SCQueryService, error) {
+	return createScQueryElement(&scQueryElementArgs{
+		generalConfig:       generalConfig,
+		epochConfig:         epochConfig,
+		coreComponents:      coreComponents,
+		stateComponents:     stateComponents,
+		dataComponents:      dataComponents,
+		processComponents:   processComponents,
+		gasScheduleNotifier: gasScheduleNotifier,
+		systemSCConfig:      &config.SystemSmartContractsConfig{},
+		workingDir:          workingDir,
+	})
+}
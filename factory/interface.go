@@ -25,6 +25,7 @@ import (
 	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
@@ -104,6 +105,7 @@ type CoreComponentsHolder interface {
 	ValidatorPubKeyConverter() core.PubkeyConverter
 	StatusHandlerUtils() factory.StatusHandlersUtils
 	StatusHandler() core.AppStatusHandler
+	MetricsHandler() common.MetricsHandler
 	PathHandler() storage.PathManagerHandler
 	Watchdog() core.WatchdogTimer
 	AlarmScheduler() core.TimersScheduler
@@ -208,6 +210,8 @@ type NetworkComponentsHolder interface {
 	PubKeyCacher() process.TimeCacher
 	PeerBlackListHandler() process.PeerBlackListCacher
 	PeerHonestyHandler() PeerHonestyHandler
+	PeerReputationHandler() process.PeerReputationHandler
+	AntifloodConfigHandler() process.AntifloodConfigHandler
 	PreferredPeersHolderHandler() PreferredPeersHolderHandler
 	IsInterfaceNil() bool
 }
@@ -221,6 +225,9 @@ type NetworkComponentsHandler interface {
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
 	ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxWithOptions(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	ProcessTxBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	ReplayTx(replayData *txSimData.ReplayData) (*txSimData.SimulationResults, error)
 	IsInterfaceNil() bool
 }
 
@@ -284,6 +291,7 @@ type StateComponentsHolder interface {
 // StatusComponentsHolder holds the status components
 type StatusComponentsHolder interface {
 	OutportHandler() outport.OutportHandler
+	SubscriptionsHub() subscriptions.Hub
 	SoftwareVersionChecker() statistics.SoftwareVersionChecker
 	IsInterfaceNil() bool
 }
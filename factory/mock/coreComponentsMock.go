@@ -31,6 +31,7 @@ type CoreComponentsMock struct {
 	ValPubKeyConv               core.PubkeyConverter
 	StatusHdlUtils              nodeFactory.StatusHandlersUtils
 	AppStatusHdl                core.AppStatusHandler
+	MetricsHandlerField         common.MetricsHandler
 	mutStatus                   sync.RWMutex
 	PathHdl                     storage.PathManagerHandler
 	WatchdogTimer               core.WatchdogTimer
@@ -123,6 +124,11 @@ func (ccm *CoreComponentsMock) StatusHandler() core.AppStatusHandler {
 	return ccm.AppStatusHdl
 }
 
+// MetricsHandler -
+func (ccm *CoreComponentsMock) MetricsHandler() common.MetricsHandler {
+	return ccm.MetricsHandlerField
+}
+
 // PathHandler -
 func (ccm *CoreComponentsMock) PathHandler() storage.PathManagerHandler {
 	return ccm.PathHdl
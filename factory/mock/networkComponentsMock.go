@@ -25,6 +25,16 @@ func (ncm *NetworkComponentsMock) PeerHonestyHandler() factory.PeerHonestyHandle
 	return nil
 }
 
+// PeerReputationHandler -
+func (ncm *NetworkComponentsMock) PeerReputationHandler() process.PeerReputationHandler {
+	return nil
+}
+
+// AntifloodConfigHandler -
+func (ncm *NetworkComponentsMock) AntifloodConfigHandler() process.AntifloodConfigHandler {
+	return nil
+}
+
 // Create -
 func (ncm *NetworkComponentsMock) Create() error {
 	return nil
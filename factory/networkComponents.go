@@ -3,12 +3,14 @@ package factory
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/core/peersholder"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/debug/antiflood"
@@ -17,7 +19,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/rating/peerHonesty"
+	"github.com/ElrondNetwork/elrond-go/process/rating/peerReputation"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	antifloodFactory "github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/factory"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 )
@@ -28,11 +33,13 @@ type NetworkComponentsFactoryArgs struct {
 	MainConfig           config.Config
 	RatingsConfig        config.RatingsConfig
 	StatusHandler        core.AppStatusHandler
+	MetricsHandler       common.MetricsHandler
 	Marshalizer          marshal.Marshalizer
 	Syncer               p2p.SyncTimer
 	PreferredPublicKeys  [][]byte
 	BootstrapWaitSeconds uint32
 	NodeOperationMode    p2p.NodeOperation
+	PathManager          storage.PathManagerHandler
 }
 
 type networkComponentsFactory struct {
@@ -40,12 +47,14 @@ type networkComponentsFactory struct {
 	mainConfig           config.Config
 	ratingsConfig        config.RatingsConfig
 	statusHandler        core.AppStatusHandler
+	metricsHandler       common.MetricsHandler
 	listenAddress        string
 	marshalizer          marshal.Marshalizer
 	syncer               p2p.SyncTimer
 	preferredPublicKeys  [][]byte
 	bootstrapWaitSeconds uint32
 	nodeOperationMode    p2p.NodeOperation
+	pathManager          storage.PathManagerHandler
 }
 
 // networkComponents struct holds the network components
@@ -59,6 +68,8 @@ type networkComponents struct {
 	peerBlackListHandler   process.PeerBlackListCacher
 	antifloodConfig        config.AntifloodConfig
 	peerHonestyHandler     consensus.PeerHonestyHandler
+	peerReputationHandler  process.PeerReputationHandler
+	antifloodConfigHandler process.AntifloodConfigHandler
 	peersHolder            PreferredPeersHolderHandler
 	closeFunc              context.CancelFunc
 }
@@ -70,12 +81,18 @@ func NewNetworkComponentsFactory(
 	if check.IfNil(args.StatusHandler) {
 		return nil, errors.ErrNilStatusHandler
 	}
+	if check.IfNil(args.MetricsHandler) {
+		return nil, errors.ErrNilMetricsHandler
+	}
 	if check.IfNil(args.Marshalizer) {
 		return nil, fmt.Errorf("%w in NewNetworkComponentsFactory", errors.ErrNilMarshalizer)
 	}
 	if check.IfNil(args.Syncer) {
 		return nil, errors.ErrNilSyncTimer
 	}
+	if check.IfNil(args.PathManager) {
+		return nil, errors.ErrNilPathHandler
+	}
 
 	return &networkComponentsFactory{
 		p2pConfig:            args.P2pConfig,
@@ -83,11 +100,13 @@ func NewNetworkComponentsFactory(
 		marshalizer:          args.Marshalizer,
 		mainConfig:           args.MainConfig,
 		statusHandler:        args.StatusHandler,
+		metricsHandler:       args.MetricsHandler,
 		listenAddress:        libp2p.ListenAddrWithIp4AndTcp,
 		syncer:               args.Syncer,
 		bootstrapWaitSeconds: args.BootstrapWaitSeconds,
 		preferredPublicKeys:  args.PreferredPublicKeys,
 		nodeOperationMode:    args.NodeOperationMode,
+		pathManager:          args.PathManager,
 	}, nil
 }
 
@@ -115,8 +134,26 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 		}
 	}()
 
+	var peerReputationHandler process.PeerReputationHandler
+	peerReputationHandler, err = ncf.createPeerReputationHandler()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			log.LogIfError(peerReputationHandler.Close())
+		}
+	}()
+
 	var antiFloodComponents *antifloodFactory.AntiFloodComponents
-	antiFloodComponents, err = antifloodFactory.NewP2PAntiFloodComponents(ctx, ncf.mainConfig, ncf.statusHandler, netMessenger.ID())
+	antiFloodComponents, err = antifloodFactory.NewP2PAntiFloodComponents(
+		ctx,
+		ncf.mainConfig,
+		ncf.statusHandler,
+		ncf.metricsHandler,
+		netMessenger.ID(),
+		peerReputationHandler,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -180,6 +217,8 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 		pubKeyTimeCacher:       antiFloodComponents.PubKeysCacher,
 		antifloodConfig:        ncf.mainConfig.Antiflood,
 		peerHonestyHandler:     peerHonestyHandler,
+		peerReputationHandler:  peerReputationHandler,
+		antifloodConfigHandler: antiFloodComponents.ConfigHandler,
 		peersHolder:            peersHolder,
 		closeFunc:              cancelFunc,
 	}, nil
@@ -204,6 +243,37 @@ func (ncf *networkComponentsFactory) createPeerHonestyHandler(
 	return peerHonesty.NewP2pPeerHonesty(ratingConfig.PeerHonesty, pkTimeCache, cache)
 }
 
+func (ncf *networkComponentsFactory) createPeerReputationHandler() (process.PeerReputationHandler, error) {
+	reputationConfig := ncf.mainConfig.PeerReputation
+	if !reputationConfig.Enabled {
+		return &disabled.PeerReputationHandler{}, nil
+	}
+
+	// the peer IDs and the validator public keys are not bound to a shard, so the storer lives in the database root
+	dbConfig := storageFactory.GetDBFromConfig(reputationConfig.Storage.DB)
+	dbConfig.FilePath = filepath.Join(ncf.pathManager.DatabasePath(), reputationConfig.Storage.DB.FilePath)
+	storer, err := storageUnit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(reputationConfig.Storage.Cache),
+		dbConfig,
+		storageFactory.GetBloomFromConfig(reputationConfig.Storage.Bloom),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	reputationHandler, err := peerReputation.NewPeerReputationStore(peerReputation.ArgPeerReputationStore{
+		Config:      reputationConfig,
+		Storer:      storer,
+		Marshalizer: &marshal.JsonMarshalizer{},
+	})
+	if err != nil {
+		log.LogIfError(storer.Close())
+		return nil, err
+	}
+
+	return reputationHandler, nil
+}
+
 // Close closes all underlying components that need closing
 func (nc *networkComponents) Close() error {
 	nc.closeFunc()
@@ -220,6 +290,9 @@ func (nc *networkComponents) Close() error {
 	if !check.IfNil(nc.peerHonestyHandler) {
 		log.LogIfError(nc.peerHonestyHandler.Close())
 	}
+	if !check.IfNil(nc.peerReputationHandler) {
+		log.LogIfError(nc.peerReputationHandler.Close())
+	}
 
 	if nc.netMessenger != nil {
 		log.Debug("calling close on the network messenger instance...")
@@ -88,6 +88,12 @@ func (mnc *managedNetworkComponents) CheckSubcomponents() error {
 	if check.IfNil(mnc.peerHonestyHandler) {
 		return errors.ErrNilPeerHonestyHandler
 	}
+	if check.IfNil(mnc.peerReputationHandler) {
+		return errors.ErrNilPeerReputationHandler
+	}
+	if check.IfNil(mnc.antifloodConfigHandler) {
+		return errors.ErrNilAntifloodConfigHandler
+	}
 
 	return nil
 }
@@ -164,6 +170,30 @@ func (mnc *managedNetworkComponents) PeerHonestyHandler() PeerHonestyHandler {
 	return mnc.networkComponents.peerHonestyHandler
 }
 
+// PeerReputationHandler returns the persistent peer reputation handler
+func (mnc *managedNetworkComponents) PeerReputationHandler() process.PeerReputationHandler {
+	mnc.mutNetworkComponents.RLock()
+	defer mnc.mutNetworkComponents.RUnlock()
+
+	if mnc.networkComponents == nil {
+		return nil
+	}
+
+	return mnc.networkComponents.peerReputationHandler
+}
+
+// AntifloodConfigHandler returns the component able to apply a new antiflood configuration at runtime
+func (mnc *managedNetworkComponents) AntifloodConfigHandler() process.AntifloodConfigHandler {
+	mnc.mutNetworkComponents.RLock()
+	defer mnc.mutNetworkComponents.RUnlock()
+
+	if mnc.networkComponents == nil {
+		return nil
+	}
+
+	return mnc.networkComponents.antifloodConfigHandler
+}
+
 // PreferredPeersHolder returns the preferred peers holder
 func (mnc *managedNetworkComponents) PreferredPeersHolderHandler() PreferredPeersHolderHandler {
 	mnc.mutNetworkComponents.RLock()
@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/factory/mock"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/require"
 )
@@ -24,6 +25,16 @@ func TestNewNetworkComponentsFactory_NilStatusHandlerShouldErr(t *testing.T) {
 	require.Equal(t, errErd.ErrNilStatusHandler, err)
 }
 
+func TestNewNetworkComponentsFactory_NilMetricsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getNetworkArgs()
+	args.MetricsHandler = nil
+	ncf, err := factory.NewNetworkComponentsFactory(args)
+	require.Nil(t, ncf)
+	require.Equal(t, errErd.ErrNilMetricsHandler, err)
+}
+
 func TestNewNetworkComponentsFactory_NilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -34,6 +45,16 @@ func TestNewNetworkComponentsFactory_NilMarshalizerShouldErr(t *testing.T) {
 	require.True(t, errors.Is(err, errErd.ErrNilMarshalizer))
 }
 
+func TestNewNetworkComponentsFactory_NilPathManagerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getNetworkArgs()
+	args.PathManager = nil
+	ncf, err := factory.NewNetworkComponentsFactory(args)
+	require.Nil(t, ncf)
+	require.Equal(t, errErd.ErrNilPathHandler, err)
+}
+
 func TestNewNetworkComponentsFactory_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 	args := getNetworkArgs()
@@ -141,5 +162,7 @@ func getNetworkArgs() factory.NetworkComponentsFactoryArgs {
 		},
 		Syncer:            &libp2p.LocalSyncTimer{},
 		NodeOperationMode: p2p.NormalOperation,
+		PathManager:       &testscommon.PathManagerStub{},
+		MetricsHandler:    &statusHandlerMock.MetricsHandlerStub{},
 	}
 }
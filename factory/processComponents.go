@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/core/partitioning"
+	"github.com/ElrondNetwork/elrond-go-core/core/random"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	dataBlock "github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
@@ -23,6 +24,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/resolverscontainer"
 	storageResolversContainers "github.com/ElrondNetwork/elrond-go/dataRetriever/factory/storageResolversContainer"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestHandlers"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/throughput"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/metachain"
@@ -150,6 +152,7 @@ type processComponentsFactory struct {
 	historyRepo            dblookupext.HistoryRepository
 	epochNotifier          process.EpochNotifier
 	importHandler          update.ImportHandler
+	peersThroughputTracker dataRetriever.PeersThroughputHandler
 
 	data                DataComponentsHolder
 	coreData            CoreComponentsHolder
@@ -233,6 +236,13 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		return nil, err
 	}
 
+	// the trie nodes resolvers and interceptors share the same tracker so that the requests go preferably to the peers
+	// that answered faster
+	pcf.peersThroughputTracker, err = throughput.NewPeersThroughputTracker(&random.ConcurrentSafeIntRandomizer{})
+	if err != nil {
+		return nil, err
+	}
+
 	resolversContainerFactory, err := pcf.newResolverContainerFactory(currentEpochProvider)
 	if err != nil {
 		return nil, err
@@ -453,6 +463,7 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		VMOutputCacher:         vmOutputCacher,
 		Hasher:                 pcf.coreData.Hasher(),
 		Marshalizer:            pcf.coreData.InternalMarshalizer(),
+		MaxTxBundleSize:        pcf.config.TxSimulator.MaxTxBundleSize,
 	}
 
 	blockProcessor, vmFactoryTxSimulator, err := pcf.newBlockProcessor(
@@ -856,7 +867,7 @@ func (pcf *processComponentsFactory) indexGenesisBlocks(genesisBlocks map[uint32
 		}
 
 		log.Info("indexGenesisBlocks(): historyRepo.RecordBlock", "shardID", shardID, "hash", genesisBlockHash)
-		err = pcf.historyRepo.RecordBlock(genesisBlockHash, genesisBlockHeader, &dataBlock.Body{}, nil, nil, nil)
+		err = pcf.historyRepo.RecordBlock(genesisBlockHash, genesisBlockHeader, &dataBlock.Body{}, nil, nil, nil, nil, nil)
 		if err != nil {
 			return err
 		}
@@ -954,6 +965,7 @@ func (pcf *processComponentsFactory) newShardResolverContainerFactory(
 		CurrentNetworkEpochProvider: currentEpochProvider,
 		ResolverConfig:              pcf.config.Resolvers,
 		PreferredPeersHolder:        pcf.network.PreferredPeersHolderHandler(),
+		PeersThroughputHandler:      pcf.peersThroughputTracker,
 	}
 	resolversContainerFactory, err := resolverscontainer.NewShardResolversContainerFactory(resolversContainerFactoryArgs)
 	if err != nil {
@@ -989,6 +1001,7 @@ func (pcf *processComponentsFactory) newMetaResolverContainerFactory(
 		CurrentNetworkEpochProvider: currentEpochProvider,
 		ResolverConfig:              pcf.config.Resolvers,
 		PreferredPeersHolder:        pcf.network.PreferredPeersHolderHandler(),
+		PeersThroughputHandler:      pcf.peersThroughputTracker,
 	}
 	resolversContainerFactory, err := resolverscontainer.NewMetaResolversContainerFactory(resolversContainerFactoryArgs)
 	if err != nil {
@@ -1052,6 +1065,7 @@ func (pcf *processComponentsFactory) newStorageResolver() (dataRetriever.Resolve
 		pathManager,
 		manualEpochStartNotifier,
 		pcf.coreData.NodeTypeProvider(),
+		pcf.coreData.MetricsHandler(),
 		pcf.bootstrapComponents.EpochBootstrapParams().Epoch(),
 		false,
 	)
@@ -1178,6 +1192,7 @@ func (pcf *processComponentsFactory) newShardInterceptorContainerFactory(
 		EnableSignTxWithHashEpoch: pcf.epochConfig.EnableEpochs.TransactionSignedWithTxHashEnableEpoch,
 		PreferredPeersHolder:      pcf.network.PreferredPeersHolderHandler(),
 		RequestHandler:            requestHandler,
+		PeersThroughputRecorder:   pcf.peersThroughputTracker,
 	}
 	log.Debug("shardInterceptor: enable epoch for transaction signed with tx hash", "epoch", shardInterceptorsContainerFactoryArgs.EnableSignTxWithHashEpoch)
 
@@ -1221,6 +1236,7 @@ func (pcf *processComponentsFactory) newMetaInterceptorContainerFactory(
 		EnableSignTxWithHashEpoch: pcf.epochConfig.EnableEpochs.TransactionSignedWithTxHashEnableEpoch,
 		PreferredPeersHolder:      pcf.network.PreferredPeersHolderHandler(),
 		RequestHandler:            requestHandler,
+		PeersThroughputRecorder:   pcf.peersThroughputTracker,
 	}
 	log.Debug("metaInterceptor: enable epoch for transaction signed with tx hash", "epoch", metaInterceptorsContainerFactoryArgs.EnableSignTxWithHashEpoch)
 
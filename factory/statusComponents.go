@@ -3,6 +3,7 @@ package factory
 import (
 	"context"
 	"fmt"
+	"time"
 
 	covalentFactory "github.com/ElrondNetwork/covalent-indexer-go/factory"
 	indexerFactory "github.com/ElrondNetwork/elastic-indexer-go/factory"
@@ -18,6 +19,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/outport"
 	outportDriverFactory "github.com/ElrondNetwork/elrond-go/outport/factory"
+	"github.com/ElrondNetwork/elrond-go/outport/grpcDriver"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -30,6 +33,7 @@ type statusComponents struct {
 	nodesCoordinator sharding.NodesCoordinator
 	statusHandler    core.AppStatusHandler
 	outportHandler   outport.OutportHandler
+	subscriptionsHub subscriptions.Hub
 	softwareVersion  statistics.SoftwareVersionChecker
 	resourceMonitor  statistics.ResourceMonitorHandler
 	cancelFunc       func()
@@ -143,7 +147,7 @@ func (scf *statusComponentsFactory) Create() (*statusComponents, error) {
 		return nil, errors.ErrInvalidRoundDuration
 	}
 
-	outportHandler, err := scf.createOutportDriver()
+	outportHandler, subscriptionsHub, err := scf.createOutportDriver()
 	if err != nil {
 		return nil, err
 	}
@@ -154,6 +158,7 @@ func (scf *statusComponentsFactory) Create() (*statusComponents, error) {
 		nodesCoordinator: scf.nodesCoordinator,
 		softwareVersion:  softwareVersionChecker,
 		outportHandler:   outportHandler,
+		subscriptionsHub: subscriptionsHub,
 		statusHandler:    scf.coreComponents.StatusHandler(),
 		resourceMonitor:  resMon,
 		cancelFunc:       cancelFunc,
@@ -205,16 +210,43 @@ func (pc *statusComponents) Close() error {
 
 // createOutportDriver creates a new outport.OutportHandler which is used to register outport drivers
 // once a driver is subscribed it will receive data through the implemented outport.Driver methods
-func (scf *statusComponentsFactory) createOutportDriver() (outport.OutportHandler, error) {
-
+// the returned subscriptions hub is a disabled one if the subscriptions connector is not enabled
+func (scf *statusComponentsFactory) createOutportDriver() (outport.OutportHandler, subscriptions.Hub, error) {
 	outportFactoryArgs := &outportDriverFactory.OutportFactoryArgs{
 		RetrialInterval:            common.RetrialIntervalForOutportDriver,
 		ElasticIndexerFactoryArgs:  scf.makeElasticIndexerArgs(),
 		EventNotifierFactoryArgs:   scf.makeEventNotifierArgs(),
 		CovalentIndexerFactoryArgs: scf.makeCovalentIndexerArgs(),
+		GrpcDriverFactoryArgs:      scf.makeGrpcDriverArgs(),
+		OutportQueueFactoryArgs:    scf.makeOutportQueueArgs(),
+		AccountsDB:                 scf.stateComponents.AccountsAdapter(),
+		ShardCoordinator:           scf.shardCoordinator,
+	}
+
+	var subscriptionsHub subscriptions.Hub = subscriptions.NewDisabledSubscriptionsHub()
+	subscriptionsConfig := scf.externalConfig.SubscriptionsConnector
+	if subscriptionsConfig.Enabled {
+		hub, err := subscriptions.NewSubscriptionsHub(subscriptions.ArgsSubscriptionsHub{
+			PubKeyConverter:  scf.coreComponents.AddressPubKeyConverter(),
+			Marshalizer:      scf.coreComponents.InternalMarshalizer(),
+			Hasher:           scf.coreComponents.Hasher(),
+			MaxSubscribers:   subscriptionsConfig.MaxSubscribers,
+			EventsBufferSize: subscriptionsConfig.EventsBufferSize,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		outportFactoryArgs.SubscriptionsHub = hub
+		subscriptionsHub = hub
 	}
 
-	return outportDriverFactory.CreateOutport(outportFactoryArgs)
+	outportHandler, err := outportDriverFactory.CreateOutport(outportFactoryArgs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return outportHandler, subscriptionsHub, nil
 }
 
 func (scf *statusComponentsFactory) makeElasticIndexerArgs() *indexerFactory.ArgsIndexerFactory {
@@ -231,7 +263,6 @@ func (scf *statusComponentsFactory) makeElasticIndexerArgs() *indexerFactory.Arg
 		AddressPubkeyConverter:   scf.coreComponents.AddressPubKeyConverter(),
 		ValidatorPubkeyConverter: scf.coreComponents.ValidatorPubKeyConverter(),
 		EnabledIndexes:           elasticSearchConfig.EnabledIndexes,
-		AccountsDB:               scf.stateComponents.AccountsAdapter(),
 		Denomination:             scf.economicsConfig.GlobalSettings.Denomination,
 		TransactionFeeCalculator: scf.coreComponents.EconomicsData(),
 		UseKibana:                elasticSearchConfig.UseKibana,
@@ -252,6 +283,32 @@ func (scf *statusComponentsFactory) makeEventNotifierArgs() *notifierFactory.Eve
 	}
 }
 
+func (scf *statusComponentsFactory) makeGrpcDriverArgs() *outportDriverFactory.GrpcDriverFactoryArgs {
+	grpcOutportConfig := scf.externalConfig.GrpcOutportConnector
+	return &outportDriverFactory.GrpcDriverFactoryArgs{
+		Enabled: grpcOutportConfig.Enabled,
+		ArgsGrpcDriver: grpcDriver.ArgsGrpcDriver{
+			Target:      grpcOutportConfig.Target,
+			AckTimeout:  time.Duration(grpcOutportConfig.AckTimeoutInSeconds) * time.Second,
+			Marshalizer: scf.coreComponents.InternalMarshalizer(),
+		},
+	}
+}
+
+func (scf *statusComponentsFactory) makeOutportQueueArgs() *outportDriverFactory.OutportQueueFactoryArgs {
+	outportQueueConfig := scf.externalConfig.OutportQueue
+	shardID := core.GetShardIDString(scf.shardCoordinator.SelfId())
+	return &outportDriverFactory.OutportQueueFactoryArgs{
+		Enabled:               outportQueueConfig.Enabled,
+		DBConfig:              outportQueueConfig.DB,
+		Path:                  scf.coreComponents.PathHandler().PathForStatic(shardID, outportQueueConfig.DB.FilePath),
+		Marshalizer:           scf.coreComponents.InternalMarshalizer(),
+		MetricsHandler:        scf.coreComponents.MetricsHandler(),
+		MaxPendingRecords:     outportQueueConfig.MaxPendingRecords,
+		MaxPendingSizeInBytes: outportQueueConfig.MaxPendingSizeInBytes,
+	}
+}
+
 func (scf *statusComponentsFactory) makeCovalentIndexerArgs() *covalentFactory.ArgsCovalentIndexerFactory {
 	return &covalentFactory.ArgsCovalentIndexerFactory{
 		Enabled:              scf.externalConfig.CovalentConnector.Enabled,
@@ -259,7 +316,6 @@ func (scf *statusComponentsFactory) makeCovalentIndexerArgs() *covalentFactory.A
 		RouteSendData:        scf.externalConfig.CovalentConnector.RouteSendData,
 		RouteAcknowledgeData: scf.externalConfig.CovalentConnector.RouteAcknowledgeData,
 		PubKeyConverter:      scf.coreComponents.AddressPubKeyConverter(),
-		Accounts:             scf.stateComponents.AccountsAdapter(),
 		Hasher:               scf.coreComponents.Hasher(),
 		Marshaller:           scf.coreComponents.InternalMarshalizer(),
 		ShardCoordinator:     scf.shardCoordinator,
@@ -0,0 +1,265 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/integrationTests/chainSimulator"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	simulatorPath      = "/simulator"
+	shardPath          = "/shard/:shardID/*path"
+	defaultNodeShardID = uint32(0)
+)
+
+var log = logger.GetOrCreate("integrationtests/chainsimulator/api")
+
+// ErrNilChainSimulator signals that a nil chain simulator was provided
+var ErrNilChainSimulator = errors.New("nil chain simulator")
+
+// ErrNilPubkeyConverter signals that a nil public key converter was provided
+var ErrNilPubkeyConverter = errors.New("nil public key converter")
+
+// ErrMissingDefaultNode signals that the handler of the default node was not provided
+var ErrMissingDefaultNode = errors.New("missing default node")
+
+// ArgsServer holds the arguments needed to create the chain simulator server
+type ArgsServer struct {
+	Simulator       ChainSimulator
+	NodeHandlers    map[uint32]http.Handler
+	PubkeyConverter core.PubkeyConverter
+}
+
+type setBalanceRequest struct {
+	Address string `json:"address"`
+	Balance string `json:"balance"`
+}
+
+// server exposes the control endpoints of the chain simulator together with the REST API of each node. The requests
+// are serialized so the nodes are never queried while the simulator produces blocks
+type server struct {
+	mutOperations   sync.Mutex
+	simulator       ChainSimulator
+	nodeHandlers    map[uint32]http.Handler
+	pubkeyConverter core.PubkeyConverter
+}
+
+// NewServer creates a new chain simulator server
+func NewServer(args ArgsServer) (*server, error) {
+	if check.IfNil(args.Simulator) {
+		return nil, ErrNilChainSimulator
+	}
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if args.NodeHandlers[defaultNodeShardID] == nil {
+		return nil, ErrMissingDefaultNode
+	}
+
+	return &server{
+		simulator:       args.Simulator,
+		nodeHandlers:    args.NodeHandlers,
+		pubkeyConverter: args.PubkeyConverter,
+	}, nil
+}
+
+// NewSimulatorServer creates a chain simulator server reaching the nodes of the provided simulator, so an integration
+// test can drive the simulator and its nodes through the REST API
+func NewSimulatorServer(simulator ChainSimulatorWithNodes) (*server, error) {
+	if check.IfNil(simulator) {
+		return nil, ErrNilChainSimulator
+	}
+
+	nodeHandlers := make(map[uint32]http.Handler)
+	for _, shardID := range simulator.GetShardIDs() {
+		node, err := simulator.GetNode(shardID)
+		if err != nil {
+			return nil, err
+		}
+		nodeHandlers[shardID] = node
+	}
+
+	return NewServer(ArgsServer{
+		Simulator:       simulator,
+		NodeHandlers:    nodeHandlers,
+		PubkeyConverter: integrationTests.TestAddressPubkeyConverter,
+	})
+}
+
+// Start will boot up the api and appropriate routes, handlers and validators
+func (s *server) Start(restApiInterface string) error {
+	ws := gin.Default()
+	ws.Use(cors.Default())
+
+	s.registerRoutes(ws)
+
+	return ws.Run(restApiInterface)
+}
+
+// GenerateBlocks advances the chain simulator with the provided number of blocks
+func (s *server) GenerateBlocks(numOfBlocks int) error {
+	s.mutOperations.Lock()
+	defer s.mutOperations.Unlock()
+
+	return s.simulator.GenerateBlocks(numOfBlocks)
+}
+
+func (s *server) registerRoutes(ws *gin.Engine) {
+	simulatorGroup := ws.Group(simulatorPath)
+	simulatorGroup.GET("/status", s.getStatus)
+	simulatorGroup.POST("/generate-blocks/:num", s.generateBlocks)
+	simulatorGroup.POST("/generate-blocks-until-epoch/:epoch", s.generateBlocksUntilEpoch)
+	simulatorGroup.POST("/set-balance", s.setBalance)
+
+	ws.Any(shardPath, s.forwardToShard)
+	ws.NoRoute(s.forwardToDefaultNode)
+}
+
+func (s *server) getStatus(c *gin.Context) {
+	s.mutOperations.Lock()
+	status := gin.H{
+		"round":  s.simulator.CurrentRound(),
+		"epoch":  s.simulator.CurrentEpoch(),
+		"shards": s.simulator.GetShardIDs(),
+	}
+	s.mutOperations.Unlock()
+
+	respondWithSuccess(c, gin.H{"status": status})
+}
+
+func (s *server) generateBlocks(c *gin.Context) {
+	numOfBlocks, err := strconv.Atoi(c.Param("num"))
+	if err != nil {
+		respondWithError(c, http.StatusBadRequest, shared.ReturnCodeRequestError, fmt.Errorf("invalid number of blocks: %w", err))
+		return
+	}
+
+	err = s.GenerateBlocks(numOfBlocks)
+	if err != nil {
+		respondWithError(c, http.StatusInternalServerError, shared.ReturnCodeInternalError, err)
+		return
+	}
+
+	s.getStatus(c)
+}
+
+func (s *server) generateBlocksUntilEpoch(c *gin.Context) {
+	epoch, err := strconv.ParseUint(c.Param("epoch"), 10, 32)
+	if err != nil {
+		respondWithError(c, http.StatusBadRequest, shared.ReturnCodeRequestError, fmt.Errorf("invalid epoch: %w", err))
+		return
+	}
+
+	s.mutOperations.Lock()
+	err = s.simulator.GenerateBlocksUntilEpochIsReached(uint32(epoch))
+	s.mutOperations.Unlock()
+	if err != nil {
+		respondWithError(c, http.StatusInternalServerError, shared.ReturnCodeInternalError, err)
+		return
+	}
+
+	s.getStatus(c)
+}
+
+func (s *server) setBalance(c *gin.Context) {
+	request := setBalanceRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		respondWithError(c, http.StatusBadRequest, shared.ReturnCodeRequestError, err)
+		return
+	}
+
+	address, err := s.pubkeyConverter.Decode(request.Address)
+	if err != nil {
+		respondWithError(c, http.StatusBadRequest, shared.ReturnCodeRequestError, fmt.Errorf("invalid address: %w", err))
+		return
+	}
+
+	balance, ok := big.NewInt(0).SetString(request.Balance, 10)
+	if !ok {
+		respondWithError(c, http.StatusBadRequest, shared.ReturnCodeRequestError, fmt.Errorf("invalid balance: %s", request.Balance))
+		return
+	}
+
+	s.mutOperations.Lock()
+	err = s.simulator.SetBalance(address, balance)
+	s.mutOperations.Unlock()
+	if errors.Is(err, chainSimulator.ErrUnsafeSetBalanceNotEnabled) {
+		respondWithError(c, http.StatusForbidden, shared.ReturnCodeRequestError, err)
+		return
+	}
+	if err != nil {
+		respondWithError(c, http.StatusInternalServerError, shared.ReturnCodeInternalError, err)
+		return
+	}
+
+	respondWithSuccess(c, gin.H{})
+}
+
+func (s *server) forwardToShard(c *gin.Context) {
+	shardID, err := core.ConvertShardIDToUint32(c.Param("shardID"))
+	if err != nil {
+		respondWithError(c, http.StatusBadRequest, shared.ReturnCodeRequestError, fmt.Errorf("invalid shard: %w", err))
+		return
+	}
+
+	handler, found := s.nodeHandlers[shardID]
+	if !found {
+		respondWithError(c, http.StatusNotFound, shared.ReturnCodeRequestError, fmt.Errorf("no node for shard %d", shardID))
+		return
+	}
+
+	c.Request.URL.Path = c.Param("path")
+	s.forward(c, handler)
+}
+
+func (s *server) forwardToDefaultNode(c *gin.Context) {
+	s.forward(c, s.nodeHandlers[defaultNodeShardID])
+}
+
+func (s *server) forward(c *gin.Context, handler http.Handler) {
+	s.mutOperations.Lock()
+	defer s.mutOperations.Unlock()
+
+	log.Trace("forwarding request", "method", c.Request.Method, "path", c.Request.URL.Path)
+	handler.ServeHTTP(c.Writer, c.Request)
+}
+
+func respondWithSuccess(c *gin.Context, data gin.H) {
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  data,
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func respondWithError(c *gin.Context, status int, code shared.ReturnCode, err error) {
+	c.JSON(
+		status,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: err.Error(),
+			Code:  code,
+		},
+	)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *server) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,399 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/integrationTests/chainSimulator"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type chainSimulatorStub struct {
+	GenerateBlocksCalled                    func(numOfBlocks int) error
+	GenerateBlocksUntilEpochIsReachedCalled func(targetEpoch uint32) error
+	SetBalanceCalled                        func(address []byte, balance *big.Int) error
+}
+
+// GenerateBlocks -
+func (stub *chainSimulatorStub) GenerateBlocks(numOfBlocks int) error {
+	if stub.GenerateBlocksCalled != nil {
+		return stub.GenerateBlocksCalled(numOfBlocks)
+	}
+
+	return nil
+}
+
+// GenerateBlocksUntilEpochIsReached -
+func (stub *chainSimulatorStub) GenerateBlocksUntilEpochIsReached(targetEpoch uint32) error {
+	if stub.GenerateBlocksUntilEpochIsReachedCalled != nil {
+		return stub.GenerateBlocksUntilEpochIsReachedCalled(targetEpoch)
+	}
+
+	return nil
+}
+
+// SetBalance -
+func (stub *chainSimulatorStub) SetBalance(address []byte, balance *big.Int) error {
+	if stub.SetBalanceCalled != nil {
+		return stub.SetBalanceCalled(address, balance)
+	}
+
+	return nil
+}
+
+// GetShardIDs -
+func (stub *chainSimulatorStub) GetShardIDs() []uint32 {
+	return []uint32{0, 1}
+}
+
+// CurrentRound -
+func (stub *chainSimulatorStub) CurrentRound() uint64 {
+	return 7
+}
+
+// CurrentEpoch -
+func (stub *chainSimulatorStub) CurrentEpoch() uint32 {
+	return 1
+}
+
+// IsInterfaceNil -
+func (stub *chainSimulatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+type chainSimulatorWithNodesStub struct {
+	chainSimulatorStub
+	GetNodeCalled func(shardID uint32) (*integrationTests.TestProcessorNodeWithTestWebServer, error)
+}
+
+// GetNode -
+func (stub *chainSimulatorWithNodesStub) GetNode(shardID uint32) (*integrationTests.TestProcessorNodeWithTestWebServer, error) {
+	if stub.GetNodeCalled != nil {
+		return stub.GetNodeCalled(shardID)
+	}
+
+	return nil, nil
+}
+
+type nodeHandlerStub struct {
+	requestedPaths []string
+}
+
+func (stub *nodeHandlerStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	stub.requestedPaths = append(stub.requestedPaths, r.URL.Path)
+	w.WriteHeader(http.StatusTeapot)
+}
+
+func createMockArgsServer() ArgsServer {
+	return ArgsServer{
+		Simulator: &chainSimulatorStub{},
+		NodeHandlers: map[uint32]http.Handler{
+			0: &nodeHandlerStub{},
+			1: &nodeHandlerStub{},
+		},
+		PubkeyConverter: testscommon.NewPubkeyConverterMock(32),
+	}
+}
+
+func createWebServer(t *testing.T, args ArgsServer) *gin.Engine {
+	s, err := NewServer(args)
+	require.Nil(t, err)
+
+	ws := gin.New()
+	s.registerRoutes(ws)
+
+	return ws
+}
+
+func doRequest(ws *gin.Engine, method string, path string, body []byte) (*httptest.ResponseRecorder, shared.GenericAPIResponse) {
+	req, _ := http.NewRequest(method, path, bytes.NewBuffer(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	_ = json.Unmarshal(resp.Body.Bytes(), &response)
+
+	return resp, response
+}
+
+func TestNewServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil simulator should error", func(t *testing.T) {
+		args := createMockArgsServer()
+		args.Simulator = nil
+		s, err := NewServer(args)
+		assert.Nil(t, s)
+		assert.Equal(t, ErrNilChainSimulator, err)
+	})
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		args := createMockArgsServer()
+		args.PubkeyConverter = nil
+		s, err := NewServer(args)
+		assert.Nil(t, s)
+		assert.Equal(t, ErrNilPubkeyConverter, err)
+	})
+	t.Run("missing default node should error", func(t *testing.T) {
+		args := createMockArgsServer()
+		delete(args.NodeHandlers, defaultNodeShardID)
+		s, err := NewServer(args)
+		assert.Nil(t, s)
+		assert.Equal(t, ErrMissingDefaultNode, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		s, err := NewServer(createMockArgsServer())
+		assert.Nil(t, err)
+		assert.False(t, s.IsInterfaceNil())
+	})
+}
+
+func TestNewSimulatorServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil simulator should error", func(t *testing.T) {
+		s, err := NewSimulatorServer(nil)
+		assert.Nil(t, s)
+		assert.Equal(t, ErrNilChainSimulator, err)
+	})
+	t.Run("missing node should error", func(t *testing.T) {
+		expectedErr := errors.New("expected error")
+		simulator := &chainSimulatorWithNodesStub{
+			GetNodeCalled: func(shardID uint32) (*integrationTests.TestProcessorNodeWithTestWebServer, error) {
+				if shardID == 1 {
+					return nil, expectedErr
+				}
+				return &integrationTests.TestProcessorNodeWithTestWebServer{}, nil
+			},
+		}
+		s, err := NewSimulatorServer(simulator)
+		assert.Nil(t, s)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		simulator := &chainSimulatorWithNodesStub{
+			GetNodeCalled: func(shardID uint32) (*integrationTests.TestProcessorNodeWithTestWebServer, error) {
+				return &integrationTests.TestProcessorNodeWithTestWebServer{}, nil
+			},
+		}
+		s, err := NewSimulatorServer(simulator)
+		assert.Nil(t, err)
+		assert.Len(t, s.nodeHandlers, 2)
+	})
+}
+
+func TestServer_GetStatus(t *testing.T) {
+	t.Parallel()
+
+	ws := createWebServer(t, createMockArgsServer())
+	resp, response := doRequest(ws, http.MethodGet, "/simulator/status", nil)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+	status := response.Data.(map[string]interface{})["status"].(map[string]interface{})
+	assert.Equal(t, float64(7), status["round"])
+	assert.Equal(t, float64(1), status["epoch"])
+}
+
+func TestServer_GenerateBlocks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of blocks should return bad request", func(t *testing.T) {
+		args := createMockArgsServer()
+		args.Simulator = &chainSimulatorStub{
+			GenerateBlocksCalled: func(numOfBlocks int) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		ws := createWebServer(t, args)
+
+		resp, response := doRequest(ws, http.MethodPost, "/simulator/generate-blocks/abc", nil)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+	})
+	t.Run("simulator error should return internal error", func(t *testing.T) {
+		expectedErr := errors.New("expected error")
+		args := createMockArgsServer()
+		args.Simulator = &chainSimulatorStub{
+			GenerateBlocksCalled: func(numOfBlocks int) error {
+				return expectedErr
+			},
+		}
+		ws := createWebServer(t, args)
+
+		resp, response := doRequest(ws, http.MethodPost, "/simulator/generate-blocks/2", nil)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		generatedBlocks := 0
+		args := createMockArgsServer()
+		args.Simulator = &chainSimulatorStub{
+			GenerateBlocksCalled: func(numOfBlocks int) error {
+				generatedBlocks = numOfBlocks
+				return nil
+			},
+		}
+		ws := createWebServer(t, args)
+
+		resp, response := doRequest(ws, http.MethodPost, "/simulator/generate-blocks/3", nil)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+		assert.Equal(t, 3, generatedBlocks)
+	})
+}
+
+func TestServer_GenerateBlocksUntilEpoch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid epoch should return bad request", func(t *testing.T) {
+		args := createMockArgsServer()
+		args.Simulator = &chainSimulatorStub{
+			GenerateBlocksUntilEpochIsReachedCalled: func(targetEpoch uint32) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		ws := createWebServer(t, args)
+
+		resp, _ := doRequest(ws, http.MethodPost, "/simulator/generate-blocks-until-epoch/-1", nil)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+		resp, _ = doRequest(ws, http.MethodPost, "/simulator/generate-blocks-until-epoch/4294967296", nil)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("simulator error should return internal error", func(t *testing.T) {
+		args := createMockArgsServer()
+		args.Simulator = &chainSimulatorStub{
+			GenerateBlocksUntilEpochIsReachedCalled: func(targetEpoch uint32) error {
+				return chainSimulator.ErrEpochNotReached
+			},
+		}
+		ws := createWebServer(t, args)
+
+		resp, response := doRequest(ws, http.MethodPost, "/simulator/generate-blocks-until-epoch/5", nil)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, chainSimulator.ErrEpochNotReached.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		reachedEpoch := uint32(0)
+		args := createMockArgsServer()
+		args.Simulator = &chainSimulatorStub{
+			GenerateBlocksUntilEpochIsReachedCalled: func(targetEpoch uint32) error {
+				reachedEpoch = targetEpoch
+				return nil
+			},
+		}
+		ws := createWebServer(t, args)
+
+		resp, _ := doRequest(ws, http.MethodPost, "/simulator/generate-blocks-until-epoch/5", nil)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, uint32(5), reachedEpoch)
+	})
+}
+
+func TestServer_SetBalance(t *testing.T) {
+	t.Parallel()
+
+	address := bytes.Repeat([]byte{1}, 32)
+	addressHex := testscommon.NewPubkeyConverterMock(32).Encode(address)
+
+	t.Run("invalid requests should return bad request", func(t *testing.T) {
+		args := createMockArgsServer()
+		args.Simulator = &chainSimulatorStub{
+			SetBalanceCalled: func(address []byte, balance *big.Int) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		ws := createWebServer(t, args)
+
+		resp, _ := doRequest(ws, http.MethodPost, "/simulator/set-balance", []byte("not a json"))
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+		resp, response := doRequest(ws, http.MethodPost, "/simulator/set-balance", []byte(`{"address":"zz","balance":"10"}`))
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, "invalid address")
+
+		resp, response = doRequest(ws, http.MethodPost, "/simulator/set-balance", []byte(`{"address":"`+addressHex+`","balance":"ten"}`))
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, "invalid balance")
+	})
+	t.Run("unsafe set balance not enabled should return forbidden", func(t *testing.T) {
+		args := createMockArgsServer()
+		args.Simulator = &chainSimulatorStub{
+			SetBalanceCalled: func(address []byte, balance *big.Int) error {
+				return chainSimulator.ErrUnsafeSetBalanceNotEnabled
+			},
+		}
+		ws := createWebServer(t, args)
+
+		resp, response := doRequest(ws, http.MethodPost, "/simulator/set-balance", []byte(`{"address":"`+addressHex+`","balance":"10"}`))
+		assert.Equal(t, http.StatusForbidden, resp.Code)
+		assert.Equal(t, chainSimulator.ErrUnsafeSetBalanceNotEnabled.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		var setAddress []byte
+		var setBalance *big.Int
+		args := createMockArgsServer()
+		args.Simulator = &chainSimulatorStub{
+			SetBalanceCalled: func(address []byte, balance *big.Int) error {
+				setAddress = address
+				setBalance = balance
+				return nil
+			},
+		}
+		ws := createWebServer(t, args)
+
+		resp, _ := doRequest(ws, http.MethodPost, "/simulator/set-balance", []byte(`{"address":"`+addressHex+`","balance":"10"}`))
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, address, setAddress)
+		assert.Equal(t, big.NewInt(10), setBalance)
+	})
+}
+
+func TestServer_ForwardToShard(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid shard should return bad request", func(t *testing.T) {
+		ws := createWebServer(t, createMockArgsServer())
+
+		resp, _ := doRequest(ws, http.MethodGet, "/shard/abc/node/status", nil)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("unknown shard should return not found", func(t *testing.T) {
+		ws := createWebServer(t, createMockArgsServer())
+
+		resp, response := doRequest(ws, http.MethodGet, "/shard/2/node/status", nil)
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+		assert.Contains(t, response.Error, "no node for shard 2")
+	})
+	t.Run("should forward the path without the shard prefix", func(t *testing.T) {
+		args := createMockArgsServer()
+		ws := createWebServer(t, args)
+
+		resp, _ := doRequest(ws, http.MethodGet, "/shard/1/address/erd1", nil)
+		assert.Equal(t, http.StatusTeapot, resp.Code)
+		assert.Equal(t, []string{"/address/erd1"}, args.NodeHandlers[1].(*nodeHandlerStub).requestedPaths)
+		assert.Empty(t, args.NodeHandlers[0].(*nodeHandlerStub).requestedPaths)
+	})
+	t.Run("unknown routes should reach the default node", func(t *testing.T) {
+		args := createMockArgsServer()
+		ws := createWebServer(t, args)
+
+		resp, _ := doRequest(ws, http.MethodPost, "/transaction/send", []byte("{}"))
+		assert.Equal(t, http.StatusTeapot, resp.Code)
+		assert.Equal(t, []string{"/transaction/send"}, args.NodeHandlers[0].(*nodeHandlerStub).requestedPaths)
+		assert.Empty(t, args.NodeHandlers[1].(*nodeHandlerStub).requestedPaths)
+	})
+}
@@ -0,0 +1,24 @@
+package api
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+)
+
+// ChainSimulator defines the operations of the chain simulator exposed through the control API
+type ChainSimulator interface {
+	GenerateBlocks(numOfBlocks int) error
+	GenerateBlocksUntilEpochIsReached(targetEpoch uint32) error
+	SetBalance(address []byte, balance *big.Int) error
+	GetShardIDs() []uint32
+	CurrentRound() uint64
+	CurrentEpoch() uint32
+	IsInterfaceNil() bool
+}
+
+// ChainSimulatorWithNodes defines a chain simulator which also provides the node of each shard
+type ChainSimulatorWithNodes interface {
+	ChainSimulator
+	GetNode(shardID uint32) (*integrationTests.TestProcessorNodeWithTestWebServer, error)
+}
@@ -0,0 +1,499 @@
+package chainSimulator
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	dataBlock "github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+const (
+	pollInterval       = 5 * time.Millisecond
+	propagationTimeout = 10 * time.Second
+	minRoundsPerEpoch  = 5
+	// extraRoundsPerEpoch covers the rounds needed by the shards to notice the start of epoch metachain block
+	extraRoundsPerEpoch = 5
+)
+
+var log = logger.GetOrCreate("integrationtests/chainsimulator")
+
+// ArgsChainSimulator holds the arguments needed to create a new chain simulator
+type ArgsChainSimulator struct {
+	NumOfShards     uint32
+	RoundsPerEpoch  uint64
+	ApiRoutesConfig config.ApiRoutesConfig
+	// EnableUnsafeSetBalance allows SetBalance, which writes the state outside of any block
+	EnableUnsafeSetBalance bool
+}
+
+// simulator runs one node for each shard and one for the metachain in the same process. The nodes are connected
+// through an in-memory network and produce blocks only when asked to, the round being advanced by the simulator
+// itself instead of the wall clock
+type simulator struct {
+	mutOperations  sync.Mutex
+	network        *memp2p.Network
+	nodes          []*integrationTests.TestProcessorNodeWithTestWebServer
+	processorNodes []*integrationTests.TestProcessorNode
+	nodesByShard   map[uint32]*integrationTests.TestProcessorNodeWithTestWebServer
+	metaNode       *integrationTests.TestProcessorNodeWithTestWebServer
+	numOfShards    uint32
+	roundsPerEpoch uint64
+	currentRound   uint64
+	closed         bool
+
+	enableUnsafeSetBalance bool
+}
+
+// NewChainSimulator creates a new chain simulator with the provided number of shards plus the metachain
+func NewChainSimulator(args ArgsChainSimulator) (*simulator, error) {
+	if args.NumOfShards == 0 {
+		return nil, ErrInvalidNumOfShards
+	}
+	if args.RoundsPerEpoch < minRoundsPerEpoch {
+		return nil, fmt.Errorf("%w: should be at least %d", ErrInvalidRoundsPerEpoch, minRoundsPerEpoch)
+	}
+
+	s := &simulator{
+		network:        memp2p.NewNetwork(),
+		nodes:          make([]*integrationTests.TestProcessorNodeWithTestWebServer, 0, args.NumOfShards+1),
+		processorNodes: make([]*integrationTests.TestProcessorNode, 0, args.NumOfShards+1),
+		nodesByShard:   make(map[uint32]*integrationTests.TestProcessorNodeWithTestWebServer),
+		numOfShards:    args.NumOfShards,
+		roundsPerEpoch: args.RoundsPerEpoch,
+
+		enableUnsafeSetBalance: args.EnableUnsafeSetBalance,
+	}
+
+	shardIDs := make([]uint32, 0, args.NumOfShards+1)
+	for shardID := uint32(0); shardID < args.NumOfShards; shardID++ {
+		shardIDs = append(shardIDs, shardID)
+	}
+	shardIDs = append(shardIDs, core.MetachainShardId)
+
+	for _, shardID := range shardIDs {
+		err := s.createNode(shardID, args.ApiRoutesConfig)
+		if err != nil {
+			_ = s.Close()
+			return nil, err
+		}
+	}
+	s.metaNode = s.nodesByShard[core.MetachainShardId]
+
+	log.Debug("chain simulator created", "num of shards", args.NumOfShards, "rounds per epoch", args.RoundsPerEpoch)
+
+	return s, nil
+}
+
+func (s *simulator) createNode(shardID uint32, apiRoutesConfig config.ApiRoutesConfig) error {
+	messenger, err := memp2p.NewMessenger(s.network)
+	if err != nil {
+		return err
+	}
+
+	txSignPrivKeyShardID := shardID
+	if shardID == core.MetachainShardId {
+		txSignPrivKeyShardID = 0
+	}
+
+	node := integrationTests.NewTestProcessorNodeWithTestWebServerAndMessenger(
+		s.numOfShards,
+		shardID,
+		txSignPrivKeyShardID,
+		messenger,
+		apiRoutesConfig,
+	)
+	node.EpochStartTrigger.SetRoundsPerEpoch(s.roundsPerEpoch)
+
+	s.nodes = append(s.nodes, node)
+	s.processorNodes = append(s.processorNodes, node.TestProcessorNode)
+	s.nodesByShard[shardID] = node
+
+	return nil
+}
+
+// GenerateBlocks advances the simulator with the provided number of rounds, each shard and the metachain producing
+// one block in every round
+func (s *simulator) GenerateBlocks(numOfBlocks int) error {
+	if numOfBlocks <= 0 {
+		return ErrInvalidNumOfBlocks
+	}
+
+	s.mutOperations.Lock()
+	defer s.mutOperations.Unlock()
+
+	if s.closed {
+		return ErrSimulatorClosed
+	}
+
+	for i := 0; i < numOfBlocks; i++ {
+		err := s.generateBlocksForRound()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GenerateBlocksUntilEpochIsReached generates blocks until all the nodes of the simulator are in the provided epoch
+func (s *simulator) GenerateBlocksUntilEpochIsReached(targetEpoch uint32) error {
+	s.mutOperations.Lock()
+	defer s.mutOperations.Unlock()
+
+	if s.closed {
+		return ErrSimulatorClosed
+	}
+
+	currentEpoch := s.metaNode.EpochStartTrigger.Epoch()
+	if targetEpoch < currentEpoch {
+		return fmt.Errorf("%w: target epoch %d is lower than the current epoch %d", ErrInvalidEpoch, targetEpoch, currentEpoch)
+	}
+
+	maxNumOfBlocks := uint64(targetEpoch-currentEpoch+1) * (s.roundsPerEpoch + extraRoundsPerEpoch)
+	for i := uint64(0); i < maxNumOfBlocks; i++ {
+		if s.isEpochReached(targetEpoch) {
+			return nil
+		}
+
+		err := s.generateBlocksForRound()
+		if err != nil {
+			return err
+		}
+	}
+
+	if s.isEpochReached(targetEpoch) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: epoch %d after %d blocks", ErrEpochNotReached, targetEpoch, maxNumOfBlocks)
+}
+
+func (s *simulator) isEpochReached(epoch uint32) bool {
+	for _, node := range s.nodes {
+		if node.EpochStartTrigger.Epoch() < epoch {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *simulator) generateBlocksForRound() error {
+	err := s.waitForNetworkIdle()
+	if err != nil {
+		return err
+	}
+
+	s.currentRound++
+	integrationTests.UpdateRound(s.processorNodes, s.currentRound)
+
+	for _, node := range s.nodes {
+		if node == s.metaNode {
+			continue
+		}
+
+		var shardHeaderHash []byte
+		shardHeaderHash, err = s.proposeBlock(node)
+		if err != nil {
+			return err
+		}
+
+		err = s.waitForHeader(s.metaNode, shardHeaderHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	metaHeaderHash, err := s.proposeBlock(s.metaNode)
+	if err != nil {
+		return err
+	}
+
+	for _, node := range s.nodes {
+		if node == s.metaNode {
+			continue
+		}
+
+		err = s.waitForHeader(node, metaHeaderHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Debug("chain simulator generated blocks", "round", s.currentRound, "epoch", s.metaNode.EpochStartTrigger.Epoch())
+
+	return nil
+}
+
+// proposeBlock creates, broadcasts and commits the next block of the provided node, returning the block's header hash
+func (s *simulator) proposeBlock(node *integrationTests.TestProcessorNodeWithTestWebServer) ([]byte, error) {
+	shardID := node.ShardCoordinator.SelfId()
+
+	nonce := uint64(1)
+	currentHeader := node.BlockChain.GetCurrentBlockHeader()
+	if !check.IfNil(currentHeader) {
+		nonce = currentHeader.GetNonce() + 1
+	}
+
+	body, header, _ := node.ProposeBlock(s.currentRound, nonce)
+	if check.IfNil(body) || check.IfNil(header) {
+		return nil, fmt.Errorf("%w: shard %d, round %d", ErrBlockNotProposed, shardID, s.currentRound)
+	}
+
+	node.WhiteListBody(s.processorNodes, body)
+	node.BroadcastBlock(body, header)
+
+	err := node.BlockProcessor.CommitBlock(header, body)
+	if err != nil {
+		return nil, fmt.Errorf("%w while committing the block of shard %d, round %d", err, shardID, s.currentRound)
+	}
+
+	headerHash, err := core.CalculateHash(integrationTests.TestMarshalizer, integrationTests.TestHasher, header)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.waitForCrossShardMiniBlocks(shardID, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return headerHash, nil
+}
+
+func (s *simulator) waitForCrossShardMiniBlocks(senderShardID uint32, body data.BodyHandler) error {
+	blockBody, ok := body.(*dataBlock.Body)
+	if !ok {
+		return nil
+	}
+
+	for _, miniBlock := range blockBody.MiniBlocks {
+		if miniBlock.ReceiverShardID == senderShardID {
+			continue
+		}
+		destinationNode, found := s.nodesByShard[miniBlock.ReceiverShardID]
+		if !found {
+			continue
+		}
+
+		miniBlockHash, err := core.CalculateHash(integrationTests.TestMarshalizer, integrationTests.TestHasher, miniBlock)
+		if err != nil {
+			return err
+		}
+
+		err = s.waitForCondition(func() bool {
+			return destinationNode.MiniBlocksPresent([][]byte{miniBlockHash})
+		}, fmt.Sprintf("miniblock %s in shard %d", hex.EncodeToString(miniBlockHash), miniBlock.ReceiverShardID))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *simulator) waitForHeader(node *integrationTests.TestProcessorNodeWithTestWebServer, headerHash []byte) error {
+	return s.waitForCondition(func() bool {
+		_, err := node.DataPool.Headers().GetHeaderByHash(headerHash)
+		return err == nil
+	}, fmt.Sprintf("header %s in shard %d", hex.EncodeToString(headerHash), node.ShardCoordinator.SelfId()))
+}
+
+// waitForNetworkIdle waits until the messages sent on the in-memory network, like the transactions received on the
+// REST API, were handed to the interceptors of all the nodes
+func (s *simulator) waitForNetworkIdle() error {
+	wasIdle := false
+
+	return s.waitForCondition(func() bool {
+		isIdle := !s.network.HasPendingMessages()
+		isStable := isIdle && wasIdle
+		wasIdle = isIdle
+
+		return isStable
+	}, "idle network")
+}
+
+func (s *simulator) waitForCondition(condition func() bool, description string) error {
+	timeout := time.After(propagationTimeout)
+	for !condition() {
+		select {
+		case <-time.After(pollInterval):
+		case <-timeout:
+			return fmt.Errorf("%w: timeout waiting for %s", ErrBlockNotPropagated, description)
+		}
+	}
+
+	return nil
+}
+
+// SendTransaction sends the provided signed transaction through the node of the sender's shard and waits until the
+// transaction is in that node's pool, returning the hex encoded hash of the transaction
+func (s *simulator) SendTransaction(tx *transaction.Transaction) (string, error) {
+	s.mutOperations.Lock()
+	defer s.mutOperations.Unlock()
+
+	if s.closed {
+		return "", ErrSimulatorClosed
+	}
+
+	node, err := s.getNodeOfAddress(tx.SndAddr)
+	if err != nil {
+		return "", err
+	}
+
+	txHash, err := node.SendTransaction(tx)
+	if err != nil {
+		return "", err
+	}
+
+	txHashBytes, err := hex.DecodeString(txHash)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.waitForCondition(func() bool {
+		_, found := node.DataPool.Transactions().SearchFirstData(txHashBytes)
+		return found
+	}, fmt.Sprintf("transaction %s in shard %d", txHash, node.ShardCoordinator.SelfId()))
+	if err != nil {
+		return "", err
+	}
+
+	return txHash, nil
+}
+
+// SetBalance sets the balance of the provided address directly in the state of the address's shard. The change is
+// committed outside of any block, so the state of the node no longer matches the root hash of its last block and the
+// other nodes never see it. It is only allowed when the simulator was created with EnableUnsafeSetBalance
+func (s *simulator) SetBalance(address []byte, balance *big.Int) error {
+	if !s.enableUnsafeSetBalance {
+		return ErrUnsafeSetBalanceNotEnabled
+	}
+	if balance == nil {
+		return ErrNilBalance
+	}
+	if balance.Sign() < 0 {
+		return ErrNegativeBalance
+	}
+
+	s.mutOperations.Lock()
+	defer s.mutOperations.Unlock()
+
+	if s.closed {
+		return ErrSimulatorClosed
+	}
+
+	node, err := s.getNodeOfAddress(address)
+	if err != nil {
+		return err
+	}
+
+	account, err := node.AccntState.LoadAccount(address)
+	if err != nil {
+		return err
+	}
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return fmt.Errorf("%w for address %s", state.ErrWrongTypeAssertion, hex.EncodeToString(address))
+	}
+
+	difference := big.NewInt(0).Sub(balance, userAccount.GetBalance())
+	err = userAccount.AddToBalance(difference)
+	if err != nil {
+		return err
+	}
+
+	err = node.AccntState.SaveAccount(userAccount)
+	if err != nil {
+		return err
+	}
+
+	_, err = node.AccntState.Commit()
+
+	return err
+}
+
+func (s *simulator) getNodeOfAddress(address []byte) (*integrationTests.TestProcessorNodeWithTestWebServer, error) {
+	shardID := s.metaNode.ShardCoordinator.ComputeId(address)
+
+	return s.getNode(shardID)
+}
+
+// GetNode returns the node handling the provided shard
+func (s *simulator) GetNode(shardID uint32) (*integrationTests.TestProcessorNodeWithTestWebServer, error) {
+	return s.getNode(shardID)
+}
+
+func (s *simulator) getNode(shardID uint32) (*integrationTests.TestProcessorNodeWithTestWebServer, error) {
+	node, found := s.nodesByShard[shardID]
+	if !found {
+		return nil, fmt.Errorf("%w for shard %d", ErrNodeNotFound, shardID)
+	}
+
+	return node, nil
+}
+
+// GetShardIDs returns the shards handled by the simulator, the metachain being the last one
+func (s *simulator) GetShardIDs() []uint32 {
+	shardIDs := make([]uint32, 0, len(s.nodesByShard))
+	for shardID := range s.nodesByShard {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	return shardIDs
+}
+
+// CurrentRound returns the round of the last generated blocks
+func (s *simulator) CurrentRound() uint64 {
+	s.mutOperations.Lock()
+	defer s.mutOperations.Unlock()
+
+	return s.currentRound
+}
+
+// CurrentEpoch returns the current epoch of the metachain
+func (s *simulator) CurrentEpoch() uint32 {
+	s.mutOperations.Lock()
+	defer s.mutOperations.Unlock()
+
+	return s.metaNode.EpochStartTrigger.Epoch()
+}
+
+// Close closes the messengers of all the nodes
+func (s *simulator) Close() error {
+	s.mutOperations.Lock()
+	defer s.mutOperations.Unlock()
+
+	s.closed = true
+
+	var lastError error
+	for _, node := range s.nodes {
+		err := node.Messenger.Close()
+		if err != nil {
+			lastError = err
+		}
+	}
+
+	return lastError
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *simulator) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,192 @@
+package chainSimulator
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsChainSimulator() ArgsChainSimulator {
+	return ArgsChainSimulator{
+		NumOfShards:     2,
+		RoundsPerEpoch:  10,
+		ApiRoutesConfig: integrationTests.CreateDefaultTestApiRoutesConfig(),
+	}
+}
+
+func createSignedMoveBalanceTx(
+	sender *integrationTests.TestWalletAccount,
+	receiver []byte,
+	value *big.Int,
+) *transaction.Transaction {
+	tx := &transaction.Transaction{
+		Nonce:    sender.Nonce,
+		Value:    value,
+		RcvAddr:  receiver,
+		SndAddr:  sender.Address,
+		GasPrice: integrationTests.MinTxGasPrice,
+		GasLimit: integrationTests.MinTxGasLimit,
+		ChainID:  integrationTests.ChainID,
+		Version:  integrationTests.MinTransactionVersion,
+	}
+	txBuff, _ := tx.GetDataForSigning(integrationTests.TestAddressPubkeyConverter, integrationTests.TestTxSignMarshalizer)
+	tx.Signature, _ = sender.SingleSigner.Sign(sender.SkTxSign, txBuff)
+	sender.Nonce++
+
+	return tx
+}
+
+func getBalance(t *testing.T, s *simulator, address []byte) *big.Int {
+	node, err := s.getNodeOfAddress(address)
+	require.Nil(t, err)
+
+	account, err := node.AccntState.GetExistingAccount(address)
+	if errors.Is(err, state.ErrAccNotFound) {
+		return big.NewInt(0)
+	}
+	require.Nil(t, err)
+
+	return account.(state.UserAccountHandler).GetBalance()
+}
+
+func TestNewChainSimulator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of shards", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsChainSimulator()
+		args.NumOfShards = 0
+		s, err := NewChainSimulator(args)
+		require.Nil(t, s)
+		require.Equal(t, ErrInvalidNumOfShards, err)
+	})
+	t.Run("invalid rounds per epoch", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsChainSimulator()
+		args.RoundsPerEpoch = 1
+		s, err := NewChainSimulator(args)
+		require.Nil(t, s)
+		require.True(t, errors.Is(err, ErrInvalidRoundsPerEpoch))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := NewChainSimulator(createArgsChainSimulator())
+		require.Nil(t, err)
+		require.False(t, s.IsInterfaceNil())
+		defer func() {
+			_ = s.Close()
+		}()
+
+		require.Equal(t, []uint32{0, 1, core.MetachainShardId}, s.GetShardIDs())
+		require.Equal(t, uint64(0), s.CurrentRound())
+		require.Equal(t, uint32(0), s.CurrentEpoch())
+
+		_, err = s.GetNode(2)
+		require.True(t, errors.Is(err, ErrNodeNotFound))
+	})
+}
+
+func TestChainSimulator_GenerateBlocksShouldExecuteCrossShardTransactions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	args := createArgsChainSimulator()
+	args.EnableUnsafeSetBalance = true
+	s, err := NewChainSimulator(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = s.Close()
+	}()
+
+	node0, _ := s.GetNode(0)
+	sender := integrationTests.CreateTestWalletAccount(node0.ShardCoordinator, 0)
+	receiver := integrationTests.CreateTestWalletAccount(node0.ShardCoordinator, 1)
+
+	initialBalance := big.NewInt(1000000000000000000)
+	err = s.SetBalance(sender.Address, initialBalance)
+	require.Nil(t, err)
+	require.Equal(t, initialBalance, getBalance(t, s, sender.Address))
+
+	value := big.NewInt(1000)
+	tx := createSignedMoveBalanceTx(sender, receiver.Address, value)
+	_, err = s.SendTransaction(tx)
+	require.Nil(t, err)
+
+	err = s.GenerateBlocks(4)
+	require.Nil(t, err)
+	require.Equal(t, uint64(4), s.CurrentRound())
+
+	require.Equal(t, value, getBalance(t, s, receiver.Address))
+	txFee := node0.EconomicsData.ComputeTxFee(tx)
+	expectedSenderBalance := big.NewInt(0).Sub(initialBalance, value)
+	expectedSenderBalance.Sub(expectedSenderBalance, txFee)
+	require.Equal(t, expectedSenderBalance, getBalance(t, s, sender.Address))
+}
+
+func TestChainSimulator_GenerateBlocksUntilEpochIsReached(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	s, err := NewChainSimulator(createArgsChainSimulator())
+	require.Nil(t, err)
+	defer func() {
+		_ = s.Close()
+	}()
+
+	err = s.GenerateBlocksUntilEpochIsReached(2)
+	require.Nil(t, err)
+	require.Equal(t, uint32(2), s.CurrentEpoch())
+
+	for _, shardID := range s.GetShardIDs() {
+		node, _ := s.GetNode(shardID)
+		require.Equal(t, uint32(2), node.EpochStartTrigger.Epoch())
+	}
+
+	err = s.GenerateBlocksUntilEpochIsReached(1)
+	require.True(t, errors.Is(err, ErrInvalidEpoch))
+}
+
+func TestChainSimulator_ClosedSimulatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	s, _ := NewChainSimulator(createArgsChainSimulator())
+	_ = s.Close()
+
+	err := s.GenerateBlocks(1)
+	require.Equal(t, ErrSimulatorClosed, err)
+
+	err = s.GenerateBlocks(0)
+	require.Equal(t, ErrInvalidNumOfBlocks, err)
+
+	err = s.SetBalance([]byte("address"), big.NewInt(1))
+	require.Equal(t, ErrUnsafeSetBalanceNotEnabled, err)
+}
+
+func TestChainSimulator_SetBalanceShouldCheckTheArguments(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsChainSimulator()
+	args.EnableUnsafeSetBalance = true
+	s, _ := NewChainSimulator(args)
+	_ = s.Close()
+
+	err := s.SetBalance([]byte("address"), nil)
+	require.Equal(t, ErrNilBalance, err)
+
+	err = s.SetBalance([]byte("address"), big.NewInt(-1))
+	require.Equal(t, ErrNegativeBalance, err)
+
+	err = s.SetBalance([]byte("address"), big.NewInt(1))
+	require.Equal(t, ErrSimulatorClosed, err)
+}
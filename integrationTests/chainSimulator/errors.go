@@ -0,0 +1,39 @@
+package chainSimulator
+
+import "errors"
+
+// ErrInvalidNumOfShards signals that an invalid number of shards was provided
+var ErrInvalidNumOfShards = errors.New("invalid number of shards")
+
+// ErrInvalidRoundsPerEpoch signals that an invalid number of rounds per epoch was provided
+var ErrInvalidRoundsPerEpoch = errors.New("invalid rounds per epoch")
+
+// ErrInvalidNumOfBlocks signals that an invalid number of blocks was provided
+var ErrInvalidNumOfBlocks = errors.New("invalid number of blocks")
+
+// ErrInvalidEpoch signals that an invalid epoch was provided
+var ErrInvalidEpoch = errors.New("invalid epoch")
+
+// ErrNodeNotFound signals that no node of the simulator handles the provided shard
+var ErrNodeNotFound = errors.New("node not found")
+
+// ErrBlockNotProposed signals that a node was not able to propose its block
+var ErrBlockNotProposed = errors.New("block not proposed")
+
+// ErrBlockNotPropagated signals that a proposed block did not reach the nodes in due time
+var ErrBlockNotPropagated = errors.New("block not propagated")
+
+// ErrEpochNotReached signals that the targeted epoch was not reached in the expected number of rounds
+var ErrEpochNotReached = errors.New("epoch not reached")
+
+// ErrNilBalance signals that a nil balance was provided
+var ErrNilBalance = errors.New("nil balance")
+
+// ErrNegativeBalance signals that a negative balance was provided
+var ErrNegativeBalance = errors.New("negative balance")
+
+// ErrSimulatorClosed signals that an operation was attempted on a closed simulator
+var ErrSimulatorClosed = errors.New("simulator closed")
+
+// ErrUnsafeSetBalanceNotEnabled signals that the balance was set on a simulator not allowing the unsafe state changes
+var ErrUnsafeSetBalanceNotEnabled = errors.New("unsafe set balance is not enabled")
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
@@ -53,16 +54,21 @@ type NetworkShardingUpdater interface {
 
 // Facade is the node facade used to decouple the node implementation with the web server. Used in integration tests
 type Facade interface {
-	GetBalance(address string) (*big.Int, error)
-	GetUsername(address string) (string, error)
-	GetValueForKey(address string, key string) (string, error)
-	GetAccount(address string) (dataApi.AccountResponse, error)
-	GetESDTData(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error)
-	GetNFTTokenIDsRegisteredByAddress(address string) ([]string, error)
-	GetESDTsWithRole(address string, role string) ([]string, error)
-	GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error)
-	GetESDTsRoles(address string) (map[string][]string, error)
-	GetKeyValuePairs(address string) (map[string]string, error)
+	GetBalance(address string, options common.AccountQueryOptions) (*big.Int, error)
+	GetUsername(address string, options common.AccountQueryOptions) (string, error)
+	GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error)
+	GetAccount(address string, options common.AccountQueryOptions) (dataApi.AccountResponse, error)
+	GetESDTData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*esdt.ESDigitalToken, error)
+	GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) ([]string, error)
+	GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) ([]string, error)
+	GetAllESDTTokens(address string, options common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error)
+	GetESDTsRoles(address string, options common.AccountQueryOptions) (map[string][]string, error)
+	GetKeyValuePairs(address string, options common.AccountQueryOptions) (map[string]string, error)
+	GetAddressTransactions(address string, from uint64, size uint64) (*common.AddressTransactionsResponse, error)
+	GetTransactionsPool(options common.TxPoolQueryOptions) (*common.TxPoolTransactionsResponse, error)
+	GetTransactionsPoolForSender(address string) (*common.TxPoolSenderResponse, error)
+	GetTransactionsPoolStatistics() (*common.TxPoolStatisticsResponse, error)
+	GetEvictedTransactionFromPool(txHash string) (*common.TxPoolEvictedTransaction, error)
 	GetBlockByHash(hash string, withTxs bool) (*dataApi.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*dataApi.Block, error)
 	GetBlockByRound(round uint64, withTxs bool) (*dataApi.Block, error)
@@ -77,6 +83,8 @@ type Facade interface {
 	StatusMetrics() external.StatusMetricsHandler
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetPeersReputation() ([]*common.PeerReputation, error)
+	ReloadAntifloodConfig() (*config.AntifloodConfig, error)
 	GetNumCheckpointsFromAccountState() uint32
 	GetNumCheckpointsFromPeerState() uint32
 	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
@@ -85,6 +93,10 @@ type Facade interface {
 	ValidateTransactionForSimulation(tx *transaction.Transaction, bypassSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	SimulateTransactionWithOptions(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	SimulateTransactionBundleExecution(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	GetMaxTxBundleSize() uint32
+	TraceTransaction(hash string) (*txSimData.SimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
@@ -96,5 +108,10 @@ type Facade interface {
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetProofDataTrieMultiKey(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiKeyProofResponse, error)
+	GetProofDataTrieRange(rootHash string, address string, startKey string, endKey string, maxNumKeys int) (*common.GetProofResponse, *common.GetRangeProofResponse, error)
+	VerifyProofDataTrieMultiKey(rootHash string, address string, keys []string, proof [][]byte) ([]core.KeyValueHolder, error)
+	VerifyProofDataTrieRange(rootHash string, address string, startKey string, endKey string, proof [][]byte) ([]core.KeyValueHolder, error)
+	GetStateStatistics(rootHash string, numLargestDataTries int) (*common.StateStatistics, error)
 	IsInterfaceNil() bool
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/factory"
 	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
@@ -123,13 +124,13 @@ func createProcessors(peers []p2p.Messenger, topic string, idxBadPeers []int, id
 		var err error
 
 		if intInSlice(i, idxBadPeers) {
-			antifloodComponents, err = factory.NewP2PAntiFloodComponents(ctx, createDisabledConfig(), &statusHandlerMock.AppStatusHandlerStub{}, peers[i].ID())
+			antifloodComponents, err = factory.NewP2PAntiFloodComponents(ctx, createDisabledConfig(), &statusHandlerMock.AppStatusHandlerStub{}, &statusHandlerMock.MetricsHandlerStub{}, peers[i].ID(), &disabled.PeerReputationHandler{})
 			log.LogIfError(err)
 		}
 
 		if intInSlice(i, idxGoodPeers) {
 			statusHandler := &statusHandlerMock.AppStatusHandlerStub{}
-			antifloodComponents, err = factory.NewP2PAntiFloodComponents(ctx, createWorkableConfig(), statusHandler, peers[i].ID())
+			antifloodComponents, err = factory.NewP2PAntiFloodComponents(ctx, createWorkableConfig(), statusHandler, &statusHandlerMock.MetricsHandlerStub{}, peers[i].ID(), &disabled.PeerReputationHandler{})
 			log.LogIfError(err)
 		}
 
@@ -137,6 +138,7 @@ func createProcessors(peers []p2p.Messenger, topic string, idxBadPeers []int, id
 			antifloodComponents.BlacklistHandler,
 			antifloodComponents.PubKeysCacher,
 			&mock.PeerShardMapperStub{},
+			&disabled.PeerReputationHandler{},
 		)
 
 		err = peers[i].SetPeerDenialEvaluator(pde)
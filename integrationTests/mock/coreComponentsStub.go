@@ -33,6 +33,7 @@ type CoreComponentsStub struct {
 	MinTransactionVersionCalled        func() uint32
 	StatusHandlerUtilsField            nodeFactory.StatusHandlersUtils
 	StatusHandlerField                 core.AppStatusHandler
+	MetricsHandlerField                common.MetricsHandler
 	WatchdogField                      core.WatchdogTimer
 	AlarmSchedulerField                core.TimersScheduler
 	SyncTimerField                     ntp.SyncTimer
@@ -81,6 +82,11 @@ func (ccs *CoreComponentsStub) StatusHandler() core.AppStatusHandler {
 	return ccs.StatusHandlerField
 }
 
+// MetricsHandler -
+func (ccs *CoreComponentsStub) MetricsHandler() common.MetricsHandler {
+	return ccs.MetricsHandlerField
+}
+
 // Watchdog -
 func (ccs *CoreComponentsStub) Watchdog() core.WatchdogTimer {
 	return ccs.WatchdogField
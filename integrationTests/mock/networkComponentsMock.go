@@ -13,6 +13,8 @@ type NetworkComponentsStub struct {
 	OutputAntiFlood      factory.P2PAntifloodHandler
 	PeerBlackList        process.PeerBlackListCacher
 	PeerHonesty          factory.PeerHonestyHandler
+	PeerReputation       process.PeerReputationHandler
+	AntifloodConfig      process.AntifloodConfigHandler
 	PreferredPeersHolder factory.PreferredPeersHolderHandler
 }
 
@@ -26,6 +28,16 @@ func (ncs *NetworkComponentsStub) PeerHonestyHandler() factory.PeerHonestyHandle
 	return ncs.PeerHonesty
 }
 
+// PeerReputationHandler -
+func (ncs *NetworkComponentsStub) PeerReputationHandler() process.PeerReputationHandler {
+	return ncs.PeerReputation
+}
+
+// AntifloodConfigHandler -
+func (ncs *NetworkComponentsStub) AntifloodConfigHandler() process.AntifloodConfigHandler {
+	return ncs.AntifloodConfig
+}
+
 // Create -
 func (ncs *NetworkComponentsStub) Create() error {
 	return nil
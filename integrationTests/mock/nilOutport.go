@@ -41,6 +41,10 @@ func (n *nilOutport) SaveAccounts(_ uint64, _ []data.UserAccountHandler) {
 func (n *nilOutport) FinalizedBlock(_ []byte) {
 }
 
+// ReplayBlock -
+func (n *nilOutport) ReplayBlock(_ *indexer.ArgsSaveBlockData) {
+}
+
 // Close -
 func (n *nilOutport) Close() error {
 	return nil
@@ -56,7 +60,17 @@ func (n *nilOutport) SubscribeDriver(_ outport.Driver) error {
 	return nil
 }
 
+// SubscribeLiveDriver -
+func (n *nilOutport) SubscribeLiveDriver(_ outport.Driver) error {
+	return nil
+}
+
 // HasDrivers -
 func (n *nilOutport) HasDrivers() bool {
 	return false
 }
+
+// HasReplayDrivers -
+func (n *nilOutport) HasReplayDrivers() bool {
+	return false
+}
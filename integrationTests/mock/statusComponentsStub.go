@@ -4,11 +4,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go/common/statistics"
 	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
 )
 
 // StatusComponentsStub -
 type StatusComponentsStub struct {
 	Outport              outport.OutportHandler
+	Subscriptions        subscriptions.Hub
 	SoftwareVersionCheck statistics.SoftwareVersionChecker
 	AppStatusHandler     core.AppStatusHandler
 }
@@ -33,6 +35,11 @@ func (scs *StatusComponentsStub) OutportHandler() outport.OutportHandler {
 	return scs.Outport
 }
 
+// SubscriptionsHub -
+func (scs *StatusComponentsStub) SubscriptionsHub() subscriptions.Hub {
+	return scs.Subscriptions
+}
+
 // SoftwareVersionChecker -
 func (scs *StatusComponentsStub) SoftwareVersionChecker() statistics.SoftwareVersionChecker {
 	return scs.SoftwareVersionCheck
@@ -241,6 +241,7 @@ func testNodeStartsInEpoch(t *testing.T, shardID uint32, expectedHighestRound ui
 		&testscommon.PathManagerStub{},
 		notifier.NewEpochStartSubscriptionHandler(),
 		&nodeTypeProviderMock.NodeTypeProviderStub{},
+		&statusHandlerMock.MetricsHandlerStub{},
 		0,
 		false,
 	)
@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/floodPreventers"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/ElrondNetwork/elrond-go/storage/timecache"
@@ -147,6 +148,7 @@ func applyBlacklistComponents(peers []p2p.Messenger, blacklistHandler []process.
 			blacklistHandler[idx],
 			&mock.TimeCacheStub{},
 			&mock.PeerShardMapperStub{},
+			&disabled.PeerReputationHandler{},
 		)
 
 		_ = peer.SetPeerDenialEvaluator(pde)
@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/state/syncer"
+	disabledMetrics "github.com/ElrondNetwork/elrond-go/statusHandler/metrics/disabled"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
@@ -130,7 +131,7 @@ func TestNode_RequestInterceptTrieNodesWithMessenger(t *testing.T) {
 	nilRootHash, _ := requesterTrie.RootHash()
 
 	timeout := 10 * time.Second
-	tss := statistics.NewTrieSyncStatistics()
+	tss, _ := statistics.NewTrieSyncStatistics(&disabledMetrics.MetricsHandler{})
 	arg := trie.ArgTrieSyncer{
 		RequestHandler:            nRequester.RequestHandler,
 		InterceptedNodes:          nRequester.DataPool.TrieNodes(),
@@ -235,7 +236,7 @@ func TestNode_RequestInterceptTrieNodesWithMessengerNotSyncingShouldErr(t *testi
 	requesterTrie := nRequester.TrieContainer.Get([]byte(trieFactory.UserAccountTrie))
 
 	timeout := 10 * time.Second
-	tss := statistics.NewTrieSyncStatistics()
+	tss, _ := statistics.NewTrieSyncStatistics(&disabledMetrics.MetricsHandler{})
 	arg := trie.ArgTrieSyncer{
 		RequestHandler:            nRequester.RequestHandler,
 		InterceptedNodes:          nRequester.DataPool.TrieNodes(),
@@ -287,7 +288,15 @@ func TestMultipleDataTriesSyncSmallValues(t *testing.T) {
 		t.Skip("this is not a short test")
 	}
 
-	testMultipleDataTriesSync(t, 1000, 50, 32)
+	testMultipleDataTriesSync(t, 1000, 50, 32, 2)
+}
+
+func TestMultipleDataTriesSyncSmallValuesAdaptiveSyncer(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	testMultipleDataTriesSync(t, 1000, 50, 32, 3)
 }
 
 func TestMultipleDataTriesSyncLargeValues(t *testing.T) {
@@ -295,10 +304,10 @@ func TestMultipleDataTriesSyncLargeValues(t *testing.T) {
 		t.Skip("this is not a short test")
 	}
 
-	testMultipleDataTriesSync(t, 3, 3, 1<<21)
+	testMultipleDataTriesSync(t, 3, 3, 1<<21, 2)
 }
 
-func testMultipleDataTriesSync(t *testing.T, numAccounts int, numDataTrieLeaves int, valSize int) {
+func testMultipleDataTriesSync(t *testing.T, numAccounts int, numDataTrieLeaves int, valSize int, trieSyncerVersion int) {
 	if testing.Short() {
 		t.Skip("this is not a short test")
 	}
@@ -349,6 +358,11 @@ func testMultipleDataTriesSync(t *testing.T, numAccounts int, numDataTrieLeaves
 	requesterTrie := nRequester.TrieContainer.Get([]byte(trieFactory.UserAccountTrie))
 	nilRootHash, _ := requesterTrie.RootHash()
 
+	trieSyncConfig := testscommon.GetGeneralConfig().TrieSync
+	trieSyncConfig.TrieSyncerVersion = trieSyncerVersion
+	requestsBudget, err := trieFactory.CreateSyncRequestsBudget(trieSyncConfig)
+	require.Nil(t, err)
+
 	thr, _ := throttler.NewNumGoRoutinesThrottler(50)
 	syncerArgs := syncer.ArgsNewUserAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
@@ -360,7 +374,9 @@ func testMultipleDataTriesSync(t *testing.T, numAccounts int, numDataTrieLeaves
 			Cacher:                    nRequester.DataPool.TrieNodes(),
 			MaxTrieLevelInMemory:      200,
 			MaxHardCapForMissingNodes: 5000,
-			TrieSyncerVersion:         2,
+			TrieSyncerVersion:         trieSyncerVersion,
+			RequestsBudget:            requestsBudget,
+			MetricsHandler:            &disabledMetrics.MetricsHandler{},
 		},
 		ShardId:   shardID,
 		Throttler: thr,
@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/core/partitioning"
 	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go-core/core/random"
 	"github.com/ElrondNetwork/elrond-go-core/core/versioning"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	dataBlock "github.com/ElrondNetwork/elrond-go-core/data/block"
@@ -39,6 +40,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/containers"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/resolverscontainer"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestHandlers"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/throughput"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/epochStart/metachain"
 	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
@@ -72,7 +74,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	sync2 "github.com/ElrondNetwork/elrond-go/process/sync"
+	antifloodDisabled "github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/track"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/process/transactionLog"
@@ -277,6 +281,7 @@ type TestProcessorNode struct {
 	BootstrapStorer          *mock.BoostrapStorerMock
 	StorageBootstrapper      *mock.StorageBootstrapperMock
 	RequestedItemsHandler    dataRetriever.RequestedItemsHandler
+	PeersThroughputTracker   dataRetriever.PeersThroughputHandler
 	WhiteListHandler         process.WhiteListHandler
 	WhiteListerVerifiedTxs   process.WhiteListHandler
 	NetworkShardingCollector consensus.NetworkShardingCollector
@@ -339,6 +344,15 @@ func newBaseTestProcessorNode(
 	maxShards uint32,
 	nodeShardId uint32,
 	txSignPrivKeyShardId uint32,
+) *TestProcessorNode {
+	return newBaseTestProcessorNodeWithMessenger(maxShards, nodeShardId, txSignPrivKeyShardId, CreateMessengerWithNoDiscovery())
+}
+
+func newBaseTestProcessorNodeWithMessenger(
+	maxShards uint32,
+	nodeShardId uint32,
+	txSignPrivKeyShardId uint32,
+	messenger p2p.Messenger,
 ) *TestProcessorNode {
 	shardCoordinator, _ := sharding.NewMultiShardCoordinator(maxShards, nodeShardId)
 
@@ -390,8 +404,6 @@ func newBaseTestProcessorNode(
 		},
 	}
 
-	messenger := CreateMessengerWithNoDiscovery()
-
 	tpn := &TestProcessorNode{
 		ShardCoordinator:        shardCoordinator,
 		Messenger:               messenger,
@@ -432,6 +444,19 @@ func NewTestProcessorNode(
 	return tpn
 }
 
+// NewTestProcessorNodeWithCustomMessenger returns a new TestProcessorNode instance using the provided messenger
+func NewTestProcessorNodeWithCustomMessenger(
+	maxShards uint32,
+	nodeShardId uint32,
+	txSignPrivKeyShardId uint32,
+	messenger p2p.Messenger,
+) *TestProcessorNode {
+	tpn := newBaseTestProcessorNodeWithMessenger(maxShards, nodeShardId, txSignPrivKeyShardId, messenger)
+	tpn.initTestNode()
+
+	return tpn
+}
+
 // NewTestProcessorNodeWithStorageTrieAndGasModel returns a new TestProcessorNode instance with a storage-based trie
 // and gas model
 func NewTestProcessorNodeWithStorageTrieAndGasModel(
@@ -526,11 +551,18 @@ func NewTestProcessorNodeWithFullGenesis(
 	tpn.initInterceptors()
 	tpn.initInnerProcessors(arwenConfig.MakeGasMapForTests())
 	argsNewScQueryService := smartContract.ArgsNewSCQueryService{
-		VmContainer:       tpn.VMContainer,
-		EconomicsFee:      tpn.EconomicsData,
-		BlockChainHook:    tpn.BlockchainHook,
-		BlockChain:        tpn.BlockChain,
-		ArwenChangeLocker: tpn.ArwenChangeLocker,
+		VmContainer:              tpn.VMContainer,
+		EconomicsFee:             tpn.EconomicsData,
+		BlockChainHook:           tpn.BlockchainHook,
+		BlockChain:               tpn.BlockChain,
+		ArwenChangeLocker:        tpn.ArwenChangeLocker,
+		AccountsAdapter:          tpn.AccntState,
+		EpochNotifier:            forking.NewGenericEpochNotifier(),
+		StorageService:           tpn.Storage,
+		Marshalizer:              TestMarshalizer,
+		Uint64ByteSliceConverter: TestUint64Converter,
+		ShardCoordinator:         tpn.ShardCoordinator,
+		ArwenVersionProvider:     &testscommon.ArwenVersionProviderStub{},
 	}
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 	tpn.initBlockProcessor(stateCheckpointModulus)
@@ -697,11 +729,18 @@ func (tpn *TestProcessorNode) initTestNode() {
 	tpn.initInterceptors()
 	tpn.initInnerProcessors(arwenConfig.MakeGasMapForTests())
 	argsNewScQueryService := smartContract.ArgsNewSCQueryService{
-		VmContainer:       tpn.VMContainer,
-		EconomicsFee:      tpn.EconomicsData,
-		BlockChainHook:    tpn.BlockchainHook,
-		BlockChain:        tpn.BlockChain,
-		ArwenChangeLocker: tpn.ArwenChangeLocker,
+		VmContainer:              tpn.VMContainer,
+		EconomicsFee:             tpn.EconomicsData,
+		BlockChainHook:           tpn.BlockchainHook,
+		BlockChain:               tpn.BlockChain,
+		ArwenChangeLocker:        tpn.ArwenChangeLocker,
+		AccountsAdapter:          tpn.AccntState,
+		EpochNotifier:            forking.NewGenericEpochNotifier(),
+		StorageService:           tpn.Storage,
+		Marshalizer:              TestMarshalizer,
+		Uint64ByteSliceConverter: TestUint64Converter,
+		ShardCoordinator:         tpn.ShardCoordinator,
+		ArwenVersionProvider:     &testscommon.ArwenVersionProviderStub{},
 	}
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 	tpn.initBlockProcessor(stateCheckpointModulus)
@@ -802,6 +841,7 @@ func (tpn *TestProcessorNode) createFullSCQueryService() {
 		DataPool:           tpn.DataPool,
 		CompiledSCPool:     smartContractsCache,
 		NilCompiledSCStore: true,
+		ExecutionTracer:    tracing.NewDisabledExecutionTracer(),
 	}
 
 	if tpn.ShardCoordinator.SelfId() == core.MetachainShardId {
@@ -896,11 +936,18 @@ func (tpn *TestProcessorNode) createFullSCQueryService() {
 
 	_ = vmcommonBuiltInFunctions.SetPayableHandler(builtInFuncs, vmFactory.BlockChainHookImpl())
 	argsNewScQueryService := smartContract.ArgsNewSCQueryService{
-		VmContainer:       vmContainer,
-		EconomicsFee:      tpn.EconomicsData,
-		BlockChainHook:    vmFactory.BlockChainHookImpl(),
-		BlockChain:        tpn.BlockChain,
-		ArwenChangeLocker: tpn.ArwenChangeLocker,
+		VmContainer:              vmContainer,
+		EconomicsFee:             tpn.EconomicsData,
+		BlockChainHook:           vmFactory.BlockChainHookImpl(),
+		BlockChain:               tpn.BlockChain,
+		ArwenChangeLocker:        tpn.ArwenChangeLocker,
+		AccountsAdapter:          tpn.AccntState,
+		EpochNotifier:            forking.NewGenericEpochNotifier(),
+		StorageService:           tpn.Storage,
+		Marshalizer:              TestMarshalizer,
+		Uint64ByteSliceConverter: TestUint64Converter,
+		ShardCoordinator:         tpn.ShardCoordinator,
+		ArwenVersionProvider:     &testscommon.ArwenVersionProviderStub{},
 	}
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 }
@@ -911,11 +958,18 @@ func (tpn *TestProcessorNode) InitializeProcessors(gasMap map[string]map[string]
 	tpn.initBlockTracker()
 	tpn.initInnerProcessors(gasMap)
 	argsNewScQueryService := smartContract.ArgsNewSCQueryService{
-		VmContainer:       tpn.VMContainer,
-		EconomicsFee:      tpn.EconomicsData,
-		BlockChainHook:    tpn.BlockchainHook,
-		BlockChain:        tpn.BlockChain,
-		ArwenChangeLocker: tpn.ArwenChangeLocker,
+		VmContainer:              tpn.VMContainer,
+		EconomicsFee:             tpn.EconomicsData,
+		BlockChainHook:           tpn.BlockchainHook,
+		BlockChain:               tpn.BlockChain,
+		ArwenChangeLocker:        tpn.ArwenChangeLocker,
+		AccountsAdapter:          tpn.AccntState,
+		EpochNotifier:            forking.NewGenericEpochNotifier(),
+		StorageService:           tpn.Storage,
+		Marshalizer:              TestMarshalizer,
+		Uint64ByteSliceConverter: TestUint64Converter,
+		ShardCoordinator:         tpn.ShardCoordinator,
+		ArwenVersionProvider:     &testscommon.ArwenVersionProviderStub{},
 	}
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 	tpn.initBlockProcessor(stateCheckpointModulus)
@@ -1179,6 +1233,7 @@ func (tpn *TestProcessorNode) initInterceptors() {
 			ArgumentsParser:         smartContract.NewArgumentParser(),
 			PreferredPeersHolder:    &p2pmocks.PeersHolderStub{},
 			RequestHandler:          tpn.RequestHandler,
+			PeersThroughputRecorder: tpn.PeersThroughputTracker,
 		}
 		interceptorContainerFactory, _ := interceptorscontainer.NewMetaInterceptorsContainerFactory(metaInterceptorContainerFactoryArgs)
 
@@ -1235,6 +1290,7 @@ func (tpn *TestProcessorNode) initInterceptors() {
 			ArgumentsParser:         smartContract.NewArgumentParser(),
 			PreferredPeersHolder:    &p2pmocks.PeersHolderStub{},
 			RequestHandler:          tpn.RequestHandler,
+			PeersThroughputRecorder: tpn.PeersThroughputTracker,
 		}
 		interceptorContainerFactory, _ := interceptorscontainer.NewShardInterceptorsContainerFactory(shardIntereptorContainerFactoryArgs)
 
@@ -1249,6 +1305,7 @@ func (tpn *TestProcessorNode) initResolvers() {
 	dataPacker, _ := partitioning.NewSimpleDataPacker(TestMarshalizer)
 
 	_ = tpn.Messenger.CreateTopic(common.ConsensusTopic+tpn.ShardCoordinator.CommunicationIdentifier(tpn.ShardCoordinator.SelfId()), true)
+	tpn.PeersThroughputTracker, _ = throughput.NewPeersThroughputTracker(&random.ConcurrentSafeIntRandomizer{})
 
 	resolverContainerFactory := resolverscontainer.FactoryArgs{
 		ShardCoordinator:            tpn.ShardCoordinator,
@@ -1265,6 +1322,7 @@ func (tpn *TestProcessorNode) initResolvers() {
 		NumConcurrentResolvingJobs:  10,
 		CurrentNetworkEpochProvider: &mock.CurrentNetworkEpochProviderStub{},
 		PreferredPeersHolder:        &p2pmocks.PeersHolderStub{},
+		PeersThroughputHandler:      tpn.PeersThroughputTracker,
 		ResolverConfig: config.ResolverConfig{
 			NumCrossShardPeers:  2,
 			NumIntraShardPeers:  1,
@@ -1381,6 +1439,7 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 		DataPool:           tpn.DataPool,
 		CompiledSCPool:     tpn.DataPool.SmartContracts(),
 		NilCompiledSCStore: true,
+		ExecutionTracer:    tracing.NewDisabledExecutionTracer(),
 	}
 	esdtTransferParser, _ := parsers.NewESDTTransferParser(TestMarshalizer)
 	maxGasLimitPerBlock := uint64(0xFFFFFFFFFFFFFFFF)
@@ -1449,6 +1508,7 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 		VMOutputCacher:    txcache.NewDisabledCache(),
 		ArwenChangeLocker: tpn.ArwenChangeLocker,
 		EnableEpochs:      tpn.EnableEpochs,
+		ExecutionTracer:   tracing.NewDisabledExecutionTracer(),
 	}
 	sc, _ := smartContract.NewSmartContractProcessor(argsNewScProcessor)
 	tpn.ScProcessor = smartContract.NewTestScProcessor(sc)
@@ -1570,6 +1630,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		DataPool:           tpn.DataPool,
 		CompiledSCPool:     tpn.DataPool.SmartContracts(),
 		NilCompiledSCStore: true,
+		ExecutionTracer:    tracing.NewDisabledExecutionTracer(),
 	}
 
 	var signVerifier vm.MessageSignVerifier
@@ -1677,6 +1738,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		VMOutputCacher:    txcache.NewDisabledCache(),
 		ArwenChangeLocker: tpn.ArwenChangeLocker,
 		EnableEpochs:      tpn.EnableEpochs,
+		ExecutionTracer:   tracing.NewDisabledExecutionTracer(),
 	}
 	scProcessor, _ := smartContract.NewSmartContractProcessor(argsNewScProcessor)
 	tpn.ScProcessor = smartContract.NewTestScProcessor(scProcessor)
@@ -2766,6 +2828,7 @@ func GetDefaultCoreComponents() *mock.CoreComponentsStub {
 		GenesisTimeField:       time.Time{},
 		EpochNotifierField:     &mock.EpochNotifierStub{},
 		TxVersionCheckField:    versioning.NewTxVersionChecker(MinTransactionVersion),
+		MetricsHandlerField:    &statusHandlerMock.MetricsHandlerStub{},
 	}
 }
 
@@ -2862,6 +2925,8 @@ func GetDefaultNetworkComponents() *mock.NetworkComponentsStub {
 		InputAntiFlood:  &mock.P2PAntifloodHandlerStub{},
 		OutputAntiFlood: &mock.P2PAntifloodHandlerStub{},
 		PeerBlackList:   &mock.PeerBlackListCacherStub{},
+		PeerReputation:  &antifloodDisabled.PeerReputationHandler{},
+		AntifloodConfig: &antifloodDisabled.AntifloodConfigHandler{},
 	}
 }
 
@@ -15,11 +15,15 @@ import (
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
 	"github.com/ElrondNetwork/elrond-go/node/trieIterators/factory"
+	"github.com/ElrondNetwork/elrond-go/outport/subscriptions"
+	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts/defaults"
 	"github.com/ElrondNetwork/elrond-vm-common/parsers"
@@ -45,7 +49,29 @@ func NewTestProcessorNodeWithTestWebServer(
 	tpn := newBaseTestProcessorNode(maxShards, nodeShardId, txSignPrivKeyShardId)
 	tpn.initTestNode()
 
-	argFacade := createFacadeArg(tpn)
+	return newTestProcessorNodeWithTestWebServer(tpn, createTestApiConfig())
+}
+
+// NewTestProcessorNodeWithTestWebServerAndMessenger returns a new TestProcessorNodeWithTestWebServer instance using the
+// provided messenger and exposing the provided API routes
+func NewTestProcessorNodeWithTestWebServerAndMessenger(
+	maxShards uint32,
+	nodeShardId uint32,
+	txSignPrivKeyShardId uint32,
+	messenger p2p.Messenger,
+	apiRoutesConfig config.ApiRoutesConfig,
+) *TestProcessorNodeWithTestWebServer {
+
+	tpn := NewTestProcessorNodeWithCustomMessenger(maxShards, nodeShardId, txSignPrivKeyShardId, messenger)
+
+	return newTestProcessorNodeWithTestWebServer(tpn, apiRoutesConfig)
+}
+
+func newTestProcessorNodeWithTestWebServer(
+	tpn *TestProcessorNode,
+	apiRoutesConfig config.ApiRoutesConfig,
+) *TestProcessorNodeWithTestWebServer {
+	argFacade := createFacadeArg(tpn, apiRoutesConfig)
 	facade, err := nodeFacade.NewNodeFacade(argFacade)
 	log.LogIfError(err)
 
@@ -60,17 +86,27 @@ func NewTestProcessorNodeWithTestWebServer(
 
 // DoRequest preforms a test request on the web server, returning the response ready to be parsed
 func (node *TestProcessorNodeWithTestWebServer) DoRequest(request *http.Request) *httptest.ResponseRecorder {
+	resp := httptest.NewRecorder()
+	node.ServeHTTP(resp, request)
+
+	return resp
+}
+
+// ServeHTTP handles the provided request on the web server, so the node can be served as a http.Handler
+func (node *TestProcessorNodeWithTestWebServer) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 	// this is a critical section, serialize each request
 	node.mutWs.Lock()
 	defer node.mutWs.Unlock()
 
-	resp := httptest.NewRecorder()
-	node.ws.ServeHTTP(resp, request)
+	node.ws.ServeHTTP(writer, request)
+}
 
-	return resp
+// CreateDefaultTestApiRoutesConfig returns the API routes configuration used by the test web servers
+func CreateDefaultTestApiRoutesConfig() config.ApiRoutesConfig {
+	return createTestApiConfig()
 }
 
-func createFacadeArg(tpn *TestProcessorNode) nodeFacade.ArgNodeFacade {
+func createFacadeArg(tpn *TestProcessorNode, apiRoutesConfig config.ApiRoutesConfig) nodeFacade.ArgNodeFacade {
 	apiResolver, txSimulator := createFacadeComponents(tpn)
 
 	return nodeFacade.ArgNodeFacade{
@@ -84,24 +120,27 @@ func createFacadeArg(tpn *TestProcessorNode) nodeFacade.ArgNodeFacade {
 			SameSourceResetIntervalInSec: 1,
 			EndpointsThrottlers:          []config.EndpointsThrottlersConfig{},
 		},
-		FacadeConfig:    config.FacadeConfig{},
-		ApiRoutesConfig: createTestApiConfig(),
-		AccountsState:   tpn.AccntState,
-		PeerState:       tpn.PeerState,
-		Blockchain:      tpn.BlockChain,
+		FacadeConfig:      config.FacadeConfig{},
+		ApiRoutesConfig:   apiRoutesConfig,
+		TxSimulatorConfig: config.TxSimulatorConfig{MaxTxBundleSize: 10},
+		AccountsState:     tpn.AccntState,
+		PeerState:         tpn.PeerState,
+		Blockchain:        tpn.BlockChain,
+		SubscriptionsHub:  subscriptions.NewDisabledSubscriptionsHub(),
+		AntifloodReloader: &testscommon.AntifloodConfigReloaderStub{},
 	}
 }
 
 func createTestApiConfig() config.ApiRoutesConfig {
 	routes := map[string][]string{
-		"node":        {"/status", "/metrics", "/heartbeatstatus", "/statistics", "/p2pstatus", "/debug", "/peerinfo"},
+		"node":        {"/status", "/metrics", "/heartbeatstatus", "/statistics", "/p2pstatus", "/debug", "/peerinfo", "/peers/reputation", "/antiflood/reload"},
 		"address":     {"/:address", "/:address/balance", "/:address/username", "/:address/key/:key", "/:address/esdt", "/:address/esdt/:tokenIdentifier"},
 		"hardfork":    {"/trigger"},
 		"network":     {"/status", "/total-staked", "/economics", "/config"},
 		"log":         {"/log"},
 		"validator":   {"/statistics"},
 		"vm-values":   {"/hex", "/string", "/int", "/query"},
-		"transaction": {"/send", "/simulate", "/send-multiple", "/cost", "/:txhash"},
+		"transaction": {"/send", "/simulate", "/simulate-bundle", "/send-multiple", "/cost", "/:txhash", "/:txhash/trace", "/pool", "/pool/by-sender/:address", "/pool/stats", "/pool/evicted/:txhash"},
 		"block":       {"/by-nonce/:nonce", "/by-hash/:hash", "/by-round/:round"},
 	}
 
@@ -187,7 +226,7 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 
 	argsApiResolver := external.ArgNodeApiResolver{
 		SCQueryService:          tpn.SCQueryService,
-		StatusMetricsHandler:    &mock.StatusMetricsStub{},
+		StatusMetricsHandler:    statusHandler.NewStatusMetrics(),
 		TxCostHandler:           txCostHandler,
 		TotalStakedValueHandler: totalStakedValueHandler,
 		DirectStakedListHandler: directStakedListHandler,
@@ -197,6 +236,12 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 	apiResolver, err := external.NewNodeApiResolver(argsApiResolver)
 	log.LogIfError(err)
 
+	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(tpn.AccntState, TestMarshalizer, TestHasher)
+	log.LogIfError(err)
+
+	executionTracer, err := tracing.NewExecutionTracer(TestAddressPubkeyConverter)
+	log.LogIfError(err)
+
 	argSimulator := txsimulator.ArgsTxSimulator{
 		TransactionProcessor:      tpn.TxProcessor,
 		IntermediateProcContainer: tpn.InterimProcContainer,
@@ -205,6 +250,9 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 		Marshalizer:               TestMarshalizer,
 		Hasher:                    TestHasher,
 		VMOutputCacher:            &testscommon.CacherMock{},
+		AccountsOverlay:           readOnlyAccountsDB,
+		ExecutionTracer:           executionTracer,
+		MaxTxBundleSize:           10,
 	}
 
 	txSimulator, err := txsimulator.NewTransactionSimulator(argSimulator)
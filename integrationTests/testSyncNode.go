@@ -137,11 +137,18 @@ func (tpn *TestProcessorNode) initTestNodeWithSync() {
 	tpn.setGenesisBlock()
 	tpn.initNode()
 	argsNewScQueryService := smartContract.ArgsNewSCQueryService{
-		VmContainer:       tpn.VMContainer,
-		EconomicsFee:      tpn.EconomicsData,
-		BlockChainHook:    tpn.BlockchainHook,
-		BlockChain:        tpn.BlockChain,
-		ArwenChangeLocker: tpn.ArwenChangeLocker,
+		VmContainer:              tpn.VMContainer,
+		EconomicsFee:             tpn.EconomicsData,
+		BlockChainHook:           tpn.BlockchainHook,
+		BlockChain:               tpn.BlockChain,
+		ArwenChangeLocker:        tpn.ArwenChangeLocker,
+		AccountsAdapter:          tpn.AccntState,
+		EpochNotifier:            forking.NewGenericEpochNotifier(),
+		StorageService:           tpn.Storage,
+		Marshalizer:              TestMarshalizer,
+		Uint64ByteSliceConverter: TestUint64Converter,
+		ShardCoordinator:         tpn.ShardCoordinator,
+		ArwenVersionProvider:     &testscommon.ArwenVersionProviderStub{},
 	}
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 	tpn.addHandlersForCounters()
@@ -37,6 +37,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	processTransaction "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
@@ -140,11 +141,18 @@ func SetupTestContext(t *testing.T) *TestContext {
 	context.initTxProcessorWithOneSCExecutorWithVMs()
 	context.ScAddress, _ = context.BlockchainHook.NewAddress(context.Owner.Address, context.Owner.Nonce, factory.ArwenVirtualMachine)
 	argsNewSCQueryService := smartContract.ArgsNewSCQueryService{
-		VmContainer:       context.VMContainer,
-		EconomicsFee:      context.EconomicsFee,
-		BlockChainHook:    context.BlockchainHook,
-		BlockChain:        &mock.BlockChainMock{},
-		ArwenChangeLocker: &sync.RWMutex{},
+		VmContainer:              context.VMContainer,
+		EconomicsFee:             context.EconomicsFee,
+		BlockChainHook:           context.BlockchainHook,
+		BlockChain:               &mock.BlockChainMock{},
+		ArwenChangeLocker:        &sync.RWMutex{},
+		AccountsAdapter:          context.Accounts,
+		EpochNotifier:            forking.NewGenericEpochNotifier(),
+		StorageService:           &mock.ChainStorerMock{},
+		Marshalizer:              marshalizer,
+		Uint64ByteSliceConverter: &mock.Uint64ByteSliceConverterMock{},
+		ShardCoordinator:         oneShardCoordinator,
+		ArwenVersionProvider:     &testscommon.ArwenVersionProviderStub{},
 	}
 	context.QueryService, _ = smartContract.NewSCQueryService(argsNewSCQueryService)
 
@@ -258,6 +266,7 @@ func (context *TestContext) initVMAndBlockchainHook() {
 				MaxBatchSize:      100,
 			},
 		},
+		ExecutionTracer: tracing.NewDisabledExecutionTracer(),
 	}
 
 	vmFactoryConfig := config.VirtualMachineConfig{
@@ -327,6 +336,7 @@ func (context *TestContext) initTxProcessorWithOneSCExecutorWithVMs() {
 		EpochNotifier:     forking.NewGenericEpochNotifier(),
 		ArwenChangeLocker: context.ArwenChangeLocker,
 		VMOutputCacher:    txcache.NewDisabledCache(),
+		ExecutionTracer:   tracing.NewDisabledExecutionTracer(),
 	}
 	sc, err := smartContract.NewSmartContractProcessor(argsNewSCProcessor)
 	context.ScProcessor = smartContract.NewTestScProcessor(sc)
@@ -9,12 +9,15 @@ import (
 	"testing"
 
 	vmData "github.com/ElrondNetwork/elrond-go-core/data/vm"
+	"github.com/ElrondNetwork/elrond-go/common/forking"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/vm"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -35,9 +38,16 @@ func TestVmGetShouldReturnValue(t *testing.T) {
 				return uint64(math.MaxUint64)
 			},
 		},
-		BlockChainHook:    &mock.BlockChainHookHandlerMock{},
-		BlockChain:        &mock.BlockChainMock{},
-		ArwenChangeLocker: &sync.RWMutex{},
+		BlockChainHook:           &mock.BlockChainHookHandlerMock{},
+		BlockChain:               &mock.BlockChainMock{},
+		ArwenChangeLocker:        &sync.RWMutex{},
+		AccountsAdapter:          accnts,
+		EpochNotifier:            forking.NewGenericEpochNotifier(),
+		StorageService:           &mock.ChainStorerMock{},
+		Marshalizer:              integrationTests.TestMarshalizer,
+		Uint64ByteSliceConverter: integrationTests.TestUint64Converter,
+		ShardCoordinator:         mock.NewMultiShardsCoordinatorMock(1),
+		ArwenVersionProvider:     &testscommon.ArwenVersionProviderStub{},
 	}
 	service, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
@@ -1,3 +1,4 @@
+//go:build cgo
 // +build cgo
 
 package vm
@@ -42,6 +43,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -235,11 +237,18 @@ func (vmTestContext *VMTestContext) GetVMOutputWithTransientVM(funcName string,
 	}
 
 	argsNewSCQueryService := smartContract.ArgsNewSCQueryService{
-		VmContainer:       vmContainer,
-		EconomicsFee:      feeHandler,
-		BlockChainHook:    blockChainHook,
-		BlockChain:        &mock.BlockChainMock{},
-		ArwenChangeLocker: &sync.RWMutex{},
+		VmContainer:              vmContainer,
+		EconomicsFee:             feeHandler,
+		BlockChainHook:           blockChainHook,
+		BlockChain:               &mock.BlockChainMock{},
+		ArwenChangeLocker:        &sync.RWMutex{},
+		AccountsAdapter:          accnts,
+		EpochNotifier:            forking.NewGenericEpochNotifier(),
+		StorageService:           &mock.ChainStorerMock{},
+		Marshalizer:              testMarshalizer,
+		Uint64ByteSliceConverter: &mock.Uint64ByteSliceConverterMock{},
+		ShardCoordinator:         oneShardCoordinator,
+		ArwenVersionProvider:     &testscommon.ArwenVersionProviderStub{},
 	}
 	scQueryService, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
@@ -424,6 +433,7 @@ func CreateTxProcessorWithOneSCExecutorMockVM(
 		CompiledSCPool:     datapool.SmartContracts(),
 		NilCompiledSCStore: true,
 		ConfigSCStorage:    *defaultStorageConfig(),
+		ExecutionTracer:    tracing.NewDisabledExecutionTracer(),
 	}
 
 	blockChainHook, _ := hooks.NewBlockChainHookImpl(args)
@@ -481,6 +491,7 @@ func CreateTxProcessorWithOneSCExecutorMockVM(
 		EnableEpochs:      enableEpochs,
 		VMOutputCacher:    txcache.NewDisabledCache(),
 		ArwenChangeLocker: arwenChangeLocker,
+		ExecutionTracer:   tracing.NewDisabledExecutionTracer(),
 	}
 	scProcessor, _ := smartContract.NewSmartContractProcessor(argsNewSCProcessor)
 
@@ -524,6 +535,7 @@ func CreateOneSCExecutorMockVM(accnts state.AccountsAdapter) vmcommon.VMExecutio
 		CompiledSCPool:     datapool.SmartContracts(),
 		NilCompiledSCStore: true,
 		ConfigSCStorage:    *defaultStorageConfig(),
+		ExecutionTracer:    tracing.NewDisabledExecutionTracer(),
 	}
 	blockChainHook, _ := hooks.NewBlockChainHookImpl(args)
 	vm, _ := mock.NewOneSCExecutorMockVM(blockChainHook, testHasher)
@@ -571,6 +583,7 @@ func CreateVMAndBlockchainHookAndDataPool(
 		CompiledSCPool:     datapool.SmartContracts(),
 		NilCompiledSCStore: true,
 		ConfigSCStorage:    *defaultStorageConfig(),
+		ExecutionTracer:    tracing.NewDisabledExecutionTracer(),
 	}
 
 	esdtTransferParser, _ := parsers.NewESDTTransferParser(testMarshalizer)
@@ -639,6 +652,7 @@ func CreateVMAndBlockchainHookMeta(
 		DataPool:           datapool,
 		CompiledSCPool:     datapool.SmartContracts(),
 		NilCompiledSCStore: true,
+		ExecutionTracer:    tracing.NewDisabledExecutionTracer(),
 	}
 
 	economicsData, err := createEconomicsData(0)
@@ -821,6 +835,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		ArwenChangeLocker: arwenChangeLocker,
 		VMOutputCacher:    txcache.NewDisabledCache(),
 		EnableEpochs:      enableEpochs,
+		ExecutionTracer:   tracing.NewDisabledExecutionTracer(),
 	}
 
 	scProcessor, err := smartContract.NewSmartContractProcessor(argsNewSCProcessor)
@@ -855,7 +870,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 	}
 
 	// create transaction simulator
-	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(accnts)
+	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(accnts, testMarshalizer, testHasher)
 	if err != nil {
 		return nil, nil, nil, nil, nil, err
 	}
@@ -907,15 +922,22 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		Type:     storageUnit.LRUCache,
 		Capacity: 10000,
 	})
+	executionTracer, err := tracing.NewExecutionTracer(pubkeyConv)
+	if err != nil {
+		return nil, nil, nil, nil, nil, err
+	}
 	txSimulatorProcessorArgs := txsimulator.ArgsTxSimulator{
 		AddressPubKeyConverter: pubkeyConv,
 		ShardCoordinator:       shardCoordinator,
 		VMOutputCacher:         vmOutputCacher,
 		Marshalizer:            testMarshalizer,
 		Hasher:                 testHasher,
+		ExecutionTracer:        executionTracer,
+		MaxTxBundleSize:        10,
 	}
 
 	argsNewSCProcessor.VMOutputCacher = txSimulatorProcessorArgs.VMOutputCacher
+	argsNewSCProcessor.ExecutionTracer = executionTracer
 
 	scProcessorTxSim, err := smartContract.NewSmartContractProcessor(argsNewSCProcessor)
 	if err != nil {
@@ -931,6 +953,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 	}
 
 	txSimulatorProcessorArgs.IntermediateProcContainer = interimProcContainer
+	txSimulatorProcessorArgs.AccountsOverlay = readOnlyAccountsDB
 
 	txSimulator, err := txsimulator.NewTransactionSimulator(txSimulatorProcessorArgs)
 	if err != nil {
@@ -1293,11 +1316,18 @@ func GetVmOutput(gasSchedule map[string]map[string]uint64, accnts state.Accounts
 	}
 
 	argsNewSCQueryService := smartContract.ArgsNewSCQueryService{
-		VmContainer:       vmContainer,
-		EconomicsFee:      feeHandler,
-		BlockChainHook:    blockChainHook,
-		BlockChain:        &mock.BlockChainMock{},
-		ArwenChangeLocker: &sync.RWMutex{},
+		VmContainer:              vmContainer,
+		EconomicsFee:             feeHandler,
+		BlockChainHook:           blockChainHook,
+		BlockChain:               &mock.BlockChainMock{},
+		ArwenChangeLocker:        &sync.RWMutex{},
+		AccountsAdapter:          accnts,
+		EpochNotifier:            forking.NewGenericEpochNotifier(),
+		StorageService:           &mock.ChainStorerMock{},
+		Marshalizer:              testMarshalizer,
+		Uint64ByteSliceConverter: &mock.Uint64ByteSliceConverterMock{},
+		ShardCoordinator:         oneShardCoordinator,
+		ArwenVersionProvider:     &testscommon.ArwenVersionProviderStub{},
 	}
 	scQueryService, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
@@ -1335,7 +1365,14 @@ func ComputeGasLimit(gasSchedule map[string]map[string]uint64, testContext *VMTe
 				}
 			},
 		},
-		ArwenChangeLocker: &sync.RWMutex{},
+		ArwenChangeLocker:        &sync.RWMutex{},
+		AccountsAdapter:          testContext.Accounts,
+		EpochNotifier:            forking.NewGenericEpochNotifier(),
+		StorageService:           &mock.ChainStorerMock{},
+		Marshalizer:              testMarshalizer,
+		Uint64ByteSliceConverter: &mock.Uint64ByteSliceConverterMock{},
+		ShardCoordinator:         oneShardCoordinator,
+		ArwenVersionProvider:     &testscommon.ArwenVersionProviderStub{},
 	}
 	scQueryService, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
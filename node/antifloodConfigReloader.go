@@ -0,0 +1,78 @@
+package node
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// ArgsAntifloodConfigReloader holds the arguments needed to create an antiflood config reloader
+type ArgsAntifloodConfigReloader struct {
+	MainConfigPath string
+	Handlers       []process.AntifloodConfigHandler
+}
+
+// antifloodConfigReloader re-reads the antiflood section of the main configuration file and applies it on the
+// running components, without restarting the node
+type antifloodConfigReloader struct {
+	mutReload      sync.Mutex
+	mainConfigPath string
+	handlers       []process.AntifloodConfigHandler
+}
+
+// NewAntifloodConfigReloader creates a new antiflood config reloader
+func NewAntifloodConfigReloader(args ArgsAntifloodConfigReloader) (*antifloodConfigReloader, error) {
+	if len(args.MainConfigPath) == 0 {
+		return nil, ErrEmptyMainConfigPath
+	}
+	for idx, handler := range args.Handlers {
+		if check.IfNil(handler) {
+			return nil, fmt.Errorf("%w at index %d", ErrNilAntifloodConfigHandler, idx)
+		}
+	}
+
+	return &antifloodConfigReloader{
+		mainConfigPath: args.MainConfigPath,
+		handlers:       args.Handlers,
+	}, nil
+}
+
+// Reload loads the antiflood configuration from the main configuration file and applies it on all handlers. The
+// configuration is applied only if all handlers accept it. Returns the applied configuration
+func (reloader *antifloodConfigReloader) Reload() (*config.AntifloodConfig, error) {
+	reloader.mutReload.Lock()
+	defer reloader.mutReload.Unlock()
+
+	mainConfig, err := common.LoadMainConfig(reloader.mainConfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	antifloodConfig := mainConfig.Antiflood
+	for _, handler := range reloader.handlers {
+		err = handler.CheckAntifloodConfig(antifloodConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, handler := range reloader.handlers {
+		err = handler.ApplyAntifloodConfig(antifloodConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	log.Info("reloaded antiflood configuration", "file", reloader.mainConfigPath)
+
+	return &antifloodConfig, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (reloader *antifloodConfigReloader) IsInterfaceNil() bool {
+	return reloader == nil
+}
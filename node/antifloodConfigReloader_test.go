@@ -0,0 +1,129 @@
+package node_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAntifloodConfig = `
+[Antiflood]
+    Enabled = true
+    [Antiflood.WebServer]
+        SimultaneousRequests = 100
+        SameSourceRequests = 10000
+        SameSourceResetIntervalInSec = 1
+    [Antiflood.Topic]
+        DefaultMaxMessagesPerSec = 15000
+`
+
+func createMainConfigFile(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	err := ioutil.WriteFile(path, []byte(testAntifloodConfig), os.ModePerm)
+	require.Nil(t, err)
+
+	return path
+}
+
+func TestNewAntifloodConfigReloader(t *testing.T) {
+	t.Parallel()
+
+	reloader, err := node.NewAntifloodConfigReloader(node.ArgsAntifloodConfigReloader{})
+	assert.Equal(t, node.ErrEmptyMainConfigPath, err)
+	assert.True(t, check.IfNil(reloader))
+
+	reloader, err = node.NewAntifloodConfigReloader(node.ArgsAntifloodConfigReloader{
+		MainConfigPath: "config.toml",
+		Handlers:       []process.AntifloodConfigHandler{&testscommon.AntifloodConfigHandlerStub{}, nil},
+	})
+	assert.True(t, errors.Is(err, node.ErrNilAntifloodConfigHandler))
+	assert.True(t, check.IfNil(reloader))
+
+	reloader, err = node.NewAntifloodConfigReloader(node.ArgsAntifloodConfigReloader{
+		MainConfigPath: "config.toml",
+		Handlers:       []process.AntifloodConfigHandler{&testscommon.AntifloodConfigHandlerStub{}},
+	})
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(reloader))
+}
+
+func TestAntifloodConfigReloader_ReloadMissingFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	reloader, _ := node.NewAntifloodConfigReloader(node.ArgsAntifloodConfigReloader{
+		MainConfigPath: "missing file.toml",
+		Handlers: []process.AntifloodConfigHandler{
+			&testscommon.AntifloodConfigHandlerStub{
+				ApplyAntifloodConfigCalled: func(cfg config.AntifloodConfig) error {
+					assert.Fail(t, "should have not applied the config")
+					return nil
+				},
+			},
+		},
+	})
+
+	cfg, err := reloader.Reload()
+	assert.NotNil(t, err)
+	assert.Nil(t, cfg)
+}
+
+func TestAntifloodConfigReloader_ReloadShouldNotApplyIfAHandlerRejectsTheConfig(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	applyCalled := false
+	reloader, _ := node.NewAntifloodConfigReloader(node.ArgsAntifloodConfigReloader{
+		MainConfigPath: createMainConfigFile(t),
+		Handlers: []process.AntifloodConfigHandler{
+			&testscommon.AntifloodConfigHandlerStub{
+				ApplyAntifloodConfigCalled: func(cfg config.AntifloodConfig) error {
+					applyCalled = true
+					return nil
+				},
+			},
+			&testscommon.AntifloodConfigHandlerStub{
+				CheckAntifloodConfigCalled: func(cfg config.AntifloodConfig) error {
+					return expectedErr
+				},
+			},
+		},
+	})
+
+	cfg, err := reloader.Reload()
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, cfg)
+	assert.False(t, applyCalled)
+}
+
+func TestAntifloodConfigReloader_ReloadShouldApplyOnAllHandlers(t *testing.T) {
+	t.Parallel()
+
+	numApplied := 0
+	handler := &testscommon.AntifloodConfigHandlerStub{
+		ApplyAntifloodConfigCalled: func(cfg config.AntifloodConfig) error {
+			assert.True(t, cfg.Enabled)
+			assert.Equal(t, uint32(100), cfg.WebServer.SimultaneousRequests)
+			numApplied++
+			return nil
+		},
+	}
+	reloader, _ := node.NewAntifloodConfigReloader(node.ArgsAntifloodConfigReloader{
+		MainConfigPath: createMainConfigFile(t),
+		Handlers:       []process.AntifloodConfigHandler{handler, handler},
+	})
+
+	cfg, err := reloader.Reload()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, numApplied)
+	assert.Equal(t, uint32(15000), cfg.Topic.DefaultMaxMessagesPerSec)
+}
@@ -147,3 +147,45 @@ var ErrInvalidESDTRole = errors.New("invalid ESDT role")
 
 // ErrMetachainOnlyEndpoint signals that an endpoint was called, but it is only available for metachain nodes
 var ErrMetachainOnlyEndpoint = errors.New("the endpoint is only available on metachain nodes")
+
+// ErrDBLookupExtensionsNotEnabled signals that the db lookup extensions are not enabled
+var ErrDBLookupExtensionsNotEnabled = errors.New("db lookup extensions not enabled")
+
+// ErrEmptyAddress signals that an empty address was provided
+var ErrEmptyAddress = errors.New("empty address")
+
+// ErrInvalidMaxNumLogsBucketsPerQuery signals that an invalid maximum number of logs index buckets per query was provided
+var ErrInvalidMaxNumLogsBucketsPerQuery = errors.New("invalid maximum number of logs index buckets per query")
+
+// ErrInvalidNoncesRange signals that an invalid block nonces range was provided
+var ErrInvalidNoncesRange = errors.New("invalid block nonces range")
+
+// ErrInvalidPageSize signals that an invalid page size was provided
+var ErrInvalidPageSize = errors.New("invalid page size")
+
+// ErrStateNotAvailable signals that the accounts state of the requested block is not available, most likely because it was pruned
+var ErrStateNotAvailable = errors.New("accounts state not available")
+
+// ErrBlockNonceAndHashBothProvided signals that both a block nonce and a block hash were provided for the same query
+var ErrBlockNonceAndHashBothProvided = errors.New("only one of block nonce and block hash can be provided")
+
+// ErrCannotReplayTransaction signals that the requested transaction cannot be re-executed
+var ErrCannotReplayTransaction = errors.New("cannot replay transaction")
+
+// ErrNilAntifloodConfigHandler signals that a nil antiflood config handler was provided
+var ErrNilAntifloodConfigHandler = errors.New("nil antiflood config handler")
+
+// ErrEmptyMainConfigPath signals that an empty main configuration file path was provided
+var ErrEmptyMainConfigPath = errors.New("empty main configuration file path")
+
+// ErrTransactionNotRecentlyEvicted signals that a transaction is not among the ones recently evicted from the pool
+var ErrTransactionNotRecentlyEvicted = errors.New("transaction not among the recently evicted ones")
+
+// ErrTxPoolInspectionNotSupported signals that the transactions pool in use cannot be inspected
+var ErrTxPoolInspectionNotSupported = errors.New("transactions pool inspection not supported")
+
+// ErrEmptyProofKeys signals that no keys were provided for a multi-key proof
+var ErrEmptyProofKeys = errors.New("empty proof keys")
+
+// ErrTooManyProofKeys signals that too many keys were provided for a multi-key proof
+var ErrTooManyProofKeys = errors.New("too many proof keys")
@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/update"
 )
 
@@ -74,3 +75,10 @@ type HeartbeatHandler interface {
 	Sender() *process.Sender
 	IsInterfaceNil() bool
 }
+
+type txPoolInspector interface {
+	ForEachTransaction(function txcache.ForEachTransaction)
+	GetSenderSnapshot(sender []byte) (*txcache.SenderSnapshot, bool)
+	GetEvictedTransaction(txHash []byte) (*txcache.EvictedTransaction, string, bool)
+	GetStatistics() map[string]txcache.Statistics
+}
@@ -33,6 +33,7 @@ type CoreComponentsMock struct {
 	MinTransactionVersionCalled func() uint32
 	StatusHdlUtils              nodeFactory.StatusHandlersUtils
 	AppStatusHdl                core.AppStatusHandler
+	MetricsHandlerField         common.MetricsHandler
 	WDTimer                     core.WatchdogTimer
 	Alarm                       core.TimersScheduler
 	NtpTimer                    ntp.SyncTimer
@@ -81,6 +82,11 @@ func (ccm *CoreComponentsMock) StatusHandler() core.AppStatusHandler {
 	return ccm.AppStatusHdl
 }
 
+// MetricsHandler -
+func (ccm *CoreComponentsMock) MetricsHandler() common.MetricsHandler {
+	return ccm.MetricsHandlerField
+}
+
 // Watchdog -
 func (ccm *CoreComponentsMock) Watchdog() core.WatchdogTimer {
 	return ccm.WDTimer
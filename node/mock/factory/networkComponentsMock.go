@@ -12,6 +12,8 @@ type NetworkComponentsMock struct {
 	InputAntiFlood       factory.P2PAntifloodHandler
 	OutputAntiFlood      factory.P2PAntifloodHandler
 	PeerBlackList        process.PeerBlackListCacher
+	PeerReputation       process.PeerReputationHandler
+	AntifloodConfig      process.AntifloodConfigHandler
 	PreferredPeersHolder factory.PreferredPeersHolderHandler
 }
 
@@ -25,6 +27,16 @@ func (ncm *NetworkComponentsMock) PeerHonestyHandler() factory.PeerHonestyHandle
 	panic("implement me")
 }
 
+// PeerReputationHandler -
+func (ncm *NetworkComponentsMock) PeerReputationHandler() process.PeerReputationHandler {
+	return ncm.PeerReputation
+}
+
+// AntifloodConfigHandler -
+func (ncm *NetworkComponentsMock) AntifloodConfigHandler() process.AntifloodConfigHandler {
+	return ncm.AntifloodConfig
+}
+
 // Create -
 func (ncm *NetworkComponentsMock) Create() error {
 	return nil
@@ -15,6 +15,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/keyValStorage"
 	"github.com/ElrondNetwork/elrond-go-core/core/partitioning"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/endProcess"
@@ -37,6 +38,8 @@ import (
 	procTx "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/ElrondNetwork/elrond-go/trie/proof"
+	"github.com/ElrondNetwork/elrond-go/trie/statistics"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -48,6 +51,9 @@ const (
 
 	// esdtTickerNumChars represents the number of hex-encoded characters of a ticker
 	esdtTickerNumChars = 6
+
+	// maxNumKeysInDataTrieProof represents the maximum number of data trie keys that can be proven at once
+	maxNumKeysInDataTrieProof = 1000
 )
 
 var log = logger.GetOrCreate("node")
@@ -107,6 +113,7 @@ type Node struct {
 	closableComponents        []mainFactory.Closer
 	enableSignTxWithHashEpoch uint32
 	isInImportMode            bool
+	maxNumLogsBucketsPerQuery uint64
 }
 
 // ApplyOptions can set up different configurable options of a Node instance
@@ -124,10 +131,11 @@ func (n *Node) ApplyOptions(opts ...Option) error {
 func NewNode(opts ...Option) (*Node, error) {
 	ctx, cancelFunc := context.WithCancel(context.Background())
 	node := &Node{
-		ctx:                      ctx,
-		cancelFunc:               cancelFunc,
-		currentSendingGoRoutines: 0,
-		queryHandlers:            make(map[string]debug.QueryHandler),
+		ctx:                       ctx,
+		cancelFunc:                cancelFunc,
+		currentSendingGoRoutines:  0,
+		queryHandlers:             make(map[string]debug.QueryHandler),
+		maxNumLogsBucketsPerQuery: defaultMaxNumLogsBucketsPerQuery,
 	}
 
 	node.closableComponents = make([]mainFactory.Closer, 0)
@@ -174,8 +182,8 @@ func (n *Node) GetConsensusGroupSize() int {
 }
 
 // GetBalance gets the balance for a specific address
-func (n *Node) GetBalance(address string) (*big.Int, error) {
-	account, err := n.getAccountHandler(address)
+func (n *Node) GetBalance(address string, options common.AccountQueryOptions) (*big.Int, error) {
+	account, err := n.getAccountHandler(address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -189,8 +197,8 @@ func (n *Node) GetBalance(address string) (*big.Int, error) {
 }
 
 // GetUsername gets the username for a specific address
-func (n *Node) GetUsername(address string) (string, error) {
-	account, err := n.getAccountHandler(address)
+func (n *Node) GetUsername(address string, options common.AccountQueryOptions) (string, error) {
+	account, err := n.getAccountHandler(address, options)
 	if err != nil {
 		return "", err
 	}
@@ -210,7 +218,7 @@ func (n *Node) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 		return nil, ErrMetachainOnlyEndpoint
 	}
 
-	account, err := n.getAccountHandlerForPubKey(vm.ESDTSCAddress)
+	account, err := n.getAccountHandlerForPubKey(vm.ESDTSCAddress, common.AccountQueryOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -278,8 +286,8 @@ func (n *Node) getEsdtDataFromLeaf(leaf core.KeyValueHolder, userAccount state.U
 }
 
 // GetKeyValuePairs returns all the key-value pairs under the address
-func (n *Node) GetKeyValuePairs(address string) (map[string]string, error) {
-	account, err := n.getAccountHandlerAPIAccounts(address)
+func (n *Node) GetKeyValuePairs(address string, options common.AccountQueryOptions) (map[string]string, error) {
+	account, err := n.getAccountHandlerAPIAccounts(address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -319,13 +327,13 @@ func (n *Node) GetKeyValuePairs(address string) (map[string]string, error) {
 }
 
 // GetValueForKey will return the value for a key from a given account
-func (n *Node) GetValueForKey(address string, key string) (string, error) {
+func (n *Node) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
 	keyBytes, err := hex.DecodeString(key)
 	if err != nil {
 		return "", fmt.Errorf("invalid key: %w", err)
 	}
 
-	account, err := n.getAccountHandler(address)
+	account, err := n.getAccountHandler(address, options)
 	if err != nil {
 		return "", err
 	}
@@ -344,8 +352,8 @@ func (n *Node) GetValueForKey(address string, key string) (string, error) {
 }
 
 // GetESDTData returns the esdt balance and properties from a given account
-func (n *Node) GetESDTData(address, tokenID string, nonce uint64) (*esdt.ESDigitalToken, error) {
-	account, err := n.getAccountHandler(address)
+func (n *Node) GetESDTData(address, tokenID string, nonce uint64, options common.AccountQueryOptions) (*esdt.ESDigitalToken, error) {
+	account, err := n.getAccountHandler(address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -380,12 +388,13 @@ func (n *Node) GetESDTData(address, tokenID string, nonce uint64) (*esdt.ESDigit
 
 func (n *Node) getTokensIDsWithFilter(
 	f filter,
+	options common.AccountQueryOptions,
 ) ([]string, error) {
 	if n.processComponents.ShardCoordinator().SelfId() != core.MetachainShardId {
 		return nil, ErrMetachainOnlyEndpoint
 	}
 
-	account, err := n.getAccountHandlerForPubKey(vm.ESDTSCAddress)
+	account, err := n.getAccountHandlerForPubKey(vm.ESDTSCAddress, options)
 	if err != nil {
 		return nil, err
 	}
@@ -430,7 +439,7 @@ func (n *Node) getTokensIDsWithFilter(
 }
 
 // GetNFTTokenIDsRegisteredByAddress returns all the token identifiers for semi or non fungible tokens registered by the address
-func (n *Node) GetNFTTokenIDsRegisteredByAddress(address string) ([]string, error) {
+func (n *Node) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) ([]string, error) {
 	addressBytes, err := n.coreComponents.AddressPubKeyConverter().Decode(address)
 	if err != nil {
 		return nil, err
@@ -439,11 +448,11 @@ func (n *Node) GetNFTTokenIDsRegisteredByAddress(address string) ([]string, erro
 	f := &getRegisteredNftsFilter{
 		addressBytes: addressBytes,
 	}
-	return n.getTokensIDsWithFilter(f)
+	return n.getTokensIDsWithFilter(f, options)
 }
 
 // GetESDTsWithRole returns all the tokens with the given role for the given address
-func (n *Node) GetESDTsWithRole(address string, role string) ([]string, error) {
+func (n *Node) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) ([]string, error) {
 	if !core.IsValidESDTRole(role) {
 		return nil, ErrInvalidESDTRole
 	}
@@ -457,11 +466,11 @@ func (n *Node) GetESDTsWithRole(address string, role string) ([]string, error) {
 		addressBytes: addressBytes,
 		role:         role,
 	}
-	return n.getTokensIDsWithFilter(f)
+	return n.getTokensIDsWithFilter(f, options)
 }
 
 // GetESDTsRoles returns all the tokens identifiers and roles for the given address
-func (n *Node) GetESDTsRoles(address string) (map[string][]string, error) {
+func (n *Node) GetESDTsRoles(address string, options common.AccountQueryOptions) (map[string][]string, error) {
 	addressBytes, err := n.coreComponents.AddressPubKeyConverter().Decode(address)
 	if err != nil {
 		return nil, err
@@ -473,7 +482,7 @@ func (n *Node) GetESDTsRoles(address string) (map[string][]string, error) {
 		addressBytes: addressBytes,
 		outputRoles:  tokensRoles,
 	}
-	_, err = n.getTokensIDsWithFilter(f)
+	_, err = n.getTokensIDsWithFilter(f, options)
 	if err != nil {
 		return nil, err
 	}
@@ -487,8 +496,8 @@ func (n *Node) GetTokenSupply(token string) (string, error) {
 }
 
 // GetAllESDTTokens returns all the ESDTs that the given address interacted with
-func (n *Node) GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error) {
-	account, err := n.getAccountHandlerAPIAccounts(address)
+func (n *Node) GetAllESDTTokens(address string, options common.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, error) {
+	account, err := n.getAccountHandlerAPIAccounts(address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -566,7 +575,7 @@ func adjustNftTokenIdentifier(token string, nonce uint64) string {
 	return formattedTokenIdentifier
 }
 
-func (n *Node) getAccountHandler(address string) (vmcommon.AccountHandler, error) {
+func (n *Node) getAccountHandler(address string, options common.AccountQueryOptions) (vmcommon.AccountHandler, error) {
 	if check.IfNil(n.coreComponents.AddressPubKeyConverter()) || check.IfNil(n.stateComponents.AccountsAdapter()) {
 		return nil, errors.New("initialize AccountsAdapter and PubkeyConverter first")
 	}
@@ -575,10 +584,14 @@ func (n *Node) getAccountHandler(address string) (vmcommon.AccountHandler, error
 	if err != nil {
 		return nil, errors.New("invalid address, could not decode from: " + err.Error())
 	}
+	if options.IsHistorical() {
+		return n.getHistoricalAccount(addr, options)
+	}
+
 	return n.stateComponents.AccountsAdapter().GetExistingAccount(addr)
 }
 
-func (n *Node) getAccountHandlerAPIAccounts(address string) (vmcommon.AccountHandler, error) {
+func (n *Node) getAccountHandlerAPIAccounts(address string, options common.AccountQueryOptions) (vmcommon.AccountHandler, error) {
 	componentsNotInitialized := check.IfNil(n.coreComponents.AddressPubKeyConverter()) ||
 		check.IfNil(n.stateComponents.AccountsAdapterAPI()) ||
 		check.IfNil(n.dataComponents.Blockchain())
@@ -591,10 +604,14 @@ func (n *Node) getAccountHandlerAPIAccounts(address string) (vmcommon.AccountHan
 		return nil, errors.New("invalid address, could not decode from: " + err.Error())
 	}
 
-	return n.getAccountHandlerForPubKey(addr)
+	return n.getAccountHandlerForPubKey(addr, options)
 }
 
-func (n *Node) getAccountHandlerForPubKey(address []byte) (vmcommon.AccountHandler, error) {
+func (n *Node) getAccountHandlerForPubKey(address []byte, options common.AccountQueryOptions) (vmcommon.AccountHandler, error) {
+	if options.IsHistorical() {
+		return n.getHistoricalAccount(address, options)
+	}
+
 	blockHeader := n.dataComponents.Blockchain().GetCurrentBlockHeader()
 	if check.IfNil(blockHeader) {
 		return nil, ErrNilBlockHeader
@@ -780,6 +797,8 @@ func (n *Node) commonTransactionValidation(
 		n.stateComponents.AccountsAdapter(),
 		n.processComponents.ShardCoordinator(),
 		whiteListRequest,
+		n.dataComponents.Datapool().Transactions(),
+		n.dataComponents.Datapool().Transactions(),
 		n.coreComponents.AddressPubKeyConverter(),
 		common.MaxTxNonceDeltaAllowed,
 	)
@@ -978,7 +997,7 @@ func (n *Node) CreateTransaction(
 }
 
 // GetAccount will return account details for a given address
-func (n *Node) GetAccount(address string) (api.AccountResponse, error) {
+func (n *Node) GetAccount(address string, options common.AccountQueryOptions) (api.AccountResponse, error) {
 	if check.IfNil(n.coreComponents.AddressPubKeyConverter()) {
 		return api.AccountResponse{}, ErrNilPubkeyConverter
 	}
@@ -991,7 +1010,12 @@ func (n *Node) GetAccount(address string) (api.AccountResponse, error) {
 		return api.AccountResponse{}, err
 	}
 
-	accWrp, err := n.stateComponents.AccountsAdapter().GetExistingAccount(addr)
+	var accWrp vmcommon.AccountHandler
+	if options.IsHistorical() {
+		accWrp, err = n.getHistoricalAccount(addr, options)
+	} else {
+		accWrp, err = n.stateComponents.AccountsAdapter().GetExistingAccount(addr)
+	}
 	if err != nil {
 		if err == state.ErrAccNotFound {
 			return api.AccountResponse{
@@ -1000,7 +1024,7 @@ func (n *Node) GetAccount(address string) (api.AccountResponse, error) {
 				DeveloperReward: "0",
 			}, nil
 		}
-		return api.AccountResponse{}, errors.New("could not fetch sender address from provided param: " + err.Error())
+		return api.AccountResponse{}, fmt.Errorf("could not fetch sender address from provided param: %w", err)
 	}
 
 	account, ok := accWrp.(state.UserAccountHandler)
@@ -1140,6 +1164,11 @@ func (n *Node) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return peerInfoSlice, nil
 }
 
+// GetPeersReputation returns the persisted reputation of the peer IDs and of the validator public keys that were banned
+func (n *Node) GetPeersReputation() []*common.PeerReputation {
+	return n.networkComponents.PeerReputationHandler().GetPeersReputation()
+}
+
 // GetHardforkTrigger returns the hardfork trigger
 func (n *Node) GetHardforkTrigger() HardforkTrigger {
 	return n.hardforkTrigger
@@ -1299,6 +1328,173 @@ func (n *Node) GetProofDataTrie(rootHash string, address string, key string) (*c
 	return mainProofResponse, dataTrieProofResponse, nil
 }
 
+// GetProofDataTrieMultiKey returns the Merkle proof for the given address, and a single Merkle proof for all the
+// given keys of its data trie. The returned values are in the order of the given keys, a nil value meaning that the
+// key is not present in the data trie
+func (n *Node) GetProofDataTrieMultiKey(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiKeyProofResponse, error) {
+	if len(keys) == 0 {
+		return nil, nil, ErrEmptyProofKeys
+	}
+	if len(keys) > maxNumKeysInDataTrieProof {
+		return nil, nil, fmt.Errorf("%w: provided %d, maximum %d", ErrTooManyProofKeys, len(keys), maxNumKeysInDataTrieProof)
+	}
+
+	keysBytes, err := decodeHexKeys(keys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	addressBytes, mainProofResponse, dataTrieRootHash, err := n.getMainProofAndDataTrieRootHash(rootHash, address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataTrie, err := n.stateComponents.AccountsAdapter().GetTrie(dataTrieRootHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataTrieProof, leafValues, err := dataTrie.GetMultiProof(keysBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := make([][]byte, 0, len(leafValues))
+	for i, leafValue := range leafValues {
+		if leafValue == nil {
+			values = append(values, nil)
+			continue
+		}
+
+		value, errTrim := proof.TrimDataTrieValue(leafValue, keysBytes[i], addressBytes)
+		if errTrim != nil {
+			return nil, nil, errTrim
+		}
+
+		values = append(values, value)
+	}
+
+	dataTrieProofResponse := &common.GetMultiKeyProofResponse{
+		Proof:    dataTrieProof,
+		Values:   values,
+		RootHash: hex.EncodeToString(dataTrieRootHash),
+	}
+
+	return mainProofResponse, dataTrieProofResponse, nil
+}
+
+// GetProofDataTrieRange returns the Merkle proof for the given address, and a Merkle proof for all the keys of its data
+// trie placed between the start key and the end key, both included, in the order in which they are stored in the trie.
+// An empty start or end key leaves the range unbounded on that side. If the range holds more than maxNumKeys keys, the
+// proof is truncated after the last returned key
+func (n *Node) GetProofDataTrieRange(rootHash string, address string, startKey string, endKey string, maxNumKeys int) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+	if maxNumKeys <= 0 || maxNumKeys > maxNumKeysInDataTrieProof {
+		maxNumKeys = maxNumKeysInDataTrieProof
+	}
+
+	startKeyBytes, err := hex.DecodeString(startKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	endKeyBytes, err := hex.DecodeString(endKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	addressBytes, mainProofResponse, dataTrieRootHash, err := n.getMainProofAndDataTrieRootHash(rootHash, address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataTrie, err := n.stateComponents.AccountsAdapter().GetTrie(dataTrieRootHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rangeProof, err := dataTrie.GetRangeProof(startKeyBytes, endKeyBytes, maxNumKeys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := make([][]byte, 0, len(rangeProof.Values))
+	for i, leafValue := range rangeProof.Values {
+		value, errTrim := proof.TrimDataTrieValue(leafValue, rangeProof.Keys[i], addressBytes)
+		if errTrim != nil {
+			return nil, nil, errTrim
+		}
+
+		values = append(values, value)
+	}
+
+	dataTrieProofResponse := &common.GetRangeProofResponse{
+		Proof:       rangeProof.Proof,
+		Keys:        rangeProof.Keys,
+		Values:      values,
+		StartKey:    rangeProof.StartKey,
+		EndKey:      rangeProof.EndKey,
+		IsTruncated: rangeProof.IsTruncated,
+		RootHash:    hex.EncodeToString(dataTrieRootHash),
+	}
+
+	return mainProofResponse, dataTrieProofResponse, nil
+}
+
+// VerifyProofDataTrieMultiKey verifies the given multi-key Merkle proof of the data trie belonging to the given address
+// and returns the proven values, in the order of the given keys. A nil value means that the key is not present
+func (n *Node) VerifyProofDataTrieMultiKey(rootHash string, address string, keys []string, dataTrieProof [][]byte) ([]core.KeyValueHolder, error) {
+	rootHashBytes, addressBytes, err := n.getRootHashAndAddressAsBytes(rootHash, address)
+	if err != nil {
+		return nil, err
+	}
+
+	keysBytes, err := decodeHexKeys(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	verifier, err := proof.NewProofVerifier(n.coreComponents.InternalMarshalizer(), n.coreComponents.Hasher())
+	if err != nil {
+		return nil, err
+	}
+
+	provenLeaves, err := verifier.VerifyMultiKeyProof(rootHashBytes, keysBytes, dataTrieProof)
+	if err != nil {
+		return nil, err
+	}
+
+	return trimProvenDataTrieValues(provenLeaves, addressBytes)
+}
+
+// VerifyProofDataTrieRange verifies the given range Merkle proof of the data trie belonging to the given address
+// and returns all the key-value pairs placed between the start key and the end key, both included
+func (n *Node) VerifyProofDataTrieRange(rootHash string, address string, startKey string, endKey string, dataTrieProof [][]byte) ([]core.KeyValueHolder, error) {
+	rootHashBytes, addressBytes, err := n.getRootHashAndAddressAsBytes(rootHash, address)
+	if err != nil {
+		return nil, err
+	}
+
+	startKeyBytes, err := hex.DecodeString(startKey)
+	if err != nil {
+		return nil, err
+	}
+	endKeyBytes, err := hex.DecodeString(endKey)
+	if err != nil {
+		return nil, err
+	}
+
+	verifier, err := proof.NewProofVerifier(n.coreComponents.InternalMarshalizer(), n.coreComponents.Hasher())
+	if err != nil {
+		return nil, err
+	}
+
+	provenLeaves, err := verifier.VerifyRangeProof(rootHashBytes, startKeyBytes, endKeyBytes, dataTrieProof)
+	if err != nil {
+		return nil, err
+	}
+
+	return trimProvenDataTrieValues(provenLeaves, addressBytes)
+}
+
 // VerifyProof verifies the given Merkle proof
 func (n *Node) VerifyProof(rootHash string, address string, proof [][]byte) (bool, error) {
 	rootHashBytes, err := hex.DecodeString(rootHash)
@@ -1319,6 +1515,33 @@ func (n *Node) VerifyProof(rootHash string, address string, proof [][]byte) (boo
 	return mpv.VerifyProof(rootHashBytes, key, proof)
 }
 
+// GetStateStatistics walks the accounts trie having the provided root hash together with all the data tries of its
+// accounts and returns their node counts, depth histograms and sizes, along with the largest data tries found
+func (n *Node) GetStateStatistics(rootHash string, numLargestDataTries int) (*common.StateStatistics, error) {
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	tr, err := n.stateComponents.AccountsAdapter().GetTrie(rootHashBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	argsCollector := statistics.ArgsStateStatisticsCollector{
+		AccountsTrie:        tr,
+		Marshalizer:         n.coreComponents.InternalMarshalizer(),
+		AddressConverter:    n.coreComponents.AddressPubKeyConverter(),
+		NumLargestDataTries: numLargestDataTries,
+	}
+	collector, err := statistics.NewStateStatisticsCollector(argsCollector)
+	if err != nil {
+		return nil, err
+	}
+
+	return collector.Collect(rootHashBytes)
+}
+
 func (n *Node) getRootHashAndAddressAsBytes(rootHash string, address string) ([]byte, []byte, error) {
 	rootHashBytes, err := hex.DecodeString(rootHash)
 	if err != nil {
@@ -1334,27 +1557,88 @@ func (n *Node) getRootHashAndAddressAsBytes(rootHash string, address string) ([]
 }
 
 func (n *Node) getAccountRootHashAndVal(address []byte, accBytes []byte, key []byte) ([]byte, []byte, error) {
-	account, err := n.stateComponents.AccountsAdapter().GetAccountFromBytes(address, accBytes)
+	userAccount, err := n.getUserAccountWithDataTrie(address, accBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataTrieRootHash := userAccount.GetRootHash()
+	retrievedVal, err := userAccount.RetrieveValueFromDataTrieTracker(key)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	return dataTrieRootHash, retrievedVal, nil
+}
+
+func (n *Node) getUserAccountWithDataTrie(address []byte, accBytes []byte) (state.UserAccountHandler, error) {
+	account, err := n.stateComponents.AccountsAdapter().GetAccountFromBytes(address, accBytes)
+	if err != nil {
+		return nil, err
+	}
+
 	userAccount, ok := account.(state.UserAccountHandler)
 	if !ok {
-		return nil, nil, fmt.Errorf("the address does not belong to a user account")
+		return nil, fmt.Errorf("the address does not belong to a user account")
 	}
 
-	dataTrieRootHash := userAccount.GetRootHash()
-	if len(dataTrieRootHash) == 0 {
-		return nil, nil, fmt.Errorf("empty dataTrie rootHash")
+	if len(userAccount.GetRootHash()) == 0 {
+		return nil, fmt.Errorf("empty dataTrie rootHash")
 	}
 
-	retrievedVal, err := userAccount.RetrieveValueFromDataTrieTracker(key)
+	return userAccount, nil
+}
+
+func (n *Node) getMainProofAndDataTrieRootHash(rootHash string, address string) ([]byte, *common.GetProofResponse, []byte, error) {
+	rootHashBytes, addressBytes, err := n.getRootHashAndAddressAsBytes(rootHash, address)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	return dataTrieRootHash, retrievedVal, nil
+	mainProofResponse, err := n.getProof(rootHashBytes, addressBytes)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	userAccount, err := n.getUserAccountWithDataTrie(addressBytes, mainProofResponse.Value)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return addressBytes, mainProofResponse, userAccount.GetRootHash(), nil
+}
+
+func trimProvenDataTrieValues(provenLeaves []proof.KeyValue, address []byte) ([]core.KeyValueHolder, error) {
+	provenValues := make([]core.KeyValueHolder, 0, len(provenLeaves))
+	for _, leaf := range provenLeaves {
+		if leaf.Value == nil {
+			provenValues = append(provenValues, keyValStorage.NewKeyValStorage(leaf.Key, nil))
+			continue
+		}
+
+		value, err := proof.TrimDataTrieValue(leaf.Value, leaf.Key, address)
+		if err != nil {
+			return nil, err
+		}
+
+		provenValues = append(provenValues, keyValStorage.NewKeyValStorage(leaf.Key, value))
+	}
+
+	return provenValues, nil
+}
+
+func decodeHexKeys(keys []string) ([][]byte, error) {
+	keysBytes := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		keyBytes, err := hex.DecodeString(key)
+		if err != nil {
+			return nil, err
+		}
+
+		keysBytes = append(keysBytes, keyBytes)
+	}
+
+	return keysBytes, nil
 }
 
 func (n *Node) getProof(rootHash []byte, key []byte) (*common.GetProofResponse, error) {
@@ -0,0 +1,73 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
+)
+
+// MaxAddressTransactionsPageSize represents the maximum number of transactions returned by a single address history query
+const MaxAddressTransactionsPageSize = 100
+
+const (
+	directionIn   = "in"
+	directionOut  = "out"
+	directionSelf = "self"
+)
+
+// GetAddressTransactions returns a page of the transactions sent or received by the provided address, newest first.
+// It requires the db lookup extensions to be enabled
+func (n *Node) GetAddressTransactions(address string, from uint64, size uint64) (*common.AddressTransactionsResponse, error) {
+	historyRepository := n.processComponents.HistoryRepository()
+	if !historyRepository.IsEnabled() {
+		return nil, ErrDBLookupExtensionsNotEnabled
+	}
+	if len(address) == 0 {
+		return nil, ErrEmptyAddress
+	}
+	if size == 0 || size > MaxAddressTransactionsPageSize {
+		return nil, fmt.Errorf("%w: size should be between 1 and %d", ErrInvalidPageSize, MaxAddressTransactionsPageSize)
+	}
+
+	addressBytes, err := n.coreComponents.AddressPubKeyConverter().Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w for address %s", err, address)
+	}
+
+	entries, total, err := historyRepository.GetAddressHistory(addressBytes, from, size)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*common.AddressTransaction, 0, len(entries))
+	for _, entry := range entries {
+		transactions = append(transactions, &common.AddressTransaction{
+			TxHash:     hex.EncodeToString(entry.TxHash),
+			Type:       block.Type(entry.MiniblockType).String(),
+			Direction:  directionToString(entry.Direction),
+			Epoch:      entry.Epoch,
+			BlockNonce: entry.BlockNonce,
+		})
+	}
+
+	return &common.AddressTransactionsResponse{
+		Transactions: transactions,
+		Total:        total,
+	}, nil
+}
+
+func directionToString(direction uint32) string {
+	switch direction {
+	case dblookupext.DirectionIncoming:
+		return directionIn
+	case dblookupext.DirectionOutgoing:
+		return directionOut
+	case dblookupext.DirectionSelf:
+		return directionSelf
+	default:
+		return ""
+	}
+}
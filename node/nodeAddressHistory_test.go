@@ -0,0 +1,114 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/node"
+	dbLookupExtMock "github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeWithHistoryRepository(t *testing.T, historyRepo dblookupext.HistoryRepository) *node.Node {
+	processComponents := getDefaultProcessComponents()
+	processComponents.HistoryRepositoryInternal = historyRepo
+
+	n, err := node.NewNode(
+		node.WithCoreComponents(getDefaultCoreComponents()),
+		node.WithProcessComponents(processComponents),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestNode_GetAddressTransactionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	address := hex.EncodeToString([]byte("alice"))
+
+	t.Run("db lookup extensions disabled", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeWithHistoryRepository(t, &dbLookupExtMock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		})
+		response, err := n.GetAddressTransactions(address, 0, 10)
+		require.Nil(t, response)
+		require.Equal(t, node.ErrDBLookupExtensionsNotEnabled, err)
+	})
+	t.Run("empty address", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeWithHistoryRepository(t, &dbLookupExtMock.HistoryRepositoryStub{})
+		response, err := n.GetAddressTransactions("", 0, 10)
+		require.Nil(t, response)
+		require.Equal(t, node.ErrEmptyAddress, err)
+	})
+	t.Run("invalid page size", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeWithHistoryRepository(t, &dbLookupExtMock.HistoryRepositoryStub{})
+		response, err := n.GetAddressTransactions(address, 0, 0)
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, node.ErrInvalidPageSize))
+
+		response, err = n.GetAddressTransactions(address, 0, node.MaxAddressTransactionsPageSize+1)
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, node.ErrInvalidPageSize))
+	})
+	t.Run("invalid address", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeWithHistoryRepository(t, &dbLookupExtMock.HistoryRepositoryStub{})
+		response, err := n.GetAddressTransactions("not hex", 0, 10)
+		require.Nil(t, response)
+		require.NotNil(t, err)
+	})
+	t.Run("history repository errors", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		n := createNodeWithHistoryRepository(t, &dbLookupExtMock.HistoryRepositoryStub{
+			GetAddressHistoryCalled: func(_ []byte, _ uint64, _ uint64) ([]*dblookupext.AddressHistoryEntry, uint64, error) {
+				return nil, 0, expectedErr
+			},
+		})
+		response, err := n.GetAddressTransactions(address, 0, 10)
+		require.Nil(t, response)
+		require.Equal(t, expectedErr, err)
+	})
+}
+
+func TestNode_GetAddressTransactionsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithHistoryRepository(t, &dbLookupExtMock.HistoryRepositoryStub{
+		GetAddressHistoryCalled: func(address []byte, numSkipped uint64, maxEntries uint64) ([]*dblookupext.AddressHistoryEntry, uint64, error) {
+			require.Equal(t, []byte("alice"), address)
+			require.Equal(t, uint64(2), numSkipped)
+			require.Equal(t, uint64(3), maxEntries)
+
+			return []*dblookupext.AddressHistoryEntry{
+				{TxHash: []byte("tx2"), Epoch: 1, BlockNonce: 8, Direction: dblookupext.DirectionIncoming, MiniblockType: int32(block.RewardsBlock)},
+				{TxHash: []byte("tx1"), Epoch: 1, BlockNonce: 7, Direction: dblookupext.DirectionSelf, MiniblockType: int32(block.TxBlock)},
+			}, 4, nil
+		},
+	})
+
+	response, err := n.GetAddressTransactions(hex.EncodeToString([]byte("alice")), 2, 3)
+	require.Nil(t, err)
+	require.Equal(t, uint64(4), response.Total)
+	require.Equal(t, 2, len(response.Transactions))
+	require.Equal(t, hex.EncodeToString([]byte("tx2")), response.Transactions[0].TxHash)
+	require.Equal(t, "in", response.Transactions[0].Direction)
+	require.Equal(t, block.RewardsBlock.String(), response.Transactions[0].Type)
+	require.Equal(t, uint64(8), response.Transactions[0].BlockNonce)
+	require.Equal(t, "self", response.Transactions[1].Direction)
+	require.Equal(t, block.TxBlock.String(), response.Transactions[1].Type)
+}
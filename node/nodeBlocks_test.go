@@ -594,7 +594,7 @@ func getDefaultDataComponents() *factory.DataComponentsMock {
 	return &factory.DataComponentsMock{
 		BlockChain: &mock.ChainHandlerStub{},
 		Store:      &mock.ChainStorerStub{},
-		DataPool:   &dataRetrieverMock.PoolsHolderMock{},
+		DataPool:   dataRetrieverMock.NewPoolsHolderMock(),
 		MbProvider: &mock.MiniBlocksProviderStub{},
 	}
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
+	trieFactory "github.com/ElrondNetwork/elrond-go/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/update"
 	updateFactory "github.com/ElrondNetwork/elrond-go/update/factory"
 	"github.com/ElrondNetwork/elrond-go/update/trigger"
@@ -50,6 +51,11 @@ func CreateHardForkTrigger(
 	accountsDBs := make(map[state.AccountsDbIdentifier]state.AccountsAdapter)
 	accountsDBs[state.UserAccountsState] = stateComponents.AccountsAdapter()
 	accountsDBs[state.PeerAccountsState] = stateComponents.PeerAccounts()
+	trieSyncRequestsBudget, err := trieFactory.CreateSyncRequestsBudget(config.TrieSync)
+	if err != nil {
+		return nil, err
+	}
+
 	hardForkConfig := config.Hardfork
 	exportFolder := filepath.Join(workingDir, hardForkConfig.ImportFolder)
 	argsExporter := updateFactory.ArgsExporter{
@@ -83,6 +89,7 @@ func CreateHardForkTrigger(
 		MaxHardCapForMissingNodes: config.TrieSync.MaxHardCapForMissingNodes,
 		NumConcurrentTrieSyncers:  config.TrieSync.NumConcurrentTrieSyncers,
 		TrieSyncerVersion:         config.TrieSync.TrieSyncerVersion,
+		TrieSyncRequestsBudget:    trieSyncRequestsBudget,
 	}
 	hardForkExportFactory, err := updateFactory.NewExportHandlerFactory(argsExporter)
 	if err != nil {
@@ -168,6 +175,7 @@ func CreateNode(
 		networkComponents.PeerBlackListHandler(),
 		networkComponents.PubKeyCacher(),
 		processComponents.PeerShardMapper(),
+		networkComponents.PeerReputationHandler(),
 	)
 	if err != nil {
 		return nil, err
@@ -212,6 +220,7 @@ func CreateNode(
 		WithPublicKeySize(config.ValidatorPubkeyConverter.Length),
 		WithNodeStopChannel(coreComponents.ChanStopNodeProcess()),
 		WithImportMode(isInImportMode),
+		WithMaxNumLogsBucketsPerQuery(config.DbLookupExtensions.LogsQueryMaxNumBuckets),
 	)
 	if err != nil {
 		return nil, errors.New("error creating node: " + err.Error())
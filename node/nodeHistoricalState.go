@@ -0,0 +1,134 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// getHistoricalAccount returns the account of the address as it was right after the block selected by the options
+// was committed. The accounts trie is recreated at the root hash of that block, through the accounts adapter dedicated
+// to the API, so the lookup never touches the accounts adapter used by the block processing
+func (n *Node) getHistoricalAccount(address []byte, options common.AccountQueryOptions) (vmcommon.AccountHandler, error) {
+	accountsAdapter := n.stateComponents.AccountsAdapterAPI()
+	if check.IfNil(accountsAdapter) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	blockHeader, err := n.getBlockHeaderForAccountQuery(options)
+	if err != nil {
+		return nil, err
+	}
+
+	rootHash := blockHeader.GetRootHash()
+	accountsTrie, err := accountsAdapter.GetTrie(rootHash)
+	if err != nil {
+		return nil, newStateNotAvailableError(blockHeader, err)
+	}
+
+	accountBytes, err := accountsTrie.Get(address)
+	if err != nil {
+		return nil, newStateNotAvailableError(blockHeader, err)
+	}
+	if len(accountBytes) == 0 {
+		return nil, state.ErrAccNotFound
+	}
+
+	account, err := accountsAdapter.GetAccountFromBytes(address, accountBytes)
+	if err != nil {
+		return nil, newStateNotAvailableError(blockHeader, err)
+	}
+
+	return account, nil
+}
+
+func newStateNotAvailableError(blockHeader data.HeaderHandler, err error) error {
+	return fmt.Errorf("%w for block nonce %d, root hash %s: %s",
+		ErrStateNotAvailable,
+		blockHeader.GetNonce(),
+		hex.EncodeToString(blockHeader.GetRootHash()),
+		err.Error(),
+	)
+}
+
+func (n *Node) getBlockHeaderForAccountQuery(options common.AccountQueryOptions) (data.HeaderHandler, error) {
+	if options.HasBlockNonce && len(options.BlockHash) > 0 {
+		return nil, ErrBlockNonceAndHashBothProvided
+	}
+
+	headerHash := options.BlockHash
+	if options.HasBlockNonce {
+		var err error
+		headerHash, err = n.getBlockHashByNonce(options.BlockNonce)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return n.getBlockHeaderByHash(headerHash)
+}
+
+// getBlockHashByNonce searches the nonce only in the epochs still kept open by the nonce to hash storer: the older blocks
+// can be selected only by their hash. A nonce which is not found is reported as common.ErrBlockNonceNotAvailable
+func (n *Node) getBlockHashByNonce(nonce uint64) ([]byte, error) {
+	storerUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(n.processComponents.ShardCoordinator().SelfId())
+	if n.isSelfInMetachain() {
+		storerUnit = dataRetriever.MetaHdrNonceHashDataUnit
+	}
+
+	nonceToByteSlice := n.coreComponents.Uint64ByteSliceConverter().ToByteSlice(nonce)
+	headerHash, err := n.dataComponents.StorageService().Get(storerUnit, nonceToByteSlice)
+	if err != nil {
+		return nil, fmt.Errorf("%w: nonce %d, %s", common.ErrBlockNonceNotAvailable, nonce, err.Error())
+	}
+
+	return headerHash, nil
+}
+
+func (n *Node) getBlockHeaderByHash(headerHash []byte) (data.HeaderHandler, error) {
+	storerUnit := dataRetriever.BlockHeaderUnit
+	var blockHeader data.HeaderHandler = &block.Header{}
+	if n.isSelfInMetachain() {
+		storerUnit = dataRetriever.MetaBlockUnit
+		blockHeader = &block.MetaBlock{}
+	}
+
+	headerBytes, err := n.getBlockHeaderBytes(storerUnit, headerHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while searching the block with hash %s", err, hex.EncodeToString(headerHash))
+	}
+
+	err = n.coreComponents.InternalMarshalizer().Unmarshal(blockHeader, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return blockHeader, nil
+}
+
+func (n *Node) getBlockHeaderBytes(storerUnit dataRetriever.UnitType, headerHash []byte) ([]byte, error) {
+	historyRepository := n.processComponents.HistoryRepository()
+	if !historyRepository.IsEnabled() {
+		return n.dataComponents.StorageService().Get(storerUnit, headerHash)
+	}
+
+	// with db lookup extensions, the header can be fetched from older epochs as well
+	epoch, err := historyRepository.GetEpochByHash(headerHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.dataComponents.StorageService().GetStorer(storerUnit).GetFromEpoch(headerHash, epoch)
+}
+
+func (n *Node) isSelfInMetachain() bool {
+	return n.processComponents.ShardCoordinator().SelfId() == core.MetachainShardId
+}
@@ -0,0 +1,191 @@
+package node_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	dbLookupExtMock "github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+var historicalStateAddress = []byte("alice___________________________")
+
+// createNodeForHistoricalState creates a node where the block with nonce N has the hash "hashN" and the root hash
+// "rootN". Only the state of the block with nonce 5 is still available, holding the provided account
+func createNodeForHistoricalState(t *testing.T, account state.UserAccountHandler) *node.Node {
+	marshalizer := &mock.MarshalizerFake{}
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = marshalizer
+
+	dataComponents := getDefaultDataComponents()
+	dataComponents.Store = &mock.ChainStorerStub{
+		GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+			switch unitType {
+			case dataRetriever.ShardHdrNonceHashDataUnit:
+				nonce, _ := coreComponents.UInt64ByteSliceConv.ToUint64(key)
+				if nonce > 10 {
+					return nil, storage.ErrKeyNotFound
+				}
+
+				return []byte(fmt.Sprintf("hash%d", nonce)), nil
+			case dataRetriever.BlockHeaderUnit:
+				var nonce uint64
+				_, err := fmt.Sscanf(string(key), "hash%d", &nonce)
+				if err != nil {
+					return nil, storage.ErrKeyNotFound
+				}
+
+				return marshalizer.Marshal(&block.Header{Nonce: nonce, RootHash: []byte(fmt.Sprintf("root%d", nonce))})
+			default:
+				return nil, storage.ErrKeyNotFound
+			}
+		},
+	}
+
+	accountsTrie := &trieMock.TrieStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			if !bytes.Equal(key, historicalStateAddress) || account == nil {
+				return nil, nil
+			}
+
+			return marshalizer.Marshal(account)
+		},
+	}
+	stateComponents := getDefaultStateComponents()
+	stateComponents.Accounts = &stateMock.AccountsStub{
+		GetTrieCalled: func(_ []byte) (common.Trie, error) {
+			require.Fail(t, "the accounts adapter of the block processing should not be used")
+			return nil, nil
+		},
+	}
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetTrieCalled: func(rootHash []byte) (common.Trie, error) {
+			if string(rootHash) != "root5" {
+				return nil, errors.New("root hash not found")
+			}
+
+			return accountsTrie, nil
+		},
+		GetAccountFromBytesCalled: func(address []byte, accountBytes []byte) (vmcommon.AccountHandler, error) {
+			recreatedAccount, _ := state.NewUserAccount(address)
+			err := marshalizer.Unmarshal(recreatedAccount, accountBytes)
+
+			return recreatedAccount, err
+		},
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			require.Fail(t, "the latest state should not be used")
+			return nil, nil
+		},
+	}
+
+	processComponents := getDefaultProcessComponents()
+	processComponents.HistoryRepositoryInternal = &dbLookupExtMock.HistoryRepositoryStub{
+		IsEnabledCalled: func() bool {
+			return false
+		},
+	}
+
+	n, err := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithDataComponents(dataComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithProcessComponents(processComponents),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func createHistoricalUserAccount() state.UserAccountHandler {
+	account, _ := state.NewUserAccount(historicalStateAddress)
+	_ = account.AddToBalance(big.NewInt(37))
+	account.IncreaseNonce(4)
+
+	return account
+}
+
+func TestNode_GetBalanceAtBlockNonceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForHistoricalState(t, createHistoricalUserAccount())
+
+	balance, err := n.GetBalance(hex.EncodeToString(historicalStateAddress), common.AccountQueryOptions{BlockNonce: 5, HasBlockNonce: true})
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(37), balance)
+}
+
+func TestNode_GetAccountAtBlockHashShouldWork(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForHistoricalState(t, createHistoricalUserAccount())
+
+	account, err := n.GetAccount(hex.EncodeToString(historicalStateAddress), common.AccountQueryOptions{BlockHash: []byte("hash5")})
+	require.Nil(t, err)
+	require.Equal(t, "37", account.Balance)
+	require.Equal(t, uint64(4), account.Nonce)
+}
+
+func TestNode_GetAccountAtBlockShouldReturnEmptyAccountIfMissing(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForHistoricalState(t, nil)
+
+	account, err := n.GetAccount(hex.EncodeToString(historicalStateAddress), common.AccountQueryOptions{BlockNonce: 5, HasBlockNonce: true})
+	require.Nil(t, err)
+	require.Equal(t, "0", account.Balance)
+
+	_, err = n.GetUsername(hex.EncodeToString(historicalStateAddress), common.AccountQueryOptions{BlockNonce: 5, HasBlockNonce: true})
+	require.Equal(t, state.ErrAccNotFound, err)
+}
+
+func TestNode_GetAccountAtBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("pruned state", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForHistoricalState(t, createHistoricalUserAccount())
+
+		_, err := n.GetBalance(hex.EncodeToString(historicalStateAddress), common.AccountQueryOptions{BlockNonce: 4, HasBlockNonce: true})
+		require.True(t, errors.Is(err, node.ErrStateNotAvailable))
+	})
+	t.Run("unknown block nonce", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForHistoricalState(t, createHistoricalUserAccount())
+
+		_, err := n.GetAccount(hex.EncodeToString(historicalStateAddress), common.AccountQueryOptions{BlockNonce: 11, HasBlockNonce: true})
+		require.True(t, errors.Is(err, common.ErrBlockNonceNotAvailable))
+	})
+	t.Run("unknown block hash", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForHistoricalState(t, createHistoricalUserAccount())
+
+		_, err := n.GetValueForKey(hex.EncodeToString(historicalStateAddress), "aa", common.AccountQueryOptions{BlockHash: []byte("unknown")})
+		require.True(t, errors.Is(err, storage.ErrKeyNotFound))
+	})
+	t.Run("both block nonce and hash", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForHistoricalState(t, createHistoricalUserAccount())
+
+		options := common.AccountQueryOptions{BlockNonce: 5, HasBlockNonce: true, BlockHash: []byte("hash5")}
+		_, err := n.GetBalance(hex.EncodeToString(historicalStateAddress), options)
+		require.Equal(t, node.ErrBlockNonceAndHashBothProvided, err)
+	})
+}
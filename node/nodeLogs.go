@@ -0,0 +1,258 @@
+package node
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
+)
+
+// MaxNumLogEventsPerQuery represents the maximum number of log events returned by a single logs query. The following
+// events can be fetched with further queries, as pointed by the truncated response
+const MaxNumLogEventsPerQuery = 1000
+
+// defaultMaxNumLogsBucketsPerQuery is the maximum number of logs index buckets read by a single logs query, when not
+// configured otherwise
+const defaultMaxNumLogsBucketsPerQuery = 1000
+
+type logEventsFilter struct {
+	address    []byte
+	identifier []byte
+	topic      []byte
+}
+
+type logsIndexBlock struct {
+	txHashesInBlock *dblookupext.TxHashesInBlock
+	epoch           uint32
+}
+
+// GetLogs returns a page of the log events matching the provided query, ordered by block nonce. It requires the db
+// lookup extensions to be enabled. A page ends either when it holds MaxNumLogEventsPerQuery events or when the
+// configured number of logs index buckets was read, the truncated response pointing to where the next page starts
+func (n *Node) GetLogs(query *common.LogsQuery) (*common.LogsResponse, error) {
+	historyRepository := n.processComponents.HistoryRepository()
+	if !historyRepository.IsEnabled() {
+		return nil, ErrDBLookupExtensionsNotEnabled
+	}
+
+	filter, err := n.createLogEventsFilter(query)
+	if err != nil {
+		return nil, err
+	}
+
+	fromNonce := query.FromNonce
+	toNonce := query.ToNonce
+	if toNonce == 0 {
+		toNonce = n.getCurrentBlockNonce()
+	}
+	if fromNonce > toNonce {
+		return nil, fmt.Errorf("%w: fromNonce %d is greater than toNonce %d", ErrInvalidNoncesRange, fromNonce, toNonce)
+	}
+
+	fromEpoch, err := n.getEpochByBlockNonce(fromNonce)
+	if err != nil {
+		return nil, err
+	}
+	toEpoch, err := n.getEpochByBlockNonce(toNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.LogsResponse{
+		Logs: make([]*common.ApiLogEvent, 0),
+	}
+
+	numReadBuckets := uint64(0)
+	firstEpochOfBucket := fromEpoch
+	firstNonceOfBucket := fromNonce - fromNonce%dblookupext.LogsIndexNumBlocksPerBucket
+	for ; firstNonceOfBucket <= toNonce; firstNonceOfBucket += dblookupext.LogsIndexNumBlocksPerBucket {
+		if numReadBuckets == n.maxNumLogsBucketsPerQuery {
+			response.Truncated = true
+			response.NextFromNonce = firstNonceOfBucket
+			response.NextFromEventIndex = 0
+			return response, nil
+		}
+		numReadBuckets++
+
+		lastNonceOfBucket := core.MinUint64(firstNonceOfBucket+dblookupext.LogsIndexNumBlocksPerBucket-1, toNonce)
+		lastEpochOfBucket := toEpoch
+		if lastNonceOfBucket < toNonce {
+			lastEpochOfBucket = n.getEpochByBlockNonceOrDefault(lastNonceOfBucket, toEpoch)
+		}
+
+		blocks := n.getLogsIndexBlocks(filter, firstNonceOfBucket, firstEpochOfBucket, lastEpochOfBucket)
+		for _, indexedBlock := range blocks {
+			blockNonce := indexedBlock.txHashesInBlock.BlockNonce
+			if blockNonce < fromNonce || blockNonce > toNonce {
+				continue
+			}
+
+			logEvents := n.getLogEventsOfBlock(indexedBlock.txHashesInBlock, indexedBlock.epoch, filter)
+			firstIndex := 0
+			if blockNonce == fromNonce {
+				firstIndex = int(query.FromEventIndex)
+			}
+			for index := firstIndex; index < len(logEvents); index++ {
+				if len(response.Logs) == MaxNumLogEventsPerQuery {
+					response.Truncated = true
+					response.NextFromNonce = blockNonce
+					response.NextFromEventIndex = uint32(index)
+					return response, nil
+				}
+
+				response.Logs = append(response.Logs, logEvents[index])
+			}
+		}
+
+		firstEpochOfBucket = lastEpochOfBucket
+	}
+
+	return response, nil
+}
+
+// getLogsIndexBlocks returns the nonce-ordered blocks holding matching log events from the bucket starting at the
+// provided nonce. A bucket spanning an epoch change has a record in each of the epochs
+func (n *Node) getLogsIndexBlocks(filter *logEventsFilter, firstNonceOfBucket uint64, fromEpoch uint32, toEpoch uint32) []*logsIndexBlock {
+	historyRepository := n.processComponents.HistoryRepository()
+
+	blocks := make([]*logsIndexBlock, 0)
+	for epoch := fromEpoch; epoch <= toEpoch; epoch++ {
+		record, err := historyRepository.GetLogsTxHashes(filter.address, filter.identifier, firstNonceOfBucket, epoch)
+		if err != nil {
+			// no matching events in this bucket and epoch or the epoch was already pruned
+			continue
+		}
+
+		for _, txHashesInBlock := range record.Blocks {
+			blocks = append(blocks, &logsIndexBlock{
+				txHashesInBlock: txHashesInBlock,
+				epoch:           epoch,
+			})
+		}
+	}
+
+	sort.SliceStable(blocks, func(i, j int) bool {
+		return blocks[i].txHashesInBlock.BlockNonce < blocks[j].txHashesInBlock.BlockNonce
+	})
+
+	return blocks
+}
+
+func (n *Node) createLogEventsFilter(query *common.LogsQuery) (*logEventsFilter, error) {
+	if len(query.Address) == 0 {
+		return nil, ErrEmptyAddress
+	}
+
+	address, err := n.coreComponents.AddressPubKeyConverter().Decode(query.Address)
+	if err != nil {
+		return nil, fmt.Errorf("%w for address %s", err, query.Address)
+	}
+
+	topic, err := hex.DecodeString(query.Topic)
+	if err != nil {
+		return nil, fmt.Errorf("%w for topic %s", err, query.Topic)
+	}
+
+	return &logEventsFilter{
+		address:    address,
+		identifier: []byte(query.Identifier),
+		topic:      topic,
+	}, nil
+}
+
+func (n *Node) getLogEventsOfBlock(
+	txHashesInBlock *dblookupext.TxHashesInBlock,
+	epoch uint32,
+	filter *logEventsFilter,
+) []*common.ApiLogEvent {
+	addressPubKeyConverter := n.coreComponents.AddressPubKeyConverter()
+
+	logEvents := make([]*common.ApiLogEvent, 0)
+
+	for _, txHash := range txHashesInBlock.TxHashes {
+		txLog, err := n.getLogsAndEvents(txHash, epoch)
+		if err != nil {
+			log.Warn("node.getLogEventsOfBlock cannot get logs from storage",
+				"txHash", hex.EncodeToString(txHash),
+				"epoch", epoch,
+				"error", err.Error())
+			continue
+		}
+
+		for _, event := range txLog.Events {
+			if !filter.matches(event) {
+				continue
+			}
+
+			logEvents = append(logEvents, &common.ApiLogEvent{
+				TxHash:     hex.EncodeToString(txHash),
+				BlockNonce: txHashesInBlock.BlockNonce,
+				Epoch:      epoch,
+				Address:    addressPubKeyConverter.Encode(event.Address),
+				Identifier: string(event.Identifier),
+				Topics:     event.Topics,
+				Data:       event.Data,
+			})
+		}
+	}
+
+	return logEvents
+}
+
+func (filter *logEventsFilter) matches(event *transaction.Event) bool {
+	if event == nil || !bytes.Equal(event.Address, filter.address) {
+		return false
+	}
+	if len(filter.identifier) > 0 && !bytes.Equal(event.Identifier, filter.identifier) {
+		return false
+	}
+	if len(filter.topic) == 0 {
+		return true
+	}
+
+	for _, topic := range event.Topics {
+		if bytes.Equal(topic, filter.topic) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (n *Node) getCurrentBlockNonce() uint64 {
+	currentHeader := n.dataComponents.Blockchain().GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		return 0
+	}
+
+	return currentHeader.GetNonce()
+}
+
+func (n *Node) getEpochByBlockNonce(nonce uint64) (uint32, error) {
+	headerHash, err := n.getBlockHashByNonce(nonce)
+	if err != nil {
+		return 0, err
+	}
+
+	epoch, err := n.processComponents.HistoryRepository().GetEpochByHash(headerHash)
+	if err != nil {
+		return 0, fmt.Errorf("%w: nonce %d, %s", common.ErrBlockNonceNotAvailable, nonce, err.Error())
+	}
+
+	return epoch, nil
+}
+
+func (n *Node) getEpochByBlockNonceOrDefault(nonce uint64, defaultEpoch uint32) uint32 {
+	epoch, err := n.getEpochByBlockNonce(nonce)
+	if err != nil {
+		return defaultEpoch
+	}
+
+	return epoch
+}
@@ -0,0 +1,318 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	dbLookupExtMock "github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	logsContractAddress = []byte("contract________________________")
+	logsOtherAddress    = []byte("other___________________________")
+)
+
+func createNodeForLogs(t *testing.T, historyRepo dblookupext.HistoryRepository, logs map[string]*transaction.Log, opts ...node.Option) *node.Node {
+	marshalizer := &mock.MarshalizerFake{}
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = marshalizer
+
+	dataComponents := getDefaultDataComponents()
+	dataComponents.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Nonce: 30}
+		},
+	}
+	dataComponents.Store = &mock.ChainStorerStub{
+		GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+			// block hash = "hash" + nonce
+			nonce, _ := coreComponents.UInt64ByteSliceConv.ToUint64(key)
+			return []byte(fmt.Sprintf("hash%d", nonce)), nil
+		},
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &testscommon.StorerStub{
+				GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+					txLog, ok := logs[string(key)]
+					if !ok {
+						return nil, storage.ErrKeyNotFound
+					}
+
+					return marshalizer.Marshal(txLog)
+				},
+			}
+		},
+	}
+
+	processComponents := getDefaultProcessComponents()
+	processComponents.HistoryRepositoryInternal = historyRepo
+
+	opts = append(opts,
+		node.WithCoreComponents(coreComponents),
+		node.WithDataComponents(dataComponents),
+		node.WithProcessComponents(processComponents),
+	)
+	n, err := node.NewNode(opts...)
+	require.Nil(t, err)
+
+	return n
+}
+
+func createHistoryRepoForLogs() *dbLookupExtMock.HistoryRepositoryStub {
+	return &dbLookupExtMock.HistoryRepositoryStub{
+		IsEnabledCalled: func() bool {
+			return true
+		},
+		GetEpochByHashCalled: func(hash []byte) (uint32, error) {
+			// nonces 0..19 are in epoch 0, the rest are in epoch 1
+			var nonce uint64
+			_, _ = fmt.Sscanf(string(hash), "hash%d", &nonce)
+			return uint32(nonce / 20), nil
+		},
+		GetLogsTxHashesCalled: func(address []byte, identifier []byte, blockNonce uint64, epoch uint32) (*dblookupext.LogsIndexRecord, error) {
+			if blockNonce >= dblookupext.LogsIndexNumBlocksPerBucket {
+				return nil, storage.ErrKeyNotFound
+			}
+
+			switch epoch {
+			case 0:
+				return &dblookupext.LogsIndexRecord{
+					Blocks: []*dblookupext.TxHashesInBlock{
+						{BlockNonce: 15, TxHashes: [][]byte{[]byte("tx2")}},
+						{BlockNonce: 5, TxHashes: [][]byte{[]byte("tx1")}},
+					},
+				}, nil
+			case 1:
+				return &dblookupext.LogsIndexRecord{
+					Blocks: []*dblookupext.TxHashesInBlock{
+						{BlockNonce: 25, TxHashes: [][]byte{[]byte("tx3"), []byte("missing")}},
+					},
+				}, nil
+			default:
+				return nil, storage.ErrKeyNotFound
+			}
+		},
+	}
+}
+
+func createLogsForNode() map[string]*transaction.Log {
+	return map[string]*transaction.Log{
+		"tx1": {
+			Events: []*transaction.Event{
+				{Address: logsContractAddress, Identifier: []byte("transfer"), Topics: [][]byte{[]byte("a")}},
+				{Address: logsOtherAddress, Identifier: []byte("transfer"), Topics: [][]byte{[]byte("a")}},
+			},
+		},
+		"tx2": {
+			Events: []*transaction.Event{
+				{Address: logsContractAddress, Identifier: []byte("mint"), Topics: [][]byte{[]byte("b")}},
+			},
+		},
+		"tx3": {
+			Events: []*transaction.Event{
+				{Address: logsContractAddress, Identifier: []byte("transfer"), Topics: [][]byte{[]byte("b"), []byte("c")}},
+			},
+		},
+	}
+}
+
+func TestNode_GetLogsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("db lookup extensions disabled", func(t *testing.T) {
+		t.Parallel()
+
+		historyRepo := &dbLookupExtMock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}
+		n := createNodeForLogs(t, historyRepo, nil)
+		response, err := n.GetLogs(&common.LogsQuery{Address: hex.EncodeToString(logsContractAddress)})
+		require.Nil(t, response)
+		require.Equal(t, node.ErrDBLookupExtensionsNotEnabled, err)
+	})
+	t.Run("empty address", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForLogs(t, createHistoryRepoForLogs(), nil)
+		response, err := n.GetLogs(&common.LogsQuery{})
+		require.Nil(t, response)
+		require.Equal(t, node.ErrEmptyAddress, err)
+	})
+	t.Run("invalid topic", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForLogs(t, createHistoryRepoForLogs(), nil)
+		response, err := n.GetLogs(&common.LogsQuery{Address: hex.EncodeToString(logsContractAddress), Topic: "zz"})
+		require.Nil(t, response)
+		require.True(t, strings.Contains(err.Error(), "topic"))
+	})
+	t.Run("invalid nonces range", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForLogs(t, createHistoryRepoForLogs(), nil)
+		response, err := n.GetLogs(&common.LogsQuery{Address: hex.EncodeToString(logsContractAddress), FromNonce: 10, ToNonce: 9})
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, node.ErrInvalidNoncesRange))
+	})
+	t.Run("pruned block nonce", func(t *testing.T) {
+		t.Parallel()
+
+		historyRepo := createHistoryRepoForLogs()
+		historyRepo.GetEpochByHashCalled = func(hash []byte) (uint32, error) {
+			if string(hash) == "hash3" {
+				return 0, storage.ErrKeyNotFound
+			}
+
+			return 0, nil
+		}
+		n := createNodeForLogs(t, historyRepo, nil)
+		response, err := n.GetLogs(&common.LogsQuery{Address: hex.EncodeToString(logsContractAddress), FromNonce: 3, ToNonce: 9})
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, common.ErrBlockNonceNotAvailable))
+	})
+}
+
+func TestNode_GetLogsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForLogs(t, createHistoryRepoForLogs(), createLogsForNode())
+	address := hex.EncodeToString(logsContractAddress)
+
+	response, err := n.GetLogs(&common.LogsQuery{Address: address})
+	require.Nil(t, err)
+	require.False(t, response.Truncated)
+	events := response.Logs
+	require.Equal(t, 3, len(events))
+	require.Equal(t, hex.EncodeToString([]byte("tx1")), events[0].TxHash)
+	require.Equal(t, uint64(5), events[0].BlockNonce)
+	require.Equal(t, uint32(0), events[0].Epoch)
+	require.Equal(t, address, events[0].Address)
+	require.Equal(t, "transfer", events[0].Identifier)
+	require.Equal(t, hex.EncodeToString([]byte("tx2")), events[1].TxHash)
+	require.Equal(t, hex.EncodeToString([]byte("tx3")), events[2].TxHash)
+	require.Equal(t, uint32(1), events[2].Epoch)
+
+	response, err = n.GetLogs(&common.LogsQuery{Address: address, Identifier: "transfer", FromNonce: 6})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(response.Logs))
+	require.Equal(t, uint64(25), response.Logs[0].BlockNonce)
+
+	response, err = n.GetLogs(&common.LogsQuery{Address: address, Topic: hex.EncodeToString([]byte("b")), ToNonce: 20})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(response.Logs))
+	require.Equal(t, "mint", response.Logs[0].Identifier)
+}
+
+func TestNode_GetLogsShouldStopAtTheMaxNumberOfBuckets(t *testing.T) {
+	t.Parallel()
+
+	readBuckets := make([]uint64, 0)
+	historyRepo := createHistoryRepoForLogs()
+	getLogsTxHashes := historyRepo.GetLogsTxHashesCalled
+	historyRepo.GetLogsTxHashesCalled = func(address []byte, identifier []byte, blockNonce uint64, epoch uint32) (*dblookupext.LogsIndexRecord, error) {
+		readBuckets = append(readBuckets, blockNonce/dblookupext.LogsIndexNumBlocksPerBucket)
+		return getLogsTxHashes(address, identifier, blockNonce, epoch)
+	}
+	n := createNodeForLogs(t, historyRepo, createLogsForNode(), node.WithMaxNumLogsBucketsPerQuery(2))
+	address := hex.EncodeToString(logsContractAddress)
+
+	response, err := n.GetLogs(&common.LogsQuery{Address: address, FromNonce: 10, ToNonce: 1000})
+	require.Nil(t, err)
+	require.True(t, response.Truncated)
+	require.Equal(t, uint64(2*dblookupext.LogsIndexNumBlocksPerBucket), response.NextFromNonce)
+	require.Equal(t, uint32(0), response.NextFromEventIndex)
+	require.Equal(t, 2, len(response.Logs))
+	for _, bucket := range readBuckets {
+		require.Less(t, bucket, uint64(2))
+	}
+
+	response, err = n.GetLogs(&common.LogsQuery{Address: address, FromNonce: response.NextFromNonce, ToNonce: 1000})
+	require.Nil(t, err)
+	require.True(t, response.Truncated)
+	require.Equal(t, uint64(4*dblookupext.LogsIndexNumBlocksPerBucket), response.NextFromNonce)
+
+	response, err = n.GetLogs(&common.LogsQuery{Address: address, FromNonce: 10, ToNonce: 199})
+	require.Nil(t, err)
+	require.False(t, response.Truncated)
+}
+
+func TestNode_GetLogsShouldPaginate(t *testing.T) {
+	t.Parallel()
+
+	numEventsInLargeLog := node.MaxNumLogEventsPerQuery + 500
+	largeLog := &transaction.Log{}
+	for i := 0; i < numEventsInLargeLog; i++ {
+		largeLog.Events = append(largeLog.Events, &transaction.Event{
+			Address:    logsContractAddress,
+			Identifier: []byte("transfer"),
+			Data:       []byte(fmt.Sprintf("%d", i)),
+		})
+	}
+	logs := createLogsForNode()
+	logs["large"] = largeLog
+
+	historyRepo := createHistoryRepoForLogs()
+	historyRepo.GetLogsTxHashesCalled = func(address []byte, identifier []byte, blockNonce uint64, epoch uint32) (*dblookupext.LogsIndexRecord, error) {
+		// nonce / 20 is the epoch of a block, as seen by the repository stub
+		bucket := blockNonce / dblookupext.LogsIndexNumBlocksPerBucket
+		switch {
+		case bucket == 0 && epoch == 0:
+			return &dblookupext.LogsIndexRecord{Blocks: []*dblookupext.TxHashesInBlock{{BlockNonce: 5, TxHashes: [][]byte{[]byte("tx1")}}}}, nil
+		case bucket == 1 && epoch == 5:
+			return &dblookupext.LogsIndexRecord{Blocks: []*dblookupext.TxHashesInBlock{{BlockNonce: 105, TxHashes: [][]byte{[]byte("large")}}}}, nil
+		case bucket == 1 && epoch == 7:
+			return &dblookupext.LogsIndexRecord{Blocks: []*dblookupext.TxHashesInBlock{{BlockNonce: 150, TxHashes: [][]byte{[]byte("tx3")}}}}, nil
+		default:
+			return nil, storage.ErrKeyNotFound
+		}
+	}
+	n := createNodeForLogs(t, historyRepo, logs)
+	address := hex.EncodeToString(logsContractAddress)
+
+	response, err := n.GetLogs(&common.LogsQuery{Address: address, ToNonce: 200})
+	require.Nil(t, err)
+	require.Equal(t, node.MaxNumLogEventsPerQuery, len(response.Logs))
+	require.True(t, response.Truncated)
+	require.Equal(t, uint64(105), response.NextFromNonce)
+	require.Equal(t, uint32(node.MaxNumLogEventsPerQuery-1), response.NextFromEventIndex)
+	require.Equal(t, uint64(5), response.Logs[0].BlockNonce)
+	require.Equal(t, []byte(fmt.Sprintf("%d", node.MaxNumLogEventsPerQuery-2)), response.Logs[node.MaxNumLogEventsPerQuery-1].Data)
+
+	response, err = n.GetLogs(&common.LogsQuery{
+		Address:        address,
+		ToNonce:        200,
+		FromNonce:      response.NextFromNonce,
+		FromEventIndex: response.NextFromEventIndex,
+	})
+	require.Nil(t, err)
+	require.False(t, response.Truncated)
+	require.Equal(t, numEventsInLargeLog-(node.MaxNumLogEventsPerQuery-1)+1, len(response.Logs))
+	require.Equal(t, []byte(fmt.Sprintf("%d", node.MaxNumLogEventsPerQuery-1)), response.Logs[0].Data)
+	require.Equal(t, uint64(150), response.Logs[len(response.Logs)-1].BlockNonce)
+
+	// a page filled exactly by the remaining events is not truncated
+	response, err = n.GetLogs(&common.LogsQuery{
+		Address:        address,
+		ToNonce:        149,
+		FromNonce:      105,
+		FromEventIndex: uint32(numEventsInLargeLog - node.MaxNumLogEventsPerQuery),
+	})
+	require.Nil(t, err)
+	require.Equal(t, node.MaxNumLogEventsPerQuery, len(response.Logs))
+	require.False(t, response.Truncated)
+}
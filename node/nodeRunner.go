@@ -27,6 +27,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	dbLookupFactory "github.com/ElrondNetwork/elrond-go/dblookupext/factory"
+	"github.com/ElrondNetwork/elrond-go/debug/tracing"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/ElrondNetwork/elrond-go/facade/initial"
 	mainFactory "github.com/ElrondNetwork/elrond-go/factory"
@@ -34,6 +35,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/health"
 	"github.com/ElrondNetwork/elrond-go/node/metrics"
 	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/replay"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
@@ -55,6 +57,8 @@ const (
 // nodeRunner holds the node runner configuration and controls running of a node
 type nodeRunner struct {
 	configs *config.Configs
+	// outportReplayDone prevents replaying the outport blocks again after a soft restart
+	outportReplayDone bool
 }
 
 // NewNodeRunner creates a nodeRunner instance
@@ -326,6 +330,24 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	err = nr.replayOutportBlocksIfNeeded(
+		managedCoreComponents,
+		managedBootstrapComponents,
+		managedDataComponents,
+		managedStatusComponents,
+	)
+	if err != nil {
+		return true, err
+	}
+
+	err = tracing.Start(tracing.ArgsTracer{
+		Config:  configs.GeneralConfig.Debug.Tracing,
+		ShardID: managedBootstrapComponents.ShardCoordinator().SelfId(),
+	})
+	if err != nil {
+		return true, err
+	}
+
 	argsGasScheduleNotifier := forking.ArgsNewGasScheduleNotifier{
 		GasScheduleConfig: configs.EpochConfig.GasSchedule,
 		ConfigDir:         configurationPaths.GasScheduleDirectoryName,
@@ -420,8 +442,13 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		)
 	}
 
+	antifloodReloader, err := nr.createAntifloodConfigReloader(currentNode, webServerHandler)
+	if err != nil {
+		return true, err
+	}
+
 	log.Debug("updating the API service after creating the node facade")
-	ef, err := nr.createApiFacade(currentNode, webServerHandler, gasScheduleNotifier)
+	ef, err := nr.createApiFacade(currentNode, webServerHandler, gasScheduleNotifier, antifloodReloader)
 	if err != nil {
 		return true, err
 	}
@@ -430,6 +457,15 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 
+	sigHup := make(chan os.Signal, 1)
+	signal.Notify(sigHup, syscall.SIGHUP)
+	chanStopReloading := make(chan struct{})
+	go reloadAntifloodConfigOnSignal(sigHup, chanStopReloading, antifloodReloader)
+	defer func() {
+		signal.Stop(sigHup)
+		close(chanStopReloading)
+	}()
+
 	err = waitForSignal(
 		sigs,
 		managedCoreComponents.ChanStopNodeProcess(),
@@ -450,6 +486,7 @@ func (nr *nodeRunner) createApiFacade(
 	currentNode *Node,
 	upgradableHttpServer shared.UpgradeableHttpServerHandler,
 	gasScheduleNotifier core.GasScheduleNotifier,
+	antifloodReloader facade.AntifloodConfigReloader,
 ) (closing.Closer, error) {
 	configs := nr.configs
 
@@ -485,10 +522,13 @@ func (nr *nodeRunner) createApiFacade(
 			RestApiInterface: flagsConfig.RestApiInterface,
 			PprofEnabled:     flagsConfig.EnablePprof,
 		},
-		ApiRoutesConfig: *configs.ApiRoutesConfig,
-		AccountsState:   currentNode.stateComponents.AccountsAdapter(),
-		PeerState:       currentNode.stateComponents.PeerAccounts(),
-		Blockchain:      currentNode.dataComponents.Blockchain(),
+		ApiRoutesConfig:   *configs.ApiRoutesConfig,
+		TxSimulatorConfig: configs.GeneralConfig.TxSimulator,
+		AccountsState:     currentNode.stateComponents.AccountsAdapter(),
+		PeerState:         currentNode.stateComponents.PeerAccounts(),
+		Blockchain:        currentNode.dataComponents.Blockchain(),
+		SubscriptionsHub:  currentNode.statusComponents.SubscriptionsHub(),
+		AntifloodReloader: antifloodReloader,
 	}
 
 	ef, err := facade.NewNodeFacade(argNodeFacade)
@@ -510,6 +550,44 @@ func (nr *nodeRunner) createApiFacade(
 	return ef, nil
 }
 
+func (nr *nodeRunner) createAntifloodConfigReloader(
+	currentNode *Node,
+	httpServer shared.UpgradeableHttpServerHandler,
+) (facade.AntifloodConfigReloader, error) {
+	antifloodReloader, err := NewAntifloodConfigReloader(ArgsAntifloodConfigReloader{
+		MainConfigPath: nr.configs.ConfigurationPathsHolder.MainConfig,
+		Handlers: []process.AntifloodConfigHandler{
+			currentNode.networkComponents.AntifloodConfigHandler(),
+			httpServer,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return antifloodReloader, nil
+}
+
+// reloadAntifloodConfigOnSignal reloads the antiflood configuration each time the node process receives SIGHUP
+func reloadAntifloodConfigOnSignal(
+	sigHup chan os.Signal,
+	chanStop chan struct{},
+	antifloodReloader facade.AntifloodConfigReloader,
+) {
+	for {
+		select {
+		case <-sigHup:
+			log.Info("reloading the antiflood configuration at user's signal...")
+			_, err := antifloodReloader.Reload()
+			if err != nil {
+				log.Error("the antiflood configuration was not reloaded", "error", err)
+			}
+		case <-chanStop:
+			return
+		}
+	}
+}
+
 func (nr *nodeRunner) createHttpServer() (shared.UpgradeableHttpServerHandler, error) {
 	httpServerArgs := gin.ArgsNewWebServer{
 		Facade:          initial.NewInitialNodeFacade(nr.configs.FlagsConfig.RestApiInterface, nr.configs.FlagsConfig.EnablePprof),
@@ -827,6 +905,39 @@ func (nr *nodeRunner) CreateManagedStatusComponents(
 	return managedStatusComponents, nil
 }
 
+// replayOutportBlocksIfNeeded re-emits the requested range of blocks from storage towards the outport drivers, before
+// the node starts processing new blocks
+func (nr *nodeRunner) replayOutportBlocksIfNeeded(
+	managedCoreComponents mainFactory.CoreComponentsHandler,
+	managedBootstrapComponents mainFactory.BootstrapComponentsHandler,
+	managedDataComponents mainFactory.DataComponentsHandler,
+	managedStatusComponents mainFactory.StatusComponentsHandler,
+) error {
+	flagsConfig := nr.configs.FlagsConfig
+	if flagsConfig.OutportReplayEndNonce == 0 || nr.outportReplayDone {
+		return nil
+	}
+
+	blocksReplayer, err := replay.NewBlocksReplayer(replay.ArgsBlocksReplayer{
+		Storage:                  managedDataComponents.StorageService(),
+		Marshalizer:              managedCoreComponents.InternalMarshalizer(),
+		Uint64ByteSliceConverter: managedCoreComponents.Uint64ByteSliceConverter(),
+		OutportHandler:           managedStatusComponents.OutportHandler(),
+		ShardID:                  managedBootstrapComponents.ShardCoordinator().SelfId(),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = blocksReplayer.Replay(flagsConfig.OutportReplayStartNonce, flagsConfig.OutportReplayEndNonce)
+	if err != nil {
+		return fmt.Errorf("outport blocks replay failed: %w", err)
+	}
+	nr.outportReplayDone = true
+
+	return nil
+}
+
 func (nr *nodeRunner) logSessionInformation(
 	workingDir string,
 	sessionInfoFileOutput string,
@@ -1068,14 +1179,15 @@ func (nr *nodeRunner) CreateManagedBootstrapComponents(
 ) (mainFactory.BootstrapComponentsHandler, error) {
 
 	bootstrapComponentsFactoryArgs := mainFactory.BootstrapComponentsFactoryArgs{
-		Config:            *nr.configs.GeneralConfig,
-		EpochConfig:       *nr.configs.EpochConfig,
-		PrefConfig:        *nr.configs.PreferencesConfig,
-		ImportDbConfig:    *nr.configs.ImportDbConfig,
-		WorkingDir:        nr.configs.FlagsConfig.WorkingDir,
-		CoreComponents:    managedCoreComponents,
-		CryptoComponents:  managedCryptoComponents,
-		NetworkComponents: managedNetworkComponents,
+		Config:                 *nr.configs.GeneralConfig,
+		EpochConfig:            *nr.configs.EpochConfig,
+		PrefConfig:             *nr.configs.PreferencesConfig,
+		ImportDbConfig:         *nr.configs.ImportDbConfig,
+		ImportSnapshotFilePath: nr.configs.FlagsConfig.ImportSnapshotFilePath,
+		WorkingDir:             nr.configs.FlagsConfig.WorkingDir,
+		CoreComponents:         managedCoreComponents,
+		CryptoComponents:       managedCryptoComponents,
+		NetworkComponents:      managedNetworkComponents,
 	}
 
 	bootstrapComponentsFactory, err := mainFactory.NewBootstrapComponentsFactory(bootstrapComponentsFactoryArgs)
@@ -1110,11 +1222,13 @@ func (nr *nodeRunner) CreateManagedNetworkComponents(
 		MainConfig:           *nr.configs.GeneralConfig,
 		RatingsConfig:        *nr.configs.RatingsConfig,
 		StatusHandler:        managedCoreComponents.StatusHandler(),
+		MetricsHandler:       managedCoreComponents.MetricsHandler(),
 		Marshalizer:          managedCoreComponents.InternalMarshalizer(),
 		Syncer:               managedCoreComponents.SyncTimer(),
 		PreferredPublicKeys:  decodedPreferredPubKeys,
 		BootstrapWaitSeconds: common.SecondsToWaitForP2PBootstrap,
 		NodeOperationMode:    p2p.NormalOperation,
+		PathManager:          managedCoreComponents.PathHandler(),
 	}
 	if nr.configs.ImportDbConfig.IsImportDBMode {
 		networkComponentsFactoryArgs.BootstrapWaitSeconds = 0
@@ -1234,6 +1348,9 @@ func closeAllComponents(
 	log.Debug("closing node")
 	log.LogIfError(node.Close())
 
+	log.Debug("closing tracing")
+	log.LogIfError(tracing.Close())
+
 	chanCloseComponents <- struct{}{}
 }
 
@@ -0,0 +1,115 @@
+package node
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+)
+
+// GetTransactionReplayData returns what is needed for re-executing an already executed transaction: the root hash of
+// the state its block was executed on, along with the transactions executed in the same block before it. Only the
+// transactions from the transaction miniblocks are gathered, so the preceding smart contract results and rewards are
+// not re-executed. The returned data signals if there were any
+func (n *Node) GetTransactionReplayData(txHash string) (*txSimData.ReplayData, error) {
+	historyRepository := n.processComponents.HistoryRepository()
+	if !historyRepository.IsEnabled() {
+		return nil, ErrDBLookupExtensionsNotEnabled
+	}
+
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	miniblockMetadata, err := historyRepository.GetMiniblockMetadataByTxHash(hash)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrTransactionNotFound.Error(), err)
+	}
+	if block.Type(miniblockMetadata.Type) != block.TxBlock {
+		return nil, fmt.Errorf("%w: only the transactions from %s miniblocks can be replayed",
+			ErrCannotReplayTransaction, block.TxBlock.String())
+	}
+
+	blockHeader, err := n.getBlockHeaderByHash(miniblockMetadata.HeaderHash)
+	if err != nil {
+		return nil, err
+	}
+	previousHeader, err := n.getBlockHeaderByHash(blockHeader.GetPrevHash())
+	if err != nil {
+		return nil, err
+	}
+
+	replayData := &txSimData.ReplayData{
+		RootHash:    previousHeader.GetRootHash(),
+		PreviousTxs: make([]*transaction.Transaction, 0),
+	}
+	for _, miniblockHash := range blockHeader.GetMiniBlockHeadersHashes() {
+		miniblock, errGet := n.getMiniblockFromEpoch(miniblockHash, miniblockMetadata.Epoch)
+		if errGet != nil {
+			return nil, errGet
+		}
+		if miniblock.Type != block.TxBlock {
+			replayData.SkippedPreviousExecutions = replayData.SkippedPreviousExecutions || isReplaySkippedMiniblock(miniblock)
+			continue
+		}
+
+		isTxMiniblock := bytes.Equal(miniblockHash, miniblockMetadata.MiniblockHash)
+		for _, hashInMiniblock := range miniblock.TxHashes {
+			tx, errGet := n.getTransactionFromEpoch(hashInMiniblock, miniblockMetadata.Epoch)
+			if errGet != nil {
+				return nil, errGet
+			}
+
+			if isTxMiniblock && bytes.Equal(hashInMiniblock, hash) {
+				replayData.Tx = tx
+				return replayData, nil
+			}
+			replayData.PreviousTxs = append(replayData.PreviousTxs, tx)
+		}
+	}
+
+	return nil, fmt.Errorf("%w: transaction not found in its block", ErrCannotReplayTransaction)
+}
+
+func isReplaySkippedMiniblock(miniblock *block.MiniBlock) bool {
+	isExecutedMiniblock := miniblock.Type == block.SmartContractResultBlock || miniblock.Type == block.RewardsBlock
+
+	return isExecutedMiniblock && len(miniblock.TxHashes) > 0
+}
+
+func (n *Node) getMiniblockFromEpoch(miniblockHash []byte, epoch uint32) (*block.MiniBlock, error) {
+	storer := n.dataComponents.StorageService().GetStorer(dataRetriever.MiniBlockUnit)
+	miniblockBytes, err := storer.GetFromEpoch(miniblockHash, epoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w while searching the miniblock with hash %s", err, hex.EncodeToString(miniblockHash))
+	}
+
+	miniblock := &block.MiniBlock{}
+	err = n.coreComponents.InternalMarshalizer().Unmarshal(miniblock, miniblockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return miniblock, nil
+}
+
+func (n *Node) getTransactionFromEpoch(txHash []byte, epoch uint32) (*transaction.Transaction, error) {
+	storer := n.dataComponents.StorageService().GetStorer(dataRetriever.TransactionUnit)
+	txBytes, err := storer.GetFromEpoch(txHash, epoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w while searching the transaction with hash %s", err, hex.EncodeToString(txHash))
+	}
+
+	tx := &transaction.Transaction{}
+	err = n.coreComponents.InternalMarshalizer().Unmarshal(tx, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,176 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	dbLookupExtMock "github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
+	"github.com/stretchr/testify/require"
+)
+
+const replayEpoch = uint32(3)
+
+// createNodeForTransactionReplay creates a node holding, in epoch 3, the block "hash5" on top of the block "hash4" with
+// the root hash "root4". The block "hash5" contains, in order, the transaction miniblock "mbA" with the transaction
+// "txA", the smart contract results miniblock "mbSCR" and the transaction miniblock "mbB" with the transactions "txB1",
+// "txB2" and "txB3"
+func createNodeForTransactionReplay(t *testing.T, miniblockType block.Type) *node.Node {
+	marshalizer := &mock.MarshalizerFake{}
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = marshalizer
+
+	units := map[dataRetriever.UnitType]map[string]interface{}{
+		dataRetriever.BlockHeaderUnit: {
+			"hash4": &block.Header{Nonce: 4, RootHash: []byte("root4")},
+			"hash5": &block.Header{
+				Nonce:    5,
+				PrevHash: []byte("hash4"),
+				RootHash: []byte("root5"),
+				MiniBlockHeaders: []block.MiniBlockHeader{
+					{Hash: []byte("mbA")},
+					{Hash: []byte("mbSCR")},
+					{Hash: []byte("mbB")},
+				},
+			},
+		},
+		dataRetriever.MiniBlockUnit: {
+			"mbA":   &block.MiniBlock{Type: block.TxBlock, TxHashes: [][]byte{[]byte("txA")}},
+			"mbSCR": &block.MiniBlock{Type: block.SmartContractResultBlock, TxHashes: [][]byte{[]byte("scr")}},
+			"mbB":   &block.MiniBlock{Type: block.TxBlock, TxHashes: [][]byte{[]byte("txB1"), []byte("txB2"), []byte("txB3")}},
+		},
+		dataRetriever.TransactionUnit: {
+			"txA":  &transaction.Transaction{Nonce: 1},
+			"txB1": &transaction.Transaction{Nonce: 2},
+			"txB2": &transaction.Transaction{Nonce: 3},
+			"txB3": &transaction.Transaction{Nonce: 4},
+		},
+	}
+
+	dataComponents := getDefaultDataComponents()
+	dataComponents.Store = &mock.ChainStorerStub{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &testscommon.StorerStub{
+				GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+					require.Equal(t, replayEpoch, epoch)
+
+					object, found := units[unitType][string(key)]
+					if !found {
+						return nil, storage.ErrKeyNotFound
+					}
+
+					return marshalizer.Marshal(object)
+				},
+			}
+		},
+	}
+
+	processComponents := getDefaultProcessComponents()
+	processComponents.HistoryRepositoryInternal = &dbLookupExtMock.HistoryRepositoryStub{
+		IsEnabledCalled: func() bool {
+			return true
+		},
+		GetEpochByHashCalled: func(hash []byte) (uint32, error) {
+			return replayEpoch, nil
+		},
+		GetMiniblockMetadataByTxHashCalled: func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+			miniblockHashes := map[string]string{
+				"txA":  "mbA",
+				"txB2": "mbB",
+			}
+			miniblockHash, found := miniblockHashes[string(hash)]
+			if !found {
+				return nil, storage.ErrKeyNotFound
+			}
+
+			return &dblookupext.MiniblockMetadata{
+				Type:          int32(miniblockType),
+				Epoch:         replayEpoch,
+				HeaderHash:    []byte("hash5"),
+				MiniblockHash: []byte(miniblockHash),
+			}, nil
+		},
+	}
+
+	n, err := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithDataComponents(dataComponents),
+		node.WithProcessComponents(processComponents),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestNode_GetTransactionReplayDataShouldWork(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForTransactionReplay(t, block.TxBlock)
+
+	replayData, err := n.GetTransactionReplayData(hex.EncodeToString([]byte("txB2")))
+	require.Nil(t, err)
+	require.Equal(t, []byte("root4"), replayData.RootHash)
+	require.Equal(t, uint64(3), replayData.Tx.Nonce)
+	require.Len(t, replayData.PreviousTxs, 2)
+	require.Equal(t, uint64(1), replayData.PreviousTxs[0].Nonce)
+	require.Equal(t, uint64(2), replayData.PreviousTxs[1].Nonce)
+	require.True(t, replayData.SkippedPreviousExecutions)
+}
+
+func TestNode_GetTransactionReplayDataBeforeTheSmartContractResultsShouldNotSignalSkippedExecutions(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForTransactionReplay(t, block.TxBlock)
+
+	replayData, err := n.GetTransactionReplayData(hex.EncodeToString([]byte("txA")))
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), replayData.Tx.Nonce)
+	require.Empty(t, replayData.PreviousTxs)
+	require.False(t, replayData.SkippedPreviousExecutions)
+}
+
+func TestNode_GetTransactionReplayDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("db lookup extensions not enabled", func(t *testing.T) {
+		t.Parallel()
+
+		processComponents := getDefaultProcessComponents()
+		processComponents.HistoryRepositoryInternal = &dbLookupExtMock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}
+		n, _ := node.NewNode(node.WithProcessComponents(processComponents))
+
+		replayData, err := n.GetTransactionReplayData(hex.EncodeToString([]byte("txB2")))
+		require.Nil(t, replayData)
+		require.Equal(t, node.ErrDBLookupExtensionsNotEnabled, err)
+	})
+	t.Run("unknown transaction", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForTransactionReplay(t, block.TxBlock)
+
+		replayData, err := n.GetTransactionReplayData(hex.EncodeToString([]byte("unknown")))
+		require.Nil(t, replayData)
+		require.True(t, errors.Is(err, storage.ErrKeyNotFound))
+	})
+	t.Run("not a transaction miniblock", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForTransactionReplay(t, block.InvalidBlock)
+
+		replayData, err := n.GetTransactionReplayData(hex.EncodeToString([]byte("txB2")))
+		require.Nil(t, replayData)
+		require.True(t, errors.Is(err, node.ErrCannotReplayTransaction))
+	})
+}
@@ -0,0 +1,299 @@
+package node
+
+import (
+	"bytes"
+	"container/heap"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+// MaxTxPoolPageSize represents the maximum number of transactions returned by a single transactions pool query
+const MaxTxPoolPageSize = 100
+
+const initialTxPoolPageHeapCapacity = 1000
+
+// GetTransactionsPool returns a page of the transactions from the pool matching the provided filters. The transactions
+// are ordered by source shard, destination shard, sender and nonce
+func (n *Node) GetTransactionsPool(options common.TxPoolQueryOptions) (*common.TxPoolTransactionsResponse, error) {
+	if options.Size == 0 || options.Size > MaxTxPoolPageSize {
+		return nil, fmt.Errorf("%w: size should be between 1 and %d", ErrInvalidPageSize, MaxTxPoolPageSize)
+	}
+
+	pool, err := n.getTxPoolInspector()
+	if err != nil {
+		return nil, err
+	}
+
+	var sender []byte
+	if len(options.Sender) > 0 {
+		sender, err = n.coreComponents.AddressPubKeyConverter().Decode(options.Sender)
+		if err != nil {
+			return nil, fmt.Errorf("%w for address %s", err, options.Sender)
+		}
+	}
+
+	// only the first From+Size matching transactions are kept, so that a page does not need the whole pool sorted
+	from := core.MinUint64(options.From, math.MaxInt32)
+	maxNumKept := int(from + options.Size)
+	total := uint64(0)
+	kept := make(txPoolPageHeap, 0, core.MinInt(maxNumKept, initialTxPoolPageHeapCapacity))
+	pool.ForEachTransaction(func(_ []byte, tx *txcache.WrappedTransaction) {
+		if isTxPoolTransactionMatching(tx, sender, options) {
+			total++
+			kept.add(tx, maxNumKept)
+		}
+	})
+
+	sorted := make([]*txcache.WrappedTransaction, kept.Len())
+	for i := len(sorted) - 1; i >= 0; i-- {
+		sorted[i] = heap.Pop(&kept).(*txcache.WrappedTransaction)
+	}
+
+	transactions := make([]*common.TxPoolTransaction, 0)
+	for i := from; i < uint64(len(sorted)); i++ {
+		transactions = append(transactions, n.convertTxPoolTransaction(sorted[i]))
+	}
+
+	return &common.TxPoolTransactionsResponse{
+		Transactions: transactions,
+		Total:        total,
+	}, nil
+}
+
+// GetTransactionsPoolForSender returns the nonce-ordered transactions of a sender from the pool, highlighting the gaps
+// between the account nonce and the nonces of the transactions
+func (n *Node) GetTransactionsPoolForSender(address string) (*common.TxPoolSenderResponse, error) {
+	if len(address) == 0 {
+		return nil, ErrEmptyAddress
+	}
+
+	pool, err := n.getTxPoolInspector()
+	if err != nil {
+		return nil, err
+	}
+
+	accountHandler, err := n.getAccountHandlerAPIAccounts(address, common.AccountQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.TxPoolSenderResponse{
+		Sender:       address,
+		AccountNonce: accountHandler.GetNonce(),
+		Transactions: make([]*common.TxPoolSenderTransaction, 0),
+		NonceGaps:    make([]*common.TxPoolNonceGap, 0),
+	}
+
+	snapshot, ok := pool.GetSenderSnapshot(accountHandler.AddressBytes())
+	if !ok {
+		return response, nil
+	}
+
+	response.Score = snapshot.Score
+	response.NumFailedSelections = snapshot.NumFailedSelections
+	response.IsInGracePeriod = snapshot.IsInGracePeriod
+	response.NumBytes = snapshot.NumBytes
+
+	expectedNonce := response.AccountNonce
+	for _, tx := range snapshot.Transactions {
+		nonce := tx.Tx.GetNonce()
+		if nonce > expectedNonce {
+			response.NonceGaps = append(response.NonceGaps, &common.TxPoolNonceGap{
+				From: expectedNonce,
+				To:   nonce - 1,
+			})
+		}
+		if nonce >= expectedNonce {
+			expectedNonce = nonce + 1
+		}
+
+		response.Transactions = append(response.Transactions, &common.TxPoolSenderTransaction{
+			TxPoolTransaction: n.convertTxPoolTransaction(tx),
+			PrecededByGap:     len(response.NonceGaps) > 0,
+		})
+	}
+
+	return response, nil
+}
+
+// GetTransactionsPoolStatistics returns aggregate information about the transactions pool, by cache
+func (n *Node) GetTransactionsPoolStatistics() (*common.TxPoolStatisticsResponse, error) {
+	pool, err := n.getTxPoolInspector()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.TxPoolStatisticsResponse{
+		Caches: make([]*common.TxPoolCacheStatistics, 0),
+	}
+	for cacheID, stats := range pool.GetStatistics() {
+		response.NumTxs += stats.NumTxs
+		response.NumBytes += stats.NumBytes
+		response.NumSenders += stats.NumSenders
+		response.Caches = append(response.Caches, &common.TxPoolCacheStatistics{
+			CacheID:           cacheID,
+			NumTxs:            stats.NumTxs,
+			NumBytes:          stats.NumBytes,
+			NumSenders:        stats.NumSenders,
+			NumEvictedTxs:     stats.NumEvictedTxs,
+			NumSweptTxs:       stats.NumSweptTxs,
+			NumReplacedTxs:    stats.NumReplacedTxs,
+			NumExpiredTxs:     stats.NumExpiredTxs,
+			ScoreDistribution: stats.ScoreDistribution,
+		})
+	}
+	sort.Slice(response.Caches, func(i, j int) bool {
+		return response.Caches[i].CacheID < response.Caches[j].CacheID
+	})
+
+	return response, nil
+}
+
+// GetEvictedTransactionFromPool returns the details about a transaction recently evicted from the pool (dropped,
+// swept or replaced) without being included in a block
+func (n *Node) GetEvictedTransactionFromPool(txHash string) (*common.TxPoolEvictedTransaction, error) {
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := n.getTxPoolInspector()
+	if err != nil {
+		return nil, err
+	}
+
+	evicted, cacheID, ok := pool.GetEvictedTransaction(hash)
+	if !ok {
+		return nil, ErrTransactionNotRecentlyEvicted
+	}
+
+	return &common.TxPoolEvictedTransaction{
+		TxHash:    txHash,
+		Sender:    n.coreComponents.AddressPubKeyConverter().Encode(evicted.Sender),
+		CacheID:   cacheID,
+		Reason:    evicted.Reason,
+		Timestamp: evicted.Timestamp,
+	}, nil
+}
+
+func (n *Node) getTxPoolInspector() (txPoolInspector, error) {
+	if check.IfNil(n.dataComponents.Datapool()) {
+		return nil, ErrNilDataPool
+	}
+
+	pool, ok := n.dataComponents.Datapool().Transactions().(txPoolInspector)
+	if !ok {
+		return nil, ErrTxPoolInspectionNotSupported
+	}
+
+	return pool, nil
+}
+
+func (n *Node) convertTxPoolTransaction(tx *txcache.WrappedTransaction) *common.TxPoolTransaction {
+	value := "0"
+	if tx.Tx.GetValue() != nil {
+		value = tx.Tx.GetValue().String()
+	}
+
+	addressPubKeyConverter := n.coreComponents.AddressPubKeyConverter()
+
+	return &common.TxPoolTransaction{
+		TxHash:           hex.EncodeToString(tx.TxHash),
+		Sender:           addressPubKeyConverter.Encode(tx.Tx.GetSndAddr()),
+		Receiver:         addressPubKeyConverter.Encode(tx.Tx.GetRcvAddr()),
+		Nonce:            tx.Tx.GetNonce(),
+		Value:            value,
+		GasPrice:         tx.Tx.GetGasPrice(),
+		GasLimit:         tx.Tx.GetGasLimit(),
+		SourceShard:      tx.SenderShardID,
+		DestinationShard: tx.ReceiverShardID,
+		Size:             tx.Size,
+	}
+}
+
+func isTxPoolTransactionMatching(tx *txcache.WrappedTransaction, sender []byte, options common.TxPoolQueryOptions) bool {
+	if len(sender) > 0 && !bytes.Equal(sender, tx.Tx.GetSndAddr()) {
+		return false
+	}
+	if options.HasSourceShard && options.SourceShard != tx.SenderShardID {
+		return false
+	}
+	if options.HasDestinationShard && options.DestinationShard != tx.ReceiverShardID {
+		return false
+	}
+
+	return tx.Tx.GetGasPrice() >= options.MinGasPrice
+}
+
+func isTxPoolTransactionBefore(first *txcache.WrappedTransaction, second *txcache.WrappedTransaction) bool {
+	if first.SenderShardID != second.SenderShardID {
+		return first.SenderShardID < second.SenderShardID
+	}
+	if first.ReceiverShardID != second.ReceiverShardID {
+		return first.ReceiverShardID < second.ReceiverShardID
+	}
+	comparison := bytes.Compare(first.Tx.GetSndAddr(), second.Tx.GetSndAddr())
+	if comparison != 0 {
+		return comparison < 0
+	}
+	if first.Tx.GetNonce() != second.Tx.GetNonce() {
+		return first.Tx.GetNonce() < second.Tx.GetNonce()
+	}
+
+	return bytes.Compare(first.TxHash, second.TxHash) < 0
+}
+
+// txPoolPageHeap is a max heap, by the order of the transactions pool queries, of the first transactions found so far
+type txPoolPageHeap []*txcache.WrappedTransaction
+
+// Len returns the number of transactions in the heap
+func (tph txPoolPageHeap) Len() int {
+	return len(tph)
+}
+
+// Less returns true if the transaction at index i comes after the one at index j
+func (tph txPoolPageHeap) Less(i, j int) bool {
+	return isTxPoolTransactionBefore(tph[j], tph[i])
+}
+
+// Swap swaps the transactions at the provided indexes
+func (tph txPoolPageHeap) Swap(i, j int) {
+	tph[i], tph[j] = tph[j], tph[i]
+}
+
+// Push adds a transaction to the heap
+func (tph *txPoolPageHeap) Push(x interface{}) {
+	*tph = append(*tph, x.(*txcache.WrappedTransaction))
+}
+
+// Pop removes the last transaction from the heap
+func (tph *txPoolPageHeap) Pop() interface{} {
+	old := *tph
+	last := old[len(old)-1]
+	*tph = old[:len(old)-1]
+
+	return last
+}
+
+func (tph *txPoolPageHeap) add(tx *txcache.WrappedTransaction, maxLen int) {
+	if maxLen == 0 {
+		return
+	}
+	if tph.Len() < maxLen {
+		heap.Push(tph, tx)
+		return
+	}
+	if !isTxPoolTransactionBefore(tx, (*tph)[0]) {
+		return
+	}
+
+	(*tph)[0] = tx
+	heap.Fix(tph, 0)
+}
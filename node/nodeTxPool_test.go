@@ -0,0 +1,327 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	txPoolAlice = []byte("alice...........................")
+	txPoolBob   = []byte("bob.............................")
+)
+
+func createNodeWithTxPool(t *testing.T, pool dataRetriever.PoolsHolder, accountNonce uint64) *node.Node {
+	accountsAdapter := &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			account, _ := state.NewUserAccount(address)
+			account.Nonce = accountNonce
+			return account, nil
+		},
+		RecreateTrieCalled: func(_ []byte) error {
+			return nil
+		},
+	}
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = accountsAdapter
+
+	dataComponents := getDefaultDataComponents()
+	dataComponents.DataPool = pool
+	dataComponents.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{}
+		},
+	}
+
+	n, err := node.NewNode(
+		node.WithCoreComponents(getDefaultCoreComponents()),
+		node.WithStateComponents(stateComponents),
+		node.WithDataComponents(dataComponents),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func addTxToPool(pool dataRetriever.PoolsHolder, hash string, sender []byte, nonce uint64, gasPrice uint64, cacheID string) {
+	tx := &transaction.Transaction{
+		Nonce:    nonce,
+		Value:    big.NewInt(10),
+		SndAddr:  sender,
+		RcvAddr:  txPoolBob,
+		GasPrice: gasPrice,
+		GasLimit: 50000,
+	}
+	pool.Transactions().AddData([]byte(hash), tx, 100, cacheID)
+}
+
+func TestNode_GetTransactionsPoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid page size", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeWithTxPool(t, dataRetrieverMock.NewPoolsHolderMock(), 0)
+		response, err := n.GetTransactionsPool(common.TxPoolQueryOptions{Size: 0})
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, node.ErrInvalidPageSize))
+
+		response, err = n.GetTransactionsPool(common.TxPoolQueryOptions{Size: node.MaxTxPoolPageSize + 1})
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, node.ErrInvalidPageSize))
+	})
+	t.Run("pool not inspectable", func(t *testing.T) {
+		t.Parallel()
+
+		pool := &dataRetrieverMock.PoolsHolderStub{
+			TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				return &testscommon.ShardedDataStub{}
+			},
+		}
+		n := createNodeWithTxPool(t, pool, 0)
+		response, err := n.GetTransactionsPool(common.TxPoolQueryOptions{Size: 10})
+		require.Nil(t, response)
+		require.Equal(t, node.ErrTxPoolInspectionNotSupported, err)
+	})
+	t.Run("invalid sender", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeWithTxPool(t, dataRetrieverMock.NewPoolsHolderMock(), 0)
+		response, err := n.GetTransactionsPool(common.TxPoolQueryOptions{Sender: "not hex", Size: 10})
+		require.Nil(t, response)
+		require.NotNil(t, err)
+	})
+}
+
+func TestNode_GetTransactionsPoolShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pool := dataRetrieverMock.NewPoolsHolderMock()
+	addTxToPool(pool, "tx-alice-2", txPoolAlice, 2, 1000, "0")
+	addTxToPool(pool, "tx-alice-1", txPoolAlice, 1, 2000, "0")
+	addTxToPool(pool, "tx-bob-5", txPoolBob, 5, 1000, "0")
+	n := createNodeWithTxPool(t, pool, 0)
+
+	response, err := n.GetTransactionsPool(common.TxPoolQueryOptions{Size: 10})
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), response.Total)
+	require.Equal(t, hex.EncodeToString([]byte("tx-alice-1")), response.Transactions[0].TxHash)
+	require.Equal(t, hex.EncodeToString([]byte("tx-alice-2")), response.Transactions[1].TxHash)
+	require.Equal(t, hex.EncodeToString([]byte("tx-bob-5")), response.Transactions[2].TxHash)
+	require.Equal(t, hex.EncodeToString(txPoolAlice), response.Transactions[0].Sender)
+	require.Equal(t, "10", response.Transactions[0].Value)
+	require.Equal(t, uint64(2000), response.Transactions[0].GasPrice)
+
+	response, err = n.GetTransactionsPool(common.TxPoolQueryOptions{From: 1, Size: 1})
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), response.Total)
+	require.Equal(t, 1, len(response.Transactions))
+	require.Equal(t, hex.EncodeToString([]byte("tx-alice-2")), response.Transactions[0].TxHash)
+
+	response, err = n.GetTransactionsPool(common.TxPoolQueryOptions{From: 5, Size: 10})
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), response.Total)
+	require.Equal(t, 0, len(response.Transactions))
+
+	response, err = n.GetTransactionsPool(common.TxPoolQueryOptions{Sender: hex.EncodeToString(txPoolBob), Size: 10})
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), response.Total)
+	require.Equal(t, hex.EncodeToString([]byte("tx-bob-5")), response.Transactions[0].TxHash)
+
+	response, err = n.GetTransactionsPool(common.TxPoolQueryOptions{MinGasPrice: 1500, Size: 10})
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), response.Total)
+	require.Equal(t, hex.EncodeToString([]byte("tx-alice-1")), response.Transactions[0].TxHash)
+
+	response, err = n.GetTransactionsPool(common.TxPoolQueryOptions{SourceShard: 1, HasSourceShard: true, Size: 10})
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), response.Total)
+
+	response, err = n.GetTransactionsPool(common.TxPoolQueryOptions{DestinationShard: 0, HasDestinationShard: true, Size: 10})
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), response.Total)
+}
+
+func TestNode_GetTransactionsPoolPagesAreConsistent(t *testing.T) {
+	t.Parallel()
+
+	pool := dataRetrieverMock.NewPoolsHolderMock()
+	numSenders := 20
+	numTxsPerSender := 10
+	for nonce := numTxsPerSender; nonce > 0; nonce-- {
+		for i := numSenders - 1; i >= 0; i-- {
+			sender := []byte(fmt.Sprintf("sender-%02d.......................", i))
+			addTxToPool(pool, fmt.Sprintf("tx-%02d-%02d", i, nonce), sender, uint64(nonce), 1000, "0")
+		}
+	}
+	n := createNodeWithTxPool(t, pool, 0)
+
+	expectedHashes := make([]string, 0)
+	for i := 0; i < numSenders; i++ {
+		for nonce := 1; nonce <= numTxsPerSender; nonce++ {
+			expectedHashes = append(expectedHashes, hex.EncodeToString([]byte(fmt.Sprintf("tx-%02d-%02d", i, nonce))))
+		}
+	}
+
+	pageSize := uint64(7)
+	actualHashes := make([]string, 0)
+	for from := uint64(0); from < uint64(len(expectedHashes)); from += pageSize {
+		response, err := n.GetTransactionsPool(common.TxPoolQueryOptions{From: from, Size: pageSize})
+		require.Nil(t, err)
+		require.Equal(t, uint64(len(expectedHashes)), response.Total)
+		for _, tx := range response.Transactions {
+			actualHashes = append(actualHashes, tx.TxHash)
+		}
+	}
+	require.Equal(t, expectedHashes, actualHashes)
+
+	response, err := n.GetTransactionsPool(common.TxPoolQueryOptions{From: math.MaxUint64, Size: pageSize})
+	require.Nil(t, err)
+	require.Equal(t, uint64(len(expectedHashes)), response.Total)
+	require.Empty(t, response.Transactions)
+}
+
+func TestNode_GetTransactionsPoolForSender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty address should err", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeWithTxPool(t, dataRetrieverMock.NewPoolsHolderMock(), 0)
+		response, err := n.GetTransactionsPoolForSender("")
+		require.Nil(t, response)
+		require.Equal(t, node.ErrEmptyAddress, err)
+	})
+	t.Run("sender not in pool", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeWithTxPool(t, dataRetrieverMock.NewPoolsHolderMock(), 7)
+		response, err := n.GetTransactionsPoolForSender(hex.EncodeToString(txPoolAlice))
+		require.Nil(t, err)
+		require.Equal(t, uint64(7), response.AccountNonce)
+		require.Equal(t, 0, len(response.Transactions))
+		require.Equal(t, 0, len(response.NonceGaps))
+	})
+	t.Run("should highlight nonce gaps", func(t *testing.T) {
+		t.Parallel()
+
+		pool := dataRetrieverMock.NewPoolsHolderMock()
+		addTxToPool(pool, "tx-alice-7", txPoolAlice, 7, 1000, "0")
+		addTxToPool(pool, "tx-alice-5", txPoolAlice, 5, 1000, "0")
+		addTxToPool(pool, "tx-alice-4", txPoolAlice, 4, 1000, "0")
+		addTxToPool(pool, "tx-alice-2", txPoolAlice, 2, 1000, "0")
+		n := createNodeWithTxPool(t, pool, 4)
+
+		response, err := n.GetTransactionsPoolForSender(hex.EncodeToString(txPoolAlice))
+		require.Nil(t, err)
+		require.Equal(t, uint64(4), response.AccountNonce)
+		require.Equal(t, 4, len(response.Transactions))
+		require.Equal(t, uint64(2), response.Transactions[0].Nonce)
+		require.False(t, response.Transactions[0].PrecededByGap)
+		require.Equal(t, uint64(4), response.Transactions[1].Nonce)
+		require.False(t, response.Transactions[1].PrecededByGap)
+		require.Equal(t, uint64(5), response.Transactions[2].Nonce)
+		require.False(t, response.Transactions[2].PrecededByGap)
+		require.Equal(t, uint64(7), response.Transactions[3].Nonce)
+		require.True(t, response.Transactions[3].PrecededByGap)
+		require.Equal(t, []*common.TxPoolNonceGap{{From: 6, To: 6}}, response.NonceGaps)
+	})
+	t.Run("should highlight initial gap", func(t *testing.T) {
+		t.Parallel()
+
+		pool := dataRetrieverMock.NewPoolsHolderMock()
+		addTxToPool(pool, "tx-alice-3", txPoolAlice, 3, 1000, "0")
+		addTxToPool(pool, "tx-alice-4", txPoolAlice, 4, 1000, "0")
+		n := createNodeWithTxPool(t, pool, 1)
+
+		response, err := n.GetTransactionsPoolForSender(hex.EncodeToString(txPoolAlice))
+		require.Nil(t, err)
+		require.True(t, response.Transactions[0].PrecededByGap)
+		require.True(t, response.Transactions[1].PrecededByGap)
+		require.Equal(t, []*common.TxPoolNonceGap{{From: 1, To: 2}}, response.NonceGaps)
+	})
+}
+
+func TestNode_GetTransactionsPoolStatistics(t *testing.T) {
+	t.Parallel()
+
+	pool := dataRetrieverMock.NewPoolsHolderMock()
+	addTxToPool(pool, "tx-alice-1", txPoolAlice, 1, 1000, "0")
+	addTxToPool(pool, "tx-alice-2", txPoolAlice, 2, 1000, "0")
+	addTxToPool(pool, "tx-bob-1", txPoolBob, 1, 1000, "0")
+	n := createNodeWithTxPool(t, pool, 0)
+
+	response, err := n.GetTransactionsPoolStatistics()
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), response.NumTxs)
+	require.Equal(t, uint64(2), response.NumSenders)
+	require.Equal(t, uint64(300), response.NumBytes)
+	require.Equal(t, 1, len(response.Caches))
+	require.Equal(t, "0", response.Caches[0].CacheID)
+	require.NotEmpty(t, response.Caches[0].ScoreDistribution)
+}
+
+func TestNode_GetEvictedTransactionFromPool(t *testing.T) {
+	t.Parallel()
+
+	txPool, err := txpool.NewShardedTxPool(txpool.ArgShardedTxPool{
+		Config: storageUnit.CacheConfig{
+			Capacity:             100,
+			SizePerSender:        1,
+			SizeInBytes:          1000000,
+			SizeInBytesPerSender: 100000,
+			Shards:               1,
+		},
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      200000000000,
+			GasProcessingDivisor: 100,
+		},
+		NumberOfShards: 1,
+	})
+	require.Nil(t, err)
+	pool := &dataRetrieverMock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return txPool
+		},
+	}
+	addTxToPool(pool, "tx-alice-1", txPoolAlice, 1, 1000, "0")
+	addTxToPool(pool, "tx-alice-2", txPoolAlice, 2, 1000, "0")
+	n := createNodeWithTxPool(t, pool, 0)
+
+	response, err := n.GetEvictedTransactionFromPool("not hex")
+	require.Nil(t, response)
+	require.NotNil(t, err)
+
+	response, err = n.GetEvictedTransactionFromPool(hex.EncodeToString([]byte("tx-alice-1")))
+	require.Nil(t, response)
+	require.Equal(t, node.ErrTransactionNotRecentlyEvicted, err)
+
+	response, err = n.GetEvictedTransactionFromPool(hex.EncodeToString([]byte("tx-alice-2")))
+	require.Nil(t, err)
+	require.Equal(t, hex.EncodeToString([]byte("tx-alice-2")), response.TxHash)
+	require.Equal(t, hex.EncodeToString(txPoolAlice), response.Sender)
+	require.Equal(t, "0", response.CacheID)
+	require.Equal(t, txcache.EvictionReasonSenderLimit, response.Reason)
+}
@@ -43,6 +43,7 @@ import (
 	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
 	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	"github.com/ElrondNetwork/elrond-go/trie"
 	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
@@ -123,7 +124,7 @@ func TestGetBalance_NoAddrConverterShouldError(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 		node.WithStateComponents(stateComponents),
 	)
-	_, err := n.GetBalance("address")
+	_, err := n.GetBalance("address", common.AccountQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, "initialize AccountsAdapter and PubkeyConverter first", err.Error())
 }
@@ -138,7 +139,7 @@ func TestGetBalance_NoAccAdapterShouldError(t *testing.T) {
 	n, _ := node.NewNode(
 		node.WithCoreComponents(coreComponents),
 	)
-	_, err := n.GetBalance("address")
+	_, err := n.GetBalance("address", common.AccountQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, "initialize AccountsAdapter and PubkeyConverter first", err.Error())
 }
@@ -163,7 +164,7 @@ func TestGetBalance_GetAccountFailsShouldError(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 		node.WithStateComponents(stateComponents),
 	)
-	_, err := n.GetBalance(createDummyHexAddress(64))
+	_, err := n.GetBalance(createDummyHexAddress(64), common.AccountQueryOptions{})
 	assert.Equal(t, expectedErr, err)
 }
 
@@ -196,7 +197,7 @@ func TestGetBalance_GetAccountReturnsNil(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 		node.WithStateComponents(stateComponents),
 	)
-	balance, err := n.GetBalance(createDummyHexAddress(64))
+	balance, err := n.GetBalance(createDummyHexAddress(64), common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, big.NewInt(0), balance)
 }
@@ -216,7 +217,7 @@ func TestGetBalance(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 		node.WithStateComponents(stateComponents),
 	)
-	balance, err := n.GetBalance(createDummyHexAddress(64))
+	balance, err := n.GetBalance(createDummyHexAddress(64), common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, big.NewInt(100), balance)
 }
@@ -245,7 +246,7 @@ func TestGetUsername(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 		node.WithStateComponents(stateComponents),
 	)
-	username, err := n.GetUsername(createDummyHexAddress(64))
+	username, err := n.GetUsername(createDummyHexAddress(64), common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, string(expectedUsername), username)
 }
@@ -308,7 +309,7 @@ func TestNode_GetKeyValuePairs(t *testing.T) {
 		node.WithDataComponents(dataComponents),
 	)
 
-	pairs, err := n.GetKeyValuePairs(createDummyHexAddress(64))
+	pairs, err := n.GetKeyValuePairs(createDummyHexAddress(64), common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	resV1, ok := pairs[hex.EncodeToString(k1)]
 	assert.True(t, ok)
@@ -344,7 +345,7 @@ func TestNode_GetValueForKey(t *testing.T) {
 		node.WithStateComponents(stateComponents),
 	)
 
-	value, err := n.GetValueForKey(createDummyHexAddress(64), hex.EncodeToString(k1))
+	value, err := n.GetValueForKey(createDummyHexAddress(64), hex.EncodeToString(k1), common.AccountQueryOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, hex.EncodeToString(v1), value)
 }
@@ -377,7 +378,7 @@ func TestNode_GetESDTData(t *testing.T) {
 		node.WithStateComponents(stateComponents),
 	)
 
-	esdtTokenData, err := n.GetESDTData(createDummyHexAddress(64), esdtToken, 0)
+	esdtTokenData, err := n.GetESDTData(createDummyHexAddress(64), esdtToken, 0, common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, esdtData.Value.String(), esdtTokenData.Value.String())
 }
@@ -406,7 +407,7 @@ func TestNode_GetESDTDataForNFT(t *testing.T) {
 		node.WithStateComponents(stateComponents),
 	)
 
-	esdtTokenData, err := n.GetESDTData(createDummyHexAddress(64), esdtToken, uint64(nonce))
+	esdtTokenData, err := n.GetESDTData(createDummyHexAddress(64), esdtToken, uint64(nonce), common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, esdtData.Value.String(), esdtTokenData.Value.String())
 }
@@ -472,7 +473,7 @@ func TestNode_GetAllESDTTokens(t *testing.T) {
 		node.WithDataComponents(dataComponents),
 	)
 
-	value, err := n.GetAllESDTTokens(hexAddress)
+	value, err := n.GetAllESDTTokens(hexAddress, common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(value))
 	assert.Equal(t, esdtData, value[esdtToken])
@@ -551,7 +552,7 @@ func TestNode_GetAllESDTTokensShouldReturnEsdtAndFormattedNft(t *testing.T) {
 		node.WithStateComponents(stateComponents),
 	)
 
-	tokens, err := n.GetAllESDTTokens(hexAddress)
+	tokens, err := n.GetAllESDTTokens(hexAddress, common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(tokens))
 	assert.Equal(t, esdtData, tokens[esdtToken])
@@ -723,17 +724,17 @@ func TestNode_GetESDTsWithRole(t *testing.T) {
 		node.WithProcessComponents(processComponents),
 	)
 
-	tokenResult, err := n.GetESDTsWithRole(hex.EncodeToString(addrBytes), core.ESDTRoleNFTAddQuantity)
+	tokenResult, err := n.GetESDTsWithRole(hex.EncodeToString(addrBytes), core.ESDTRoleNFTAddQuantity, common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Equal(t, 1, len(tokenResult))
 	require.Equal(t, string(esdtToken), tokenResult[0])
 
-	tokenResult, err = n.GetESDTsWithRole(hex.EncodeToString(addrBytes), core.ESDTRoleLocalMint)
+	tokenResult, err = n.GetESDTsWithRole(hex.EncodeToString(addrBytes), core.ESDTRoleLocalMint, common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Equal(t, 1, len(tokenResult))
 	require.Equal(t, string(esdtToken), tokenResult[0])
 
-	tokenResult, err = n.GetESDTsWithRole(hex.EncodeToString(addrBytes), core.ESDTRoleNFTCreate)
+	tokenResult, err = n.GetESDTsWithRole(hex.EncodeToString(addrBytes), core.ESDTRoleNFTCreate, common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Len(t, tokenResult, 0)
 }
@@ -803,7 +804,7 @@ func TestNode_GetESDTsRoles(t *testing.T) {
 		node.WithProcessComponents(processComponents),
 	)
 
-	tokenResult, err := n.GetESDTsRoles(hex.EncodeToString(addrBytes))
+	tokenResult, err := n.GetESDTsRoles(hex.EncodeToString(addrBytes), common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Equal(t, map[string][]string{
 		string(esdtToken): {core.ESDTRoleNFTAddQuantity, core.ESDTRoleLocalMint},
@@ -869,7 +870,7 @@ func TestNode_GetNFTTokenIDsRegisteredByAddress(t *testing.T) {
 		node.WithProcessComponents(processComponents),
 	)
 
-	tokenResult, err := n.GetNFTTokenIDsRegisteredByAddress(hex.EncodeToString(addrBytes))
+	tokenResult, err := n.GetNFTTokenIDsRegisteredByAddress(hex.EncodeToString(addrBytes), common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Equal(t, 1, len(tokenResult))
 	require.Equal(t, string(esdtToken), tokenResult[0])
@@ -1969,6 +1970,7 @@ func TestCreateTransaction_OkValsShouldWork(t *testing.T) {
 	bootstrapComponents.HdrIntegrityVerifier = processComponents.HeaderIntegrVerif
 	n, _ := node.NewNode(
 		node.WithCoreComponents(coreComponents),
+		node.WithDataComponents(getDefaultDataComponents()),
 		node.WithStateComponents(stateComponents),
 		node.WithProcessComponents(processComponents),
 		node.WithNetworkComponents(networkComponents),
@@ -2071,6 +2073,7 @@ func TestCreateTransaction_TxSignedWithHashShouldErrVersionShoudBe2(t *testing.T
 
 	n, _ := node.NewNode(
 		node.WithCoreComponents(coreComponents),
+		node.WithDataComponents(getDefaultDataComponents()),
 		node.WithBootstrapComponents(bootstrapComponents),
 		node.WithStateComponents(stateComponents),
 		node.WithProcessComponents(processComponents),
@@ -2172,6 +2175,7 @@ func TestCreateTransaction_TxSignedWithHashNoEnabledShouldErr(t *testing.T) {
 
 	n, _ := node.NewNode(
 		node.WithCoreComponents(coreComponents),
+		node.WithDataComponents(getDefaultDataComponents()),
 		node.WithBootstrapComponents(bootstrapComponents),
 		node.WithStateComponents(stateComponents),
 		node.WithProcessComponents(processComponents),
@@ -2494,7 +2498,7 @@ func TestNode_GetAccountWithNilAccountsAdapterShouldErr(t *testing.T) {
 	)
 
 	stateComponents.Accounts = nil
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), common.AccountQueryOptions{})
 
 	assert.Empty(t, recovAccnt)
 	assert.Equal(t, node.ErrNilAccountsAdapter, err)
@@ -2518,7 +2522,7 @@ func TestNode_GetAccountWithNilPubkeyConverterShouldErr(t *testing.T) {
 	)
 
 	coreComponents.AddrPubKeyConv = nil
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), common.AccountQueryOptions{})
 
 	assert.Empty(t, recovAccnt)
 	assert.Equal(t, node.ErrNilPubkeyConverter, err)
@@ -2548,7 +2552,7 @@ func TestNode_GetAccountPubkeyConverterFailsShouldErr(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), common.AccountQueryOptions{})
 
 	assert.Empty(t, recovAccnt)
 	assert.Equal(t, errExpected, err)
@@ -2572,7 +2576,7 @@ func TestNode_GetAccountAccountDoesNotExistsShouldRetEmpty(t *testing.T) {
 		node.WithStateComponents(stateComponents),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), common.AccountQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, uint64(0), recovAccnt.Nonce)
@@ -2601,7 +2605,7 @@ func TestNode_GetAccountAccountsAdapterFailsShouldErr(t *testing.T) {
 		node.WithStateComponents(stateComponents),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), common.AccountQueryOptions{})
 
 	assert.Empty(t, recovAccnt)
 	assert.NotNil(t, err)
@@ -2635,7 +2639,7 @@ func TestNode_GetAccountAccountExistsShouldReturn(t *testing.T) {
 		node.WithStateComponents(stateComponents),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), common.AccountQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, uint64(2), recovAccnt.Nonce)
@@ -3209,6 +3213,7 @@ func TestNode_ValidateTransactionForSimulation_CheckSignatureFalse(t *testing.T)
 
 	n, _ := node.NewNode(
 		node.WithCoreComponents(coreComponents),
+		node.WithDataComponents(getDefaultDataComponents()),
 		node.WithProcessComponents(processComponents),
 		node.WithBootstrapComponents(bootstrapComponents),
 		node.WithStateComponents(stateComponents),
@@ -3255,7 +3260,7 @@ func TestGetKeyValuePairs_CannotDecodeAddress(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 	)
 
-	res, err := n.GetKeyValuePairs("addr")
+	res, err := n.GetKeyValuePairs("addr", common.AccountQueryOptions{})
 	require.Nil(t, res)
 	require.True(t, strings.Contains(fmt.Sprintf("%v", err), expectedErr.Error()))
 }
@@ -3283,7 +3288,7 @@ func TestGetKeyValuePairs_NilCurrentBlockHeader(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 	)
 
-	res, err := n.GetKeyValuePairs("addr")
+	res, err := n.GetKeyValuePairs("addr", common.AccountQueryOptions{})
 	require.Nil(t, res)
 	require.Equal(t, node.ErrNilBlockHeader, err)
 }
@@ -3319,7 +3324,7 @@ func TestGetKeyValuePairs_CannotRecreateTree(t *testing.T) {
 		node.WithCoreComponents(coreComponents),
 	)
 
-	res, err := n.GetKeyValuePairs("addr")
+	res, err := n.GetKeyValuePairs("addr", common.AccountQueryOptions{})
 	require.Nil(t, res)
 	require.Equal(t, expectedErr, err)
 }
@@ -3619,6 +3624,284 @@ func TestNode_VerifyProofInvalidAddress(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestNode_GetProofDataTrieMultiKeyEmptyKeysShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(node.WithStateComponents(getDefaultStateComponents()))
+
+	mainTrieResponse, dataTrieResponse, err := n.GetProofDataTrieMultiKey("deadbeef", "0123", nil)
+	assert.Nil(t, mainTrieResponse)
+	assert.Nil(t, dataTrieResponse)
+	assert.Equal(t, node.ErrEmptyProofKeys, err)
+}
+
+func TestNode_GetProofDataTrieMultiKeyTooManyKeysShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(node.WithStateComponents(getDefaultStateComponents()))
+
+	keys := make([]string, 1001)
+	mainTrieResponse, dataTrieResponse, err := n.GetProofDataTrieMultiKey("deadbeef", "0123", keys)
+	assert.Nil(t, mainTrieResponse)
+	assert.Nil(t, dataTrieResponse)
+	assert.True(t, errors.Is(err, node.ErrTooManyProofKeys))
+}
+
+func TestNode_GetProofDataTrieMultiKeyInvalidKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(node.WithStateComponents(getDefaultStateComponents()))
+
+	mainTrieResponse, dataTrieResponse, err := n.GetProofDataTrieMultiKey("deadbeef", "0123", []string{"4567", "key"})
+	assert.Nil(t, mainTrieResponse)
+	assert.Nil(t, dataTrieResponse)
+	assert.NotNil(t, err)
+}
+
+func createStateComponentsForDataTrieProofs(t *testing.T, mainTrieKey string, dataTrieRootHash []byte, dataTrie common.Trie) *testscommon.StateComponentsMock {
+	mainTrieProof := [][]byte{[]byte("valid"), []byte("proof"), []byte("mainTrie")}
+	mainTrieValue := []byte("mainValue")
+
+	stateComponents := getDefaultStateComponents()
+	stateComponents.Accounts = &stateMock.AccountsStub{
+		GetTrieCalled: func(rootHash []byte) (common.Trie, error) {
+			if bytes.Equal(rootHash, dataTrieRootHash) {
+				return dataTrie, nil
+			}
+
+			return &trieMock.TrieStub{
+				GetProofCalled: func(key []byte) ([][]byte, []byte, error) {
+					assert.Equal(t, mainTrieKey, hex.EncodeToString(key))
+					return mainTrieProof, mainTrieValue, nil
+				},
+			}, nil
+		},
+		GetAccountFromBytesCalled: func(address []byte, accountBytes []byte) (vmcommon.AccountHandler, error) {
+			assert.Equal(t, mainTrieValue, accountBytes)
+			acc := &mock.AccountWrapMock{}
+			acc.SetRootHash(dataTrieRootHash)
+			return acc, nil
+		},
+	}
+
+	return stateComponents
+}
+
+func TestNode_GetProofDataTrieMultiKeyShouldWork(t *testing.T) {
+	t.Parallel()
+
+	mainTrieKey := "0123"
+	address, _ := hex.DecodeString(mainTrieKey)
+	dataTrieRootHash := []byte("dataTrieRoot")
+	dataTrieProof := [][]byte{[]byte("valid"), []byte("proof"), []byte("dataTrie")}
+	dataTrie := &trieMock.TrieStub{
+		GetMultiProofCalled: func(keys [][]byte) ([][]byte, [][]byte, error) {
+			require.Equal(t, [][]byte{{0x45, 0x67}, {0x89}}, keys)
+			storedValue := append(append([]byte("value"), keys[0]...), address...)
+			return dataTrieProof, [][]byte{storedValue, nil}, nil
+		},
+	}
+	stateComponents := createStateComponentsForDataTrieProofs(t, mainTrieKey, dataTrieRootHash, dataTrie)
+	n, _ := node.NewNode(
+		node.WithStateComponents(stateComponents),
+		node.WithCoreComponents(getDefaultCoreComponents()),
+	)
+
+	mainTrieResponse, dataTrieResponse, err := n.GetProofDataTrieMultiKey("deadbeef", mainTrieKey, []string{"4567", "89"})
+	require.Nil(t, err)
+	assert.Equal(t, "deadbeef", mainTrieResponse.RootHash)
+	assert.Equal(t, dataTrieProof, dataTrieResponse.Proof)
+	assert.Equal(t, [][]byte{[]byte("value"), nil}, dataTrieResponse.Values)
+	assert.Equal(t, hex.EncodeToString(dataTrieRootHash), dataTrieResponse.RootHash)
+}
+
+func TestNode_GetProofDataTrieRangeShouldWork(t *testing.T) {
+	t.Parallel()
+
+	mainTrieKey := "0123"
+	address, _ := hex.DecodeString(mainTrieKey)
+	dataTrieRootHash := []byte("dataTrieRoot")
+	dataTrieProof := [][]byte{[]byte("valid"), []byte("proof"), []byte("dataTrie")}
+	dataTrie := &trieMock.TrieStub{
+		GetRangeProofCalled: func(startKey []byte, endKey []byte, maxNumLeaves int) (*common.RangeProof, error) {
+			assert.Equal(t, []byte{0x45}, startKey)
+			assert.Equal(t, 0, len(endKey))
+			assert.Equal(t, 1000, maxNumLeaves)
+
+			keys := [][]byte{{0x45}, {0x67}}
+			values := make([][]byte, 0, len(keys))
+			for _, key := range keys {
+				values = append(values, append(append([]byte("value"), key...), address...))
+			}
+
+			return &common.RangeProof{
+				Proof:       dataTrieProof,
+				Keys:        keys,
+				Values:      values,
+				StartKey:    startKey,
+				EndKey:      keys[1],
+				IsTruncated: true,
+			}, nil
+		},
+	}
+	stateComponents := createStateComponentsForDataTrieProofs(t, mainTrieKey, dataTrieRootHash, dataTrie)
+	n, _ := node.NewNode(
+		node.WithStateComponents(stateComponents),
+		node.WithCoreComponents(getDefaultCoreComponents()),
+	)
+
+	mainTrieResponse, dataTrieResponse, err := n.GetProofDataTrieRange("deadbeef", mainTrieKey, "45", "", 0)
+	require.Nil(t, err)
+	assert.Equal(t, "deadbeef", mainTrieResponse.RootHash)
+	assert.Equal(t, dataTrieProof, dataTrieResponse.Proof)
+	assert.Equal(t, [][]byte{{0x45}, {0x67}}, dataTrieResponse.Keys)
+	assert.Equal(t, [][]byte{[]byte("value"), []byte("value")}, dataTrieResponse.Values)
+	assert.Equal(t, []byte{0x67}, dataTrieResponse.EndKey)
+	assert.True(t, dataTrieResponse.IsTruncated)
+	assert.Equal(t, hex.EncodeToString(dataTrieRootHash), dataTrieResponse.RootHash)
+}
+
+func TestNode_VerifyProofDataTrieMultiKeyInvalidRootHash(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithStateComponents(getDefaultStateComponents()),
+		node.WithCoreComponents(getDefaultCoreComponents()),
+	)
+
+	provenValues, err := n.VerifyProofDataTrieMultiKey("invalidRootHash", "0123", []string{"4567"}, [][]byte{})
+	assert.Nil(t, provenValues)
+	assert.NotNil(t, err)
+}
+
+func TestNode_VerifyProofDataTrieRangeInvalidStartKey(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithStateComponents(getDefaultStateComponents()),
+		node.WithCoreComponents(getDefaultCoreComponents()),
+	)
+
+	provenValues, err := n.VerifyProofDataTrieRange("deadbeef", "0123", "key", "", [][]byte{})
+	assert.Nil(t, provenValues)
+	assert.NotNil(t, err)
+}
+
+func TestNode_VerifyProofDataTrieShouldWork(t *testing.T) {
+	t.Parallel()
+
+	address := []byte{0x01, 0x23}
+	marshalizer := &testscommon.MarshalizerMock{}
+	hasher := &testscommon.KeccakMock{}
+	trieStorageManager, _ := trie.NewTrieStorageManagerWithoutPruning(testscommon.NewMemDbMock())
+	dataTrie, _ := trie.NewTrie(trieStorageManager, marshalizer, hasher, 5)
+	keys := []string{"4567", "89ab", "cdef"}
+	for _, key := range keys {
+		keyBytes, _ := hex.DecodeString(key)
+		_ = dataTrie.Update(keyBytes, append(append([]byte("value"), keyBytes...), address...))
+	}
+	dataTrieRootHash, _ := dataTrie.RootHash()
+
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = marshalizer
+	coreComponents.Hash = hasher
+	n, _ := node.NewNode(
+		node.WithStateComponents(getDefaultStateComponents()),
+		node.WithCoreComponents(coreComponents),
+	)
+
+	t.Run("multi-key proof", func(t *testing.T) {
+		provenKeys := [][]byte{{0x45, 0x67}, {0x00}}
+		dataTrieProof, _, _ := dataTrie.GetMultiProof(provenKeys)
+
+		provenValues, err := n.VerifyProofDataTrieMultiKey(hex.EncodeToString(dataTrieRootHash), "0123", []string{"4567", "00"}, dataTrieProof)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(provenValues))
+		assert.Equal(t, []byte("value"), provenValues[0].Value())
+		assert.Nil(t, provenValues[1].Value())
+
+		_, err = n.VerifyProofDataTrieMultiKey(hex.EncodeToString(dataTrieRootHash), "4567", []string{"4567"}, dataTrieProof)
+		assert.NotNil(t, err)
+	})
+	t.Run("range proof", func(t *testing.T) {
+		rangeProof, _ := dataTrie.GetRangeProof(nil, nil, 0)
+
+		provenValues, err := n.VerifyProofDataTrieRange(hex.EncodeToString(dataTrieRootHash), "0123", "", "", rangeProof.Proof)
+		require.Nil(t, err)
+		require.Equal(t, len(keys), len(provenValues))
+		for _, provenValue := range provenValues {
+			assert.Equal(t, []byte("value"), provenValue.Value())
+		}
+	})
+}
+
+func TestNode_GetStateStatisticsInvalidRootHash(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithStateComponents(getDefaultStateComponents()),
+		node.WithCoreComponents(getDefaultCoreComponents()),
+	)
+
+	stats, err := n.GetStateStatistics("invalidRootHash", 10)
+	assert.Nil(t, stats)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetStateStatisticsTrieNotPresent(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := fmt.Errorf("expected err")
+	stateComponents := getDefaultStateComponents()
+	stateComponents.Accounts = &stateMock.AccountsStub{
+		GetTrieCalled: func(_ []byte) (common.Trie, error) {
+			return nil, expectedErr
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithStateComponents(stateComponents),
+		node.WithCoreComponents(getDefaultCoreComponents()),
+	)
+
+	stats, err := n.GetStateStatistics("deadbeef", 10)
+	assert.Nil(t, stats)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestNode_GetStateStatisticsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rootHash := "deadbeef"
+	stateComponents := getDefaultStateComponents()
+	stateComponents.Accounts = &stateMock.AccountsStub{
+		GetTrieCalled: func(_ []byte) (common.Trie, error) {
+			return &trieMock.TrieStub{
+				CollectStatisticsCalled: func(providedRootHash []byte, handler common.TrieStatisticsHandler) error {
+					assert.Equal(t, rootHash, hex.EncodeToString(providedRootHash))
+					handler.AddExtensionNode(0, 40)
+					return nil
+				},
+				GetAllLeavesOnChannelCalled: func(_ []byte) (chan core.KeyValueHolder, error) {
+					leavesChannel := make(chan core.KeyValueHolder)
+					close(leavesChannel)
+					return leavesChannel, nil
+				},
+			}, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithStateComponents(stateComponents),
+		node.WithCoreComponents(getDefaultCoreComponents()),
+	)
+
+	stats, err := n.GetStateStatistics(rootHash, 10)
+	require.Nil(t, err)
+	assert.Equal(t, rootHash, stats.RootHash)
+	assert.Equal(t, uint64(1), stats.AccountsTrie.NumExtensionNodes)
+	assert.Equal(t, uint64(40), stats.AccountsTrie.TotalSizeInBytes)
+	assert.Equal(t, uint64(0), stats.NumAccounts)
+}
+
 func TestNode_VerifyProof(t *testing.T) {
 	t.Parallel()
 
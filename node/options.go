@@ -333,6 +333,17 @@ func WithEnableSignTxWithHashEpoch(enableSignTxWithHashEpoch uint32) Option {
 	}
 }
 
+// WithMaxNumLogsBucketsPerQuery sets up the maximum number of logs index buckets read by a single logs query
+func WithMaxNumLogsBucketsPerQuery(maxNumBuckets uint32) Option {
+	return func(n *Node) error {
+		if maxNumBuckets == 0 {
+			return ErrInvalidMaxNumLogsBucketsPerQuery
+		}
+		n.maxNumLogsBucketsPerQuery = uint64(maxNumBuckets)
+		return nil
+	}
+}
+
 // WithImportMode sets up the flag if the node is running in import mode
 func WithImportMode(importMode bool) Option {
 	return func(n *Node) error {
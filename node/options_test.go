@@ -291,3 +291,27 @@ func TestWithSignTxWithHashEpoch_EnableSignTxWithHashEpochShouldWork(t *testing.
 	assert.Equal(t, epochEnable, node.enableSignTxWithHashEpoch)
 	assert.Nil(t, err)
 }
+
+func TestWithMaxNumLogsBucketsPerQuery_ZeroShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithMaxNumLogsBucketsPerQuery(0)
+	err := opt(node)
+
+	assert.Equal(t, ErrInvalidMaxNumLogsBucketsPerQuery, err)
+	assert.Equal(t, uint64(defaultMaxNumLogsBucketsPerQuery), node.maxNumLogsBucketsPerQuery)
+}
+
+func TestWithMaxNumLogsBucketsPerQuery_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithMaxNumLogsBucketsPerQuery(50)
+	err := opt(node)
+
+	assert.Equal(t, uint64(50), node.maxNumLogsBucketsPerQuery)
+	assert.Nil(t, err)
+}
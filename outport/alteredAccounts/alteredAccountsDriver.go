@@ -0,0 +1,74 @@
+package alteredAccounts
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/outport"
+)
+
+var _ outport.Driver = (*alteredAccountsDriver)(nil)
+
+// ArgsAlteredAccountsDriver holds the arguments needed to create an altered accounts driver
+type ArgsAlteredAccountsDriver struct {
+	// Driver loads the altered accounts through the recorder behind Server while saving a block
+	Driver outport.Driver
+	Server AlteredAccountsServer
+	// SaveAlteredAccounts makes each block be followed by its altered accounts, saved through SaveAccounts. It is
+	// needed by a driver saving the blocks asynchronously and in order (the elastic indexer), which may load the
+	// accounts of a later block: the accounts saved after the block overwrite them
+	SaveAlteredAccounts bool
+}
+
+// alteredAccountsDriver wraps a driver which loads the altered accounts while saving a block. Each saved block is
+// delivered to the driver along with its altered accounts, either recorded right away or recorded when the block was
+// queued (see RecordAlteredAccounts and SaveBlockWithAlteredAccounts)
+type alteredAccountsDriver struct {
+	outport.Driver
+	server              AlteredAccountsServer
+	saveAlteredAccounts bool
+}
+
+// NewAlteredAccountsDriver creates a new altered accounts driver
+func NewAlteredAccountsDriver(args ArgsAlteredAccountsDriver) (*alteredAccountsDriver, error) {
+	if check.IfNil(args.Driver) {
+		return nil, ErrNilDriver
+	}
+	if check.IfNil(args.Server) {
+		return nil, ErrNilAlteredAccountsServer
+	}
+
+	return &alteredAccountsDriver{
+		Driver:              args.Driver,
+		server:              args.Server,
+		saveAlteredAccounts: args.SaveAlteredAccounts,
+	}, nil
+}
+
+// SaveBlock records the accounts altered by the block and saves the block
+func (aad *alteredAccountsDriver) SaveBlock(args *indexer.ArgsSaveBlockData) error {
+	return aad.SaveBlockWithAlteredAccounts(args, aad.RecordAlteredAccounts(args))
+}
+
+// RecordAlteredAccounts returns the accounts altered by the provided block, as they were right after the block was
+// processed
+func (aad *alteredAccountsDriver) RecordAlteredAccounts(args *indexer.ArgsSaveBlockData) []data.UserAccountHandler {
+	return aad.server.RecordAlteredAccounts(args)
+}
+
+// SaveBlockWithAlteredAccounts saves the block, serving the provided accounts to the wrapped driver
+func (aad *alteredAccountsDriver) SaveBlockWithAlteredAccounts(args *indexer.ArgsSaveBlockData, alteredAccounts []data.UserAccountHandler) error {
+	err := aad.server.ServeAlteredAccounts(alteredAccounts, func() error {
+		return aad.Driver.SaveBlock(args)
+	})
+	if err != nil || !aad.saveAlteredAccounts || len(alteredAccounts) == 0 || args == nil || check.IfNil(args.Header) {
+		return err
+	}
+
+	return aad.Driver.SaveAccounts(args.Header.GetTimeStamp(), alteredAccounts)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (aad *alteredAccountsDriver) IsInterfaceNil() bool {
+	return aad == nil
+}
@@ -0,0 +1,115 @@
+package alteredAccounts
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/outport/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAlteredAccountsDriver(t *testing.T) {
+	t.Parallel()
+
+	recorder, _ := NewAlteredAccountsRecorder(createMockArgsAlteredAccountsRecorder())
+
+	driver, err := NewAlteredAccountsDriver(ArgsAlteredAccountsDriver{Server: recorder})
+	require.True(t, check.IfNil(driver))
+	require.Equal(t, ErrNilDriver, err)
+
+	driver, err = NewAlteredAccountsDriver(ArgsAlteredAccountsDriver{Driver: &mock.DriverStub{}})
+	require.True(t, check.IfNil(driver))
+	require.Equal(t, ErrNilAlteredAccountsServer, err)
+
+	driver, err = NewAlteredAccountsDriver(ArgsAlteredAccountsDriver{Driver: &mock.DriverStub{}, Server: recorder})
+	require.False(t, check.IfNil(driver))
+	require.Nil(t, err)
+}
+
+func TestAlteredAccountsDriver_SaveBlockShouldServeTheAccountsOfTheBlock(t *testing.T) {
+	t.Parallel()
+
+	recorder, _ := NewAlteredAccountsRecorder(createMockArgsAlteredAccountsRecorder())
+	numSavedBlocks := 0
+	driver, _ := NewAlteredAccountsDriver(ArgsAlteredAccountsDriver{
+		Driver: &mock.DriverStub{
+			SaveBlockCalled: func(_ *indexer.ArgsSaveBlockData) error {
+				account, err := recorder.LoadAccount([]byte("alice"))
+				require.Nil(t, err)
+				requireAccount(t, createUserAccount("alice", 1, 10), account)
+				numSavedBlocks++
+
+				return nil
+			},
+			SaveAccountsCalled: func(_ uint64, _ []data.UserAccountHandler) error {
+				require.Fail(t, "the altered accounts should not be saved")
+				return nil
+			},
+		},
+		Server: recorder,
+	})
+
+	err := driver.SaveBlock(createSaveBlockArgs("root", &transaction.Transaction{SndAddr: []byte("alice")}))
+	require.Nil(t, err)
+	require.Equal(t, 1, numSavedBlocks)
+}
+
+func TestAlteredAccountsDriver_SaveBlockWithAlteredAccountsShouldServeTheProvidedAccounts(t *testing.T) {
+	t.Parallel()
+
+	recorder, _ := NewAlteredAccountsRecorder(createMockArgsAlteredAccountsRecorder())
+	driver, _ := NewAlteredAccountsDriver(ArgsAlteredAccountsDriver{
+		Driver: &mock.DriverStub{
+			SaveBlockCalled: func(_ *indexer.ArgsSaveBlockData) error {
+				account, err := recorder.LoadAccount([]byte("alice"))
+				require.Nil(t, err)
+				requireAccount(t, createUserAccount("alice", 1, 10), account)
+
+				return nil
+			},
+		},
+		Server: recorder,
+	})
+
+	blockArgs := createSaveBlockArgs("root", &transaction.Transaction{SndAddr: []byte("alice")})
+	alteredAccounts := driver.RecordAlteredAccounts(blockArgs)
+	blockArgs.TransactionsPool = nil
+
+	err := driver.SaveBlockWithAlteredAccounts(blockArgs, alteredAccounts)
+	require.Nil(t, err)
+}
+
+func TestAlteredAccountsDriver_SaveBlockShouldSaveTheAlteredAccountsAfterTheBlock(t *testing.T) {
+	t.Parallel()
+
+	recorder, _ := NewAlteredAccountsRecorder(createMockArgsAlteredAccountsRecorder())
+	calls := make([]string, 0)
+	var savedAccounts []data.UserAccountHandler
+	driver, _ := NewAlteredAccountsDriver(ArgsAlteredAccountsDriver{
+		Driver: &mock.DriverStub{
+			SaveBlockCalled: func(_ *indexer.ArgsSaveBlockData) error {
+				calls = append(calls, "block")
+				return nil
+			},
+			SaveAccountsCalled: func(blockTimestamp uint64, accounts []data.UserAccountHandler) error {
+				calls = append(calls, "accounts")
+				require.Equal(t, uint64(1234), blockTimestamp)
+				savedAccounts = accounts
+				return nil
+			},
+		},
+		Server:              recorder,
+		SaveAlteredAccounts: true,
+	})
+
+	blockArgs := createSaveBlockArgs("root", &transaction.Transaction{SndAddr: []byte("alice")})
+	blockArgs.Header.SetTimeStamp(1234)
+	err := driver.SaveBlock(blockArgs)
+	require.Nil(t, err)
+	require.Equal(t, []string{"block", "accounts"}, calls)
+	require.Len(t, savedAccounts, 1)
+	requireAccount(t, createUserAccount("alice", 1, 10), savedAccounts[0])
+}
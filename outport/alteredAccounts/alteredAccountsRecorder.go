@@ -0,0 +1,222 @@
+package alteredAccounts
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var log = logger.GetOrCreate("outport/alteredAccounts")
+
+// ArgsAlteredAccountsRecorder holds the arguments needed to create an altered accounts recorder
+type ArgsAlteredAccountsRecorder struct {
+	Accounts         state.AccountsAdapter
+	ShardCoordinator sharding.Coordinator
+	// NumServedBlocks is the number of the last served blocks whose altered accounts are still served. A driver saving
+	// the blocks asynchronously needs the accounts of all the blocks it may not have saved yet
+	NumServedBlocks int
+}
+
+// alteredAccountsRecorder records the accounts of the shard altered by a block, as they were right after the block
+// was processed, and serves them to a driver loading the altered accounts while saving the block (the elastic and the
+// covalent indexers). It is provided to the driver as its accounts adapter, so the driver does not read the current
+// state of the node, which is the state of a later block when the block is delivered by a queue or replayed.
+// The accounts of the last served blocks are kept, the most recent version of an account being served, so a driver
+// saving the blocks asynchronously may load the version of a later block (see ArgsAlteredAccountsDriver)
+type alteredAccountsRecorder struct {
+	accounts         state.AccountsAdapter
+	shardCoordinator sharding.Coordinator
+	numServedBlocks  int
+
+	mutServed    sync.RWMutex
+	servedBlocks []map[string]vmcommon.AccountHandler
+}
+
+// NewAlteredAccountsRecorder creates a new altered accounts recorder
+func NewAlteredAccountsRecorder(args ArgsAlteredAccountsRecorder) (*alteredAccountsRecorder, error) {
+	if check.IfNil(args.Accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if args.NumServedBlocks < 1 {
+		return nil, fmt.Errorf("%w, provided %d", ErrInvalidNumServedBlocks, args.NumServedBlocks)
+	}
+
+	return &alteredAccountsRecorder{
+		accounts:         args.Accounts,
+		shardCoordinator: args.ShardCoordinator,
+		numServedBlocks:  args.NumServedBlocks,
+		servedBlocks:     make([]map[string]vmcommon.AccountHandler, 0, args.NumServedBlocks),
+	}, nil
+}
+
+// RecordAlteredAccounts returns the accounts of the shard altered by the provided block, read from the state at the
+// root hash of the block. The altered accounts are the senders and the receivers of the transactions, of the smart
+// contract results and of the rewards, and the senders of the receipts. If the state of the block is no longer
+// available (it was pruned), the accounts cannot be recorded and the driver will not find them
+func (aar *alteredAccountsRecorder) RecordAlteredAccounts(args *indexer.ArgsSaveBlockData) []data.UserAccountHandler {
+	alteredAccounts := make([]data.UserAccountHandler, 0)
+	if args == nil || check.IfNil(args.Header) {
+		return alteredAccounts
+	}
+
+	addresses := aar.computeAlteredAddresses(args.TransactionsPool)
+	if len(addresses) == 0 {
+		return alteredAccounts
+	}
+
+	trie, err := aar.accounts.GetTrie(args.Header.GetRootHash())
+	if err != nil {
+		log.Warn("cannot record the altered accounts, the state of the block is not available",
+			"nonce", args.Header.GetNonce(),
+			"root hash", args.Header.GetRootHash(),
+			"error", err)
+		return alteredAccounts
+	}
+
+	for _, address := range addresses {
+		accountBytes, errGet := trie.Get(address)
+		if errGet != nil || len(accountBytes) == 0 {
+			log.Debug("cannot record altered account", "address", address, "error", errGet)
+			continue
+		}
+
+		account, errGet := aar.accounts.GetAccountFromBytes(address, accountBytes)
+		if errGet != nil {
+			log.Debug("cannot record altered account", "address", address, "error", errGet)
+			continue
+		}
+
+		userAccount, ok := account.(data.UserAccountHandler)
+		if !ok {
+			continue
+		}
+
+		alteredAccounts = append(alteredAccounts, userAccount)
+	}
+
+	return alteredAccounts
+}
+
+func (aar *alteredAccountsRecorder) computeAlteredAddresses(pool *indexer.Pool) [][]byte {
+	addresses := make([][]byte, 0)
+	if pool == nil {
+		return addresses
+	}
+
+	added := make(map[string]struct{})
+	addAddress := func(address []byte) {
+		if len(address) == 0 || aar.shardCoordinator.ComputeId(address) != aar.shardCoordinator.SelfId() {
+			return
+		}
+		if _, found := added[string(address)]; found {
+			return
+		}
+
+		added[string(address)] = struct{}{}
+		addresses = append(addresses, address)
+	}
+
+	for _, txs := range []map[string]data.TransactionHandler{pool.Txs, pool.Scrs, pool.Rewards, pool.Invalid} {
+		for _, tx := range txs {
+			if check.IfNil(tx) {
+				continue
+			}
+
+			addAddress(tx.GetSndAddr())
+			addAddress(tx.GetRcvAddr())
+		}
+	}
+	for _, rcpt := range pool.Receipts {
+		if check.IfNil(rcpt) {
+			continue
+		}
+
+		addAddress(rcpt.GetSndAddr())
+	}
+
+	return addresses
+}
+
+// ServeAlteredAccounts starts serving the provided accounts from LoadAccount and calls the provided function. The
+// accounts of the oldest served block are no longer served. Only the address, the nonce and the balance of the
+// accounts are served
+func (aar *alteredAccountsRecorder) ServeAlteredAccounts(alteredAccounts []data.UserAccountHandler, saveBlock func() error) error {
+	servedAccounts := make(map[string]vmcommon.AccountHandler, len(alteredAccounts))
+	for _, alteredAccount := range alteredAccounts {
+		if check.IfNil(alteredAccount) {
+			continue
+		}
+
+		account, err := createServedAccount(alteredAccount)
+		if err != nil {
+			return err
+		}
+
+		servedAccounts[string(alteredAccount.AddressBytes())] = account
+	}
+
+	aar.addServedBlock(servedAccounts)
+
+	return saveBlock()
+}
+
+func createServedAccount(alteredAccount data.UserAccountHandler) (vmcommon.AccountHandler, error) {
+	account, err := state.NewUserAccount(alteredAccount.AddressBytes())
+	if err != nil {
+		return nil, err
+	}
+
+	account.IncreaseNonce(alteredAccount.GetNonce())
+	if alteredAccount.GetBalance() == nil {
+		return account, nil
+	}
+
+	err = account.AddToBalance(alteredAccount.GetBalance())
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+func (aar *alteredAccountsRecorder) addServedBlock(servedAccounts map[string]vmcommon.AccountHandler) {
+	aar.mutServed.Lock()
+	defer aar.mutServed.Unlock()
+
+	if len(aar.servedBlocks) == aar.numServedBlocks {
+		copy(aar.servedBlocks, aar.servedBlocks[1:])
+		aar.servedBlocks = aar.servedBlocks[:len(aar.servedBlocks)-1]
+	}
+	aar.servedBlocks = append(aar.servedBlocks, servedAccounts)
+}
+
+// LoadAccount returns the most recent version of the account among the served blocks, or ErrAccountNotRecorded if
+// the account was not recorded as altered by any of them. The current state is never read
+func (aar *alteredAccountsRecorder) LoadAccount(address []byte) (vmcommon.AccountHandler, error) {
+	aar.mutServed.RLock()
+	defer aar.mutServed.RUnlock()
+
+	for i := len(aar.servedBlocks) - 1; i >= 0; i-- {
+		account, found := aar.servedBlocks[i][string(address)]
+		if found {
+			return account, nil
+		}
+	}
+
+	return nil, ErrAccountNotRecorded
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (aar *alteredAccountsRecorder) IsInterfaceNil() bool {
+	return aar == nil
+}
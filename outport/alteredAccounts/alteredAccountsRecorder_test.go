@@ -0,0 +1,228 @@
+package alteredAccounts
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+var testMarshalizer = &marshal.GogoProtoMarshalizer{}
+
+func createUserAccount(address string, nonce uint64, balance int64) state.UserAccountHandler {
+	account, _ := state.NewUserAccount([]byte(address))
+	account.IncreaseNonce(nonce)
+	_ = account.AddToBalance(big.NewInt(balance))
+
+	return account
+}
+
+// createAccountsStub creates an accounts adapter holding the provided states, by root hash. The live state holds the
+// accounts of the "live" root hash
+func createAccountsStub(states map[string][]state.UserAccountHandler) *stateMock.AccountsStub {
+	return &stateMock.AccountsStub{
+		GetTrieCalled: func(rootHash []byte) (common.Trie, error) {
+			accounts, found := states[string(rootHash)]
+			if !found {
+				return nil, errors.New("trie was pruned")
+			}
+
+			return &trie.TrieStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					for _, account := range accounts {
+						if string(account.AddressBytes()) == string(key) {
+							return testMarshalizer.Marshal(account)
+						}
+					}
+
+					return nil, nil
+				},
+			}, nil
+		},
+		GetAccountFromBytesCalled: func(address []byte, accountBytes []byte) (vmcommon.AccountHandler, error) {
+			account, _ := state.NewUserAccount(address)
+			err := testMarshalizer.Unmarshal(account, accountBytes)
+
+			return account, err
+		},
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			for _, account := range states["live"] {
+				if string(account.AddressBytes()) == string(address) {
+					return account, nil
+				}
+			}
+
+			return nil, errors.New("account not found")
+		},
+	}
+}
+
+func createMockArgsAlteredAccountsRecorder() ArgsAlteredAccountsRecorder {
+	return ArgsAlteredAccountsRecorder{
+		Accounts: createAccountsStub(map[string][]state.UserAccountHandler{
+			"root": {createUserAccount("alice", 1, 10), createUserAccount("bob", 2, 20)},
+			"live": {createUserAccount("alice", 5, 50), createUserAccount("bob", 6, 60)},
+		}),
+		ShardCoordinator: testscommon.NewMultiShardsCoordinatorMock(2),
+		NumServedBlocks:  2,
+	}
+}
+
+func createSaveBlockArgs(rootHash string, txs ...data.TransactionHandler) *indexer.ArgsSaveBlockData {
+	pool := &indexer.Pool{Txs: make(map[string]data.TransactionHandler)}
+	for i, tx := range txs {
+		pool.Txs[string(rune('a'+i))] = tx
+	}
+
+	return &indexer.ArgsSaveBlockData{
+		Header:           &block.Header{Nonce: 1, RootHash: []byte(rootHash)},
+		TransactionsPool: pool,
+	}
+}
+
+func requireAccount(t *testing.T, expected state.UserAccountHandler, account interface{}) {
+	userAccount, ok := account.(data.UserAccountHandler)
+	require.True(t, ok)
+	require.Equal(t, expected.AddressBytes(), userAccount.AddressBytes())
+	require.Equal(t, expected.GetNonce(), userAccount.GetNonce())
+	require.Equal(t, expected.GetBalance(), userAccount.GetBalance())
+}
+
+func TestNewAlteredAccountsRecorder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts adapter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAlteredAccountsRecorder()
+		args.Accounts = nil
+		recorder, err := NewAlteredAccountsRecorder(args)
+		require.True(t, check.IfNil(recorder))
+		require.Equal(t, ErrNilAccountsAdapter, err)
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAlteredAccountsRecorder()
+		args.ShardCoordinator = nil
+		recorder, err := NewAlteredAccountsRecorder(args)
+		require.True(t, check.IfNil(recorder))
+		require.Equal(t, ErrNilShardCoordinator, err)
+	})
+	t.Run("invalid number of served blocks should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAlteredAccountsRecorder()
+		args.NumServedBlocks = 0
+		recorder, err := NewAlteredAccountsRecorder(args)
+		require.True(t, check.IfNil(recorder))
+		require.True(t, errors.Is(err, ErrInvalidNumServedBlocks))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		recorder, err := NewAlteredAccountsRecorder(createMockArgsAlteredAccountsRecorder())
+		require.False(t, check.IfNil(recorder))
+		require.Nil(t, err)
+	})
+}
+
+func TestAlteredAccountsRecorder_RecordAlteredAccountsShouldReadTheStateOfTheBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAlteredAccountsRecorder()
+	args.ShardCoordinator = &testscommon.ShardsCoordinatorMock{
+		ComputeIdCalled: func(address []byte) uint32 {
+			if string(address) == "carol" {
+				return 1
+			}
+			return 0
+		},
+	}
+	recorder, _ := NewAlteredAccountsRecorder(args)
+
+	blockArgs := createSaveBlockArgs("root", &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("carol")})
+	blockArgs.TransactionsPool.Scrs = map[string]data.TransactionHandler{
+		"scr": &smartContractResult.SmartContractResult{SndAddr: []byte("carol"), RcvAddr: []byte("alice")},
+	}
+	blockArgs.TransactionsPool.Rewards = map[string]data.TransactionHandler{
+		"reward": &rewardTx.RewardTx{RcvAddr: []byte("bob")},
+	}
+	blockArgs.TransactionsPool.Receipts = map[string]data.TransactionHandler{
+		"receipt": &receipt.Receipt{SndAddr: []byte("unknown")},
+	}
+
+	alteredAccounts := recorder.RecordAlteredAccounts(blockArgs)
+	require.Len(t, alteredAccounts, 2)
+	recorded := make(map[string]data.UserAccountHandler)
+	for _, account := range alteredAccounts {
+		recorded[string(account.AddressBytes())] = account
+	}
+	requireAccount(t, createUserAccount("alice", 1, 10), recorded["alice"])
+	requireAccount(t, createUserAccount("bob", 2, 20), recorded["bob"])
+}
+
+func TestAlteredAccountsRecorder_RecordAlteredAccountsWithoutTheStateOfTheBlockShouldRecordNothing(t *testing.T) {
+	t.Parallel()
+
+	recorder, _ := NewAlteredAccountsRecorder(createMockArgsAlteredAccountsRecorder())
+
+	alteredAccounts := recorder.RecordAlteredAccounts(createSaveBlockArgs("pruned", &transaction.Transaction{SndAddr: []byte("alice")}))
+	require.Empty(t, alteredAccounts)
+
+	alteredAccounts = recorder.RecordAlteredAccounts(nil)
+	require.Empty(t, alteredAccounts)
+}
+
+func TestAlteredAccountsRecorder_LoadAccountShouldServeTheMostRecentVersionAmongTheServedBlocks(t *testing.T) {
+	t.Parallel()
+
+	serve := func(recorder *alteredAccountsRecorder, accounts ...data.UserAccountHandler) {
+		err := recorder.ServeAlteredAccounts(accounts, func() error {
+			return nil
+		})
+		require.Nil(t, err)
+	}
+
+	recorder, _ := NewAlteredAccountsRecorder(createMockArgsAlteredAccountsRecorder())
+
+	account, err := recorder.LoadAccount([]byte("alice"))
+	require.Nil(t, account)
+	require.Equal(t, ErrAccountNotRecorded, err)
+
+	serve(recorder, createUserAccount("alice", 1, 10), createUserAccount("bob", 2, 20))
+	serve(recorder, createUserAccount("alice", 3, 30))
+
+	account, err = recorder.LoadAccount([]byte("alice"))
+	require.Nil(t, err)
+	requireAccount(t, createUserAccount("alice", 3, 30), account)
+	account, err = recorder.LoadAccount([]byte("bob"))
+	require.Nil(t, err)
+	requireAccount(t, createUserAccount("bob", 2, 20), account)
+
+	// the accounts of the oldest block are no longer served and the current state is never read
+	serve(recorder, createUserAccount("carol", 1, 10))
+
+	account, err = recorder.LoadAccount([]byte("bob"))
+	require.Nil(t, account)
+	require.Equal(t, ErrAccountNotRecorded, err)
+	account, err = recorder.LoadAccount([]byte("alice"))
+	require.Nil(t, err)
+	requireAccount(t, createUserAccount("alice", 3, 30), account)
+}
@@ -0,0 +1,21 @@
+package alteredAccounts
+
+import "errors"
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilDriver signals that a nil driver has been provided
+var ErrNilDriver = errors.New("nil driver")
+
+// ErrNilAlteredAccountsServer signals that a nil altered accounts server has been provided
+var ErrNilAlteredAccountsServer = errors.New("nil altered accounts server")
+
+// ErrInvalidNumServedBlocks signals that an invalid number of served blocks has been provided
+var ErrInvalidNumServedBlocks = errors.New("invalid number of served blocks")
+
+// ErrAccountNotRecorded signals that the requested account was not recorded as altered by any of the served blocks
+var ErrAccountNotRecorded = errors.New("account not recorded as altered by the block")
@@ -0,0 +1,13 @@
+package alteredAccounts
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+)
+
+// AlteredAccountsServer records the accounts altered by a block and serves them to the driver saving the block
+type AlteredAccountsServer interface {
+	RecordAlteredAccounts(args *indexer.ArgsSaveBlockData) []data.UserAccountHandler
+	ServeAlteredAccounts(alteredAccounts []data.UserAccountHandler, saveBlock func() error) error
+	IsInterfaceNil() bool
+}
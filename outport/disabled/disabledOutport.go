@@ -41,6 +41,10 @@ func (n *disabledOutport) SaveAccounts(_ uint64, _ []data.UserAccountHandler) {
 func (n *disabledOutport) FinalizedBlock(_ []byte) {
 }
 
+// ReplayBlock does nothing
+func (n *disabledOutport) ReplayBlock(_ *indexer.ArgsSaveBlockData) {
+}
+
 // Close does nothing
 func (n *disabledOutport) Close() error {
 	return nil
@@ -56,7 +60,17 @@ func (n *disabledOutport) SubscribeDriver(_ outport.Driver) error {
 	return nil
 }
 
+// SubscribeLiveDriver does nothing
+func (n *disabledOutport) SubscribeLiveDriver(_ outport.Driver) error {
+	return nil
+}
+
 // HasDrivers does nothing
 func (n *disabledOutport) HasDrivers() bool {
 	return false
 }
+
+// HasReplayDrivers does nothing
+func (n *disabledOutport) HasReplayDrivers() bool {
+	return false
+}
@@ -1,20 +1,57 @@
 package factory
 
 import (
+	"path/filepath"
 	"time"
 
 	covalentFactory "github.com/ElrondNetwork/covalent-indexer-go/factory"
 	indexerFactory "github.com/ElrondNetwork/elastic-indexer-go/factory"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/alteredAccounts"
+	"github.com/ElrondNetwork/elrond-go/outport/grpcDriver"
+	"github.com/ElrondNetwork/elrond-go/outport/queue"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	notifierFactory "github.com/ElrondNetwork/notifier-go/factory"
 )
 
+// GrpcDriverFactoryArgs holds the arguments needed to create the gRPC outport driver
+type GrpcDriverFactoryArgs struct {
+	Enabled bool
+	grpcDriver.ArgsGrpcDriver
+}
+
+// OutportQueueFactoryArgs holds the arguments needed to place an on-disk queue in front of each created driver
+type OutportQueueFactoryArgs struct {
+	Enabled  bool
+	DBConfig config.DBConfig
+	// Path is the directory holding the queues, each driver having its own subdirectory
+	Path                  string
+	Marshalizer           marshal.Marshalizer
+	MetricsHandler        common.MetricsHandler
+	MaxPendingRecords     uint64
+	MaxPendingSizeInBytes uint64
+}
+
 // OutportFactoryArgs holds the factory arguments of different outport drivers
 type OutportFactoryArgs struct {
 	RetrialInterval            time.Duration
 	ElasticIndexerFactoryArgs  *indexerFactory.ArgsIndexerFactory
 	EventNotifierFactoryArgs   *notifierFactory.EventNotifierFactoryArgs
 	CovalentIndexerFactoryArgs *covalentFactory.ArgsCovalentIndexerFactory
+	GrpcDriverFactoryArgs      *GrpcDriverFactoryArgs
+	OutportQueueFactoryArgs    *OutportQueueFactoryArgs
+	// AccountsDB and ShardCoordinator are used to record the accounts altered by each block for the elastic and the
+	// covalent indexers, which load them while saving the block
+	AccountsDB       state.AccountsAdapter
+	ShardCoordinator sharding.Coordinator
+	// SubscriptionsHub is an already created in-process driver and will be subscribed only if it is not nil
+	SubscriptionsHub outport.Driver
 }
 
 // CreateOutport will create a new instance of OutportHandler
@@ -38,17 +75,38 @@ func CreateOutport(args *OutportFactoryArgs) (outport.OutportHandler, error) {
 }
 
 func createAndSubscribeDrivers(outport outport.OutportHandler, args *OutportFactoryArgs) error {
-	err := createAndSubscribeElasticDriverIfNeeded(outport, args.ElasticIndexerFactoryArgs)
+	subscriber := &driverSubscriber{
+		outport:         outport,
+		queueArgs:       args.OutportQueueFactoryArgs,
+		retrialInterval: args.RetrialInterval,
+	}
+
+	alteredAccountsArgs := alteredAccounts.ArgsAlteredAccountsRecorder{
+		Accounts:         args.AccountsDB,
+		ShardCoordinator: args.ShardCoordinator,
+	}
+
+	err := createAndSubscribeElasticDriverIfNeeded(subscriber, args.ElasticIndexerFactoryArgs, alteredAccountsArgs)
 	if err != nil {
 		return err
 	}
 
-	err = createAndSubscribeEventNotifierIfNeeded(outport, args.EventNotifierFactoryArgs)
+	err = createAndSubscribeEventNotifierIfNeeded(subscriber, args.EventNotifierFactoryArgs)
 	if err != nil {
 		return err
 	}
 
-	err = createAndSubscribeCovalentDriverIfNeeded(outport, args.CovalentIndexerFactoryArgs)
+	err = createAndSubscribeCovalentDriverIfNeeded(subscriber, args.CovalentIndexerFactoryArgs, alteredAccountsArgs)
+	if err != nil {
+		return err
+	}
+
+	err = createAndSubscribeGrpcDriverIfNeeded(subscriber, args.GrpcDriverFactoryArgs)
+	if err != nil {
+		return err
+	}
+
+	err = subscribeSubscriptionsHubIfNeeded(outport, args.SubscriptionsHub)
 	if err != nil {
 		return err
 	}
@@ -57,39 +115,79 @@ func createAndSubscribeDrivers(outport outport.OutportHandler, args *OutportFact
 }
 
 func createAndSubscribeCovalentDriverIfNeeded(
-	outport outport.OutportHandler,
+	subscriber *driverSubscriber,
 	args *covalentFactory.ArgsCovalentIndexerFactory,
+	alteredAccountsArgs alteredAccounts.ArgsAlteredAccountsRecorder,
 ) error {
 	if !args.Enabled {
 		return nil
 	}
 
-	covalentDriver, err := covalentFactory.CreateCovalentIndexer(args)
+	// the covalent indexer saves the blocks synchronously, loading only the accounts of the block being saved
+	alteredAccountsArgs.NumServedBlocks = 1
+	recorder, err := alteredAccounts.NewAlteredAccountsRecorder(alteredAccountsArgs)
 	if err != nil {
 		return err
 	}
 
-	return outport.SubscribeDriver(covalentDriver)
+	covalentArgs := *args
+	covalentArgs.Accounts = recorder
+	covalentDriver, err := covalentFactory.CreateCovalentIndexer(&covalentArgs)
+	if err != nil {
+		return err
+	}
+
+	driver, err := alteredAccounts.NewAlteredAccountsDriver(alteredAccounts.ArgsAlteredAccountsDriver{
+		Driver: covalentDriver,
+		Server: recorder,
+	})
+	if err != nil {
+		_ = covalentDriver.Close()
+		return err
+	}
+
+	return subscriber.subscribe(driver, "covalent")
 }
 
 func createAndSubscribeElasticDriverIfNeeded(
-	outport outport.OutportHandler,
+	subscriber *driverSubscriber,
 	args *indexerFactory.ArgsIndexerFactory,
+	alteredAccountsArgs alteredAccounts.ArgsAlteredAccountsRecorder,
 ) error {
 	if !args.Enabled {
 		return nil
 	}
 
-	elasticDriver, err := indexerFactory.NewIndexer(args)
+	// the elastic indexer saves the blocks asynchronously, so the accounts of all the blocks waiting in its dispatcher
+	// are served, along with the ones of the block being saved and of the block waiting to be added
+	alteredAccountsArgs.NumServedBlocks = args.IndexerCacheSize + 2
+	recorder, err := alteredAccounts.NewAlteredAccountsRecorder(alteredAccountsArgs)
+	if err != nil {
+		return err
+	}
+
+	elasticArgs := *args
+	elasticArgs.AccountsDB = recorder
+	elasticDriver, err := indexerFactory.NewIndexer(&elasticArgs)
+	if err != nil {
+		return err
+	}
+
+	driver, err := alteredAccounts.NewAlteredAccountsDriver(alteredAccounts.ArgsAlteredAccountsDriver{
+		Driver:              elasticDriver,
+		Server:              recorder,
+		SaveAlteredAccounts: true,
+	})
 	if err != nil {
+		_ = elasticDriver.Close()
 		return err
 	}
 
-	return outport.SubscribeDriver(elasticDriver)
+	return subscriber.subscribe(driver, "elastic")
 }
 
 func createAndSubscribeEventNotifierIfNeeded(
-	outport outport.OutportHandler,
+	subscriber *driverSubscriber,
 	args *notifierFactory.EventNotifierFactoryArgs,
 ) error {
 	if !args.Enabled {
@@ -101,7 +199,73 @@ func createAndSubscribeEventNotifierIfNeeded(
 		return err
 	}
 
-	return outport.SubscribeDriver(eventNotifier)
+	return subscriber.subscribe(eventNotifier, "eventNotifier")
+}
+
+func createAndSubscribeGrpcDriverIfNeeded(
+	subscriber *driverSubscriber,
+	args *GrpcDriverFactoryArgs,
+) error {
+	if args == nil || !args.Enabled {
+		return nil
+	}
+
+	driver, err := grpcDriver.NewGrpcDriver(args.ArgsGrpcDriver)
+	if err != nil {
+		return err
+	}
+
+	return subscriber.subscribe(driver, "grpc")
+}
+
+type driverSubscriber struct {
+	outport         outport.OutportHandler
+	queueArgs       *OutportQueueFactoryArgs
+	retrialInterval time.Duration
+}
+
+// subscribe subscribes the provided driver, placing an on-disk queue in front of it if the queues are enabled
+func (ds *driverSubscriber) subscribe(driver outport.Driver, queueName string) error {
+	if ds.queueArgs == nil || !ds.queueArgs.Enabled {
+		return ds.outport.SubscribeDriver(driver)
+	}
+
+	persisterFactory := storageFactory.NewPersisterFactory(ds.queueArgs.DBConfig)
+	persister, err := persisterFactory.Create(filepath.Join(ds.queueArgs.Path, queueName))
+	if err != nil {
+		_ = driver.Close()
+		return err
+	}
+
+	queuedDriver, err := queue.NewQueuedDriver(queue.ArgsQueuedDriver{
+		Driver:                driver,
+		Persister:             persister,
+		Marshalizer:           ds.queueArgs.Marshalizer,
+		MetricsHandler:        ds.queueArgs.MetricsHandler,
+		RetrialInterval:       ds.retrialInterval,
+		Name:                  queueName,
+		MaxPendingRecords:     ds.queueArgs.MaxPendingRecords,
+		MaxPendingSizeInBytes: ds.queueArgs.MaxPendingSizeInBytes,
+	})
+	if err != nil {
+		_ = persister.Close()
+		_ = driver.Close()
+		return err
+	}
+
+	return ds.outport.SubscribeDriver(queuedDriver)
+}
+
+func subscribeSubscriptionsHubIfNeeded(
+	outport outport.OutportHandler,
+	subscriptionsHub outport.Driver,
+) error {
+	if check.IfNil(subscriptionsHub) {
+		return nil
+	}
+
+	// the websocket subscribers expect only the new blocks
+	return outport.SubscribeLiveDriver(subscriptionsHub)
 }
 
 func checkArguments(args *OutportFactoryArgs) error {
@@ -7,10 +7,16 @@ import (
 
 	covalentFactory "github.com/ElrondNetwork/covalent-indexer-go/factory"
 	indexerFactory "github.com/ElrondNetwork/elastic-indexer-go/factory"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/outport/factory"
+	"github.com/ElrondNetwork/elrond-go/outport/grpcDriver"
+	outportMock "github.com/ElrondNetwork/elrond-go/outport/mock"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	notifierFactory "github.com/ElrondNetwork/notifier-go/factory"
 	"github.com/stretchr/testify/require"
 )
@@ -107,8 +113,9 @@ func TestCreateOutport_SubscribeCovalentDriver(t *testing.T) {
 	args.CovalentIndexerFactoryArgs.Hasher = &mock.HasherMock{}
 	args.CovalentIndexerFactoryArgs.ShardCoordinator = &mock.ShardCoordinatorStub{}
 	args.CovalentIndexerFactoryArgs.Marshaller = &mock.MarshalizerMock{}
-	args.CovalentIndexerFactoryArgs.Accounts = &stateMock.AccountsStub{}
 	args.CovalentIndexerFactoryArgs.PubKeyConverter = &mock.PubkeyConverterStub{}
+	args.AccountsDB = &stateMock.AccountsStub{}
+	args.ShardCoordinator = &mock.ShardCoordinatorStub{}
 
 	outPort, err := factory.CreateOutport(args)
 
@@ -117,6 +124,7 @@ func TestCreateOutport_SubscribeCovalentDriver(t *testing.T) {
 	}(outPort)
 
 	require.True(t, outPort.HasDrivers())
+	require.True(t, outPort.HasReplayDrivers())
 	require.Nil(t, err)
 }
 
@@ -134,3 +142,117 @@ func TestCreateOutport_SubscribeNotifierDriver(t *testing.T) {
 	require.True(t, outPort.HasDrivers())
 	require.Nil(t, err)
 }
+
+func TestCreateOutport_SubscribeSubscriptionsHub(t *testing.T) {
+	args := createMockArgsOutportHandler(false, false, false)
+
+	outPort, err := factory.CreateOutport(args)
+	require.Nil(t, err)
+	require.False(t, outPort.HasDrivers())
+	_ = outPort.Close()
+
+	args.SubscriptionsHub = &outportMock.DriverStub{}
+	outPort, err = factory.CreateOutport(args)
+
+	defer func(c outport.OutportHandler) {
+		_ = c.Close()
+	}(outPort)
+
+	require.True(t, outPort.HasDrivers())
+	require.False(t, outPort.HasReplayDrivers())
+	require.Nil(t, err)
+}
+
+func TestCreateOutport_SubscribeGrpcDriver(t *testing.T) {
+	args := createMockArgsOutportHandler(false, false, false)
+	args.GrpcDriverFactoryArgs = &factory.GrpcDriverFactoryArgs{
+		Enabled: false,
+		ArgsGrpcDriver: grpcDriver.ArgsGrpcDriver{
+			Target:      "localhost:22111",
+			AckTimeout:  time.Second,
+			Marshalizer: &mock.MarshalizerMock{},
+		},
+	}
+
+	outPort, err := factory.CreateOutport(args)
+	require.Nil(t, err)
+	require.False(t, outPort.HasDrivers())
+	_ = outPort.Close()
+
+	args.GrpcDriverFactoryArgs.Enabled = true
+	args.GrpcDriverFactoryArgs.Target = "10.0.0.1:22111"
+	outPort, err = factory.CreateOutport(args)
+	require.Nil(t, outPort)
+	require.True(t, errors.Is(err, grpcDriver.ErrNonLocalTarget))
+
+	args.GrpcDriverFactoryArgs.Target = "localhost:22111"
+	outPort, err = factory.CreateOutport(args)
+
+	defer func(c outport.OutportHandler) {
+		_ = c.Close()
+	}(outPort)
+
+	require.True(t, outPort.HasDrivers())
+	require.Nil(t, err)
+}
+
+func TestCreateOutport_SubscribeQueuedDriver(t *testing.T) {
+	args := createMockArgsOutportHandler(false, false, false)
+	args.RetrialInterval = time.Second
+	args.GrpcDriverFactoryArgs = &factory.GrpcDriverFactoryArgs{
+		Enabled: true,
+		ArgsGrpcDriver: grpcDriver.ArgsGrpcDriver{
+			Target:      "localhost:22111",
+			AckTimeout:  time.Second,
+			Marshalizer: &mock.MarshalizerMock{},
+		},
+	}
+	args.OutportQueueFactoryArgs = &factory.OutportQueueFactoryArgs{
+		Enabled: true,
+		DBConfig: config.DBConfig{
+			Type: string(storageUnit.MemoryDB),
+		},
+		Path:                  "OutportQueue",
+		Marshalizer:           &mock.MarshalizerMock{},
+		MetricsHandler:        &statusHandler.MetricsHandlerStub{},
+		MaxPendingRecords:     100,
+		MaxPendingSizeInBytes: 1024,
+	}
+
+	outPort, err := factory.CreateOutport(args)
+	require.Nil(t, err)
+
+	defer func(c outport.OutportHandler) {
+		_ = c.Close()
+	}(outPort)
+
+	require.True(t, outPort.HasDrivers())
+	require.True(t, outPort.HasReplayDrivers())
+}
+
+func TestCreateOutport_SubscribeQueuedDriverWithInvalidDBShouldError(t *testing.T) {
+	args := createMockArgsOutportHandler(false, false, false)
+	args.GrpcDriverFactoryArgs = &factory.GrpcDriverFactoryArgs{
+		Enabled: true,
+		ArgsGrpcDriver: grpcDriver.ArgsGrpcDriver{
+			Target:      "localhost:22111",
+			AckTimeout:  time.Second,
+			Marshalizer: &mock.MarshalizerMock{},
+		},
+	}
+	args.OutportQueueFactoryArgs = &factory.OutportQueueFactoryArgs{
+		Enabled: true,
+		DBConfig: config.DBConfig{
+			Type: "invalid",
+		},
+		Path:                  "OutportQueue",
+		Marshalizer:           &mock.MarshalizerMock{},
+		MetricsHandler:        &statusHandler.MetricsHandlerStub{},
+		MaxPendingRecords:     100,
+		MaxPendingSizeInBytes: 1024,
+	}
+
+	outPort, err := factory.CreateOutport(args)
+	require.Nil(t, outPort)
+	require.Equal(t, storage.ErrNotSupportedDBType, err)
+}
@@ -0,0 +1,51 @@
+package grpcDriver
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/encoding"
+)
+
+const codecName = "proto"
+
+var _ encoding.Codec = (*codec)(nil)
+
+type codec struct {
+}
+
+// NewCodec returns the gRPC codec used on the outport stream. It relies on the gogo protobuf implementation, so
+// indexers written in Go can use it (together with the messages of this package) on the server side, as well
+func NewCodec() *codec {
+	return &codec{}
+}
+
+// Marshal returns the protobuf encoding of the provided message
+func (c *codec) Marshal(v interface{}) ([]byte, error) {
+	message, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrNotProtoMessage, v)
+	}
+
+	return proto.Marshal(message)
+}
+
+// Unmarshal decodes the provided bytes into the provided message
+func (c *codec) Unmarshal(data []byte, v interface{}) error {
+	message, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrNotProtoMessage, v)
+	}
+
+	return proto.Unmarshal(data, message)
+}
+
+// Name returns the name of the codec
+func (c *codec) Name() string {
+	return codecName
+}
+
+// String returns the name of the codec, so it can be provided to grpc.CustomCodec on the server side, as well
+func (c *codec) String() string {
+	return codecName
+}
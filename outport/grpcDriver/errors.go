@@ -0,0 +1,30 @@
+package grpcDriver
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrInvalidTarget signals that an invalid target has been provided
+var ErrInvalidTarget = errors.New("invalid target")
+
+// ErrNonLocalTarget signals that a TCP target which is not a loopback address has been provided
+var ErrNonLocalTarget = errors.New("target is not a local address")
+
+// ErrInvalidAckTimeout signals that an invalid acknowledgement timeout has been provided
+var ErrInvalidAckTimeout = errors.New("invalid acknowledgement timeout")
+
+// ErrAckTimeout signals that the indexer did not acknowledge a message in due time
+var ErrAckTimeout = errors.New("acknowledgement timeout")
+
+// ErrUnexpectedAck signals that the indexer acknowledged another message than the one which was sent
+var ErrUnexpectedAck = errors.New("unexpected acknowledgement")
+
+// ErrMessageRejected signals that the indexer could not handle a message
+var ErrMessageRejected = errors.New("message rejected by the indexer")
+
+// ErrNotProtoMessage signals that a value which is not a protobuf message was provided to the codec
+var ErrNotProtoMessage = errors.New("value is not a protobuf message")
+
+// ErrDriverClosed signals that the driver has been closed
+var ErrDriverClosed = errors.New("driver closed")
@@ -0,0 +1,305 @@
+package grpcDriver
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/messages"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+)
+
+var log = logger.GetOrCreate("outport/grpcDriver")
+
+const (
+	// ServiceName is the name of the gRPC service the indexer has to implement
+	ServiceName = "proto.Outport"
+	// StreamName is the name of the bidirectional stream of the gRPC service
+	StreamName = "Stream"
+
+	unixTargetPrefix  = "unix://"
+	minimumAckTimeout = time.Millisecond * 10
+)
+
+var _ outport.Driver = (*grpcDriver)(nil)
+
+var streamDescription = &grpc.StreamDesc{
+	StreamName:    StreamName,
+	ServerStreams: true,
+	ClientStreams: true,
+}
+
+// ArgsGrpcDriver holds the arguments needed to create a gRPC outport driver
+type ArgsGrpcDriver struct {
+	// Target is either a Unix socket ("unix:///path/to/socket") or a local TCP address ("localhost:port")
+	Target      string
+	AckTimeout  time.Duration
+	Marshalizer marshal.Marshalizer
+}
+
+// grpcDriver forwards the outport calls towards an external indexer, over a bidirectional gRPC stream. Each call
+// returns only after the indexer acknowledged the corresponding message, while an error (including a missing
+// acknowledgement) makes the outport retry the call, so the node never runs ahead of the indexer (back-pressure).
+type grpcDriver struct {
+	mutStream    sync.Mutex
+	conn         *grpc.ClientConn
+	stream       grpc.ClientStream
+	cancelStream context.CancelFunc
+	lastID       uint64
+	closed       bool
+	ackTimeout   time.Duration
+	codec        *codec
+	converter    outportConverter
+	target       string
+}
+
+// NewGrpcDriver creates a new gRPC outport driver. The connection towards the indexer is lazily established, so the
+// indexer does not need to be started before the node
+func NewGrpcDriver(args ArgsGrpcDriver) (*grpcDriver, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if args.AckTimeout < minimumAckTimeout {
+		return nil, fmt.Errorf("%w, provided: %v, minimum: %v", ErrInvalidAckTimeout, args.AckTimeout, minimumAckTimeout)
+	}
+	network, address, err := parseTarget(args.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) {
+		var netDialer net.Dialer
+		return netDialer.DialContext(ctx, network, address)
+	}
+
+	converter, err := messages.NewConverter(args.Marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.Dial(args.Target, grpc.WithInsecure(), grpc.WithContextDialer(dialer))
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("NewGrpcDriver", "network", network, "address", address)
+
+	return &grpcDriver{
+		conn:       conn,
+		ackTimeout: args.AckTimeout,
+		codec:      NewCodec(),
+		converter:  converter,
+		target:     args.Target,
+	}, nil
+}
+
+func parseTarget(target string) (string, string, error) {
+	if strings.HasPrefix(target, unixTargetPrefix) {
+		path := strings.TrimPrefix(target, unixTargetPrefix)
+		if len(path) == 0 {
+			return "", "", fmt.Errorf("%w: empty Unix socket path", ErrInvalidTarget)
+		}
+
+		return "unix", path, nil
+	}
+
+	host, _, err := net.SplitHostPort(target)
+	if err != nil {
+		return "", "", fmt.Errorf("%w: %s", ErrInvalidTarget, err.Error())
+	}
+	if !isLoopbackHost(host) {
+		return "", "", fmt.Errorf("%w: %s", ErrNonLocalTarget, target)
+	}
+
+	return "tcp", target, nil
+}
+
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// SaveBlock forwards the block, its transactions, receipts and logs
+func (driver *grpcDriver) SaveBlock(args *indexer.ArgsSaveBlockData) error {
+	if args == nil {
+		return nil
+	}
+
+	payload, err := driver.converter.ConvertSaveBlock(args)
+	if err != nil {
+		return err
+	}
+
+	return driver.send(messages.OperationSaveBlock, payload)
+}
+
+// RevertIndexedBlock forwards the reverted block
+func (driver *grpcDriver) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) error {
+	payload, err := driver.converter.ConvertRevertIndexedBlock(header, body)
+	if err != nil {
+		return err
+	}
+
+	return driver.send(messages.OperationRevertIndexedBlock, payload)
+}
+
+// SaveRoundsInfo forwards the rounds information
+func (driver *grpcDriver) SaveRoundsInfo(roundsInfos []*indexer.RoundInfo) error {
+	return driver.send(messages.OperationSaveRoundsInfo, messages.ConvertRoundsInfo(roundsInfos))
+}
+
+// SaveValidatorsPubKeys forwards the validators public keys of an epoch
+func (driver *grpcDriver) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) error {
+	return driver.send(messages.OperationSaveValidatorsPubKeys, messages.ConvertValidatorsPubKeys(validatorsPubKeys, epoch))
+}
+
+// SaveValidatorsRating forwards the validators rating
+func (driver *grpcDriver) SaveValidatorsRating(indexID string, infoRating []*indexer.ValidatorRatingInfo) error {
+	return driver.send(messages.OperationSaveValidatorsRating, messages.ConvertValidatorsRating(indexID, infoRating))
+}
+
+// SaveAccounts forwards the provided accounts
+func (driver *grpcDriver) SaveAccounts(blockTimestamp uint64, acc []data.UserAccountHandler) error {
+	return driver.send(messages.OperationSaveAccounts, messages.ConvertAccounts(blockTimestamp, acc))
+}
+
+// FinalizedBlock forwards the hash of the finalized block
+func (driver *grpcDriver) FinalizedBlock(headerHash []byte) error {
+	return driver.send(messages.OperationFinalizedBlock, &messages.FinalizedBlockPayload{HeaderHash: headerHash})
+}
+
+func (driver *grpcDriver) send(operation messages.Operation, payload proto.Message) error {
+	payloadBytes, err := proto.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	driver.mutStream.Lock()
+	defer driver.mutStream.Unlock()
+
+	if driver.closed {
+		return ErrDriverClosed
+	}
+
+	stream, err := driver.getOrCreateStreamNoLock()
+	if err != nil {
+		return err
+	}
+
+	driver.lastID++
+	message := &messages.OutportMessage{
+		ID:        driver.lastID,
+		Operation: operation,
+		Payload:   payloadBytes,
+	}
+
+	// SendMsg blocks while the flow control window of the stream is exhausted
+	err = stream.SendMsg(message)
+	if err != nil {
+		driver.resetStreamNoLock()
+		return fmt.Errorf("%w while sending %s", err, operation)
+	}
+
+	ack, err := driver.receiveAckNoLock(stream)
+	if err != nil {
+		driver.resetStreamNoLock()
+		return fmt.Errorf("%w while waiting the acknowledgement of %s", err, operation)
+	}
+	if ack.ID != message.ID {
+		driver.resetStreamNoLock()
+		return fmt.Errorf("%w for %s: expected ID %d, received %d", ErrUnexpectedAck, operation, message.ID, ack.ID)
+	}
+	if len(ack.Error) > 0 {
+		return fmt.Errorf("%w, operation: %s, error: %s", ErrMessageRejected, operation, ack.Error)
+	}
+
+	return nil
+}
+
+func (driver *grpcDriver) getOrCreateStreamNoLock() (grpc.ClientStream, error) {
+	if driver.stream != nil {
+		return driver.stream, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := driver.conn.NewStream(ctx, streamDescription, "/"+ServiceName+"/"+StreamName, grpc.ForceCodec(driver.codec))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	log.Debug("grpcDriver: stream opened", "target", driver.target)
+	driver.stream = stream
+	driver.cancelStream = cancel
+
+	return stream, nil
+}
+
+func (driver *grpcDriver) receiveAckNoLock(stream grpc.ClientStream) (*messages.Ack, error) {
+	chResult := make(chan error, 1)
+	ack := &messages.Ack{}
+	go func() {
+		chResult <- stream.RecvMsg(ack)
+	}()
+
+	timer := time.NewTimer(driver.ackTimeout)
+	defer timer.Stop()
+
+	select {
+	case err := <-chResult:
+		if err != nil {
+			return nil, err
+		}
+		return ack, nil
+	case <-timer.C:
+		return nil, ErrAckTimeout
+	}
+}
+
+// resetStreamNoLock cancels the current stream (if any), so the next call opens a new one. Cancelling the stream also
+// unblocks a pending RecvMsg call
+func (driver *grpcDriver) resetStreamNoLock() {
+	if driver.cancelStream != nil {
+		driver.cancelStream()
+	}
+
+	driver.stream = nil
+	driver.cancelStream = nil
+}
+
+// Close closes the stream and the underlying connection
+func (driver *grpcDriver) Close() error {
+	driver.mutStream.Lock()
+	defer driver.mutStream.Unlock()
+
+	if driver.closed {
+		return nil
+	}
+
+	if driver.stream != nil {
+		log.LogIfError(driver.stream.CloseSend())
+	}
+	driver.resetStreamNoLock()
+	driver.closed = true
+
+	return driver.conn.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (driver *grpcDriver) IsInterfaceNil() bool {
+	return driver == nil
+}
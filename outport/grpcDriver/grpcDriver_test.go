@@ -0,0 +1,360 @@
+package grpcDriver
+
+import (
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/outport/messages"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// indexerStub is an in-process gRPC server implementing the outport stream
+type indexerStub struct {
+	mutMessages sync.Mutex
+	messages    []*messages.OutportMessage
+	handle      func(message *messages.OutportMessage) (*messages.Ack, bool)
+	server      *grpc.Server
+	target      string
+}
+
+func newIndexerStub(t *testing.T, handle func(message *messages.OutportMessage) (*messages.Ack, bool)) *indexerStub {
+	dir, err := ioutil.TempDir("", "grpcDriver")
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+
+	socketPath := filepath.Join(dir, "outport.sock")
+	listener, err := net.Listen("unix", socketPath)
+	require.Nil(t, err)
+
+	stub := &indexerStub{
+		messages: make([]*messages.OutportMessage, 0),
+		handle:   handle,
+		server:   grpc.NewServer(grpc.CustomCodec(NewCodec())),
+		target:   unixTargetPrefix + socketPath,
+	}
+	stub.server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: ServiceName,
+		HandlerType: (*interface{})(nil),
+		Streams: []grpc.StreamDesc{
+			{
+				StreamName:    StreamName,
+				Handler:       stub.handleStream,
+				ServerStreams: true,
+				ClientStreams: true,
+			},
+		},
+	}, stub)
+
+	go func() {
+		_ = stub.server.Serve(listener)
+	}()
+	t.Cleanup(stub.server.Stop)
+
+	return stub
+}
+
+func (stub *indexerStub) handleStream(_ interface{}, stream grpc.ServerStream) error {
+	for {
+		message := &messages.OutportMessage{}
+		err := stream.RecvMsg(message)
+		if err != nil {
+			return nil
+		}
+
+		stub.mutMessages.Lock()
+		stub.messages = append(stub.messages, message)
+		stub.mutMessages.Unlock()
+
+		ack := &messages.Ack{ID: message.ID}
+		shouldReply := true
+		if stub.handle != nil {
+			ack, shouldReply = stub.handle(message)
+		}
+		if !shouldReply {
+			continue
+		}
+
+		err = stream.SendMsg(ack)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (stub *indexerStub) getMessages() []*messages.OutportMessage {
+	stub.mutMessages.Lock()
+	defer stub.mutMessages.Unlock()
+
+	return append(make([]*messages.OutportMessage, 0, len(stub.messages)), stub.messages...)
+}
+
+func createMockArgsGrpcDriver(target string) ArgsGrpcDriver {
+	return ArgsGrpcDriver{
+		Target:      target,
+		AckTimeout:  time.Second * 5,
+		Marshalizer: &marshal.GogoProtoMarshalizer{},
+	}
+}
+
+func TestNewGrpcDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		args := createMockArgsGrpcDriver("localhost:22111")
+		args.Marshalizer = nil
+
+		driver, err := NewGrpcDriver(args)
+		require.Nil(t, driver)
+		require.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("invalid ack timeout should error", func(t *testing.T) {
+		args := createMockArgsGrpcDriver("localhost:22111")
+		args.AckTimeout = time.Millisecond
+
+		driver, err := NewGrpcDriver(args)
+		require.Nil(t, driver)
+		require.True(t, errors.Is(err, ErrInvalidAckTimeout))
+	})
+	t.Run("invalid targets should error", func(t *testing.T) {
+		for _, target := range []string{"", "unix://", "localhost", "http://localhost:22111"} {
+			driver, err := NewGrpcDriver(createMockArgsGrpcDriver(target))
+			require.Nil(t, driver, target)
+			require.True(t, errors.Is(err, ErrInvalidTarget), target)
+		}
+	})
+	t.Run("non local targets should error", func(t *testing.T) {
+		for _, target := range []string{"10.0.0.1:22111", "example.com:22111", "[2001:db8::1]:22111"} {
+			driver, err := NewGrpcDriver(createMockArgsGrpcDriver(target))
+			require.Nil(t, driver, target)
+			require.True(t, errors.Is(err, ErrNonLocalTarget), target)
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		for _, target := range []string{"localhost:22111", "127.0.0.1:22111", "[::1]:22111", "unix:///tmp/outport.sock"} {
+			driver, err := NewGrpcDriver(createMockArgsGrpcDriver(target))
+			require.Nil(t, err, target)
+			require.False(t, driver.IsInterfaceNil())
+			require.Nil(t, driver.Close())
+		}
+	})
+}
+
+func TestGrpcDriver_ShouldForwardAllOperations(t *testing.T) {
+	t.Parallel()
+
+	server := newIndexerStub(t, nil)
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	driver, err := NewGrpcDriver(createMockArgsGrpcDriver(server.target))
+	require.Nil(t, err)
+	defer func() {
+		_ = driver.Close()
+	}()
+
+	header := &block.Header{Nonce: 7, Round: 8}
+	body := &block.Body{MiniBlocks: []*block.MiniBlock{{TxHashes: [][]byte{[]byte("tx")}}}}
+	tx := &transaction.Transaction{Nonce: 1, Value: big.NewInt(10)}
+	require.Nil(t, driver.SaveBlock(createSaveBlockArgs(header, body, tx)))
+	require.Nil(t, driver.RevertIndexedBlock(&block.MetaBlock{Nonce: 9}, body))
+	require.Nil(t, driver.SaveRoundsInfo([]*indexer.RoundInfo{{Index: 8, ShardId: 1, BlockWasProposed: true}}))
+	require.Nil(t, driver.SaveValidatorsPubKeys(map[uint32][][]byte{1: {[]byte("b")}, 0: {[]byte("a")}}, 3))
+	require.Nil(t, driver.SaveValidatorsRating("0_3", []*indexer.ValidatorRatingInfo{{PublicKey: "a", Rating: 50.5}}))
+	require.Nil(t, driver.SaveAccounts(100, []data.UserAccountHandler{createUserAccount(t, []byte("alice"), 5, 42)}))
+	require.Nil(t, driver.FinalizedBlock([]byte("hash")))
+
+	received := server.getMessages()
+	require.Len(t, received, 7)
+	expectedOperations := []messages.Operation{
+		messages.OperationSaveBlock,
+		messages.OperationRevertIndexedBlock,
+		messages.OperationSaveRoundsInfo,
+		messages.OperationSaveValidatorsPubKeys,
+		messages.OperationSaveValidatorsRating,
+		messages.OperationSaveAccounts,
+		messages.OperationFinalizedBlock,
+	}
+	for i, message := range received {
+		require.Equal(t, uint64(i+1), message.ID)
+		require.Equal(t, expectedOperations[i], message.Operation)
+	}
+
+	saveBlockPayload := &messages.SaveBlockPayload{}
+	require.Nil(t, proto.Unmarshal(received[0].Payload, saveBlockPayload))
+	require.Equal(t, []byte("headerHash"), saveBlockPayload.HeaderHash)
+	require.Equal(t, messages.HeaderTypeShard, saveBlockPayload.HeaderType)
+	require.Equal(t, uint64(1000), saveBlockPayload.GasConsumed)
+	receivedHeader := &block.Header{}
+	require.Nil(t, marshalizer.Unmarshal(receivedHeader, saveBlockPayload.Header))
+	require.Equal(t, header, receivedHeader)
+	receivedBody := &block.Body{}
+	require.Nil(t, marshalizer.Unmarshal(receivedBody, saveBlockPayload.Body))
+	require.Equal(t, body, receivedBody)
+	require.Len(t, saveBlockPayload.Transactions, 1)
+	require.Equal(t, []byte("txHash"), saveBlockPayload.Transactions[0].Hash)
+	receivedTx := &transaction.Transaction{}
+	require.Nil(t, marshalizer.Unmarshal(receivedTx, saveBlockPayload.Transactions[0].Payload))
+	require.Equal(t, tx, receivedTx)
+
+	revertPayload := &messages.RevertIndexedBlockPayload{}
+	require.Nil(t, proto.Unmarshal(received[1].Payload, revertPayload))
+	require.Equal(t, messages.HeaderTypeMeta, revertPayload.HeaderType)
+
+	pubKeysPayload := &messages.ValidatorsPubKeysPayload{}
+	require.Nil(t, proto.Unmarshal(received[3].Payload, pubKeysPayload))
+	require.Equal(t, uint32(3), pubKeysPayload.Epoch)
+	require.Len(t, pubKeysPayload.Shards, 2)
+	require.Equal(t, uint32(0), pubKeysPayload.Shards[0].ShardID)
+	require.Equal(t, [][]byte{[]byte("a")}, pubKeysPayload.Shards[0].PubKeys)
+
+	ratingPayload := &messages.ValidatorsRatingPayload{}
+	require.Nil(t, proto.Unmarshal(received[4].Payload, ratingPayload))
+	require.Equal(t, float32(50.5), ratingPayload.Ratings[0].Rating)
+
+	accountsPayload := &messages.AccountsPayload{}
+	require.Nil(t, proto.Unmarshal(received[5].Payload, accountsPayload))
+	require.Equal(t, uint64(100), accountsPayload.BlockTimestamp)
+	require.Equal(t, &messages.Account{Address: []byte("alice"), Nonce: 5, Balance: "42"}, accountsPayload.Accounts[0])
+}
+
+func TestGrpcDriver_RejectedMessageShouldError(t *testing.T) {
+	t.Parallel()
+
+	numCalls := uint32(0)
+	server := newIndexerStub(t, func(message *messages.OutportMessage) (*messages.Ack, bool) {
+		if atomic.AddUint32(&numCalls, 1) == 1 {
+			return &messages.Ack{ID: message.ID, Error: "database is down"}, true
+		}
+		return &messages.Ack{ID: message.ID}, true
+	})
+	driver, _ := NewGrpcDriver(createMockArgsGrpcDriver(server.target))
+	defer func() {
+		_ = driver.Close()
+	}()
+
+	err := driver.FinalizedBlock([]byte("hash"))
+	require.True(t, errors.Is(err, ErrMessageRejected))
+	require.Contains(t, err.Error(), "database is down")
+
+	// The retry goes on the same stream
+	require.Nil(t, driver.FinalizedBlock([]byte("hash")))
+	require.Len(t, server.getMessages(), 2)
+}
+
+func TestGrpcDriver_MissingAckShouldErrorAndReopenStream(t *testing.T) {
+	t.Parallel()
+
+	numCalls := uint32(0)
+	server := newIndexerStub(t, func(message *messages.OutportMessage) (*messages.Ack, bool) {
+		return &messages.Ack{ID: message.ID}, atomic.AddUint32(&numCalls, 1) > 1
+	})
+	args := createMockArgsGrpcDriver(server.target)
+	args.AckTimeout = time.Millisecond * 200
+	driver, _ := NewGrpcDriver(args)
+	defer func() {
+		_ = driver.Close()
+	}()
+
+	err := driver.FinalizedBlock([]byte("hash"))
+	require.True(t, errors.Is(err, ErrAckTimeout))
+
+	require.Nil(t, driver.FinalizedBlock([]byte("hash")))
+	received := server.getMessages()
+	require.Len(t, received, 2)
+	require.Equal(t, uint64(2), received[1].ID)
+}
+
+func TestGrpcDriver_UnexpectedAckShouldError(t *testing.T) {
+	t.Parallel()
+
+	server := newIndexerStub(t, func(message *messages.OutportMessage) (*messages.Ack, bool) {
+		return &messages.Ack{ID: message.ID + 1}, true
+	})
+	driver, _ := NewGrpcDriver(createMockArgsGrpcDriver(server.target))
+	defer func() {
+		_ = driver.Close()
+	}()
+
+	err := driver.FinalizedBlock([]byte("hash"))
+	require.True(t, errors.Is(err, ErrUnexpectedAck))
+}
+
+func TestGrpcDriver_IndexerNotStartedShouldError(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGrpcDriver("unix:///tmp/missing-outport-indexer.sock")
+	args.AckTimeout = time.Millisecond * 200
+	driver, err := NewGrpcDriver(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = driver.Close()
+	}()
+
+	require.NotNil(t, driver.FinalizedBlock([]byte("hash")))
+}
+
+func TestGrpcDriver_SaveBlockWithUnsupportedHeaderShouldError(t *testing.T) {
+	t.Parallel()
+
+	driver, _ := NewGrpcDriver(createMockArgsGrpcDriver("localhost:22111"))
+	defer func() {
+		_ = driver.Close()
+	}()
+
+	err := driver.SaveBlock(&indexer.ArgsSaveBlockData{Header: &testscommon.HeaderHandlerStub{}})
+	require.True(t, errors.Is(err, messages.ErrUnsupportedHeaderType))
+}
+
+func TestGrpcDriver_CallsAfterCloseShouldError(t *testing.T) {
+	t.Parallel()
+
+	server := newIndexerStub(t, nil)
+	driver, _ := NewGrpcDriver(createMockArgsGrpcDriver(server.target))
+	require.Nil(t, driver.FinalizedBlock([]byte("hash")))
+
+	require.Nil(t, driver.Close())
+	require.Nil(t, driver.Close())
+	require.Equal(t, ErrDriverClosed, driver.FinalizedBlock([]byte("hash")))
+}
+
+func createSaveBlockArgs(header data.HeaderHandler, body data.BodyHandler, tx data.TransactionHandler) *indexer.ArgsSaveBlockData {
+	return &indexer.ArgsSaveBlockData{
+		HeaderHash: []byte("headerHash"),
+		Header:     header,
+		Body:       body,
+		HeaderGasConsumption: indexer.HeaderGasConsumption{
+			GasConsumed: 1000,
+		},
+		TransactionsPool: &indexer.Pool{
+			Txs: map[string]data.TransactionHandler{
+				"txHash": tx,
+			},
+		},
+	}
+}
+
+func createUserAccount(t *testing.T, address []byte, nonce uint64, balance int64) data.UserAccountHandler {
+	account, err := state.NewUserAccount(address)
+	require.Nil(t, err)
+	account.Nonce = nonce
+	account.Balance = big.NewInt(balance)
+
+	return account
+}
@@ -0,0 +1,12 @@
+package grpcDriver
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/outport/messages"
+)
+
+type outportConverter interface {
+	ConvertSaveBlock(args *indexer.ArgsSaveBlockData) (*messages.SaveBlockPayload, error)
+	ConvertRevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) (*messages.RevertIndexedBlockPayload, error)
+}
@@ -29,8 +29,11 @@ type OutportHandler interface {
 	SaveValidatorsRating(indexID string, infoRating []*indexer.ValidatorRatingInfo)
 	SaveAccounts(blockTimestamp uint64, acc []data.UserAccountHandler)
 	FinalizedBlock(headerHash []byte)
+	ReplayBlock(args *indexer.ArgsSaveBlockData)
 	SubscribeDriver(driver Driver) error
+	SubscribeLiveDriver(driver Driver) error
 	HasDrivers() bool
+	HasReplayDrivers() bool
 	Close() error
 	IsInterfaceNil() bool
 }
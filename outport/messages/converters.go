@@ -0,0 +1,285 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. outport.proto
+package messages
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+)
+
+const (
+	// HeaderTypeShard is the header type of the shard blocks (block.Header)
+	HeaderTypeShard = "Header"
+	// HeaderTypeMeta is the header type of the metachain blocks (block.MetaBlock)
+	HeaderTypeMeta = "MetaBlock"
+)
+
+type converter struct {
+	marshalizer marshal.Marshalizer
+}
+
+// NewConverter creates a converter between the outport driver calls and the messages of this package. The provided
+// marshalizer is used on the headers, bodies, transactions, receipts and logs
+func NewConverter(marshalizer marshal.Marshalizer) (*converter, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+
+	return &converter{
+		marshalizer: marshalizer,
+	}, nil
+}
+
+// ConvertSaveBlock converts the arguments of a SaveBlock call
+func (c *converter) ConvertSaveBlock(args *indexer.ArgsSaveBlockData) (*SaveBlockPayload, error) {
+	headerType, headerBytes, err := c.marshalHeader(args.Header)
+	if err != nil {
+		return nil, err
+	}
+	bodyBytes, err := c.marshalBody(args.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &SaveBlockPayload{
+		HeaderHash:             args.HeaderHash,
+		HeaderType:             headerType,
+		Header:                 headerBytes,
+		Body:                   bodyBytes,
+		SignersIndexes:         args.SignersIndexes,
+		NotarizedHeadersHashes: args.NotarizedHeadersHashes,
+		GasConsumed:            args.HeaderGasConsumption.GasConsumed,
+		GasRefunded:            args.HeaderGasConsumption.GasRefunded,
+		GasPenalized:           args.HeaderGasConsumption.GasPenalized,
+		MaxGasPerBlock:         args.HeaderGasConsumption.MaxGasPerBlock,
+	}
+
+	pool := args.TransactionsPool
+	if pool == nil {
+		return payload, nil
+	}
+
+	payload.Transactions, err = c.convertTransactions(pool.Txs)
+	if err != nil {
+		return nil, err
+	}
+	payload.SmartContractResults, err = c.convertTransactions(pool.Scrs)
+	if err != nil {
+		return nil, err
+	}
+	payload.Rewards, err = c.convertTransactions(pool.Rewards)
+	if err != nil {
+		return nil, err
+	}
+	payload.InvalidTransactions, err = c.convertTransactions(pool.Invalid)
+	if err != nil {
+		return nil, err
+	}
+	payload.Receipts, err = c.convertTransactions(pool.Receipts)
+	if err != nil {
+		return nil, err
+	}
+	payload.Logs, err = c.convertLogs(pool.Logs)
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// ConvertRevertIndexedBlock converts the arguments of a RevertIndexedBlock call
+func (c *converter) ConvertRevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) (*RevertIndexedBlockPayload, error) {
+	headerType, headerBytes, err := c.marshalHeader(header)
+	if err != nil {
+		return nil, err
+	}
+	bodyBytes, err := c.marshalBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RevertIndexedBlockPayload{
+		HeaderType: headerType,
+		Header:     headerBytes,
+		Body:       bodyBytes,
+	}, nil
+}
+
+func (c *converter) marshalHeader(header data.HeaderHandler) (string, []byte, error) {
+	if check.IfNil(header) {
+		return "", nil, nil
+	}
+
+	var headerType string
+	switch header.(type) {
+	case *block.Header:
+		headerType = HeaderTypeShard
+	case *block.MetaBlock:
+		headerType = HeaderTypeMeta
+	default:
+		return "", nil, fmt.Errorf("%w: %T", ErrUnsupportedHeaderType, header)
+	}
+
+	headerBytes, err := c.marshalizer.Marshal(header)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return headerType, headerBytes, nil
+}
+
+func (c *converter) marshalBody(body data.BodyHandler) ([]byte, error) {
+	if check.IfNil(body) {
+		return nil, nil
+	}
+
+	return c.marshalizer.Marshal(body)
+}
+
+func (c *converter) convertTransactions(txs map[string]data.TransactionHandler) ([]*TransactionEntry, error) {
+	entries := make([]*TransactionEntry, 0, len(txs))
+	for hash, tx := range txs {
+		if check.IfNil(tx) {
+			continue
+		}
+
+		txBytes, err := c.marshalizer.Marshal(tx)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, &TransactionEntry{
+			Hash:    []byte(hash),
+			Payload: txBytes,
+		})
+	}
+
+	sortEntriesByHash(entries)
+	return entries, nil
+}
+
+func (c *converter) convertLogs(logs map[string]data.LogHandler) ([]*TransactionEntry, error) {
+	entries := make([]*TransactionEntry, 0, len(logs))
+	for hash, logHandler := range logs {
+		if check.IfNil(logHandler) {
+			continue
+		}
+
+		logBytes, err := c.marshalizer.Marshal(logHandler)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, &TransactionEntry{
+			Hash:    []byte(hash),
+			Payload: logBytes,
+		})
+	}
+
+	sortEntriesByHash(entries)
+	return entries, nil
+}
+
+func sortEntriesByHash(entries []*TransactionEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		return string(entries[i].Hash) < string(entries[j].Hash)
+	})
+}
+
+// ConvertRoundsInfo converts the arguments of a SaveRoundsInfo call
+func ConvertRoundsInfo(roundsInfo []*indexer.RoundInfo) *RoundsInfoPayload {
+	payload := &RoundsInfoPayload{
+		RoundsInfo: make([]*RoundInfo, 0, len(roundsInfo)),
+	}
+	for _, roundInfo := range roundsInfo {
+		if roundInfo == nil {
+			continue
+		}
+
+		payload.RoundsInfo = append(payload.RoundsInfo, &RoundInfo{
+			Index:            roundInfo.Index,
+			SignersIndexes:   roundInfo.SignersIndexes,
+			BlockWasProposed: roundInfo.BlockWasProposed,
+			ShardID:          roundInfo.ShardId,
+			Epoch:            roundInfo.Epoch,
+			Timestamp:        uint64(roundInfo.Timestamp),
+		})
+	}
+
+	return payload
+}
+
+// ConvertValidatorsPubKeys converts the arguments of a SaveValidatorsPubKeys call
+func ConvertValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) *ValidatorsPubKeysPayload {
+	payload := &ValidatorsPubKeysPayload{
+		Epoch:  epoch,
+		Shards: make([]*ShardValidatorsPubKeys, 0, len(validatorsPubKeys)),
+	}
+	for shardID, pubKeys := range validatorsPubKeys {
+		payload.Shards = append(payload.Shards, &ShardValidatorsPubKeys{
+			ShardID: shardID,
+			PubKeys: pubKeys,
+		})
+	}
+	sort.Slice(payload.Shards, func(i, j int) bool {
+		return payload.Shards[i].ShardID < payload.Shards[j].ShardID
+	})
+
+	return payload
+}
+
+// ConvertValidatorsRating converts the arguments of a SaveValidatorsRating call
+func ConvertValidatorsRating(indexID string, infoRating []*indexer.ValidatorRatingInfo) *ValidatorsRatingPayload {
+	payload := &ValidatorsRatingPayload{
+		IndexID: indexID,
+		Ratings: make([]*ValidatorRating, 0, len(infoRating)),
+	}
+	for _, rating := range infoRating {
+		if rating == nil {
+			continue
+		}
+
+		payload.Ratings = append(payload.Ratings, &ValidatorRating{
+			PublicKey: rating.PublicKey,
+			Rating:    rating.Rating,
+		})
+	}
+
+	return payload
+}
+
+// ConvertAccounts converts the arguments of a SaveAccounts call
+func ConvertAccounts(blockTimestamp uint64, accounts []data.UserAccountHandler) *AccountsPayload {
+	return &AccountsPayload{
+		BlockTimestamp: blockTimestamp,
+		Accounts:       ConvertAlteredAccounts(accounts),
+	}
+}
+
+// ConvertAlteredAccounts converts the provided accounts, keeping only their address, nonce and balance
+func ConvertAlteredAccounts(accounts []data.UserAccountHandler) []*Account {
+	converted := make([]*Account, 0, len(accounts))
+	for _, account := range accounts {
+		if check.IfNil(account) {
+			continue
+		}
+
+		balance := "0"
+		if account.GetBalance() != nil {
+			balance = account.GetBalance().String()
+		}
+
+		converted = append(converted, &Account{
+			Address: account.AddressBytes(),
+			Nonce:   account.GetNonce(),
+			Balance: balance,
+		})
+	}
+
+	return converted
+}
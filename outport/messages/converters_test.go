@@ -0,0 +1,156 @@
+package messages
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConverter(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewConverter(nil)
+	require.Nil(t, c)
+	require.Equal(t, ErrNilMarshalizer, err)
+
+	c, err = NewConverter(&marshal.GogoProtoMarshalizer{})
+	require.NotNil(t, c)
+	require.Nil(t, err)
+}
+
+func TestConverter_SaveBlockRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	c, _ := NewConverter(&marshal.GogoProtoMarshalizer{})
+	args := &indexer.ArgsSaveBlockData{
+		HeaderHash:             []byte("headerHash"),
+		Header:                 &block.MetaBlock{Nonce: 4, Round: 5},
+		Body:                   &block.Body{MiniBlocks: []*block.MiniBlock{{TxHashes: [][]byte{[]byte("txHash")}}}},
+		SignersIndexes:         []uint64{1, 2},
+		NotarizedHeadersHashes: []string{"notarized"},
+		HeaderGasConsumption: indexer.HeaderGasConsumption{
+			GasConsumed:    1,
+			GasRefunded:    2,
+			GasPenalized:   3,
+			MaxGasPerBlock: 4,
+		},
+		TransactionsPool: &indexer.Pool{
+			Txs:      map[string]data.TransactionHandler{"tx": &transaction.Transaction{Nonce: 1, Value: big.NewInt(1)}},
+			Scrs:     map[string]data.TransactionHandler{"scr": &smartContractResult.SmartContractResult{Nonce: 2, Value: big.NewInt(2)}},
+			Rewards:  map[string]data.TransactionHandler{"reward": &rewardTx.RewardTx{Round: 3, Value: big.NewInt(3)}},
+			Invalid:  map[string]data.TransactionHandler{"invalid": &transaction.Transaction{Nonce: 4, Value: big.NewInt(4)}},
+			Receipts: map[string]data.TransactionHandler{"receipt": &receipt.Receipt{Value: big.NewInt(5), TxHash: []byte("tx")}},
+			Logs:     map[string]data.LogHandler{"tx": &transaction.Log{Address: []byte("contract")}},
+		},
+	}
+
+	payload, err := c.ConvertSaveBlock(args)
+	require.Nil(t, err)
+
+	restored, err := c.RestoreSaveBlock(payload)
+	require.Nil(t, err)
+	require.Equal(t, args, restored)
+}
+
+func TestConverter_RevertIndexedBlockRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	c, _ := NewConverter(&marshal.GogoProtoMarshalizer{})
+
+	payload, err := c.ConvertRevertIndexedBlock(&block.Header{Nonce: 3}, &block.Body{})
+	require.Nil(t, err)
+	header, body, err := c.RestoreRevertIndexedBlock(payload)
+	require.Nil(t, err)
+	require.Equal(t, &block.Header{Nonce: 3}, header)
+	require.Equal(t, &block.Body{}, body)
+
+	payload, err = c.ConvertRevertIndexedBlock(nil, nil)
+	require.Nil(t, err)
+	header, body, err = c.RestoreRevertIndexedBlock(payload)
+	require.Nil(t, err)
+	require.True(t, check.IfNil(header))
+	require.True(t, check.IfNil(body))
+}
+
+func TestConverter_UnsupportedHeaderTypeShouldError(t *testing.T) {
+	t.Parallel()
+
+	c, _ := NewConverter(&marshal.GogoProtoMarshalizer{})
+
+	_, err := c.ConvertRevertIndexedBlock(&testscommon.HeaderHandlerStub{}, &block.Body{})
+	require.True(t, errors.Is(err, ErrUnsupportedHeaderType))
+
+	_, _, err = c.RestoreRevertIndexedBlock(&RevertIndexedBlockPayload{HeaderType: "HeaderV3"})
+	require.True(t, errors.Is(err, ErrUnsupportedHeaderType))
+}
+
+func TestRestore_RoundTrips(t *testing.T) {
+	t.Parallel()
+
+	roundsInfo := []*indexer.RoundInfo{{Index: 1, SignersIndexes: []uint64{2}, BlockWasProposed: true, ShardId: 3, Epoch: 4, Timestamp: time.Duration(5)}}
+	require.Equal(t, roundsInfo, RestoreRoundsInfo(ConvertRoundsInfo(roundsInfo)))
+
+	pubKeys := map[uint32][][]byte{0: {[]byte("a")}, 1: {[]byte("b"), []byte("c")}}
+	restoredPubKeys, epoch := RestoreValidatorsPubKeys(ConvertValidatorsPubKeys(pubKeys, 7))
+	require.Equal(t, pubKeys, restoredPubKeys)
+	require.Equal(t, uint32(7), epoch)
+
+	ratings := []*indexer.ValidatorRatingInfo{{PublicKey: "a", Rating: 50.5}}
+	indexID, restoredRatings := RestoreValidatorsRating(ConvertValidatorsRating("0_1", ratings))
+	require.Equal(t, "0_1", indexID)
+	require.Equal(t, ratings, restoredRatings)
+}
+
+func TestRestoreAccounts(t *testing.T) {
+	t.Parallel()
+
+	timestamp, accounts, err := RestoreAccounts(&AccountsPayload{
+		BlockTimestamp: 10,
+		Accounts:       []*Account{{Address: []byte("alice"), Nonce: 2, Balance: "42"}},
+	})
+	require.Nil(t, err)
+	require.Equal(t, uint64(10), timestamp)
+	require.Len(t, accounts, 1)
+	require.Equal(t, []byte("alice"), accounts[0].AddressBytes())
+	require.Equal(t, uint64(2), accounts[0].GetNonce())
+	require.Equal(t, big.NewInt(42), accounts[0].GetBalance())
+	value, err := accounts[0].RetrieveValueFromDataTrieTracker([]byte("key"))
+	require.Nil(t, value)
+	require.Equal(t, ErrDataTrieNotAvailable, err)
+
+	_, _, err = RestoreAccounts(&AccountsPayload{Accounts: []*Account{{Balance: "not a number"}}})
+	require.True(t, errors.Is(err, ErrInvalidBalance))
+}
+
+func TestAlteredAccounts_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	accounts := []data.UserAccountHandler{
+		&restoredAccount{address: []byte("alice"), nonce: 2, balance: big.NewInt(42)},
+		nil,
+		&restoredAccount{address: []byte("bob"), nonce: 0, balance: big.NewInt(0)},
+	}
+
+	converted := ConvertAlteredAccounts(accounts)
+	require.Equal(t, []*Account{
+		{Address: []byte("alice"), Nonce: 2, Balance: "42"},
+		{Address: []byte("bob"), Nonce: 0, Balance: "0"},
+	}, converted)
+
+	restored, err := RestoreAlteredAccounts(converted)
+	require.Nil(t, err)
+	require.Equal(t, []data.UserAccountHandler{accounts[0], accounts[2]}, restored)
+}
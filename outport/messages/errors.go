@@ -0,0 +1,18 @@
+package messages
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrUnsupportedHeaderType signals that the provided header type is not supported
+var ErrUnsupportedHeaderType = errors.New("unsupported header type")
+
+// ErrUnknownOperation signals that an unknown operation has been provided
+var ErrUnknownOperation = errors.New("unknown operation")
+
+// ErrInvalidBalance signals that an account balance could not be parsed
+var ErrInvalidBalance = errors.New("invalid balance")
+
+// ErrDataTrieNotAvailable signals that the data trie of a restored account is not available
+var ErrDataTrieNotAvailable = errors.New("data trie not available")
@@ -0,0 +1,5327 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: outport.proto
+
+// The node acts as a gRPC client: it opens a single bidirectional stream towards the indexer (server) and sends one
+// OutportMessage for each call of the outport driver. The indexer must answer each message with an Ack having the same
+// ID, in order. A non-empty Ack.Error, as well as a missing Ack (timeout), makes the node retry the same call, so the
+// block processing of the node waits for the indexer (back-pressure), unless the outport queue is enabled.
+//
+// Headers, bodies, transactions, receipts and logs are serialized with the internal marshalizer of the node (gogo
+// protobuf), using the message definitions of github.com/ElrondNetwork/elrond-go-core/data.
+//
+// The same OutportMessage records are written in the on-disk outport queues (see outport/queue), the ID being the
+// position of the record in the queue.
+
+package messages
+
+import (
+	bytes "bytes"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Operation identifies the outport driver call carried by an OutportMessage
+type Operation int32
+
+const (
+	// OperationUnknown is the zero value of Operation and is never sent
+	OperationUnknown Operation = 0
+	// OperationSaveBlock is sent on SaveBlock calls, the payload is a SaveBlockPayload
+	OperationSaveBlock Operation = 1
+	// OperationRevertIndexedBlock is sent on RevertIndexedBlock calls, the payload is a RevertIndexedBlockPayload
+	OperationRevertIndexedBlock Operation = 2
+	// OperationSaveRoundsInfo is sent on SaveRoundsInfo calls, the payload is a RoundsInfoPayload
+	OperationSaveRoundsInfo Operation = 3
+	// OperationSaveValidatorsPubKeys is sent on SaveValidatorsPubKeys calls, the payload is a ValidatorsPubKeysPayload
+	OperationSaveValidatorsPubKeys Operation = 4
+	// OperationSaveValidatorsRating is sent on SaveValidatorsRating calls, the payload is a ValidatorsRatingPayload
+	OperationSaveValidatorsRating Operation = 5
+	// OperationSaveAccounts is sent on SaveAccounts calls, the payload is an AccountsPayload
+	OperationSaveAccounts Operation = 6
+	// OperationFinalizedBlock is sent on FinalizedBlock calls, the payload is a FinalizedBlockPayload
+	OperationFinalizedBlock Operation = 7
+)
+
+var Operation_name = map[int32]string{
+	0: "OperationUnknown",
+	1: "OperationSaveBlock",
+	2: "OperationRevertIndexedBlock",
+	3: "OperationSaveRoundsInfo",
+	4: "OperationSaveValidatorsPubKeys",
+	5: "OperationSaveValidatorsRating",
+	6: "OperationSaveAccounts",
+	7: "OperationFinalizedBlock",
+}
+
+var Operation_value = map[string]int32{
+	"OperationUnknown":               0,
+	"OperationSaveBlock":             1,
+	"OperationRevertIndexedBlock":    2,
+	"OperationSaveRoundsInfo":        3,
+	"OperationSaveValidatorsPubKeys": 4,
+	"OperationSaveValidatorsRating":  5,
+	"OperationSaveAccounts":          6,
+	"OperationFinalizedBlock":        7,
+}
+
+func (Operation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b3c7f6cbbb5ff4ad, []int{0}
+}
+
+// OutportMessage is the envelope of every call sent towards the indexer
+type OutportMessage struct {
+	ID        uint64    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Operation Operation `protobuf:"varint,2,opt,name=Operation,proto3,enum=proto.Operation" json:"Operation,omitempty"`
+	// Payload holds one of the *Payload messages below, depending on the operation
+	Payload []byte `protobuf:"bytes,3,opt,name=Payload,proto3" json:"Payload,omitempty"`
+}
+
+func (m *OutportMessage) Reset()      { *m = OutportMessage{} }
+func (*OutportMessage) ProtoMessage() {}
+func (*OutportMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3c7f6cbbb5ff4ad, []int{0}
+}
+func (m *OutportMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutportMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OutportMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutportMessage.Merge(m, src)
+}
+func (m *OutportMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutportMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutportMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutportMessage proto.InternalMessageInfo
+
+func (m *OutportMessage) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *OutportMessage) GetOperation() Operation {
+	if m != nil {
+		return m.Operation
+	}
+	return OperationUnknown
+}
+
+func (m *OutportMessage) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// Ack is the acknowledgement sent back by the indexer for each OutportMessage. A non-empty error signals that the
+// indexer could not handle the message and the node should retry it
+type Ack struct {
+	ID    uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (m *Ack) Reset()      { *m = Ack{} }
+func (*Ack) ProtoMessage() {}
+func (*Ack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3c7f6cbbb5ff4ad, []int{1}
+}
+func (m *Ack) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Ack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Ack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ack.Merge(m, src)
+}
+func (m *Ack) XXX_Size() int {
+	return m.Size()
+}
+func (m *Ack) XXX_DiscardUnknown() {
+	xxx_messageInfo_Ack.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Ack proto.InternalMessageInfo
+
+func (m *Ack) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Ack) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// TransactionEntry holds a transaction (or receipt, or log) marshaled with the internal marshalizer of the node
+type TransactionEntry struct {
+	Hash    []byte `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Payload []byte `protobuf:"bytes,2,opt,name=Payload,proto3" json:"Payload,omitempty"`
+}
+
+func (m *TransactionEntry) Reset()      { *m = TransactionEntry{} }
+func (*TransactionEntry) ProtoMessage() {}
+func (*TransactionEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3c7f6cbbb5ff4ad, []int{2}
+}
+func (m *TransactionEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TransactionEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionEntry.Merge(m, src)
+}
+func (m *TransactionEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionEntry proto.InternalMessageInfo
+
+func (m *TransactionEntry) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *TransactionEntry) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// SaveBlockPayload is the payload of the SaveBlock operation
+type SaveBlockPayload struct {
+	HeaderHash             []byte              `protobuf:"bytes,1,opt,name=HeaderHash,proto3" json:"HeaderHash,omitempty"`
+	HeaderType             string              `protobuf:"bytes,2,opt,name=HeaderType,proto3" json:"HeaderType,omitempty"`
+	Header                 []byte              `protobuf:"bytes,3,opt,name=Header,proto3" json:"Header,omitempty"`
+	Body                   []byte              `protobuf:"bytes,4,opt,name=Body,proto3" json:"Body,omitempty"`
+	SignersIndexes         []uint64            `protobuf:"varint,5,rep,packed,name=SignersIndexes,proto3" json:"SignersIndexes,omitempty"`
+	NotarizedHeadersHashes []string            `protobuf:"bytes,6,rep,name=NotarizedHeadersHashes,proto3" json:"NotarizedHeadersHashes,omitempty"`
+	GasConsumed            uint64              `protobuf:"varint,7,opt,name=GasConsumed,proto3" json:"GasConsumed,omitempty"`
+	GasRefunded            uint64              `protobuf:"varint,8,opt,name=GasRefunded,proto3" json:"GasRefunded,omitempty"`
+	GasPenalized           uint64              `protobuf:"varint,9,opt,name=GasPenalized,proto3" json:"GasPenalized,omitempty"`
+	MaxGasPerBlock         uint64              `protobuf:"varint,10,opt,name=MaxGasPerBlock,proto3" json:"MaxGasPerBlock,omitempty"`
+	Transactions           []*TransactionEntry `protobuf:"bytes,11,rep,name=Transactions,proto3" json:"Transactions,omitempty"`
+	SmartContractResults   []*TransactionEntry `protobuf:"bytes,12,rep,name=SmartContractResults,proto3" json:"SmartContractResults,omitempty"`
+	Rewards                []*TransactionEntry `protobuf:"bytes,13,rep,name=Rewards,proto3" json:"Rewards,omitempty"`
+	InvalidTransactions    []*TransactionEntry `protobuf:"bytes,14,rep,name=InvalidTransactions,proto3" json:"InvalidTransactions,omitempty"`
+	Receipts               []*TransactionEntry `protobuf:"bytes,15,rep,name=Receipts,proto3" json:"Receipts,omitempty"`
+	Logs                   []*TransactionEntry `protobuf:"bytes,16,rep,name=Logs,proto3" json:"Logs,omitempty"`
+	// AlteredAccounts holds the accounts of the shard altered by the block, as they were right after the block was
+	// processed. They are served to the drivers loading the altered accounts while saving the block
+	AlteredAccounts []*Account `protobuf:"bytes,17,rep,name=AlteredAccounts,proto3" json:"AlteredAccounts,omitempty"`
+}
+
+func (m *SaveBlockPayload) Reset()      { *m = SaveBlockPayload{} }
+func (*SaveBlockPayload) ProtoMessage() {}
+func (*SaveBlockPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3c7f6cbbb5ff4ad, []int{3}
+}
+func (m *SaveBlockPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SaveBlockPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SaveBlockPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaveBlockPayload.Merge(m, src)
+}
+func (m *SaveBlockPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *SaveBlockPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaveBlockPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SaveBlockPayload proto.InternalMessageInfo
+
+func (m *SaveBlockPayload) GetHeaderHash() []byte {
+	if m != nil {
+		return m.HeaderHash
+	}
+	return nil
+}
+
+func (m *SaveBlockPayload) GetHeaderType() string {
+	if m != nil {
+		return m.HeaderType
+	}
+	return ""
+}
+
+func (m *SaveBlockPayload) GetHeader() []byte {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SaveBlockPayload) GetBody() []byte {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *SaveBlockPayload) GetSignersIndexes() []uint64 {
+	if m != nil {
+		return m.SignersIndexes
+	}
+	return nil
+}
+
+func (m *SaveBlockPayload) GetNotarizedHeadersHashes() []string {
+	if m != nil {
+		return m.NotarizedHeadersHashes
+	}
+	return nil
+}
+
+func (m *SaveBlockPayload) GetGasConsumed() uint64 {
+	if m != nil {
+		return m.GasConsumed
+	}
+	return 0
+}
+
+func (m *SaveBlockPayload) GetGasRefunded() uint64 {
+	if m != nil {
+		return m.GasRefunded
+	}
+	return 0
+}
+
+func (m *SaveBlockPayload) GetGasPenalized() uint64 {
+	if m != nil {
+		return m.GasPenalized
+	}
+	return 0
+}
+
+func (m *SaveBlockPayload) GetMaxGasPerBlock() uint64 {
+	if m != nil {
+		return m.MaxGasPerBlock
+	}
+	return 0
+}
+
+func (m *SaveBlockPayload) GetTransactions() []*TransactionEntry {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *SaveBlockPayload) GetSmartContractResults() []*TransactionEntry {
+	if m != nil {
+		return m.SmartContractResults
+	}
+	return nil
+}
+
+func (m *SaveBlockPayload) GetRewards() []*TransactionEntry {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *SaveBlockPayload) GetInvalidTransactions() []*TransactionEntry {
+	if m != nil {
+		return m.InvalidTransactions
+	}
+	return nil
+}
+
+func (m *SaveBlockPayload) GetReceipts() []*TransactionEntry {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+func (m *SaveBlockPayload) GetLogs() []*TransactionEntry {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *SaveBlockPayload) GetAlteredAccounts() []*Account {
+	if m != nil {
+		return m.AlteredAccounts
+	}
+	return nil
+}
+
+// RevertIndexedBlockPayload is the payload of the RevertIndexedBlock operation
+type RevertIndexedBlockPayload struct {
+	HeaderType string `protobuf:"bytes,1,opt,name=HeaderType,proto3" json:"HeaderType,omitempty"`
+	Header     []byte `protobuf:"bytes,2,opt,name=Header,proto3" json:"Header,omitempty"`
+	Body       []byte `protobuf:"bytes,3,opt,name=Body,proto3" json:"Body,omitempty"`
+}
+
+func (m *RevertIndexedBlockPayload) Reset()      { *m = RevertIndexedBlockPayload{} }
+func (*RevertIndexedBlockPayload) ProtoMessage() {}
+func (*RevertIndexedBlockPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3c7f6cbbb5ff4ad, []int{4}
+}
+func (m *RevertIndexedBlockPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevertIndexedBlockPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RevertIndexedBlockPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevertIndexedBlockPayload.Merge(m, src)
+}
+func (m *RevertIndexedBlockPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevertIndexedBlockPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevertIndexedBlockPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevertIndexedBlockPayload proto.InternalMessageInfo
+
+func (m *RevertIndexedBlockPayload) GetHeaderType() string {
+	if m != nil {
+		return m.HeaderType
+	}
+	return ""
+}
+
+func (m *RevertIndexedBlockPayload) GetHeader() []byte {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *RevertIndexedBlockPayload) GetBody() []byte {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+// RoundInfo holds the information about a round
+type RoundInfo struct {
+	Index            uint64   `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
+	SignersIndexes   []uint64 `protobuf:"varint,2,rep,packed,name=SignersIndexes,proto3" json:"SignersIndexes,omitempty"`
+	BlockWasProposed bool     `protobuf:"varint,3,opt,name=BlockWasProposed,proto3" json:"BlockWasProposed,omitempty"`
+	ShardID          uint32   `protobuf:"varint,4,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	Epoch            uint32   `protobuf:"varint,5,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Timestamp        uint64   `protobuf:"varint,6,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+}
+
+func (m *RoundInfo) Reset()      { *m = RoundInfo{} }
+func (*RoundInfo) ProtoMessage() {}
+func (*RoundInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3c7f6cbbb5ff4ad, []int{5}
+}
+func (m *RoundInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoundInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RoundInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoundInfo.Merge(m, src)
+}
+func (m *RoundInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoundInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoundInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoundInfo proto.InternalMessageInfo
+
+func (m *RoundInfo) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *RoundInfo) GetSignersIndexes() []uint64 {
+	if m != nil {
+		return m.SignersIndexes
+	}
+	return nil
+}
+
+func (m *RoundInfo) GetBlockWasProposed() bool {
+	if m != nil {
+		return m.BlockWasProposed
+	}
+	return false
+}
+
+func (m *RoundInfo) GetShardID() uint32 {
+	if m != nil {
+		return m.ShardID
+	}
+	return 0
+}
+
+func (m *RoundInfo) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *RoundInfo) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// RoundsInfoPayload is the payload of the SaveRoundsInfo operation
+type RoundsInfoPayload struct {
+	RoundsInfo []*RoundInfo `protobuf:"bytes,1,rep,name=RoundsInfo,proto3" json:"RoundsInfo,omitempty"`
+}
+
+func (m *RoundsInfoPayload) Reset()      { *m = RoundsInfoPayload{} }
+func (*RoundsInfoPayload) ProtoMessage() {}
+func (*RoundsInfoPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3c7f6cbbb5ff4ad, []int{6}
+}
+func (m *RoundsInfoPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoundsInfoPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RoundsInfoPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoundsInfoPayload.Merge(m, src)
+}
+func (m *RoundsInfoPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoundsInfoPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoundsInfoPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoundsInfoPayload proto.InternalMessageInfo
+
+func (m *RoundsInfoPayload) GetRoundsInfo() []*RoundInfo {
+	if m != nil {
+		return m.RoundsInfo
+	}
+	return nil
+}
+
+// ShardValidatorsPubKeys holds the public keys of the validators of a shard
+type ShardValidatorsPubKeys struct {
+	ShardID uint32   `protobuf:"varint,1,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	PubKeys [][]byte `protobuf:"bytes,2,rep,name=PubKeys,proto3" json:"PubKeys,omitempty"`
+}
+
+func (m *ShardValidatorsPubKeys) Reset()      { *m = ShardValidatorsPubKeys{} }
+func (*ShardValidatorsPubKeys) ProtoMessage() {}
+func (*ShardValidatorsPubKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3c7f6cbbb5ff4ad, []int{7}
+}
+func (m *ShardValidatorsPubKeys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardValidatorsPubKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShardValidatorsPubKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardValidatorsPubKeys.Merge(m, src)
+}
+func (m *ShardValidatorsPubKeys) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardValidatorsPubKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardValidatorsPubKeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardValidatorsPubKeys proto.InternalMessageInfo
+
+func (m *ShardValidatorsPubKeys) GetShardID() uint32 {
+	if m != nil {
+		return m.ShardID
+	}
+	return 0
+}
+
+func (m *ShardValidatorsPubKeys) GetPubKeys() [][]byte {
+	if m != nil {
+		return m.PubKeys
+	}
+	return nil
+}
+
+// ValidatorsPubKeysPayload is the payload of the SaveValidatorsPubKeys operation
+type ValidatorsPubKeysPayload struct {
+	Epoch  uint32                    `protobuf:"varint,1,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Shards []*ShardValidatorsPubKeys `protobuf:"bytes,2,rep,name=Shards,proto3" json:"Shards,omitempty"`
+}
+
+func (m *ValidatorsPubKeysPayload) Reset()      { *m = ValidatorsPubKeysPayload{} }
+func (*ValidatorsPubKeysPayload) ProtoMessage() {}
+func (*ValidatorsPubKeysPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3c7f6cbbb5ff4ad, []int{8}
+}
+func (m *ValidatorsPubKeysPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorsPubKeysPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ValidatorsPubKeysPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorsPubKeysPayload.Merge(m, src)
+}
+func (m *ValidatorsPubKeysPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorsPubKeysPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorsPubKeysPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorsPubKeysPayload proto.InternalMessageInfo
+
+func (m *ValidatorsPubKeysPayload) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorsPubKeysPayload) GetShards() []*ShardValidatorsPubKeys {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+// ValidatorRating holds the rating of a validator
+type ValidatorRating struct {
+	PublicKey string  `protobuf:"bytes,1,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	Rating    float32 `protobuf:"fixed32,2,opt,name=Rating,proto3" json:"Rating,omitempty"`
+}
+
+func (m *ValidatorRating) Reset()      { *m = ValidatorRating{} }
+func (*ValidatorRating) ProtoMessage() {}
+func (*ValidatorRating) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3c7f6cbbb5ff4ad, []int{9}
+}
+func (m *ValidatorRating) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRating) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ValidatorRating) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRating.Merge(m, src)
+}
+func (m *ValidatorRating) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRating) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRating.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRating proto.InternalMessageInfo
+
+func (m *ValidatorRating) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *ValidatorRating) GetRating() float32 {
+	if m != nil {
+		return m.Rating
+	}
+	return 0
+}
+
+// ValidatorsRatingPayload is the payload of the SaveValidatorsRating operation
+type ValidatorsRatingPayload struct {
+	IndexID string             `protobuf:"bytes,1,opt,name=IndexID,proto3" json:"IndexID,omitempty"`
+	Ratings []*ValidatorRating `protobuf:"bytes,2,rep,name=Ratings,proto3" json:"Ratings,omitempty"`
+}
+
+func (m *ValidatorsRatingPayload) Reset()      { *m = ValidatorsRatingPayload{} }
+func (*ValidatorsRatingPayload) ProtoMessage() {}
+func (*ValidatorsRatingPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3c7f6cbbb5ff4ad, []int{10}
+}
+func (m *ValidatorsRatingPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorsRatingPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ValidatorsRatingPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorsRatingPayload.Merge(m, src)
+}
+func (m *ValidatorsRatingPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorsRatingPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorsRatingPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorsRatingPayload proto.InternalMessageInfo
+
+func (m *ValidatorsRatingPayload) GetIndexID() string {
+	if m != nil {
+		return m.IndexID
+	}
+	return ""
+}
+
+func (m *ValidatorsRatingPayload) GetRatings() []*ValidatorRating {
+	if m != nil {
+		return m.Ratings
+	}
+	return nil
+}
+
+// Account holds the basic information about an account
+type Account struct {
+	Address []byte `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Nonce   uint64 `protobuf:"varint,2,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Balance string `protobuf:"bytes,3,opt,name=Balance,proto3" json:"Balance,omitempty"`
+}
+
+func (m *Account) Reset()      { *m = Account{} }
+func (*Account) ProtoMessage() {}
+func (*Account) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3c7f6cbbb5ff4ad, []int{11}
+}
+func (m *Account) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account.Merge(m, src)
+}
+func (m *Account) XXX_Size() int {
+	return m.Size()
+}
+func (m *Account) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account proto.InternalMessageInfo
+
+func (m *Account) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *Account) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *Account) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+// AccountsPayload is the payload of the SaveAccounts operation
+type AccountsPayload struct {
+	BlockTimestamp uint64     `protobuf:"varint,1,opt,name=BlockTimestamp,proto3" json:"BlockTimestamp,omitempty"`
+	Accounts       []*Account `protobuf:"bytes,2,rep,name=Accounts,proto3" json:"Accounts,omitempty"`
+}
+
+func (m *AccountsPayload) Reset()      { *m = AccountsPayload{} }
+func (*AccountsPayload) ProtoMessage() {}
+func (*AccountsPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3c7f6cbbb5ff4ad, []int{12}
+}
+func (m *AccountsPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountsPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountsPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountsPayload.Merge(m, src)
+}
+func (m *AccountsPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountsPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountsPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountsPayload proto.InternalMessageInfo
+
+func (m *AccountsPayload) GetBlockTimestamp() uint64 {
+	if m != nil {
+		return m.BlockTimestamp
+	}
+	return 0
+}
+
+func (m *AccountsPayload) GetAccounts() []*Account {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+// FinalizedBlockPayload is the payload of the FinalizedBlock operation
+type FinalizedBlockPayload struct {
+	HeaderHash []byte `protobuf:"bytes,1,opt,name=HeaderHash,proto3" json:"HeaderHash,omitempty"`
+}
+
+func (m *FinalizedBlockPayload) Reset()      { *m = FinalizedBlockPayload{} }
+func (*FinalizedBlockPayload) ProtoMessage() {}
+func (*FinalizedBlockPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3c7f6cbbb5ff4ad, []int{13}
+}
+func (m *FinalizedBlockPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalizedBlockPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FinalizedBlockPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizedBlockPayload.Merge(m, src)
+}
+func (m *FinalizedBlockPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalizedBlockPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizedBlockPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizedBlockPayload proto.InternalMessageInfo
+
+func (m *FinalizedBlockPayload) GetHeaderHash() []byte {
+	if m != nil {
+		return m.HeaderHash
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("proto.Operation", Operation_name, Operation_value)
+	proto.RegisterType((*OutportMessage)(nil), "proto.OutportMessage")
+	proto.RegisterType((*Ack)(nil), "proto.Ack")
+	proto.RegisterType((*TransactionEntry)(nil), "proto.TransactionEntry")
+	proto.RegisterType((*SaveBlockPayload)(nil), "proto.SaveBlockPayload")
+	proto.RegisterType((*RevertIndexedBlockPayload)(nil), "proto.RevertIndexedBlockPayload")
+	proto.RegisterType((*RoundInfo)(nil), "proto.RoundInfo")
+	proto.RegisterType((*RoundsInfoPayload)(nil), "proto.RoundsInfoPayload")
+	proto.RegisterType((*ShardValidatorsPubKeys)(nil), "proto.ShardValidatorsPubKeys")
+	proto.RegisterType((*ValidatorsPubKeysPayload)(nil), "proto.ValidatorsPubKeysPayload")
+	proto.RegisterType((*ValidatorRating)(nil), "proto.ValidatorRating")
+	proto.RegisterType((*ValidatorsRatingPayload)(nil), "proto.ValidatorsRatingPayload")
+	proto.RegisterType((*Account)(nil), "proto.Account")
+	proto.RegisterType((*AccountsPayload)(nil), "proto.AccountsPayload")
+	proto.RegisterType((*FinalizedBlockPayload)(nil), "proto.FinalizedBlockPayload")
+}
+
+func init() { proto.RegisterFile("outport.proto", fileDescriptor_b3c7f6cbbb5ff4ad) }
+
+var fileDescriptor_b3c7f6cbbb5ff4ad = []byte{
+	// 1036 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0xd6, 0x4a, 0xb2, 0x64, 0x8d, 0x15, 0x99, 0xd9, 0xfa, 0x67, 0x93, 0x34, 0xac, 0xca, 0x43,
+	0x20, 0x38, 0xa8, 0xe3, 0x38, 0xe8, 0x0f, 0xd0, 0x1e, 0x6a, 0xc7, 0xae, 0x23, 0x38, 0x3f, 0xc6,
+	0xca, 0x6d, 0x81, 0xde, 0xd6, 0xe4, 0x5a, 0x56, 0x2d, 0x71, 0x85, 0x5d, 0xca, 0x89, 0x7a, 0xea,
+	0x23, 0x14, 0x7d, 0x8a, 0xbe, 0x47, 0x2f, 0x3d, 0xfa, 0xe8, 0x63, 0x2d, 0x5f, 0x7a, 0xcc, 0xa9,
+	0xe7, 0x82, 0xbb, 0x4b, 0x4a, 0x94, 0x15, 0x01, 0x3d, 0x49, 0xf3, 0xed, 0x37, 0xb3, 0xdf, 0x0c,
+	0x67, 0x86, 0x84, 0x3b, 0x62, 0x10, 0xf5, 0x85, 0x8c, 0x36, 0xfb, 0x52, 0x44, 0x02, 0x2f, 0xe8,
+	0x9f, 0xfb, 0x9f, 0xb5, 0x3b, 0xd1, 0xd9, 0xe0, 0x64, 0xd3, 0x17, 0xbd, 0x27, 0x6d, 0xd1, 0x16,
+	0x4f, 0x34, 0x7c, 0x32, 0x38, 0xd5, 0x96, 0x36, 0xf4, 0x3f, 0xe3, 0xe5, 0xfd, 0x0c, 0xb5, 0x37,
+	0x26, 0xcc, 0x2b, 0xae, 0x14, 0x6b, 0x73, 0x5c, 0x83, 0x7c, 0x73, 0x8f, 0xa0, 0x3a, 0x6a, 0x14,
+	0x69, 0xbe, 0xb9, 0x87, 0x37, 0xa1, 0xf2, 0xa6, 0xcf, 0x25, 0x8b, 0x3a, 0x22, 0x24, 0xf9, 0x3a,
+	0x6a, 0xd4, 0xb6, 0x1d, 0xe3, 0xbc, 0x99, 0xe2, 0x74, 0x4c, 0xc1, 0x04, 0xca, 0x47, 0x6c, 0xd8,
+	0x15, 0x2c, 0x20, 0x85, 0x3a, 0x6a, 0x54, 0x69, 0x62, 0x7a, 0x8f, 0xa1, 0xb0, 0xe3, 0x9f, 0xdf,
+	0xba, 0x60, 0x05, 0x16, 0xf6, 0xa5, 0x14, 0x52, 0x07, 0xaf, 0x50, 0x63, 0x78, 0xdf, 0x82, 0x73,
+	0x2c, 0x59, 0xa8, 0x98, 0x1f, 0x47, 0xdd, 0x0f, 0x23, 0x39, 0xc4, 0x18, 0x8a, 0x2f, 0x98, 0x3a,
+	0xd3, 0xbe, 0x55, 0xaa, 0xff, 0x4f, 0x5e, 0x97, 0xcf, 0x5e, 0xf7, 0x7b, 0x09, 0x9c, 0x16, 0xbb,
+	0xe0, 0xbb, 0x5d, 0xe1, 0x9f, 0x5b, 0x10, 0xbb, 0x00, 0x2f, 0x38, 0x0b, 0xb8, 0x9c, 0x08, 0x34,
+	0x81, 0x8c, 0xcf, 0x8f, 0x87, 0x7d, 0x6e, 0x15, 0x4d, 0x20, 0x78, 0x0d, 0x4a, 0xc6, 0xb2, 0xc9,
+	0x59, 0x2b, 0x96, 0xb6, 0x2b, 0x82, 0x21, 0x29, 0x1a, 0x69, 0xf1, 0x7f, 0xfc, 0x08, 0x6a, 0xad,
+	0x4e, 0x3b, 0xe4, 0x52, 0x35, 0xc3, 0x80, 0xbf, 0xe3, 0x8a, 0x2c, 0xd4, 0x0b, 0x8d, 0x22, 0x9d,
+	0x42, 0xf1, 0x17, 0xb0, 0xf6, 0x5a, 0x44, 0x4c, 0x76, 0x7e, 0xe1, 0x81, 0x09, 0xa7, 0x62, 0x2d,
+	0x5c, 0x91, 0x52, 0xbd, 0xd0, 0xa8, 0xd0, 0x0f, 0x9c, 0xe2, 0x3a, 0x2c, 0x1d, 0x30, 0xf5, 0x5c,
+	0x84, 0x6a, 0xd0, 0xe3, 0x01, 0x29, 0xeb, 0x8a, 0x4e, 0x42, 0x96, 0x41, 0xf9, 0xe9, 0x20, 0x0c,
+	0x78, 0x40, 0x16, 0x53, 0x46, 0x02, 0x61, 0x0f, 0xaa, 0x07, 0x4c, 0x1d, 0xf1, 0x90, 0x75, 0xe3,
+	0x0b, 0x48, 0x45, 0x53, 0x32, 0x58, 0x9c, 0xc7, 0x2b, 0xf6, 0x4e, 0x43, 0x52, 0x17, 0x93, 0x80,
+	0x66, 0x4d, 0xa1, 0xf8, 0x6b, 0xa8, 0x4e, 0x3c, 0x32, 0x45, 0x96, 0xea, 0x85, 0xc6, 0xd2, 0xf6,
+	0xba, 0x6d, 0x96, 0xe9, 0xa7, 0x49, 0x33, 0x64, 0x7c, 0x08, 0x2b, 0xad, 0x1e, 0x93, 0xd1, 0x73,
+	0x11, 0x46, 0x92, 0xf9, 0x11, 0xe5, 0x6a, 0xd0, 0x8d, 0x14, 0xa9, 0xce, 0x0f, 0x32, 0xd3, 0x09,
+	0x3f, 0x85, 0x32, 0xe5, 0x6f, 0x99, 0x0c, 0x14, 0xb9, 0x33, 0xdf, 0x3f, 0xe1, 0xe1, 0x26, 0x7c,
+	0xd4, 0x0c, 0x2f, 0x58, 0xb7, 0x13, 0x64, 0x72, 0xa8, 0xcd, 0x77, 0x9f, 0xe5, 0x83, 0x9f, 0xc1,
+	0x22, 0xe5, 0x3e, 0xef, 0xf4, 0x23, 0x45, 0x96, 0xe7, 0xfb, 0xa7, 0x44, 0xfc, 0x18, 0x8a, 0x2f,
+	0x45, 0x5b, 0x11, 0x67, 0xbe, 0x83, 0x26, 0xe1, 0xaf, 0x60, 0x79, 0xa7, 0x1b, 0x71, 0xc9, 0x83,
+	0x1d, 0xdf, 0x17, 0x83, 0x30, 0x52, 0xe4, 0xae, 0xf6, 0xab, 0x59, 0x3f, 0x0b, 0xd3, 0x69, 0x9a,
+	0xd7, 0x86, 0x7b, 0x94, 0x5f, 0x70, 0x19, 0x99, 0xe6, 0x0b, 0x66, 0x0f, 0x87, 0x6e, 0x7e, 0x34,
+	0xa7, 0xf9, 0xf3, 0x33, 0x9b, 0xbf, 0x30, 0x6e, 0x7e, 0xef, 0x4f, 0x04, 0x15, 0x2a, 0x06, 0x61,
+	0xd0, 0x0c, 0x4f, 0x45, 0x3c, 0xe3, 0xfa, 0x42, 0x3b, 0xf6, 0xc6, 0x98, 0x31, 0x20, 0xf9, 0x99,
+	0x03, 0xb2, 0x01, 0x8e, 0xd6, 0xf9, 0x23, 0x53, 0x47, 0x52, 0xf4, 0x85, 0xe2, 0x66, 0xb7, 0x2c,
+	0xd2, 0x5b, 0x78, 0xbc, 0x0f, 0x5a, 0x67, 0x4c, 0x06, 0xcd, 0x3d, 0x3d, 0x8b, 0x77, 0x68, 0x62,
+	0xea, 0x3d, 0xd3, 0x17, 0xfe, 0x19, 0x59, 0xd0, 0xb8, 0x31, 0xf0, 0xc7, 0x50, 0x39, 0xee, 0xf4,
+	0xb8, 0x8a, 0x58, 0xaf, 0x4f, 0x4a, 0x5a, 0xdd, 0x18, 0xf0, 0xf6, 0xe1, 0xae, 0x4e, 0x42, 0xc5,
+	0x59, 0x24, 0x65, 0xda, 0x02, 0x18, 0x83, 0x04, 0xe9, 0xc2, 0x27, 0x2b, 0x31, 0x4d, 0x99, 0x4e,
+	0x70, 0xbc, 0x97, 0xb0, 0xa6, 0x55, 0xfc, 0x10, 0xf7, 0x0a, 0x8b, 0x84, 0x54, 0x47, 0x83, 0x93,
+	0x43, 0x3e, 0x54, 0x93, 0x72, 0x51, 0x56, 0x6e, 0xbc, 0xd8, 0x0c, 0x49, 0x57, 0xa5, 0x4a, 0x13,
+	0xd3, 0x6b, 0x03, 0xb9, 0x15, 0x28, 0xd1, 0x96, 0x26, 0x89, 0x26, 0x93, 0xfc, 0x1c, 0x4a, 0x3a,
+	0xac, 0x09, 0xb5, 0xb4, 0xfd, 0xd0, 0xaa, 0x9d, 0x2d, 0x8a, 0x5a, 0xb2, 0x77, 0x00, 0xcb, 0xe9,
+	0x21, 0x65, 0x51, 0x27, 0x6c, 0xc7, 0xe5, 0x3a, 0x1a, 0x9c, 0x74, 0x3b, 0xfe, 0x21, 0x1f, 0xda,
+	0x0e, 0x19, 0x03, 0x71, 0x83, 0x18, 0x9e, 0x6e, 0x90, 0x3c, 0xb5, 0x96, 0xc7, 0x61, 0x7d, 0x7c,
+	0x8b, 0xc1, 0x12, 0xc1, 0x04, 0xca, 0xfa, 0x31, 0xdb, 0x02, 0x54, 0x68, 0x62, 0xe2, 0x2d, 0x28,
+	0x1b, 0x6a, 0xa2, 0x7a, 0xcd, 0xaa, 0x9e, 0xd2, 0x44, 0x13, 0x9a, 0xd7, 0x82, 0xb2, 0x6d, 0xf4,
+	0x38, 0xec, 0x4e, 0x10, 0x48, 0xae, 0x94, 0x5d, 0xf2, 0x89, 0x19, 0x57, 0xe8, 0xb5, 0x08, 0x7d,
+	0xb3, 0xdc, 0x8b, 0xd4, 0x18, 0x31, 0x7f, 0x97, 0x75, 0x59, 0x8c, 0x17, 0x8c, 0x0c, 0x6b, 0x7a,
+	0x1c, 0x96, 0x93, 0xe9, 0x49, 0x34, 0x3f, 0x82, 0x9a, 0xee, 0xbb, 0x71, 0xe3, 0x98, 0xb6, 0x9e,
+	0x42, 0xf1, 0x06, 0x2c, 0xa6, 0xf3, 0x99, 0x9f, 0x39, 0x9f, 0xe9, 0xb9, 0xf7, 0x25, 0xac, 0x7e,
+	0xd7, 0xb1, 0x1b, 0xf7, 0xff, 0xbc, 0xb1, 0x36, 0xfe, 0x45, 0x13, 0x2f, 0x68, 0xbc, 0x02, 0x4e,
+	0x6a, 0x7c, 0x1f, 0x9e, 0x87, 0xe2, 0x6d, 0xe8, 0xe4, 0xf0, 0x1a, 0xe0, 0x14, 0x4d, 0x5f, 0x89,
+	0x0e, 0xc2, 0x9f, 0xc0, 0x83, 0x14, 0xbf, 0xbd, 0x16, 0x9c, 0x3c, 0x7e, 0x00, 0xeb, 0x19, 0xc7,
+	0x71, 0x4f, 0x3b, 0x05, 0xec, 0x81, 0x9b, 0x39, 0xbc, 0xd5, 0x48, 0x4e, 0x11, 0x7f, 0x0a, 0x0f,
+	0x3f, 0xc0, 0x31, 0x0f, 0xcd, 0x59, 0xc0, 0xf7, 0x60, 0x35, 0x43, 0x49, 0x4a, 0xe2, 0x94, 0x32,
+	0xd7, 0x67, 0xab, 0xe3, 0x94, 0xb7, 0xbf, 0x81, 0xb2, 0xfd, 0x74, 0xc1, 0x4f, 0xa1, 0xd4, 0x8a,
+	0x24, 0x67, 0x3d, 0xbc, 0x9a, 0x7c, 0x9a, 0x64, 0x3e, 0x6a, 0xee, 0x43, 0x5a, 0xf7, 0x73, 0x2f,
+	0xd7, 0x40, 0x5b, 0x68, 0x77, 0xf7, 0xf2, 0xda, 0xcd, 0x5d, 0x5d, 0xbb, 0xb9, 0xf7, 0xd7, 0x2e,
+	0xfa, 0x75, 0xe4, 0xa2, 0x3f, 0x46, 0x2e, 0xfa, 0x6b, 0xe4, 0xa2, 0xcb, 0x91, 0x8b, 0xae, 0x46,
+	0x2e, 0xfa, 0x7b, 0xe4, 0xa2, 0x7f, 0x46, 0x6e, 0xee, 0xfd, 0xc8, 0x45, 0xbf, 0xdd, 0xb8, 0xb9,
+	0xcb, 0x1b, 0x37, 0x77, 0x75, 0xe3, 0xe6, 0x7e, 0x5a, 0xec, 0x99, 0xa0, 0xea, 0xa4, 0xa4, 0x83,
+	0x3e, 0xfb, 0x6f, 0x00, 0x6e, 0xf8, 0x3e, 0x93, 0x8a, 0x09, 0x00, 0x00,
+}
+
+func (x Operation) String() string {
+	s, ok := Operation_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *OutportMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OutportMessage)
+	if !ok {
+		that2, ok := that.(OutportMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Operation != that1.Operation {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	return true
+}
+func (this *Ack) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Ack)
+	if !ok {
+		that2, ok := that.(Ack)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *TransactionEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransactionEntry)
+	if !ok {
+		that2, ok := that.(TransactionEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	return true
+}
+func (this *SaveBlockPayload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SaveBlockPayload)
+	if !ok {
+		that2, ok := that.(SaveBlockPayload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.HeaderHash, that1.HeaderHash) {
+		return false
+	}
+	if this.HeaderType != that1.HeaderType {
+		return false
+	}
+	if !bytes.Equal(this.Header, that1.Header) {
+		return false
+	}
+	if !bytes.Equal(this.Body, that1.Body) {
+		return false
+	}
+	if len(this.SignersIndexes) != len(that1.SignersIndexes) {
+		return false
+	}
+	for i := range this.SignersIndexes {
+		if this.SignersIndexes[i] != that1.SignersIndexes[i] {
+			return false
+		}
+	}
+	if len(this.NotarizedHeadersHashes) != len(that1.NotarizedHeadersHashes) {
+		return false
+	}
+	for i := range this.NotarizedHeadersHashes {
+		if this.NotarizedHeadersHashes[i] != that1.NotarizedHeadersHashes[i] {
+			return false
+		}
+	}
+	if this.GasConsumed != that1.GasConsumed {
+		return false
+	}
+	if this.GasRefunded != that1.GasRefunded {
+		return false
+	}
+	if this.GasPenalized != that1.GasPenalized {
+		return false
+	}
+	if this.MaxGasPerBlock != that1.MaxGasPerBlock {
+		return false
+	}
+	if len(this.Transactions) != len(that1.Transactions) {
+		return false
+	}
+	for i := range this.Transactions {
+		if !this.Transactions[i].Equal(that1.Transactions[i]) {
+			return false
+		}
+	}
+	if len(this.SmartContractResults) != len(that1.SmartContractResults) {
+		return false
+	}
+	for i := range this.SmartContractResults {
+		if !this.SmartContractResults[i].Equal(that1.SmartContractResults[i]) {
+			return false
+		}
+	}
+	if len(this.Rewards) != len(that1.Rewards) {
+		return false
+	}
+	for i := range this.Rewards {
+		if !this.Rewards[i].Equal(that1.Rewards[i]) {
+			return false
+		}
+	}
+	if len(this.InvalidTransactions) != len(that1.InvalidTransactions) {
+		return false
+	}
+	for i := range this.InvalidTransactions {
+		if !this.InvalidTransactions[i].Equal(that1.InvalidTransactions[i]) {
+			return false
+		}
+	}
+	if len(this.Receipts) != len(that1.Receipts) {
+		return false
+	}
+	for i := range this.Receipts {
+		if !this.Receipts[i].Equal(that1.Receipts[i]) {
+			return false
+		}
+	}
+	if len(this.Logs) != len(that1.Logs) {
+		return false
+	}
+	for i := range this.Logs {
+		if !this.Logs[i].Equal(that1.Logs[i]) {
+			return false
+		}
+	}
+	if len(this.AlteredAccounts) != len(that1.AlteredAccounts) {
+		return false
+	}
+	for i := range this.AlteredAccounts {
+		if !this.AlteredAccounts[i].Equal(that1.AlteredAccounts[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RevertIndexedBlockPayload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RevertIndexedBlockPayload)
+	if !ok {
+		that2, ok := that.(RevertIndexedBlockPayload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HeaderType != that1.HeaderType {
+		return false
+	}
+	if !bytes.Equal(this.Header, that1.Header) {
+		return false
+	}
+	if !bytes.Equal(this.Body, that1.Body) {
+		return false
+	}
+	return true
+}
+func (this *RoundInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RoundInfo)
+	if !ok {
+		that2, ok := that.(RoundInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if len(this.SignersIndexes) != len(that1.SignersIndexes) {
+		return false
+	}
+	for i := range this.SignersIndexes {
+		if this.SignersIndexes[i] != that1.SignersIndexes[i] {
+			return false
+		}
+	}
+	if this.BlockWasProposed != that1.BlockWasProposed {
+		return false
+	}
+	if this.ShardID != that1.ShardID {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	return true
+}
+func (this *RoundsInfoPayload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RoundsInfoPayload)
+	if !ok {
+		that2, ok := that.(RoundsInfoPayload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.RoundsInfo) != len(that1.RoundsInfo) {
+		return false
+	}
+	for i := range this.RoundsInfo {
+		if !this.RoundsInfo[i].Equal(that1.RoundsInfo[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ShardValidatorsPubKeys) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShardValidatorsPubKeys)
+	if !ok {
+		that2, ok := that.(ShardValidatorsPubKeys)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardID != that1.ShardID {
+		return false
+	}
+	if len(this.PubKeys) != len(that1.PubKeys) {
+		return false
+	}
+	for i := range this.PubKeys {
+		if !bytes.Equal(this.PubKeys[i], that1.PubKeys[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ValidatorsPubKeysPayload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorsPubKeysPayload)
+	if !ok {
+		that2, ok := that.(ValidatorsPubKeysPayload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if len(this.Shards) != len(that1.Shards) {
+		return false
+	}
+	for i := range this.Shards {
+		if !this.Shards[i].Equal(that1.Shards[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ValidatorRating) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorRating)
+	if !ok {
+		that2, ok := that.(ValidatorRating)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PublicKey != that1.PublicKey {
+		return false
+	}
+	if this.Rating != that1.Rating {
+		return false
+	}
+	return true
+}
+func (this *ValidatorsRatingPayload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorsRatingPayload)
+	if !ok {
+		that2, ok := that.(ValidatorsRatingPayload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.IndexID != that1.IndexID {
+		return false
+	}
+	if len(this.Ratings) != len(that1.Ratings) {
+		return false
+	}
+	for i := range this.Ratings {
+		if !this.Ratings[i].Equal(that1.Ratings[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Account) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Account)
+	if !ok {
+		that2, ok := that.(Account)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Balance != that1.Balance {
+		return false
+	}
+	return true
+}
+func (this *AccountsPayload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountsPayload)
+	if !ok {
+		that2, ok := that.(AccountsPayload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BlockTimestamp != that1.BlockTimestamp {
+		return false
+	}
+	if len(this.Accounts) != len(that1.Accounts) {
+		return false
+	}
+	for i := range this.Accounts {
+		if !this.Accounts[i].Equal(that1.Accounts[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *FinalizedBlockPayload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FinalizedBlockPayload)
+	if !ok {
+		that2, ok := that.(FinalizedBlockPayload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.HeaderHash, that1.HeaderHash) {
+		return false
+	}
+	return true
+}
+func (this *OutportMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.OutportMessage{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "Operation: "+fmt.Sprintf("%#v", this.Operation)+",\n")
+	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Ack) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.Ack{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TransactionEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.TransactionEntry{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SaveBlockPayload) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 21)
+	s = append(s, "&messages.SaveBlockPayload{")
+	s = append(s, "HeaderHash: "+fmt.Sprintf("%#v", this.HeaderHash)+",\n")
+	s = append(s, "HeaderType: "+fmt.Sprintf("%#v", this.HeaderType)+",\n")
+	s = append(s, "Header: "+fmt.Sprintf("%#v", this.Header)+",\n")
+	s = append(s, "Body: "+fmt.Sprintf("%#v", this.Body)+",\n")
+	s = append(s, "SignersIndexes: "+fmt.Sprintf("%#v", this.SignersIndexes)+",\n")
+	s = append(s, "NotarizedHeadersHashes: "+fmt.Sprintf("%#v", this.NotarizedHeadersHashes)+",\n")
+	s = append(s, "GasConsumed: "+fmt.Sprintf("%#v", this.GasConsumed)+",\n")
+	s = append(s, "GasRefunded: "+fmt.Sprintf("%#v", this.GasRefunded)+",\n")
+	s = append(s, "GasPenalized: "+fmt.Sprintf("%#v", this.GasPenalized)+",\n")
+	s = append(s, "MaxGasPerBlock: "+fmt.Sprintf("%#v", this.MaxGasPerBlock)+",\n")
+	if this.Transactions != nil {
+		s = append(s, "Transactions: "+fmt.Sprintf("%#v", this.Transactions)+",\n")
+	}
+	if this.SmartContractResults != nil {
+		s = append(s, "SmartContractResults: "+fmt.Sprintf("%#v", this.SmartContractResults)+",\n")
+	}
+	if this.Rewards != nil {
+		s = append(s, "Rewards: "+fmt.Sprintf("%#v", this.Rewards)+",\n")
+	}
+	if this.InvalidTransactions != nil {
+		s = append(s, "InvalidTransactions: "+fmt.Sprintf("%#v", this.InvalidTransactions)+",\n")
+	}
+	if this.Receipts != nil {
+		s = append(s, "Receipts: "+fmt.Sprintf("%#v", this.Receipts)+",\n")
+	}
+	if this.Logs != nil {
+		s = append(s, "Logs: "+fmt.Sprintf("%#v", this.Logs)+",\n")
+	}
+	if this.AlteredAccounts != nil {
+		s = append(s, "AlteredAccounts: "+fmt.Sprintf("%#v", this.AlteredAccounts)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RevertIndexedBlockPayload) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.RevertIndexedBlockPayload{")
+	s = append(s, "HeaderType: "+fmt.Sprintf("%#v", this.HeaderType)+",\n")
+	s = append(s, "Header: "+fmt.Sprintf("%#v", this.Header)+",\n")
+	s = append(s, "Body: "+fmt.Sprintf("%#v", this.Body)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RoundInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&messages.RoundInfo{")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "SignersIndexes: "+fmt.Sprintf("%#v", this.SignersIndexes)+",\n")
+	s = append(s, "BlockWasProposed: "+fmt.Sprintf("%#v", this.BlockWasProposed)+",\n")
+	s = append(s, "ShardID: "+fmt.Sprintf("%#v", this.ShardID)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RoundsInfoPayload) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.RoundsInfoPayload{")
+	if this.RoundsInfo != nil {
+		s = append(s, "RoundsInfo: "+fmt.Sprintf("%#v", this.RoundsInfo)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ShardValidatorsPubKeys) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.ShardValidatorsPubKeys{")
+	s = append(s, "ShardID: "+fmt.Sprintf("%#v", this.ShardID)+",\n")
+	s = append(s, "PubKeys: "+fmt.Sprintf("%#v", this.PubKeys)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ValidatorsPubKeysPayload) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.ValidatorsPubKeysPayload{")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	if this.Shards != nil {
+		s = append(s, "Shards: "+fmt.Sprintf("%#v", this.Shards)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ValidatorRating) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.ValidatorRating{")
+	s = append(s, "PublicKey: "+fmt.Sprintf("%#v", this.PublicKey)+",\n")
+	s = append(s, "Rating: "+fmt.Sprintf("%#v", this.Rating)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ValidatorsRatingPayload) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.ValidatorsRatingPayload{")
+	s = append(s, "IndexID: "+fmt.Sprintf("%#v", this.IndexID)+",\n")
+	if this.Ratings != nil {
+		s = append(s, "Ratings: "+fmt.Sprintf("%#v", this.Ratings)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Account) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.Account{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Balance: "+fmt.Sprintf("%#v", this.Balance)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountsPayload) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.AccountsPayload{")
+	s = append(s, "BlockTimestamp: "+fmt.Sprintf("%#v", this.BlockTimestamp)+",\n")
+	if this.Accounts != nil {
+		s = append(s, "Accounts: "+fmt.Sprintf("%#v", this.Accounts)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *FinalizedBlockPayload) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.FinalizedBlockPayload{")
+	s = append(s, "HeaderHash: "+fmt.Sprintf("%#v", this.HeaderHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringOutport(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *OutportMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutportMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutportMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintOutport(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Operation != 0 {
+		i = encodeVarintOutport(dAtA, i, uint64(m.Operation))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintOutport(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Ack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Ack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Ack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintOutport(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintOutport(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintOutport(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintOutport(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SaveBlockPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SaveBlockPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SaveBlockPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AlteredAccounts) > 0 {
+		for iNdEx := len(m.AlteredAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AlteredAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOutport(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOutport(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOutport(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.InvalidTransactions) > 0 {
+		for iNdEx := len(m.InvalidTransactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InvalidTransactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOutport(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOutport(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.SmartContractResults) > 0 {
+		for iNdEx := len(m.SmartContractResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SmartContractResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOutport(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOutport(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.MaxGasPerBlock != 0 {
+		i = encodeVarintOutport(dAtA, i, uint64(m.MaxGasPerBlock))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.GasPenalized != 0 {
+		i = encodeVarintOutport(dAtA, i, uint64(m.GasPenalized))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.GasRefunded != 0 {
+		i = encodeVarintOutport(dAtA, i, uint64(m.GasRefunded))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GasConsumed != 0 {
+		i = encodeVarintOutport(dAtA, i, uint64(m.GasConsumed))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.NotarizedHeadersHashes) > 0 {
+		for iNdEx := len(m.NotarizedHeadersHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NotarizedHeadersHashes[iNdEx])
+			copy(dAtA[i:], m.NotarizedHeadersHashes[iNdEx])
+			i = encodeVarintOutport(dAtA, i, uint64(len(m.NotarizedHeadersHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SignersIndexes) > 0 {
+		dAtA2 := make([]byte, len(m.SignersIndexes)*10)
+		var j1 int
+		for _, num := range m.SignersIndexes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintOutport(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintOutport(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Header) > 0 {
+		i -= len(m.Header)
+		copy(dAtA[i:], m.Header)
+		i = encodeVarintOutport(dAtA, i, uint64(len(m.Header)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.HeaderType) > 0 {
+		i -= len(m.HeaderType)
+		copy(dAtA[i:], m.HeaderType)
+		i = encodeVarintOutport(dAtA, i, uint64(len(m.HeaderType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HeaderHash) > 0 {
+		i -= len(m.HeaderHash)
+		copy(dAtA[i:], m.HeaderHash)
+		i = encodeVarintOutport(dAtA, i, uint64(len(m.HeaderHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevertIndexedBlockPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevertIndexedBlockPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevertIndexedBlockPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintOutport(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Header) > 0 {
+		i -= len(m.Header)
+		copy(dAtA[i:], m.Header)
+		i = encodeVarintOutport(dAtA, i, uint64(len(m.Header)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HeaderType) > 0 {
+		i -= len(m.HeaderType)
+		copy(dAtA[i:], m.HeaderType)
+		i = encodeVarintOutport(dAtA, i, uint64(len(m.HeaderType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoundInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoundInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoundInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintOutport(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintOutport(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ShardID != 0 {
+		i = encodeVarintOutport(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockWasProposed {
+		i--
+		if m.BlockWasProposed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SignersIndexes) > 0 {
+		dAtA4 := make([]byte, len(m.SignersIndexes)*10)
+		var j3 int
+		for _, num := range m.SignersIndexes {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintOutport(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintOutport(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoundsInfoPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoundsInfoPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoundsInfoPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RoundsInfo) > 0 {
+		for iNdEx := len(m.RoundsInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoundsInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOutport(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShardValidatorsPubKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardValidatorsPubKeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardValidatorsPubKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PubKeys[iNdEx])
+			copy(dAtA[i:], m.PubKeys[iNdEx])
+			i = encodeVarintOutport(dAtA, i, uint64(len(m.PubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ShardID != 0 {
+		i = encodeVarintOutport(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorsPubKeysPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorsPubKeysPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorsPubKeysPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shards) > 0 {
+		for iNdEx := len(m.Shards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOutport(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintOutport(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRating) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRating) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRating) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rating != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rating))))
+		i--
+		dAtA[i] = 0x15
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintOutport(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorsRatingPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorsRatingPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorsRatingPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ratings) > 0 {
+		for iNdEx := len(m.Ratings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ratings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOutport(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.IndexID) > 0 {
+		i -= len(m.IndexID)
+		copy(dAtA[i:], m.IndexID)
+		i = encodeVarintOutport(dAtA, i, uint64(len(m.IndexID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Account) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Account) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintOutport(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintOutport(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintOutport(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountsPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountsPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountsPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOutport(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BlockTimestamp != 0 {
+		i = encodeVarintOutport(dAtA, i, uint64(m.BlockTimestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalizedBlockPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalizedBlockPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalizedBlockPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HeaderHash) > 0 {
+		i -= len(m.HeaderHash)
+		copy(dAtA[i:], m.HeaderHash)
+		i = encodeVarintOutport(dAtA, i, uint64(len(m.HeaderHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOutport(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOutport(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OutportMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovOutport(uint64(m.ID))
+	}
+	if m.Operation != 0 {
+		n += 1 + sovOutport(uint64(m.Operation))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovOutport(uint64(l))
+	}
+	return n
+}
+
+func (m *Ack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovOutport(uint64(m.ID))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovOutport(uint64(l))
+	}
+	return n
+}
+
+func (m *TransactionEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovOutport(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovOutport(uint64(l))
+	}
+	return n
+}
+
+func (m *SaveBlockPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HeaderHash)
+	if l > 0 {
+		n += 1 + l + sovOutport(uint64(l))
+	}
+	l = len(m.HeaderType)
+	if l > 0 {
+		n += 1 + l + sovOutport(uint64(l))
+	}
+	l = len(m.Header)
+	if l > 0 {
+		n += 1 + l + sovOutport(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovOutport(uint64(l))
+	}
+	if len(m.SignersIndexes) > 0 {
+		l = 0
+		for _, e := range m.SignersIndexes {
+			l += sovOutport(uint64(e))
+		}
+		n += 1 + sovOutport(uint64(l)) + l
+	}
+	if len(m.NotarizedHeadersHashes) > 0 {
+		for _, s := range m.NotarizedHeadersHashes {
+			l = len(s)
+			n += 1 + l + sovOutport(uint64(l))
+		}
+	}
+	if m.GasConsumed != 0 {
+		n += 1 + sovOutport(uint64(m.GasConsumed))
+	}
+	if m.GasRefunded != 0 {
+		n += 1 + sovOutport(uint64(m.GasRefunded))
+	}
+	if m.GasPenalized != 0 {
+		n += 1 + sovOutport(uint64(m.GasPenalized))
+	}
+	if m.MaxGasPerBlock != 0 {
+		n += 1 + sovOutport(uint64(m.MaxGasPerBlock))
+	}
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovOutport(uint64(l))
+		}
+	}
+	if len(m.SmartContractResults) > 0 {
+		for _, e := range m.SmartContractResults {
+			l = e.Size()
+			n += 1 + l + sovOutport(uint64(l))
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovOutport(uint64(l))
+		}
+	}
+	if len(m.InvalidTransactions) > 0 {
+		for _, e := range m.InvalidTransactions {
+			l = e.Size()
+			n += 1 + l + sovOutport(uint64(l))
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for _, e := range m.Receipts {
+			l = e.Size()
+			n += 1 + l + sovOutport(uint64(l))
+		}
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 2 + l + sovOutport(uint64(l))
+		}
+	}
+	if len(m.AlteredAccounts) > 0 {
+		for _, e := range m.AlteredAccounts {
+			l = e.Size()
+			n += 2 + l + sovOutport(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RevertIndexedBlockPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HeaderType)
+	if l > 0 {
+		n += 1 + l + sovOutport(uint64(l))
+	}
+	l = len(m.Header)
+	if l > 0 {
+		n += 1 + l + sovOutport(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovOutport(uint64(l))
+	}
+	return n
+}
+
+func (m *RoundInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovOutport(uint64(m.Index))
+	}
+	if len(m.SignersIndexes) > 0 {
+		l = 0
+		for _, e := range m.SignersIndexes {
+			l += sovOutport(uint64(e))
+		}
+		n += 1 + sovOutport(uint64(l)) + l
+	}
+	if m.BlockWasProposed {
+		n += 2
+	}
+	if m.ShardID != 0 {
+		n += 1 + sovOutport(uint64(m.ShardID))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovOutport(uint64(m.Epoch))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovOutport(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *RoundsInfoPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RoundsInfo) > 0 {
+		for _, e := range m.RoundsInfo {
+			l = e.Size()
+			n += 1 + l + sovOutport(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ShardValidatorsPubKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardID != 0 {
+		n += 1 + sovOutport(uint64(m.ShardID))
+	}
+	if len(m.PubKeys) > 0 {
+		for _, b := range m.PubKeys {
+			l = len(b)
+			n += 1 + l + sovOutport(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorsPubKeysPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovOutport(uint64(m.Epoch))
+	}
+	if len(m.Shards) > 0 {
+		for _, e := range m.Shards {
+			l = e.Size()
+			n += 1 + l + sovOutport(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorRating) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovOutport(uint64(l))
+	}
+	if m.Rating != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *ValidatorsRatingPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IndexID)
+	if l > 0 {
+		n += 1 + l + sovOutport(uint64(l))
+	}
+	if len(m.Ratings) > 0 {
+		for _, e := range m.Ratings {
+			l = e.Size()
+			n += 1 + l + sovOutport(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Account) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovOutport(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovOutport(uint64(m.Nonce))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovOutport(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountsPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockTimestamp != 0 {
+		n += 1 + sovOutport(uint64(m.BlockTimestamp))
+	}
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovOutport(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FinalizedBlockPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HeaderHash)
+	if l > 0 {
+		n += 1 + l + sovOutport(uint64(l))
+	}
+	return n
+}
+
+func sovOutport(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOutport(x uint64) (n int) {
+	return sovOutport(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *OutportMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OutportMessage{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Operation:` + fmt.Sprintf("%v", this.Operation) + `,`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Ack) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Ack{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TransactionEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransactionEntry{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SaveBlockPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTransactions := "[]*TransactionEntry{"
+	for _, f := range this.Transactions {
+		repeatedStringForTransactions += strings.Replace(f.String(), "TransactionEntry", "TransactionEntry", 1) + ","
+	}
+	repeatedStringForTransactions += "}"
+	repeatedStringForSmartContractResults := "[]*TransactionEntry{"
+	for _, f := range this.SmartContractResults {
+		repeatedStringForSmartContractResults += strings.Replace(f.String(), "TransactionEntry", "TransactionEntry", 1) + ","
+	}
+	repeatedStringForSmartContractResults += "}"
+	repeatedStringForRewards := "[]*TransactionEntry{"
+	for _, f := range this.Rewards {
+		repeatedStringForRewards += strings.Replace(f.String(), "TransactionEntry", "TransactionEntry", 1) + ","
+	}
+	repeatedStringForRewards += "}"
+	repeatedStringForInvalidTransactions := "[]*TransactionEntry{"
+	for _, f := range this.InvalidTransactions {
+		repeatedStringForInvalidTransactions += strings.Replace(f.String(), "TransactionEntry", "TransactionEntry", 1) + ","
+	}
+	repeatedStringForInvalidTransactions += "}"
+	repeatedStringForReceipts := "[]*TransactionEntry{"
+	for _, f := range this.Receipts {
+		repeatedStringForReceipts += strings.Replace(f.String(), "TransactionEntry", "TransactionEntry", 1) + ","
+	}
+	repeatedStringForReceipts += "}"
+	repeatedStringForLogs := "[]*TransactionEntry{"
+	for _, f := range this.Logs {
+		repeatedStringForLogs += strings.Replace(f.String(), "TransactionEntry", "TransactionEntry", 1) + ","
+	}
+	repeatedStringForLogs += "}"
+	repeatedStringForAlteredAccounts := "[]*Account{"
+	for _, f := range this.AlteredAccounts {
+		repeatedStringForAlteredAccounts += strings.Replace(f.String(), "Account", "Account", 1) + ","
+	}
+	repeatedStringForAlteredAccounts += "}"
+	s := strings.Join([]string{`&SaveBlockPayload{`,
+		`HeaderHash:` + fmt.Sprintf("%v", this.HeaderHash) + `,`,
+		`HeaderType:` + fmt.Sprintf("%v", this.HeaderType) + `,`,
+		`Header:` + fmt.Sprintf("%v", this.Header) + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`SignersIndexes:` + fmt.Sprintf("%v", this.SignersIndexes) + `,`,
+		`NotarizedHeadersHashes:` + fmt.Sprintf("%v", this.NotarizedHeadersHashes) + `,`,
+		`GasConsumed:` + fmt.Sprintf("%v", this.GasConsumed) + `,`,
+		`GasRefunded:` + fmt.Sprintf("%v", this.GasRefunded) + `,`,
+		`GasPenalized:` + fmt.Sprintf("%v", this.GasPenalized) + `,`,
+		`MaxGasPerBlock:` + fmt.Sprintf("%v", this.MaxGasPerBlock) + `,`,
+		`Transactions:` + repeatedStringForTransactions + `,`,
+		`SmartContractResults:` + repeatedStringForSmartContractResults + `,`,
+		`Rewards:` + repeatedStringForRewards + `,`,
+		`InvalidTransactions:` + repeatedStringForInvalidTransactions + `,`,
+		`Receipts:` + repeatedStringForReceipts + `,`,
+		`Logs:` + repeatedStringForLogs + `,`,
+		`AlteredAccounts:` + repeatedStringForAlteredAccounts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RevertIndexedBlockPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RevertIndexedBlockPayload{`,
+		`HeaderType:` + fmt.Sprintf("%v", this.HeaderType) + `,`,
+		`Header:` + fmt.Sprintf("%v", this.Header) + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RoundInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RoundInfo{`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`SignersIndexes:` + fmt.Sprintf("%v", this.SignersIndexes) + `,`,
+		`BlockWasProposed:` + fmt.Sprintf("%v", this.BlockWasProposed) + `,`,
+		`ShardID:` + fmt.Sprintf("%v", this.ShardID) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RoundsInfoPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRoundsInfo := "[]*RoundInfo{"
+	for _, f := range this.RoundsInfo {
+		repeatedStringForRoundsInfo += strings.Replace(f.String(), "RoundInfo", "RoundInfo", 1) + ","
+	}
+	repeatedStringForRoundsInfo += "}"
+	s := strings.Join([]string{`&RoundsInfoPayload{`,
+		`RoundsInfo:` + repeatedStringForRoundsInfo + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShardValidatorsPubKeys) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShardValidatorsPubKeys{`,
+		`ShardID:` + fmt.Sprintf("%v", this.ShardID) + `,`,
+		`PubKeys:` + fmt.Sprintf("%v", this.PubKeys) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValidatorsPubKeysPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForShards := "[]*ShardValidatorsPubKeys{"
+	for _, f := range this.Shards {
+		repeatedStringForShards += strings.Replace(f.String(), "ShardValidatorsPubKeys", "ShardValidatorsPubKeys", 1) + ","
+	}
+	repeatedStringForShards += "}"
+	s := strings.Join([]string{`&ValidatorsPubKeysPayload{`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`Shards:` + repeatedStringForShards + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValidatorRating) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValidatorRating{`,
+		`PublicKey:` + fmt.Sprintf("%v", this.PublicKey) + `,`,
+		`Rating:` + fmt.Sprintf("%v", this.Rating) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValidatorsRatingPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRatings := "[]*ValidatorRating{"
+	for _, f := range this.Ratings {
+		repeatedStringForRatings += strings.Replace(f.String(), "ValidatorRating", "ValidatorRating", 1) + ","
+	}
+	repeatedStringForRatings += "}"
+	s := strings.Join([]string{`&ValidatorsRatingPayload{`,
+		`IndexID:` + fmt.Sprintf("%v", this.IndexID) + `,`,
+		`Ratings:` + repeatedStringForRatings + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Account) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Account{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Balance:` + fmt.Sprintf("%v", this.Balance) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountsPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForAccounts := "[]*Account{"
+	for _, f := range this.Accounts {
+		repeatedStringForAccounts += strings.Replace(f.String(), "Account", "Account", 1) + ","
+	}
+	repeatedStringForAccounts += "}"
+	s := strings.Join([]string{`&AccountsPayload{`,
+		`BlockTimestamp:` + fmt.Sprintf("%v", this.BlockTimestamp) + `,`,
+		`Accounts:` + repeatedStringForAccounts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FinalizedBlockPayload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FinalizedBlockPayload{`,
+		`HeaderHash:` + fmt.Sprintf("%v", this.HeaderHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringOutport(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *OutportMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutportMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutportMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			m.Operation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operation |= Operation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Ack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Ack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Ack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SaveBlockPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SaveBlockPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SaveBlockPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHash = append(m.HeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeaderHash == nil {
+				m.HeaderHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = append(m.Header[:0], dAtA[iNdEx:postIndex]...)
+			if m.Header == nil {
+				m.Header = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
+			if m.Body == nil {
+				m.Body = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOutport
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SignersIndexes = append(m.SignersIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOutport
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthOutport
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthOutport
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SignersIndexes) == 0 {
+					m.SignersIndexes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOutport
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SignersIndexes = append(m.SignersIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignersIndexes", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotarizedHeadersHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotarizedHeadersHashes = append(m.NotarizedHeadersHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasConsumed", wireType)
+			}
+			m.GasConsumed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasConsumed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRefunded", wireType)
+			}
+			m.GasRefunded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasRefunded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPenalized", wireType)
+			}
+			m.GasPenalized = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPenalized |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerBlock", wireType)
+			}
+			m.MaxGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &TransactionEntry{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmartContractResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SmartContractResults = append(m.SmartContractResults, &TransactionEntry{})
+			if err := m.SmartContractResults[len(m.SmartContractResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, &TransactionEntry{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidTransactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidTransactions = append(m.InvalidTransactions, &TransactionEntry{})
+			if err := m.InvalidTransactions[len(m.InvalidTransactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipts = append(m.Receipts, &TransactionEntry{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &TransactionEntry{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlteredAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlteredAccounts = append(m.AlteredAccounts, &Account{})
+			if err := m.AlteredAccounts[len(m.AlteredAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevertIndexedBlockPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevertIndexedBlockPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevertIndexedBlockPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = append(m.Header[:0], dAtA[iNdEx:postIndex]...)
+			if m.Header == nil {
+				m.Header = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
+			if m.Body == nil {
+				m.Body = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoundInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoundInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoundInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOutport
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SignersIndexes = append(m.SignersIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOutport
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthOutport
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthOutport
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SignersIndexes) == 0 {
+					m.SignersIndexes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOutport
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SignersIndexes = append(m.SignersIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignersIndexes", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockWasProposed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockWasProposed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoundsInfoPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoundsInfoPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoundsInfoPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundsInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoundsInfo = append(m.RoundsInfo, &RoundInfo{})
+			if err := m.RoundsInfo[len(m.RoundsInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardValidatorsPubKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardValidatorsPubKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardValidatorsPubKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeys = append(m.PubKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PubKeys[len(m.PubKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorsPubKeysPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorsPubKeysPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorsPubKeysPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shards = append(m.Shards, &ShardValidatorsPubKeys{})
+			if err := m.Shards[len(m.Shards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRating) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRating: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRating: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Rating = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorsRatingPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorsRatingPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorsRatingPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ratings = append(m.Ratings, &ValidatorRating{})
+			if err := m.Ratings[len(m.Ratings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Account) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Account: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountsPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountsPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountsPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+			}
+			m.BlockTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &Account{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalizedBlockPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalizedBlockPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalizedBlockPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOutport
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHash = append(m.HeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeaderHash == nil {
+				m.HeaderHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOutport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOutport(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOutport
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOutport
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOutport
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOutport
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOutport
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOutport        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOutport          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOutport = fmt.Errorf("proto: unexpected end of group")
+)
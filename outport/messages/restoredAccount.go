@@ -0,0 +1,41 @@
+package messages
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+)
+
+var _ data.UserAccountHandler = (*restoredAccount)(nil)
+
+// restoredAccount is the account restored from an AccountsPayload. It does not have a data trie
+type restoredAccount struct {
+	address []byte
+	nonce   uint64
+	balance *big.Int
+}
+
+// RetrieveValueFromDataTrieTracker returns ErrDataTrieNotAvailable as the restored accounts do not have a data trie
+func (ra *restoredAccount) RetrieveValueFromDataTrieTracker(_ []byte) ([]byte, error) {
+	return nil, ErrDataTrieNotAvailable
+}
+
+// GetBalance returns the balance of the account
+func (ra *restoredAccount) GetBalance() *big.Int {
+	return big.NewInt(0).Set(ra.balance)
+}
+
+// GetNonce returns the nonce of the account
+func (ra *restoredAccount) GetNonce() uint64 {
+	return ra.nonce
+}
+
+// AddressBytes returns the address of the account
+func (ra *restoredAccount) AddressBytes() []byte {
+	return ra.address
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ra *restoredAccount) IsInterfaceNil() bool {
+	return ra == nil
+}
@@ -0,0 +1,219 @@
+package messages
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+)
+
+// RestoreSaveBlock restores the arguments of a SaveBlock call from the provided payload
+func (c *converter) RestoreSaveBlock(payload *SaveBlockPayload) (*indexer.ArgsSaveBlockData, error) {
+	header, body, err := c.restoreHeaderAndBody(payload.HeaderType, payload.Header, payload.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	args := &indexer.ArgsSaveBlockData{
+		HeaderHash:             payload.HeaderHash,
+		Body:                   body,
+		Header:                 header,
+		SignersIndexes:         payload.SignersIndexes,
+		NotarizedHeadersHashes: payload.NotarizedHeadersHashes,
+		HeaderGasConsumption: indexer.HeaderGasConsumption{
+			GasConsumed:    payload.GasConsumed,
+			GasRefunded:    payload.GasRefunded,
+			GasPenalized:   payload.GasPenalized,
+			MaxGasPerBlock: payload.MaxGasPerBlock,
+		},
+		TransactionsPool: &indexer.Pool{},
+	}
+
+	pool := args.TransactionsPool
+	pool.Txs, err = c.restoreTransactions(payload.Transactions, createTransaction)
+	if err != nil {
+		return nil, err
+	}
+	pool.Scrs, err = c.restoreTransactions(payload.SmartContractResults, createSmartContractResult)
+	if err != nil {
+		return nil, err
+	}
+	pool.Rewards, err = c.restoreTransactions(payload.Rewards, createRewardTransaction)
+	if err != nil {
+		return nil, err
+	}
+	pool.Invalid, err = c.restoreTransactions(payload.InvalidTransactions, createTransaction)
+	if err != nil {
+		return nil, err
+	}
+	pool.Receipts, err = c.restoreTransactions(payload.Receipts, createReceipt)
+	if err != nil {
+		return nil, err
+	}
+	pool.Logs, err = c.restoreLogs(payload.Logs)
+	if err != nil {
+		return nil, err
+	}
+
+	return args, nil
+}
+
+// RestoreRevertIndexedBlock restores the arguments of a RevertIndexedBlock call from the provided payload
+func (c *converter) RestoreRevertIndexedBlock(payload *RevertIndexedBlockPayload) (data.HeaderHandler, data.BodyHandler, error) {
+	return c.restoreHeaderAndBody(payload.HeaderType, payload.Header, payload.Body)
+}
+
+func (c *converter) restoreHeaderAndBody(headerType string, headerBytes []byte, bodyBytes []byte) (data.HeaderHandler, data.BodyHandler, error) {
+	var header data.HeaderHandler
+	switch headerType {
+	case "":
+		return nil, nil, nil
+	case HeaderTypeShard:
+		header = &block.Header{}
+	case HeaderTypeMeta:
+		header = &block.MetaBlock{}
+	default:
+		return nil, nil, fmt.Errorf("%w: %s", ErrUnsupportedHeaderType, headerType)
+	}
+
+	err := c.marshalizer.Unmarshal(header, headerBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// an empty body is marshaled as an empty byte slice, so the body is always restored as a non-nil one
+	body := &block.Body{}
+	err = c.marshalizer.Unmarshal(body, bodyBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return header, body, nil
+}
+
+func (c *converter) restoreTransactions(
+	entries []*TransactionEntry,
+	createTx func() data.TransactionHandler,
+) (map[string]data.TransactionHandler, error) {
+	txs := make(map[string]data.TransactionHandler, len(entries))
+	for _, entry := range entries {
+		tx := createTx()
+		err := c.marshalizer.Unmarshal(tx, entry.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		txs[string(entry.Hash)] = tx
+	}
+
+	return txs, nil
+}
+
+func (c *converter) restoreLogs(entries []*TransactionEntry) (map[string]data.LogHandler, error) {
+	logs := make(map[string]data.LogHandler, len(entries))
+	for _, entry := range entries {
+		txLog := &transaction.Log{}
+		err := c.marshalizer.Unmarshal(txLog, entry.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		logs[string(entry.Hash)] = txLog
+	}
+
+	return logs, nil
+}
+
+func createTransaction() data.TransactionHandler {
+	return &transaction.Transaction{}
+}
+
+func createSmartContractResult() data.TransactionHandler {
+	return &smartContractResult.SmartContractResult{}
+}
+
+func createRewardTransaction() data.TransactionHandler {
+	return &rewardTx.RewardTx{}
+}
+
+func createReceipt() data.TransactionHandler {
+	return &receipt.Receipt{}
+}
+
+// RestoreRoundsInfo restores the arguments of a SaveRoundsInfo call from the provided payload
+func RestoreRoundsInfo(payload *RoundsInfoPayload) []*indexer.RoundInfo {
+	roundsInfo := make([]*indexer.RoundInfo, 0, len(payload.RoundsInfo))
+	for _, roundInfo := range payload.RoundsInfo {
+		roundsInfo = append(roundsInfo, &indexer.RoundInfo{
+			Index:            roundInfo.Index,
+			SignersIndexes:   roundInfo.SignersIndexes,
+			BlockWasProposed: roundInfo.BlockWasProposed,
+			ShardId:          roundInfo.ShardID,
+			Epoch:            roundInfo.Epoch,
+			Timestamp:        time.Duration(roundInfo.Timestamp),
+		})
+	}
+
+	return roundsInfo
+}
+
+// RestoreValidatorsPubKeys restores the arguments of a SaveValidatorsPubKeys call from the provided payload
+func RestoreValidatorsPubKeys(payload *ValidatorsPubKeysPayload) (map[uint32][][]byte, uint32) {
+	validatorsPubKeys := make(map[uint32][][]byte, len(payload.Shards))
+	for _, shard := range payload.Shards {
+		validatorsPubKeys[shard.ShardID] = shard.PubKeys
+	}
+
+	return validatorsPubKeys, payload.Epoch
+}
+
+// RestoreValidatorsRating restores the arguments of a SaveValidatorsRating call from the provided payload
+func RestoreValidatorsRating(payload *ValidatorsRatingPayload) (string, []*indexer.ValidatorRatingInfo) {
+	ratings := make([]*indexer.ValidatorRatingInfo, 0, len(payload.Ratings))
+	for _, rating := range payload.Ratings {
+		ratings = append(ratings, &indexer.ValidatorRatingInfo{
+			PublicKey: rating.PublicKey,
+			Rating:    rating.Rating,
+		})
+	}
+
+	return payload.IndexID, ratings
+}
+
+// RestoreAccounts restores the arguments of a SaveAccounts call from the provided payload. Only the address, the
+// nonce and the balance of the accounts are carried by the payload, so the data tries of the restored accounts are
+// not available
+func RestoreAccounts(payload *AccountsPayload) (uint64, []data.UserAccountHandler, error) {
+	accounts, err := RestoreAlteredAccounts(payload.Accounts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return payload.BlockTimestamp, accounts, nil
+}
+
+// RestoreAlteredAccounts restores the provided accounts. The data tries of the restored accounts are not available
+func RestoreAlteredAccounts(accounts []*Account) ([]data.UserAccountHandler, error) {
+	restored := make([]data.UserAccountHandler, 0, len(accounts))
+	for _, account := range accounts {
+		balance, ok := big.NewInt(0).SetString(account.Balance, 10)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidBalance, account.Balance)
+		}
+
+		restored = append(restored, &restoredAccount{
+			address: account.Address,
+			nonce:   account.Nonce,
+			balance: balance,
+		})
+	}
+
+	return restored, nil
+}
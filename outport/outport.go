@@ -16,8 +16,10 @@ var log = logger.GetOrCreate("outport")
 const minimumRetrialInterval = time.Millisecond * 10
 
 type outport struct {
-	mutex           sync.RWMutex
-	drivers         []Driver
+	mutex   sync.RWMutex
+	drivers []Driver
+	// replayDrivers holds the drivers which also receive the blocks replayed from storage
+	replayDrivers   []Driver
 	retrialInterval time.Duration
 	chanClose       chan struct{}
 }
@@ -30,6 +32,7 @@ func NewOutport(retrialInterval time.Duration) (*outport, error) {
 
 	return &outport{
 		drivers:         make([]Driver, 0),
+		replayDrivers:   make([]Driver, 0),
 		mutex:           sync.RWMutex{},
 		retrialInterval: retrialInterval,
 		chanClose:       make(chan struct{}),
@@ -241,6 +244,17 @@ func (o *outport) finalizedBlockBlocking(headerHash []byte, driver Driver) {
 	}
 }
 
+// ReplayBlock will save and then finalize a block, read from storage, for every driver except the live ones
+func (o *outport) ReplayBlock(args *indexer.ArgsSaveBlockData) {
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+
+	for _, driver := range o.replayDrivers {
+		o.saveBlockBlocking(args, driver)
+		o.finalizedBlockBlocking(args.HeaderHash, driver)
+	}
+}
+
 // Close will close all the drivers that are in outport
 func (o *outport) Close() error {
 	close(o.chanClose)
@@ -269,6 +283,14 @@ func (o *outport) HasDrivers() bool {
 	return len(o.drivers) != 0
 }
 
+// HasReplayDrivers returns true if there is at least one driver in the outport which receives the replayed blocks
+func (o *outport) HasReplayDrivers() bool {
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+
+	return len(o.replayDrivers) != 0
+}
+
 // SubscribeDriver can subscribe a driver to the outport
 func (o *outport) SubscribeDriver(driver Driver) error {
 	if check.IfNil(driver) {
@@ -277,6 +299,7 @@ func (o *outport) SubscribeDriver(driver Driver) error {
 
 	o.mutex.Lock()
 	o.drivers = append(o.drivers, driver)
+	o.replayDrivers = append(o.replayDrivers, driver)
 	o.mutex.Unlock()
 
 	log.Debug("outport.SubscribeDriver new driver added", "driver", driverString(driver))
@@ -284,6 +307,22 @@ func (o *outport) SubscribeDriver(driver Driver) error {
 	return nil
 }
 
+// SubscribeLiveDriver can subscribe a driver which receives only the blocks as they are committed, and never the
+// blocks replayed from storage. It is meant for the drivers whose clients expect only new blocks
+func (o *outport) SubscribeLiveDriver(driver Driver) error {
+	if check.IfNil(driver) {
+		return ErrNilDriver
+	}
+
+	o.mutex.Lock()
+	o.drivers = append(o.drivers, driver)
+	o.mutex.Unlock()
+
+	log.Debug("outport.SubscribeLiveDriver new driver added", "driver", driverString(driver))
+
+	return nil
+}
+
 func driverString(driver Driver) string {
 	return fmt.Sprintf("%T", driver)
 }
@@ -278,6 +278,65 @@ func TestOutport_SubscribeDriver(t *testing.T) {
 	})
 }
 
+func TestOutport_SubscribeLiveDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil driver should error", func(t *testing.T) {
+		outportHandler, _ := NewOutport(minimumRetrialInterval)
+
+		err := outportHandler.SubscribeLiveDriver(nil)
+		require.Equal(t, ErrNilDriver, err)
+		require.False(t, outportHandler.HasDrivers())
+	})
+	t.Run("should work", func(t *testing.T) {
+		outportHandler, _ := NewOutport(minimumRetrialInterval)
+
+		err := outportHandler.SubscribeLiveDriver(&mock.DriverStub{})
+		require.Nil(t, err)
+		require.True(t, outportHandler.HasDrivers())
+		require.False(t, outportHandler.HasReplayDrivers())
+
+		err = outportHandler.SubscribeDriver(&mock.DriverStub{})
+		require.Nil(t, err)
+		require.True(t, outportHandler.HasReplayDrivers())
+	})
+}
+
+func TestOutport_ReplayBlockShouldSkipTheLiveDrivers(t *testing.T) {
+	t.Parallel()
+
+	numSavedLive := 0
+	liveDriver := &mock.DriverStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) error {
+			numSavedLive++
+			return nil
+		},
+	}
+	savedHashes := make([]string, 0)
+	finalizedHashes := make([]string, 0)
+	driver := &mock.DriverStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) error {
+			savedHashes = append(savedHashes, string(args.HeaderHash))
+			return nil
+		},
+		FinalizedBlockCalled: func(headerHash []byte) error {
+			finalizedHashes = append(finalizedHashes, string(headerHash))
+			return nil
+		},
+	}
+	outportHandler, _ := NewOutport(minimumRetrialInterval)
+	_ = outportHandler.SubscribeLiveDriver(liveDriver)
+	_ = outportHandler.SubscribeDriver(driver)
+
+	outportHandler.ReplayBlock(&indexer.ArgsSaveBlockData{HeaderHash: []byte("hash")})
+	assert.Equal(t, 0, numSavedLive)
+	assert.Equal(t, []string{"hash"}, savedHashes)
+	assert.Equal(t, []string{"hash"}, finalizedHashes)
+
+	outportHandler.SaveBlock(&indexer.ArgsSaveBlockData{HeaderHash: []byte("hash")})
+	assert.Equal(t, 1, numSavedLive)
+}
+
 func TestOutport_Close(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,33 @@
+package queue
+
+import "errors"
+
+// ErrNilDriver signals that a nil driver has been provided
+var ErrNilDriver = errors.New("nil driver")
+
+// ErrNilPersister signals that a nil persister has been provided
+var ErrNilPersister = errors.New("nil persister")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilMetricsHandler signals that a nil metrics handler has been provided
+var ErrNilMetricsHandler = errors.New("nil metrics handler")
+
+// ErrEmptyName signals that an empty queue name has been provided
+var ErrEmptyName = errors.New("empty queue name")
+
+// ErrInvalidMaxPendingRecords signals that an invalid maximum number of pending records has been provided
+var ErrInvalidMaxPendingRecords = errors.New("invalid maximum number of pending records")
+
+// ErrInvalidMaxPendingSizeInBytes signals that an invalid maximum size of the pending records has been provided
+var ErrInvalidMaxPendingSizeInBytes = errors.New("invalid maximum size of the pending records")
+
+// ErrInvalidRetrialInterval signals that an invalid retrial interval has been provided
+var ErrInvalidRetrialInterval = errors.New("invalid retrial interval")
+
+// ErrQueueClosed signals that the queue has been closed
+var ErrQueueClosed = errors.New("queue closed")
+
+// ErrQueueFull signals that the queue cannot accept a new record without exceeding its bounds
+var ErrQueueFull = errors.New("queue full")
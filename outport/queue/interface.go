@@ -0,0 +1,21 @@
+package queue
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/outport/messages"
+)
+
+type outportConverter interface {
+	ConvertSaveBlock(args *indexer.ArgsSaveBlockData) (*messages.SaveBlockPayload, error)
+	ConvertRevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) (*messages.RevertIndexedBlockPayload, error)
+	RestoreSaveBlock(payload *messages.SaveBlockPayload) (*indexer.ArgsSaveBlockData, error)
+	RestoreRevertIndexedBlock(payload *messages.RevertIndexedBlockPayload) (data.HeaderHandler, data.BodyHandler, error)
+}
+
+// alteredAccountsDriver is implemented by the drivers which load the accounts altered by a block while saving it. The
+// queue records the altered accounts along with the block and delivers them to the driver
+type alteredAccountsDriver interface {
+	RecordAlteredAccounts(args *indexer.ArgsSaveBlockData) []data.UserAccountHandler
+	SaveBlockWithAlteredAccounts(args *indexer.ArgsSaveBlockData, alteredAccounts []data.UserAccountHandler) error
+}
@@ -0,0 +1,552 @@
+package queue
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/messages"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/gogo/protobuf/proto"
+)
+
+var log = logger.GetOrCreate("outport/queue")
+
+const (
+	minimumRetrialInterval = time.Millisecond * 10
+	recordKeyLength        = 8
+)
+
+var _ outport.Driver = (*queuedDriver)(nil)
+
+// ArgsQueuedDriver holds the arguments needed to create a queued driver
+type ArgsQueuedDriver struct {
+	Driver outport.Driver
+	// Persister holds the records of the queue. It should write each record before returning from Put (no batching),
+	// otherwise the records which are not yet written are lost if the node stops
+	Persister       storage.Persister
+	Marshalizer     marshal.Marshalizer
+	MetricsHandler  common.MetricsHandler
+	RetrialInterval time.Duration
+	// Name identifies the queue in the metrics
+	Name string
+	// MaxPendingRecords and MaxPendingSizeInBytes bound the records which were not yet delivered to the driver
+	MaxPendingRecords     uint64
+	MaxPendingSizeInBytes uint64
+}
+
+// queuedDriver is a write-ahead queue placed in front of an outport driver. Each call is persisted as a record (an
+// OutportMessage) and the call returns right away, so the block processing does not wait for the driver. The records
+// are delivered to the driver asynchronously, in order, and are removed only after the driver handled them without
+// error. The records which were not delivered when the node stopped are delivered after the node restarts.
+// The wrapped driver must rely only on the queued data: a driver reading the current state of the node while handling
+// a block would see the state of a later block. The accounts altered by a block are queued along with the block for
+// the drivers loading them while saving the block (see alteredAccountsDriver).
+// The queue is bounded: a call which would exceed the maximum number of pending records or the maximum size of the
+// pending records is rejected with ErrQueueFull and nothing is written. The outport retries the rejected calls, so a
+// full queue blocks the block processing until the driver catches up, as if there was no queue in front of the driver.
+// A record is always accepted by an empty queue, so a record larger than the size bound cannot block the node forever.
+type queuedDriver struct {
+	driver                outport.Driver
+	alteredAccountsDriver alteredAccountsDriver
+	persister             storage.Persister
+	converter             outportConverter
+	metricsHandler        common.MetricsHandler
+	retrialInterval       time.Duration
+	name                  string
+	maxPendingRecords     uint64
+	maxPendingSizeInBytes uint64
+
+	mutQueue      sync.RWMutex
+	head          uint64
+	tail          uint64
+	pendingBytes  uint64
+	closed        bool
+	chanNewRecord chan struct{}
+	cancelFunc    context.CancelFunc
+	chanLoopDone  chan struct{}
+}
+
+// NewQueuedDriver creates a new queued driver and starts delivering the records already existing in the persister
+func NewQueuedDriver(args ArgsQueuedDriver) (*queuedDriver, error) {
+	if check.IfNil(args.Driver) {
+		return nil, ErrNilDriver
+	}
+	if check.IfNil(args.Persister) {
+		return nil, ErrNilPersister
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.MetricsHandler) {
+		return nil, ErrNilMetricsHandler
+	}
+	if args.RetrialInterval < minimumRetrialInterval {
+		return nil, fmt.Errorf("%w, provided: %v, minimum: %v", ErrInvalidRetrialInterval, args.RetrialInterval, minimumRetrialInterval)
+	}
+	if len(args.Name) == 0 {
+		return nil, ErrEmptyName
+	}
+	if args.MaxPendingRecords == 0 {
+		return nil, ErrInvalidMaxPendingRecords
+	}
+	if args.MaxPendingSizeInBytes == 0 {
+		return nil, ErrInvalidMaxPendingSizeInBytes
+	}
+
+	converter, err := messages.NewConverter(args.Marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	alteredAccountsHandler, _ := args.Driver.(alteredAccountsDriver)
+	qd := &queuedDriver{
+		driver:                args.Driver,
+		alteredAccountsDriver: alteredAccountsHandler,
+		persister:             args.Persister,
+		converter:             converter,
+		metricsHandler:        args.MetricsHandler,
+		retrialInterval:       args.RetrialInterval,
+		name:                  args.Name,
+		maxPendingRecords:     args.MaxPendingRecords,
+		maxPendingSizeInBytes: args.MaxPendingSizeInBytes,
+		chanNewRecord:         make(chan struct{}, 1),
+		cancelFunc:            cancel,
+		chanLoopDone:          make(chan struct{}),
+	}
+	qd.loadBounds()
+	qd.updateSizeMetric()
+
+	log.Debug("NewQueuedDriver",
+		"name", qd.name,
+		"driver", driverString(qd.driver),
+		"pending records", qd.NumPendingRecords(),
+		"pending bytes", qd.NumPendingBytes(),
+	)
+
+	go qd.processLoop(ctx)
+
+	return qd, nil
+}
+
+// loadBounds finds the first and the last records of the queue and their total size. As the records are removed in
+// order, all the records in between exist in the persister
+func (qd *queuedDriver) loadBounds() {
+	found := false
+	qd.persister.RangeKeys(func(key []byte, value []byte) bool {
+		if len(key) != recordKeyLength {
+			return true
+		}
+
+		index := binary.BigEndian.Uint64(key)
+		if !found || index < qd.head {
+			qd.head = index
+		}
+		if !found || index >= qd.tail {
+			qd.tail = index + 1
+		}
+		qd.pendingBytes += uint64(len(value))
+		found = true
+
+		return true
+	})
+}
+
+// SaveBlock queues the block, its transactions, receipts and logs. The accounts altered by the block are queued as
+// well if the driver loads them while saving the block
+func (qd *queuedDriver) SaveBlock(args *indexer.ArgsSaveBlockData) error {
+	if args == nil {
+		return nil
+	}
+
+	payload, err := qd.converter.ConvertSaveBlock(args)
+	if err != nil {
+		return err
+	}
+	if qd.alteredAccountsDriver != nil {
+		payload.AlteredAccounts = messages.ConvertAlteredAccounts(qd.alteredAccountsDriver.RecordAlteredAccounts(args))
+	}
+
+	return qd.enqueue(messages.OperationSaveBlock, payload)
+}
+
+// RevertIndexedBlock queues the reverted block
+func (qd *queuedDriver) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) error {
+	payload, err := qd.converter.ConvertRevertIndexedBlock(header, body)
+	if err != nil {
+		return err
+	}
+
+	return qd.enqueue(messages.OperationRevertIndexedBlock, payload)
+}
+
+// SaveRoundsInfo queues the rounds information
+func (qd *queuedDriver) SaveRoundsInfo(roundsInfos []*indexer.RoundInfo) error {
+	return qd.enqueue(messages.OperationSaveRoundsInfo, messages.ConvertRoundsInfo(roundsInfos))
+}
+
+// SaveValidatorsPubKeys queues the validators public keys of an epoch
+func (qd *queuedDriver) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) error {
+	return qd.enqueue(messages.OperationSaveValidatorsPubKeys, messages.ConvertValidatorsPubKeys(validatorsPubKeys, epoch))
+}
+
+// SaveValidatorsRating queues the validators rating
+func (qd *queuedDriver) SaveValidatorsRating(indexID string, infoRating []*indexer.ValidatorRatingInfo) error {
+	return qd.enqueue(messages.OperationSaveValidatorsRating, messages.ConvertValidatorsRating(indexID, infoRating))
+}
+
+// SaveAccounts queues the provided accounts. Only the address, the nonce and the balance of the accounts are queued
+func (qd *queuedDriver) SaveAccounts(blockTimestamp uint64, acc []data.UserAccountHandler) error {
+	return qd.enqueue(messages.OperationSaveAccounts, messages.ConvertAccounts(blockTimestamp, acc))
+}
+
+// FinalizedBlock queues the hash of the finalized block
+func (qd *queuedDriver) FinalizedBlock(headerHash []byte) error {
+	return qd.enqueue(messages.OperationFinalizedBlock, &messages.FinalizedBlockPayload{HeaderHash: headerHash})
+}
+
+func (qd *queuedDriver) enqueue(operation messages.Operation, payload proto.Message) error {
+	payloadBytes, err := proto.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	qd.mutQueue.Lock()
+	defer qd.mutQueue.Unlock()
+
+	if qd.closed {
+		return ErrQueueClosed
+	}
+
+	record := &messages.OutportMessage{
+		ID:        qd.tail,
+		Operation: operation,
+		Payload:   payloadBytes,
+	}
+	recordBytes, err := proto.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	recordSize := uint64(len(recordBytes))
+	if qd.isFull(recordSize) {
+		qd.metricsHandler.AddOutportQueueRejection(qd.name)
+		return fmt.Errorf("%w while queueing %s, queue: %s, pending records: %d, pending bytes: %d, record size: %d",
+			ErrQueueFull, operation, qd.name, qd.tail-qd.head, qd.pendingBytes, recordSize)
+	}
+
+	err = qd.persister.Put(recordKey(record.ID), recordBytes)
+	if err != nil {
+		return fmt.Errorf("%w while queueing %s", err, operation)
+	}
+	qd.tail++
+	qd.pendingBytes += recordSize
+	qd.updateSizeMetricUnprotected()
+
+	select {
+	case qd.chanNewRecord <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// isFull returns true if a record of the provided size would exceed the bounds of the queue. An empty queue accepts
+// any record
+func (qd *queuedDriver) isFull(recordSize uint64) bool {
+	numPendingRecords := qd.tail - qd.head
+	if numPendingRecords == 0 {
+		return false
+	}
+
+	return numPendingRecords+1 > qd.maxPendingRecords || qd.pendingBytes+recordSize > qd.maxPendingSizeInBytes
+}
+
+func (qd *queuedDriver) processLoop(ctx context.Context) {
+	defer close(qd.chanLoopDone)
+
+	for {
+		index, hasRecords := qd.firstRecordIndex()
+		if !hasRecords {
+			select {
+			case <-ctx.Done():
+				return
+			case <-qd.chanNewRecord:
+				continue
+			}
+		}
+
+		call, recordSize, err := qd.restoreCall(index)
+		if err != nil {
+			log.Error("queuedDriver: dropping the record which cannot be restored",
+				"driver", driverString(qd.driver),
+				"index", index,
+				"error", err)
+			qd.removeRecord(index, recordSize)
+			continue
+		}
+
+		delivered := qd.deliverBlocking(ctx, call)
+		if !delivered {
+			return
+		}
+
+		qd.removeRecord(index, recordSize)
+	}
+}
+
+func (qd *queuedDriver) firstRecordIndex() (uint64, bool) {
+	qd.mutQueue.RLock()
+	defer qd.mutQueue.RUnlock()
+
+	return qd.head, qd.head < qd.tail
+}
+
+type driverCall struct {
+	operation messages.Operation
+	handler   func() error
+}
+
+// restoreCall returns the driver call held by the provided record and the size of the record
+func (qd *queuedDriver) restoreCall(index uint64) (*driverCall, uint64, error) {
+	recordBytes, err := qd.persister.Get(recordKey(index))
+	if err != nil {
+		return nil, 0, err
+	}
+	recordSize := uint64(len(recordBytes))
+
+	record := &messages.OutportMessage{}
+	err = proto.Unmarshal(recordBytes, record)
+	if err != nil {
+		return nil, recordSize, err
+	}
+
+	handler, err := qd.restoreHandler(record)
+	if err != nil {
+		return nil, recordSize, err
+	}
+
+	return &driverCall{
+		operation: record.Operation,
+		handler:   handler,
+	}, recordSize, nil
+}
+
+func (qd *queuedDriver) restoreHandler(record *messages.OutportMessage) (func() error, error) {
+	switch record.Operation {
+	case messages.OperationSaveBlock:
+		payload := &messages.SaveBlockPayload{}
+		err := proto.Unmarshal(record.Payload, payload)
+		if err != nil {
+			return nil, err
+		}
+		args, err := qd.converter.RestoreSaveBlock(payload)
+		if err != nil {
+			return nil, err
+		}
+		if qd.alteredAccountsDriver != nil {
+			alteredAccounts, errRestore := messages.RestoreAlteredAccounts(payload.AlteredAccounts)
+			if errRestore != nil {
+				return nil, errRestore
+			}
+
+			return func() error {
+				return qd.alteredAccountsDriver.SaveBlockWithAlteredAccounts(args, alteredAccounts)
+			}, nil
+		}
+
+		return func() error {
+			return qd.driver.SaveBlock(args)
+		}, nil
+	case messages.OperationRevertIndexedBlock:
+		payload := &messages.RevertIndexedBlockPayload{}
+		err := proto.Unmarshal(record.Payload, payload)
+		if err != nil {
+			return nil, err
+		}
+		header, body, err := qd.converter.RestoreRevertIndexedBlock(payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() error {
+			return qd.driver.RevertIndexedBlock(header, body)
+		}, nil
+	case messages.OperationSaveRoundsInfo:
+		payload := &messages.RoundsInfoPayload{}
+		err := proto.Unmarshal(record.Payload, payload)
+		if err != nil {
+			return nil, err
+		}
+		roundsInfo := messages.RestoreRoundsInfo(payload)
+
+		return func() error {
+			return qd.driver.SaveRoundsInfo(roundsInfo)
+		}, nil
+	case messages.OperationSaveValidatorsPubKeys:
+		payload := &messages.ValidatorsPubKeysPayload{}
+		err := proto.Unmarshal(record.Payload, payload)
+		if err != nil {
+			return nil, err
+		}
+		validatorsPubKeys, epoch := messages.RestoreValidatorsPubKeys(payload)
+
+		return func() error {
+			return qd.driver.SaveValidatorsPubKeys(validatorsPubKeys, epoch)
+		}, nil
+	case messages.OperationSaveValidatorsRating:
+		payload := &messages.ValidatorsRatingPayload{}
+		err := proto.Unmarshal(record.Payload, payload)
+		if err != nil {
+			return nil, err
+		}
+		indexID, infoRating := messages.RestoreValidatorsRating(payload)
+
+		return func() error {
+			return qd.driver.SaveValidatorsRating(indexID, infoRating)
+		}, nil
+	case messages.OperationSaveAccounts:
+		payload := &messages.AccountsPayload{}
+		err := proto.Unmarshal(record.Payload, payload)
+		if err != nil {
+			return nil, err
+		}
+		blockTimestamp, accounts, err := messages.RestoreAccounts(payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() error {
+			return qd.driver.SaveAccounts(blockTimestamp, accounts)
+		}, nil
+	case messages.OperationFinalizedBlock:
+		payload := &messages.FinalizedBlockPayload{}
+		err := proto.Unmarshal(record.Payload, payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() error {
+			return qd.driver.FinalizedBlock(payload.HeaderHash)
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %d", messages.ErrUnknownOperation, record.Operation)
+	}
+}
+
+// deliverBlocking calls the driver until it succeeds. Returns false if the queue was closed in the meantime
+func (qd *queuedDriver) deliverBlocking(ctx context.Context, call *driverCall) bool {
+	for {
+		err := call.handler()
+		if err == nil {
+			return true
+		}
+
+		log.Error("error delivering queued record, will retry",
+			"driver", driverString(qd.driver),
+			"operation", call.operation.String(),
+			"retrial in", qd.retrialInterval,
+			"pending records", qd.NumPendingRecords(),
+			"error", err)
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(qd.retrialInterval):
+		}
+	}
+}
+
+func (qd *queuedDriver) removeRecord(index uint64, recordSize uint64) {
+	qd.mutQueue.Lock()
+	defer qd.mutQueue.Unlock()
+
+	err := qd.persister.Remove(recordKey(index))
+	if err != nil {
+		log.Warn("queuedDriver: cannot remove delivered record",
+			"driver", driverString(qd.driver),
+			"index", index,
+			"error", err)
+	}
+	qd.head = index + 1
+	qd.pendingBytes -= core.MinUint64(recordSize, qd.pendingBytes)
+	qd.updateSizeMetricUnprotected()
+}
+
+func (qd *queuedDriver) updateSizeMetric() {
+	qd.mutQueue.RLock()
+	defer qd.mutQueue.RUnlock()
+
+	qd.updateSizeMetricUnprotected()
+}
+
+func (qd *queuedDriver) updateSizeMetricUnprotected() {
+	qd.metricsHandler.SetOutportQueueSize(qd.name, qd.tail-qd.head, qd.pendingBytes)
+}
+
+// NumPendingRecords returns the number of records which were not yet delivered to the driver
+func (qd *queuedDriver) NumPendingRecords() uint64 {
+	qd.mutQueue.RLock()
+	defer qd.mutQueue.RUnlock()
+
+	return qd.tail - qd.head
+}
+
+// NumPendingBytes returns the size of the records which were not yet delivered to the driver
+func (qd *queuedDriver) NumPendingBytes() uint64 {
+	qd.mutQueue.RLock()
+	defer qd.mutQueue.RUnlock()
+
+	return qd.pendingBytes
+}
+
+// Close stops the delivery of the records and closes the driver and the persister. The pending records are
+// delivered after the node restarts
+func (qd *queuedDriver) Close() error {
+	qd.mutQueue.Lock()
+	if qd.closed {
+		qd.mutQueue.Unlock()
+		return nil
+	}
+	qd.closed = true
+	qd.mutQueue.Unlock()
+
+	qd.cancelFunc()
+	// closing the driver first unblocks an in-flight call
+	errDriver := qd.driver.Close()
+	<-qd.chanLoopDone
+
+	errPersister := qd.persister.Close()
+	if errDriver != nil {
+		return errDriver
+	}
+
+	return errPersister
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (qd *queuedDriver) IsInterfaceNil() bool {
+	return qd == nil
+}
+
+func recordKey(index uint64) []byte {
+	key := make([]byte, recordKeyLength)
+	binary.BigEndian.PutUint64(key, index)
+
+	return key
+}
+
+func driverString(driver outport.Driver) string {
+	return fmt.Sprintf("%T", driver)
+}
@@ -0,0 +1,568 @@
+package queue
+
+import (
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/outport/messages"
+	"github.com/ElrondNetwork/elrond-go/outport/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
+	"github.com/stretchr/testify/require"
+)
+
+const testTimeout = time.Second * 5
+
+type callsRecorder struct {
+	mut   sync.Mutex
+	calls []string
+}
+
+func (recorder *callsRecorder) add(call string) {
+	recorder.mut.Lock()
+	recorder.calls = append(recorder.calls, call)
+	recorder.mut.Unlock()
+}
+
+func (recorder *callsRecorder) get() []string {
+	recorder.mut.Lock()
+	defer recorder.mut.Unlock()
+
+	return append(make([]string, 0, len(recorder.calls)), recorder.calls...)
+}
+
+func createMockArgsQueuedDriver(driver *mock.DriverStub, persister storage.Persister) ArgsQueuedDriver {
+	return ArgsQueuedDriver{
+		Driver:                driver,
+		Persister:             persister,
+		Marshalizer:           &marshal.GogoProtoMarshalizer{},
+		MetricsHandler:        &statusHandler.MetricsHandlerStub{},
+		RetrialInterval:       minimumRetrialInterval,
+		Name:                  "test",
+		MaxPendingRecords:     1000,
+		MaxPendingSizeInBytes: 1024 * 1024,
+	}
+}
+
+func waitAllRecordsDelivered(t *testing.T, qd *queuedDriver) {
+	deadline := time.Now().Add(testTimeout)
+	for qd.NumPendingRecords() > 0 {
+		require.True(t, time.Now().Before(deadline), "records not delivered in due time")
+		time.Sleep(time.Millisecond * 5)
+	}
+}
+
+func TestNewQueuedDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil driver should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsQueuedDriver(nil, memorydb.New())
+		args.Driver = nil
+		qd, err := NewQueuedDriver(args)
+		require.True(t, check.IfNil(qd))
+		require.Equal(t, ErrNilDriver, err)
+	})
+	t.Run("nil persister should error", func(t *testing.T) {
+		t.Parallel()
+
+		qd, err := NewQueuedDriver(createMockArgsQueuedDriver(&mock.DriverStub{}, nil))
+		require.True(t, check.IfNil(qd))
+		require.Equal(t, ErrNilPersister, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsQueuedDriver(&mock.DriverStub{}, memorydb.New())
+		args.Marshalizer = nil
+		qd, err := NewQueuedDriver(args)
+		require.True(t, check.IfNil(qd))
+		require.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("nil metrics handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsQueuedDriver(&mock.DriverStub{}, memorydb.New())
+		args.MetricsHandler = nil
+		qd, err := NewQueuedDriver(args)
+		require.True(t, check.IfNil(qd))
+		require.Equal(t, ErrNilMetricsHandler, err)
+	})
+	t.Run("empty name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsQueuedDriver(&mock.DriverStub{}, memorydb.New())
+		args.Name = ""
+		qd, err := NewQueuedDriver(args)
+		require.True(t, check.IfNil(qd))
+		require.Equal(t, ErrEmptyName, err)
+	})
+	t.Run("invalid max pending records should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsQueuedDriver(&mock.DriverStub{}, memorydb.New())
+		args.MaxPendingRecords = 0
+		qd, err := NewQueuedDriver(args)
+		require.True(t, check.IfNil(qd))
+		require.Equal(t, ErrInvalidMaxPendingRecords, err)
+	})
+	t.Run("invalid max pending size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsQueuedDriver(&mock.DriverStub{}, memorydb.New())
+		args.MaxPendingSizeInBytes = 0
+		qd, err := NewQueuedDriver(args)
+		require.True(t, check.IfNil(qd))
+		require.Equal(t, ErrInvalidMaxPendingSizeInBytes, err)
+	})
+	t.Run("invalid retrial interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsQueuedDriver(&mock.DriverStub{}, memorydb.New())
+		args.RetrialInterval = time.Millisecond
+		qd, err := NewQueuedDriver(args)
+		require.True(t, check.IfNil(qd))
+		require.True(t, errors.Is(err, ErrInvalidRetrialInterval))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		qd, err := NewQueuedDriver(createMockArgsQueuedDriver(&mock.DriverStub{}, memorydb.New()))
+		require.False(t, check.IfNil(qd))
+		require.Nil(t, err)
+		require.Nil(t, qd.Close())
+	})
+}
+
+func TestQueuedDriver_ShouldDeliverAllOperationsInOrder(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{Nonce: 7, Value: big.NewInt(10), SndAddr: []byte("sender")}
+	txLog := &transaction.Log{Address: []byte("contract")}
+	header := &block.Header{Nonce: 37, Round: 38}
+	var receivedSaveBlockArgs *indexer.ArgsSaveBlockData
+	var receivedAccounts []data.UserAccountHandler
+	var receivedPubKeys map[uint32][][]byte
+
+	recorder := &callsRecorder{}
+	driver := &mock.DriverStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) error {
+			receivedSaveBlockArgs = args
+			recorder.add("SaveBlock")
+			return nil
+		},
+		RevertBlockCalled: func(_ data.HeaderHandler, _ data.BodyHandler) error {
+			recorder.add("RevertIndexedBlock")
+			return nil
+		},
+		SaveRoundsInfoCalled: func(_ []*indexer.RoundInfo) error {
+			recorder.add("SaveRoundsInfo")
+			return nil
+		},
+		SaveValidatorsPubKeysCalled: func(validatorsPubKeys map[uint32][][]byte, _ uint32) error {
+			receivedPubKeys = validatorsPubKeys
+			recorder.add("SaveValidatorsPubKeys")
+			return nil
+		},
+		SaveValidatorsRatingCalled: func(_ string, _ []*indexer.ValidatorRatingInfo) error {
+			recorder.add("SaveValidatorsRating")
+			return nil
+		},
+		SaveAccountsCalled: func(_ uint64, acc []data.UserAccountHandler) error {
+			receivedAccounts = acc
+			recorder.add("SaveAccounts")
+			return nil
+		},
+		FinalizedBlockCalled: func(_ []byte) error {
+			recorder.add("FinalizedBlock")
+			return nil
+		},
+	}
+	qd, _ := NewQueuedDriver(createMockArgsQueuedDriver(driver, memorydb.New()))
+	defer func() {
+		_ = qd.Close()
+	}()
+
+	account, _ := state.NewUserAccount([]byte("alice"))
+	_ = account.AddToBalance(big.NewInt(42))
+
+	require.Nil(t, qd.SaveBlock(&indexer.ArgsSaveBlockData{
+		HeaderHash: []byte("headerHash"),
+		Header:     header,
+		Body:       &block.Body{MiniBlocks: []*block.MiniBlock{{TxHashes: [][]byte{[]byte("txHash")}}}},
+		TransactionsPool: &indexer.Pool{
+			Txs:  map[string]data.TransactionHandler{"txHash": tx},
+			Logs: map[string]data.LogHandler{"txHash": txLog},
+		},
+	}))
+	require.Nil(t, qd.RevertIndexedBlock(&block.MetaBlock{Nonce: 2}, &block.Body{}))
+	require.Nil(t, qd.SaveRoundsInfo([]*indexer.RoundInfo{{Index: 8}}))
+	require.Nil(t, qd.SaveValidatorsPubKeys(map[uint32][][]byte{0: {[]byte("a")}}, 3))
+	require.Nil(t, qd.SaveValidatorsRating("0_3", []*indexer.ValidatorRatingInfo{{PublicKey: "a", Rating: 50}}))
+	require.Nil(t, qd.SaveAccounts(100, []data.UserAccountHandler{account}))
+	require.Nil(t, qd.FinalizedBlock([]byte("headerHash")))
+
+	waitAllRecordsDelivered(t, qd)
+	expectedCalls := []string{
+		"SaveBlock",
+		"RevertIndexedBlock",
+		"SaveRoundsInfo",
+		"SaveValidatorsPubKeys",
+		"SaveValidatorsRating",
+		"SaveAccounts",
+		"FinalizedBlock",
+	}
+	require.Equal(t, expectedCalls, recorder.get())
+
+	require.Equal(t, []byte("headerHash"), receivedSaveBlockArgs.HeaderHash)
+	require.Equal(t, header, receivedSaveBlockArgs.Header)
+	require.Equal(t, tx, receivedSaveBlockArgs.TransactionsPool.Txs["txHash"])
+	require.Equal(t, txLog, receivedSaveBlockArgs.TransactionsPool.Logs["txHash"])
+	require.Equal(t, map[uint32][][]byte{0: {[]byte("a")}}, receivedPubKeys)
+	require.Len(t, receivedAccounts, 1)
+	require.Equal(t, []byte("alice"), receivedAccounts[0].AddressBytes())
+	require.Equal(t, big.NewInt(42), receivedAccounts[0].GetBalance())
+}
+
+type alteredAccountsDriverStub struct {
+	*mock.DriverStub
+	RecordAlteredAccountsCalled        func(args *indexer.ArgsSaveBlockData) []data.UserAccountHandler
+	SaveBlockWithAlteredAccountsCalled func(args *indexer.ArgsSaveBlockData, alteredAccounts []data.UserAccountHandler) error
+}
+
+func (stub *alteredAccountsDriverStub) RecordAlteredAccounts(args *indexer.ArgsSaveBlockData) []data.UserAccountHandler {
+	return stub.RecordAlteredAccountsCalled(args)
+}
+
+func (stub *alteredAccountsDriverStub) SaveBlockWithAlteredAccounts(args *indexer.ArgsSaveBlockData, alteredAccounts []data.UserAccountHandler) error {
+	return stub.SaveBlockWithAlteredAccountsCalled(args, alteredAccounts)
+}
+
+func TestQueuedDriver_ShouldDeliverTheAlteredAccountsRecordedWhenTheBlockWasQueued(t *testing.T) {
+	t.Parallel()
+
+	chanDeliver := make(chan struct{})
+	var alteredAccounts []data.UserAccountHandler
+	recorder := &callsRecorder{}
+	driver := &alteredAccountsDriverStub{
+		DriverStub: &mock.DriverStub{
+			SaveBlockCalled: func(_ *indexer.ArgsSaveBlockData) error {
+				recorder.add("SaveBlock")
+				return nil
+			},
+		},
+		RecordAlteredAccountsCalled: func(_ *indexer.ArgsSaveBlockData) []data.UserAccountHandler {
+			account, _ := state.NewUserAccount([]byte("alice"))
+			account.IncreaseNonce(3)
+			_ = account.AddToBalance(big.NewInt(42))
+
+			return []data.UserAccountHandler{account}
+		},
+		SaveBlockWithAlteredAccountsCalled: func(args *indexer.ArgsSaveBlockData, accounts []data.UserAccountHandler) error {
+			<-chanDeliver
+			alteredAccounts = accounts
+			recorder.add("SaveBlockWithAlteredAccounts")
+			return nil
+		},
+	}
+	args := createMockArgsQueuedDriver(nil, memorydb.New())
+	args.Driver = driver
+	qd, _ := NewQueuedDriver(args)
+	defer func() {
+		_ = qd.Close()
+	}()
+
+	require.Nil(t, qd.SaveBlock(&indexer.ArgsSaveBlockData{Header: &block.Header{Nonce: 1}, Body: &block.Body{}}))
+	driver.RecordAlteredAccountsCalled = func(_ *indexer.ArgsSaveBlockData) []data.UserAccountHandler {
+		require.Fail(t, "the altered accounts should be recorded only when the block is queued")
+		return nil
+	}
+	close(chanDeliver)
+
+	waitAllRecordsDelivered(t, qd)
+	require.Equal(t, []string{"SaveBlockWithAlteredAccounts"}, recorder.get())
+	require.Len(t, alteredAccounts, 1)
+	require.Equal(t, []byte("alice"), alteredAccounts[0].AddressBytes())
+	require.Equal(t, uint64(3), alteredAccounts[0].GetNonce())
+	require.Equal(t, big.NewInt(42), alteredAccounts[0].GetBalance())
+}
+
+func TestQueuedDriver_FailingDriverShouldNotBlockTheCallsAndShouldRetry(t *testing.T) {
+	t.Parallel()
+
+	chanRelease := make(chan struct{})
+	recorder := &callsRecorder{}
+	driver := &mock.DriverStub{
+		FinalizedBlockCalled: func(headerHash []byte) error {
+			select {
+			case <-chanRelease:
+				recorder.add(string(headerHash))
+				return nil
+			default:
+				return errors.New("indexer is down")
+			}
+		},
+	}
+	qd, _ := NewQueuedDriver(createMockArgsQueuedDriver(driver, memorydb.New()))
+	defer func() {
+		_ = qd.Close()
+	}()
+
+	require.Nil(t, qd.FinalizedBlock([]byte("a")))
+	require.Nil(t, qd.FinalizedBlock([]byte("b")))
+	require.Nil(t, qd.FinalizedBlock([]byte("c")))
+	require.Equal(t, uint64(3), qd.NumPendingRecords())
+
+	close(chanRelease)
+	waitAllRecordsDelivered(t, qd)
+	require.Equal(t, []string{"a", "b", "c"}, recorder.get())
+}
+
+func TestQueuedDriver_PendingRecordsShouldBeDeliveredAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "outportQueue")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	persister, err := leveldb.NewDB(dir, 1, 1, 10)
+	require.Nil(t, err)
+	failingDriver := &mock.DriverStub{
+		FinalizedBlockCalled: func(_ []byte) error {
+			return errors.New("indexer is down")
+		},
+	}
+	qd, _ := NewQueuedDriver(createMockArgsQueuedDriver(failingDriver, persister))
+	require.Nil(t, qd.FinalizedBlock([]byte("a")))
+	require.Nil(t, qd.FinalizedBlock([]byte("b")))
+	require.Nil(t, qd.Close())
+	require.Equal(t, ErrQueueClosed, qd.FinalizedBlock([]byte("c")))
+
+	persister, err = leveldb.NewDB(dir, 1, 1, 10)
+	require.Nil(t, err)
+	recorder := &callsRecorder{}
+	driver := &mock.DriverStub{
+		FinalizedBlockCalled: func(headerHash []byte) error {
+			recorder.add(string(headerHash))
+			return nil
+		},
+	}
+	qd, _ = NewQueuedDriver(createMockArgsQueuedDriver(driver, persister))
+	defer func() {
+		_ = qd.Close()
+	}()
+
+	require.Nil(t, qd.FinalizedBlock([]byte("c")))
+	waitAllRecordsDelivered(t, qd)
+	require.Equal(t, []string{"a", "b", "c"}, recorder.get())
+}
+
+func TestQueuedDriver_RecordWhichCannotBeRestoredShouldBeDropped(t *testing.T) {
+	t.Parallel()
+
+	persister := memorydb.New()
+	_ = persister.Put(recordKey(0), []byte("not a record"))
+	record, _ := (&marshal.GogoProtoMarshalizer{}).Marshal(&block.Header{})
+	_ = persister.Put(recordKey(1), record)
+
+	recorder := &callsRecorder{}
+	driver := &mock.DriverStub{
+		FinalizedBlockCalled: func(headerHash []byte) error {
+			recorder.add(string(headerHash))
+			return nil
+		},
+	}
+	qd, _ := NewQueuedDriver(createMockArgsQueuedDriver(driver, persister))
+	defer func() {
+		_ = qd.Close()
+	}()
+
+	require.Nil(t, qd.FinalizedBlock([]byte("a")))
+	waitAllRecordsDelivered(t, qd)
+	require.Equal(t, []string{"a"}, recorder.get())
+	numRecords := 0
+	persister.RangeKeys(func(_ []byte, _ []byte) bool {
+		numRecords++
+		return true
+	})
+	require.Equal(t, 0, numRecords)
+}
+
+func createDownDriver() *mock.DriverStub {
+	return &mock.DriverStub{
+		FinalizedBlockCalled: func(_ []byte) error {
+			return errors.New("indexer is down")
+		},
+	}
+}
+
+func TestQueuedDriver_MaxPendingRecordsReachedShouldRejectTheCall(t *testing.T) {
+	t.Parallel()
+
+	numRejections := 0
+	args := createMockArgsQueuedDriver(createDownDriver(), memorydb.New())
+	args.MaxPendingRecords = 2
+	args.MetricsHandler = &statusHandler.MetricsHandlerStub{
+		AddOutportQueueRejectionCalled: func(driver string) {
+			require.Equal(t, "test", driver)
+			numRejections++
+		},
+	}
+	qd, _ := NewQueuedDriver(args)
+	defer func() {
+		_ = qd.Close()
+	}()
+
+	require.Nil(t, qd.FinalizedBlock([]byte("a")))
+	require.Nil(t, qd.FinalizedBlock([]byte("b")))
+	err := qd.FinalizedBlock([]byte("c"))
+	require.True(t, errors.Is(err, ErrQueueFull))
+	require.Equal(t, uint64(2), qd.NumPendingRecords())
+	require.Equal(t, 1, numRejections)
+}
+
+func TestQueuedDriver_MaxPendingSizeReachedShouldRejectTheCall(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsQueuedDriver(createDownDriver(), memorydb.New())
+	args.MaxPendingSizeInBytes = 100
+	qd, _ := NewQueuedDriver(args)
+	defer func() {
+		_ = qd.Close()
+	}()
+
+	require.Nil(t, qd.FinalizedBlock(make([]byte, 60)))
+	pendingBytes := qd.NumPendingBytes()
+	require.True(t, pendingBytes > 60)
+
+	err := qd.FinalizedBlock(make([]byte, 60))
+	require.True(t, errors.Is(err, ErrQueueFull))
+	require.Equal(t, uint64(1), qd.NumPendingRecords())
+	require.Equal(t, pendingBytes, qd.NumPendingBytes())
+}
+
+func TestQueuedDriver_EmptyQueueShouldAcceptARecordLargerThanTheMaxSize(t *testing.T) {
+	t.Parallel()
+
+	recorder := &callsRecorder{}
+	driver := &mock.DriverStub{
+		FinalizedBlockCalled: func(headerHash []byte) error {
+			recorder.add(string(headerHash))
+			return nil
+		},
+	}
+	args := createMockArgsQueuedDriver(driver, memorydb.New())
+	args.MaxPendingSizeInBytes = 10
+	qd, _ := NewQueuedDriver(args)
+	defer func() {
+		_ = qd.Close()
+	}()
+
+	largeHash := string(make([]byte, 50))
+	require.Nil(t, qd.FinalizedBlock([]byte(largeHash)))
+	waitAllRecordsDelivered(t, qd)
+	require.Equal(t, []string{largeHash}, recorder.get())
+	require.Equal(t, uint64(0), qd.NumPendingBytes())
+}
+
+func TestQueuedDriver_SizeMetricShouldFollowThePendingRecords(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "outportQueue")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	persister, err := leveldb.NewDB(dir, 1, 1, 10)
+	require.Nil(t, err)
+	qd, _ := NewQueuedDriver(createMockArgsQueuedDriver(createDownDriver(), persister))
+	require.Nil(t, qd.FinalizedBlock([]byte("a")))
+	require.Nil(t, qd.FinalizedBlock([]byte("b")))
+	pendingBytes := qd.NumPendingBytes()
+	require.Nil(t, qd.Close())
+
+	var mutMetric sync.Mutex
+	lastRecords, lastBytes := uint64(0), uint64(0)
+	chanRelease := make(chan struct{})
+	persister, err = leveldb.NewDB(dir, 1, 1, 10)
+	require.Nil(t, err)
+	args := createMockArgsQueuedDriver(&mock.DriverStub{
+		FinalizedBlockCalled: func(_ []byte) error {
+			<-chanRelease
+			return nil
+		},
+	}, persister)
+	args.MetricsHandler = &statusHandler.MetricsHandlerStub{
+		SetOutportQueueSizeCalled: func(_ string, numRecords uint64, numBytes uint64) {
+			mutMetric.Lock()
+			lastRecords, lastBytes = numRecords, numBytes
+			mutMetric.Unlock()
+		},
+	}
+	qd, _ = NewQueuedDriver(args)
+	defer func() {
+		_ = qd.Close()
+	}()
+
+	require.Equal(t, uint64(2), qd.NumPendingRecords())
+	require.Equal(t, pendingBytes, qd.NumPendingBytes())
+	mutMetric.Lock()
+	require.Equal(t, uint64(2), lastRecords)
+	require.Equal(t, pendingBytes, lastBytes)
+	mutMetric.Unlock()
+
+	close(chanRelease)
+	waitAllRecordsDelivered(t, qd)
+	mutMetric.Lock()
+	require.Equal(t, uint64(0), lastRecords)
+	require.Equal(t, uint64(0), lastBytes)
+	mutMetric.Unlock()
+}
+
+func TestQueuedDriver_CloseShouldCloseTheDriver(t *testing.T) {
+	t.Parallel()
+
+	numCloseCalls := 0
+	driver := &mock.DriverStub{
+		CloseCalled: func() error {
+			numCloseCalls++
+			return nil
+		},
+	}
+	qd, _ := NewQueuedDriver(createMockArgsQueuedDriver(driver, memorydb.New()))
+
+	require.Nil(t, qd.Close())
+	require.Nil(t, qd.Close())
+	require.Equal(t, 1, numCloseCalls)
+}
+
+func TestQueuedDriver_UnknownOperationShouldError(t *testing.T) {
+	t.Parallel()
+
+	qd, _ := NewQueuedDriver(createMockArgsQueuedDriver(&mock.DriverStub{}, memorydb.New()))
+	defer func() {
+		_ = qd.Close()
+	}()
+
+	handler, err := qd.restoreHandler(&messages.OutportMessage{Operation: messages.OperationUnknown})
+	require.Nil(t, handler)
+	require.True(t, errors.Is(err, messages.ErrUnknownOperation))
+}
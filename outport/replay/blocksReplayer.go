@@ -0,0 +1,290 @@
+package replay
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/outport"
+)
+
+var log = logger.GetOrCreate("outport/replay")
+
+// ArgsBlocksReplayer holds the arguments needed to create a blocks replayer
+type ArgsBlocksReplayer struct {
+	Storage                  dataRetriever.StorageService
+	Marshalizer              marshal.Marshalizer
+	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	OutportHandler           outport.OutportHandler
+	ShardID                  uint32
+}
+
+// blocksReplayer reads the committed blocks from storage and re-emits them towards the outport drivers
+type blocksReplayer struct {
+	storage                  dataRetriever.StorageService
+	marshalizer              marshal.Marshalizer
+	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	outportHandler           outport.OutportHandler
+	shardID                  uint32
+}
+
+// NewBlocksReplayer creates a new blocks replayer
+func NewBlocksReplayer(args ArgsBlocksReplayer) (*blocksReplayer, error) {
+	if check.IfNil(args.Storage) {
+		return nil, ErrNilStorageService
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Uint64ByteSliceConverter) {
+		return nil, ErrNilUint64Converter
+	}
+	if check.IfNil(args.OutportHandler) {
+		return nil, ErrNilOutportHandler
+	}
+
+	return &blocksReplayer{
+		storage:                  args.Storage,
+		marshalizer:              args.Marshalizer,
+		uint64ByteSliceConverter: args.Uint64ByteSliceConverter,
+		outportHandler:           args.OutportHandler,
+		shardID:                  args.ShardID,
+	}, nil
+}
+
+// Replay re-emits the blocks having the nonces in the [startNonce, endNonce] range, in order. Each block is saved and
+// then finalized. The signers indexes and the gas consumption of the blocks are not kept in storage, so they are not
+// re-emitted, and neither are the receipts. The accounts altered by a block are served to the elastic and the covalent
+// indexers only if the state of the block was not pruned. The live drivers, such as the subscriptions hub, do not
+// receive the replayed blocks
+func (br *blocksReplayer) Replay(startNonce uint64, endNonce uint64) error {
+	if startNonce > endNonce {
+		return fmt.Errorf("%w: start nonce %d is greater than end nonce %d", ErrInvalidNoncesRange, startNonce, endNonce)
+	}
+	if !br.outportHandler.HasReplayDrivers() {
+		log.Warn("blocksReplayer: no outport driver accepting replayed blocks is enabled, nothing to replay")
+		return nil
+	}
+
+	log.Info("replaying blocks towards the outport drivers", "start nonce", startNonce, "end nonce", endNonce)
+	for nonce := startNonce; nonce <= endNonce; nonce++ {
+		args, err := br.createSaveBlockArgs(nonce)
+		if err != nil {
+			return fmt.Errorf("%w while replaying the block with nonce %d", err, nonce)
+		}
+
+		br.outportHandler.ReplayBlock(args)
+		log.Debug("replayed block", "nonce", nonce, "hash", args.HeaderHash)
+
+		if nonce == endNonce {
+			// avoids the overflow when endNonce is the maximum uint64 value
+			break
+		}
+	}
+
+	log.Info("replayed blocks towards the outport drivers", "start nonce", startNonce, "end nonce", endNonce)
+
+	return nil
+}
+
+func (br *blocksReplayer) createSaveBlockArgs(nonce uint64) (*indexer.ArgsSaveBlockData, error) {
+	headerHash, header, miniBlockHeaders, err := br.getHeaderByNonce(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	body := &block.Body{}
+	pool := &indexer.Pool{
+		Txs:      make(map[string]data.TransactionHandler),
+		Scrs:     make(map[string]data.TransactionHandler),
+		Rewards:  make(map[string]data.TransactionHandler),
+		Invalid:  make(map[string]data.TransactionHandler),
+		Receipts: make(map[string]data.TransactionHandler),
+		Logs:     make(map[string]data.LogHandler),
+	}
+	epoch := header.GetEpoch()
+	for _, miniBlockHeader := range miniBlockHeaders {
+		miniBlock, errGet := br.getMiniBlock(miniBlockHeader.Hash, epoch)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+		errGet = br.addTransactions(pool, miniBlock, epoch)
+		if errGet != nil {
+			return nil, errGet
+		}
+	}
+
+	err = br.addLogs(pool, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &indexer.ArgsSaveBlockData{
+		HeaderHash:       headerHash,
+		Body:             body,
+		Header:           header,
+		TransactionsPool: pool,
+	}, nil
+}
+
+func (br *blocksReplayer) getHeaderByNonce(nonce uint64) ([]byte, data.HeaderHandler, []block.MiniBlockHeader, error) {
+	nonceUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(br.shardID)
+	headerUnit := dataRetriever.BlockHeaderUnit
+	if br.shardID == core.MetachainShardId {
+		nonceUnit = dataRetriever.MetaHdrNonceHashDataUnit
+		headerUnit = dataRetriever.MetaBlockUnit
+	}
+
+	headerHash, err := br.storage.Get(nonceUnit, br.uint64ByteSliceConverter.ToByteSlice(nonce))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	headerBytes, err := br.storage.Get(headerUnit, headerHash)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if br.shardID == core.MetachainShardId {
+		metaBlock := &block.MetaBlock{}
+		err = br.marshalizer.Unmarshal(metaBlock, headerBytes)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		return headerHash, metaBlock, metaBlock.MiniBlockHeaders, nil
+	}
+
+	header := &block.Header{}
+	err = br.marshalizer.Unmarshal(header, headerBytes)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return headerHash, header, header.MiniBlockHeaders, nil
+}
+
+func (br *blocksReplayer) getMiniBlock(hash []byte, epoch uint32) (*block.MiniBlock, error) {
+	miniBlockBytes, err := br.storage.GetStorer(dataRetriever.MiniBlockUnit).GetFromEpoch(hash, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = br.marshalizer.Unmarshal(miniBlock, miniBlockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return miniBlock, nil
+}
+
+func (br *blocksReplayer) addTransactions(pool *indexer.Pool, miniBlock *block.MiniBlock, epoch uint32) error {
+	switch miniBlock.Type {
+	case block.TxBlock:
+		return br.getTransactions(pool.Txs, miniBlock.TxHashes, epoch, dataRetriever.TransactionUnit, createTransaction)
+	case block.InvalidBlock:
+		return br.getTransactions(pool.Invalid, miniBlock.TxHashes, epoch, dataRetriever.TransactionUnit, createTransaction)
+	case block.SmartContractResultBlock:
+		return br.getTransactions(pool.Scrs, miniBlock.TxHashes, epoch, dataRetriever.UnsignedTransactionUnit, createSmartContractResult)
+	case block.RewardsBlock:
+		return br.getTransactions(pool.Rewards, miniBlock.TxHashes, epoch, dataRetriever.RewardTransactionUnit, createRewardTransaction)
+	default:
+		return nil
+	}
+}
+
+func (br *blocksReplayer) getTransactions(
+	txs map[string]data.TransactionHandler,
+	hashes [][]byte,
+	epoch uint32,
+	unit dataRetriever.UnitType,
+	createTx func() data.TransactionHandler,
+) error {
+	if len(hashes) == 0 {
+		return nil
+	}
+
+	txsBytes, err := br.storage.GetStorer(unit).GetBulkFromEpoch(hashes, epoch)
+	if err != nil {
+		return err
+	}
+
+	for _, hash := range hashes {
+		txBytes, found := txsBytes[string(hash)]
+		if !found {
+			return fmt.Errorf("transaction %x not found in storage unit %s", hash, unit.String())
+		}
+
+		tx := createTx()
+		err = br.marshalizer.Unmarshal(tx, txBytes)
+		if err != nil {
+			return err
+		}
+
+		txs[string(hash)] = tx
+	}
+
+	return nil
+}
+
+// addLogs adds the logs of the transactions and of the smart contract results. Not all of them have logs, so the
+// missing ones are skipped
+func (br *blocksReplayer) addLogs(pool *indexer.Pool, epoch uint32) error {
+	hashes := make([][]byte, 0, len(pool.Txs)+len(pool.Scrs))
+	for hash := range pool.Txs {
+		hashes = append(hashes, []byte(hash))
+	}
+	for hash := range pool.Scrs {
+		hashes = append(hashes, []byte(hash))
+	}
+	if len(hashes) == 0 {
+		return nil
+	}
+
+	logsBytes, err := br.storage.GetStorer(dataRetriever.TxLogsUnit).GetBulkFromEpoch(hashes, epoch)
+	if err != nil {
+		return err
+	}
+
+	for hash, logBytes := range logsBytes {
+		txLog := &transaction.Log{}
+		err = br.marshalizer.Unmarshal(txLog, logBytes)
+		if err != nil {
+			return err
+		}
+
+		pool.Logs[hash] = txLog
+	}
+
+	return nil
+}
+
+func createTransaction() data.TransactionHandler {
+	return &transaction.Transaction{}
+}
+
+func createSmartContractResult() data.TransactionHandler {
+	return &smartContractResult.SmartContractResult{}
+}
+
+func createRewardTransaction() data.TransactionHandler {
+	return &rewardTx.RewardTx{}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (br *blocksReplayer) IsInterfaceNil() bool {
+	return br == nil
+}
@@ -0,0 +1,303 @@
+package replay
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/alteredAccounts"
+	"github.com/ElrondNetwork/elrond-go/outport/mock"
+	"github.com/ElrondNetwork/elrond-go/outport/queue"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+var testMarshalizer = &marshal.GogoProtoMarshalizer{}
+
+func createMockArgsBlocksReplayer() ArgsBlocksReplayer {
+	outportHandler, _ := outport.NewOutport(time.Millisecond * 10)
+
+	return ArgsBlocksReplayer{
+		Storage:                  genericMocks.NewChainStorerMock(0),
+		Marshalizer:              testMarshalizer,
+		Uint64ByteSliceConverter: uint64ByteSlice.NewBigEndianConverter(),
+		OutportHandler:           outportHandler,
+		ShardID:                  0,
+	}
+}
+
+func putMarshaled(t *testing.T, storer *genericMocks.StorerMock, key []byte, obj interface{}) {
+	buff, err := testMarshalizer.Marshal(obj)
+	require.Nil(t, err)
+	require.Nil(t, storer.Put(key, buff))
+}
+
+func TestNewBlocksReplayer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storage should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksReplayer()
+		args.Storage = nil
+		br, err := NewBlocksReplayer(args)
+		require.True(t, check.IfNil(br))
+		require.Equal(t, ErrNilStorageService, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksReplayer()
+		args.Marshalizer = nil
+		br, err := NewBlocksReplayer(args)
+		require.True(t, check.IfNil(br))
+		require.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksReplayer()
+		args.Uint64ByteSliceConverter = nil
+		br, err := NewBlocksReplayer(args)
+		require.True(t, check.IfNil(br))
+		require.Equal(t, ErrNilUint64Converter, err)
+	})
+	t.Run("nil outport handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksReplayer()
+		args.OutportHandler = nil
+		br, err := NewBlocksReplayer(args)
+		require.True(t, check.IfNil(br))
+		require.Equal(t, ErrNilOutportHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		br, err := NewBlocksReplayer(createMockArgsBlocksReplayer())
+		require.False(t, check.IfNil(br))
+		require.Nil(t, err)
+	})
+}
+
+func TestBlocksReplayer_ReplayInvalidRangeShouldError(t *testing.T) {
+	t.Parallel()
+
+	br, _ := NewBlocksReplayer(createMockArgsBlocksReplayer())
+
+	err := br.Replay(5, 4)
+	require.True(t, errors.Is(err, ErrInvalidNoncesRange))
+}
+
+func TestBlocksReplayer_ReplayShouldReEmitTheBlocksFromStorage(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsBlocksReplayer()
+	storage := args.Storage.(*genericMocks.ChainStorerMock)
+
+	tx := &transaction.Transaction{Nonce: 1, Value: big.NewInt(10)}
+	scr := &smartContractResult.SmartContractResult{Nonce: 2, Value: big.NewInt(3)}
+	reward := &rewardTx.RewardTx{Round: 4, Value: big.NewInt(5)}
+	txLog := &transaction.Log{Address: []byte("contract")}
+	putMarshaled(t, storage.Transactions, []byte("txHash"), tx)
+	putMarshaled(t, storage.Unsigned, []byte("scrHash"), scr)
+	putMarshaled(t, storage.Rewards, []byte("rewardHash"), reward)
+	putMarshaled(t, storage.HdrNonce, []byte("txHash"), txLog)
+
+	miniBlocks := []*block.MiniBlock{
+		{Type: block.TxBlock, TxHashes: [][]byte{[]byte("txHash")}},
+		{Type: block.SmartContractResultBlock, TxHashes: [][]byte{[]byte("scrHash")}},
+		{Type: block.RewardsBlock, TxHashes: [][]byte{[]byte("rewardHash")}},
+	}
+	header := &block.Header{Nonce: 7}
+	for i, miniBlock := range miniBlocks {
+		hash := []byte{byte(i)}
+		putMarshaled(t, storage.HdrNonce, hash, miniBlock)
+		header.MiniBlockHeaders = append(header.MiniBlockHeaders, block.MiniBlockHeader{Hash: hash, Type: miniBlock.Type})
+	}
+	putMarshaled(t, storage.HdrNonce, []byte("headerHash7"), header)
+	putMarshaled(t, storage.HdrNonce, []byte("headerHash8"), &block.Header{Nonce: 8})
+	_ = storage.HdrNonce.Put(args.Uint64ByteSliceConverter.ToByteSlice(7), []byte("headerHash7"))
+	_ = storage.HdrNonce.Put(args.Uint64ByteSliceConverter.ToByteSlice(8), []byte("headerHash8"))
+
+	savedBlocks := make([]*indexer.ArgsSaveBlockData, 0)
+	finalizedBlocks := make([]string, 0)
+	_ = args.OutportHandler.SubscribeDriver(&mock.DriverStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) error {
+			savedBlocks = append(savedBlocks, args)
+			return nil
+		},
+		FinalizedBlockCalled: func(headerHash []byte) error {
+			finalizedBlocks = append(finalizedBlocks, string(headerHash))
+			return nil
+		},
+	})
+	br, _ := NewBlocksReplayer(args)
+
+	err := br.Replay(7, 8)
+	require.Nil(t, err)
+	require.Equal(t, []string{"headerHash7", "headerHash8"}, finalizedBlocks)
+	require.Len(t, savedBlocks, 2)
+
+	saved := savedBlocks[0]
+	require.Equal(t, []byte("headerHash7"), saved.HeaderHash)
+	require.Equal(t, header, saved.Header)
+	require.Equal(t, &block.Body{MiniBlocks: miniBlocks}, saved.Body)
+	require.Equal(t, tx, saved.TransactionsPool.Txs["txHash"])
+	require.Equal(t, scr, saved.TransactionsPool.Scrs["scrHash"])
+	require.Equal(t, reward, saved.TransactionsPool.Rewards["rewardHash"])
+	require.Equal(t, txLog, saved.TransactionsPool.Logs["txHash"])
+	require.Len(t, saved.TransactionsPool.Logs, 1)
+
+	require.Equal(t, &block.Header{Nonce: 8}, savedBlocks[1].Header)
+}
+
+func TestBlocksReplayer_ReplayShouldDeliverTheBlocksToTheQueuedAlteredAccountsDrivers(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsBlocksReplayer()
+	storage := args.Storage.(*genericMocks.ChainStorerMock)
+
+	tx := &transaction.Transaction{Nonce: 1, Value: big.NewInt(10), SndAddr: []byte("alice")}
+	putMarshaled(t, storage.Transactions, []byte("txHash"), tx)
+	miniBlock := &block.MiniBlock{Type: block.TxBlock, TxHashes: [][]byte{[]byte("txHash")}}
+	putMarshaled(t, storage.HdrNonce, []byte("miniBlockHash"), miniBlock)
+	header := &block.Header{
+		Nonce:            7,
+		RootHash:         []byte("root7"),
+		MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("miniBlockHash"), Type: block.TxBlock}},
+	}
+	putMarshaled(t, storage.HdrNonce, []byte("headerHash7"), header)
+	_ = storage.HdrNonce.Put(args.Uint64ByteSliceConverter.ToByteSlice(7), []byte("headerHash7"))
+
+	// alice had the nonce 1 right after the block 7 and the nonce 5 in the current state
+	aliceAtBlock7, _ := state.NewUserAccount([]byte("alice"))
+	aliceAtBlock7.IncreaseNonce(1)
+	aliceNow, _ := state.NewUserAccount([]byte("alice"))
+	aliceNow.IncreaseNonce(5)
+	recorder, _ := alteredAccounts.NewAlteredAccountsRecorder(alteredAccounts.ArgsAlteredAccountsRecorder{
+		Accounts: &stateMock.AccountsStub{
+			GetTrieCalled: func(rootHash []byte) (common.Trie, error) {
+				require.Equal(t, []byte("root7"), rootHash)
+				return &trie.TrieStub{
+					GetCalled: func(key []byte) ([]byte, error) {
+						return testMarshalizer.Marshal(aliceAtBlock7)
+					},
+				}, nil
+			},
+			GetAccountFromBytesCalled: func(address []byte, accountBytes []byte) (vmcommon.AccountHandler, error) {
+				account, _ := state.NewUserAccount(address)
+				err := testMarshalizer.Unmarshal(account, accountBytes)
+				return account, err
+			},
+			LoadAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				return aliceNow, nil
+			},
+		},
+		ShardCoordinator: testscommon.NewMultiShardsCoordinatorMock(1),
+		NumServedBlocks:  1,
+	})
+
+	// the driver loads the altered accounts while saving a block, as the elastic and the covalent indexers do
+	chanSavedBlock := make(chan *indexer.ArgsSaveBlockData, 1)
+	var loadedAccount data.UserAccountHandler
+	driver, _ := alteredAccounts.NewAlteredAccountsDriver(alteredAccounts.ArgsAlteredAccountsDriver{
+		Driver: &mock.DriverStub{
+			SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) error {
+				account, err := recorder.LoadAccount([]byte("alice"))
+				require.Nil(t, err)
+				loadedAccount = account.(data.UserAccountHandler)
+				chanSavedBlock <- args
+				return nil
+			},
+		},
+		Server: recorder,
+	})
+	queuedDriver, err := queue.NewQueuedDriver(queue.ArgsQueuedDriver{
+		Driver:                driver,
+		Persister:             memorydb.New(),
+		Marshalizer:           testMarshalizer,
+		MetricsHandler:        &statusHandler.MetricsHandlerStub{},
+		RetrialInterval:       time.Millisecond * 10,
+		Name:                  "elastic",
+		MaxPendingRecords:     10,
+		MaxPendingSizeInBytes: 1024 * 1024,
+	})
+	require.Nil(t, err)
+	defer func() {
+		_ = queuedDriver.Close()
+	}()
+	_ = args.OutportHandler.SubscribeDriver(queuedDriver)
+	br, _ := NewBlocksReplayer(args)
+
+	err = br.Replay(7, 7)
+	require.Nil(t, err)
+
+	select {
+	case saved := <-chanSavedBlock:
+		require.Equal(t, []byte("headerHash7"), saved.HeaderHash)
+		require.Equal(t, tx, saved.TransactionsPool.Txs["txHash"])
+	case <-time.After(time.Second * 5):
+		require.Fail(t, "the replayed block was not delivered")
+	}
+	require.Equal(t, []byte("alice"), loadedAccount.AddressBytes())
+	require.Equal(t, uint64(1), loadedAccount.GetNonce())
+}
+
+func TestBlocksReplayer_ReplayMissingBlockShouldError(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsBlocksReplayer()
+	args.ShardID = core.MetachainShardId
+	_ = args.OutportHandler.SubscribeDriver(&mock.DriverStub{})
+	br, _ := NewBlocksReplayer(args)
+
+	err := br.Replay(1, 1)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "nonce 1")
+}
+
+func TestBlocksReplayer_ReplayWithoutDriversShouldNotReadTheStorage(t *testing.T) {
+	t.Parallel()
+
+	br, _ := NewBlocksReplayer(createMockArgsBlocksReplayer())
+
+	err := br.Replay(1, 1)
+	require.Nil(t, err)
+}
+
+func TestBlocksReplayer_ReplayWithOnlyLiveDriversShouldNotReadTheStorage(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsBlocksReplayer()
+	_ = args.OutportHandler.SubscribeLiveDriver(&mock.DriverStub{
+		SaveBlockCalled: func(args *indexer.ArgsSaveBlockData) error {
+			require.Fail(t, "a live driver should not receive the replayed blocks")
+			return nil
+		},
+	})
+	br, _ := NewBlocksReplayer(args)
+
+	err := br.Replay(1, 1)
+	require.Nil(t, err)
+}
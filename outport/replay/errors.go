@@ -0,0 +1,18 @@
+package replay
+
+import "errors"
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilUint64Converter signals that a nil uint64 <-> byte slice converter has been provided
+var ErrNilUint64Converter = errors.New("nil uint64 converter")
+
+// ErrNilOutportHandler signals that a nil outport handler has been provided
+var ErrNilOutportHandler = errors.New("nil outport handler")
+
+// ErrInvalidNoncesRange signals that an invalid nonces range has been provided
+var ErrInvalidNoncesRange = errors.New("invalid nonces range")
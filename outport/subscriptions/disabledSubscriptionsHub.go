@@ -0,0 +1,24 @@
+package subscriptions
+
+type disabledSubscriptionsHub struct {
+}
+
+// NewDisabledSubscriptionsHub returns a hub implementation that rejects all the subscriptions
+func NewDisabledSubscriptionsHub() *disabledSubscriptionsHub {
+	return &disabledSubscriptionsHub{}
+}
+
+// Subscribe returns ErrSubscriptionsDisabled
+func (dsh *disabledSubscriptionsHub) Subscribe(_ *SubscriptionRequest) (Subscription, error) {
+	return nil, ErrSubscriptionsDisabled
+}
+
+// NumSubscribers returns 0
+func (dsh *disabledSubscriptionsHub) NumSubscribers() int {
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsh *disabledSubscriptionsHub) IsInterfaceNil() bool {
+	return dsh == nil
+}
@@ -0,0 +1,49 @@
+package subscriptions
+
+const (
+	// TopicBlocks is the topic used for the new committed blocks
+	TopicBlocks = "blocks"
+	// TopicFinalizedBlocks is the topic used for the finalized blocks
+	TopicFinalizedBlocks = "finalizedBlocks"
+	// TopicRevertedBlocks is the topic used for the reverted blocks
+	TopicRevertedBlocks = "revertedBlocks"
+	// TopicLogEvents is the topic used for the smart contract log events
+	TopicLogEvents = "logEvents"
+)
+
+// SubscriptionRequest holds the topics and the filters requested by a client. The addresses and identifiers
+// filters only apply on the log events topic and an empty filter matches everything
+type SubscriptionRequest struct {
+	Topics      []string `json:"topics"`
+	Addresses   []string `json:"addresses"`
+	Identifiers []string `json:"identifiers"`
+}
+
+// Event is the message pushed towards the subscribers
+type Event struct {
+	Topic string     `json:"topic"`
+	Block *BlockInfo `json:"block,omitempty"`
+	Log   *LogEvent  `json:"log,omitempty"`
+}
+
+// BlockInfo holds the block related data pushed towards the subscribers
+type BlockInfo struct {
+	Hash      string `json:"hash"`
+	Nonce     uint64 `json:"nonce,omitempty"`
+	Round     uint64 `json:"round,omitempty"`
+	Epoch     uint32 `json:"epoch,omitempty"`
+	ShardID   uint32 `json:"shardID,omitempty"`
+	PrevHash  string `json:"prevHash,omitempty"`
+	TimeStamp uint64 `json:"timestamp,omitempty"`
+	NumTxs    uint32 `json:"numTxs,omitempty"`
+}
+
+// LogEvent holds a smart contract log event pushed towards the subscribers
+type LogEvent struct {
+	BlockHash  string   `json:"blockHash"`
+	TxHash     string   `json:"txHash"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+}
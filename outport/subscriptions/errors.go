@@ -0,0 +1,36 @@
+package subscriptions
+
+import "errors"
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrInvalidMaxSubscribers signals that an invalid maximum number of subscribers has been provided
+var ErrInvalidMaxSubscribers = errors.New("invalid maximum number of subscribers")
+
+// ErrInvalidEventsBufferSize signals that an invalid events buffer size has been provided
+var ErrInvalidEventsBufferSize = errors.New("invalid events buffer size")
+
+// ErrNilSubscriptionRequest signals that a nil subscription request has been provided
+var ErrNilSubscriptionRequest = errors.New("nil subscription request")
+
+// ErrNoTopics signals that the subscription request does not contain any topic
+var ErrNoTopics = errors.New("no topics provided")
+
+// ErrUnknownTopic signals that the subscription request contains an unknown topic
+var ErrUnknownTopic = errors.New("unknown topic")
+
+// ErrTooManySubscribers signals that the maximum number of subscribers has been reached
+var ErrTooManySubscribers = errors.New("too many subscribers")
+
+// ErrSubscriptionsDisabled signals that the subscriptions hub is disabled
+var ErrSubscriptionsDisabled = errors.New("subscriptions are disabled")
+
+// ErrHubClosed signals that the subscriptions hub has been closed
+var ErrHubClosed = errors.New("subscriptions hub is closed")
@@ -0,0 +1,85 @@
+package subscriptions
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+)
+
+type eventsFilter struct {
+	topics      map[string]struct{}
+	addresses   map[string]struct{}
+	identifiers map[string]struct{}
+}
+
+func newEventsFilter(request *SubscriptionRequest, pubKeyConverter core.PubkeyConverter) (*eventsFilter, error) {
+	if request == nil {
+		return nil, ErrNilSubscriptionRequest
+	}
+	if len(request.Topics) == 0 {
+		return nil, ErrNoTopics
+	}
+
+	filter := &eventsFilter{
+		topics:      make(map[string]struct{}),
+		addresses:   make(map[string]struct{}),
+		identifiers: make(map[string]struct{}),
+	}
+
+	for _, topic := range request.Topics {
+		if !isKnownTopic(topic) {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownTopic, topic)
+		}
+		filter.topics[topic] = struct{}{}
+	}
+
+	for _, address := range request.Addresses {
+		decodedAddress, err := pubKeyConverter.Decode(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w for address %s", err, address)
+		}
+		filter.addresses[string(decodedAddress)] = struct{}{}
+	}
+
+	for _, identifier := range request.Identifiers {
+		filter.identifiers[identifier] = struct{}{}
+	}
+
+	return filter, nil
+}
+
+func isKnownTopic(topic string) bool {
+	switch topic {
+	case TopicBlocks, TopicFinalizedBlocks, TopicRevertedBlocks, TopicLogEvents:
+		return true
+	default:
+		return false
+	}
+}
+
+func (ef *eventsFilter) hasTopic(topic string) bool {
+	_, ok := ef.topics[topic]
+	return ok
+}
+
+func (ef *eventsFilter) matchesLogEvent(address []byte, identifier []byte) bool {
+	if !ef.hasTopic(TopicLogEvents) {
+		return false
+	}
+
+	if len(ef.addresses) > 0 {
+		_, ok := ef.addresses[string(address)]
+		if !ok {
+			return false
+		}
+	}
+
+	if len(ef.identifiers) > 0 {
+		_, ok := ef.identifiers[string(identifier)]
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,16 @@
+package subscriptions
+
+// Subscription defines the behaviour of a client subscription registered on the hub
+type Subscription interface {
+	// Events returns the channel on which the matching events are pushed. The channel is closed when the
+	// subscription is closed or dropped by the hub
+	Events() <-chan *Event
+	Close()
+}
+
+// Hub defines what a subscriptions hub should be able to do
+type Hub interface {
+	Subscribe(request *SubscriptionRequest) (Subscription, error)
+	NumSubscribers() int
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,39 @@
+package subscriptions
+
+import (
+	"sync"
+)
+
+type subscriber struct {
+	id        uint64
+	hub       *subscriptionsHub
+	filter    *eventsFilter
+	events    chan *Event
+	closeOnce sync.Once
+}
+
+// Events returns the channel on which the matching events are pushed
+func (s *subscriber) Events() <-chan *Event {
+	return s.events
+}
+
+// Close will unregister the subscriber from the hub
+func (s *subscriber) Close() {
+	s.hub.unsubscribe(s.id)
+}
+
+func (s *subscriber) closeEventsChannel() {
+	s.closeOnce.Do(func() {
+		close(s.events)
+	})
+}
+
+// tryPush will not block if the subscriber is not consuming its events fast enough
+func (s *subscriber) tryPush(event *Event) bool {
+	select {
+	case s.events <- event:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,316 @@
+package subscriptions
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+var log = logger.GetOrCreate("outport/subscriptions")
+
+// ArgsSubscriptionsHub holds the arguments needed to create a new subscriptions hub
+type ArgsSubscriptionsHub struct {
+	PubKeyConverter  core.PubkeyConverter
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	MaxSubscribers   int
+	EventsBufferSize int
+}
+
+// subscriptionsHub is an in-process outport driver that pushes the block related data towards the registered
+// subscribers. It never blocks the outport: a subscriber that can not keep up is dropped
+type subscriptionsHub struct {
+	pubKeyConverter  core.PubkeyConverter
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	maxSubscribers   int
+	eventsBufferSize int
+
+	mut         sync.Mutex
+	subscribers map[uint64]*subscriber
+	lastID      uint64
+	closed      bool
+}
+
+// NewSubscriptionsHub creates a new subscriptions hub
+func NewSubscriptionsHub(args ArgsSubscriptionsHub) (*subscriptionsHub, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscriptionsHub{
+		pubKeyConverter:  args.PubKeyConverter,
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		maxSubscribers:   args.MaxSubscribers,
+		eventsBufferSize: args.EventsBufferSize,
+		subscribers:      make(map[uint64]*subscriber),
+	}, nil
+}
+
+func checkArgs(args ArgsSubscriptionsHub) error {
+	if check.IfNil(args.PubKeyConverter) {
+		return ErrNilPubKeyConverter
+	}
+	if check.IfNil(args.Marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+	if args.MaxSubscribers < 1 {
+		return fmt.Errorf("%w, provided: %d", ErrInvalidMaxSubscribers, args.MaxSubscribers)
+	}
+	if args.EventsBufferSize < 1 {
+		return fmt.Errorf("%w, provided: %d", ErrInvalidEventsBufferSize, args.EventsBufferSize)
+	}
+
+	return nil
+}
+
+// Subscribe registers a new subscriber for the provided request
+func (sh *subscriptionsHub) Subscribe(request *SubscriptionRequest) (Subscription, error) {
+	filter, err := newEventsFilter(request, sh.pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	sh.mut.Lock()
+	defer sh.mut.Unlock()
+
+	if sh.closed {
+		return nil, ErrHubClosed
+	}
+	if len(sh.subscribers) >= sh.maxSubscribers {
+		return nil, fmt.Errorf("%w, maximum: %d", ErrTooManySubscribers, sh.maxSubscribers)
+	}
+
+	sh.lastID++
+	sub := &subscriber{
+		id:     sh.lastID,
+		hub:    sh,
+		filter: filter,
+		events: make(chan *Event, sh.eventsBufferSize),
+	}
+	sh.subscribers[sub.id] = sub
+
+	log.Debug("new subscriber registered", "id", sub.id, "topics", request.Topics)
+
+	return sub, nil
+}
+
+func (sh *subscriptionsHub) unsubscribe(id uint64) {
+	sh.mut.Lock()
+	defer sh.mut.Unlock()
+
+	sh.removeSubscriber(id)
+}
+
+// removeSubscriber must be called under mutex protection
+func (sh *subscriptionsHub) removeSubscriber(id uint64) {
+	sub, ok := sh.subscribers[id]
+	if !ok {
+		return
+	}
+
+	delete(sh.subscribers, id)
+	sub.closeEventsChannel()
+}
+
+// NumSubscribers returns the number of registered subscribers
+func (sh *subscriptionsHub) NumSubscribers() int {
+	sh.mut.Lock()
+	defer sh.mut.Unlock()
+
+	return len(sh.subscribers)
+}
+
+// SaveBlock will push the new block and its log events towards the interested subscribers
+func (sh *subscriptionsHub) SaveBlock(args *indexer.ArgsSaveBlockData) error {
+	if args == nil || check.IfNil(args.Header) {
+		return nil
+	}
+
+	blockHash := hex.EncodeToString(args.HeaderHash)
+	sh.pushEvent(TopicBlocks, &Event{
+		Topic: TopicBlocks,
+		Block: &BlockInfo{
+			Hash:      blockHash,
+			Nonce:     args.Header.GetNonce(),
+			Round:     args.Header.GetRound(),
+			Epoch:     args.Header.GetEpoch(),
+			ShardID:   args.Header.GetShardID(),
+			PrevHash:  hex.EncodeToString(args.Header.GetPrevHash()),
+			TimeStamp: args.Header.GetTimeStamp(),
+			NumTxs:    args.Header.GetTxCount(),
+		},
+	})
+
+	if args.TransactionsPool == nil {
+		return nil
+	}
+
+	sh.pushLogEvents(blockHash, args.TransactionsPool.Logs)
+
+	return nil
+}
+
+func (sh *subscriptionsHub) pushLogEvents(blockHash string, logs map[string]data.LogHandler) {
+	txHashes := make([]string, 0, len(logs))
+	for txHash := range logs {
+		txHashes = append(txHashes, txHash)
+	}
+	sort.Strings(txHashes)
+
+	for _, txHash := range txHashes {
+		txLog := logs[txHash]
+		if check.IfNil(txLog) {
+			continue
+		}
+
+		for _, event := range txLog.GetLogEvents() {
+			if check.IfNil(event) {
+				continue
+			}
+
+			sh.pushLogEvent(blockHash, []byte(txHash), event)
+		}
+	}
+}
+
+func (sh *subscriptionsHub) pushLogEvent(blockHash string, txHash []byte, event data.EventHandler) {
+	sh.mut.Lock()
+	defer sh.mut.Unlock()
+
+	var logEvent *Event
+	for id, sub := range sh.subscribers {
+		if !sub.filter.matchesLogEvent(event.GetAddress(), event.GetIdentifier()) {
+			continue
+		}
+
+		if logEvent == nil {
+			logEvent = &Event{
+				Topic: TopicLogEvents,
+				Log: &LogEvent{
+					BlockHash:  blockHash,
+					TxHash:     hex.EncodeToString(txHash),
+					Address:    sh.pubKeyConverter.Encode(event.GetAddress()),
+					Identifier: string(event.GetIdentifier()),
+					Topics:     event.GetTopics(),
+					Data:       event.GetData(),
+				},
+			}
+		}
+
+		sh.pushToSubscriber(id, sub, logEvent)
+	}
+}
+
+func (sh *subscriptionsHub) pushEvent(topic string, event *Event) {
+	sh.mut.Lock()
+	defer sh.mut.Unlock()
+
+	for id, sub := range sh.subscribers {
+		if !sub.filter.hasTopic(topic) {
+			continue
+		}
+
+		sh.pushToSubscriber(id, sub, event)
+	}
+}
+
+// pushToSubscriber must be called under mutex protection
+func (sh *subscriptionsHub) pushToSubscriber(id uint64, sub *subscriber, event *Event) {
+	if sub.tryPush(event) {
+		return
+	}
+
+	log.Debug("subscriber can not keep up with the events, dropping it", "id", id)
+	sh.removeSubscriber(id)
+}
+
+// RevertIndexedBlock will notify the interested subscribers about the reverted block
+func (sh *subscriptionsHub) RevertIndexedBlock(header data.HeaderHandler, _ data.BodyHandler) error {
+	if check.IfNil(header) {
+		return nil
+	}
+
+	headerHash, err := core.CalculateHash(sh.marshalizer, sh.hasher, header)
+	if err != nil {
+		log.Warn("subscriptionsHub.RevertIndexedBlock: cannot compute header hash", "error", err)
+		return nil
+	}
+
+	sh.pushEvent(TopicRevertedBlocks, &Event{
+		Topic: TopicRevertedBlocks,
+		Block: &BlockInfo{
+			Hash:    hex.EncodeToString(headerHash),
+			Nonce:   header.GetNonce(),
+			Round:   header.GetRound(),
+			Epoch:   header.GetEpoch(),
+			ShardID: header.GetShardID(),
+		},
+	})
+
+	return nil
+}
+
+// FinalizedBlock will notify the interested subscribers about the finalized block
+func (sh *subscriptionsHub) FinalizedBlock(headerHash []byte) error {
+	sh.pushEvent(TopicFinalizedBlocks, &Event{
+		Topic: TopicFinalizedBlocks,
+		Block: &BlockInfo{
+			Hash: hex.EncodeToString(headerHash),
+		},
+	})
+
+	return nil
+}
+
+// SaveRoundsInfo does nothing
+func (sh *subscriptionsHub) SaveRoundsInfo(_ []*indexer.RoundInfo) error {
+	return nil
+}
+
+// SaveValidatorsPubKeys does nothing
+func (sh *subscriptionsHub) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) error {
+	return nil
+}
+
+// SaveValidatorsRating does nothing
+func (sh *subscriptionsHub) SaveValidatorsRating(_ string, _ []*indexer.ValidatorRatingInfo) error {
+	return nil
+}
+
+// SaveAccounts does nothing
+func (sh *subscriptionsHub) SaveAccounts(_ uint64, _ []data.UserAccountHandler) error {
+	return nil
+}
+
+// Close will drop all the subscribers and will reject any new subscription
+func (sh *subscriptionsHub) Close() error {
+	sh.mut.Lock()
+	defer sh.mut.Unlock()
+
+	for id := range sh.subscribers {
+		sh.removeSubscriber(id)
+	}
+	sh.closed = true
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sh *subscriptionsHub) IsInterfaceNil() bool {
+	return sh == nil
+}
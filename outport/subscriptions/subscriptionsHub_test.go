@@ -0,0 +1,257 @@
+package subscriptions
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsSubscriptionsHub() ArgsSubscriptionsHub {
+	return ArgsSubscriptionsHub{
+		PubKeyConverter:  testscommon.NewPubkeyConverterMock(32),
+		Marshalizer:      &testscommon.MarshalizerMock{},
+		Hasher:           &testscommon.HasherMock{},
+		MaxSubscribers:   10,
+		EventsBufferSize: 10,
+	}
+}
+
+func createArgsSaveBlockWithLogs() *indexer.ArgsSaveBlockData {
+	return &indexer.ArgsSaveBlockData{
+		HeaderHash: []byte("hash"),
+		Header:     &block.Header{Nonce: 5, Round: 6, Epoch: 1},
+		TransactionsPool: &indexer.Pool{
+			Logs: map[string]data.LogHandler{
+				"txHash": &transaction.Log{
+					Address: []byte("sc1"),
+					Events: []*transaction.Event{
+						{Address: []byte("sc1"), Identifier: []byte("transfer"), Topics: [][]byte{[]byte("t")}},
+						{Address: []byte("sc2"), Identifier: []byte("transfer")},
+						{Address: []byte("sc2"), Identifier: []byte("claim"), Data: []byte("d")},
+					},
+				},
+			},
+		},
+	}
+}
+
+func drainEvents(sub Subscription) []*Event {
+	events := make([]*Event, 0)
+	for {
+		select {
+		case event, ok := <-sub.Events():
+			if !ok {
+				return events
+			}
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestNewSubscriptionsHub(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		args := createMockArgsSubscriptionsHub()
+		args.PubKeyConverter = nil
+		hub, err := NewSubscriptionsHub(args)
+		assert.Equal(t, ErrNilPubKeyConverter, err)
+		assert.True(t, check.IfNil(hub))
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		args := createMockArgsSubscriptionsHub()
+		args.Marshalizer = nil
+		hub, err := NewSubscriptionsHub(args)
+		assert.Equal(t, ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(hub))
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		args := createMockArgsSubscriptionsHub()
+		args.Hasher = nil
+		hub, err := NewSubscriptionsHub(args)
+		assert.Equal(t, ErrNilHasher, err)
+		assert.True(t, check.IfNil(hub))
+	})
+	t.Run("invalid max subscribers should error", func(t *testing.T) {
+		args := createMockArgsSubscriptionsHub()
+		args.MaxSubscribers = 0
+		hub, err := NewSubscriptionsHub(args)
+		assert.True(t, errors.Is(err, ErrInvalidMaxSubscribers))
+		assert.True(t, check.IfNil(hub))
+	})
+	t.Run("invalid events buffer size should error", func(t *testing.T) {
+		args := createMockArgsSubscriptionsHub()
+		args.EventsBufferSize = 0
+		hub, err := NewSubscriptionsHub(args)
+		assert.True(t, errors.Is(err, ErrInvalidEventsBufferSize))
+		assert.True(t, check.IfNil(hub))
+	})
+	t.Run("should work", func(t *testing.T) {
+		hub, err := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(hub))
+	})
+}
+
+func TestSubscriptionsHub_SubscribeInvalidRequestsShouldError(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+
+	sub, err := hub.Subscribe(nil)
+	assert.Equal(t, ErrNilSubscriptionRequest, err)
+	assert.Nil(t, sub)
+
+	sub, err = hub.Subscribe(&SubscriptionRequest{})
+	assert.Equal(t, ErrNoTopics, err)
+	assert.Nil(t, sub)
+
+	sub, err = hub.Subscribe(&SubscriptionRequest{Topics: []string{"unknown"}})
+	assert.True(t, errors.Is(err, ErrUnknownTopic))
+	assert.Nil(t, sub)
+
+	sub, err = hub.Subscribe(&SubscriptionRequest{Topics: []string{TopicLogEvents}, Addresses: []string{"not hex"}})
+	assert.NotNil(t, err)
+	assert.Nil(t, sub)
+
+	assert.Equal(t, 0, hub.NumSubscribers())
+}
+
+func TestSubscriptionsHub_SubscribeTooManySubscribersShouldError(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSubscriptionsHub()
+	args.MaxSubscribers = 1
+	hub, _ := NewSubscriptionsHub(args)
+
+	request := &SubscriptionRequest{Topics: []string{TopicBlocks}}
+	sub, err := hub.Subscribe(request)
+	require.Nil(t, err)
+
+	_, err = hub.Subscribe(request)
+	assert.True(t, errors.Is(err, ErrTooManySubscribers))
+
+	sub.Close()
+	sub.Close()
+	assert.Equal(t, 0, hub.NumSubscribers())
+
+	_, err = hub.Subscribe(request)
+	assert.Nil(t, err)
+}
+
+func TestSubscriptionsHub_SaveBlockShouldPushBlocksAndFilteredLogEvents(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+
+	blocksSub, _ := hub.Subscribe(&SubscriptionRequest{Topics: []string{TopicBlocks}})
+	allLogsSub, _ := hub.Subscribe(&SubscriptionRequest{Topics: []string{TopicLogEvents}})
+	filteredLogsSub, _ := hub.Subscribe(&SubscriptionRequest{
+		Topics:      []string{TopicLogEvents},
+		Addresses:   []string{hex.EncodeToString([]byte("sc2"))},
+		Identifiers: []string{"claim"},
+	})
+	finalizedSub, _ := hub.Subscribe(&SubscriptionRequest{Topics: []string{TopicFinalizedBlocks}})
+
+	err := hub.SaveBlock(createArgsSaveBlockWithLogs())
+	assert.Nil(t, err)
+
+	events := drainEvents(blocksSub)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, TopicBlocks, events[0].Topic)
+	assert.Equal(t, hex.EncodeToString([]byte("hash")), events[0].Block.Hash)
+	assert.Equal(t, uint64(5), events[0].Block.Nonce)
+	assert.Equal(t, uint64(6), events[0].Block.Round)
+
+	events = drainEvents(allLogsSub)
+	require.Equal(t, 3, len(events))
+	assert.Equal(t, "transfer", events[0].Log.Identifier)
+	assert.Equal(t, hex.EncodeToString([]byte("sc1")), events[0].Log.Address)
+	assert.Equal(t, hex.EncodeToString([]byte("txHash")), events[0].Log.TxHash)
+
+	events = drainEvents(filteredLogsSub)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, "claim", events[0].Log.Identifier)
+	assert.Equal(t, []byte("d"), events[0].Log.Data)
+
+	assert.Equal(t, 0, len(drainEvents(finalizedSub)))
+}
+
+func TestSubscriptionsHub_FinalizedAndRevertedBlocks(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+	sub, _ := hub.Subscribe(&SubscriptionRequest{Topics: []string{TopicFinalizedBlocks, TopicRevertedBlocks}})
+
+	_ = hub.FinalizedBlock([]byte("final"))
+	_ = hub.RevertIndexedBlock(&block.Header{Nonce: 7}, &block.Body{})
+
+	events := drainEvents(sub)
+	require.Equal(t, 2, len(events))
+	assert.Equal(t, TopicFinalizedBlocks, events[0].Topic)
+	assert.Equal(t, hex.EncodeToString([]byte("final")), events[0].Block.Hash)
+	assert.Equal(t, TopicRevertedBlocks, events[1].Topic)
+	assert.Equal(t, uint64(7), events[1].Block.Nonce)
+	assert.NotEmpty(t, events[1].Block.Hash)
+}
+
+func TestSubscriptionsHub_SlowSubscriberShouldBeDropped(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSubscriptionsHub()
+	args.EventsBufferSize = 1
+	hub, _ := NewSubscriptionsHub(args)
+	slowSub, _ := hub.Subscribe(&SubscriptionRequest{Topics: []string{TopicFinalizedBlocks}})
+
+	_ = hub.FinalizedBlock([]byte("h1"))
+	_ = hub.FinalizedBlock([]byte("h2"))
+	assert.Equal(t, 0, hub.NumSubscribers())
+
+	event, ok := <-slowSub.Events()
+	assert.True(t, ok)
+	assert.Equal(t, hex.EncodeToString([]byte("h1")), event.Block.Hash)
+
+	_, ok = <-slowSub.Events()
+	assert.False(t, ok)
+
+	slowSub.Close()
+}
+
+func TestSubscriptionsHub_CloseShouldDropSubscribersAndRejectNewOnes(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+	sub, _ := hub.Subscribe(&SubscriptionRequest{Topics: []string{TopicBlocks}})
+
+	err := hub.Close()
+	assert.Nil(t, err)
+
+	_, ok := <-sub.Events()
+	assert.False(t, ok)
+
+	_, err = hub.Subscribe(&SubscriptionRequest{Topics: []string{TopicBlocks}})
+	assert.Equal(t, ErrHubClosed, err)
+}
+
+func TestDisabledSubscriptionsHub(t *testing.T) {
+	t.Parallel()
+
+	hub := NewDisabledSubscriptionsHub()
+	assert.False(t, check.IfNil(hub))
+
+	sub, err := hub.Subscribe(&SubscriptionRequest{Topics: []string{TopicBlocks}})
+	assert.Equal(t, ErrSubscriptionsDisabled, err)
+	assert.Nil(t, sub)
+	assert.Equal(t, 0, hub.NumSubscribers())
+}
@@ -2,9 +2,9 @@ package memp2p
 
 import "github.com/ElrondNetwork/elrond-go/p2p"
 
-func (messenger *Messenger) TopicValidator(name string) p2p.MessageProcessor {
+func (messenger *Messenger) TopicProcessor(topic string, identifier string) p2p.MessageProcessor {
 	messenger.topicsMutex.RLock()
-	processor := messenger.topicValidators[name]
+	processor := messenger.topicProcessors[topic][identifier]
 	messenger.topicsMutex.RUnlock()
 
 	return processor
@@ -17,6 +17,8 @@ const maxQueueSize = 1000
 
 var log = logger.GetOrCreate("p2p/memp2p")
 
+var _ p2p.Messenger = (*Messenger)(nil)
+
 // Messenger is an implementation of the p2p.Messenger interface that
 // uses no real networking code, but instead connects to a network simulated in
 // memory (the Network struct). The Messenger is intended for use
@@ -35,11 +37,12 @@ type Messenger struct {
 	p2pID           core.PeerID
 	address         string
 	topics          map[string]struct{}
-	topicValidators map[string]p2p.MessageProcessor
+	topicProcessors map[string]map[string]p2p.MessageProcessor
 	topicsMutex     *sync.RWMutex
 	seqNo           uint64
 	processQueue    chan p2p.MessageP2P
 	numReceived     uint64
+	numPending      int64
 }
 
 // NewMessenger constructs a new Messenger that is connected to the
@@ -59,7 +62,7 @@ func NewMessenger(network *Network) (*Messenger, error) {
 		p2pID:           core.PeerID(ID),
 		address:         Address,
 		topics:          make(map[string]struct{}),
-		topicValidators: make(map[string]p2p.MessageProcessor),
+		topicProcessors: make(map[string]map[string]p2p.MessageProcessor),
 		topicsMutex:     &sync.RWMutex{},
 		processQueue:    make(chan p2p.MessageP2P, maxQueueSize),
 	}
@@ -167,13 +170,19 @@ func (messenger *Messenger) ConnectedPeersOnTopic(topic string) []core.PeerID {
 	return filteredPeers
 }
 
+// ConnectedFullHistoryPeersOnTopic returns an empty slice, as the in-memory network
+// does not hold full history peers.
+func (messenger *Messenger) ConnectedFullHistoryPeersOnTopic(_ string) []core.PeerID {
+	return make([]core.PeerID, 0)
+}
+
 // TrimConnections does nothing, as it is not applicable to the in-memory
 // messenger.
 func (messenger *Messenger) TrimConnections() {
 }
 
 // Bootstrap does nothing, as it is not applicable to the in-memory messenger.
-func (messenger *Messenger) Bootstrap(_ uint32) error {
+func (messenger *Messenger) Bootstrap() error {
 	return nil
 }
 
@@ -203,9 +212,11 @@ func (messenger *Messenger) HasTopic(name string) bool {
 	return found
 }
 
-// RegisterMessageProcessor sets the provided message processor to be the
-// processor of received messages for the given topic.
-func (messenger *Messenger) RegisterMessageProcessor(topic string, _ string, handler p2p.MessageProcessor) error {
+// RegisterMessageProcessor adds the provided message processor to the
+// processors of the received messages for the given topic. As in the libp2p
+// implementation, the processors can be registered on topics this Messenger
+// did not join, in which case they only handle the direct messages.
+func (messenger *Messenger) RegisterMessageProcessor(topic string, identifier string, handler p2p.MessageProcessor) error {
 	if check.IfNil(handler) {
 		return p2p.ErrNilValidator
 	}
@@ -213,37 +224,62 @@ func (messenger *Messenger) RegisterMessageProcessor(topic string, _ string, han
 	messenger.topicsMutex.Lock()
 	defer messenger.topicsMutex.Unlock()
 
-	_, found := messenger.topics[topic]
-	if !found {
-		return fmt.Errorf("%w RegisterMessageProcessor, topic: %s", p2p.ErrNilTopic, topic)
+	processors := messenger.topicProcessors[topic]
+	if processors == nil {
+		processors = make(map[string]p2p.MessageProcessor)
+		messenger.topicProcessors[topic] = processors
 	}
 
-	validator := messenger.topicValidators[topic]
-	if !check.IfNil(validator) {
-		return p2p.ErrTopicValidatorOperationNotSupported
+	_, found := processors[identifier]
+	if found {
+		return fmt.Errorf("%w RegisterMessageProcessor, topic: %s, identifier: %s",
+			p2p.ErrMessageProcessorAlreadyDefined, topic, identifier)
 	}
 
-	messenger.topicValidators[topic] = handler
+	processors[identifier] = handler
 	return nil
 }
 
-// UnregisterMessageProcessor unsets the message processor for the given topic
-// (sets it to nil).
-func (messenger *Messenger) UnregisterMessageProcessor(topic string, _ string) error {
+// UnregisterMessageProcessor removes the message processor registered with the
+// provided identifier for the given topic.
+func (messenger *Messenger) UnregisterMessageProcessor(topic string, identifier string) error {
 	messenger.topicsMutex.Lock()
 	defer messenger.topicsMutex.Unlock()
 
-	_, found := messenger.topics[topic]
+	processors := messenger.topicProcessors[topic]
+	if processors == nil {
+		return nil
+	}
+
+	_, found := processors[identifier]
 	if !found {
-		return fmt.Errorf("%w UnregisterMessageProcessor, topic: %s", p2p.ErrNilTopic, topic)
+		return fmt.Errorf("%w UnregisterMessageProcessor, topic: %s, identifier: %s",
+			p2p.ErrMessageProcessorDoesNotExists, topic, identifier)
 	}
 
-	validator := messenger.topicValidators[topic]
-	if check.IfNil(validator) {
-		return p2p.ErrTopicValidatorOperationNotSupported
+	delete(processors, identifier)
+	if len(processors) == 0 {
+		delete(messenger.topicProcessors, topic)
 	}
 
-	messenger.topicValidators[topic] = nil
+	return nil
+}
+
+// UnregisterAllMessageProcessors removes the message processors of all the topics.
+func (messenger *Messenger) UnregisterAllMessageProcessors() error {
+	messenger.topicsMutex.Lock()
+	messenger.topicProcessors = make(map[string]map[string]p2p.MessageProcessor)
+	messenger.topicsMutex.Unlock()
+
+	return nil
+}
+
+// UnjoinAllTopics removes all the topics this Messenger declared its interest in.
+func (messenger *Messenger) UnjoinAllTopics() error {
+	messenger.topicsMutex.Lock()
+	messenger.topics = make(map[string]struct{})
+	messenger.topicsMutex.Unlock()
+
 	return nil
 }
 
@@ -291,6 +327,10 @@ func (messenger *Messenger) synchronousBroadcast(topic string, data []byte) erro
 
 	peers := messenger.network.Peers()
 	for _, peer := range peers {
+		if !peer.HasTopic(topic) {
+			continue
+		}
+
 		peer.receiveMessage(messageObject)
 	}
 
@@ -300,32 +340,37 @@ func (messenger *Messenger) synchronousBroadcast(topic string, data []byte) erro
 func (messenger *Messenger) processFromQueue() {
 	for {
 		messageObject := <-messenger.processQueue
-		if check.IfNil(messageObject) {
-			continue
-		}
+		messenger.processMessage(messageObject)
+		atomic.AddInt64(&messenger.numPending, -1)
+	}
+}
 
-		topic := messageObject.Topic()
-		if topic == "" {
-			continue
-		}
+func (messenger *Messenger) processMessage(messageObject p2p.MessageP2P) {
+	if check.IfNil(messageObject) {
+		return
+	}
 
-		messenger.topicsMutex.Lock()
-		_, found := messenger.topics[topic]
-		if !found {
-			messenger.topicsMutex.Unlock()
-			continue
-		}
+	topic := messageObject.Topic()
+	if topic == "" {
+		return
+	}
 
-		// numReceived gets incremented because the message arrived on a registered topic
-		atomic.AddUint64(&messenger.numReceived, 1)
-		validator := messenger.topicValidators[topic]
-		if check.IfNil(validator) {
-			messenger.topicsMutex.Unlock()
-			continue
-		}
-		messenger.topicsMutex.Unlock()
+	messenger.topicsMutex.RLock()
+	_, joined := messenger.topics[topic]
+	processors := make([]p2p.MessageProcessor, 0, len(messenger.topicProcessors[topic]))
+	for _, processor := range messenger.topicProcessors[topic] {
+		processors = append(processors, processor)
+	}
+	messenger.topicsMutex.RUnlock()
+
+	if !joined && len(processors) == 0 {
+		return
+	}
 
-		_ = validator.ProcessReceivedMessage(messageObject, messenger.p2pID)
+	// numReceived gets incremented because the message arrived on a joined topic or on a topic with processors
+	atomic.AddUint64(&messenger.numReceived, 1)
+	for _, processor := range processors {
+		_ = processor.ProcessReceivedMessage(messageObject, messenger.p2pID)
 	}
 }
 
@@ -354,9 +399,16 @@ func (messenger *Messenger) SendToConnectedPeer(topic string, buff []byte, peerI
 // log the message only if the Network.LogMessages flag is set and only if the
 // Messenger has the requested topic and MessageProcessor.
 func (messenger *Messenger) receiveMessage(message p2p.MessageP2P) {
+	atomic.AddInt64(&messenger.numPending, 1)
 	messenger.processQueue <- message
 }
 
+// HasPendingMessages returns true if this Messenger received messages that were not yet
+// handed to the message processors
+func (messenger *Messenger) HasPendingMessages() bool {
+	return atomic.LoadInt64(&messenger.numPending) > 0
+}
+
 // IsConnectedToTheNetwork returns true as this implementation is always connected to its network
 func (messenger *Messenger) IsConnectedToTheNetwork() bool {
 	return true
@@ -392,6 +444,11 @@ func (messenger *Messenger) GetConnectedPeersInfo() *p2p.ConnectedPeersInfo {
 	return nil
 }
 
+// Port returns 0, as the in-memory messenger is not bound to any port
+func (messenger *Messenger) Port() int {
+	return 0
+}
+
 // Close disconnects this Messenger from the network it was connected to.
 func (messenger *Messenger) Close() error {
 	messenger.network.UnregisterPeer(messenger.ID())
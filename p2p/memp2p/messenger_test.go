@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
@@ -35,39 +36,46 @@ func TestRegisteringTopics(t *testing.T) {
 
 	processor := &mock.MessageProcessorStub{}
 
-	// Cannot register a MessageProcessor to a topic that doesn't exist.
-	err = messenger.RegisterMessageProcessor("rocket", "", processor)
-	assert.True(t, errors.Is(err, p2p.ErrNilTopic))
+	// Cannot register a nil MessageProcessor.
+	err = messenger.RegisterMessageProcessor("rocket", "", nil)
+	assert.Equal(t, p2p.ErrNilValidator, err)
 
-	// Create a proper topic.
+	// A MessageProcessor can be registered on a topic that was not joined, in
+	// which case it will only handle the direct messages.
+	assert.Nil(t, messenger.TopicProcessor("rocket", "id1"))
+	err = messenger.RegisterMessageProcessor("rocket", "id1", processor)
+	assert.Nil(t, err)
+	assert.Equal(t, processor, messenger.TopicProcessor("rocket", "id1"))
 	assert.False(t, messenger.HasTopic("rocket"))
+
+	// Create a proper topic.
 	assert.Nil(t, messenger.CreateTopic("rocket", false))
 	assert.True(t, messenger.HasTopic("rocket"))
 
-	// The newly created topic has no MessageProcessor attached to it, so we
-	// attach one now.
-	assert.Nil(t, messenger.TopicValidator("rocket"))
-	err = messenger.RegisterMessageProcessor("rocket", "", processor)
+	// Multiple MessageProcessors can be attached to the same topic, but not
+	// with the same identifier.
+	err = messenger.RegisterMessageProcessor("rocket", "id1", processor)
+	assert.True(t, errors.Is(err, p2p.ErrMessageProcessorAlreadyDefined))
+	err = messenger.RegisterMessageProcessor("rocket", "id2", processor)
 	assert.Nil(t, err)
-	assert.Equal(t, processor, messenger.TopicValidator("rocket"))
+	assert.Equal(t, processor, messenger.TopicProcessor("rocket", "id2"))
 
-	// Cannot unregister a MessageProcessor from a topic that doesn't exist.
+	// Unregistering from a topic without MessageProcessors is a no-op.
 	err = messenger.UnregisterMessageProcessor("albatross", "")
-	assert.True(t, errors.Is(err, p2p.ErrNilTopic))
-
-	// Cannot unregister a MessageProcessor from a topic that doesn't have a
-	// MessageProcessor, even if the topic itself exists.
-	err = messenger.CreateTopic("nitrous_oxide", false)
 	assert.Nil(t, err)
-	err = messenger.UnregisterMessageProcessor("nitrous_oxide", "")
-	assert.Equal(t, p2p.ErrTopicValidatorOperationNotSupported, err)
 
-	// Unregister the MessageProcessor from a topic that exists and has a
-	// MessageProcessor.
-	err = messenger.UnregisterMessageProcessor("rocket", "")
+	// Cannot unregister a MessageProcessor that was not registered.
+	err = messenger.UnregisterMessageProcessor("rocket", "id3")
+	assert.True(t, errors.Is(err, p2p.ErrMessageProcessorDoesNotExists))
+
+	// Unregister the MessageProcessors from a topic that exists.
+	err = messenger.UnregisterMessageProcessor("rocket", "id1")
+	assert.Nil(t, err)
+	err = messenger.UnregisterMessageProcessor("rocket", "id2")
 	assert.Nil(t, err)
 	assert.True(t, messenger.HasTopic("rocket"))
-	assert.Nil(t, messenger.TopicValidator("rocket"))
+	assert.Nil(t, messenger.TopicProcessor("rocket", "id1"))
+	assert.Nil(t, messenger.TopicProcessor("rocket", "id2"))
 
 	// Disallow creating duplicate topics.
 	err = messenger.CreateTopic("more_rockets", false)
@@ -184,3 +192,49 @@ func TestSendingDirectMessages(t *testing.T) {
 	// Peer1 got the message
 	assert.Equal(t, uint64(1), peer1.NumMessagesReceived())
 }
+
+func TestPendingMessages(t *testing.T) {
+	network := memp2p.NewNetwork()
+
+	peer1, _ := memp2p.NewMessenger(network)
+	peer2, _ := memp2p.NewMessenger(network)
+
+	releaseProcessing := make(chan struct{})
+	_ = peer2.CreateTopic("rocket", false)
+	_ = peer2.RegisterMessageProcessor("rocket", "", &mock.MessageProcessorStub{
+		ProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+			<-releaseProcessing
+			return nil
+		},
+	})
+	assert.False(t, network.HasPendingMessages())
+
+	// Peer2 is stuck processing the message, so the network still has a pending message
+	_ = peer1.SendToConnectedPeer("rocket", []byte("launch the rocket"), peer2.ID())
+	time.Sleep(time.Millisecond * 100)
+	assert.False(t, peer1.HasPendingMessages())
+	assert.True(t, peer2.HasPendingMessages())
+	assert.True(t, network.HasPendingMessages())
+
+	close(releaseProcessing)
+	time.Sleep(time.Millisecond * 100)
+	assert.False(t, network.HasPendingMessages())
+	assert.Equal(t, uint64(1), peer2.NumMessagesReceived())
+}
+
+func TestUnjoinAllTopics(t *testing.T) {
+	network := memp2p.NewNetwork()
+
+	messenger, _ := memp2p.NewMessenger(network)
+	_ = messenger.CreateTopic("rocket", false)
+	_ = messenger.RegisterMessageProcessor("rocket", "", &mock.MessageProcessorStub{})
+
+	err := messenger.UnregisterAllMessageProcessors()
+	assert.Nil(t, err)
+	assert.True(t, messenger.HasTopic("rocket"))
+	assert.Nil(t, messenger.TopicProcessor("rocket", ""))
+
+	err = messenger.UnjoinAllTopics()
+	assert.Nil(t, err)
+	assert.False(t, messenger.HasTopic("rocket"))
+}
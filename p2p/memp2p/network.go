@@ -129,3 +129,18 @@ func (network *Network) IsPeerConnected(peerID core.PeerID) bool {
 	network.mutex.RUnlock()
 	return found
 }
+
+// HasPendingMessages returns true if any of the connected peers has received messages
+// that were not yet processed
+func (network *Network) HasPendingMessages() bool {
+	network.mutex.RLock()
+	defer network.mutex.RUnlock()
+
+	for _, peer := range network.peers {
+		if peer.HasPendingMessages() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/debug/tracing"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -73,6 +74,7 @@ type baseProcessor struct {
 	headerIntegrityVerifier process.HeaderIntegrityVerifier
 
 	appStatusHandler       core.AppStatusHandler
+	metricsHandler         common.MetricsHandler
 	stateCheckpointModulus uint
 	blockProcessor         blockProcessor
 	txCounter              *transactionCounter
@@ -99,6 +101,25 @@ type bootStorerDataArgs struct {
 	epochStartTriggerConfigKey []byte
 }
 
+// startBlockSpan starts a tracing span for a block operation, having the block coordinates as attributes
+func startBlockSpan(name string, header data.HeaderHandler) *tracing.Span {
+	span := tracing.StartSpan(name)
+	if span == nil || check.IfNil(header) {
+		return span
+	}
+
+	span.SetUint64Attribute("shard", uint64(header.GetShardID()))
+	span.SetUint64Attribute("epoch", uint64(header.GetEpoch()))
+	span.SetUint64Attribute("round", header.GetRound())
+	span.SetUint64Attribute("nonce", header.GetNonce())
+
+	return span
+}
+
+func (bp *baseProcessor) observeBlockOperation(operation common.BlockOperation, startTime time.Time) {
+	bp.metricsHandler.ObserveBlockOperation(operation, time.Since(startTime))
+}
+
 func checkForNils(
 	headerHandler data.HeaderHandler,
 	bodyHandler data.BodyHandler,
@@ -188,7 +209,10 @@ func (bp *baseProcessor) checkBlockValidity(
 
 // verifyStateRoot verifies the state root hash given as parameter against the
 // Merkle trie root hash stored for accounts and returns if equal or not
-func (bp *baseProcessor) verifyStateRoot(rootHash []byte) bool {
+func (bp *baseProcessor) verifyStateRoot(rootHash []byte, parentSpan *tracing.Span) bool {
+	span := parentSpan.StartChild("verifyStateRoot")
+	defer span.End()
+
 	trieRootHash, err := bp.accountsDB[state.UserAccountsState].RootHash()
 	if err != nil {
 		log.Debug("verify account.RootHash", "error", err.Error())
@@ -435,6 +459,9 @@ func checkProcessorNilParameters(arguments ArgBaseProcessor) error {
 	if check.IfNil(arguments.CoreComponents.StatusHandler()) {
 		return process.ErrNilAppStatusHandler
 	}
+	if check.IfNil(arguments.CoreComponents.MetricsHandler()) {
+		return process.ErrNilMetricsHandler
+	}
 	if check.IfNil(arguments.GasHandler) {
 		return process.ErrNilGasHandler
 	}
@@ -644,6 +671,9 @@ func (bp *baseProcessor) requestHeaderByShardAndNonce(shardID uint32, nonce uint
 func (bp *baseProcessor) cleanupPools(headerHandler data.HeaderHandler) {
 	bp.cleanupBlockTrackerPools(headerHandler)
 
+	// the transactions which stayed in the pool for more than the validity window (if enabled) are dropped
+	bp.dataPool.Transactions().NotifyRound(headerHandler.GetRound())
+
 	noncesToFinal := bp.getNoncesToFinal(headerHandler)
 
 	bp.removeHeadersBehindNonceFromPools(
@@ -988,10 +1018,12 @@ func (bp *baseProcessor) DecodeBlockHeader(dta []byte) data.HeaderHandler {
 	return header
 }
 
-func (bp *baseProcessor) saveBody(body *block.Body, header data.HeaderHandler) {
+func (bp *baseProcessor) saveBody(body *block.Body, header data.HeaderHandler, parentSpan *tracing.Span) {
 	startTime := time.Now()
 
+	span := parentSpan.StartChild("transactionCoordinator.SaveTxsToStorage")
 	errNotCritical := bp.txCoordinator.SaveTxsToStorage(body)
+	span.EndWithError(errNotCritical)
 	if errNotCritical != nil {
 		log.Warn("saveBody.SaveTxsToStorage", "error", errNotCritical.Error())
 	}
@@ -1135,9 +1167,12 @@ func (bp *baseProcessor) RevertAccountState(_ data.HeaderHandler) {
 	}
 }
 
-func (bp *baseProcessor) commitAll() error {
+func (bp *baseProcessor) commitAll(parentSpan *tracing.Span) error {
 	for key := range bp.accountsDB {
+		span := parentSpan.StartChild("AccountsDB.Commit")
+		span.SetUint64Attribute("accountsState", uint64(key))
 		_, err := bp.accountsDB[key].Commit()
+		span.EndWithError(err)
 		if err != nil {
 			return err
 		}
@@ -1257,11 +1292,13 @@ func (bp *baseProcessor) requestMiniBlocksIfNeeded(headerHandler data.HeaderHand
 }
 
 func (bp *baseProcessor) recordBlockInHistory(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler) {
+	txsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.TxBlock)
 	scrResultsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.SmartContractResultBlock)
+	rewardsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.RewardsBlock)
 	receiptsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.ReceiptBlock)
 	logs := bp.txCoordinator.GetAllCurrentLogs()
 
-	err := bp.historyRepo.RecordBlock(blockHeaderHash, blockHeader, blockBody, scrResultsFromPool, receiptsFromPool, logs)
+	err := bp.historyRepo.RecordBlock(blockHeaderHash, blockHeader, blockBody, txsFromPool, scrResultsFromPool, rewardsFromPool, receiptsFromPool, logs)
 	if err != nil {
 		log.Error("historyRepo.RecordBlock()", "blockHeaderHash", blockHeaderHash, "error", err.Error())
 	}
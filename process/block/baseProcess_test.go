@@ -298,6 +298,7 @@ func createComponentHolderMocks() (
 		UInt64ByteSliceConv: &mock.Uint64ByteSliceConverterMock{},
 		StatusField:         &statusHandlerMock.AppStatusHandlerStub{},
 		RoundField:          &mock.RoundHandlerMock{},
+		MetricsHandlerField: &statusHandlerMock.MetricsHandlerStub{},
 	}
 
 	dataComponents := &mock.DataComponentsMock{
@@ -25,7 +25,7 @@ func (bp *baseProcessor) ComputeHeaderHash(hdr data.HeaderHandler) ([]byte, erro
 }
 
 func (bp *baseProcessor) VerifyStateRoot(rootHash []byte) bool {
-	return bp.verifyStateRoot(rootHash)
+	return bp.verifyStateRoot(rootHash, nil)
 }
 
 func (bp *baseProcessor) CheckBlockValidity(
@@ -52,7 +52,7 @@ func (sp *shardProcessor) ReceivedMetaBlock(header data.HeaderHandler, metaBlock
 }
 
 func (sp *shardProcessor) CreateMiniBlocks(haveTime func() bool) (*block.Body, error) {
-	return sp.createMiniBlocks(haveTime)
+	return sp.createMiniBlocks(haveTime, nil)
 }
 
 func (sp *shardProcessor) GetOrderedProcessedMetaBlocksFromHeader(header *block.Header) ([]data.HeaderHandler, error) {
@@ -90,6 +90,7 @@ func NewShardProcessorEmptyWith3shards(
 		UInt64ByteSliceConv: &mock.Uint64ByteSliceConverterMock{},
 		StatusField:         &statusHandlerMock.AppStatusHandlerStub{},
 		RoundField:          &mock.RoundHandlerMock{},
+		MetricsHandlerField: &statusHandlerMock.MetricsHandlerStub{},
 	}
 	dataComponents := &mock.DataComponentsMock{
 		Storage:    &mock.ChainStorerMock{},
@@ -362,11 +363,11 @@ func (sp *shardProcessor) ApplyBodyToHeader(shardHdr *block.Header, body *block.
 }
 
 func (mp *metaProcessor) CreateBlockBody(metaBlock *block.MetaBlock, haveTime func() bool) (data.BodyHandler, error) {
-	return mp.createBlockBody(metaBlock, haveTime)
+	return mp.createBlockBody(metaBlock, haveTime, nil)
 }
 
 func (sp *shardProcessor) CreateBlockBody(shardHdr *block.Header, haveTime func() bool) (data.BodyHandler, error) {
-	return sp.createBlockBody(shardHdr, haveTime)
+	return sp.createBlockBody(shardHdr, haveTime, nil)
 }
 
 func (sp *shardProcessor) CheckEpochCorrectnessCrossChain() error {
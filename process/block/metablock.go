@@ -17,6 +17,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/debug/tracing"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/process/block/processedMb"
@@ -96,6 +97,7 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		uint64Converter:               arguments.CoreComponents.Uint64ByteSliceConverter(),
 		requestHandler:                arguments.RequestHandler,
 		appStatusHandler:              arguments.CoreComponents.StatusHandler(),
+		metricsHandler:                arguments.CoreComponents.MetricsHandler(),
 		blockChainHook:                arguments.BlockChainHook,
 		txCoordinator:                 arguments.TxCoordinator,
 		epochStartTrigger:             arguments.EpochStartTrigger,
@@ -164,6 +166,9 @@ func (mp *metaProcessor) ProcessBlock(
 	bodyHandler data.BodyHandler,
 	haveTime func() time.Duration,
 ) error {
+	defer mp.observeBlockOperation(common.BlockOperationProcess, time.Now())
+	span := startBlockSpan("metaProcessor.ProcessBlock", headerHandler)
+	defer span.End()
 
 	if haveTime == nil {
 		return process.ErrNilHaveTimeHandler
@@ -251,7 +256,7 @@ func (mp *metaProcessor) ProcessBlock(
 	}
 
 	if header.IsStartOfEpochBlock() {
-		err = mp.processEpochStartMetaBlock(header, body)
+		err = mp.processEpochStartMetaBlock(header, body, span)
 		return err
 	}
 
@@ -262,7 +267,9 @@ func (mp *metaProcessor) ProcessBlock(
 		return process.ErrTimeIsOut
 	}
 
+	isDataPreparedSpan := span.StartChild("transactionCoordinator.IsDataPreparedForProcessing")
 	err = mp.txCoordinator.IsDataPreparedForProcessing(haveTime)
+	isDataPreparedSpan.EndWithError(err)
 	if err != nil {
 		return err
 	}
@@ -323,12 +330,16 @@ func (mp *metaProcessor) ProcessBlock(
 		return err
 	}
 
-	err = mp.txCoordinator.ProcessBlockTransaction(body, haveTime)
+	processSpan := span.StartChild("transactionCoordinator.ProcessBlockTransaction")
+	err = mp.txCoordinator.ProcessBlockTransaction(body, haveTime, processSpan)
+	processSpan.EndWithError(err)
 	if err != nil {
 		return err
 	}
 
+	verifySpan := span.StartChild("transactionCoordinator.VerifyCreatedBlockTransactions")
 	err = mp.txCoordinator.VerifyCreatedBlockTransactions(header, body)
+	verifySpan.EndWithError(err)
 	if err != nil {
 		return err
 	}
@@ -343,7 +354,7 @@ func (mp *metaProcessor) ProcessBlock(
 		return err
 	}
 
-	if !mp.verifyStateRoot(header.GetRootHash()) {
+	if !mp.verifyStateRoot(header.GetRootHash(), span) {
 		err = process.ErrRootStateDoesNotMatch
 		return err
 	}
@@ -359,6 +370,7 @@ func (mp *metaProcessor) ProcessBlock(
 func (mp *metaProcessor) processEpochStartMetaBlock(
 	header *block.MetaBlock,
 	body *block.Body,
+	parentSpan *tracing.Span,
 ) error {
 	err := mp.epochStartDataCreator.VerifyEpochStartDataForMetablock(header)
 	if err != nil {
@@ -432,7 +444,7 @@ func (mp *metaProcessor) processEpochStartMetaBlock(
 		return err
 	}
 
-	if !mp.verifyStateRoot(header.GetRootHash()) {
+	if !mp.verifyStateRoot(header.GetRootHash(), parentSpan) {
 		err = process.ErrRootStateDoesNotMatch
 		return err
 	}
@@ -558,6 +570,7 @@ func (mp *metaProcessor) indexBlock(
 	lastMetaBlock data.HeaderHandler,
 	notarizedHeadersHashes []string,
 	rewardsTxs map[string]data.TransactionHandler,
+	parentSpan *tracing.Span,
 ) {
 	if !mp.outportHandler.HasDrivers() {
 		return
@@ -629,7 +642,9 @@ func (mp *metaProcessor) indexBlock(
 		NotarizedHeadersHashes: notarizedHeadersHashes,
 		TransactionsPool:       pool,
 	}
+	span := parentSpan.StartChild("outport.SaveBlock")
 	mp.outportHandler.SaveBlock(args)
+	span.End()
 	log.Debug("indexed block", "hash", headerHash, "nonce", metaBlock.GetNonce(), "round", metaBlock.GetRound())
 
 	indexRoundInfo(mp.outportHandler, mp.nodesCoordinator, core.MetachainShardId, metaBlock, lastMetaBlock, signersIndexes)
@@ -698,6 +713,9 @@ func (mp *metaProcessor) CreateBlock(
 	initialHdr data.HeaderHandler,
 	haveTime func() bool,
 ) (data.HeaderHandler, data.BodyHandler, error) {
+	span := startBlockSpan("metaProcessor.CreateBlock", initialHdr)
+	defer span.End()
+
 	if check.IfNil(initialHdr) {
 		return nil, nil, process.ErrNilBlockHeader
 	}
@@ -736,7 +754,7 @@ func (mp *metaProcessor) CreateBlock(
 			return nil, nil, err
 		}
 	} else {
-		body, err = mp.createBlockBody(metaHdr, haveTime)
+		body, err = mp.createBlockBody(metaHdr, haveTime, span)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -893,7 +911,7 @@ func (mp *metaProcessor) createEpochStartBody(metaBlock *block.MetaBlock) (data.
 }
 
 // createBlockBody creates block body of metachain
-func (mp *metaProcessor) createBlockBody(metaBlock *block.MetaBlock, haveTime func() bool) (data.BodyHandler, error) {
+func (mp *metaProcessor) createBlockBody(metaBlock *block.MetaBlock, haveTime func() bool, parentSpan *tracing.Span) (data.BodyHandler, error) {
 	mp.createBlockStarted()
 	mp.blockSizeThrottler.ComputeCurrentMaxSize()
 
@@ -903,7 +921,7 @@ func (mp *metaProcessor) createBlockBody(metaBlock *block.MetaBlock, haveTime fu
 		"nonce", metaBlock.GetNonce(),
 	)
 
-	miniBlocks, err := mp.createMiniBlocks(haveTime)
+	miniBlocks, err := mp.createMiniBlocks(haveTime, parentSpan)
 	if err != nil {
 		return nil, err
 	}
@@ -918,6 +936,7 @@ func (mp *metaProcessor) createBlockBody(metaBlock *block.MetaBlock, haveTime fu
 
 func (mp *metaProcessor) createMiniBlocks(
 	haveTime func() bool,
+	parentSpan *tracing.Span,
 ) (*block.Body, error) {
 	var miniBlocks block.MiniBlockSlice
 
@@ -926,7 +945,9 @@ func (mp *metaProcessor) createMiniBlocks(
 		return &block.Body{MiniBlocks: miniBlocks}, nil
 	}
 
+	dstMeSpan := parentSpan.StartChild("metaProcessor.createAndProcessCrossMiniBlocksDstMe")
 	mbsToMe, numTxs, numShardHeaders, err := mp.createAndProcessCrossMiniBlocksDstMe(haveTime)
+	dstMeSpan.EndWithError(err)
 	if err != nil {
 		log.Debug("createAndProcessCrossMiniBlocksDstMe", "error", err.Error())
 	}
@@ -941,7 +962,10 @@ func (mp *metaProcessor) createMiniBlocks(
 		)
 	}
 
+	fromMeSpan := parentSpan.StartChild("transactionCoordinator.CreateMbsAndProcessTransactionsFromMe")
 	mbsFromMe := mp.txCoordinator.CreateMbsAndProcessTransactionsFromMe(haveTime)
+	fromMeSpan.SetUint64Attribute("miniblocks", uint64(len(mbsFromMe)))
+	fromMeSpan.End()
 	if len(mbsFromMe) > 0 {
 		miniBlocks = append(miniBlocks, mbsFromMe...)
 
@@ -1112,6 +1136,8 @@ func (mp *metaProcessor) CommitBlock(
 	headerHandler data.HeaderHandler,
 	bodyHandler data.BodyHandler,
 ) error {
+	defer mp.observeBlockOperation(common.BlockOperationCommit, time.Now())
+
 	var err error
 	defer func() {
 		if err != nil {
@@ -1119,6 +1145,11 @@ func (mp *metaProcessor) CommitBlock(
 		}
 	}()
 
+	span := startBlockSpan("metaProcessor.CommitBlock", headerHandler)
+	defer func() {
+		span.EndWithError(err)
+	}()
+
 	err = checkForNils(headerHandler, bodyHandler)
 	if err != nil {
 		return err
@@ -1160,9 +1191,9 @@ func (mp *metaProcessor) CommitBlock(
 	mp.commitEpochStart(header, body)
 	headerHash := mp.hasher.Compute(string(marshalizedHeader))
 	mp.saveMetaHeader(header, headerHash, marshalizedHeader)
-	mp.saveBody(body, header)
+	mp.saveBody(body, header, span)
 
-	err = mp.commitAll()
+	err = mp.commitAll(span)
 	if err != nil {
 		return err
 	}
@@ -1223,7 +1254,7 @@ func (mp *metaProcessor) CommitBlock(
 		mp.blockTracker.CleanupInvalidCrossHeaders(header.Epoch, header.Round)
 	}
 
-	mp.indexBlock(header, headerHash, body, lastMetaBlock, notarizedHeadersHashes, rewardsTxs)
+	mp.indexBlock(header, headerHash, body, lastMetaBlock, notarizedHeadersHashes, rewardsTxs, span)
 	mp.recordBlockInHistory(headerHash, headerHandler, bodyHandler)
 
 	highestFinalBlockNonce := mp.forkDetector.GetHighestFinalBlockNonce()
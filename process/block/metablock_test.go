@@ -43,6 +43,7 @@ func createMockComponentHolders() (
 		UInt64ByteSliceConv: &mock.Uint64ByteSliceConverterMock{},
 		StatusField:         &statusHandlerMock.AppStatusHandlerStub{},
 		RoundField:          &mock.RoundHandlerMock{RoundTimeDuration: time.Second},
+		MetricsHandlerField: &statusHandlerMock.MetricsHandlerStub{},
 	}
 
 	dataComponents := &mock.DataComponentsMock{
@@ -15,6 +15,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/debug/tracing"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/process/block/processedMb"
@@ -65,6 +66,7 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		uint64Converter:               arguments.CoreComponents.Uint64ByteSliceConverter(),
 		requestHandler:                arguments.RequestHandler,
 		appStatusHandler:              arguments.CoreComponents.StatusHandler(),
+		metricsHandler:                arguments.CoreComponents.MetricsHandler(),
 		blockChainHook:                arguments.BlockChainHook,
 		txCoordinator:                 arguments.TxCoordinator,
 		roundHandler:                  arguments.CoreComponents.RoundHandler(),
@@ -116,6 +118,9 @@ func (sp *shardProcessor) ProcessBlock(
 	bodyHandler data.BodyHandler,
 	haveTime func() time.Duration,
 ) error {
+	defer sp.observeBlockOperation(common.BlockOperationProcess, time.Now())
+	span := startBlockSpan("shardProcessor.ProcessBlock", headerHandler)
+	defer span.End()
 
 	if haveTime == nil {
 		return process.ErrNilHaveTimeHandler
@@ -178,7 +183,9 @@ func (sp *shardProcessor) ProcessBlock(
 		return process.ErrTimeIsOut
 	}
 
+	isDataPreparedSpan := span.StartChild("transactionCoordinator.IsDataPreparedForProcessing")
 	err = sp.txCoordinator.IsDataPreparedForProcessing(haveTime)
+	isDataPreparedSpan.EndWithError(err)
 	if err != nil {
 		return err
 	}
@@ -256,7 +263,9 @@ func (sp *shardProcessor) ProcessBlock(
 	}()
 
 	startTime := time.Now()
-	err = sp.txCoordinator.ProcessBlockTransaction(body, haveTime)
+	processSpan := span.StartChild("transactionCoordinator.ProcessBlockTransaction")
+	err = sp.txCoordinator.ProcessBlockTransaction(body, haveTime, processSpan)
+	processSpan.EndWithError(err)
 	elapsedTime := time.Since(startTime)
 	log.Debug("elapsed time to process block transaction",
 		"time [s]", elapsedTime,
@@ -265,7 +274,9 @@ func (sp *shardProcessor) ProcessBlock(
 		return err
 	}
 
+	verifySpan := span.StartChild("transactionCoordinator.VerifyCreatedBlockTransactions")
 	err = sp.txCoordinator.VerifyCreatedBlockTransactions(header, body)
+	verifySpan.EndWithError(err)
 	if err != nil {
 		return err
 	}
@@ -280,7 +291,7 @@ func (sp *shardProcessor) ProcessBlock(
 		return err
 	}
 
-	if !sp.verifyStateRoot(header.GetRootHash()) {
+	if !sp.verifyStateRoot(header.GetRootHash(), span) {
 		err = process.ErrRootStateDoesNotMatch
 		return err
 	}
@@ -524,6 +535,7 @@ func (sp *shardProcessor) indexBlockIfNeeded(
 	headerHash []byte,
 	header data.HeaderHandler,
 	lastBlockHeader data.HeaderHandler,
+	parentSpan *tracing.Span,
 ) {
 	if !sp.outportHandler.HasDrivers() {
 		return
@@ -616,7 +628,9 @@ func (sp *shardProcessor) indexBlockIfNeeded(
 		TransactionsPool:       pool,
 	}
 
+	span := parentSpan.StartChild("outport.SaveBlock")
 	sp.outportHandler.SaveBlock(args)
+	span.End()
 	log.Debug("indexed block", "hash", headerHash, "nonce", header.GetNonce(), "round", header.GetRound())
 
 	indexRoundInfo(sp.outportHandler, sp.nodesCoordinator, shardId, header, lastBlockHeader, signersIndexes)
@@ -704,6 +718,9 @@ func (sp *shardProcessor) CreateBlock(
 	initialHdr data.HeaderHandler,
 	haveTime func() bool,
 ) (data.HeaderHandler, data.BodyHandler, error) {
+	span := startBlockSpan("shardProcessor.CreateBlock", initialHdr)
+	defer span.End()
+
 	if check.IfNil(initialHdr) {
 		return nil, nil, process.ErrNilBlockHeader
 	}
@@ -724,7 +741,7 @@ func (sp *shardProcessor) CreateBlock(
 	sp.epochNotifier.CheckEpoch(shardHdr)
 	sp.blockChainHook.SetCurrentHeader(shardHdr)
 	shardHdr.SoftwareVersion = []byte(sp.headerIntegrityVerifier.GetVersion(shardHdr.Epoch))
-	body, err := sp.createBlockBody(shardHdr, haveTime)
+	body, err := sp.createBlockBody(shardHdr, haveTime, span)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -747,7 +764,7 @@ func (sp *shardProcessor) CreateBlock(
 
 // createBlockBody creates a a list of miniblocks by filling them with transactions out of the transactions pools
 // as long as the transactions limit for the block has not been reached and there is still time to add transactions
-func (sp *shardProcessor) createBlockBody(shardHdr *block.Header, haveTime func() bool) (*block.Body, error) {
+func (sp *shardProcessor) createBlockBody(shardHdr *block.Header, haveTime func() bool, parentSpan *tracing.Span) (*block.Body, error) {
 	sp.blockSizeThrottler.ComputeCurrentMaxSize()
 
 	log.Debug("started creating block body",
@@ -756,7 +773,7 @@ func (sp *shardProcessor) createBlockBody(shardHdr *block.Header, haveTime func(
 		"nonce", shardHdr.GetNonce(),
 	)
 
-	miniBlocks, err := sp.createMiniBlocks(haveTime)
+	miniBlocks, err := sp.createMiniBlocks(haveTime, parentSpan)
 	if err != nil {
 		return nil, err
 	}
@@ -771,6 +788,8 @@ func (sp *shardProcessor) CommitBlock(
 	headerHandler data.HeaderHandler,
 	bodyHandler data.BodyHandler,
 ) error {
+	defer sp.observeBlockOperation(common.BlockOperationCommit, time.Now())
+
 	var err error
 	defer func() {
 		if err != nil {
@@ -778,6 +797,11 @@ func (sp *shardProcessor) CommitBlock(
 		}
 	}()
 
+	span := startBlockSpan("shardProcessor.CommitBlock", headerHandler)
+	defer func() {
+		span.EndWithError(err)
+	}()
+
 	err = checkForNils(headerHandler, bodyHandler)
 	if err != nil {
 		return err
@@ -826,7 +850,7 @@ func (sp *shardProcessor) CommitBlock(
 		return err
 	}
 
-	sp.saveBody(body, header)
+	sp.saveBody(body, header, span)
 
 	processedMetaHdrs, err := sp.getOrderedProcessedMetaBlocksFromHeader(header)
 	if err != nil {
@@ -848,7 +872,7 @@ func (sp *shardProcessor) CommitBlock(
 		return err
 	}
 
-	err = sp.commitAll()
+	err = sp.commitAll(span)
 	if err != nil {
 		return err
 	}
@@ -895,7 +919,7 @@ func (sp *shardProcessor) CommitBlock(
 	}
 
 	sp.blockChain.SetCurrentBlockHeaderHash(headerHash)
-	sp.indexBlockIfNeeded(bodyHandler, headerHash, headerHandler, lastBlockHeader)
+	sp.indexBlockIfNeeded(bodyHandler, headerHash, headerHandler, lastBlockHeader, span)
 	sp.recordBlockInHistory(headerHash, headerHandler, bodyHandler)
 
 	lastCrossNotarizedHeader, _, err := sp.blockTracker.GetLastCrossNotarizedHeader(core.MetachainShardId)
@@ -1699,7 +1723,7 @@ func (sp *shardProcessor) requestMetaHeadersIfNeeded(hdrsAdded uint32, lastMetaH
 	}
 }
 
-func (sp *shardProcessor) createMiniBlocks(haveTime func() bool) (*block.Body, error) {
+func (sp *shardProcessor) createMiniBlocks(haveTime func() bool, parentSpan *tracing.Span) (*block.Body, error) {
 	var miniBlocks block.MiniBlockSlice
 
 	if sp.accountsDB[state.UserAccountsState].JournalLen() != 0 {
@@ -1716,7 +1740,9 @@ func (sp *shardProcessor) createMiniBlocks(haveTime func() bool) (*block.Body, e
 	}
 
 	startTime := time.Now()
+	dstMeSpan := parentSpan.StartChild("shardProcessor.createAndProcessMiniBlocksDstMe")
 	mbsToMe, numTxs, numMetaHeaders, err := sp.createAndProcessMiniBlocksDstMe(haveTime)
+	dstMeSpan.EndWithError(err)
 	elapsedTime := time.Since(startTime)
 	log.Debug("elapsed time to create mbs to me",
 		"time [s]", elapsedTime,
@@ -1747,7 +1773,10 @@ func (sp *shardProcessor) createMiniBlocks(haveTime func() bool) (*block.Body, e
 	}
 
 	startTime = time.Now()
+	fromMeSpan := parentSpan.StartChild("transactionCoordinator.CreateMbsAndProcessTransactionsFromMe")
 	mbsFromMe := sp.txCoordinator.CreateMbsAndProcessTransactionsFromMe(haveTime)
+	fromMeSpan.SetUint64Attribute("miniblocks", uint64(len(mbsFromMe)))
+	fromMeSpan.End()
 	elapsedTime = time.Since(startTime)
 	log.Debug("elapsed time to create mbs from me",
 		"time [s]", elapsedTime,
@@ -167,6 +167,18 @@ func TestNewShardProcessor_NilHasherShouldErr(t *testing.T) {
 	assert.Nil(t, sp)
 }
 
+func TestNewShardProcessor_NilMetricsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComponents, dataComponents, bootstrapComponents, statusComponents := createComponentHolderMocks()
+	coreComponents.MetricsHandlerField = nil
+	arguments := CreateMockArguments(coreComponents, dataComponents, bootstrapComponents, statusComponents)
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilMetricsHandler, err)
+	assert.Nil(t, sp)
+}
+
 func TestNewShardProcessor_NilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1721,6 +1733,14 @@ func TestShardProcessor_CommitBlockOkValsShouldWork(t *testing.T) {
 	t.Parallel()
 	tdp := initDataPool([]byte("tx_hash1"))
 	txHash := []byte("tx_hash1")
+	notifiedRound := uint64(0)
+	txPool := tdp.Transactions().(*testscommon.ShardedDataStub)
+	txPool.NotifyRoundCalled = func(round uint64) {
+		notifiedRound = round
+	}
+	tdp.TransactionsCalled = func() dataRetriever.ShardedDataCacherNotifier {
+		return txPool
+	}
 
 	rootHash := []byte("root hash")
 	hdrHash := []byte("header hash")
@@ -1821,6 +1841,7 @@ func TestShardProcessor_CommitBlockOkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, forkDetectorAddCalled)
 	assert.Equal(t, hdrHash, blkc.GetCurrentBlockHeaderHash())
+	assert.Equal(t, hdr.Round, notifiedRound)
 	//this should sleep as there is an async call to display current hdr and block in CommitBlock
 	time.Sleep(time.Second)
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/debug/tracing"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
@@ -415,10 +416,12 @@ func (tc *transactionCoordinator) RemoveTxsFromPool(body *block.Body) error {
 	return errFound
 }
 
-// ProcessBlockTransaction processes transactions and updates state tries
+// ProcessBlockTransaction processes transactions and updates state tries. The processing done by each pre-processor
+// is traced as a child of the given parent span
 func (tc *transactionCoordinator) ProcessBlockTransaction(
 	body *block.Body,
 	timeRemaining func() time.Duration,
+	parentSpan *tracing.Span,
 ) error {
 	if check.IfNil(body) {
 		return process.ErrNilBlockBody
@@ -441,7 +444,7 @@ func (tc *transactionCoordinator) ProcessBlockTransaction(
 	}
 
 	startTime := time.Now()
-	mbIndex, err := tc.processMiniBlocksToMe(body, haveTime)
+	mbIndex, err := tc.processMiniBlocksToMe(body, haveTime, parentSpan)
 	elapsedTime := time.Since(startTime)
 	log.Debug("elapsed time to processMiniBlocksToMe",
 		"time [s]", elapsedTime,
@@ -456,7 +459,7 @@ func (tc *transactionCoordinator) ProcessBlockTransaction(
 
 	miniBlocksFromMe := body.MiniBlocks[mbIndex:]
 	startTime = time.Now()
-	err = tc.processMiniBlocksFromMe(&block.Body{MiniBlocks: miniBlocksFromMe}, haveTime)
+	err = tc.processMiniBlocksFromMe(&block.Body{MiniBlocks: miniBlocksFromMe}, haveTime, parentSpan)
 	elapsedTime = time.Since(startTime)
 	log.Debug("elapsed time to processMiniBlocksFromMe",
 		"time [s]", elapsedTime,
@@ -471,6 +474,7 @@ func (tc *transactionCoordinator) ProcessBlockTransaction(
 func (tc *transactionCoordinator) processMiniBlocksFromMe(
 	body *block.Body,
 	haveTime func() bool,
+	parentSpan *tracing.Span,
 ) error {
 	for _, mb := range body.MiniBlocks {
 		if mb.SenderShardID != tc.shardCoordinator.SelfId() {
@@ -491,7 +495,9 @@ func (tc *transactionCoordinator) processMiniBlocksFromMe(
 			return process.ErrMissingPreProcessor
 		}
 
+		span := startPreProcessorSpan(parentSpan, blockType, len(separatedBodies[blockType].MiniBlocks))
 		err := preProc.ProcessBlockTransactions(separatedBodies[blockType], haveTime)
+		span.EndWithError(err)
 		if err != nil {
 			return err
 		}
@@ -511,6 +517,7 @@ func (tc *transactionCoordinator) processMiniBlocksFromMe(
 func (tc *transactionCoordinator) processMiniBlocksToMe(
 	body *block.Body,
 	haveTime func() bool,
+	parentSpan *tracing.Span,
 ) (int, error) {
 	// processing has to be done in order, as the order of different type of transactions over the same account is strict
 	// processing destination ME miniblocks first
@@ -526,7 +533,9 @@ func (tc *transactionCoordinator) processMiniBlocksToMe(
 			return mbIndex, process.ErrMissingPreProcessor
 		}
 
+		span := startPreProcessorSpan(parentSpan, miniBlock.Type, 1)
 		err := preProc.ProcessBlockTransactions(&block.Body{MiniBlocks: []*block.MiniBlock{miniBlock}}, haveTime)
+		span.EndWithError(err)
 		if err != nil {
 			return mbIndex, err
 		}
@@ -548,7 +557,6 @@ func (tc *transactionCoordinator) CreateMbsAndProcessCrossShardTransactionsDstMe
 	processedMiniBlocksHashes map[string]struct{},
 	haveTime func() bool,
 ) (block.MiniBlockSlice, uint32, bool, error) {
-
 	miniBlocks := make(block.MiniBlockSlice, 0)
 	nrTxAdded := uint32(0)
 	nrMiniBlocksProcessed := 0
@@ -668,7 +676,6 @@ func (tc *transactionCoordinator) CreateMbsAndProcessCrossShardTransactionsDstMe
 func (tc *transactionCoordinator) CreateMbsAndProcessTransactionsFromMe(
 	haveTime func() bool,
 ) block.MiniBlockSlice {
-
 	numMiniBlocksProcessed := 0
 	miniBlocks := make(block.MiniBlockSlice, 0)
 	for _, blockType := range tc.keysTxPreProcs {
@@ -744,6 +751,14 @@ func (tc *transactionCoordinator) CreateBlockStarted() {
 	tc.transactionsLogProcessor.Clean()
 }
 
+func startPreProcessorSpan(parentSpan *tracing.Span, blockType block.Type, numMiniBlocks int) *tracing.Span {
+	span := parentSpan.StartChild("preProcessor.ProcessBlockTransactions")
+	span.SetStringAttribute("type", blockType.String())
+	span.SetUint64Attribute("miniblocks", uint64(numMiniBlocks))
+
+	return span
+}
+
 func (tc *transactionCoordinator) getPreProcessor(blockType block.Type) process.PreProcessor {
 	tc.mutPreProcessor.RLock()
 	preprocessor, exists := tc.txPreProcessors[blockType]
@@ -18,6 +18,8 @@ type txValidator struct {
 	accounts             state.AccountsAdapter
 	shardCoordinator     sharding.Coordinator
 	whiteListHandler     process.WhiteListHandler
+	txReplacementChecker process.TxReplacementChecker
+	txExpiryChecker      process.TxExpiryChecker
 	pubkeyConverter      core.PubkeyConverter
 	maxNonceDeltaAllowed int
 }
@@ -27,6 +29,8 @@ func NewTxValidator(
 	accounts state.AccountsAdapter,
 	shardCoordinator sharding.Coordinator,
 	whiteListHandler process.WhiteListHandler,
+	txReplacementChecker process.TxReplacementChecker,
+	txExpiryChecker process.TxExpiryChecker,
 	pubkeyConverter core.PubkeyConverter,
 	maxNonceDeltaAllowed int,
 ) (*txValidator, error) {
@@ -39,6 +43,12 @@ func NewTxValidator(
 	if check.IfNil(whiteListHandler) {
 		return nil, process.ErrNilWhiteListHandler
 	}
+	if check.IfNil(txReplacementChecker) {
+		return nil, process.ErrNilTxReplacementChecker
+	}
+	if check.IfNil(txExpiryChecker) {
+		return nil, process.ErrNilTxExpiryChecker
+	}
 	if check.IfNil(pubkeyConverter) {
 		return nil, fmt.Errorf("%w in NewTxValidator", process.ErrNilPubkeyConverter)
 	}
@@ -47,6 +57,8 @@ func NewTxValidator(
 		accounts:             accounts,
 		shardCoordinator:     shardCoordinator,
 		whiteListHandler:     whiteListHandler,
+		txReplacementChecker: txReplacementChecker,
+		txExpiryChecker:      txExpiryChecker,
 		maxNonceDeltaAllowed: maxNonceDeltaAllowed,
 		pubkeyConverter:      pubkeyConverter,
 	}, nil
@@ -113,6 +125,52 @@ func (txv *txValidator) CheckTxValidity(interceptedTx process.TxValidatorHandler
 		)
 	}
 
+	err = txv.checkTxExpiry(interceptedTx)
+	if err != nil {
+		return err
+	}
+
+	return txv.checkTxReplacement(interceptedTx)
+}
+
+func (txv *txValidator) checkTxExpiry(interceptedTx process.TxValidatorHandler) error {
+	interceptedData, ok := interceptedTx.(process.InterceptedData)
+	if !ok {
+		return nil
+	}
+
+	err := txv.txExpiryChecker.CheckTxExpiry(interceptedData.Hash())
+	if err != nil {
+		return fmt.Errorf("%w, for address: %s", err, txv.pubkeyConverter.Encode(interceptedTx.SenderAddress()))
+	}
+
+	return nil
+}
+
+func (txv *txValidator) checkTxReplacement(interceptedTx process.TxValidatorHandler) error {
+	interceptedTxHandler, ok := interceptedTx.(processor.InterceptedTransactionHandler)
+	if !ok {
+		return nil
+	}
+	interceptedData, ok := interceptedTx.(process.InterceptedData)
+	if !ok {
+		return nil
+	}
+	tx := interceptedTxHandler.Transaction()
+	if check.IfNil(tx) {
+		return nil
+	}
+
+	err := txv.txReplacementChecker.CheckTxReplacement(
+		interceptedData.Hash(),
+		interceptedTxHandler.SenderAddress(),
+		interceptedTxHandler.Nonce(),
+		tx.GetGasPrice(),
+	)
+	if err != nil {
+		return fmt.Errorf("%w, for address: %s", err, txv.pubkeyConverter.Encode(interceptedTxHandler.SenderAddress()))
+	}
+
 	return nil
 }
 
@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
@@ -76,6 +78,8 @@ func TestNewTxValidator_NilAccountsShouldErr(t *testing.T) {
 		nil,
 		shardCoordinator,
 		&testscommon.WhiteListHandlerStub{},
+		&testscommon.ShardedDataStub{},
+		&testscommon.ShardedDataStub{},
 		mock.NewPubkeyConverterMock(32),
 		maxNonceDeltaAllowed,
 	)
@@ -93,6 +97,8 @@ func TestNewTxValidator_NilShardCoordinatorShouldErr(t *testing.T) {
 		adb,
 		nil,
 		&testscommon.WhiteListHandlerStub{},
+		&testscommon.ShardedDataStub{},
+		&testscommon.ShardedDataStub{},
 		mock.NewPubkeyConverterMock(32),
 		maxNonceDeltaAllowed,
 	)
@@ -111,6 +117,8 @@ func TestTxValidator_NewValidatorNilWhiteListHandlerShouldErr(t *testing.T) {
 		adb,
 		shardCoordinator,
 		nil,
+		&testscommon.ShardedDataStub{},
+		&testscommon.ShardedDataStub{},
 		mock.NewPubkeyConverterMock(32),
 		maxNonceDeltaAllowed,
 	)
@@ -119,6 +127,46 @@ func TestTxValidator_NewValidatorNilWhiteListHandlerShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilWhiteListHandler, err)
 }
 
+func TestNewTxValidator_NilTxReplacementCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb := getAccAdapter(0, big.NewInt(0))
+	maxNonceDeltaAllowed := 100
+	shardCoordinator := createMockCoordinator("_", 0)
+	txValidator, err := dataValidators.NewTxValidator(
+		adb,
+		shardCoordinator,
+		&testscommon.WhiteListHandlerStub{},
+		nil,
+		&testscommon.ShardedDataStub{},
+		mock.NewPubkeyConverterMock(32),
+		maxNonceDeltaAllowed,
+	)
+
+	assert.Nil(t, txValidator)
+	assert.Equal(t, process.ErrNilTxReplacementChecker, err)
+}
+
+func TestNewTxValidator_NilTxExpiryCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb := getAccAdapter(0, big.NewInt(0))
+	maxNonceDeltaAllowed := 100
+	shardCoordinator := createMockCoordinator("_", 0)
+	txValidator, err := dataValidators.NewTxValidator(
+		adb,
+		shardCoordinator,
+		&testscommon.WhiteListHandlerStub{},
+		&testscommon.ShardedDataStub{},
+		nil,
+		mock.NewPubkeyConverterMock(32),
+		maxNonceDeltaAllowed,
+	)
+
+	assert.Nil(t, txValidator)
+	assert.Equal(t, process.ErrNilTxExpiryChecker, err)
+}
+
 func TestNewTxValidator_NilPubkeyConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -129,6 +177,8 @@ func TestNewTxValidator_NilPubkeyConverterShouldErr(t *testing.T) {
 		adb,
 		shardCoordinator,
 		&testscommon.WhiteListHandlerStub{},
+		&testscommon.ShardedDataStub{},
+		&testscommon.ShardedDataStub{},
 		nil,
 		maxNonceDeltaAllowed,
 	)
@@ -147,6 +197,8 @@ func TestNewTxValidator_ShouldWork(t *testing.T) {
 		adb,
 		shardCoordinator,
 		&testscommon.WhiteListHandlerStub{},
+		&testscommon.ShardedDataStub{},
+		&testscommon.ShardedDataStub{},
 		mock.NewPubkeyConverterMock(32),
 		maxNonceDeltaAllowed,
 	)
@@ -169,6 +221,8 @@ func TestTxValidator_CheckTxValidityTxCrossShardShouldWork(t *testing.T) {
 		adb,
 		shardCoordinator,
 		&testscommon.WhiteListHandlerStub{},
+		&testscommon.ShardedDataStub{},
+		&testscommon.ShardedDataStub{},
 		mock.NewPubkeyConverterMock(32),
 		maxNonceDeltaAllowed,
 	)
@@ -194,6 +248,8 @@ func TestTxValidator_CheckTxValidityAccountNonceIsGreaterThanTxNonceShouldReturn
 		adb,
 		shardCoordinator,
 		&testscommon.WhiteListHandlerStub{},
+		&testscommon.ShardedDataStub{},
+		&testscommon.ShardedDataStub{},
 		mock.NewPubkeyConverterMock(32),
 		maxNonceDeltaAllowed,
 	)
@@ -220,6 +276,8 @@ func TestTxValidator_CheckTxValidityTxNonceIsTooHigh(t *testing.T) {
 		adb,
 		shardCoordinator,
 		&testscommon.WhiteListHandlerStub{},
+		&testscommon.ShardedDataStub{},
+		&testscommon.ShardedDataStub{},
 		mock.NewPubkeyConverterMock(32),
 		maxNonceDeltaAllowed,
 	)
@@ -248,6 +306,8 @@ func TestTxValidator_CheckTxValidityAccountBalanceIsLessThanTxTotalValueShouldRe
 		adb,
 		shardCoordinator,
 		&testscommon.WhiteListHandlerStub{},
+		&testscommon.ShardedDataStub{},
+		&testscommon.ShardedDataStub{},
 		mock.NewPubkeyConverterMock(32),
 		maxNonceDeltaAllowed,
 	)
@@ -275,6 +335,8 @@ func TestTxValidator_CheckTxValidityAccountNotExitsShouldReturnFalse(t *testing.
 		accDB,
 		shardCoordinator,
 		&testscommon.WhiteListHandlerStub{},
+		&testscommon.ShardedDataStub{},
+		&testscommon.ShardedDataStub{},
 		mock.NewPubkeyConverterMock(32),
 		maxNonceDeltaAllowed,
 	)
@@ -304,6 +366,8 @@ func TestTxValidator_CheckTxValidityAccountNotExitsButWhiteListedShouldReturnTru
 				return true
 			},
 		},
+		&testscommon.ShardedDataStub{},
+		&testscommon.ShardedDataStub{},
 		mock.NewPubkeyConverterMock(32),
 		maxNonceDeltaAllowed,
 	)
@@ -338,6 +402,8 @@ func TestTxValidator_CheckTxValidityWrongAccountTypeShouldReturnFalse(t *testing
 		accDB,
 		shardCoordinator,
 		&testscommon.WhiteListHandlerStub{},
+		&testscommon.ShardedDataStub{},
+		&testscommon.ShardedDataStub{},
 		mock.NewPubkeyConverterMock(32),
 		maxNonceDeltaAllowed,
 	)
@@ -362,6 +428,8 @@ func TestTxValidator_CheckTxValidityTxIsOkShouldReturnTrue(t *testing.T) {
 		adb,
 		shardCoordinator,
 		&testscommon.WhiteListHandlerStub{},
+		&testscommon.ShardedDataStub{},
+		&testscommon.ShardedDataStub{},
 		mock.NewPubkeyConverterMock(32),
 		maxNonceDeltaAllowed,
 	)
@@ -374,6 +442,186 @@ func TestTxValidator_CheckTxValidityTxIsOkShouldReturnTrue(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestTxValidator_CheckTxValidityShouldCheckTxReplacement(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("hash")
+	addressMock := []byte("address")
+	currentShard := uint32(0)
+	txNonce := uint64(1)
+	gasPrice := uint64(1100)
+	expectedErr := errors.New("expected error")
+
+	createInterceptedTx := func() process.TxValidatorHandler {
+		return struct {
+			*testscommon.InterceptedDataStub
+			*mock.InterceptedTxHandlerStub
+		}{
+			InterceptedDataStub: &testscommon.InterceptedDataStub{
+				HashCalled: func() []byte {
+					return txHash
+				},
+			},
+			InterceptedTxHandlerStub: &mock.InterceptedTxHandlerStub{
+				SenderShardIdCalled: func() uint32 {
+					return currentShard
+				},
+				NonceCalled: func() uint64 {
+					return txNonce
+				},
+				SenderAddressCalled: func() []byte {
+					return addressMock
+				},
+				FeeCalled: func() *big.Int {
+					return big.NewInt(0)
+				},
+				TransactionCalled: func() data.TransactionHandler {
+					return &transaction.Transaction{Nonce: txNonce, GasPrice: gasPrice}
+				},
+			},
+		}
+	}
+
+	t.Run("underpriced replacement should error", func(t *testing.T) {
+		t.Parallel()
+
+		checkCalled := false
+		txValidator, _ := dataValidators.NewTxValidator(
+			getAccAdapter(0, big.NewInt(10)),
+			createMockCoordinator("_", currentShard),
+			&testscommon.WhiteListHandlerStub{},
+			&testscommon.ShardedDataStub{
+				CheckTxReplacementCalled: func(hash []byte, sender []byte, nonce uint64, price uint64) error {
+					checkCalled = true
+					assert.Equal(t, txHash, hash)
+					assert.Equal(t, addressMock, sender)
+					assert.Equal(t, txNonce, nonce)
+					assert.Equal(t, gasPrice, price)
+					return expectedErr
+				},
+			},
+			&testscommon.ShardedDataStub{},
+			mock.NewPubkeyConverterMock(32),
+			100,
+		)
+
+		result := txValidator.CheckTxValidity(createInterceptedTx())
+		assert.True(t, errors.Is(result, expectedErr))
+		assert.True(t, checkCalled)
+	})
+	t.Run("whitelisted transaction should not be checked", func(t *testing.T) {
+		t.Parallel()
+
+		txValidator, _ := dataValidators.NewTxValidator(
+			getAccAdapter(0, big.NewInt(10)),
+			createMockCoordinator("_", currentShard),
+			&testscommon.WhiteListHandlerStub{
+				IsWhiteListedCalled: func(interceptedData process.InterceptedData) bool {
+					return true
+				},
+			},
+			&testscommon.ShardedDataStub{
+				CheckTxReplacementCalled: func(_ []byte, _ []byte, _ uint64, _ uint64) error {
+					assert.Fail(t, "should not have been called")
+					return expectedErr
+				},
+			},
+			&testscommon.ShardedDataStub{},
+			mock.NewPubkeyConverterMock(32),
+			100,
+		)
+
+		result := txValidator.CheckTxValidity(createInterceptedTx())
+		assert.Nil(t, result)
+	})
+}
+
+func TestTxValidator_CheckTxValidityShouldCheckTxExpiry(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("hash")
+	addressMock := []byte("address")
+	currentShard := uint32(0)
+	expectedErr := errors.New("expected error")
+
+	createInterceptedTx := func() process.TxValidatorHandler {
+		return struct {
+			*testscommon.InterceptedDataStub
+			*mock.InterceptedTxHandlerStub
+		}{
+			InterceptedDataStub: &testscommon.InterceptedDataStub{
+				HashCalled: func() []byte {
+					return txHash
+				},
+			},
+			InterceptedTxHandlerStub: &mock.InterceptedTxHandlerStub{
+				SenderShardIdCalled: func() uint32 {
+					return currentShard
+				},
+				SenderAddressCalled: func() []byte {
+					return addressMock
+				},
+				NonceCalled: func() uint64 {
+					return 1
+				},
+				FeeCalled: func() *big.Int {
+					return big.NewInt(0)
+				},
+			},
+		}
+	}
+
+	t.Run("expired transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		checkCalled := false
+		txValidator, _ := dataValidators.NewTxValidator(
+			getAccAdapter(0, big.NewInt(10)),
+			createMockCoordinator("_", currentShard),
+			&testscommon.WhiteListHandlerStub{},
+			&testscommon.ShardedDataStub{},
+			&testscommon.ShardedDataStub{
+				CheckTxExpiryCalled: func(hash []byte) error {
+					checkCalled = true
+					assert.Equal(t, txHash, hash)
+					return expectedErr
+				},
+			},
+			mock.NewPubkeyConverterMock(32),
+			100,
+		)
+
+		result := txValidator.CheckTxValidity(createInterceptedTx())
+		assert.True(t, errors.Is(result, expectedErr))
+		assert.True(t, checkCalled)
+	})
+	t.Run("whitelisted transaction should not be checked", func(t *testing.T) {
+		t.Parallel()
+
+		txValidator, _ := dataValidators.NewTxValidator(
+			getAccAdapter(0, big.NewInt(10)),
+			createMockCoordinator("_", currentShard),
+			&testscommon.WhiteListHandlerStub{
+				IsWhiteListedCalled: func(interceptedData process.InterceptedData) bool {
+					return true
+				},
+			},
+			&testscommon.ShardedDataStub{},
+			&testscommon.ShardedDataStub{
+				CheckTxExpiryCalled: func(_ []byte) error {
+					assert.Fail(t, "should not have been called")
+					return expectedErr
+				},
+			},
+			mock.NewPubkeyConverterMock(32),
+			100,
+		)
+
+		result := txValidator.CheckTxValidity(createInterceptedTx())
+		assert.Nil(t, result)
+	})
+}
+
 //------- IsInterfaceNil
 
 func TestTxValidator_IsInterfaceNil(t *testing.T) {
@@ -385,6 +633,8 @@ func TestTxValidator_IsInterfaceNil(t *testing.T) {
 		adb,
 		shardCoordinator,
 		&testscommon.WhiteListHandlerStub{},
+		&testscommon.ShardedDataStub{},
+		&testscommon.ShardedDataStub{},
 		mock.NewPubkeyConverterMock(32),
 		100,
 	)
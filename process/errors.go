@@ -416,6 +416,9 @@ var ErrMissingPreProcessor = errors.New("pre processor is missing")
 // ErrNilAppStatusHandler defines the error for setting a nil AppStatusHandler
 var ErrNilAppStatusHandler = errors.New("nil AppStatusHandler")
 
+// ErrNilMetricsHandler signals that a nil metrics handler has been provided
+var ErrNilMetricsHandler = errors.New("nil metrics handler")
+
 // ErrNilInterceptedDataFactory signals that a nil intercepted data factory was provided
 var ErrNilInterceptedDataFactory = errors.New("nil intercepted data factory")
 
@@ -554,6 +557,16 @@ var ErrNilNodesSetup = errors.New("nil nodes setup")
 // ErrNilBlackListCacher signals that a nil black list cacher was provided
 var ErrNilBlackListCacher = errors.New("nil black list cacher")
 
+// ErrNilPeerReputationHandler signals that a nil peer reputation handler has been provided
+var ErrNilPeerReputationHandler = errors.New("nil peer reputation handler")
+
+// ErrNilAntifloodConfigHandler signals that a nil antiflood config handler has been provided
+var ErrNilAntifloodConfigHandler = errors.New("nil antiflood config handler")
+
+// ErrAntifloodEnabledFlagChanged signals that a new antiflood configuration tried to enable or disable the antiflood
+// mechanism, which can only be done with a restart
+var ErrAntifloodEnabledFlagChanged = errors.New("the antiflood enabled flag can not be changed without a restart")
+
 // ErrNilPeerShardMapper signals that a nil peer shard mapper has been provided
 var ErrNilPeerShardMapper = errors.New("nil peer shard mapper")
 
@@ -659,6 +672,12 @@ var ErrNilMiniBlocksProvider = errors.New("nil miniblocks provider")
 // ErrNilWhiteListHandler signals that white list handler is nil
 var ErrNilWhiteListHandler = errors.New("nil whitelist handler")
 
+// ErrNilTxReplacementChecker signals that a nil transaction replacement checker has been provided
+var ErrNilTxReplacementChecker = errors.New("nil transaction replacement checker")
+
+// ErrNilTxExpiryChecker signals that a nil transaction expiry checker has been provided
+var ErrNilTxExpiryChecker = errors.New("nil transaction expiry checker")
+
 // ErrNilPreferredPeersHolder signals that preferred peers holder is nil
 var ErrNilPreferredPeersHolder = errors.New("nil preferred peers holder")
 
@@ -994,3 +1013,21 @@ var ErrResultingSCRIsTooBig = errors.New("resulting SCR is too big")
 
 // ErrNotAllowedToWriteUnderProtectedKey signals that writing under protected key is not allowed
 var ErrNotAllowedToWriteUnderProtectedKey = errors.New("not allowed to write under protected key")
+
+// ErrBlockNonceAndHashBothProvided signals that both a block nonce and a block hash were provided for the same query
+var ErrBlockNonceAndHashBothProvided = errors.New("only one of block nonce and block hash can be provided")
+
+// ErrStateNotAvailable signals that the state of the requested block is no longer available
+var ErrStateNotAvailable = errors.New("state not available")
+
+// ErrNilExecutionTracer signals that a nil execution tracer has been provided
+var ErrNilExecutionTracer = errors.New("nil execution tracer")
+
+// ErrNilPeersThroughputRecorder signals that a nil peers throughput recorder has been provided
+var ErrNilPeersThroughputRecorder = errors.New("nil peers throughput recorder")
+
+// ErrNilArwenVersionProvider signals that a nil Arwen version provider was provided
+var ErrNilArwenVersionProvider = errors.New("nil Arwen version provider")
+
+// ErrArwenVersionNotAvailableForQuery signals that the Arwen version needed by the block of a query could not be loaded
+var ErrArwenVersionNotAvailableForQuery = errors.New("the Arwen version of the requested block is not available for queries")
@@ -32,4 +32,5 @@ type CommonInterceptorsContainerFactoryArgs struct {
 	SizeCheckDelta            uint32
 	EnableSignTxWithHashEpoch uint32
 	RequestHandler            process.RequestHandler
+	PeersThroughputRecorder   process.PeersThroughputRecorder
 }
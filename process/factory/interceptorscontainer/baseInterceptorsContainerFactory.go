@@ -22,23 +22,25 @@ const numGoRoutines = 100
 const chunksProcessorRequestInterval = time.Millisecond * 400
 
 type baseInterceptorsContainerFactory struct {
-	container              process.InterceptorsContainer
-	shardCoordinator       sharding.Coordinator
-	accounts               state.AccountsAdapter
-	store                  dataRetriever.StorageService
-	dataPool               dataRetriever.PoolsHolder
-	messenger              process.TopicHandler
-	nodesCoordinator       sharding.NodesCoordinator
-	blockBlackList         process.TimeCacher
-	argInterceptorFactory  *interceptorFactory.ArgInterceptedDataFactory
-	globalThrottler        process.InterceptorThrottler
-	maxTxNonceDeltaAllowed int
-	antifloodHandler       process.P2PAntifloodHandler
-	whiteListHandler       process.WhiteListHandler
-	whiteListerVerifiedTxs process.WhiteListHandler
-	preferredPeersHolder   process.PreferredPeersHolderHandler
-	hasher                 hashing.Hasher
-	requestHandler         process.RequestHandler
+	container               process.InterceptorsContainer
+	shardCoordinator        sharding.Coordinator
+	accounts                state.AccountsAdapter
+	store                   dataRetriever.StorageService
+	dataPool                dataRetriever.PoolsHolder
+	messenger               process.TopicHandler
+	nodesCoordinator        sharding.NodesCoordinator
+	blockBlackList          process.TimeCacher
+	argInterceptorFactory   *interceptorFactory.ArgInterceptedDataFactory
+	globalThrottler         process.InterceptorThrottler
+	maxTxNonceDeltaAllowed  int
+	antifloodHandler        process.P2PAntifloodHandler
+	whiteListHandler        process.WhiteListHandler
+	whiteListerVerifiedTxs  process.WhiteListHandler
+	preferredPeersHolder    process.PreferredPeersHolderHandler
+	hasher                  hashing.Hasher
+	requestHandler          process.RequestHandler
+	peersThroughputRecorder process.PeersThroughputRecorder
+	metricsHandler          common.MetricsHandler
 }
 
 func checkBaseParams(
@@ -56,6 +58,7 @@ func checkBaseParams(
 	whiteListerVerifiedTxs process.WhiteListHandler,
 	preferredPeersHolder process.PreferredPeersHolderHandler,
 	requestHandler process.RequestHandler,
+	peersThroughputRecorder process.PeersThroughputRecorder,
 ) error {
 	if check.IfNil(coreComponents) {
 		return process.ErrNilCoreComponentsHolder
@@ -87,6 +90,9 @@ func checkBaseParams(
 	if check.IfNil(coreComponents.EpochNotifier()) {
 		return process.ErrNilEpochNotifier
 	}
+	if check.IfNil(coreComponents.MetricsHandler()) {
+		return process.ErrNilMetricsHandler
+	}
 	if len(coreComponents.ChainID()) == 0 {
 		return process.ErrInvalidChainID
 	}
@@ -138,6 +144,9 @@ func checkBaseParams(
 	if check.IfNil(requestHandler) {
 		return process.ErrNilRequestHandler
 	}
+	if check.IfNil(peersThroughputRecorder) {
+		return process.ErrNilPeersThroughputRecorder
+	}
 
 	return nil
 }
@@ -206,6 +215,8 @@ func (bicf *baseInterceptorsContainerFactory) createOneTxInterceptor(topic strin
 		bicf.accounts,
 		bicf.shardCoordinator,
 		bicf.whiteListHandler,
+		bicf.dataPool.Transactions(),
+		bicf.dataPool.Transactions(),
 		addrPubKeyConverter,
 		bicf.maxTxNonceDeltaAllowed,
 	)
@@ -237,6 +248,7 @@ func (bicf *baseInterceptorsContainerFactory) createOneTxInterceptor(topic strin
 			Throttler:            bicf.globalThrottler,
 			AntifloodHandler:     bicf.antifloodHandler,
 			WhiteListRequest:     bicf.whiteListHandler,
+			MetricsHandler:       bicf.metricsHandler,
 			CurrentPeerId:        bicf.messenger.ID(),
 			PreferredPeersHolder: bicf.preferredPeersHolder,
 		},
@@ -280,6 +292,7 @@ func (bicf *baseInterceptorsContainerFactory) createOneUnsignedTxInterceptor(top
 			Throttler:            bicf.globalThrottler,
 			AntifloodHandler:     bicf.antifloodHandler,
 			WhiteListRequest:     bicf.whiteListHandler,
+			MetricsHandler:       bicf.metricsHandler,
 			CurrentPeerId:        bicf.messenger.ID(),
 			PreferredPeersHolder: bicf.preferredPeersHolder,
 		},
@@ -323,6 +336,7 @@ func (bicf *baseInterceptorsContainerFactory) createOneRewardTxInterceptor(topic
 			Throttler:            bicf.globalThrottler,
 			AntifloodHandler:     bicf.antifloodHandler,
 			WhiteListRequest:     bicf.whiteListHandler,
+			MetricsHandler:       bicf.metricsHandler,
 			CurrentPeerId:        bicf.messenger.ID(),
 			PreferredPeersHolder: bicf.preferredPeersHolder,
 		},
@@ -365,6 +379,7 @@ func (bicf *baseInterceptorsContainerFactory) generateHeaderInterceptors() error
 			Throttler:            bicf.globalThrottler,
 			AntifloodHandler:     bicf.antifloodHandler,
 			WhiteListRequest:     bicf.whiteListHandler,
+			MetricsHandler:       bicf.metricsHandler,
 			CurrentPeerId:        bicf.messenger.ID(),
 			PreferredPeersHolder: bicf.preferredPeersHolder,
 		},
@@ -453,6 +468,7 @@ func (bicf *baseInterceptorsContainerFactory) createOneMiniBlocksInterceptor(top
 			Throttler:            bicf.globalThrottler,
 			AntifloodHandler:     bicf.antifloodHandler,
 			WhiteListRequest:     bicf.whiteListHandler,
+			MetricsHandler:       bicf.metricsHandler,
 			CurrentPeerId:        bicf.messenger.ID(),
 			PreferredPeersHolder: bicf.preferredPeersHolder,
 		},
@@ -492,6 +508,7 @@ func (bicf *baseInterceptorsContainerFactory) generateMetachainHeaderInterceptor
 			Throttler:            bicf.globalThrottler,
 			AntifloodHandler:     bicf.antifloodHandler,
 			WhiteListRequest:     bicf.whiteListHandler,
+			MetricsHandler:       bicf.metricsHandler,
 			CurrentPeerId:        bicf.messenger.ID(),
 			PreferredPeersHolder: bicf.preferredPeersHolder,
 		},
@@ -509,7 +526,7 @@ func (bicf *baseInterceptorsContainerFactory) generateMetachainHeaderInterceptor
 }
 
 func (bicf *baseInterceptorsContainerFactory) createOneTrieNodesInterceptor(topic string) (process.Interceptor, error) {
-	trieNodesProcessor, err := processor.NewTrieNodesInterceptorProcessor(bicf.dataPool.TrieNodes())
+	trieNodesProcessor, err := processor.NewTrieNodesInterceptorProcessor(bicf.dataPool.TrieNodes(), bicf.peersThroughputRecorder)
 	if err != nil {
 		return nil, err
 	}
@@ -529,6 +546,7 @@ func (bicf *baseInterceptorsContainerFactory) createOneTrieNodesInterceptor(topi
 			Throttler:            bicf.globalThrottler,
 			AntifloodHandler:     bicf.antifloodHandler,
 			WhiteListRequest:     bicf.whiteListHandler,
+			MetricsHandler:       bicf.metricsHandler,
 			CurrentPeerId:        bicf.messenger.ID(),
 			PreferredPeersHolder: bicf.preferredPeersHolder,
 		},
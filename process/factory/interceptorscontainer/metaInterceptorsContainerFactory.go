@@ -39,6 +39,7 @@ func NewMetaInterceptorsContainerFactory(
 		args.WhiteListerVerifiedTxs,
 		args.PreferredPeersHolder,
 		args.RequestHandler,
+		args.PeersThroughputRecorder,
 	)
 	if err != nil {
 		return nil, err
@@ -87,22 +88,24 @@ func NewMetaInterceptorsContainerFactory(
 
 	container := containers.NewInterceptorsContainer()
 	base := &baseInterceptorsContainerFactory{
-		container:              container,
-		shardCoordinator:       args.ShardCoordinator,
-		messenger:              args.Messenger,
-		store:                  args.Store,
-		dataPool:               args.DataPool,
-		nodesCoordinator:       args.NodesCoordinator,
-		blockBlackList:         args.BlockBlackList,
-		argInterceptorFactory:  argInterceptorFactory,
-		maxTxNonceDeltaAllowed: args.MaxTxNonceDeltaAllowed,
-		accounts:               args.Accounts,
-		antifloodHandler:       args.AntifloodHandler,
-		whiteListHandler:       args.WhiteListHandler,
-		whiteListerVerifiedTxs: args.WhiteListerVerifiedTxs,
-		preferredPeersHolder:   args.PreferredPeersHolder,
-		hasher:                 args.CoreComponents.Hasher(),
-		requestHandler:         args.RequestHandler,
+		container:               container,
+		shardCoordinator:        args.ShardCoordinator,
+		messenger:               args.Messenger,
+		store:                   args.Store,
+		dataPool:                args.DataPool,
+		nodesCoordinator:        args.NodesCoordinator,
+		blockBlackList:          args.BlockBlackList,
+		argInterceptorFactory:   argInterceptorFactory,
+		maxTxNonceDeltaAllowed:  args.MaxTxNonceDeltaAllowed,
+		accounts:                args.Accounts,
+		antifloodHandler:        args.AntifloodHandler,
+		whiteListHandler:        args.WhiteListHandler,
+		whiteListerVerifiedTxs:  args.WhiteListerVerifiedTxs,
+		preferredPeersHolder:    args.PreferredPeersHolder,
+		hasher:                  args.CoreComponents.Hasher(),
+		requestHandler:          args.RequestHandler,
+		peersThroughputRecorder: args.PeersThroughputRecorder,
+		metricsHandler:          args.CoreComponents.MetricsHandler(),
 	}
 
 	icf := &metaInterceptorsContainerFactory{
@@ -228,6 +231,7 @@ func (micf *metaInterceptorsContainerFactory) createOneShardHeaderInterceptor(to
 			Throttler:            micf.globalThrottler,
 			AntifloodHandler:     micf.antifloodHandler,
 			WhiteListRequest:     micf.whiteListHandler,
+			MetricsHandler:       micf.metricsHandler,
 			CurrentPeerId:        micf.messenger.ID(),
 			PreferredPeersHolder: micf.preferredPeersHolder,
 		},
@@ -200,6 +200,18 @@ func TestNewMetaInterceptorsContainerFactory_NilHasherShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilHasher, err)
 }
 
+func TestNewMetaInterceptorsContainerFactory_NilMetricsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComp, cryptoComp := createMockComponentHolders()
+	coreComp.MetricsHandlerField = nil
+	args := getArgumentsMeta(coreComp, cryptoComp)
+	icf, err := interceptorscontainer.NewMetaInterceptorsContainerFactory(args)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilMetricsHandler, err)
+}
+
 func TestNewMetaInterceptorsContainerFactory_NilHeaderSigVerifierShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -380,6 +392,18 @@ func TestNewMetaInterceptorsContainerFactory_NilRequestHandlerShouldErr(t *testi
 	assert.Equal(t, process.ErrNilRequestHandler, err)
 }
 
+func TestNewMetaInterceptorsContainerFactory_NilPeersThroughputRecorderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComp, cryptoComp := createMockComponentHolders()
+	args := getArgumentsMeta(coreComp, cryptoComp)
+	args.PeersThroughputRecorder = nil
+	icf, err := interceptorscontainer.NewMetaInterceptorsContainerFactory(args)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilPeersThroughputRecorder, err)
+}
+
 func TestNewMetaInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -576,5 +600,6 @@ func getArgumentsMeta(
 		ArgumentsParser:         &mock.ArgumentParserMock{},
 		PreferredPeersHolder:    &p2pmocks.PeersHolderStub{},
 		RequestHandler:          &testscommon.RequestHandlerStub{},
+		PeersThroughputRecorder: &mock.PeersThroughputRecorderStub{},
 	}
 }
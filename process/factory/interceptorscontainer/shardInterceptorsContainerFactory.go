@@ -37,6 +37,7 @@ func NewShardInterceptorsContainerFactory(
 		args.WhiteListerVerifiedTxs,
 		args.PreferredPeersHolder,
 		args.RequestHandler,
+		args.PeersThroughputRecorder,
 	)
 	if err != nil {
 		return nil, err
@@ -86,22 +87,24 @@ func NewShardInterceptorsContainerFactory(
 
 	container := containers.NewInterceptorsContainer()
 	base := &baseInterceptorsContainerFactory{
-		container:              container,
-		accounts:               args.Accounts,
-		shardCoordinator:       args.ShardCoordinator,
-		messenger:              args.Messenger,
-		store:                  args.Store,
-		dataPool:               args.DataPool,
-		nodesCoordinator:       args.NodesCoordinator,
-		argInterceptorFactory:  argInterceptorFactory,
-		blockBlackList:         args.BlockBlackList,
-		maxTxNonceDeltaAllowed: args.MaxTxNonceDeltaAllowed,
-		antifloodHandler:       args.AntifloodHandler,
-		whiteListHandler:       args.WhiteListHandler,
-		whiteListerVerifiedTxs: args.WhiteListerVerifiedTxs,
-		preferredPeersHolder:   args.PreferredPeersHolder,
-		hasher:                 args.CoreComponents.Hasher(),
-		requestHandler:         args.RequestHandler,
+		container:               container,
+		accounts:                args.Accounts,
+		shardCoordinator:        args.ShardCoordinator,
+		messenger:               args.Messenger,
+		store:                   args.Store,
+		dataPool:                args.DataPool,
+		nodesCoordinator:        args.NodesCoordinator,
+		argInterceptorFactory:   argInterceptorFactory,
+		blockBlackList:          args.BlockBlackList,
+		maxTxNonceDeltaAllowed:  args.MaxTxNonceDeltaAllowed,
+		antifloodHandler:        args.AntifloodHandler,
+		whiteListHandler:        args.WhiteListHandler,
+		whiteListerVerifiedTxs:  args.WhiteListerVerifiedTxs,
+		preferredPeersHolder:    args.PreferredPeersHolder,
+		hasher:                  args.CoreComponents.Hasher(),
+		requestHandler:          args.RequestHandler,
+		peersThroughputRecorder: args.PeersThroughputRecorder,
+		metricsHandler:          args.CoreComponents.MetricsHandler(),
 	}
 
 	icf := &shardInterceptorsContainerFactory{
@@ -17,6 +17,7 @@ import (
 	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/testscommon/p2pmocks"
 	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -211,6 +212,18 @@ func TestNewShardInterceptorsContainerFactory_NilKeyGenShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilKeyGen, err)
 }
 
+func TestNewShardInterceptorsContainerFactory_NilMetricsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComp, cryptoComp := createMockComponentHolders()
+	coreComp.MetricsHandlerField = nil
+	args := getArgumentsShard(coreComp, cryptoComp)
+	icf, err := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilMetricsHandler, err)
+}
+
 func TestNewShardInterceptorsContainerFactory_NilHeaderSigVerifierShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -371,6 +384,18 @@ func TestNewShardInterceptorsContainerFactory_EmptyEpochStartTriggerShouldErr(t
 	assert.Equal(t, process.ErrNilEpochStartTrigger, err)
 }
 
+func TestNewShardInterceptorsContainerFactory_NilPeersThroughputRecorderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComp, cryptoComp := createMockComponentHolders()
+	args := getArgumentsShard(coreComp, cryptoComp)
+	args.PeersThroughputRecorder = nil
+	icf, err := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilPeersThroughputRecorder, err)
+}
+
 func TestNewShardInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -613,6 +638,7 @@ func createMockComponentHolders() (*mock.CoreComponentsMock, *mock.CryptoCompone
 		},
 		EpochNotifierField:  &mock.EpochNotifierStub{},
 		TxVersionCheckField: versioning.NewTxVersionChecker(1),
+		MetricsHandlerField: &statusHandler.MetricsHandlerStub{},
 	}
 	cryptoComponents := &mock.CryptoComponentsMock{
 		BlockSig: &mock.SignerMock{},
@@ -652,5 +678,6 @@ func getArgumentsShard(
 		ArgumentsParser:         &mock.ArgumentParserMock{},
 		PreferredPeersHolder:    &p2pmocks.PeersHolderStub{},
 		RequestHandler:          &testscommon.RequestHandlerStub{},
+		PeersThroughputRecorder: &mock.PeersThroughputRecorderStub{},
 	}
 }
@@ -267,6 +267,11 @@ func (vmf *vmContainerFactory) SystemSmartContractContainerFactory() vm.SystemSC
 	return vmf.scFactory
 }
 
+// GetArwenVersion returns an empty string as the metachain does not run Arwen
+func (vmf *vmContainerFactory) GetArwenVersion(_ uint32) string {
+	return ""
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (vmf *vmContainerFactory) IsInterfaceNil() bool {
 	return vmf == nil
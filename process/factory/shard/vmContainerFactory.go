@@ -238,6 +238,11 @@ func (vmf *vmContainerFactory) createArwenVM(version config.ArwenVersionByEpoch)
 	return currentVM, nil
 }
 
+// GetArwenVersion returns the Arwen version used in the provided epoch
+func (vmf *vmContainerFactory) GetArwenVersion(epoch uint32) string {
+	return vmf.getMatchingVersion(epoch).Version
+}
+
 func (vmf *vmContainerFactory) getMatchingVersion(epoch uint32) config.ArwenVersionByEpoch {
 	matchingVersion := vmf.arwenVersions[len(vmf.arwenVersions)-1]
 	for idx := 0; idx < len(vmf.arwenVersions)-1; idx++ {
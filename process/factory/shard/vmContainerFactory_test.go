@@ -1,3 +1,4 @@
+//go:build !race
 // +build !race
 
 // TODO remove build condition above to allow -race -short, after Arwen fix
@@ -18,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
 	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
@@ -46,6 +48,7 @@ func createMockVMAccountsArguments() hooks.ArgBlockChainHook {
 		DataPool:           datapool,
 		CompiledSCPool:     datapool.SmartContracts(),
 		NilCompiledSCStore: true,
+		ExecutionTracer:    tracing.NewDisabledExecutionTracer(),
 	}
 	return arguments
 }
@@ -254,6 +257,29 @@ func getArwenVersion(t testing.TB, container process.VirtualMachinesContainer) s
 	return vm.GetVersion()
 }
 
+func TestVmContainerFactory_GetArwenVersion(t *testing.T) {
+	t.Parallel()
+
+	esdtTransferParser, _ := parsers.NewESDTTransferParser(&mock.MarshalizerMock{})
+	argsNewVMFactory := ArgVMContainerFactory{
+		Config:             makeVMConfig(),
+		BlockGasLimit:      10000,
+		GasSchedule:        mock.NewGasScheduleNotifierMock(arwenConfig.MakeGasMapForTests()),
+		ArgBlockChainHook:  createMockVMAccountsArguments(),
+		EpochConfig:        config.EnableEpochs{},
+		ArwenChangeLocker:  &sync.RWMutex{},
+		EpochNotifier:      forking.NewGenericEpochNotifier(),
+		ESDTTransferParser: esdtTransferParser,
+	}
+	vmf, _ := NewVMContainerFactory(argsNewVMFactory)
+
+	assert.Equal(t, "v1.2", vmf.GetArwenVersion(0))
+	assert.Equal(t, "v1.2", vmf.GetArwenVersion(11))
+	assert.Equal(t, "v1.3", vmf.GetArwenVersion(13))
+	assert.Equal(t, "v1.4", vmf.GetArwenVersion(14))
+	assert.Equal(t, "v1.4", vmf.GetArwenVersion(100))
+}
+
 func makeVMConfig() config.VirtualMachineConfig {
 	return config.VirtualMachineConfig{
 		ArwenVersions: []config.ArwenVersionByEpoch{
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
@@ -19,6 +20,7 @@ type baseDataInterceptor struct {
 	mutDebugHandler      sync.RWMutex
 	debugHandler         process.InterceptedDebugger
 	preferredPeersHolder process.PreferredPeersHolderHandler
+	metricsHandler       common.MetricsHandler
 }
 
 func (bdi *baseDataInterceptor) preProcessMesage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
@@ -48,6 +50,14 @@ func (bdi *baseDataInterceptor) preProcessMesage(message p2p.MessageP2P, fromCon
 	return nil
 }
 
+func (bdi *baseDataInterceptor) recordReceivedMessage(message p2p.MessageP2P, err error) {
+	if message == nil {
+		return
+	}
+
+	bdi.metricsHandler.AddInterceptedMessage(bdi.topic, err == nil, len(message.Data()))
+}
+
 func (bdi *baseDataInterceptor) shouldSkipAntifloodChecks(fromConnectedPeer core.PeerID, message p2p.MessageP2P) bool {
 	if bdi.isMessageFromSelfToSelf(fromConnectedPeer, message) {
 		return true
@@ -27,6 +27,7 @@ type ArgMultiDataInterceptor struct {
 	AntifloodHandler     process.P2PAntifloodHandler
 	WhiteListRequest     process.WhiteListHandler
 	PreferredPeersHolder process.PreferredPeersHolderHandler
+	MetricsHandler       common.MetricsHandler
 	CurrentPeerId        core.PeerID
 }
 
@@ -66,6 +67,9 @@ func NewMultiDataInterceptor(arg ArgMultiDataInterceptor) (*MultiDataInterceptor
 	if check.IfNil(arg.PreferredPeersHolder) {
 		return nil, process.ErrNilPreferredPeersHolder
 	}
+	if check.IfNil(arg.MetricsHandler) {
+		return nil, process.ErrNilMetricsHandler
+	}
 	if len(arg.CurrentPeerId) == 0 {
 		return nil, process.ErrEmptyPeerID
 	}
@@ -78,6 +82,7 @@ func NewMultiDataInterceptor(arg ArgMultiDataInterceptor) (*MultiDataInterceptor
 			currentPeerId:        arg.CurrentPeerId,
 			processor:            arg.Processor,
 			preferredPeersHolder: arg.PreferredPeersHolder,
+			metricsHandler:       arg.MetricsHandler,
 			debugHandler:         resolver.NewDisabledInterceptorResolver(),
 		},
 		marshalizer:      arg.Marshalizer,
@@ -92,6 +97,13 @@ func NewMultiDataInterceptor(arg ArgMultiDataInterceptor) (*MultiDataInterceptor
 // ProcessReceivedMessage is the callback func from the p2p.Messenger and will be called each time a new message was received
 // (for the topic this validator was registered to)
 func (mdi *MultiDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	err := mdi.processReceivedMessage(message, fromConnectedPeer)
+	mdi.recordReceivedMessage(message, err)
+
+	return err
+}
+
+func (mdi *MultiDataInterceptor) processReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
 	err := mdi.preProcessMesage(message, fromConnectedPeer)
 	if err != nil {
 		return err
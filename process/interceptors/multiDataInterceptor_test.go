@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/p2pmocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -31,6 +32,7 @@ func createMockArgMultiDataInterceptor() interceptors.ArgMultiDataInterceptor {
 		AntifloodHandler:     &mock.P2PAntifloodHandlerStub{},
 		WhiteListRequest:     &testscommon.WhiteListHandlerStub{},
 		PreferredPeersHolder: &p2pmocks.PeersHolderStub{},
+		MetricsHandler:       &statusHandler.MetricsHandlerStub{},
 		CurrentPeerId:        "pid",
 	}
 }
@@ -112,6 +114,17 @@ func TestNewMultiDataInterceptor_NilPreferredPeersHolderShouldErr(t *testing.T)
 	assert.Equal(t, process.ErrNilPreferredPeersHolder, err)
 }
 
+func TestNewMultiDataInterceptor_NilMetricsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgMultiDataInterceptor()
+	arg.MetricsHandler = nil
+	mdi, err := interceptors.NewMultiDataInterceptor(arg)
+
+	assert.Nil(t, mdi)
+	assert.Equal(t, process.ErrNilMetricsHandler, err)
+}
+
 func TestNewMultiDataInterceptor_NilWhiteListHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
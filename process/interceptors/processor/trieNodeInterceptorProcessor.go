@@ -15,17 +15,25 @@ type interceptedTrieNodeHandler interface {
 
 // TrieNodeInterceptorProcessor is the processor used when intercepting trie nodes
 type TrieNodeInterceptorProcessor struct {
-	interceptedNodes storage.Cacher
+	interceptedNodes        storage.Cacher
+	peersThroughputRecorder process.PeersThroughputRecorder
 }
 
 // NewTrieNodesInterceptorProcessor creates a new instance of TrieNodeInterceptorProcessor
-func NewTrieNodesInterceptorProcessor(interceptedNodes storage.Cacher) (*TrieNodeInterceptorProcessor, error) {
+func NewTrieNodesInterceptorProcessor(
+	interceptedNodes storage.Cacher,
+	peersThroughputRecorder process.PeersThroughputRecorder,
+) (*TrieNodeInterceptorProcessor, error) {
 	if check.IfNil(interceptedNodes) {
 		return nil, process.ErrNilCacher
 	}
+	if check.IfNil(peersThroughputRecorder) {
+		return nil, process.ErrNilPeersThroughputRecorder
+	}
 
 	return &TrieNodeInterceptorProcessor{
-		interceptedNodes: interceptedNodes,
+		interceptedNodes:        interceptedNodes,
+		peersThroughputRecorder: peersThroughputRecorder,
 	}, nil
 }
 
@@ -34,14 +42,16 @@ func (tnip *TrieNodeInterceptorProcessor) Validate(_ process.InterceptedData, _
 	return nil
 }
 
-// Save saves the intercepted trie node in the intercepted nodes cacher
-func (tnip *TrieNodeInterceptorProcessor) Save(data process.InterceptedData, _ core.PeerID, _ string) error {
+// Save saves the intercepted trie node in the intercepted nodes cacher and records its size as received from the
+// connected peer that sent it
+func (tnip *TrieNodeInterceptorProcessor) Save(data process.InterceptedData, fromConnectedPeer core.PeerID, _ string) error {
 	nodeData, ok := data.(interceptedTrieNodeHandler)
 	if !ok {
 		return process.ErrWrongTypeAssertion
 	}
 
 	tnip.interceptedNodes.Put(data.Hash(), nodeData, nodeData.SizeInBytes()+len(data.Hash()))
+	tnip.peersThroughputRecorder.AddReceivedData(fromConnectedPeer, nodeData.SizeInBytes())
 	return nil
 }
 
@@ -3,6 +3,7 @@ package processor_test
 import (
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
@@ -14,15 +15,23 @@ import (
 func TestNewTrieNodesInterceptorProcessor_NilCacherShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tnip, err := processor.NewTrieNodesInterceptorProcessor(nil)
+	tnip, err := processor.NewTrieNodesInterceptorProcessor(nil, &mock.PeersThroughputRecorderStub{})
 	assert.Nil(t, tnip)
 	assert.Equal(t, process.ErrNilCacher, err)
 }
 
+func TestNewTrieNodesInterceptorProcessor_NilPeersThroughputRecorderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tnip, err := processor.NewTrieNodesInterceptorProcessor(testscommon.NewCacherMock(), nil)
+	assert.Nil(t, tnip)
+	assert.Equal(t, process.ErrNilPeersThroughputRecorder, err)
+}
+
 func TestNewTrieNodesInterceptorProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
-	tnip, err := processor.NewTrieNodesInterceptorProcessor(testscommon.NewCacherMock())
+	tnip, err := processor.NewTrieNodesInterceptorProcessor(testscommon.NewCacherMock(), &mock.PeersThroughputRecorderStub{})
 	assert.Nil(t, err)
 	assert.NotNil(t, tnip)
 }
@@ -32,7 +41,7 @@ func TestNewTrieNodesInterceptorProcessor_OkValsShouldWork(t *testing.T) {
 func TestTrieNodesInterceptorProcessor_ValidateShouldWork(t *testing.T) {
 	t.Parallel()
 
-	tnip, _ := processor.NewTrieNodesInterceptorProcessor(testscommon.NewCacherMock())
+	tnip, _ := processor.NewTrieNodesInterceptorProcessor(testscommon.NewCacherMock(), &mock.PeersThroughputRecorderStub{})
 
 	assert.Nil(t, tnip.Validate(nil, ""))
 }
@@ -42,7 +51,7 @@ func TestTrieNodesInterceptorProcessor_ValidateShouldWork(t *testing.T) {
 func TestTrieNodesInterceptorProcessor_SaveWrongTypeAssertion(t *testing.T) {
 	t.Parallel()
 
-	tnip, _ := processor.NewTrieNodesInterceptorProcessor(testscommon.NewCacherMock())
+	tnip, _ := processor.NewTrieNodesInterceptorProcessor(testscommon.NewCacherMock(), &mock.PeersThroughputRecorderStub{})
 
 	err := tnip.Save(nil, "", "")
 	assert.Equal(t, process.ErrWrongTypeAssertion, err)
@@ -68,11 +77,19 @@ func TestTrieNodesInterceptorProcessor_SaveShouldPutInCacher(t *testing.T) {
 			return false
 		},
 	}
-	tnip, _ := processor.NewTrieNodesInterceptorProcessor(cacher)
+	receivedBytes := 0
+	peersThroughputRecorder := &mock.PeersThroughputRecorderStub{
+		AddReceivedDataCalled: func(peer core.PeerID, numBytes int) {
+			assert.Equal(t, core.PeerID("pid"), peer)
+			receivedBytes += numBytes
+		},
+	}
+	tnip, _ := processor.NewTrieNodesInterceptorProcessor(cacher, peersThroughputRecorder)
 
-	err := tnip.Save(interceptedTrieNode, "", "")
+	err := tnip.Save(interceptedTrieNode, "pid", "")
 	assert.Nil(t, err)
 	assert.True(t, putCalled)
+	assert.Equal(t, nodeSize, receivedBytes)
 }
 
 //------- IsInterfaceNil
@@ -18,6 +18,7 @@ type ArgSingleDataInterceptor struct {
 	AntifloodHandler     process.P2PAntifloodHandler
 	WhiteListRequest     process.WhiteListHandler
 	PreferredPeersHolder process.PreferredPeersHolderHandler
+	MetricsHandler       common.MetricsHandler
 	CurrentPeerId        core.PeerID
 }
 
@@ -51,6 +52,9 @@ func NewSingleDataInterceptor(arg ArgSingleDataInterceptor) (*SingleDataIntercep
 	if check.IfNil(arg.PreferredPeersHolder) {
 		return nil, process.ErrNilPreferredPeersHolder
 	}
+	if check.IfNil(arg.MetricsHandler) {
+		return nil, process.ErrNilMetricsHandler
+	}
 	if len(arg.CurrentPeerId) == 0 {
 		return nil, process.ErrEmptyPeerID
 	}
@@ -63,6 +67,7 @@ func NewSingleDataInterceptor(arg ArgSingleDataInterceptor) (*SingleDataIntercep
 			currentPeerId:        arg.CurrentPeerId,
 			processor:            arg.Processor,
 			preferredPeersHolder: arg.PreferredPeersHolder,
+			metricsHandler:       arg.MetricsHandler,
 			debugHandler:         resolver.NewDisabledInterceptorResolver(),
 		},
 		factory:          arg.DataFactory,
@@ -75,6 +80,13 @@ func NewSingleDataInterceptor(arg ArgSingleDataInterceptor) (*SingleDataIntercep
 // ProcessReceivedMessage is the callback func from the p2p.Messenger and will be called each time a new message was received
 // (for the topic this validator was registered to)
 func (sdi *SingleDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	err := sdi.processReceivedMessage(message, fromConnectedPeer)
+	sdi.recordReceivedMessage(message, err)
+
+	return err
+}
+
+func (sdi *SingleDataInterceptor) processReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
 	sdi.mutDebugHandler.RLock()
 	defer sdi.mutDebugHandler.RUnlock()
 
@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/p2pmocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -26,6 +27,7 @@ func createMockArgSingleDataInterceptor() interceptors.ArgSingleDataInterceptor
 		AntifloodHandler:     &mock.P2PAntifloodHandlerStub{},
 		WhiteListRequest:     &testscommon.WhiteListHandlerStub{},
 		PreferredPeersHolder: &p2pmocks.PeersHolderStub{},
+		MetricsHandler:       &statusHandler.MetricsHandlerStub{},
 		CurrentPeerId:        "pid",
 	}
 }
@@ -123,6 +125,17 @@ func TestNewSingleDataInterceptor_NilPreferredPeersHolderShouldErr(t *testing.T)
 	assert.Equal(t, process.ErrNilPreferredPeersHolder, err)
 }
 
+func TestNewSingleDataInterceptor_NilMetricsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgSingleDataInterceptor()
+	arg.MetricsHandler = nil
+	sdi, err := interceptors.NewSingleDataInterceptor(arg)
+
+	assert.Nil(t, sdi)
+	assert.Equal(t, process.ErrNilMetricsHandler, err)
+}
+
 func TestNewSingleDataInterceptor_NilWhiteListHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
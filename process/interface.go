@@ -17,6 +17,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/debug/tracing"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
@@ -131,7 +133,7 @@ type TransactionCoordinator interface {
 	RemoveBlockDataFromPool(body *block.Body) error
 	RemoveTxsFromPool(body *block.Body) error
 
-	ProcessBlockTransaction(body *block.Body, haveTime func() time.Duration) error
+	ProcessBlockTransaction(body *block.Body, haveTime func() time.Duration, parentSpan *tracing.Span) error
 
 	CreateBlockStarted()
 	CreateMbsAndProcessCrossShardTransactionsDstMe(
@@ -417,6 +419,12 @@ type VirtualMachinesContainerFactory interface {
 	IsInterfaceNil() bool
 }
 
+// ArwenVersionProvider is able to tell which Arwen version is used in a provided epoch
+type ArwenVersionProvider interface {
+	GetArwenVersion(epoch uint32) string
+	IsInterfaceNil() bool
+}
+
 // EpochStartTriggerHandler defines that actions which are needed by processor for start of epoch
 type EpochStartTriggerHandler interface {
 	Update(round uint64, nonce uint64)
@@ -651,6 +659,25 @@ type PeerBlackListCacher interface {
 	IsInterfaceNil() bool
 }
 
+// PeerReputationHandler keeps the reputation of the peer IDs and of the validator public keys, persisting the applied
+// bans so they survive restarts
+type PeerReputationHandler interface {
+	RecordPeerIDBan(pid core.PeerID, duration time.Duration, reason string)
+	RecordPublicKeyBan(pk []byte, duration time.Duration, reason string)
+	IsPeerIDDenied(pid core.PeerID) bool
+	IsPublicKeyDenied(pk []byte) bool
+	GetPeersReputation() []*common.PeerReputation
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// AntifloodConfigHandler defines the behavior of a component able to apply a new antiflood configuration at runtime
+type AntifloodConfigHandler interface {
+	CheckAntifloodConfig(cfg config.AntifloodConfig) error
+	ApplyAntifloodConfig(cfg config.AntifloodConfig) error
+	IsInterfaceNil() bool
+}
+
 // PeerShardMapper can return the public key of a provided peer ID
 type PeerShardMapper interface {
 	GetPeerInfo(pid core.PeerID) core.P2PPeerInfo
@@ -672,13 +699,17 @@ type NetworkConnectionWatcher interface {
 	IsInterfaceNil() bool
 }
 
-// SCQuery represents a prepared query for executing a function of the smart contract
+// SCQuery represents a prepared query for executing a function of the smart contract. When a block nonce or a block
+// hash is provided, the query is executed against the state of that block instead of the latest state
 type SCQuery struct {
-	ScAddress  []byte
-	FuncName   string
-	CallerAddr []byte
-	CallValue  *big.Int
-	Arguments  [][]byte
+	ScAddress     []byte
+	FuncName      string
+	CallerAddr    []byte
+	CallValue     *big.Int
+	Arguments     [][]byte
+	BlockNonce    uint64
+	HasBlockNonce bool
+	BlockHash     []byte
 }
 
 // GasHandler is able to perform some gas calculation
@@ -942,6 +973,20 @@ type WhiteListHandler interface {
 	IsInterfaceNil() bool
 }
 
+// TxReplacementChecker verifies that a transaction having the same sender and nonce as a pending one is priced high
+// enough to replace it
+type TxReplacementChecker interface {
+	CheckTxReplacement(txHash []byte, sender []byte, nonce uint64, gasPrice uint64) error
+	IsInterfaceNil() bool
+}
+
+// TxExpiryChecker verifies that a transaction was not dropped from the pool because it stayed there for more than the
+// validity window
+type TxExpiryChecker interface {
+	CheckTxExpiry(txHash []byte) error
+	IsInterfaceNil() bool
+}
+
 // InterceptedDebugger defines an interface for debugging the intercepted data
 type InterceptedDebugger interface {
 	LogReceivedHashes(topic string, hashes [][]byte)
@@ -1038,6 +1083,7 @@ type CoreComponentsHolder interface {
 	MinTransactionVersion() uint32
 	TxVersionChecker() TxVersionCheckerHandler
 	StatusHandler() core.AppStatusHandler
+	MetricsHandler() common.MetricsHandler
 	GenesisNodesSetup() sharding.GenesisNodesSetupHandler
 	EpochNotifier() EpochNotifier
 	ChanStopNodeProcess() chan endProcess.ArgEndProcess
@@ -1084,8 +1130,21 @@ type AccountsDBSyncer interface {
 	IsInterfaceNil() bool
 }
 
+// ExecutionTracer defines the component able to record the call tree of the smart contract executions
+type ExecutionTracer interface {
+	EnterFrame(frameType string, callee []byte, function string, input *vmcommon.VMInput)
+	ExitFrame(output *vmcommon.VMOutput, err error)
+	IsInterfaceNil() bool
+}
+
 // CurrentNetworkEpochProviderHandler is an interface able to compute if the provided epoch is active on the network or not
 type CurrentNetworkEpochProviderHandler interface {
 	EpochIsActiveInNetwork(epoch uint32) bool
 	IsInterfaceNil() bool
 }
+
+// PeersThroughputRecorder defines the component able to record the amount of data received from each peer
+type PeersThroughputRecorder interface {
+	AddReceivedData(peer core.PeerID, numBytes int)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,59 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+)
+
+// AccountsOverlayHandlerStub -
+type AccountsOverlayHandlerStub struct {
+	StartOverlayCalled           func()
+	StartOverlayAtRootHashCalled func(rootHash []byte) error
+	ResetOverlayStateDiffsCalled func()
+	StopOverlayCalled            func()
+	GetOverlayStateDiffsCalled   func(addressConverter core.PubkeyConverter) ([]*txSimData.AccountStateDiff, error)
+}
+
+// StartOverlay -
+func (stub *AccountsOverlayHandlerStub) StartOverlay() {
+	if stub.StartOverlayCalled != nil {
+		stub.StartOverlayCalled()
+	}
+}
+
+// StartOverlayAtRootHash -
+func (stub *AccountsOverlayHandlerStub) StartOverlayAtRootHash(rootHash []byte) error {
+	if stub.StartOverlayAtRootHashCalled != nil {
+		return stub.StartOverlayAtRootHashCalled(rootHash)
+	}
+
+	return nil
+}
+
+// ResetOverlayStateDiffs -
+func (stub *AccountsOverlayHandlerStub) ResetOverlayStateDiffs() {
+	if stub.ResetOverlayStateDiffsCalled != nil {
+		stub.ResetOverlayStateDiffsCalled()
+	}
+}
+
+// StopOverlay -
+func (stub *AccountsOverlayHandlerStub) StopOverlay() {
+	if stub.StopOverlayCalled != nil {
+		stub.StopOverlayCalled()
+	}
+}
+
+// GetOverlayStateDiffs -
+func (stub *AccountsOverlayHandlerStub) GetOverlayStateDiffs(addressConverter core.PubkeyConverter) ([]*txSimData.AccountStateDiff, error) {
+	if stub.GetOverlayStateDiffsCalled != nil {
+		return stub.GetOverlayStateDiffsCalled(addressConverter)
+	}
+
+	return make([]*txSimData.AccountStateDiff, 0), nil
+}
+
+// IsInterfaceNil -
+func (stub *AccountsOverlayHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
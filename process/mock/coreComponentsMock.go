@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -31,6 +32,7 @@ type CoreComponentsMock struct {
 	EpochNotifierField          process.EpochNotifier
 	RoundField                  consensus.RoundHandler
 	StatusField                 core.AppStatusHandler
+	MetricsHandlerField         common.MetricsHandler
 	ChanStopNode                chan endProcess.ArgEndProcess
 	NodeTypeProviderField       core.NodeTypeProviderHandler
 	EconomicsDataField          process.EconomicsDataHandler
@@ -135,6 +137,11 @@ func (ccm *CoreComponentsMock) StatusHandler() core.AppStatusHandler {
 	return ccm.StatusField
 }
 
+// MetricsHandler -
+func (ccm *CoreComponentsMock) MetricsHandler() common.MetricsHandler {
+	return ccm.MetricsHandlerField
+}
+
 // NodeTypeProvider -
 func (ccm *CoreComponentsMock) NodeTypeProvider() core.NodeTypeProviderHandler {
 	return ccm.NodeTypeProviderField
@@ -0,0 +1,32 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
+)
+
+// ExecutionTraceHandlerStub -
+type ExecutionTraceHandlerStub struct {
+	StartTraceCalled func()
+	StopTraceCalled  func() []*tracing.CallFrame
+}
+
+// StartTrace -
+func (stub *ExecutionTraceHandlerStub) StartTrace() {
+	if stub.StartTraceCalled != nil {
+		stub.StartTraceCalled()
+	}
+}
+
+// StopTrace -
+func (stub *ExecutionTraceHandlerStub) StopTrace() []*tracing.CallFrame {
+	if stub.StopTraceCalled != nil {
+		return stub.StopTraceCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *ExecutionTraceHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
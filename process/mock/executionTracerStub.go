@@ -0,0 +1,30 @@
+package mock
+
+import (
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// ExecutionTracerStub -
+type ExecutionTracerStub struct {
+	EnterFrameCalled func(frameType string, callee []byte, function string, input *vmcommon.VMInput)
+	ExitFrameCalled  func(output *vmcommon.VMOutput, err error)
+}
+
+// EnterFrame -
+func (stub *ExecutionTracerStub) EnterFrame(frameType string, callee []byte, function string, input *vmcommon.VMInput) {
+	if stub.EnterFrameCalled != nil {
+		stub.EnterFrameCalled(frameType, callee, function, input)
+	}
+}
+
+// ExitFrame -
+func (stub *ExecutionTracerStub) ExitFrame(output *vmcommon.VMOutput, err error) {
+	if stub.ExitFrameCalled != nil {
+		stub.ExitFrameCalled(output, err)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *ExecutionTracerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
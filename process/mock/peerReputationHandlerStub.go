@@ -0,0 +1,73 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// PeerReputationHandlerStub -
+type PeerReputationHandlerStub struct {
+	RecordPeerIDBanCalled    func(pid core.PeerID, duration time.Duration, reason string)
+	RecordPublicKeyBanCalled func(pk []byte, duration time.Duration, reason string)
+	IsPeerIDDeniedCalled     func(pid core.PeerID) bool
+	IsPublicKeyDeniedCalled  func(pk []byte) bool
+	GetPeersReputationCalled func() []*common.PeerReputation
+	CloseCalled              func() error
+}
+
+// RecordPeerIDBan -
+func (prhs *PeerReputationHandlerStub) RecordPeerIDBan(pid core.PeerID, duration time.Duration, reason string) {
+	if prhs.RecordPeerIDBanCalled != nil {
+		prhs.RecordPeerIDBanCalled(pid, duration, reason)
+	}
+}
+
+// RecordPublicKeyBan -
+func (prhs *PeerReputationHandlerStub) RecordPublicKeyBan(pk []byte, duration time.Duration, reason string) {
+	if prhs.RecordPublicKeyBanCalled != nil {
+		prhs.RecordPublicKeyBanCalled(pk, duration, reason)
+	}
+}
+
+// IsPeerIDDenied -
+func (prhs *PeerReputationHandlerStub) IsPeerIDDenied(pid core.PeerID) bool {
+	if prhs.IsPeerIDDeniedCalled != nil {
+		return prhs.IsPeerIDDeniedCalled(pid)
+	}
+
+	return false
+}
+
+// IsPublicKeyDenied -
+func (prhs *PeerReputationHandlerStub) IsPublicKeyDenied(pk []byte) bool {
+	if prhs.IsPublicKeyDeniedCalled != nil {
+		return prhs.IsPublicKeyDeniedCalled(pk)
+	}
+
+	return false
+}
+
+// GetPeersReputation -
+func (prhs *PeerReputationHandlerStub) GetPeersReputation() []*common.PeerReputation {
+	if prhs.GetPeersReputationCalled != nil {
+		return prhs.GetPeersReputationCalled()
+	}
+
+	return nil
+}
+
+// Close -
+func (prhs *PeerReputationHandlerStub) Close() error {
+	if prhs.CloseCalled != nil {
+		return prhs.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (prhs *PeerReputationHandlerStub) IsInterfaceNil() bool {
+	return prhs == nil
+}
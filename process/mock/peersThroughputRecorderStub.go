@@ -0,0 +1,22 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+)
+
+// PeersThroughputRecorderStub -
+type PeersThroughputRecorderStub struct {
+	AddReceivedDataCalled func(peer core.PeerID, numBytes int)
+}
+
+// AddReceivedData -
+func (stub *PeersThroughputRecorderStub) AddReceivedData(peer core.PeerID, numBytes int) {
+	if stub.AddReceivedDataCalled != nil {
+		stub.AddReceivedDataCalled(peer, numBytes)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *PeersThroughputRecorderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -7,7 +7,10 @@ import (
 
 // TransactionSimulatorStub -
 type TransactionSimulatorStub struct {
-	ProcessTxCalled func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxCalled            func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxBundleCalled      func(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	ProcessTxWithOptionsCalled func(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	ReplayTxCalled             func(replayData *txSimData.ReplayData) (*txSimData.SimulationResults, error)
 }
 
 // ProcessTx -
@@ -19,6 +22,33 @@ func (tss *TransactionSimulatorStub) ProcessTx(tx *transaction.Transaction) (*tx
 	return nil, nil
 }
 
+// ProcessTxWithOptions -
+func (tss *TransactionSimulatorStub) ProcessTxWithOptions(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+	if tss.ProcessTxWithOptionsCalled != nil {
+		return tss.ProcessTxWithOptionsCalled(tx, options)
+	}
+
+	return nil, nil
+}
+
+// ProcessTxBundle -
+func (tss *TransactionSimulatorStub) ProcessTxBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	if tss.ProcessTxBundleCalled != nil {
+		return tss.ProcessTxBundleCalled(txs)
+	}
+
+	return nil, nil
+}
+
+// ReplayTx -
+func (tss *TransactionSimulatorStub) ReplayTx(replayData *txSimData.ReplayData) (*txSimData.SimulationResults, error) {
+	if tss.ReplayTxCalled != nil {
+		return tss.ReplayTxCalled(replayData)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (tss *TransactionSimulatorStub) IsInterfaceNil() bool {
 	return tss == nil
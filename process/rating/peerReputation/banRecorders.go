@@ -0,0 +1,130 @@
+package peerReputation
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// peerBlackListCacherRecorder decorates a peer black list cacher, recording each upserted peer ID as a ban
+type peerBlackListCacherRecorder struct {
+	cacher            process.PeerBlackListCacher
+	reputationHandler process.PeerReputationHandler
+	reason            string
+}
+
+// NewPeerBlackListCacherRecorder creates a peer black list cacher that records in the reputation handler each peer ID
+// upserted in the provided cacher, using the provided reason
+func NewPeerBlackListCacherRecorder(
+	cacher process.PeerBlackListCacher,
+	reputationHandler process.PeerReputationHandler,
+	reason string,
+) (*peerBlackListCacherRecorder, error) {
+	if check.IfNil(cacher) {
+		return nil, process.ErrNilBlackListCacher
+	}
+	if check.IfNil(reputationHandler) {
+		return nil, process.ErrNilPeerReputationHandler
+	}
+
+	return &peerBlackListCacherRecorder{
+		cacher:            cacher,
+		reputationHandler: reputationHandler,
+		reason:            reason,
+	}, nil
+}
+
+// Upsert upserts the peer ID in the wrapped cacher and records the ban
+func (recorder *peerBlackListCacherRecorder) Upsert(pid core.PeerID, span time.Duration) error {
+	err := recorder.cacher.Upsert(pid, span)
+	if err != nil {
+		return err
+	}
+
+	recorder.reputationHandler.RecordPeerIDBan(pid, span, recorder.reason)
+
+	return nil
+}
+
+// Has returns true if the peer ID is found in the wrapped cacher
+func (recorder *peerBlackListCacherRecorder) Has(pid core.PeerID) bool {
+	return recorder.cacher.Has(pid)
+}
+
+// Sweep calls sweep on the wrapped cacher
+func (recorder *peerBlackListCacherRecorder) Sweep() {
+	recorder.cacher.Sweep()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (recorder *peerBlackListCacherRecorder) IsInterfaceNil() bool {
+	return recorder == nil
+}
+
+// publicKeysTimeCacherRecorder decorates the time cacher holding the black listed public keys, recording each
+// upserted public key as a ban
+type publicKeysTimeCacherRecorder struct {
+	cacher            process.TimeCacher
+	reputationHandler process.PeerReputationHandler
+	reason            string
+}
+
+// NewPublicKeysTimeCacherRecorder creates a time cacher that records in the reputation handler each public key
+// upserted in the provided cacher, using the provided reason
+func NewPublicKeysTimeCacherRecorder(
+	cacher process.TimeCacher,
+	reputationHandler process.PeerReputationHandler,
+	reason string,
+) (*publicKeysTimeCacherRecorder, error) {
+	if check.IfNil(cacher) {
+		return nil, process.ErrNilBlackListCacher
+	}
+	if check.IfNil(reputationHandler) {
+		return nil, process.ErrNilPeerReputationHandler
+	}
+
+	return &publicKeysTimeCacherRecorder{
+		cacher:            cacher,
+		reputationHandler: reputationHandler,
+		reason:            reason,
+	}, nil
+}
+
+// Add adds the key in the wrapped cacher for its default span. The key is not recorded as the span is not known
+func (recorder *publicKeysTimeCacherRecorder) Add(key string) error {
+	return recorder.cacher.Add(key)
+}
+
+// Upsert upserts the public key in the wrapped cacher and records the ban
+func (recorder *publicKeysTimeCacherRecorder) Upsert(key string, span time.Duration) error {
+	err := recorder.cacher.Upsert(key, span)
+	if err != nil {
+		return err
+	}
+
+	recorder.reputationHandler.RecordPublicKeyBan([]byte(key), span, recorder.reason)
+
+	return nil
+}
+
+// Has returns true if the key is found in the wrapped cacher
+func (recorder *publicKeysTimeCacherRecorder) Has(key string) bool {
+	return recorder.cacher.Has(key)
+}
+
+// Sweep calls sweep on the wrapped cacher
+func (recorder *publicKeysTimeCacherRecorder) Sweep() {
+	recorder.cacher.Sweep()
+}
+
+// Len returns the number of elements of the wrapped cacher
+func (recorder *publicKeysTimeCacherRecorder) Len() int {
+	return recorder.cacher.Len()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (recorder *publicKeysTimeCacherRecorder) IsInterfaceNil() bool {
+	return recorder == nil
+}
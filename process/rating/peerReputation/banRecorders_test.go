@@ -0,0 +1,114 @@
+package peerReputation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPeerBlackListCacherRecorder(t *testing.T) {
+	t.Parallel()
+
+	recorder, err := NewPeerBlackListCacherRecorder(nil, &mock.PeerReputationHandlerStub{}, "reason")
+	assert.Equal(t, process.ErrNilBlackListCacher, err)
+	assert.True(t, check.IfNil(recorder))
+
+	recorder, err = NewPeerBlackListCacherRecorder(&mock.PeerBlackListHandlerStub{}, nil, "reason")
+	assert.Equal(t, process.ErrNilPeerReputationHandler, err)
+	assert.True(t, check.IfNil(recorder))
+
+	recorder, err = NewPeerBlackListCacherRecorder(&mock.PeerBlackListHandlerStub{}, &mock.PeerReputationHandlerStub{}, "reason")
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(recorder))
+}
+
+func TestPeerBlackListCacherRecorder_UpsertShouldRecordTheBan(t *testing.T) {
+	t.Parallel()
+
+	recordedReason := ""
+	recorder, _ := NewPeerBlackListCacherRecorder(
+		&mock.PeerBlackListHandlerStub{
+			HasCalled: func(pid core.PeerID) bool {
+				return pid == "pid"
+			},
+		},
+		&mock.PeerReputationHandlerStub{
+			RecordPeerIDBanCalled: func(pid core.PeerID, duration time.Duration, reason string) {
+				assert.Equal(t, core.PeerID("pid"), pid)
+				assert.Equal(t, time.Minute, duration)
+				recordedReason = reason
+			},
+		},
+		"flooding",
+	)
+
+	err := recorder.Upsert("pid", time.Minute)
+	assert.Nil(t, err)
+	assert.Equal(t, "flooding", recordedReason)
+	assert.True(t, recorder.Has("pid"))
+}
+
+func TestPeerBlackListCacherRecorder_UpsertErrorShouldNotRecord(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	recorder, _ := NewPeerBlackListCacherRecorder(
+		&mock.PeerBlackListHandlerStub{
+			UpsertCalled: func(pid core.PeerID, span time.Duration) error {
+				return expectedErr
+			},
+		},
+		&mock.PeerReputationHandlerStub{
+			RecordPeerIDBanCalled: func(pid core.PeerID, duration time.Duration, reason string) {
+				assert.Fail(t, "should have not recorded the ban")
+			},
+		},
+		"flooding",
+	)
+
+	err := recorder.Upsert("pid", time.Minute)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestNewPublicKeysTimeCacherRecorder(t *testing.T) {
+	t.Parallel()
+
+	recorder, err := NewPublicKeysTimeCacherRecorder(nil, &mock.PeerReputationHandlerStub{}, "reason")
+	assert.Equal(t, process.ErrNilBlackListCacher, err)
+	assert.True(t, check.IfNil(recorder))
+
+	recorder, err = NewPublicKeysTimeCacherRecorder(&mock.TimeCacheStub{}, nil, "reason")
+	assert.Equal(t, process.ErrNilPeerReputationHandler, err)
+	assert.True(t, check.IfNil(recorder))
+
+	recorder, err = NewPublicKeysTimeCacherRecorder(&mock.TimeCacheStub{}, &mock.PeerReputationHandlerStub{}, "reason")
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(recorder))
+}
+
+func TestPublicKeysTimeCacherRecorder_UpsertShouldRecordTheBan(t *testing.T) {
+	t.Parallel()
+
+	recordedReason := ""
+	recorder, _ := NewPublicKeysTimeCacherRecorder(
+		&mock.TimeCacheStub{},
+		&mock.PeerReputationHandlerStub{
+			RecordPublicKeyBanCalled: func(pk []byte, duration time.Duration, reason string) {
+				assert.Equal(t, []byte("pk"), pk)
+				assert.Equal(t, time.Hour, duration)
+				recordedReason = reason
+			},
+		},
+		"low peer honesty score",
+	)
+
+	err := recorder.Upsert("pk", time.Hour)
+	assert.Nil(t, err)
+	assert.Equal(t, "low peer honesty score", recordedReason)
+}
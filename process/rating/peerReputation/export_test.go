@@ -0,0 +1,15 @@
+package peerReputation
+
+import (
+	"time"
+)
+
+func (prs *peerReputationStore) SetGetTimeHandler(handler func() time.Time) {
+	prs.mut.Lock()
+	prs.getTimeHandler = handler
+	prs.mut.Unlock()
+}
+
+func (prs *peerReputationStore) Sweep() {
+	prs.sweep()
+}
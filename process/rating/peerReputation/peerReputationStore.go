@@ -0,0 +1,389 @@
+package peerReputation
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("process/rating/peerreputation")
+
+const (
+	// TypePeerID marks the reputation records of peer IDs
+	TypePeerID = "peerID"
+	// TypePublicKey marks the reputation records of validator public keys
+	TypePublicKey = "publicKey"
+
+	peerIDKeyPrefix    = "pid_"
+	publicKeyKeyPrefix = "pk_"
+	approximateZero    = 0.00001
+
+	minDecayCoefficient    = 0.0
+	maxDecayCoefficient    = 1.0
+	minSweepIntervalInSecs = uint32(1)
+)
+
+// ArgPeerReputationStore holds the arguments needed to create a peer reputation store
+type ArgPeerReputationStore struct {
+	Config      config.PeerReputationConfig
+	Storer      storage.Storer
+	Marshalizer marshal.Marshalizer
+}
+
+type reputationRecord struct {
+	reputation *common.PeerReputation
+	isDirty    bool
+}
+
+// peerReputationStore keeps the reputation records in memory and writes them through the provided storer. The bans are
+// saved as soon as they are applied while the last seen and score updates are saved on each sweep
+type peerReputationStore struct {
+	mut                    sync.RWMutex
+	records                map[string]*reputationRecord
+	storer                 storage.Storer
+	marshalizer            marshal.Marshalizer
+	maxHistoryEntries      int
+	banPenalty             float64
+	decayCoefficient       float64
+	recordExpiry           time.Duration
+	sweepInterval          time.Duration
+	lastSeenUpdateInterval int64
+	getTimeHandler         func() time.Time
+	cancelFunc             func()
+}
+
+// NewPeerReputationStore creates a new peer reputation store, loading the records already saved in the storer
+func NewPeerReputationStore(args ArgPeerReputationStore) (*peerReputationStore, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	prs := &peerReputationStore{
+		records:                make(map[string]*reputationRecord),
+		storer:                 args.Storer,
+		marshalizer:            args.Marshalizer,
+		maxHistoryEntries:      int(args.Config.MaxHistoryEntries),
+		banPenalty:             args.Config.BanPenalty,
+		decayCoefficient:       args.Config.DecayCoefficient,
+		recordExpiry:           time.Duration(args.Config.RecordExpiryInSeconds) * time.Second,
+		sweepInterval:          time.Duration(args.Config.SweepIntervalInSeconds) * time.Second,
+		lastSeenUpdateInterval: int64(args.Config.LastSeenUpdateIntervalInSeconds),
+		getTimeHandler:         time.Now,
+	}
+	prs.loadRecords()
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	prs.cancelFunc = cancelFunc
+
+	go prs.sweepContinuously(ctx)
+
+	return prs, nil
+}
+
+func checkArgs(args ArgPeerReputationStore) error {
+	if check.IfNil(args.Storer) {
+		return process.ErrNilStorage
+	}
+	if check.IfNil(args.Marshalizer) {
+		return process.ErrNilMarshalizer
+	}
+	if args.Config.MaxHistoryEntries == 0 {
+		return fmt.Errorf("%w, MaxHistoryEntries should be greater than 0", process.ErrInvalidValue)
+	}
+	if args.Config.BanPenalty < 0 {
+		return fmt.Errorf("%w, BanPenalty should be positive or zero", process.ErrInvalidValue)
+	}
+	isDecayCoefficientOk := args.Config.DecayCoefficient > minDecayCoefficient &&
+		args.Config.DecayCoefficient <= maxDecayCoefficient
+	if !isDecayCoefficientOk {
+		return fmt.Errorf("%w, decay coefficient should be in interval (%.2f, %.2f]",
+			process.ErrInvalidDecayCoefficient,
+			minDecayCoefficient,
+			maxDecayCoefficient,
+		)
+	}
+	if args.Config.SweepIntervalInSeconds < minSweepIntervalInSecs {
+		return fmt.Errorf("%w, SweepIntervalInSeconds should be greater or equal to %d",
+			process.ErrInvalidValue,
+			minSweepIntervalInSecs,
+		)
+	}
+	if args.Config.RecordExpiryInSeconds == 0 {
+		return fmt.Errorf("%w, RecordExpiryInSeconds should be greater than 0", process.ErrInvalidValue)
+	}
+	if args.Config.LastSeenUpdateIntervalInSeconds == 0 {
+		return fmt.Errorf("%w, LastSeenUpdateIntervalInSeconds should be greater than 0", process.ErrInvalidValue)
+	}
+
+	return nil
+}
+
+func (prs *peerReputationStore) loadRecords() {
+	prs.storer.RangeKeys(func(key []byte, value []byte) bool {
+		reputation := &common.PeerReputation{}
+		err := prs.marshalizer.Unmarshal(reputation, value)
+		if err != nil {
+			log.Debug("peerReputationStore: skipped invalid record", "key", key, "error", err)
+			return true
+		}
+
+		prs.records[string(key)] = &reputationRecord{reputation: reputation}
+		return true
+	})
+
+	log.Debug("peerReputationStore: loaded records", "num records", len(prs.records))
+}
+
+func (prs *peerReputationStore) sweepContinuously(ctx context.Context) {
+	for {
+		select {
+		case <-time.After(prs.sweepInterval):
+			prs.sweep()
+		case <-ctx.Done():
+			log.Debug("closing peerReputationStore.sweepContinuously go routine")
+			return
+		}
+	}
+}
+
+// sweep decays the scores, removes the expired records and saves the changed ones
+func (prs *peerReputationStore) sweep() {
+	prs.mut.Lock()
+	defer prs.mut.Unlock()
+
+	now := prs.getTimeHandler().Unix()
+	for key, record := range prs.records {
+		if prs.isExpired(record.reputation, now) {
+			delete(prs.records, key)
+			log.LogIfError(prs.storer.Remove([]byte(key)))
+			continue
+		}
+
+		if record.reputation.Score != 0 {
+			record.reputation.Score *= prs.decayCoefficient
+			if check.IsZeroFloat64(record.reputation.Score, approximateZero) {
+				record.reputation.Score = 0
+			}
+			record.isDirty = true
+		}
+
+		if record.isDirty {
+			prs.saveRecord(key, record)
+		}
+	}
+}
+
+func (prs *peerReputationStore) isExpired(reputation *common.PeerReputation, now int64) bool {
+	if now < reputation.BannedUntil {
+		return false
+	}
+
+	lastActivity := reputation.LastSeen
+	if reputation.BannedUntil > lastActivity {
+		lastActivity = reputation.BannedUntil
+	}
+
+	return now-lastActivity > int64(prs.recordExpiry.Seconds())
+}
+
+// RecordPeerIDBan records that the provided peer ID was banned for the given duration
+func (prs *peerReputationStore) RecordPeerIDBan(pid core.PeerID, duration time.Duration, reason string) {
+	prs.recordBan(peerIDKeyPrefix+string(pid), pid.Pretty(), TypePeerID, duration, reason)
+}
+
+// RecordPublicKeyBan records that the provided validator public key was banned for the given duration
+func (prs *peerReputationStore) RecordPublicKeyBan(pk []byte, duration time.Duration, reason string) {
+	prs.recordBan(publicKeyKeyPrefix+string(pk), hex.EncodeToString(pk), TypePublicKey, duration, reason)
+}
+
+func (prs *peerReputationStore) recordBan(key string, identifier string, recordType string, duration time.Duration, reason string) {
+	prs.mut.Lock()
+	defer prs.mut.Unlock()
+
+	now := prs.getTimeHandler().Unix()
+	bannedUntil := now + int64(duration.Seconds())
+
+	record, found := prs.records[key]
+	if !found {
+		record = &reputationRecord{
+			reputation: &common.PeerReputation{
+				Identifier: identifier,
+				Type:       recordType,
+				History:    make([]*common.PeerReputationEvent, 0, 1),
+			},
+		}
+		prs.records[key] = record
+	}
+
+	reputation := record.reputation
+	reputation.LastSeen = now
+	if prs.isBanRefresh(reputation, now, reason) {
+		// the same offence reported again while the peer is still banned, only extend the ban
+		if bannedUntil > reputation.BannedUntil {
+			reputation.BannedUntil = bannedUntil
+		}
+		record.isDirty = true
+		return
+	}
+
+	if bannedUntil > reputation.BannedUntil {
+		reputation.BannedUntil = bannedUntil
+	}
+	reputation.Score -= prs.banPenalty
+	reputation.NumBans++
+	reputation.History = append(reputation.History, &common.PeerReputationEvent{
+		Timestamp:            now,
+		Reason:               reason,
+		BanDurationInSeconds: int64(duration.Seconds()),
+		Score:                reputation.Score,
+	})
+	if len(reputation.History) > prs.maxHistoryEntries {
+		reputation.History = reputation.History[len(reputation.History)-prs.maxHistoryEntries:]
+	}
+
+	log.Debug("peerReputationStore: recorded ban",
+		"type", recordType,
+		"identifier", identifier,
+		"reason", reason,
+		"duration", duration,
+		"score", fmt.Sprintf("%.2f", reputation.Score),
+	)
+
+	prs.saveRecord(key, record)
+}
+
+func (prs *peerReputationStore) isBanRefresh(reputation *common.PeerReputation, now int64, reason string) bool {
+	numEvents := len(reputation.History)
+	if numEvents == 0 || now >= reputation.BannedUntil {
+		return false
+	}
+
+	return reputation.History[numEvents-1].Reason == reason
+}
+
+func (prs *peerReputationStore) saveRecord(key string, record *reputationRecord) {
+	buff, err := prs.marshalizer.Marshal(record.reputation)
+	if err != nil {
+		log.Warn("peerReputationStore: cannot marshal record", "identifier", record.reputation.Identifier, "error", err)
+		return
+	}
+
+	err = prs.storer.Put([]byte(key), buff)
+	if err != nil {
+		log.Warn("peerReputationStore: cannot save record", "identifier", record.reputation.Identifier, "error", err)
+		return
+	}
+
+	record.isDirty = false
+}
+
+// IsPeerIDDenied returns true if the provided peer ID has an active ban. It also refreshes the last seen time of the
+// peer, if it is known, at most once per configured interval
+func (prs *peerReputationStore) IsPeerIDDenied(pid core.PeerID) bool {
+	return prs.isDenied(peerIDKeyPrefix + string(pid))
+}
+
+// IsPublicKeyDenied returns true if the provided validator public key has an active ban. It also refreshes the last
+// seen time of the public key, if it is known, at most once per configured interval
+func (prs *peerReputationStore) IsPublicKeyDenied(pk []byte) bool {
+	return prs.isDenied(publicKeyKeyPrefix + string(pk))
+}
+
+// isDenied is called for every received message so, most of the times, it only needs the read lock. The write lock is
+// taken only when the last seen time of a known peer is older than the update interval
+func (prs *peerReputationStore) isDenied(key string) bool {
+	prs.mut.RLock()
+	record, found := prs.records[key]
+	if !found {
+		prs.mut.RUnlock()
+		return false
+	}
+
+	now := prs.getTimeHandler().Unix()
+	isDenied := now < record.reputation.BannedUntil
+	shouldUpdateLastSeen := now-record.reputation.LastSeen >= prs.lastSeenUpdateInterval
+	prs.mut.RUnlock()
+
+	if shouldUpdateLastSeen {
+		prs.updateLastSeen(key, now)
+	}
+
+	return isDenied
+}
+
+func (prs *peerReputationStore) updateLastSeen(key string, now int64) {
+	prs.mut.Lock()
+	defer prs.mut.Unlock()
+
+	record, found := prs.records[key]
+	if !found || now <= record.reputation.LastSeen {
+		return
+	}
+
+	record.reputation.LastSeen = now
+	record.isDirty = true
+}
+
+// GetPeersReputation returns a copy of all the known reputation records, the lowest scores first
+func (prs *peerReputationStore) GetPeersReputation() []*common.PeerReputation {
+	prs.mut.RLock()
+	reputations := make([]*common.PeerReputation, 0, len(prs.records))
+	for _, record := range prs.records {
+		reputations = append(reputations, copyReputation(record.reputation))
+	}
+	prs.mut.RUnlock()
+
+	sort.Slice(reputations, func(i, j int) bool {
+		if reputations[i].Score == reputations[j].Score {
+			return reputations[i].Identifier < reputations[j].Identifier
+		}
+
+		return reputations[i].Score < reputations[j].Score
+	})
+
+	return reputations
+}
+
+func copyReputation(reputation *common.PeerReputation) *common.PeerReputation {
+	reputationCopy := *reputation
+	reputationCopy.History = make([]*common.PeerReputationEvent, 0, len(reputation.History))
+	for _, event := range reputation.History {
+		eventCopy := *event
+		reputationCopy.History = append(reputationCopy.History, &eventCopy)
+	}
+
+	return &reputationCopy
+}
+
+// Close saves the pending changes, stops the sweeping go routine and closes the storer
+func (prs *peerReputationStore) Close() error {
+	prs.cancelFunc()
+
+	prs.mut.Lock()
+	for key, record := range prs.records {
+		if record.isDirty {
+			prs.saveRecord(key, record)
+		}
+	}
+	prs.mut.Unlock()
+
+	return prs.storer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (prs *peerReputationStore) IsInterfaceNil() bool {
+	return prs == nil
+}
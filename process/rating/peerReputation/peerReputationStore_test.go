@@ -0,0 +1,331 @@
+package peerReputation
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgPeerReputationStore() ArgPeerReputationStore {
+	return ArgPeerReputationStore{
+		Config: config.PeerReputationConfig{
+			Enabled:                         true,
+			MaxHistoryEntries:               3,
+			BanPenalty:                      10,
+			DecayCoefficient:                0.5,
+			SweepIntervalInSeconds:          3600,
+			RecordExpiryInSeconds:           100,
+			LastSeenUpdateIntervalInSeconds: 10,
+		},
+		Storer:      genericMocks.NewStorerMock("PeerReputation", 0),
+		Marshalizer: &marshal.JsonMarshalizer{},
+	}
+}
+
+func createStoreWithTime(t *testing.T, args ArgPeerReputationStore, currentTime *time.Time) *peerReputationStore {
+	prs, err := NewPeerReputationStore(args)
+	require.Nil(t, err)
+	prs.SetGetTimeHandler(func() time.Time {
+		return *currentTime
+	})
+
+	return prs
+}
+
+func TestNewPeerReputationStore_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgPeerReputationStore()
+	args.Storer = nil
+	prs, err := NewPeerReputationStore(args)
+	assert.Equal(t, process.ErrNilStorage, err)
+	assert.True(t, check.IfNil(prs))
+
+	args = createMockArgPeerReputationStore()
+	args.Marshalizer = nil
+	prs, err = NewPeerReputationStore(args)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+	assert.True(t, check.IfNil(prs))
+
+	args = createMockArgPeerReputationStore()
+	args.Config.MaxHistoryEntries = 0
+	_, err = NewPeerReputationStore(args)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	args = createMockArgPeerReputationStore()
+	args.Config.BanPenalty = -1
+	_, err = NewPeerReputationStore(args)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	args = createMockArgPeerReputationStore()
+	args.Config.DecayCoefficient = 0
+	_, err = NewPeerReputationStore(args)
+	assert.True(t, errors.Is(err, process.ErrInvalidDecayCoefficient))
+
+	args = createMockArgPeerReputationStore()
+	args.Config.DecayCoefficient = 1.1
+	_, err = NewPeerReputationStore(args)
+	assert.True(t, errors.Is(err, process.ErrInvalidDecayCoefficient))
+
+	args = createMockArgPeerReputationStore()
+	args.Config.SweepIntervalInSeconds = 0
+	_, err = NewPeerReputationStore(args)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	args = createMockArgPeerReputationStore()
+	args.Config.RecordExpiryInSeconds = 0
+	_, err = NewPeerReputationStore(args)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	args = createMockArgPeerReputationStore()
+	args.Config.LastSeenUpdateIntervalInSeconds = 0
+	_, err = NewPeerReputationStore(args)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+}
+
+func TestNewPeerReputationStore_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	prs, err := NewPeerReputationStore(createMockArgPeerReputationStore())
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(prs))
+	assert.Equal(t, 0, len(prs.GetPeersReputation()))
+	assert.Nil(t, prs.Close())
+}
+
+func TestPeerReputationStore_RecordPeerIDBanShouldDenyUntilTheBanExpires(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	prs := createStoreWithTime(t, createMockArgPeerReputationStore(), &currentTime)
+	defer func() {
+		_ = prs.Close()
+	}()
+
+	pid := core.PeerID("pid")
+	assert.False(t, prs.IsPeerIDDenied(pid))
+
+	prs.RecordPeerIDBan(pid, time.Minute, "flooding")
+	assert.True(t, prs.IsPeerIDDenied(pid))
+	assert.False(t, prs.IsPeerIDDenied("other pid"))
+	assert.False(t, prs.IsPublicKeyDenied([]byte(pid)))
+
+	currentTime = currentTime.Add(time.Minute)
+	assert.False(t, prs.IsPeerIDDenied(pid))
+
+	reputations := prs.GetPeersReputation()
+	require.Equal(t, 1, len(reputations))
+	assert.Equal(t, pid.Pretty(), reputations[0].Identifier)
+	assert.Equal(t, TypePeerID, reputations[0].Type)
+	assert.Equal(t, float64(-10), reputations[0].Score)
+	assert.Equal(t, uint32(1), reputations[0].NumBans)
+	assert.Equal(t, int64(1060), reputations[0].LastSeen)
+	assert.Equal(t, int64(1060), reputations[0].BannedUntil)
+	require.Equal(t, 1, len(reputations[0].History))
+	assert.Equal(t, &common.PeerReputationEvent{
+		Timestamp:            1000,
+		Reason:               "flooding",
+		BanDurationInSeconds: 60,
+		Score:                -10,
+	}, reputations[0].History[0])
+}
+
+func TestPeerReputationStore_IsDeniedShouldUpdateLastSeenOncePerInterval(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	prs := createStoreWithTime(t, createMockArgPeerReputationStore(), &currentTime)
+	defer func() {
+		_ = prs.Close()
+	}()
+
+	pid := core.PeerID("pid")
+	prs.RecordPeerIDBan(pid, time.Hour, "flooding")
+	key := peerIDKeyPrefix + string(pid)
+	assert.False(t, prs.records[key].isDirty)
+
+	currentTime = currentTime.Add(time.Second * 9)
+	assert.True(t, prs.IsPeerIDDenied(pid))
+	assert.Equal(t, int64(1000), prs.GetPeersReputation()[0].LastSeen)
+	assert.False(t, prs.records[key].isDirty)
+
+	currentTime = currentTime.Add(time.Second)
+	assert.True(t, prs.IsPeerIDDenied(pid))
+	assert.Equal(t, int64(1010), prs.GetPeersReputation()[0].LastSeen)
+	assert.True(t, prs.records[key].isDirty)
+}
+
+func TestPeerReputationStore_RecordPublicKeyBan(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	prs := createStoreWithTime(t, createMockArgPeerReputationStore(), &currentTime)
+	defer func() {
+		_ = prs.Close()
+	}()
+
+	pk := []byte("public key")
+	prs.RecordPublicKeyBan(pk, time.Hour, "low peer honesty score")
+	assert.True(t, prs.IsPublicKeyDenied(pk))
+	assert.False(t, prs.IsPeerIDDenied(core.PeerID(pk)))
+
+	reputations := prs.GetPeersReputation()
+	require.Equal(t, 1, len(reputations))
+	assert.Equal(t, hex.EncodeToString(pk), reputations[0].Identifier)
+	assert.Equal(t, TypePublicKey, reputations[0].Type)
+}
+
+func TestPeerReputationStore_RepeatedBanWithTheSameReasonShouldOnlyExtendIt(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	prs := createStoreWithTime(t, createMockArgPeerReputationStore(), &currentTime)
+	defer func() {
+		_ = prs.Close()
+	}()
+
+	pid := core.PeerID("pid")
+	prs.RecordPeerIDBan(pid, time.Minute, "invalid message")
+	currentTime = currentTime.Add(time.Second * 30)
+	prs.RecordPeerIDBan(pid, time.Minute, "invalid message")
+
+	reputations := prs.GetPeersReputation()
+	require.Equal(t, 1, len(reputations))
+	assert.Equal(t, uint32(1), reputations[0].NumBans)
+	assert.Equal(t, float64(-10), reputations[0].Score)
+	assert.Equal(t, int64(1090), reputations[0].BannedUntil)
+
+	prs.RecordPeerIDBan(pid, time.Second, "flooding")
+	reputations = prs.GetPeersReputation()
+	assert.Equal(t, uint32(2), reputations[0].NumBans)
+	assert.Equal(t, float64(-20), reputations[0].Score)
+	assert.Equal(t, int64(1090), reputations[0].BannedUntil)
+	assert.Equal(t, 2, len(reputations[0].History))
+}
+
+func TestPeerReputationStore_HistoryShouldBeBounded(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	args := createMockArgPeerReputationStore()
+	prs := createStoreWithTime(t, args, &currentTime)
+	defer func() {
+		_ = prs.Close()
+	}()
+
+	reasons := []string{"reason 0", "reason 1", "reason 2", "reason 3", "reason 4"}
+	for _, reason := range reasons {
+		prs.RecordPeerIDBan("pid", time.Second, reason)
+		currentTime = currentTime.Add(time.Second * 2)
+	}
+
+	reputations := prs.GetPeersReputation()
+	require.Equal(t, 1, len(reputations))
+	assert.Equal(t, uint32(5), reputations[0].NumBans)
+	require.Equal(t, int(args.Config.MaxHistoryEntries), len(reputations[0].History))
+	assert.Equal(t, "reason 2", reputations[0].History[0].Reason)
+	assert.Equal(t, "reason 4", reputations[0].History[2].Reason)
+}
+
+func TestPeerReputationStore_BansShouldSurviveRestarts(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	args := createMockArgPeerReputationStore()
+	prs := createStoreWithTime(t, args, &currentTime)
+
+	prs.RecordPeerIDBan("pid", time.Hour, "flooding")
+	prs.RecordPublicKeyBan([]byte("pk"), time.Hour, "low peer honesty score")
+	currentTime = currentTime.Add(time.Second * 10)
+	assert.True(t, prs.IsPeerIDDenied("pid"))
+	assert.Nil(t, prs.Close())
+
+	restartedStore := createStoreWithTime(t, args, &currentTime)
+	defer func() {
+		_ = restartedStore.Close()
+	}()
+
+	assert.True(t, restartedStore.IsPeerIDDenied("pid"))
+	assert.True(t, restartedStore.IsPublicKeyDenied([]byte("pk")))
+
+	reputations := restartedStore.GetPeersReputation()
+	require.Equal(t, 2, len(reputations))
+	reputationsByType := make(map[string]*common.PeerReputation)
+	for _, reputation := range reputations {
+		reputationsByType[reputation.Type] = reputation
+	}
+	assert.Equal(t, int64(1010), reputationsByType[TypePeerID].LastSeen)
+	assert.Equal(t, "flooding", reputationsByType[TypePeerID].History[0].Reason)
+	assert.Equal(t, "low peer honesty score", reputationsByType[TypePublicKey].History[0].Reason)
+}
+
+func TestPeerReputationStore_SweepShouldDecayScoresAndRemoveExpiredRecords(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	args := createMockArgPeerReputationStore()
+	prs := createStoreWithTime(t, args, &currentTime)
+	defer func() {
+		_ = prs.Close()
+	}()
+
+	prs.RecordPeerIDBan("short ban", time.Second*10, "flooding")
+	prs.RecordPeerIDBan("long ban", time.Hour, "flooding")
+
+	currentTime = currentTime.Add(time.Second * 50)
+	prs.Sweep()
+
+	reputations := prs.GetPeersReputation()
+	require.Equal(t, 2, len(reputations))
+	assert.Equal(t, float64(-5), reputations[0].Score)
+
+	// the short ban ended at 1010 and the record expires 100 seconds later
+	currentTime = time.Unix(1111, 0)
+	prs.Sweep()
+
+	reputations = prs.GetPeersReputation()
+	require.Equal(t, 1, len(reputations))
+	assert.Equal(t, core.PeerID("long ban").Pretty(), reputations[0].Identifier)
+	assert.Equal(t, -2.5, reputations[0].Score)
+
+	numStoredRecords := 0
+	args.Storer.RangeKeys(func(key []byte, value []byte) bool {
+		numStoredRecords++
+		return true
+	})
+	assert.Equal(t, 1, numStoredRecords)
+}
+
+func TestPeerReputationStore_GetPeersReputationShouldSortByScoreAndReturnCopies(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	prs := createStoreWithTime(t, createMockArgPeerReputationStore(), &currentTime)
+	defer func() {
+		_ = prs.Close()
+	}()
+
+	prs.RecordPeerIDBan("pid 1", time.Second, "reason 1")
+	prs.RecordPeerIDBan("pid 2", time.Second, "reason 1")
+	currentTime = currentTime.Add(time.Second * 2)
+	prs.RecordPeerIDBan("pid 2", time.Second, "reason 2")
+
+	reputations := prs.GetPeersReputation()
+	require.Equal(t, 2, len(reputations))
+	assert.Equal(t, core.PeerID("pid 2").Pretty(), reputations[0].Identifier)
+	assert.Equal(t, core.PeerID("pid 1").Pretty(), reputations[1].Identifier)
+
+	reputations[0].History[0].Reason = "changed"
+	assert.Equal(t, "reason 1", prs.GetPeersReputation()[0].History[0].Reason)
+}
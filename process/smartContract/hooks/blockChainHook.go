@@ -21,6 +21,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -51,6 +52,7 @@ type ArgBlockChainHook struct {
 	ConfigSCStorage    config.StorageConfig
 	WorkingDir         string
 	NilCompiledSCStore bool
+	ExecutionTracer    process.ExecutionTracer
 }
 
 // BlockChainHookImpl is a wrapper over AccountsAdapter that satisfy vmcommon.BlockchainHook interface
@@ -63,6 +65,7 @@ type BlockChainHookImpl struct {
 	marshalizer      marshal.Marshalizer
 	uint64Converter  typeConverters.Uint64ByteSliceConverter
 	builtInFunctions vmcommon.BuiltInFunctionContainer
+	executionTracer  process.ExecutionTracer
 
 	mutCurrentHdr sync.RWMutex
 	currentHdr    data.HeaderHandler
@@ -96,6 +99,7 @@ func NewBlockChainHookImpl(
 		configSCStorage:    args.ConfigSCStorage,
 		workingDir:         args.WorkingDir,
 		nilCompiledSCStore: args.NilCompiledSCStore,
+		executionTracer:    args.ExecutionTracer,
 	}
 
 	err = blockChainHookImpl.makeCompiledSCStorage()
@@ -137,6 +141,9 @@ func checkForNil(args ArgBlockChainHook) error {
 	if check.IfNil(args.CompiledSCPool) {
 		return process.ErrNilCacher
 	}
+	if check.IfNil(args.ExecutionTracer) {
+		return process.ErrNilExecutionTracer
+	}
 
 	return nil
 }
@@ -349,6 +356,14 @@ func (bh *BlockChainHookImpl) ProcessBuiltInFunction(input *vmcommon.ContractCal
 		return nil, process.ErrNilVmInput
 	}
 
+	bh.executionTracer.EnterFrame(tracing.FrameTypeBuiltInFunction, input.RecipientAddr, input.Function, &input.VMInput)
+	vmOutput, err := bh.processBuiltInFunction(input)
+	bh.executionTracer.ExitFrame(vmOutput, err)
+
+	return vmOutput, err
+}
+
+func (bh *BlockChainHookImpl) processBuiltInFunction(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
 	function, err := bh.builtInFunctions.Get(input.Function)
 	if err != nil {
 		return nil, err
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
@@ -42,6 +43,7 @@ func createMockVMAccountsArguments() hooks.ArgBlockChainHook {
 		DataPool:           datapool,
 		CompiledSCPool:     datapool.SmartContracts(),
 		NilCompiledSCStore: true,
+		ExecutionTracer:    tracing.NewDisabledExecutionTracer(),
 	}
 	return arguments
 }
@@ -123,6 +125,17 @@ func TestNewBlockChainHookImpl_NilUint64ConverterShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilUint64Converter, err)
 }
 
+func TestNewBlockChainHookImpl_NilExecutionTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockVMAccountsArguments()
+	args.ExecutionTracer = nil
+	bh, err := hooks.NewBlockChainHookImpl(args)
+
+	assert.Nil(t, bh)
+	assert.Equal(t, process.ErrNilExecutionTracer, err)
+}
+
 func TestNewBlockChainHookImpl_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -511,3 +524,35 @@ func TestBlockChainHookImpl_ProcessBuiltInFunction(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, vmcommon.Ok, output.ReturnCode)
 }
+
+func TestBlockChainHookImpl_ProcessBuiltInFunctionShouldTraceTheExecution(t *testing.T) {
+	t.Parallel()
+
+	args := createMockVMAccountsArguments()
+	args.BuiltInFunctions = vmcommonBuiltInFunctions.NewBuiltInFunctionContainer()
+
+	enteredFunction := ""
+	var exitErr error
+	args.ExecutionTracer = &mock.ExecutionTracerStub{
+		EnterFrameCalled: func(frameType string, callee []byte, function string, _ *vmcommon.VMInput) {
+			require.Equal(t, tracing.FrameTypeBuiltInFunction, frameType)
+			require.Equal(t, []byte("callee"), callee)
+			enteredFunction = function
+		},
+		ExitFrameCalled: func(output *vmcommon.VMOutput, err error) {
+			require.Nil(t, output)
+			exitErr = err
+		},
+	}
+	bh, _ := hooks.NewBlockChainHookImpl(args)
+
+	input := &vmcommon.ContractCallInput{
+		RecipientAddr: []byte("callee"),
+		Function:      "missingFunction",
+	}
+	output, err := bh.ProcessBuiltInFunction(input)
+	require.Nil(t, output)
+	require.NotNil(t, err)
+	require.Equal(t, "missingFunction", enteredFunction)
+	require.Equal(t, err, exitErr)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -102,6 +103,7 @@ type scProcessor struct {
 	mutGasLock          sync.RWMutex
 	txLogsProcessor     process.TransactionLogProcessor
 	vmOutputCacher      storage.Cacher
+	executionTracer     process.ExecutionTracer
 	isGenesisProcessing bool
 }
 
@@ -127,6 +129,7 @@ type ArgsNewSmartContractProcessor struct {
 	EpochNotifier       process.EpochNotifier
 	VMOutputCacher      storage.Cacher
 	ArwenChangeLocker   common.Locker
+	ExecutionTracer     process.ExecutionTracer
 	IsGenesisProcessing bool
 }
 
@@ -189,6 +192,9 @@ func NewSmartContractProcessor(args ArgsNewSmartContractProcessor) (*scProcessor
 	if check.IfNil(args.VMOutputCacher) {
 		return nil, process.ErrNilCacher
 	}
+	if check.IfNil(args.ExecutionTracer) {
+		return nil, process.ErrNilExecutionTracer
+	}
 
 	builtInFuncCost := args.GasSchedule.LatestGasSchedule()[common.BuiltInCost]
 	baseOperationCost := args.GasSchedule.LatestGasSchedule()[common.BaseOperationCost]
@@ -222,6 +228,7 @@ func NewSmartContractProcessor(args ArgsNewSmartContractProcessor) (*scProcessor
 		backwardCompSaveKeyValueEnableEpoch:   args.EnableEpochs.BackwardCompSaveKeyValueEnableEpoch,
 		arwenChangeLocker:                     args.ArwenChangeLocker,
 		vmOutputCacher:                        args.VMOutputCacher,
+		executionTracer:                       args.ExecutionTracer,
 		storePerByte:                          baseOperationCost["StorePerByte"],
 		persistPerByte:                        baseOperationCost["PersistPerByte"],
 		incrementSCRNonceInMultiTransferEnableEpoch: args.EnableEpochs.IncrementSCRNonceInMultiTransferEnableEpoch,
@@ -415,7 +422,9 @@ func (sc *scProcessor) executeSmartContractCall(
 	}
 
 	var vmOutput *vmcommon.VMOutput
+	sc.executionTracer.EnterFrame(tracing.FrameTypeCall, vmInput.RecipientAddr, vmInput.Function, &vmInput.VMInput)
 	vmOutput, err = vmExec.RunSmartContractCall(vmInput)
+	sc.executionTracer.ExitFrame(vmOutput, err)
 	sc.arwenChangeLocker.RUnlock()
 	if err != nil {
 		log.Debug("run smart contract call error", "error", err.Error())
@@ -1522,7 +1531,9 @@ func (sc *scProcessor) doDeploySmartContract(
 		return vmcommon.UserError, sc.ProcessIfError(acntSnd, txHash, tx, err.Error(), []byte(""), snapshot, vmInput.GasLocked)
 	}
 
+	sc.executionTracer.EnterFrame(tracing.FrameTypeDeploy, nil, "", &vmInput.VMInput)
 	vmOutput, err = vmExec.RunSmartContractCreate(vmInput)
+	sc.executionTracer.ExitFrame(vmOutput, err)
 	sc.arwenChangeLocker.RUnlock()
 	if err != nil {
 		log.Debug("VM error", "error", err.Error())
@@ -20,6 +20,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/block/postprocess"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
@@ -101,6 +102,7 @@ func createMockSmartContractProcessorArguments() ArgsNewSmartContractProcessor {
 		EpochNotifier:     &mock.EpochNotifierStub{},
 		ArwenChangeLocker: &sync.RWMutex{},
 		VMOutputCacher:    txcache.NewDisabledCache(),
+		ExecutionTracer:   tracing.NewDisabledExecutionTracer(),
 	}
 }
 
@@ -327,6 +329,17 @@ func TestNewSmartContractProcessor_NilLockerShouldErr(t *testing.T) {
 	require.Equal(t, process.ErrNilLocker, err)
 }
 
+func TestNewSmartContractProcessor_NilExecutionTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.ExecutionTracer = nil
+	sc, err := NewSmartContractProcessor(arguments)
+
+	require.Nil(t, sc)
+	require.Equal(t, process.ErrNilExecutionTracer, err)
+}
+
 func TestNewSmartContractProcessor_ShouldRegisterNotifiers(t *testing.T) {
 	t.Parallel()
 
@@ -1636,6 +1649,51 @@ func TestScProcessor_ExecuteSmartContractTransaction(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestScProcessor_ExecuteSmartContractTransactionShouldTraceTheCall(t *testing.T) {
+	t.Parallel()
+
+	vm := &mock.VMContainerMock{}
+	argParser := &mock.ArgumentParserMock{}
+	accntState := &stateMock.AccountsStub{}
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.VmContainer = vm
+	arguments.ArgsParser = argParser
+	arguments.AccountsDB = accntState
+
+	numEnteredFrames := 0
+	numExitedFrames := 0
+	tx := &transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = []byte("DST0000000")
+	tx.Data = []byte("data")
+	tx.Value = big.NewInt(0)
+	arguments.ExecutionTracer = &mock.ExecutionTracerStub{
+		EnterFrameCalled: func(frameType string, callee []byte, _ string, input *vmcommon.VMInput) {
+			require.Equal(t, tracing.FrameTypeCall, frameType)
+			require.Equal(t, tx.RcvAddr, callee)
+			require.Equal(t, tx.SndAddr, input.CallerAddr)
+			numEnteredFrames++
+		},
+		ExitFrameCalled: func(_ *vmcommon.VMOutput, _ error) {
+			require.Equal(t, 1, numEnteredFrames)
+			numExitedFrames++
+		},
+	}
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	acntSrc, acntDst := createAccounts(tx)
+	accntState.LoadAccountCalled = func(address []byte) (handler vmcommon.AccountHandler, e error) {
+		return acntSrc, nil
+	}
+
+	acntDst.SetCode([]byte("code"))
+	_, err := sc.ExecuteSmartContractTransaction(tx, acntSrc, acntDst)
+	require.Nil(t, err)
+	require.Equal(t, 1, numEnteredFrames)
+	require.Equal(t, 1, numExitedFrames)
+}
+
 func TestScProcessor_ExecuteSmartContractTransactionSaveLogCalled(t *testing.T) {
 	t.Parallel()
 
@@ -1,23 +1,36 @@
 package smartContract
 
 import (
+	"bytes"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"math"
 	"math/big"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
 	vmData "github.com/ElrondNetwork/elrond-go-core/data/vm"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/ElrondNetwork/elrond-vm-common/parsers"
 )
 
 var _ process.SCQueryService = (*SCQueryService)(nil)
 
+// anyArwenVersion is the version configured for the epochs which accept any Arwen version
+const anyArwenVersion = "*"
+
 // SCQueryService can execute Get functions over SC to fetch stored values
 type SCQueryService struct {
 	vmContainer       process.VirtualMachinesContainer
@@ -28,15 +41,36 @@ type SCQueryService struct {
 	numQueries        int
 	gasForQuery       uint64
 	arwenChangeLocker common.Locker
+	accountsAdapter   state.AccountsAdapter
+	epochNotifier     process.EpochNotifier
+	storageService    dataRetriever.StorageService
+	marshalizer       marshal.Marshalizer
+	uint64Converter   typeConverters.Uint64ByteSliceConverter
+	shardCoordinator  sharding.Coordinator
+	recreatedRootHash []byte
+
+	arwenVersionProvider        process.ArwenVersionProvider
+	validatorAccountsAdapter    state.AccountsAdapter
+	recreatedValidatorsRootHash []byte
 }
 
 // ArgsNewSCQueryService defines the arguments needed for the sc query service
 type ArgsNewSCQueryService struct {
-	VmContainer       process.VirtualMachinesContainer
-	EconomicsFee      process.FeeHandler
-	BlockChainHook    process.BlockChainHookHandler
-	BlockChain        data.ChainHandler
-	ArwenChangeLocker common.Locker
+	VmContainer              process.VirtualMachinesContainer
+	EconomicsFee             process.FeeHandler
+	BlockChainHook           process.BlockChainHookHandler
+	BlockChain               data.ChainHandler
+	ArwenChangeLocker        common.Locker
+	AccountsAdapter          state.AccountsAdapter
+	EpochNotifier            process.EpochNotifier
+	StorageService           dataRetriever.StorageService
+	Marshalizer              marshal.Marshalizer
+	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	ShardCoordinator         sharding.Coordinator
+	ArwenVersionProvider     process.ArwenVersionProvider
+	// ValidatorAccountsAdapter is only used on the metachain, where the system smart contracts read the validators
+	// state. It can be nil on the shards
+	ValidatorAccountsAdapter state.AccountsAdapter
 }
 
 // NewSCQueryService returns a new instance of SCQueryService
@@ -58,6 +92,27 @@ func NewSCQueryService(
 	if check.IfNilReflect(args.ArwenChangeLocker) {
 		return nil, process.ErrNilLocker
 	}
+	if check.IfNil(args.AccountsAdapter) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+	if check.IfNil(args.StorageService) {
+		return nil, process.ErrNilStorage
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Uint64ByteSliceConverter) {
+		return nil, process.ErrNilUint64Converter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.ArwenVersionProvider) {
+		return nil, process.ErrNilArwenVersionProvider
+	}
 
 	return &SCQueryService{
 		vmContainer:       args.VmContainer,
@@ -66,6 +121,15 @@ func NewSCQueryService(
 		blockChainHook:    args.BlockChainHook,
 		arwenChangeLocker: args.ArwenChangeLocker,
 		gasForQuery:       math.MaxUint64,
+		accountsAdapter:   args.AccountsAdapter,
+		epochNotifier:     args.EpochNotifier,
+		storageService:    args.StorageService,
+		marshalizer:       args.Marshalizer,
+		uint64Converter:   args.Uint64ByteSliceConverter,
+		shardCoordinator:  args.ShardCoordinator,
+
+		arwenVersionProvider:     args.ArwenVersionProvider,
+		validatorAccountsAdapter: args.ValidatorAccountsAdapter,
 	}, nil
 }
 
@@ -88,7 +152,24 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	log.Trace("executeScCall", "function", query.FuncName, "numQueries", service.numQueries)
 	service.numQueries++
 
-	service.blockChainHook.SetCurrentHeader(service.blockChain.GetCurrentBlockHeader())
+	blockHeader, err := service.getBlockHeader(query)
+	if err != nil {
+		return nil, err
+	}
+
+	err = service.recreateTrieIfNeeded(query, blockHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	// the epoch notifier of the query element replaces the Arwen instance with the one matching the epoch of the
+	// header, so it is called before acquiring the Arwen change locker. A historical query moves the epoch back to
+	// the one of the current block once it is done
+	service.epochNotifier.CheckEpoch(blockHeader)
+	if isHistoricalQuery(query) {
+		defer service.epochNotifier.CheckEpoch(service.blockChain.GetCurrentBlockHeader())
+	}
+	service.blockChainHook.SetCurrentHeader(blockHeader)
 
 	service.arwenChangeLocker.RLock()
 	vm, err := findVMByScAddress(service.vmContainer, query.ScAddress)
@@ -97,6 +178,12 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 		return nil, err
 	}
 
+	err = service.checkArwenVersion(query, vm, blockHeader)
+	if err != nil {
+		service.arwenChangeLocker.RUnlock()
+		return nil, err
+	}
+
 	query = prepareScQuery(query)
 	vmInput := service.createVMCallInput(query, gasPrice)
 	vmOutput, err := vm.RunSmartContractCall(vmInput)
@@ -117,6 +204,138 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	return vmOutput, nil
 }
 
+func (service *SCQueryService) getBlockHeader(query *process.SCQuery) (data.HeaderHandler, error) {
+	if query.HasBlockNonce && len(query.BlockHash) > 0 {
+		return nil, process.ErrBlockNonceAndHashBothProvided
+	}
+
+	if query.HasBlockNonce {
+		blockHeader, _, err := process.GetHeaderFromStorageWithNonce(
+			query.BlockNonce,
+			service.shardCoordinator.SelfId(),
+			service.storageService,
+			service.uint64Converter,
+			service.marshalizer,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%w while searching the block with nonce %d", err, query.BlockNonce)
+		}
+
+		return blockHeader, nil
+	}
+
+	if len(query.BlockHash) > 0 {
+		blockHeader, err := service.getBlockHeaderByHash(query.BlockHash)
+		if err != nil {
+			return nil, fmt.Errorf("%w while searching the block with hash %s", err, hex.EncodeToString(query.BlockHash))
+		}
+
+		return blockHeader, nil
+	}
+
+	return service.blockChain.GetCurrentBlockHeader(), nil
+}
+
+func (service *SCQueryService) getBlockHeaderByHash(headerHash []byte) (data.HeaderHandler, error) {
+	if service.shardCoordinator.SelfId() == core.MetachainShardId {
+		return process.GetMetaHeaderFromStorage(headerHash, service.marshalizer, service.storageService)
+	}
+
+	return process.GetShardHeaderFromStorage(headerHash, service.marshalizer, service.storageService)
+}
+
+func isHistoricalQuery(query *process.SCQuery) bool {
+	return query.HasBlockNonce || len(query.BlockHash) > 0
+}
+
+// checkArwenVersion verifies that the Arwen instance selected for the epoch of the header is the one configured for
+// that epoch. The Arwen instance is replaced by the VM container factory, which only logs a failed replacement
+func (service *SCQueryService) checkArwenVersion(query *process.SCQuery, vm vmcommon.VMExecutionHandler, blockHeader data.HeaderHandler) error {
+	if check.IfNil(blockHeader) {
+		return nil
+	}
+	vmType, err := parseVMTypeFromContractAddress(query.ScAddress)
+	if err != nil || !bytes.Equal(vmType, factory.ArwenVirtualMachine) {
+		return nil
+	}
+
+	requiredVersion := service.arwenVersionProvider.GetArwenVersion(blockHeader.GetEpoch())
+	isAnyVersionAllowed := len(requiredVersion) == 0 || requiredVersion == anyArwenVersion
+	if isAnyVersionAllowed || requiredVersion == vm.GetVersion() {
+		return nil
+	}
+
+	return fmt.Errorf("%w: block nonce %d, epoch %d needs Arwen %s, loaded Arwen %s",
+		process.ErrArwenVersionNotAvailableForQuery,
+		blockHeader.GetNonce(),
+		blockHeader.GetEpoch(),
+		requiredVersion,
+		vm.GetVersion(),
+	)
+}
+
+// recreateTrieIfNeeded moves the accounts trie to the root hash of the provided header. The queries without a block
+// use the current block header, so the accounts adapter of the service follows the latest committed state on its own
+// trie, no matter how the state used by the block processing is moved (for example when reverting a block)
+func (service *SCQueryService) recreateTrieIfNeeded(query *process.SCQuery, blockHeader data.HeaderHandler) error {
+	if check.IfNil(blockHeader) {
+		blockHeader = service.blockChain.GetGenesisHeader()
+	}
+	if check.IfNil(blockHeader) {
+		if isHistoricalQuery(query) {
+			return process.ErrNilHeaderHandler
+		}
+
+		return nil
+	}
+
+	rootHash := blockHeader.GetRootHash()
+	if bytes.Equal(rootHash, service.recreatedRootHash) {
+		return service.recreateValidatorsTrieIfNeeded(blockHeader)
+	}
+
+	err := service.accountsAdapter.RecreateTrie(rootHash)
+	if err != nil {
+		service.recreatedRootHash = make([]byte, 0)
+		return fmt.Errorf("%w for block nonce %d, root hash %s: %s",
+			process.ErrStateNotAvailable,
+			blockHeader.GetNonce(),
+			hex.EncodeToString(rootHash),
+			err.Error(),
+		)
+	}
+
+	service.recreatedRootHash = rootHash
+
+	return service.recreateValidatorsTrieIfNeeded(blockHeader)
+}
+
+func (service *SCQueryService) recreateValidatorsTrieIfNeeded(blockHeader data.HeaderHandler) error {
+	if check.IfNil(service.validatorAccountsAdapter) {
+		return nil
+	}
+
+	rootHash := blockHeader.GetValidatorStatsRootHash()
+	if len(rootHash) == 0 || bytes.Equal(rootHash, service.recreatedValidatorsRootHash) {
+		return nil
+	}
+
+	err := service.validatorAccountsAdapter.RecreateTrie(rootHash)
+	if err != nil {
+		service.recreatedValidatorsRootHash = make([]byte, 0)
+		return fmt.Errorf("%w for block nonce %d, validator statistics root hash %s: %s",
+			process.ErrStateNotAvailable,
+			blockHeader.GetNonce(),
+			hex.EncodeToString(rootHash),
+			err.Error(),
+		)
+	}
+
+	service.recreatedValidatorsRootHash = rootHash
+
+	return nil
+}
+
 func prepareScQuery(query *process.SCQuery) *process.SCQuery {
 	if query.CallerAddr == nil {
 		query.CallerAddr = query.ScAddress
@@ -0,0 +1,119 @@
+//go:build !race
+// +build !race
+
+// TODO remove build condition above to allow -race -short, after Arwen fix
+
+package smartContract
+
+import (
+	"sync"
+	"testing"
+
+	arwenConfig "github.com/ElrondNetwork/arwen-wasm-vm/v1_4/config"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common/forking"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+	processFactory "github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
+	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	vmcommonBuiltInFunctions "github.com/ElrondNetwork/elrond-vm-common/builtInFunctions"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockBlockChainHookArgs() hooks.ArgBlockChainHook {
+	datapool := dataRetrieverMock.NewPoolsHolderMock()
+	return hooks.ArgBlockChainHook{
+		Accounts: &stateMock.AccountsStub{
+			GetExistingAccountCalled: func(address []byte) (handler vmcommon.AccountHandler, e error) {
+				return &mock.AccountWrapMock{}, nil
+			},
+		},
+		PubkeyConv:         mock.NewPubkeyConverterMock(32),
+		StorageService:     &mock.ChainStorerMock{},
+		BlockChain:         &mock.BlockChainMock{},
+		ShardCoordinator:   mock.NewOneShardCoordinatorMock(),
+		Marshalizer:        &mock.MarshalizerMock{},
+		Uint64Converter:    &mock.Uint64ByteSliceConverterMock{},
+		BuiltInFunctions:   vmcommonBuiltInFunctions.NewBuiltInFunctionContainer(),
+		DataPool:           datapool,
+		CompiledSCPool:     datapool.SmartContracts(),
+		NilCompiledSCStore: true,
+		ExecutionTracer:    tracing.NewDisabledExecutionTracer(),
+	}
+}
+
+func TestSCQueryService_ExecuteQueryAtBlockShouldUseTheArwenVersionOfTheBlock(t *testing.T) {
+	t.Parallel()
+
+	esdtTransferParser, _ := parsers.NewESDTTransferParser(&mock.MarshalizerMock{})
+	epochNotifier := forking.NewGenericEpochNotifier()
+	epochNotifier.CheckEpoch(&block.Header{Epoch: 10})
+	arwenChangeLocker := &sync.RWMutex{}
+	vmFactory, err := shard.NewVMContainerFactory(shard.ArgVMContainerFactory{
+		Config: config.VirtualMachineConfig{
+			ArwenVersions: []config.ArwenVersionByEpoch{
+				{StartEpoch: 0, Version: "v1.3"},
+				{StartEpoch: 3, Version: "v1.4"},
+			},
+		},
+		BlockGasLimit:      10000,
+		GasSchedule:        mock.NewGasScheduleNotifierMock(arwenConfig.MakeGasMapForTests()),
+		ArgBlockChainHook:  createMockBlockChainHookArgs(),
+		EpochConfig:        config.EnableEpochs{},
+		ArwenChangeLocker:  arwenChangeLocker,
+		EpochNotifier:      epochNotifier,
+		ESDTTransferParser: esdtTransferParser,
+	})
+	require.Nil(t, err)
+	container, err := vmFactory.Create()
+	require.Nil(t, err)
+	defer func() {
+		_ = container.Close()
+	}()
+
+	usedVersions := make([]string, 0)
+	args := createArgumentsForHistoricalSCQuery(t)
+	args.EpochNotifier = epochNotifier
+	args.ArwenChangeLocker = arwenChangeLocker
+	args.ArwenVersionProvider = vmFactory
+	args.VmContainer = &mock.VMContainerMock{
+		GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+			arwenVM, errGet := container.Get(key)
+			if errGet != nil {
+				return nil, errGet
+			}
+
+			return &mock.VMExecutionHandlerStub{
+				GetVersionCalled: arwenVM.GetVersion,
+				RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+					usedVersions = append(usedVersions, arwenVM.GetVersion())
+					return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+				},
+			}, nil
+		},
+	}
+	target, _ := NewSCQueryService(args)
+
+	arwenVM, _ := container.Get(processFactory.ArwenVirtualMachine)
+	require.Equal(t, "v1.4", arwenVM.GetVersion())
+
+	_, err = target.ExecuteQuery(&process.SCQuery{ScAddress: createArwenScAddress(), FuncName: "f", BlockNonce: 2, HasBlockNonce: true})
+	require.Nil(t, err)
+	arwenVM, _ = container.Get(processFactory.ArwenVirtualMachine)
+	assert.Equal(t, "v1.4", arwenVM.GetVersion())
+
+	_, err = target.ExecuteQuery(&process.SCQuery{ScAddress: createArwenScAddress(), FuncName: "f"})
+	require.Nil(t, err)
+	_, err = target.ExecuteQuery(&process.SCQuery{ScAddress: createArwenScAddress(), FuncName: "f", BlockHash: []byte("hash3")})
+	require.Nil(t, err)
+
+	assert.Equal(t, []string{"v1.3", "v1.4", "v1.4"}, usedVersions)
+}
@@ -3,6 +3,7 @@ package smartContract
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"math"
 	"math/big"
 	"sync"
@@ -10,9 +11,23 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
+	processFactory "github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/state/factory"
+	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager/disabled"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/ElrondNetwork/elrond-go/trie"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -22,11 +37,18 @@ const DummyScAddress = "00000000000000000500fabd9501b7e5353de57a4e319857c2fb9908
 
 func createMockArgumentsForSCQuery() ArgsNewSCQueryService {
 	return ArgsNewSCQueryService{
-		VmContainer:       &mock.VMContainerMock{},
-		EconomicsFee:      &mock.FeeHandlerStub{},
-		BlockChainHook:    &mock.BlockChainHookHandlerMock{},
-		BlockChain:        &mock.BlockChainMock{},
-		ArwenChangeLocker: &sync.RWMutex{},
+		VmContainer:              &mock.VMContainerMock{},
+		EconomicsFee:             &mock.FeeHandlerStub{},
+		BlockChainHook:           &mock.BlockChainHookHandlerMock{},
+		BlockChain:               &mock.BlockChainMock{},
+		ArwenChangeLocker:        &sync.RWMutex{},
+		AccountsAdapter:          &stateMock.AccountsStub{},
+		EpochNotifier:            &mock.EpochNotifierStub{},
+		StorageService:           genericMocks.NewChainStorerMock(0),
+		Marshalizer:              &mock.MarshalizerMock{},
+		Uint64ByteSliceConverter: uint64ByteSlice.NewBigEndianConverter(),
+		ShardCoordinator:         mock.NewMultiShardsCoordinatorMock(1),
+		ArwenVersionProvider:     &testscommon.ArwenVersionProviderStub{},
 	}
 }
 
@@ -85,6 +107,83 @@ func TestNewSCQueryService_NilArwenLockerShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilLocker, err)
 }
 
+func TestNewSCQueryService_NilAccountsAdapterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForSCQuery()
+	args.AccountsAdapter = nil
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+}
+
+func TestNewSCQueryService_NilEpochNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForSCQuery()
+	args.EpochNotifier = nil
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestNewSCQueryService_NilStorageServiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForSCQuery()
+	args.StorageService = nil
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilStorage, err)
+}
+
+func TestNewSCQueryService_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForSCQuery()
+	args.Marshalizer = nil
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewSCQueryService_NilUint64ConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForSCQuery()
+	args.Uint64ByteSliceConverter = nil
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilUint64Converter, err)
+}
+
+func TestNewSCQueryService_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForSCQuery()
+	args.ShardCoordinator = nil
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+}
+
+func TestNewSCQueryService_NilArwenVersionProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForSCQuery()
+	args.ArwenVersionProvider = nil
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilArwenVersionProvider, err)
+}
+
 func TestNewSCQueryService_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -481,10 +580,17 @@ func TestNewSCQueryService_CloseShouldWork(t *testing.T) {
 				return nil
 			},
 		},
-		EconomicsFee:      &mock.FeeHandlerStub{},
-		BlockChainHook:    &mock.BlockChainHookHandlerMock{},
-		BlockChain:        &mock.BlockChainMock{},
-		ArwenChangeLocker: &sync.RWMutex{},
+		EconomicsFee:             &mock.FeeHandlerStub{},
+		BlockChainHook:           &mock.BlockChainHookHandlerMock{},
+		BlockChain:               &mock.BlockChainMock{},
+		ArwenChangeLocker:        &sync.RWMutex{},
+		AccountsAdapter:          &stateMock.AccountsStub{},
+		EpochNotifier:            &mock.EpochNotifierStub{},
+		StorageService:           genericMocks.NewChainStorerMock(0),
+		Marshalizer:              &mock.MarshalizerMock{},
+		Uint64ByteSliceConverter: uint64ByteSlice.NewBigEndianConverter(),
+		ShardCoordinator:         mock.NewMultiShardsCoordinatorMock(1),
+		ArwenVersionProvider:     &testscommon.ArwenVersionProviderStub{},
 	}
 
 	target, _ := NewSCQueryService(argsNewSCQueryService)
@@ -493,3 +599,278 @@ func TestNewSCQueryService_CloseShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, closeCalled)
 }
+
+// createArgumentsForHistoricalSCQuery saves the headers with nonces from 1 to 3 in storage. The block with nonce N has
+// the hash "hashN", the root hash "rootN" and the epoch N. The state of the block with nonce 1 was pruned
+func createArgumentsForHistoricalSCQuery(t *testing.T) ArgsNewSCQueryService {
+	args := createMockArgumentsForSCQuery()
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		header := &block.Header{
+			Nonce:    nonce,
+			Epoch:    uint32(nonce),
+			RootHash: []byte(fmt.Sprintf("root%d", nonce)),
+		}
+		headerBytes, _ := args.Marshalizer.Marshal(header)
+		headerHash := []byte(fmt.Sprintf("hash%d", nonce))
+
+		err := args.StorageService.Put(dataRetriever.BlockHeaderUnit, headerHash, headerBytes)
+		require.Nil(t, err)
+		err = args.StorageService.Put(dataRetriever.ShardHdrNonceHashDataUnit, args.Uint64ByteSliceConverter.ToByteSlice(nonce), headerHash)
+		require.Nil(t, err)
+	}
+
+	args.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Nonce: 10, Epoch: 10, RootHash: []byte("root10")}
+		},
+	}
+	args.AccountsAdapter = &stateMock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			if string(rootHash) == "root1" {
+				return errors.New("root hash not found")
+			}
+
+			return nil
+		},
+	}
+	args.VmContainer = &mock.VMContainerMock{
+		GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+			return &mock.VMExecutionHandlerStub{
+				RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+					return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+				},
+			}, nil
+		},
+	}
+
+	return args
+}
+
+func TestSCQueryService_ExecuteQueryAtBlockShouldUseTheStateAndEpochOfTheBlock(t *testing.T) {
+	t.Parallel()
+
+	recreatedRootHashes := make([]string, 0)
+	checkedEpochs := make([]uint32, 0)
+	currentHeaderNonces := make([]uint64, 0)
+	args := createArgumentsForHistoricalSCQuery(t)
+	args.AccountsAdapter = &stateMock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			recreatedRootHashes = append(recreatedRootHashes, string(rootHash))
+			return nil
+		},
+	}
+	args.EpochNotifier = &mock.EpochNotifierStub{
+		CheckEpochCalled: func(header data.HeaderHandler) {
+			checkedEpochs = append(checkedEpochs, header.GetEpoch())
+		},
+	}
+	args.BlockChainHook = &mock.BlockChainHookHandlerMock{
+		SetCurrentHeaderCalled: func(hdr data.HeaderHandler) {
+			currentHeaderNonces = append(currentHeaderNonces, hdr.GetNonce())
+		},
+	}
+	target, _ := NewSCQueryService(args)
+
+	_, err := target.ExecuteQuery(&process.SCQuery{ScAddress: []byte(DummyScAddress), FuncName: "f"})
+	require.Nil(t, err)
+	_, err = target.ExecuteQuery(&process.SCQuery{ScAddress: []byte(DummyScAddress), FuncName: "f", BlockNonce: 2, HasBlockNonce: true})
+	require.Nil(t, err)
+	_, err = target.ExecuteQuery(&process.SCQuery{ScAddress: []byte(DummyScAddress), FuncName: "f", BlockHash: []byte("hash3")})
+	require.Nil(t, err)
+	_, err = target.ExecuteQuery(&process.SCQuery{ScAddress: []byte(DummyScAddress), FuncName: "f"})
+	require.Nil(t, err)
+
+	assert.Equal(t, []string{"root10", "root2", "root3", "root10"}, recreatedRootHashes)
+	// each historical query moves the epoch back to the one of the current block
+	assert.Equal(t, []uint32{10, 2, 10, 3, 10, 10}, checkedEpochs)
+	assert.Equal(t, []uint64{10, 2, 3, 10}, currentHeaderNonces)
+}
+
+func TestSCQueryService_ExecuteQueryAtBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("both block nonce and hash", func(t *testing.T) {
+		t.Parallel()
+
+		target, _ := NewSCQueryService(createArgumentsForHistoricalSCQuery(t))
+
+		query := &process.SCQuery{ScAddress: []byte(DummyScAddress), FuncName: "f", BlockNonce: 2, HasBlockNonce: true, BlockHash: []byte("hash2")}
+		_, err := target.ExecuteQuery(query)
+		assert.Equal(t, process.ErrBlockNonceAndHashBothProvided, err)
+	})
+	t.Run("unknown block nonce", func(t *testing.T) {
+		t.Parallel()
+
+		target, _ := NewSCQueryService(createArgumentsForHistoricalSCQuery(t))
+
+		_, err := target.ExecuteQuery(&process.SCQuery{ScAddress: []byte(DummyScAddress), FuncName: "f", BlockNonce: 7, HasBlockNonce: true})
+		assert.True(t, errors.Is(err, process.ErrMissingHashForHeaderNonce))
+	})
+	t.Run("unknown block hash", func(t *testing.T) {
+		t.Parallel()
+
+		target, _ := NewSCQueryService(createArgumentsForHistoricalSCQuery(t))
+
+		_, err := target.ExecuteQuery(&process.SCQuery{ScAddress: []byte(DummyScAddress), FuncName: "f", BlockHash: []byte("hash7")})
+		assert.True(t, errors.Is(err, process.ErrMissingHeader))
+	})
+	t.Run("Arwen version of the block not loaded", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgumentsForHistoricalSCQuery(t)
+		args.ArwenVersionProvider = &testscommon.ArwenVersionProviderStub{
+			GetArwenVersionCalled: func(epoch uint32) string {
+				if epoch < 3 {
+					return "v1.3"
+				}
+
+				return "v1.4"
+			},
+		}
+		args.VmContainer = &mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return &mock.VMExecutionHandlerStub{
+					GetVersionCalled: func() string {
+						return "v1.4"
+					},
+					RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+						return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+					},
+				}, nil
+			},
+		}
+		target, _ := NewSCQueryService(args)
+
+		_, err := target.ExecuteQuery(&process.SCQuery{ScAddress: createArwenScAddress(), FuncName: "f", BlockNonce: 2, HasBlockNonce: true})
+		assert.True(t, errors.Is(err, process.ErrArwenVersionNotAvailableForQuery))
+
+		_, err = target.ExecuteQuery(&process.SCQuery{ScAddress: createArwenScAddress(), FuncName: "f", BlockNonce: 3, HasBlockNonce: true})
+		assert.Nil(t, err)
+	})
+	t.Run("pruned state", func(t *testing.T) {
+		t.Parallel()
+
+		target, _ := NewSCQueryService(createArgumentsForHistoricalSCQuery(t))
+
+		_, err := target.ExecuteQuery(&process.SCQuery{ScAddress: []byte(DummyScAddress), FuncName: "f", BlockNonce: 1, HasBlockNonce: true})
+		assert.True(t, errors.Is(err, process.ErrStateNotAvailable))
+
+		_, err = target.ExecuteQuery(&process.SCQuery{ScAddress: []byte(DummyScAddress), FuncName: "f"})
+		assert.Nil(t, err)
+	})
+}
+
+func createArwenScAddress() []byte {
+	scAddress := make([]byte, 32)
+	copy(scAddress[core.NumInitCharactersForScAddress-core.VMTypeLen:], processFactory.ArwenVirtualMachine)
+
+	return scAddress
+}
+
+func TestSCQueryService_ExecuteQueryShouldFollowTheCurrentBlockAfterTheProcessingStateIsReverted(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &testscommon.MarshalizerMock{}
+	hasher := &testscommon.KeccakMock{}
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	processingTrie, _ := trie.NewTrie(storageManager, marshalizer, hasher, 5)
+	processingAccounts, _ := state.NewAccountsDB(processingTrie, hasher, marshalizer, factory.NewAccountCreator(), disabled.NewDisabledStoragePruningManager())
+	queriesTrie, _ := processingTrie.Recreate(trie.EmptyTrieHash)
+	queriesAccounts, _ := state.NewAccountsDB(queriesTrie, hasher, marshalizer, factory.NewAccountCreator(), disabled.NewDisabledStoragePruningManager())
+
+	address := bytes.Repeat([]byte{1}, 32)
+	addToBalance := func(value int64) []byte {
+		account, err := processingAccounts.LoadAccount(address)
+		require.Nil(t, err)
+		require.Nil(t, account.(state.UserAccountHandler).AddToBalance(big.NewInt(value)))
+		require.Nil(t, processingAccounts.SaveAccount(account))
+		rootHash, err := processingAccounts.Commit()
+		require.Nil(t, err)
+
+		return rootHash
+	}
+
+	var currentHeader data.HeaderHandler
+	args := createMockArgumentsForSCQuery()
+	args.AccountsAdapter = queriesAccounts
+	args.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return currentHeader
+		},
+	}
+	args.VmContainer = &mock.VMContainerMock{
+		GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+			return &mock.VMExecutionHandlerStub{
+				RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+					account, err := queriesAccounts.GetExistingAccount(address)
+					if err != nil {
+						return nil, err
+					}
+
+					balance := account.(state.UserAccountHandler).GetBalance()
+					return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, ReturnData: [][]byte{balance.Bytes()}}, nil
+				},
+			}, nil
+		},
+	}
+	target, _ := NewSCQueryService(args)
+	getBalance := func() int64 {
+		vmOutput, err := target.ExecuteQuery(&process.SCQuery{ScAddress: []byte(DummyScAddress), FuncName: "balance"})
+		require.Nil(t, err)
+
+		return big.NewInt(0).SetBytes(vmOutput.ReturnData[0]).Int64()
+	}
+
+	rootHash1 := addToBalance(10)
+	rootHash2 := addToBalance(5)
+	currentHeader = &block.Header{Nonce: 2, RootHash: rootHash2}
+	assert.Equal(t, int64(15), getBalance())
+
+	// the processing reverts the block with nonce 2 and moves its state back
+	require.Nil(t, processingAccounts.RecreateTrie(rootHash1))
+	currentHeader = &block.Header{Nonce: 1, RootHash: rootHash1}
+	assert.Equal(t, int64(10), getBalance())
+
+	rootHash3 := addToBalance(100)
+	currentHeader = &block.Header{Nonce: 2, RootHash: rootHash3}
+	assert.Equal(t, int64(110), getBalance())
+}
+
+func TestSCQueryService_ExecuteQueryShouldRecreateTheValidatorsStateOfTheBlock(t *testing.T) {
+	t.Parallel()
+
+	currentHeader := &block.MetaBlock{Nonce: 1, RootHash: []byte("root2"), ValidatorStatsRootHash: []byte("validators1")}
+	recreatedValidatorsRootHashes := make([]string, 0)
+	args := createArgumentsForHistoricalSCQuery(t)
+	args.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return currentHeader
+		},
+	}
+	args.ValidatorAccountsAdapter = &stateMock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			recreatedValidatorsRootHashes = append(recreatedValidatorsRootHashes, string(rootHash))
+			if string(rootHash) == "validators3" {
+				return errors.New("root hash not found")
+			}
+
+			return nil
+		},
+	}
+	target, _ := NewSCQueryService(args)
+
+	_, err := target.ExecuteQuery(&process.SCQuery{ScAddress: []byte(DummyScAddress), FuncName: "f"})
+	require.Nil(t, err)
+	_, err = target.ExecuteQuery(&process.SCQuery{ScAddress: []byte(DummyScAddress), FuncName: "f"})
+	require.Nil(t, err)
+
+	currentHeader = &block.MetaBlock{Nonce: 2, RootHash: []byte("root2"), ValidatorStatsRootHash: []byte("validators2")}
+	_, err = target.ExecuteQuery(&process.SCQuery{ScAddress: []byte(DummyScAddress), FuncName: "f"})
+	require.Nil(t, err)
+
+	currentHeader = &block.MetaBlock{Nonce: 3, RootHash: []byte("root3"), ValidatorStatsRootHash: []byte("validators3")}
+	_, err = target.ExecuteQuery(&process.SCQuery{ScAddress: []byte(DummyScAddress), FuncName: "f"})
+	assert.True(t, errors.Is(err, process.ErrStateNotAvailable))
+
+	assert.Equal(t, []string{"validators1", "validators2", "validators3"}, recreatedValidatorsRootHashes)
+}
@@ -0,0 +1,57 @@
+package tracing
+
+// The types of the recorded call frames
+const (
+	// FrameTypeDeploy is the type of the frame in which a smart contract is deployed
+	FrameTypeDeploy = "deploy"
+	// FrameTypeCall is the type of the frame in which a smart contract function is executed
+	FrameTypeCall = "call"
+	// FrameTypeBuiltInFunction is the type of the frame in which a built in function is executed
+	FrameTypeBuiltInFunction = "builtInFunction"
+)
+
+// CallFrame holds the details of an execution, along with the executions nested in it. The frames entered by the
+// node, either by the smart contract processor or by the blockchain hook on behalf of the VM, are recorded as they
+// happen. The contracts executed within the VM during a frame, through synchronous or same-shard asynchronous calls,
+// are only reported by the VM output once the frame is exited, so each of them becomes a nested frame holding the gas
+// used by the contract and the logs it emitted. The VM reports neither the order nor the depth of those executions
+// and sums up the gas of all the calls to the same contract, so the caller, the call type, the value and the gas
+// provided of such a frame are not known. The built in functions invoked by a contract executed within the VM are
+// nested in its frame. The transfers and the calls issued by the frame and not executed as part of it are listed apart
+type CallFrame struct {
+	Type            string            `json:"type"`
+	CallType        string            `json:"callType,omitempty"`
+	Caller          string            `json:"caller,omitempty"`
+	Callee          string            `json:"callee,omitempty"`
+	Function        string            `json:"function,omitempty"`
+	Arguments       []string          `json:"arguments,omitempty"`
+	Value           string            `json:"value,omitempty"`
+	GasProvided     uint64            `json:"gasProvided,omitempty"`
+	GasUsed         uint64            `json:"gasUsed"`
+	ReturnCode      string            `json:"returnCode,omitempty"`
+	ReturnMessage   string            `json:"returnMessage,omitempty"`
+	Logs            []*LogEntry       `json:"logs,omitempty"`
+	Calls           []*CallFrame      `json:"calls,omitempty"`
+	OutputTransfers []*OutputTransfer `json:"outputTransfers,omitempty"`
+}
+
+// OutputTransfer holds a transfer or a call issued during a frame which is not executed within the traced
+// execution, such as a cross-shard asynchronous call, but delivered afterwards as a smart contract result
+type OutputTransfer struct {
+	CallType  string   `json:"callType"`
+	Sender    string   `json:"sender"`
+	Receiver  string   `json:"receiver"`
+	Function  string   `json:"function,omitempty"`
+	Arguments []string `json:"arguments,omitempty"`
+	Value     string   `json:"value"`
+	GasLimit  uint64   `json:"gasLimit"`
+}
+
+// LogEntry holds a log emitted during the execution of a frame. The identifier is kept as string while the topics
+// and the data are hex encoded
+type LogEntry struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     []string `json:"topics,omitempty"`
+	Data       string   `json:"data,omitempty"`
+}
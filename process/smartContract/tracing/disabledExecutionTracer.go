@@ -0,0 +1,26 @@
+package tracing
+
+import (
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+type disabledExecutionTracer struct {
+}
+
+// NewDisabledExecutionTracer returns a new instance of an execution tracer which does not record anything
+func NewDisabledExecutionTracer() *disabledExecutionTracer {
+	return &disabledExecutionTracer{}
+}
+
+// EnterFrame does nothing
+func (det *disabledExecutionTracer) EnterFrame(_ string, _ []byte, _ string, _ *vmcommon.VMInput) {
+}
+
+// ExitFrame does nothing
+func (det *disabledExecutionTracer) ExitFrame(_ *vmcommon.VMOutput, _ error) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (det *disabledExecutionTracer) IsInterfaceNil() bool {
+	return det == nil
+}
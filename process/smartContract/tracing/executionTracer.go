@@ -0,0 +1,359 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const dataSeparator = "@"
+
+var callTypeNames = map[vm.CallType]string{
+	vm.DirectCall:             "directCall",
+	vm.AsynchronousCall:       "asynchronousCall",
+	vm.AsynchronousCallBack:   "asynchronousCallBack",
+	vm.ESDTTransferAndExecute: "esdtTransferAndExecute",
+}
+
+// executionTracer records, while a trace is started, the call tree of the smart contract executions: the frames entered
+// and exited by the smart contract processor and by the blockchain hook, along with the contracts executed within the
+// VM, taken from the VM output of each frame
+type executionTracer struct {
+	addressConverter core.PubkeyConverter
+	mut              sync.Mutex
+	isTracing        bool
+	frames           []*CallFrame
+	stack            []*CallFrame
+}
+
+// NewExecutionTracer creates a new execution tracer
+func NewExecutionTracer(addressConverter core.PubkeyConverter) (*executionTracer, error) {
+	if check.IfNil(addressConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	return &executionTracer{
+		addressConverter: addressConverter,
+	}, nil
+}
+
+// StartTrace drops the previously recorded frames, if any, and starts recording
+func (et *executionTracer) StartTrace() {
+	et.mut.Lock()
+	et.isTracing = true
+	et.frames = make([]*CallFrame, 0)
+	et.stack = make([]*CallFrame, 0)
+	et.mut.Unlock()
+}
+
+// StopTrace stops recording and returns the top level frames recorded since the trace was started
+func (et *executionTracer) StopTrace() []*CallFrame {
+	et.mut.Lock()
+	defer et.mut.Unlock()
+
+	frames := et.frames
+	et.isTracing = false
+	et.frames = nil
+	et.stack = nil
+
+	return frames
+}
+
+// EnterFrame records the start of an execution. The frame becomes a nested call of the frame entered before and not
+// yet exited, if any
+func (et *executionTracer) EnterFrame(frameType string, callee []byte, function string, input *vmcommon.VMInput) {
+	et.mut.Lock()
+	defer et.mut.Unlock()
+
+	if !et.isTracing || input == nil {
+		return
+	}
+
+	frame := &CallFrame{
+		Type:        frameType,
+		CallType:    callTypeNames[input.CallType],
+		Caller:      et.encodeAddress(input.CallerAddr),
+		Callee:      et.encodeAddress(callee),
+		Function:    function,
+		Arguments:   encodeArguments(input.Arguments),
+		Value:       valueToString(input.CallValue),
+		GasProvided: input.GasProvided,
+	}
+
+	numOpenFrames := len(et.stack)
+	if numOpenFrames == 0 {
+		et.frames = append(et.frames, frame)
+	} else {
+		parent := et.stack[numOpenFrames-1]
+		parent.Calls = append(parent.Calls, frame)
+	}
+	et.stack = append(et.stack, frame)
+}
+
+// ExitFrame records the end of the last entered execution, filling in the gas used, the return code, the logs, the
+// frames of the contracts executed within the VM and the transfers reported by the VM output
+func (et *executionTracer) ExitFrame(output *vmcommon.VMOutput, err error) {
+	et.mut.Lock()
+	defer et.mut.Unlock()
+
+	numOpenFrames := len(et.stack)
+	if !et.isTracing || numOpenFrames == 0 {
+		return
+	}
+
+	frame := et.stack[numOpenFrames-1]
+	et.stack = et.stack[:numOpenFrames-1]
+
+	if err != nil {
+		frame.GasUsed = frame.GasProvided
+		frame.ReturnMessage = err.Error()
+		return
+	}
+	if output == nil {
+		frame.GasUsed = frame.GasProvided
+		return
+	}
+
+	frame.ReturnCode = output.ReturnCode.String()
+	frame.ReturnMessage = output.ReturnMessage
+	if output.GasRemaining < frame.GasProvided {
+		frame.GasUsed = frame.GasProvided - output.GasRemaining
+	}
+
+	outputAccounts := sortOutputAccounts(output)
+	if frame.Type == FrameTypeDeploy && len(frame.Callee) == 0 {
+		frame.Callee = et.encodeAddress(getDeployedAddress(outputAccounts))
+	}
+
+	logs := et.convertNewLogs(frame, output.Logs)
+	contractFrames := et.addContractFrames(frame, outputAccounts)
+	addLogs(frame, contractFrames, logs)
+	frame.OutputTransfers = et.createOutputTransfers(frame, outputAccounts)
+}
+
+// addContractFrames adds and returns a nested frame for each contract, other than the callee, for which the VM reported
+// the gas used while executing within the frame. The executions failed within the VM are reverted, so they are not
+// reported. The built in functions already recorded as nested frames are moved in the frame of the contract which
+// invoked them
+func (et *executionTracer) addContractFrames(frame *CallFrame, outputAccounts []*vmcommon.OutputAccount) []*CallFrame {
+	contractFrames := make([]*CallFrame, 0)
+	for _, outAcc := range outputAccounts {
+		address := et.encodeAddress(outAcc.Address)
+		if outAcc.GasUsed == 0 || address == frame.Callee {
+			continue
+		}
+
+		contractFrames = append(contractFrames, &CallFrame{
+			Type:       FrameTypeCall,
+			Callee:     address,
+			GasUsed:    outAcc.GasUsed,
+			ReturnCode: vmcommon.Ok.String(),
+		})
+	}
+	if len(contractFrames) == 0 {
+		return contractFrames
+	}
+
+	calls := make([]*CallFrame, 0, len(frame.Calls)+len(contractFrames))
+	for _, call := range frame.Calls {
+		contractFrame := findFrameOfCallee(contractFrames, call.Caller)
+		if contractFrame == nil {
+			calls = append(calls, call)
+			continue
+		}
+
+		contractFrame.Calls = append(contractFrame.Calls, call)
+	}
+	frame.Calls = append(calls, contractFrames...)
+
+	return contractFrames
+}
+
+func findFrameOfCallee(frames []*CallFrame, callee string) *CallFrame {
+	for _, frame := range frames {
+		if frame.Callee == callee {
+			return frame
+		}
+	}
+
+	return nil
+}
+
+func (et *executionTracer) createOutputTransfers(frame *CallFrame, outputAccounts []*vmcommon.OutputAccount) []*OutputTransfer {
+	outputTransfers := make([]*OutputTransfer, 0)
+	for _, outAcc := range outputAccounts {
+		for _, transfer := range outAcc.OutputTransfers {
+			sender := frame.Callee
+			if len(transfer.SenderAddress) > 0 {
+				sender = et.encodeAddress(transfer.SenderAddress)
+			}
+
+			outputTransfer := &OutputTransfer{
+				CallType: callTypeNames[transfer.CallType],
+				Sender:   sender,
+				Receiver: et.encodeAddress(outAcc.Address),
+				Value:    valueToString(transfer.Value),
+				GasLimit: transfer.GasLimit,
+			}
+			if len(transfer.Data) > 0 {
+				tokens := strings.Split(string(transfer.Data), dataSeparator)
+				outputTransfer.Function = tokens[0]
+				if len(tokens) > 1 {
+					outputTransfer.Arguments = tokens[1:]
+				}
+			}
+
+			outputTransfers = append(outputTransfers, outputTransfer)
+		}
+	}
+
+	return outputTransfers
+}
+
+// convertNewLogs returns the logs not already attached to a nested frame
+func (et *executionTracer) convertNewLogs(frame *CallFrame, logs []*vmcommon.LogEntry) []*LogEntry {
+	newLogs := make([]*LogEntry, 0, len(logs))
+	for _, logEntry := range logs {
+		if logEntry == nil {
+			continue
+		}
+
+		entry := et.convertLogEntry(logEntry)
+		if containsLog(frame.Calls, entry) {
+			continue
+		}
+
+		newLogs = append(newLogs, entry)
+	}
+
+	return newLogs
+}
+
+// addLogs attaches each of the logs to the frame of the contract which emitted it, if the contract was executed within
+// the VM, otherwise to the frame itself
+func addLogs(frame *CallFrame, contractFrames []*CallFrame, logs []*LogEntry) {
+	for _, entry := range logs {
+		contractFrame := findFrameOfCallee(contractFrames, entry.Address)
+		if contractFrame != nil {
+			contractFrame.Logs = append(contractFrame.Logs, entry)
+			continue
+		}
+		frame.Logs = append(frame.Logs, entry)
+	}
+}
+
+func containsLog(frames []*CallFrame, entry *LogEntry) bool {
+	for _, frame := range frames {
+		if containsSameLog(frame.Logs, entry) {
+			return true
+		}
+		if containsLog(frame.Calls, entry) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsSameLog(logs []*LogEntry, entry *LogEntry) bool {
+	for _, logEntry := range logs {
+		if isSameLog(logEntry, entry) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isSameLog(first *LogEntry, second *LogEntry) bool {
+	if first.Address != second.Address || first.Identifier != second.Identifier || first.Data != second.Data {
+		return false
+	}
+	if len(first.Topics) != len(second.Topics) {
+		return false
+	}
+	for i := range first.Topics {
+		if first.Topics[i] != second.Topics[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (et *executionTracer) convertLogEntry(logEntry *vmcommon.LogEntry) *LogEntry {
+	return &LogEntry{
+		Address:    et.encodeAddress(logEntry.Address),
+		Identifier: string(logEntry.Identifier),
+		Topics:     encodeArguments(logEntry.Topics),
+		Data:       hex.EncodeToString(logEntry.Data),
+	}
+}
+
+func (et *executionTracer) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return et.addressConverter.Encode(address)
+}
+
+func sortOutputAccounts(output *vmcommon.VMOutput) []*vmcommon.OutputAccount {
+	outputAccounts := make([]*vmcommon.OutputAccount, 0, len(output.OutputAccounts))
+	for _, outAcc := range output.OutputAccounts {
+		if outAcc == nil {
+			continue
+		}
+		outputAccounts = append(outputAccounts, outAcc)
+	}
+
+	sort.Slice(outputAccounts, func(i, j int) bool {
+		return bytes.Compare(outputAccounts[i].Address, outputAccounts[j].Address) < 0
+	})
+
+	return outputAccounts
+}
+
+func getDeployedAddress(outputAccounts []*vmcommon.OutputAccount) []byte {
+	for _, outAcc := range outputAccounts {
+		if len(outAcc.Code) > 0 {
+			return outAcc.Address
+		}
+	}
+
+	return nil
+}
+
+func encodeArguments(arguments [][]byte) []string {
+	if len(arguments) == 0 {
+		return nil
+	}
+
+	encodedArguments := make([]string, 0, len(arguments))
+	for _, argument := range arguments {
+		encodedArguments = append(encodedArguments, hex.EncodeToString(argument))
+	}
+
+	return encodedArguments
+}
+
+func valueToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (et *executionTracer) IsInterfaceNil() bool {
+	return et == nil
+}
@@ -0,0 +1,226 @@
+package tracing
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	tracerUser      = []byte("user___________________________1")
+	tracerContractA = []byte("contractA_______________________")
+	tracerContractB = []byte("contractB_______________________")
+	tracerContractC = []byte("contractC_______________________")
+)
+
+func createTracerForTests() *executionTracer {
+	addressConverter, _ := pubkeyConverter.NewHexPubkeyConverter(32)
+	tracer, _ := NewExecutionTracer(addressConverter)
+
+	return tracer
+}
+
+func createVMInput(caller []byte, gasProvided uint64, arguments ...[]byte) *vmcommon.VMInput {
+	return &vmcommon.VMInput{
+		CallerAddr:  caller,
+		Arguments:   arguments,
+		CallValue:   big.NewInt(0),
+		CallType:    vm.DirectCall,
+		GasProvided: gasProvided,
+	}
+}
+
+func TestNewExecutionTracer(t *testing.T) {
+	t.Parallel()
+
+	tracer, err := NewExecutionTracer(nil)
+	require.Nil(t, tracer)
+	require.Equal(t, ErrNilPubkeyConverter, err)
+
+	tracer = createTracerForTests()
+	require.False(t, tracer.IsInterfaceNil())
+}
+
+func TestExecutionTracer_ShouldNotRecordWhenNotStarted(t *testing.T) {
+	t.Parallel()
+
+	tracer := createTracerForTests()
+	tracer.EnterFrame(FrameTypeCall, tracerContractA, "add", createVMInput(tracerUser, 1000))
+	tracer.ExitFrame(&vmcommon.VMOutput{GasRemaining: 400}, nil)
+
+	tracer.StartTrace()
+	require.Empty(t, tracer.StopTrace())
+}
+
+func TestExecutionTracer_ShouldRecordTheFrames(t *testing.T) {
+	t.Parallel()
+
+	tracer := createTracerForTests()
+	tracer.StartTrace()
+
+	tracer.EnterFrame(FrameTypeCall, tracerContractA, "add", createVMInput(tracerUser, 1000, []byte{7}))
+	tracer.EnterFrame(FrameTypeBuiltInFunction, tracerContractA, "ESDTTransfer", createVMInput(tracerContractA, 300))
+	tracer.ExitFrame(&vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: 200,
+		Logs: []*vmcommon.LogEntry{
+			{Address: tracerContractA, Identifier: []byte("ESDTTransfer"), Topics: [][]byte{[]byte("TKN")}},
+		},
+	}, nil)
+	tracer.ExitFrame(&vmcommon.VMOutput{
+		ReturnCode:    vmcommon.UserError,
+		ReturnMessage: "not enough funds",
+		GasRemaining:  400,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(tracerContractB): {
+				Address: tracerContractB,
+				GasUsed: 150,
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{Value: big.NewInt(5), GasLimit: 50, Data: []byte("callBack@01"), CallType: vm.AsynchronousCallBack},
+				},
+			},
+		},
+		Logs: []*vmcommon.LogEntry{
+			{Address: tracerContractA, Identifier: []byte("ESDTTransfer"), Topics: [][]byte{[]byte("TKN")}},
+			{Address: tracerContractB, Identifier: []byte("event"), Data: []byte("data")},
+		},
+	}, nil)
+
+	frames := tracer.StopTrace()
+	require.Len(t, frames, 1)
+
+	rootFrame := frames[0]
+	require.Equal(t, FrameTypeCall, rootFrame.Type)
+	require.Equal(t, "directCall", rootFrame.CallType)
+	require.Equal(t, hex.EncodeToString(tracerUser), rootFrame.Caller)
+	require.Equal(t, hex.EncodeToString(tracerContractA), rootFrame.Callee)
+	require.Equal(t, "add", rootFrame.Function)
+	require.Equal(t, []string{"07"}, rootFrame.Arguments)
+	require.Equal(t, uint64(600), rootFrame.GasUsed)
+	require.Equal(t, vmcommon.UserError.String(), rootFrame.ReturnCode)
+	require.Equal(t, "not enough funds", rootFrame.ReturnMessage)
+	require.Empty(t, rootFrame.Logs)
+	require.Len(t, rootFrame.Calls, 2)
+
+	builtInFrame := rootFrame.Calls[0]
+	require.Equal(t, FrameTypeBuiltInFunction, builtInFrame.Type)
+	require.Equal(t, uint64(100), builtInFrame.GasUsed)
+	require.Len(t, builtInFrame.Logs, 1)
+	require.Equal(t, "ESDTTransfer", builtInFrame.Logs[0].Identifier)
+
+	contractFrame := rootFrame.Calls[1]
+	require.Equal(t, FrameTypeCall, contractFrame.Type)
+	require.Equal(t, hex.EncodeToString(tracerContractB), contractFrame.Callee)
+	require.Empty(t, contractFrame.Caller)
+	require.Equal(t, uint64(150), contractFrame.GasUsed)
+	require.Equal(t, vmcommon.Ok.String(), contractFrame.ReturnCode)
+	require.Len(t, contractFrame.Logs, 1)
+	require.Equal(t, hex.EncodeToString([]byte("data")), contractFrame.Logs[0].Data)
+
+	require.Len(t, rootFrame.OutputTransfers, 1)
+	outputTransfer := rootFrame.OutputTransfers[0]
+	require.Equal(t, "asynchronousCallBack", outputTransfer.CallType)
+	require.Equal(t, hex.EncodeToString(tracerContractA), outputTransfer.Sender)
+	require.Equal(t, hex.EncodeToString(tracerContractB), outputTransfer.Receiver)
+	require.Equal(t, "callBack", outputTransfer.Function)
+	require.Equal(t, []string{"01"}, outputTransfer.Arguments)
+	require.Equal(t, "5", outputTransfer.Value)
+	require.Equal(t, uint64(50), outputTransfer.GasLimit)
+}
+
+func TestExecutionTracer_ShouldNestTheContractsExecutedWithinTheVM(t *testing.T) {
+	t.Parallel()
+
+	tracer := createTracerForTests()
+	tracer.StartTrace()
+
+	tracer.EnterFrame(FrameTypeCall, tracerContractA, "swap", createVMInput(tracerUser, 1000))
+	// contract B, called by contract A within the VM, transfers tokens to contract C
+	tracer.EnterFrame(FrameTypeBuiltInFunction, tracerContractC, "ESDTTransfer", createVMInput(tracerContractB, 100))
+	tracer.ExitFrame(&vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: 50}, nil)
+	tracer.ExitFrame(&vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: 100,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(tracerContractA): {Address: tracerContractA, GasUsed: 300},
+			string(tracerContractB): {Address: tracerContractB, GasUsed: 200},
+			string(tracerContractC): {Address: tracerContractC, GasUsed: 100},
+			string(tracerUser):      {Address: tracerUser},
+		},
+		Logs: []*vmcommon.LogEntry{
+			{Address: tracerContractA, Identifier: []byte("swapped")},
+			{Address: tracerContractC, Identifier: []byte("received")},
+			{Address: tracerContractC, Identifier: []byte("received")},
+		},
+	}, nil)
+
+	frames := tracer.StopTrace()
+	require.Len(t, frames, 1)
+
+	rootFrame := frames[0]
+	require.Equal(t, uint64(900), rootFrame.GasUsed)
+	require.Len(t, rootFrame.Logs, 1)
+	require.Equal(t, "swapped", rootFrame.Logs[0].Identifier)
+	require.Len(t, rootFrame.Calls, 2)
+
+	frameB := rootFrame.Calls[0]
+	require.Equal(t, hex.EncodeToString(tracerContractB), frameB.Callee)
+	require.Equal(t, uint64(200), frameB.GasUsed)
+	require.Empty(t, frameB.Logs)
+	require.Len(t, frameB.Calls, 1)
+	require.Equal(t, FrameTypeBuiltInFunction, frameB.Calls[0].Type)
+	require.Equal(t, hex.EncodeToString(tracerContractB), frameB.Calls[0].Caller)
+	require.Equal(t, uint64(50), frameB.Calls[0].GasUsed)
+
+	frameC := rootFrame.Calls[1]
+	require.Equal(t, hex.EncodeToString(tracerContractC), frameC.Callee)
+	require.Equal(t, uint64(100), frameC.GasUsed)
+	require.Empty(t, frameC.Calls)
+	require.Len(t, frameC.Logs, 2)
+}
+
+func TestExecutionTracer_DeployShouldSetTheDeployedAddress(t *testing.T) {
+	t.Parallel()
+
+	tracer := createTracerForTests()
+	tracer.StartTrace()
+
+	tracer.EnterFrame(FrameTypeDeploy, nil, "", createVMInput(tracerUser, 1000))
+	tracer.ExitFrame(&vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: 100,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(tracerUser):      {Address: tracerUser, Nonce: 1},
+			string(tracerContractA): {Address: tracerContractA, Code: []byte("code")},
+		},
+	}, nil)
+
+	frames := tracer.StopTrace()
+	require.Len(t, frames, 1)
+	require.Equal(t, hex.EncodeToString(tracerContractA), frames[0].Callee)
+	require.Equal(t, uint64(900), frames[0].GasUsed)
+}
+
+func TestExecutionTracer_ExecutionErrorShouldConsumeAllGas(t *testing.T) {
+	t.Parallel()
+
+	tracer := createTracerForTests()
+	tracer.StartTrace()
+
+	tracer.EnterFrame(FrameTypeCall, tracerContractA, "add", createVMInput(tracerUser, 1000))
+	tracer.ExitFrame(nil, errors.New("execution error"))
+
+	frames := tracer.StopTrace()
+	require.Len(t, frames, 1)
+	require.Equal(t, uint64(1000), frames[0].GasUsed)
+	require.Equal(t, "execution error", frames[0].ReturnMessage)
+
+	tracer.StartTrace()
+	require.Empty(t, tracer.StopTrace())
+}
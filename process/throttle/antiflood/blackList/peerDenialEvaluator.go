@@ -9,17 +9,24 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
+// reasonUpsertedPeerID is recorded for the bans applied through UpsertPeerID, used by the network messenger when it
+// receives incompatible p2p messages
+const reasonUpsertedPeerID = "incompatible p2p message"
+
 type peerDenialEvaluator struct {
 	blackListIDsCache          process.PeerBlackListCacher
 	blackListedPublicKeysCache process.TimeCacher
 	peerShardMapper            process.PeerShardMapper
+	reputationHandler          process.PeerReputationHandler
 }
 
-// NewPeerDenialEvaluator will create a new instance of a peer deny cache evaluator
+// NewPeerDenialEvaluator will create a new instance of a peer deny cache evaluator. The reputation handler is also
+// consulted so the bans applied before a restart are still honored
 func NewPeerDenialEvaluator(
 	blackListIDsCache process.PeerBlackListCacher,
 	blackListedPublicKeysCache process.TimeCacher,
 	psm process.PeerShardMapper,
+	reputationHandler process.PeerReputationHandler,
 ) (*peerDenialEvaluator, error) {
 
 	if check.IfNil(blackListIDsCache) {
@@ -31,11 +38,15 @@ func NewPeerDenialEvaluator(
 	if check.IfNil(psm) {
 		return nil, process.ErrNilPeerShardMapper
 	}
+	if check.IfNil(reputationHandler) {
+		return nil, process.ErrNilPeerReputationHandler
+	}
 
 	return &peerDenialEvaluator{
 		blackListIDsCache:          blackListIDsCache,
 		blackListedPublicKeysCache: blackListedPublicKeysCache,
 		peerShardMapper:            psm,
+		reputationHandler:          reputationHandler,
 	}, nil
 }
 
@@ -45,6 +56,9 @@ func (pde *peerDenialEvaluator) IsDenied(pid core.PeerID) bool {
 	if pde.blackListIDsCache.Has(pid) {
 		return true
 	}
+	if pde.reputationHandler.IsPeerIDDenied(pid) {
+		return true
+	}
 
 	peerInfo := pde.peerShardMapper.GetPeerInfo(pid)
 	pkBytes := peerInfo.PkBytes
@@ -52,12 +66,23 @@ func (pde *peerDenialEvaluator) IsDenied(pid core.PeerID) bool {
 		return false //no need to further search in the next cache, this is an unknown peer
 	}
 
-	return pde.blackListedPublicKeysCache.Has(string(pkBytes))
+	if pde.blackListedPublicKeysCache.Has(string(pkBytes)) {
+		return true
+	}
+
+	return pde.reputationHandler.IsPublicKeyDenied(pkBytes)
 }
 
 // UpsertPeerID will update or insert the provided peer id in the corresponding time cache
 func (pde *peerDenialEvaluator) UpsertPeerID(pid core.PeerID, duration time.Duration) error {
-	return pde.blackListIDsCache.Upsert(pid, duration)
+	err := pde.blackListIDsCache.Upsert(pid, duration)
+	if err != nil {
+		return err
+	}
+
+	pde.reputationHandler.RecordPeerIDBan(pid, duration, reasonUpsertedPeerID)
+
+	return nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
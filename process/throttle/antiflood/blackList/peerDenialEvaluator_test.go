@@ -19,6 +19,7 @@ func TestNewPeerDenialEvaluator_NilPeerTimeCacherShouldErr(t *testing.T) {
 		nil,
 		&mock.TimeCacheStub{},
 		&mock.PeerShardMapperStub{},
+		&mock.PeerReputationHandlerStub{},
 	)
 
 	assert.True(t, errors.Is(err, process.ErrNilBlackListCacher))
@@ -32,6 +33,7 @@ func TestNewPeerDenialEvaluator_NilTimeCacherShouldErr(t *testing.T) {
 		&mock.PeerBlackListHandlerStub{},
 		nil,
 		&mock.PeerShardMapperStub{},
+		&mock.PeerReputationHandlerStub{},
 	)
 
 	assert.True(t, errors.Is(err, process.ErrNilBlackListCacher))
@@ -45,12 +47,27 @@ func TestNewPeerDenialEvaluator_NilPeerShardMapperShouldErr(t *testing.T) {
 		&mock.PeerBlackListHandlerStub{},
 		&mock.TimeCacheStub{},
 		nil,
+		&mock.PeerReputationHandlerStub{},
 	)
 
 	assert.True(t, errors.Is(err, process.ErrNilPeerShardMapper))
 	assert.True(t, check.IfNil(pdc))
 }
 
+func TestNewPeerDenialEvaluator_NilReputationHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pdc, err := NewPeerDenialEvaluator(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TimeCacheStub{},
+		&mock.PeerShardMapperStub{},
+		nil,
+	)
+
+	assert.Equal(t, process.ErrNilPeerReputationHandler, err)
+	assert.True(t, check.IfNil(pdc))
+}
+
 func TestNewPeerDenialEvaluator_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -58,6 +75,7 @@ func TestNewPeerDenialEvaluator_ShouldWork(t *testing.T) {
 		&mock.PeerBlackListHandlerStub{},
 		&mock.TimeCacheStub{},
 		&mock.PeerShardMapperStub{},
+		&mock.PeerReputationHandlerStub{},
 	)
 
 	assert.Nil(t, err)
@@ -85,6 +103,7 @@ func TestPeerDenialEvaluator_IsDeniedShouldWorkIfFoundInPids(t *testing.T) {
 				return core.P2PPeerInfo{}
 			},
 		},
+		&mock.PeerReputationHandlerStub{},
 	)
 
 	assert.True(t, pdc.IsDenied(""))
@@ -110,6 +129,7 @@ func TestPeerDenialEvaluator_IsDeniedShouldWorkIfNotFoundInPidsNorInPeerShardMap
 				return core.P2PPeerInfo{}
 			},
 		},
+		&mock.PeerReputationHandlerStub{},
 	)
 
 	assert.False(t, pdc.IsDenied(""))
@@ -136,11 +156,61 @@ func TestPeerDenialEvaluator_IsDeniedShouldWorkIfFoundInPk(t *testing.T) {
 				}
 			},
 		},
+		&mock.PeerReputationHandlerStub{},
 	)
 
 	assert.True(t, pdc.IsDenied(""))
 }
 
+func TestPeerDenialEvaluator_IsDeniedShouldWorkIfPidDeniedByReputation(t *testing.T) {
+	t.Parallel()
+
+	pdc, _ := NewPeerDenialEvaluator(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TimeCacheStub{},
+		&mock.PeerShardMapperStub{
+			GetPeerInfoCalled: func(pid core.PeerID) core.P2PPeerInfo {
+				assert.Fail(t, "should have not reached this point")
+				return core.P2PPeerInfo{}
+			},
+		},
+		&mock.PeerReputationHandlerStub{
+			IsPeerIDDeniedCalled: func(pid core.PeerID) bool {
+				return pid == "pid"
+			},
+		},
+	)
+
+	assert.True(t, pdc.IsDenied("pid"))
+}
+
+func TestPeerDenialEvaluator_IsDeniedShouldWorkIfPkDeniedByReputation(t *testing.T) {
+	t.Parallel()
+
+	pdc, _ := NewPeerDenialEvaluator(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TimeCacheStub{
+			HasCalled: func(key string) bool {
+				return false
+			},
+		},
+		&mock.PeerShardMapperStub{
+			GetPeerInfoCalled: func(pid core.PeerID) core.P2PPeerInfo {
+				return core.P2PPeerInfo{
+					PkBytes: []byte("pk"),
+				}
+			},
+		},
+		&mock.PeerReputationHandlerStub{
+			IsPublicKeyDeniedCalled: func(pk []byte) bool {
+				return string(pk) == "pk"
+			},
+		},
+	)
+
+	assert.True(t, pdc.IsDenied("pid"))
+}
+
 func TestPeerDenialEvaluator_UpsertPeerID(t *testing.T) {
 	t.Parallel()
 
@@ -154,9 +224,55 @@ func TestPeerDenialEvaluator_UpsertPeerID(t *testing.T) {
 		},
 		&mock.TimeCacheStub{},
 		&mock.PeerShardMapperStub{},
+		&mock.PeerReputationHandlerStub{},
 	)
 
 	err := pdc.UpsertPeerID("", time.Second)
 	assert.Nil(t, err)
 	assert.True(t, upsertCalled)
 }
+
+func TestPeerDenialEvaluator_UpsertPeerIDShouldRecordTheBan(t *testing.T) {
+	t.Parallel()
+
+	recordedReason := ""
+	pdc, _ := NewPeerDenialEvaluator(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TimeCacheStub{},
+		&mock.PeerShardMapperStub{},
+		&mock.PeerReputationHandlerStub{
+			RecordPeerIDBanCalled: func(pid core.PeerID, duration time.Duration, reason string) {
+				assert.Equal(t, core.PeerID("pid"), pid)
+				assert.Equal(t, time.Second, duration)
+				recordedReason = reason
+			},
+		},
+	)
+
+	err := pdc.UpsertPeerID("pid", time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, reasonUpsertedPeerID, recordedReason)
+}
+
+func TestPeerDenialEvaluator_UpsertPeerIDErrorShouldNotRecordTheBan(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	pdc, _ := NewPeerDenialEvaluator(
+		&mock.PeerBlackListHandlerStub{
+			UpsertCalled: func(pid core.PeerID, span time.Duration) error {
+				return expectedErr
+			},
+		},
+		&mock.TimeCacheStub{},
+		&mock.PeerShardMapperStub{},
+		&mock.PeerReputationHandlerStub{
+			RecordPeerIDBanCalled: func(pid core.PeerID, duration time.Duration, reason string) {
+				assert.Fail(t, "should have not recorded the ban")
+			},
+		},
+	)
+
+	err := pdc.UpsertPeerID("pid", time.Second)
+	assert.Equal(t, expectedErr, err)
+}
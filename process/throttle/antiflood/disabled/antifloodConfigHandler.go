@@ -0,0 +1,31 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.AntifloodConfigHandler = (*AntifloodConfigHandler)(nil)
+
+// AntifloodConfigHandler is the antiflood config handler used when the antiflood mechanism is disabled
+type AntifloodConfigHandler struct {
+}
+
+// CheckAntifloodConfig returns an error if the provided config tries to enable the antiflood mechanism
+func (handler *AntifloodConfigHandler) CheckAntifloodConfig(cfg config.AntifloodConfig) error {
+	if cfg.Enabled {
+		return process.ErrAntifloodEnabledFlagChanged
+	}
+
+	return nil
+}
+
+// ApplyAntifloodConfig does nothing as there are no antiflood components to update
+func (handler *AntifloodConfigHandler) ApplyAntifloodConfig(cfg config.AntifloodConfig) error {
+	return handler.CheckAntifloodConfig(cfg)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *AntifloodConfigHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
@@ -0,0 +1,24 @@
+package disabled
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAntifloodConfigHandler_ShouldRejectEnablingTheAntiflood(t *testing.T) {
+	t.Parallel()
+
+	handler := &AntifloodConfigHandler{}
+	assert.False(t, check.IfNil(handler))
+
+	assert.Nil(t, handler.CheckAntifloodConfig(config.AntifloodConfig{}))
+	assert.Nil(t, handler.ApplyAntifloodConfig(config.AntifloodConfig{}))
+
+	enabledConfig := config.AntifloodConfig{Enabled: true}
+	assert.Equal(t, process.ErrAntifloodEnabledFlagChanged, handler.CheckAntifloodConfig(enabledConfig))
+	assert.Equal(t, process.ErrAntifloodEnabledFlagChanged, handler.ApplyAntifloodConfig(enabledConfig))
+}
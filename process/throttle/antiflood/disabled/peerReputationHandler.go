@@ -0,0 +1,48 @@
+package disabled
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.PeerReputationHandler = (*PeerReputationHandler)(nil)
+
+// PeerReputationHandler is a disabled implementation of PeerReputationHandler
+type PeerReputationHandler struct {
+}
+
+// RecordPeerIDBan does nothing
+func (handler *PeerReputationHandler) RecordPeerIDBan(_ core.PeerID, _ time.Duration, _ string) {
+}
+
+// RecordPublicKeyBan does nothing
+func (handler *PeerReputationHandler) RecordPublicKeyBan(_ []byte, _ time.Duration, _ string) {
+}
+
+// IsPeerIDDenied outputs false (all peer IDs are allowed)
+func (handler *PeerReputationHandler) IsPeerIDDenied(_ core.PeerID) bool {
+	return false
+}
+
+// IsPublicKeyDenied outputs false (all public keys are allowed)
+func (handler *PeerReputationHandler) IsPublicKeyDenied(_ []byte) bool {
+	return false
+}
+
+// GetPeersReputation returns an empty slice
+func (handler *PeerReputationHandler) GetPeersReputation() []*common.PeerReputation {
+	return make([]*common.PeerReputation, 0)
+}
+
+// Close does nothing
+func (handler *PeerReputationHandler) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *PeerReputationHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
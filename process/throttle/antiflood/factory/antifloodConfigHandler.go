@@ -0,0 +1,139 @@
+package factory
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/floodPreventers"
+)
+
+type quotaParametersSetter interface {
+	SetQuotaParameters(params floodPreventers.QuotaParameters) error
+}
+
+type topicsMaxMessagesSetter interface {
+	SetTopicsMaxMessages(defaultMaxMessagesPerPeer uint32, topicsMaxMessages map[string]uint32) error
+}
+
+var _ process.AntifloodConfigHandler = (*antifloodConfigHandler)(nil)
+
+// antifloodConfigHandler applies the limits of a new antiflood configuration on the running flood preventers
+type antifloodConfigHandler struct {
+	mutConfig           sync.RWMutex
+	quotaPreventers     map[string]quotaParametersSetter
+	topicFloodPreventer topicsMaxMessagesSetter
+	topicMaxMessages    []config.TopicMaxMessagesConfig
+}
+
+func newAntifloodConfigHandler(
+	quotaPreventers map[string]quotaParametersSetter,
+	topicFloodPreventer topicsMaxMessagesSetter,
+	topicMaxMessages []config.TopicMaxMessagesConfig,
+) *antifloodConfigHandler {
+	return &antifloodConfigHandler{
+		quotaPreventers:     quotaPreventers,
+		topicFloodPreventer: topicFloodPreventer,
+		topicMaxMessages:    copyTopicMaxMessages(topicMaxMessages),
+	}
+}
+
+// CheckAntifloodConfig returns an error if the limits defined in the provided config can not be applied
+func (handler *antifloodConfigHandler) CheckAntifloodConfig(cfg config.AntifloodConfig) error {
+	if !cfg.Enabled {
+		return process.ErrAntifloodEnabledFlagChanged
+	}
+
+	for identifier, params := range quotaParametersFromConfig(cfg) {
+		err := floodPreventers.CheckQuotaParameters(params)
+		if err != nil {
+			return fmt.Errorf("%w for the %s flood preventer", err, identifier)
+		}
+	}
+
+	err := floodPreventers.CheckTopicMaxMessagesPerPeer(cfg.Topic.DefaultMaxMessagesPerSec)
+	if err != nil {
+		return fmt.Errorf("%w for the topic flood preventer", err)
+	}
+
+	return nil
+}
+
+// ApplyAntifloodConfig checks the provided config and, if valid, applies the new limits on all flood preventers.
+// The interval, black list and cache settings are only read at start-up
+func (handler *antifloodConfigHandler) ApplyAntifloodConfig(cfg config.AntifloodConfig) error {
+	err := handler.CheckAntifloodConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	handler.mutConfig.Lock()
+	defer handler.mutConfig.Unlock()
+
+	for identifier, params := range quotaParametersFromConfig(cfg) {
+		preventer, found := handler.quotaPreventers[identifier]
+		if !found {
+			continue
+		}
+
+		err = preventer.SetQuotaParameters(params)
+		if err != nil {
+			return fmt.Errorf("%w for the %s flood preventer", err, identifier)
+		}
+	}
+
+	topicsMaxMessages := make(map[string]uint32, len(cfg.Topic.MaxMessages))
+	for _, topicMaxMsg := range cfg.Topic.MaxMessages {
+		topicsMaxMessages[topicMaxMsg.Topic] = topicMaxMsg.NumMessagesPerSec
+	}
+	err = handler.topicFloodPreventer.SetTopicsMaxMessages(cfg.Topic.DefaultMaxMessagesPerSec, topicsMaxMessages)
+	if err != nil {
+		return fmt.Errorf("%w for the topic flood preventer", err)
+	}
+	handler.topicMaxMessages = copyTopicMaxMessages(cfg.Topic.MaxMessages)
+
+	log.Info("applied new antiflood configuration",
+		"num topics", len(cfg.Topic.MaxMessages),
+		"default max messages per topic", cfg.Topic.DefaultMaxMessagesPerSec,
+	)
+
+	return nil
+}
+
+func (handler *antifloodConfigHandler) getTopicMaxMessages() []config.TopicMaxMessagesConfig {
+	handler.mutConfig.RLock()
+	defer handler.mutConfig.RUnlock()
+
+	return copyTopicMaxMessages(handler.topicMaxMessages)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *antifloodConfigHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
+
+func quotaParametersFromConfig(cfg config.AntifloodConfig) map[string]floodPreventers.QuotaParameters {
+	return map[string]floodPreventers.QuotaParameters{
+		fastReactingIdentifier: quotaParametersFromFloodPreventerConfig(cfg.FastReacting),
+		slowReactingIdentifier: quotaParametersFromFloodPreventerConfig(cfg.SlowReacting),
+		outOfSpecsIdentifier:   quotaParametersFromFloodPreventerConfig(cfg.OutOfSpecs),
+	}
+}
+
+func quotaParametersFromFloodPreventerConfig(cfg config.FloodPreventerConfig) floodPreventers.QuotaParameters {
+	return floodPreventers.QuotaParameters{
+		MaxTotalSizePerPeer:       cfg.PeerMaxInput.TotalSizePerInterval,
+		PercentReserved:           cfg.ReservedPercent,
+		IncreaseFactor:            cfg.PeerMaxInput.IncreaseFactor.Factor,
+		IncreaseThreshold:         cfg.PeerMaxInput.IncreaseFactor.Threshold,
+		BaseMaxNumMessagesPerPeer: cfg.PeerMaxInput.BaseMessagesPerInterval,
+	}
+}
+
+func copyTopicMaxMessages(topicMaxMessages []config.TopicMaxMessagesConfig) []config.TopicMaxMessagesConfig {
+	copied := make([]config.TopicMaxMessagesConfig, len(topicMaxMessages))
+	copy(copied, topicMaxMessages)
+
+	return copied
+}
@@ -0,0 +1,124 @@
+package factory
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/floodPreventers"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createAntifloodConfig() config.AntifloodConfig {
+	return config.AntifloodConfig{
+		Enabled:      true,
+		FastReacting: createFloodPreventerConfig(),
+		SlowReacting: createFloodPreventerConfig(),
+		OutOfSpecs:   createFloodPreventerConfig(),
+		Topic: config.TopicAntifloodConfig{
+			DefaultMaxMessagesPerSec: 10,
+			MaxMessages: []config.TopicMaxMessagesConfig{
+				{Topic: "headers*", NumMessagesPerSec: 20},
+			},
+		},
+	}
+}
+
+func createQuotaFloodPreventer(t *testing.T, name string) quotaFloodPreventer {
+	qfp, err := floodPreventers.NewQuotaFloodPreventer(floodPreventers.ArgQuotaFloodPreventer{
+		Name:                      name,
+		Cacher:                    testscommon.NewCacherMock(),
+		StatusHandlers:            make([]floodPreventers.QuotaStatusHandler, 0),
+		MaxTotalSizePerPeer:       1000,
+		BaseMaxNumMessagesPerPeer: 10,
+	})
+	require.Nil(t, err)
+
+	return qfp
+}
+
+func createAntifloodConfigHandler(t *testing.T) (*antifloodConfigHandler, quotaFloodPreventer, process.TopicFloodPreventer) {
+	fastReacting := createQuotaFloodPreventer(t, fastReactingIdentifier)
+	topicFloodPreventer, err := floodPreventers.NewTopicFloodPreventer(10)
+	require.Nil(t, err)
+
+	handler := newAntifloodConfigHandler(
+		map[string]quotaParametersSetter{
+			fastReactingIdentifier: fastReacting,
+			slowReactingIdentifier: createQuotaFloodPreventer(t, slowReactingIdentifier),
+			outOfSpecsIdentifier:   createQuotaFloodPreventer(t, outOfSpecsIdentifier),
+		},
+		topicFloodPreventer,
+		nil,
+	)
+
+	return handler, fastReacting, topicFloodPreventer
+}
+
+func TestAntifloodConfigHandler_CheckAntifloodConfig(t *testing.T) {
+	t.Parallel()
+
+	handler, _, _ := createAntifloodConfigHandler(t)
+	assert.False(t, check.IfNil(handler))
+	assert.Nil(t, handler.CheckAntifloodConfig(createAntifloodConfig()))
+
+	cfg := createAntifloodConfig()
+	cfg.Enabled = false
+	assert.Equal(t, process.ErrAntifloodEnabledFlagChanged, handler.CheckAntifloodConfig(cfg))
+
+	cfg = createAntifloodConfig()
+	cfg.SlowReacting.PeerMaxInput.BaseMessagesPerInterval = 0
+	assert.True(t, errors.Is(handler.CheckAntifloodConfig(cfg), process.ErrInvalidValue))
+
+	cfg = createAntifloodConfig()
+	cfg.OutOfSpecs.ReservedPercent = 95
+	assert.True(t, errors.Is(handler.CheckAntifloodConfig(cfg), process.ErrInvalidValue))
+
+	cfg = createAntifloodConfig()
+	cfg.Topic.DefaultMaxMessagesPerSec = 0
+	assert.True(t, errors.Is(handler.CheckAntifloodConfig(cfg), process.ErrInvalidValue))
+}
+
+func TestAntifloodConfigHandler_ApplyAntifloodConfigInvalidConfigShouldNotApplyAnything(t *testing.T) {
+	t.Parallel()
+
+	handler, fastReacting, topicFloodPreventer := createAntifloodConfigHandler(t)
+
+	cfg := createAntifloodConfig()
+	cfg.FastReacting.PeerMaxInput.BaseMessagesPerInterval = 1
+	cfg.OutOfSpecs.PeerMaxInput.TotalSizePerInterval = 0
+	err := handler.ApplyAntifloodConfig(cfg)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	pid := core.PeerID("pid")
+	for i := 0; i < 10; i++ {
+		assert.Nil(t, fastReacting.IncreaseLoad(pid, 1))
+	}
+	assert.Nil(t, topicFloodPreventer.IncreaseLoad(pid, "headers_0", 10))
+	assert.Equal(t, 0, len(handler.getTopicMaxMessages()))
+}
+
+func TestAntifloodConfigHandler_ApplyAntifloodConfigShouldWork(t *testing.T) {
+	t.Parallel()
+
+	handler, fastReacting, topicFloodPreventer := createAntifloodConfigHandler(t)
+
+	cfg := createAntifloodConfig()
+	cfg.FastReacting.PeerMaxInput.BaseMessagesPerInterval = 2
+	err := handler.ApplyAntifloodConfig(cfg)
+	assert.Nil(t, err)
+
+	pid := core.PeerID("pid")
+	assert.Nil(t, fastReacting.IncreaseLoad(pid, 1))
+	assert.Nil(t, fastReacting.IncreaseLoad(pid, 1))
+	assert.True(t, errors.Is(fastReacting.IncreaseLoad(pid, 1), process.ErrSystemBusy))
+
+	assert.Nil(t, topicFloodPreventer.IncreaseLoad(pid, "headers_0", 20))
+	assert.Equal(t, process.ErrSystemBusy, topicFloodPreventer.IncreaseLoad(pid, "headers_0", 1))
+	assert.Equal(t, cfg.Topic.MaxMessages, handler.getTopicMaxMessages())
+}
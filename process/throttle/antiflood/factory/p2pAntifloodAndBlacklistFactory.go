@@ -8,9 +8,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/rating/peerReputation"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
@@ -28,6 +30,7 @@ const fastReactingIdentifier = "fast_reacting"
 const slowReactingIdentifier = "slow_reacting"
 const outOfSpecsIdentifier = "out_of_specs"
 const outputIdentifier = "output"
+const reasonLowHonestyScore = "low peer honesty score"
 
 var durationSweepP2PBlacklist = time.Second * 5
 
@@ -38,15 +41,35 @@ type AntiFloodComponents struct {
 	FloodPreventers  []process.FloodPreventer
 	TopicPreventer   process.TopicFloodPreventer
 	PubKeysCacher    process.TimeCacher
+	ConfigHandler    process.AntifloodConfigHandler
 }
 
-// NewP2PAntiFloodComponents will return instances of antiflood and blacklist, based on the config
-func NewP2PAntiFloodComponents(ctx context.Context, config config.Config, statusHandler core.AppStatusHandler, currentPid core.PeerID) (*AntiFloodComponents, error) {
+type quotaFloodPreventer interface {
+	process.FloodPreventer
+	quotaParametersSetter
+}
+
+// NewP2PAntiFloodComponents will return instances of antiflood and blacklist, based on the config. The bans applied
+// by the created components are recorded in the provided reputation handler
+func NewP2PAntiFloodComponents(
+	ctx context.Context,
+	config config.Config,
+	statusHandler core.AppStatusHandler,
+	metricsHandler common.MetricsHandler,
+	currentPid core.PeerID,
+	reputationHandler process.PeerReputationHandler,
+) (*AntiFloodComponents, error) {
 	if check.IfNil(statusHandler) {
 		return nil, p2p.ErrNilStatusHandler
 	}
+	if check.IfNil(metricsHandler) {
+		return nil, process.ErrNilMetricsHandler
+	}
+	if check.IfNil(reputationHandler) {
+		return nil, process.ErrNilPeerReputationHandler
+	}
 	if config.Antiflood.Enabled {
-		return initP2PAntiFloodComponents(ctx, config, statusHandler, currentPid)
+		return initP2PAntiFloodComponents(ctx, config, statusHandler, metricsHandler, currentPid, reputationHandler)
 	}
 
 	return &AntiFloodComponents{
@@ -55,6 +78,7 @@ func NewP2PAntiFloodComponents(ctx context.Context, config config.Config, status
 		FloodPreventers:  make([]process.FloodPreventer, 0),
 		TopicPreventer:   disabled.NewNilTopicFloodPreventer(),
 		PubKeysCacher:    &disabled.TimeCache{},
+		ConfigHandler:    &disabled.AntifloodConfigHandler{},
 	}, nil
 }
 
@@ -62,7 +86,9 @@ func initP2PAntiFloodComponents(
 	ctx context.Context,
 	mainConfig config.Config,
 	statusHandler core.AppStatusHandler,
+	metricsHandler common.MetricsHandler,
 	currentPid core.PeerID,
+	reputationHandler process.PeerReputationHandler,
 ) (*AntiFloodComponents, error) {
 	cache := timecache.NewTimeCache(defaultSpan)
 	p2pPeerBlackList, err := timecache.NewPeerTimeCache(cache)
@@ -70,7 +96,14 @@ func initP2PAntiFloodComponents(
 		return nil, err
 	}
 
-	publicKeysCache := timecache.NewTimeCache(defaultSpan)
+	publicKeysCache, err := peerReputation.NewPublicKeysTimeCacherRecorder(
+		timecache.NewTimeCache(defaultSpan),
+		reputationHandler,
+		reasonLowHonestyScore,
+	)
+	if err != nil {
+		return nil, err
+	}
 
 	fastReactingFloodPreventer, err := createFloodPreventer(
 		ctx,
@@ -80,6 +113,7 @@ func initP2PAntiFloodComponents(
 		fastReactingIdentifier,
 		p2pPeerBlackList,
 		currentPid,
+		reputationHandler,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("%w when creating fast reacting flood preventer", err)
@@ -93,6 +127,7 @@ func initP2PAntiFloodComponents(
 		slowReactingIdentifier,
 		p2pPeerBlackList,
 		currentPid,
+		reputationHandler,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("%w when creating fast reacting flood preventer", err)
@@ -106,6 +141,7 @@ func initP2PAntiFloodComponents(
 		outOfSpecsIdentifier,
 		p2pPeerBlackList,
 		currentPid,
+		reputationHandler,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("%w when creating out of specs flood preventer", err)
@@ -116,8 +152,19 @@ func initP2PAntiFloodComponents(
 		return nil, err
 	}
 
-	topicMaxMessages := mainConfig.Antiflood.Topic.MaxMessages
-	setMaxMessages(topicFloodPreventer, topicMaxMessages)
+	configHandler := newAntifloodConfigHandler(
+		map[string]quotaParametersSetter{
+			fastReactingIdentifier: fastReactingFloodPreventer,
+			slowReactingIdentifier: slowReactingFloodPreventer,
+			outOfSpecsIdentifier:   outOfSpecsFloodPreventer,
+		},
+		topicFloodPreventer,
+		mainConfig.Antiflood.Topic.MaxMessages,
+	)
+	err = configHandler.ApplyAntifloodConfig(mainConfig.Antiflood)
+	if err != nil {
+		return nil, err
+	}
 
 	p2pAntiflood, err := antiflood.NewP2PAntiflood(
 		p2pPeerBlackList,
@@ -130,7 +177,17 @@ func initP2PAntiFloodComponents(
 		return nil, err
 	}
 
-	startResettingTopicFloodPreventer(ctx, topicFloodPreventer, topicMaxMessages)
+	err = p2pAntiflood.SetPeerReputationHandler(reputationHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p2pAntiflood.SetMetricsHandler(metricsHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	startResettingTopicFloodPreventer(ctx, topicFloodPreventer, configHandler.getTopicMaxMessages)
 	startSweepingTimeCaches(ctx, p2pPeerBlackList, publicKeysCache)
 
 	return &AntiFloodComponents{
@@ -143,24 +200,16 @@ func initP2PAntiFloodComponents(
 			outOfSpecsFloodPreventer,
 		},
 		TopicPreventer: topicFloodPreventer,
+		ConfigHandler:  configHandler,
 	}, nil
 }
 
-func setMaxMessages(topicFloodPreventer process.TopicFloodPreventer, topicMaxMessages []config.TopicMaxMessagesConfig) {
-	for _, topicMaxMsg := range topicMaxMessages {
-		topicFloodPreventer.SetMaxMessagesForTopic(topicMaxMsg.Topic, topicMaxMsg.NumMessagesPerSec)
-	}
-}
-
 func startResettingTopicFloodPreventer(
 	ctx context.Context,
 	topicFloodPreventer process.TopicFloodPreventer,
-	topicMaxMessages []config.TopicMaxMessagesConfig,
+	getTopicMaxMessages func() []config.TopicMaxMessagesConfig,
 	floodPreventers ...process.FloodPreventer,
 ) {
-	localTopicMaxMessages := make([]config.TopicMaxMessagesConfig, len(topicMaxMessages))
-	copy(localTopicMaxMessages, topicMaxMessages)
-
 	go func() {
 		for {
 			select {
@@ -173,7 +222,7 @@ func startResettingTopicFloodPreventer(
 			for _, fp := range floodPreventers {
 				fp.Reset()
 			}
-			for _, topicMaxMsg := range localTopicMaxMessages {
+			for _, topicMaxMsg := range getTopicMaxMessages() {
 				topicFloodPreventer.ResetForTopic(topicMaxMsg.Topic)
 			}
 			topicFloodPreventer.ResetForNotRegisteredTopics()
@@ -205,16 +254,26 @@ func createFloodPreventer(
 	quotaIdentifier string,
 	blackListHandler process.PeerBlackListCacher,
 	selfPid core.PeerID,
-) (process.FloodPreventer, error) {
+	reputationHandler process.PeerReputationHandler,
+) (quotaFloodPreventer, error) {
 	cacheConfig := storageFactory.GetCacherFromConfig(antifloodCacheConfig)
 	blackListCache, err := storageUnit.NewCache(cacheConfig)
 	if err != nil {
 		return nil, err
 	}
 
+	blackListRecorder, err := peerReputation.NewPeerBlackListCacherRecorder(
+		blackListHandler,
+		reputationHandler,
+		fmt.Sprintf("flooding detected by the %s flood preventer", quotaIdentifier),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	blackListProcessor, err := blackList.NewP2PBlackListProcessor(
 		blackListCache,
-		blackListHandler,
+		blackListRecorder,
 		floodPreventerConfig.BlackList.ThresholdNumMessagesPerInterval,
 		floodPreventerConfig.BlackList.ThresholdSizePerInterval,
 		floodPreventerConfig.BlackList.NumFloodingRounds,
@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
@@ -20,11 +21,33 @@ func TestNewP2PAntiFloodAndBlackList_NilStatusHandlerShouldErr(t *testing.T) {
 
 	ctx := context.Background()
 	cfg := config.Config{}
-	components, err := NewP2PAntiFloodComponents(ctx, cfg, nil, currentPid)
+	components, err := NewP2PAntiFloodComponents(ctx, cfg, nil, &statusHandler.MetricsHandlerStub{}, currentPid, &disabled.PeerReputationHandler{})
 	assert.Nil(t, components)
 	assert.Equal(t, p2p.ErrNilStatusHandler, err)
 }
 
+func TestNewP2PAntiFloodAndBlackList_NilMetricsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cfg := config.Config{}
+	ash := statusHandler.NewAppStatusHandlerMock()
+	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, nil, currentPid, &disabled.PeerReputationHandler{})
+	assert.Nil(t, components)
+	assert.Equal(t, process.ErrNilMetricsHandler, err)
+}
+
+func TestNewP2PAntiFloodAndBlackList_NilReputationHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cfg := config.Config{}
+	ash := statusHandler.NewAppStatusHandlerMock()
+	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, &statusHandler.MetricsHandlerStub{}, currentPid, nil)
+	assert.Nil(t, components)
+	assert.Equal(t, process.ErrNilPeerReputationHandler, err)
+}
+
 func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnDisabledImplementations(t *testing.T) {
 	t.Parallel()
 
@@ -35,16 +58,18 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnDisabledImplementations(
 	}
 	ash := statusHandler.NewAppStatusHandlerMock()
 	ctx := context.Background()
-	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, currentPid)
+	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, &statusHandler.MetricsHandlerStub{}, currentPid, &disabled.PeerReputationHandler{})
 	assert.NotNil(t, components)
 	assert.Nil(t, err)
 
 	_, ok1 := components.AntiFloodHandler.(*disabled.AntiFlood)
 	_, ok2 := components.BlacklistHandler.(*disabled.PeerBlacklistCacher)
 	_, ok3 := components.PubKeysCacher.(*disabled.TimeCache)
+	_, ok4 := components.ConfigHandler.(*disabled.AntifloodConfigHandler)
 	assert.True(t, ok1)
 	assert.True(t, ok2)
 	assert.True(t, ok3)
+	assert.True(t, ok4)
 }
 
 func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnOkImplementations(t *testing.T) {
@@ -69,11 +94,12 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnOkImplementations(t *tes
 
 	ash := statusHandler.NewAppStatusHandlerMock()
 	ctx := context.Background()
-	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, currentPid)
+	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, &statusHandler.MetricsHandlerStub{}, currentPid, &disabled.PeerReputationHandler{})
 	assert.Nil(t, err)
 	assert.NotNil(t, components.AntiFloodHandler)
 	assert.NotNil(t, components.BlacklistHandler)
 	assert.NotNil(t, components.PubKeysCacher)
+	assert.NotNil(t, components.ConfigHandler)
 
 	// we need this time sleep as to allow the code coverage tool to deterministically compute the code coverage
 	//on the go routines that are automatically launched
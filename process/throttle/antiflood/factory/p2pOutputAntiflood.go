@@ -23,6 +23,10 @@ func NewP2POutputAntiFlood(ctx context.Context, mainConfig config.Config) (proce
 	return &disabled.AntiFlood{}, nil
 }
 
+func noTopicMaxMessages() []config.TopicMaxMessagesConfig {
+	return make([]config.TopicMaxMessagesConfig, 0)
+}
+
 func initP2POutputAntiFlood(ctx context.Context, mainConfig config.Config) (process.P2PAntifloodHandler, error) {
 	cacheConfig := storageFactory.GetCacherFromConfig(mainConfig.Antiflood.Cache)
 	antifloodCache, err := storageUnit.NewCache(cacheConfig)
@@ -49,7 +53,7 @@ func initP2POutputAntiFlood(ctx context.Context, mainConfig config.Config) (proc
 	}
 
 	topicFloodPreventer := disabled.NewNilTopicFloodPreventer()
-	startResettingTopicFloodPreventer(ctx, topicFloodPreventer, make([]config.TopicMaxMessagesConfig, 0), floodPreventer)
+	startResettingTopicFloodPreventer(ctx, topicFloodPreventer, noTopicMaxMessages, floodPreventer)
 
 	return antiflood.NewP2PAntiflood(&disabled.PeerBlacklistCacher{}, topicFloodPreventer, floodPreventer)
 }
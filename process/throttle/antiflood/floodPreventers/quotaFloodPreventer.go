@@ -43,6 +43,15 @@ func (q *quota) Size() int {
 	return quotaStructSize
 }
 
+// QuotaParameters holds the limits of a quota flood preventer that can be changed at runtime
+type QuotaParameters struct {
+	MaxTotalSizePerPeer       uint64
+	PercentReserved           float32
+	IncreaseFactor            float32
+	IncreaseThreshold         uint32
+	BaseMaxNumMessagesPerPeer uint32
+}
+
 // quotaFloodPreventer represents a cache of quotas per peer used in antiflooding mechanism
 type quotaFloodPreventer struct {
 	name                          string
@@ -55,6 +64,7 @@ type quotaFloodPreventer struct {
 	percentReserved               float32
 	increaseThreshold             uint32
 	increaseFactor                float32
+	consensusSize                 int
 }
 
 // NewQuotaFloodPreventer creates a new flood preventer based on quota / peer
@@ -68,52 +78,68 @@ func NewQuotaFloodPreventer(arg ArgQuotaFloodPreventer) (*quotaFloodPreventer, e
 			return nil, process.ErrNilQuotaStatusHandler
 		}
 	}
-	if arg.BaseMaxNumMessagesPerPeer < minMessages {
-		return nil, fmt.Errorf("%w, maxMessagesPerPeer: provided %d, minimum %d",
+	err := CheckQuotaParameters(QuotaParameters{
+		MaxTotalSizePerPeer:       arg.MaxTotalSizePerPeer,
+		PercentReserved:           arg.PercentReserved,
+		IncreaseFactor:            arg.IncreaseFactor,
+		IncreaseThreshold:         arg.IncreaseThreshold,
+		BaseMaxNumMessagesPerPeer: arg.BaseMaxNumMessagesPerPeer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &quotaFloodPreventer{
+		name:                          arg.Name,
+		cacher:                        arg.Cacher,
+		statusHandlers:                arg.StatusHandlers,
+		computedMaxNumMessagesPerPeer: arg.BaseMaxNumMessagesPerPeer,
+		baseMaxNumMessagesPerPeer:     arg.BaseMaxNumMessagesPerPeer,
+		maxTotalSizePerPeer:           arg.MaxTotalSizePerPeer,
+		percentReserved:               arg.PercentReserved,
+		increaseThreshold:             arg.IncreaseThreshold,
+		increaseFactor:                arg.IncreaseFactor,
+	}, nil
+}
+
+// CheckQuotaParameters returns an error if the provided quota parameters can not be used by a quota flood preventer
+func CheckQuotaParameters(params QuotaParameters) error {
+	if params.BaseMaxNumMessagesPerPeer < minMessages {
+		return fmt.Errorf("%w, maxMessagesPerPeer: provided %d, minimum %d",
 			process.ErrInvalidValue,
-			arg.BaseMaxNumMessagesPerPeer,
+			params.BaseMaxNumMessagesPerPeer,
 			minMessages,
 		)
 	}
-	if arg.MaxTotalSizePerPeer < minTotalSize {
-		return nil, fmt.Errorf("%w, maxTotalSizePerPeer: provided %d, minimum %d",
+	if params.MaxTotalSizePerPeer < minTotalSize {
+		return fmt.Errorf("%w, maxTotalSizePerPeer: provided %d, minimum %d",
 			process.ErrInvalidValue,
-			arg.MaxTotalSizePerPeer,
+			params.MaxTotalSizePerPeer,
 			minTotalSize,
 		)
 	}
-	if arg.PercentReserved > maxPercentReserved {
-		return nil, fmt.Errorf("%w, percentReserved: provided %0.3f, maximum %0.3f",
+	if params.PercentReserved > maxPercentReserved {
+		return fmt.Errorf("%w, percentReserved: provided %0.3f, maximum %0.3f",
 			process.ErrInvalidValue,
-			arg.PercentReserved,
+			params.PercentReserved,
 			maxPercentReserved,
 		)
 	}
-	if arg.PercentReserved < minPercentReserved {
-		return nil, fmt.Errorf("%w, percentReserved: provided %0.3f, minimum %0.3f",
+	if params.PercentReserved < minPercentReserved {
+		return fmt.Errorf("%w, percentReserved: provided %0.3f, minimum %0.3f",
 			process.ErrInvalidValue,
-			arg.PercentReserved,
+			params.PercentReserved,
 			minPercentReserved,
 		)
 	}
-	if arg.IncreaseFactor < 0 {
-		return nil, fmt.Errorf("%w, increaseFactor is negative: provided %0.3f",
+	if params.IncreaseFactor < 0 {
+		return fmt.Errorf("%w, increaseFactor is negative: provided %0.3f",
 			process.ErrInvalidValue,
-			arg.IncreaseFactor,
+			params.IncreaseFactor,
 		)
 	}
 
-	return &quotaFloodPreventer{
-		name:                          arg.Name,
-		cacher:                        arg.Cacher,
-		statusHandlers:                arg.StatusHandlers,
-		computedMaxNumMessagesPerPeer: arg.BaseMaxNumMessagesPerPeer,
-		baseMaxNumMessagesPerPeer:     arg.BaseMaxNumMessagesPerPeer,
-		maxTotalSizePerPeer:           arg.MaxTotalSizePerPeer,
-		percentReserved:               arg.PercentReserved,
-		increaseThreshold:             arg.IncreaseThreshold,
-		increaseFactor:                arg.IncreaseFactor,
-	}, nil
+	return nil
 }
 
 // IncreaseLoad tries to increment the counter values held at "pid" position
@@ -238,6 +264,11 @@ func (qfp *quotaFloodPreventer) ApplyConsensusSize(size int) {
 		)
 		return
 	}
+
+	qfp.mutOperation.Lock()
+	defer qfp.mutOperation.Unlock()
+
+	qfp.consensusSize = size
 	if qfp.increaseThreshold > uint32(size) {
 		log.Debug("consensus size did not reach the threshold for quota flood preventer",
 			"name", qfp.name,
@@ -247,13 +278,8 @@ func (qfp *quotaFloodPreventer) ApplyConsensusSize(size int) {
 		return
 	}
 
-	qfp.mutOperation.Lock()
-	defer qfp.mutOperation.Unlock()
-
-	numNodesOverThreshold := float32(uint32(size) - qfp.increaseThreshold)
-	value := numNodesOverThreshold * qfp.increaseFactor
 	oldComputed := qfp.computedMaxNumMessagesPerPeer
-	qfp.computedMaxNumMessagesPerPeer = qfp.baseMaxNumMessagesPerPeer + uint32(value)
+	qfp.computedMaxNumMessagesPerPeer = qfp.computeMaxNumMessagesPerPeer()
 
 	log.Debug("quotaFloodPreventer.ApplyConsensusSize",
 		"name", qfp.name,
@@ -266,6 +292,50 @@ func (qfp *quotaFloodPreventer) ApplyConsensusSize(size int) {
 	)
 }
 
+// computeMaxNumMessagesPerPeer returns the maximum number of messages per peer for the last applied consensus size.
+// Should be called under mutex protection
+func (qfp *quotaFloodPreventer) computeMaxNumMessagesPerPeer() uint32 {
+	if qfp.consensusSize < 1 || qfp.increaseThreshold > uint32(qfp.consensusSize) {
+		return qfp.baseMaxNumMessagesPerPeer
+	}
+
+	numNodesOverThreshold := float32(uint32(qfp.consensusSize) - qfp.increaseThreshold)
+	value := numNodesOverThreshold * qfp.increaseFactor
+
+	return qfp.baseMaxNumMessagesPerPeer + uint32(value)
+}
+
+// SetQuotaParameters validates and atomically applies the provided limits. The maximum number of messages per peer
+// is recomputed using the last applied consensus size
+func (qfp *quotaFloodPreventer) SetQuotaParameters(params QuotaParameters) error {
+	err := CheckQuotaParameters(params)
+	if err != nil {
+		return err
+	}
+
+	qfp.mutOperation.Lock()
+	defer qfp.mutOperation.Unlock()
+
+	qfp.baseMaxNumMessagesPerPeer = params.BaseMaxNumMessagesPerPeer
+	qfp.maxTotalSizePerPeer = params.MaxTotalSizePerPeer
+	qfp.percentReserved = params.PercentReserved
+	qfp.increaseThreshold = params.IncreaseThreshold
+	qfp.increaseFactor = params.IncreaseFactor
+	qfp.computedMaxNumMessagesPerPeer = qfp.computeMaxNumMessagesPerPeer()
+
+	log.Debug("quotaFloodPreventer.SetQuotaParameters",
+		"name", qfp.name,
+		"base", qfp.baseMaxNumMessagesPerPeer,
+		"max total size", core.ConvertBytes(qfp.maxTotalSizePerPeer),
+		"percent reserved", qfp.percentReserved,
+		"threshold", qfp.increaseThreshold,
+		"factor", qfp.increaseFactor,
+		"computed", qfp.computedMaxNumMessagesPerPeer,
+	)
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (qfp *quotaFloodPreventer) IsInterfaceNil() bool {
 	return qfp == nil
@@ -488,3 +488,65 @@ func TestQuotaFloodPreventer_ApplyConsensusShouldWork(t *testing.T) {
 	err := qfp.IncreaseLoad(identifier, 0)
 	assert.NotNil(t, err)
 }
+
+//------- SetQuotaParameters
+
+func TestQuotaFloodPreventer_SetQuotaParametersInvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.BaseMaxNumMessagesPerPeer = 10
+	qfp, _ := NewQuotaFloodPreventer(arg)
+
+	err := qfp.SetQuotaParameters(QuotaParameters{
+		MaxTotalSizePerPeer:       minTotalSize,
+		PercentReserved:           maxPercentReserved + 1,
+		BaseMaxNumMessagesPerPeer: 20,
+	})
+
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	assert.Equal(t, arg.BaseMaxNumMessagesPerPeer, qfp.baseMaxNumMessagesPerPeer)
+	assert.Equal(t, arg.PercentReserved, qfp.percentReserved)
+}
+
+func TestQuotaFloodPreventer_SetQuotaParametersShouldRecomputeTheMaximumUsingTheLastConsensusSize(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.Cacher = testscommon.NewCacherMock()
+	arg.BaseMaxNumMessagesPerPeer = 2000
+	arg.IncreaseThreshold = 1000
+	arg.IncreaseFactor = 0.25
+	qfp, _ := NewQuotaFloodPreventer(arg)
+	qfp.ApplyConsensusSize(2000)
+	assert.Equal(t, uint32(2250), qfp.computedMaxNumMessagesPerPeer)
+
+	err := qfp.SetQuotaParameters(QuotaParameters{
+		MaxTotalSizePerPeer:       minTotalSize,
+		PercentReserved:           0,
+		IncreaseFactor:            0.5,
+		IncreaseThreshold:         1000,
+		BaseMaxNumMessagesPerPeer: 10,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(510), qfp.computedMaxNumMessagesPerPeer)
+
+	err = qfp.SetQuotaParameters(QuotaParameters{
+		MaxTotalSizePerPeer:       math.MaxUint64,
+		PercentReserved:           0,
+		IncreaseFactor:            0.5,
+		IncreaseThreshold:         3000,
+		BaseMaxNumMessagesPerPeer: 10,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(10), qfp.computedMaxNumMessagesPerPeer)
+
+	identifier := core.PeerID("identifier")
+	for i := 0; i < 10; i++ {
+		err = qfp.IncreaseLoad(identifier, 1)
+		assert.Nil(t, err, fmt.Sprintf("on iteration %d", i))
+	}
+
+	err = qfp.IncreaseLoad(identifier, 1)
+	assert.True(t, errors.Is(err, process.ErrSystemBusy))
+}
@@ -24,6 +24,7 @@ type topicFloodPreventer struct {
 	mutTopicMaxMessages       sync.RWMutex
 	topicMaxMessages          map[string]uint32
 	registeredTopics          map[string]struct{}
+	configuredTopics          map[string]struct{}
 	counterMap                map[string]map[core.PeerID]uint32
 	defaultMaxMessagesPerPeer uint32
 }
@@ -33,22 +34,34 @@ func NewTopicFloodPreventer(
 	maxMessagesPerPeer uint32,
 ) (*topicFloodPreventer, error) {
 
-	if maxMessagesPerPeer < topicMinMessages {
-		return nil, fmt.Errorf("%w raised in NewTopicFloodPreventer, maxMessagesPerPeer: provided %d, minimum %d",
-			process.ErrInvalidValue,
-			maxMessagesPerPeer,
-			topicMinMessages,
-		)
+	err := CheckTopicMaxMessagesPerPeer(maxMessagesPerPeer)
+	if err != nil {
+		return nil, fmt.Errorf("%w raised in NewTopicFloodPreventer", err)
 	}
 
 	return &topicFloodPreventer{
 		topicMaxMessages:          make(map[string]uint32),
 		counterMap:                make(map[string]map[core.PeerID]uint32),
 		registeredTopics:          make(map[string]struct{}),
+		configuredTopics:          make(map[string]struct{}),
 		defaultMaxMessagesPerPeer: maxMessagesPerPeer,
 	}, nil
 }
 
+// CheckTopicMaxMessagesPerPeer returns an error if the provided value can not be used as the default maximum number
+// of messages per peer in a topic flood preventer
+func CheckTopicMaxMessagesPerPeer(maxMessagesPerPeer uint32) error {
+	if maxMessagesPerPeer < topicMinMessages {
+		return fmt.Errorf("%w, maxMessagesPerPeer: provided %d, minimum %d",
+			process.ErrInvalidValue,
+			maxMessagesPerPeer,
+			topicMinMessages,
+		)
+	}
+
+	return nil
+}
+
 // IncreaseLoad tries to increment the counter values held at "identifier" position for the given topic
 // It returns nil if it had succeeded incrementing (existing counter value is lower than provided maxMessagesPerPeer)
 func (tfp *topicFloodPreventer) IncreaseLoad(pid core.PeerID, topic string, numMessages uint32) error {
@@ -79,6 +92,46 @@ func (tfp *topicFloodPreventer) SetMaxMessagesForTopic(topic string, numMessages
 	tfp.mutTopicMaxMessages.Unlock()
 }
 
+// SetTopicsMaxMessages validates and atomically applies a new default maximum number of messages per peer together
+// with the configured maximum values per topic. The topics set by a previous call are replaced, while the
+// topics set through SetMaxMessagesForTopic by other components remain unaffected
+func (tfp *topicFloodPreventer) SetTopicsMaxMessages(defaultMaxMessagesPerPeer uint32, topicsMaxMessages map[string]uint32) error {
+	err := CheckTopicMaxMessagesPerPeer(defaultMaxMessagesPerPeer)
+	if err != nil {
+		return err
+	}
+
+	tfp.mutTopicMaxMessages.Lock()
+	defer tfp.mutTopicMaxMessages.Unlock()
+
+	for topic := range tfp.configuredTopics {
+		delete(tfp.topicMaxMessages, topic)
+		delete(tfp.registeredTopics, topic)
+	}
+	// the values computed for the topics that were not registered will be recomputed using the new settings
+	for topic := range tfp.topicMaxMessages {
+		_, isRegistered := tfp.registeredTopics[topic]
+		if !isRegistered {
+			delete(tfp.topicMaxMessages, topic)
+		}
+	}
+
+	tfp.configuredTopics = make(map[string]struct{})
+	for topic, numMessages := range topicsMaxMessages {
+		tfp.topicMaxMessages[topic] = numMessages
+		tfp.registeredTopics[topic] = struct{}{}
+		tfp.configuredTopics[topic] = struct{}{}
+	}
+	tfp.defaultMaxMessagesPerPeer = defaultMaxMessagesPerPeer
+
+	log.Debug("SetTopicsMaxMessages",
+		"default max messages per peer", defaultMaxMessagesPerPeer,
+		"num configured topics", len(topicsMaxMessages),
+	)
+
+	return nil
+}
+
 // ResetForTopic clears all map values for a given topic
 func (tfp *topicFloodPreventer) ResetForTopic(topic string) {
 	tfp.mutTopicMaxMessages.Lock()
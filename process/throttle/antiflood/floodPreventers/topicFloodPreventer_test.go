@@ -312,3 +312,45 @@ func TestTopicFloodPreventer_ResetForNotRegisteredTopicsWithWildcardShuldWork(t
 	err = tfp.IncreaseLoad(identifier, unregisteredTopic, defaultMaxMessages)
 	assert.Nil(t, err)
 }
+
+func TestTopicFloodPreventer_SetTopicsMaxMessagesInvalidDefaultShouldErr(t *testing.T) {
+	t.Parallel()
+
+	defaultMaxMessages := uint32(2)
+	tfp, _ := floodPreventers.NewTopicFloodPreventer(defaultMaxMessages)
+
+	err := tfp.SetTopicsMaxMessages(0, map[string]uint32{"topic": 10})
+
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	assert.Equal(t, defaultMaxMessages, tfp.MaxMessagesForTopic("topic"))
+}
+
+func TestTopicFloodPreventer_SetTopicsMaxMessagesShouldReplaceThePreviouslyConfiguredTopics(t *testing.T) {
+	t.Parallel()
+
+	tfp, _ := floodPreventers.NewTopicFloodPreventer(2)
+
+	consensusTopic := "consensus"
+	tfp.SetMaxMessagesForTopic(consensusTopic, 50)
+
+	err := tfp.SetTopicsMaxMessages(3, map[string]uint32{
+		"headers" + floodPreventers.WildcardCharacter: 100,
+		"transactions": 200,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(100), tfp.MaxMessagesForTopic("headers_0"))
+	assert.Equal(t, uint32(200), tfp.MaxMessagesForTopic("transactions"))
+	assert.Equal(t, uint32(3), tfp.MaxMessagesForTopic("unknown"))
+
+	err = tfp.SetTopicsMaxMessages(4, map[string]uint32{
+		"transactions": 300,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(4), tfp.MaxMessagesForTopic("headers_0"))
+	assert.Equal(t, uint32(300), tfp.MaxMessagesForTopic("transactions"))
+	assert.Equal(t, uint32(4), tfp.MaxMessagesForTopic("unknown"))
+	assert.Equal(t, uint32(50), tfp.MaxMessagesForTopic(consensusTopic))
+
+	_, found := tfp.TopicMaxMessages()["headers"+floodPreventers.WildcardCharacter]
+	assert.False(t, found)
+}
@@ -8,9 +8,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
+	disabledMetrics "github.com/ElrondNetwork/elrond-go/statusHandler/metrics/disabled"
 )
 
 const unidentifiedTopic = "unidentifier topic"
@@ -27,6 +29,10 @@ type p2pAntiflood struct {
 	peerValidatorMapper process.PeerValidatorMapper
 	mapTopicsFromAll    map[string]struct{}
 	mutTopicCheck       sync.RWMutex
+	mutReputation       sync.RWMutex
+	reputationHandler   process.PeerReputationHandler
+	mutMetrics          sync.RWMutex
+	metricsHandler      common.MetricsHandler
 }
 
 // NewP2PAntiflood creates a new p2p anti flood protection mechanism built on top of a flood preventer implementation.
@@ -54,6 +60,8 @@ func NewP2PAntiflood(
 		debugger:            &disabled.AntifloodDebugger{},
 		mapTopicsFromAll:    make(map[string]struct{}),
 		peerValidatorMapper: &disabled.PeerValidatorMapper{},
+		reputationHandler:   &disabled.PeerReputationHandler{},
+		metricsHandler:      &disabledMetrics.MetricsHandler{},
 	}, nil
 }
 
@@ -72,6 +80,7 @@ func (af *p2pAntiflood) CanProcessMessage(message p2p.MessageP2P, fromConnectedP
 	}
 
 	if lastErrFound != nil {
+		af.recordRejection(message.Topic(), common.AntifloodReasonFlood)
 		af.recordDebugEvent(
 			fromConnectedPeer,
 			message.Topic(),
@@ -86,6 +95,7 @@ func (af *p2pAntiflood) CanProcessMessage(message p2p.MessageP2P, fromConnectedP
 
 	originatorIsBlacklisted := af.blacklistHandler.Has(message.Peer())
 	if originatorIsBlacklisted {
+		af.recordRejection(message.Topic(), common.AntifloodReasonBlacklistedOriginator)
 		af.recordDebugEvent(message.Peer(), message.Topic(), 1, uint64(len(message.Data())), message.SeqNo(), true)
 		return fmt.Errorf("%w for pid %s", process.ErrOriginatorIsBlacklisted, message.Peer().Pretty())
 	}
@@ -189,6 +199,7 @@ func (af *p2pAntiflood) CanProcessMessagesOnTopic(peer core.PeerID, topic string
 			"topic", topic,
 		)
 
+		af.recordRejection(topic, common.AntifloodReasonTopicFlood)
 		af.recordDebugEvent(peer, topic, numMessages, totalSize, sequence, af.blacklistHandler.Has(peer))
 
 		return fmt.Errorf("%w in p2pAntiflood for connected peer %s",
@@ -246,6 +257,10 @@ func (af *p2pAntiflood) BlacklistPeer(peer core.PeerID, reason string, duration
 		return
 	}
 
+	af.mutReputation.RLock()
+	af.reputationHandler.RecordPeerIDBan(peer, duration, reason)
+	af.mutReputation.RUnlock()
+
 	if !peerIsBlacklisted {
 		log.Debug("blacklisted peer",
 			"pid", peer.Pretty(),
@@ -255,6 +270,38 @@ func (af *p2pAntiflood) BlacklistPeer(peer core.PeerID, reason string, duration
 	}
 }
 
+// SetPeerReputationHandler sets the handler that will record the bans applied through BlacklistPeer
+func (af *p2pAntiflood) SetPeerReputationHandler(reputationHandler process.PeerReputationHandler) error {
+	if check.IfNil(reputationHandler) {
+		return process.ErrNilPeerReputationHandler
+	}
+
+	af.mutReputation.Lock()
+	af.reputationHandler = reputationHandler
+	af.mutReputation.Unlock()
+
+	return nil
+}
+
+// SetMetricsHandler sets the handler recording the rejected messages
+func (af *p2pAntiflood) SetMetricsHandler(metricsHandler common.MetricsHandler) error {
+	if check.IfNil(metricsHandler) {
+		return process.ErrNilMetricsHandler
+	}
+
+	af.mutMetrics.Lock()
+	af.metricsHandler = metricsHandler
+	af.mutMetrics.Unlock()
+
+	return nil
+}
+
+func (af *p2pAntiflood) recordRejection(topic string, reason common.AntifloodReason) {
+	af.mutMetrics.RLock()
+	af.metricsHandler.AddAntifloodRejection(topic, reason)
+	af.mutMetrics.RUnlock()
+}
+
 // Close will call the close function on all sub components
 func (af *p2pAntiflood) Close() error {
 	return af.debugger.Close()
@@ -421,6 +421,33 @@ func TestP2pAntiflood_BlacklistPeerShouldWork(t *testing.T) {
 	assert.Equal(t, int32(1), atomic.LoadInt32(&numCalls))
 }
 
+func TestP2pAntiflood_BlacklistPeerShouldRecordTheBan(t *testing.T) {
+	t.Parallel()
+
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TopicAntiFloodStub{},
+		&mock.FloodPreventerStub{},
+	)
+
+	err := afm.SetPeerReputationHandler(nil)
+	assert.Equal(t, process.ErrNilPeerReputationHandler, err)
+
+	recordedReason := ""
+	err = afm.SetPeerReputationHandler(&mock.PeerReputationHandlerStub{
+		RecordPeerIDBanCalled: func(pid core.PeerID, duration time.Duration, reason string) {
+			assert.Equal(t, core.PeerID("pid"), pid)
+			assert.Equal(t, time.Second, duration)
+			recordedReason = reason
+		},
+	})
+	assert.Nil(t, err)
+
+	afm.BlacklistPeer("pid", "reason", time.Second)
+
+	assert.Equal(t, "reason", recordedReason)
+}
+
 func TestP2pAntiflood_IsOriginatorEligibleForTopic(t *testing.T) {
 	t.Parallel()
 
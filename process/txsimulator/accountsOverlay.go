@@ -0,0 +1,452 @@
+package txsimulator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/ElrondNetwork/elrond-go/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const esdtTickerNumRandomChars = 6
+
+var esdtKeyPrefix = []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier)
+
+// overlayAccount holds an account saved in the overlay. The data trie changes are kept aside as the data trie of the
+// account is never updated
+type overlayAccount struct {
+	accountBytes []byte
+	dirtyData    map[string][]byte
+}
+
+type overlayJournalEntry struct {
+	address         string
+	previousAccount *overlayAccount
+	addedCodeHash   string
+}
+
+// accountsOverlay keeps in memory the accounts saved while simulating transactions, along with a journal that allows
+// reverting the changes of a failed transaction. The overlay is built either on top of the current state or, when the
+// base trie is set, on top of a past state. The code deployed by the simulated transactions is kept by its hash, as it
+// is never saved in the main trie
+type accountsOverlay struct {
+	accounts     map[string]*overlayAccount
+	codes        map[string][]byte
+	journal      []*overlayJournalEntry
+	baseTrie     common.Trie
+	diffAccounts map[string]*overlayAccount
+}
+
+func newAccountsOverlay(baseTrie common.Trie) *accountsOverlay {
+	return &accountsOverlay{
+		accounts:     make(map[string]*overlayAccount),
+		codes:        make(map[string][]byte),
+		journal:      make([]*overlayJournalEntry, 0),
+		baseTrie:     baseTrie,
+		diffAccounts: make(map[string]*overlayAccount),
+	}
+}
+
+func (overlay *accountsOverlay) saveAccount(
+	account vmcommon.AccountHandler,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) error {
+	addedCodeHash := overlay.saveCode(account, hasher)
+
+	accountBytes, err := marshalizer.Marshal(account)
+	if err != nil {
+		return err
+	}
+
+	address := string(account.AddressBytes())
+	previousAccount := overlay.accounts[address]
+
+	dirtyData := make(map[string][]byte)
+	if previousAccount != nil {
+		for key, value := range previousAccount.dirtyData {
+			dirtyData[key] = value
+		}
+	}
+	userAccount, ok := account.(state.UserAccountHandler)
+	if ok && !check.IfNil(userAccount.DataTrieTracker()) {
+		for key, value := range userAccount.DataTrieTracker().DirtyData() {
+			dirtyData[key] = value
+		}
+	}
+
+	overlay.journal = append(overlay.journal, &overlayJournalEntry{
+		address:         address,
+		previousAccount: previousAccount,
+		addedCodeHash:   addedCodeHash,
+	})
+	overlay.accounts[address] = &overlayAccount{
+		accountBytes: accountBytes,
+		dirtyData:    dirtyData,
+	}
+
+	return nil
+}
+
+// saveCode keeps the new code of the account, if any, and sets its hash on the account, the same way the accounts
+// database does. It returns the code hash if the code was not already kept in the overlay
+func (overlay *accountsOverlay) saveCode(account vmcommon.AccountHandler, hasher hashing.Hasher) string {
+	accountWithNewCode, ok := account.(accountWithNewCodeHandler)
+	if !ok || !accountWithNewCode.HasNewCode() {
+		return ""
+	}
+
+	code := accountWithNewCode.GetCode()
+	if len(code) == 0 {
+		accountWithNewCode.SetCodeHash(nil)
+		return ""
+	}
+
+	codeHash := hasher.Compute(string(code))
+	accountWithNewCode.SetCodeHash(codeHash)
+
+	_, exists := overlay.codes[string(codeHash)]
+	if exists {
+		return ""
+	}
+	overlay.codes[string(codeHash)] = code
+
+	return string(codeHash)
+}
+
+func (overlay *accountsOverlay) getCode(codeHash []byte) ([]byte, bool) {
+	code, found := overlay.codes[string(codeHash)]
+
+	return code, found
+}
+
+func (overlay *accountsOverlay) revertToSnapshot(snapshot int) error {
+	if snapshot > len(overlay.journal) || snapshot < 0 {
+		return state.ErrSnapshotValueOutOfBounds
+	}
+
+	for i := len(overlay.journal) - 1; i >= snapshot; i-- {
+		entry := overlay.journal[i]
+		if len(entry.addedCodeHash) > 0 {
+			delete(overlay.codes, entry.addedCodeHash)
+		}
+		if entry.previousAccount == nil {
+			delete(overlay.accounts, entry.address)
+			continue
+		}
+
+		overlay.accounts[entry.address] = entry.previousAccount
+	}
+	overlay.journal = overlay.journal[:snapshot]
+
+	return nil
+}
+
+// StartOverlay will start keeping in memory the saved accounts, dropping the previously kept ones, if any
+func (r *readOnlyAccountsDB) StartOverlay() {
+	r.mutOverlay.Lock()
+	r.overlay = newAccountsOverlay(nil)
+	r.mutOverlay.Unlock()
+}
+
+// StartOverlayAtRootHash will start keeping in memory the saved accounts, on top of the state with the provided root
+// hash instead of the current one. The previously kept accounts, if any, are dropped
+func (r *readOnlyAccountsDB) StartOverlayAtRootHash(rootHash []byte) error {
+	baseTrie, err := r.originalAccounts.GetTrie(rootHash)
+	if err != nil {
+		return fmt.Errorf("%w for root hash %s: %s", ErrStateNotAvailable, hex.EncodeToString(rootHash), err.Error())
+	}
+	if check.IfNil(baseTrie) {
+		return fmt.Errorf("%w for root hash %s", ErrStateNotAvailable, hex.EncodeToString(rootHash))
+	}
+
+	r.mutOverlay.Lock()
+	r.overlay = newAccountsOverlay(baseTrie)
+	r.mutOverlay.Unlock()
+
+	return nil
+}
+
+// ResetOverlayStateDiffs will make the following state diffs be computed against the accounts currently kept in the
+// overlay instead of the state the overlay was started on
+func (r *readOnlyAccountsDB) ResetOverlayStateDiffs() {
+	r.mutOverlay.Lock()
+	defer r.mutOverlay.Unlock()
+
+	if r.overlay == nil {
+		return
+	}
+
+	r.overlay.diffAccounts = make(map[string]*overlayAccount, len(r.overlay.accounts))
+	for address, account := range r.overlay.accounts {
+		r.overlay.diffAccounts[address] = account
+	}
+}
+
+// StopOverlay will drop the accounts kept in memory and will make the component read-only again
+func (r *readOnlyAccountsDB) StopOverlay() {
+	r.mutOverlay.Lock()
+	r.overlay = nil
+	r.mutOverlay.Unlock()
+}
+
+// GetOverlayStateDiffs returns, for each account saved in the overlay, the differences from the original state
+func (r *readOnlyAccountsDB) GetOverlayStateDiffs(addressConverter core.PubkeyConverter) ([]*txSimData.AccountStateDiff, error) {
+	r.mutOverlay.RLock()
+	addresses := make([]string, 0)
+	if r.overlay != nil {
+		for address, account := range r.overlay.accounts {
+			if r.overlay.diffAccounts[address] == account {
+				continue
+			}
+			addresses = append(addresses, address)
+		}
+	}
+	r.mutOverlay.RUnlock()
+
+	sort.Strings(addresses)
+
+	stateDiffs := make([]*txSimData.AccountStateDiff, 0, len(addresses))
+	for _, address := range addresses {
+		stateDiff, err := r.computeStateDiff([]byte(address), addressConverter)
+		if err != nil {
+			return nil, err
+		}
+		if stateDiff == nil {
+			continue
+		}
+
+		stateDiffs = append(stateDiffs, stateDiff)
+	}
+
+	return stateDiffs, nil
+}
+
+func (r *readOnlyAccountsDB) computeStateDiff(address []byte, addressConverter core.PubkeyConverter) (*txSimData.AccountStateDiff, error) {
+	accountAfter, found, err := r.getOverlayAccount(address)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	// the account might not exist in the original state
+	accountBefore, _ := r.getDiffBaseAccount(address)
+
+	stateDiff := &txSimData.AccountStateDiff{
+		Address:    addressConverter.Encode(address),
+		NonceAfter: accountAfter.GetNonce(),
+	}
+	if !check.IfNil(accountBefore) {
+		stateDiff.NonceBefore = accountBefore.GetNonce()
+	}
+
+	balanceBefore := big.NewInt(0)
+	balanceAfter := big.NewInt(0)
+	userAccountBefore, _ := accountBefore.(state.UserAccountHandler)
+	userAccountAfter, ok := accountAfter.(state.UserAccountHandler)
+	if ok {
+		balanceAfter = userAccountAfter.GetBalance()
+		stateDiff.StorageChanges, stateDiff.ESDTChanges = r.computeDataTrieChanges(userAccountBefore, userAccountAfter)
+	}
+	if !check.IfNil(userAccountBefore) {
+		balanceBefore = userAccountBefore.GetBalance()
+	}
+	stateDiff.BalanceBefore = balanceBefore.String()
+	stateDiff.BalanceAfter = balanceAfter.String()
+	stateDiff.BalanceDelta = big.NewInt(0).Sub(balanceAfter, balanceBefore).String()
+
+	isUnchanged := stateDiff.NonceBefore == stateDiff.NonceAfter &&
+		balanceBefore.Cmp(balanceAfter) == 0 &&
+		len(stateDiff.StorageChanges) == 0
+	if isUnchanged {
+		return nil, nil
+	}
+
+	return stateDiff, nil
+}
+
+// computeDataTrieChanges returns the changed data trie keys of the account, along with the changed ESDT balances, which
+// are decoded from the protected ESDT keys
+func (r *readOnlyAccountsDB) computeDataTrieChanges(
+	accountBefore state.UserAccountHandler,
+	accountAfter state.UserAccountHandler,
+) ([]*txSimData.StorageValueDiff, []*txSimData.ESDTBalanceDiff) {
+	if check.IfNil(accountAfter.DataTrieTracker()) {
+		return nil, nil
+	}
+
+	keys := make([]string, 0)
+	for key := range accountAfter.DataTrieTracker().DirtyData() {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	storageChanges := make([]*txSimData.StorageValueDiff, 0)
+	esdtChanges := make([]*txSimData.ESDTBalanceDiff, 0)
+	for _, key := range keys {
+		valueAfter, _ := accountAfter.RetrieveValueFromDataTrieTracker([]byte(key))
+
+		var valueBefore []byte
+		if !check.IfNil(accountBefore) {
+			valueBefore, _ = accountBefore.RetrieveValueFromDataTrieTracker([]byte(key))
+		}
+
+		if bytes.Equal(valueBefore, valueAfter) {
+			continue
+		}
+
+		storageChanges = append(storageChanges, &txSimData.StorageValueDiff{
+			Key:         hex.EncodeToString([]byte(key)),
+			ValueBefore: hex.EncodeToString(valueBefore),
+			ValueAfter:  hex.EncodeToString(valueAfter),
+		})
+
+		esdtChange, isESDTKey := r.computeESDTBalanceDiff([]byte(key), valueBefore, valueAfter)
+		if isESDTKey {
+			esdtChanges = append(esdtChanges, esdtChange)
+		}
+	}
+
+	return storageChanges, esdtChanges
+}
+
+func (r *readOnlyAccountsDB) computeESDTBalanceDiff(key []byte, valueBefore []byte, valueAfter []byte) (*txSimData.ESDTBalanceDiff, bool) {
+	if !bytes.HasPrefix(key, esdtKeyPrefix) {
+		return nil, false
+	}
+
+	balanceBefore, err := r.getESDTBalance(valueBefore)
+	if err != nil {
+		log.Debug("cannot decode the ESDT balance before the simulation", "key", key, "error", err)
+		return nil, false
+	}
+	balanceAfter, err := r.getESDTBalance(valueAfter)
+	if err != nil {
+		log.Debug("cannot decode the ESDT balance after the simulation", "key", key, "error", err)
+		return nil, false
+	}
+	if balanceBefore.Cmp(balanceAfter) == 0 {
+		return nil, false
+	}
+
+	return &txSimData.ESDTBalanceDiff{
+		TokenIdentifier: formatESDTTokenIdentifier(key[len(esdtKeyPrefix):]),
+		BalanceBefore:   balanceBefore.String(),
+		BalanceAfter:    balanceAfter.String(),
+	}, true
+}
+
+func (r *readOnlyAccountsDB) getESDTBalance(esdtTokenBytes []byte) (*big.Int, error) {
+	if len(esdtTokenBytes) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	esdtToken := &esdt.ESDigitalToken{Value: big.NewInt(0)}
+	err := r.marshalizer.Unmarshal(esdtToken, esdtTokenBytes)
+	if err != nil {
+		return nil, err
+	}
+	if esdtToken.Value == nil {
+		return big.NewInt(0), nil
+	}
+
+	return esdtToken.Value, nil
+}
+
+// formatESDTTokenIdentifier returns the token identifier as TICKER-random for fungible tokens and as
+// TICKER-random-nonce for the NFTs and SFTs, whose storage key ends with the nonce bytes
+func formatESDTTokenIdentifier(tokenKey []byte) string {
+	separatorIndex := bytes.IndexByte(tokenKey, '-')
+	tokenIdentifierLen := separatorIndex + 1 + esdtTickerNumRandomChars
+	if separatorIndex < 0 || len(tokenKey) <= tokenIdentifierLen {
+		return string(tokenKey)
+	}
+
+	return fmt.Sprintf("%s-%s", tokenKey[:tokenIdentifierLen], hex.EncodeToString(tokenKey[tokenIdentifierLen:]))
+}
+
+// getOverlayAccount rebuilds the account saved in the overlay, if any, on top of the original state. The returned
+// account is a new instance each time, so that the changes not saved are discarded
+func (r *readOnlyAccountsDB) getOverlayAccount(address []byte) (vmcommon.AccountHandler, bool, error) {
+	r.mutOverlay.RLock()
+	defer r.mutOverlay.RUnlock()
+
+	if r.overlay == nil {
+		return nil, false, nil
+	}
+	savedAccount, found := r.overlay.accounts[string(address)]
+	if !found {
+		return nil, false, nil
+	}
+
+	account, err := r.rebuildOverlayAccount(address, savedAccount)
+
+	return account, true, err
+}
+
+// getDiffBaseAccount returns the account against which the state diff of the address is computed
+func (r *readOnlyAccountsDB) getDiffBaseAccount(address []byte) (vmcommon.AccountHandler, error) {
+	r.mutOverlay.RLock()
+	var savedAccount *overlayAccount
+	found := false
+	if r.overlay != nil {
+		savedAccount, found = r.overlay.diffAccounts[string(address)]
+	}
+	r.mutOverlay.RUnlock()
+
+	if found {
+		return r.rebuildOverlayAccount(address, savedAccount)
+	}
+
+	return r.getExistingBaseAccount(address)
+}
+
+// getExistingBaseAccount returns the account from the state the overlay was started on
+func (r *readOnlyAccountsDB) getExistingBaseAccount(address []byte) (vmcommon.AccountHandler, error) {
+	r.mutOverlay.RLock()
+	var baseTrie common.Trie
+	if r.overlay != nil {
+		baseTrie = r.overlay.baseTrie
+	}
+	r.mutOverlay.RUnlock()
+
+	if check.IfNil(baseTrie) {
+		return r.originalAccounts.GetExistingAccount(address)
+	}
+
+	accountBytes, err := baseTrie.Get(address)
+	if err != nil {
+		return nil, err
+	}
+	if len(accountBytes) == 0 {
+		return nil, state.ErrAccNotFound
+	}
+
+	return r.originalAccounts.GetAccountFromBytes(address, accountBytes)
+}
+
+func (r *readOnlyAccountsDB) rebuildOverlayAccount(address []byte, savedAccount *overlayAccount) (vmcommon.AccountHandler, error) {
+	account, err := r.originalAccounts.GetAccountFromBytes(address, savedAccount.accountBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if ok && !check.IfNil(userAccount.DataTrieTracker()) {
+		dirtyData := userAccount.DataTrieTracker().DirtyData()
+		for key, value := range savedAccount.dirtyData {
+			dirtyData[key] = value
+		}
+	}
+
+	return account, nil
+}
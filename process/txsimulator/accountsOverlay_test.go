@@ -0,0 +1,411 @@
+package txsimulator
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/ElrondNetwork/elrond-go/state"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	overlaySender   = []byte("sender__________________________")
+	overlayReceiver = []byte("receiver________________________")
+)
+
+// createReadOnlyAccountsDBForOverlay creates a read-only accounts db on top of a state holding only the sender, with
+// a balance of 100. The past state with the root hash "pastRoot" holds only the sender as well, with a balance of 60
+func createReadOnlyAccountsDBForOverlay(t *testing.T) *readOnlyAccountsDB {
+	marshalizer := &mock.MarshalizerMock{}
+	getExistingAccount := func(address []byte) (vmcommon.AccountHandler, error) {
+		if string(address) != string(overlaySender) {
+			return nil, state.ErrAccNotFound
+		}
+
+		account, _ := state.NewUserAccount(address)
+		_ = account.AddToBalance(big.NewInt(100))
+
+		return account, nil
+	}
+	accDb := &stateMock.AccountsStub{
+		GetExistingAccountCalled: getExistingAccount,
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			account, err := getExistingAccount(address)
+			if err != nil {
+				return state.NewUserAccount(address)
+			}
+
+			return account, nil
+		},
+		GetAccountFromBytesCalled: func(address []byte, accountBytes []byte) (vmcommon.AccountHandler, error) {
+			account, _ := state.NewUserAccount(address)
+			err := marshalizer.Unmarshal(account, accountBytes)
+
+			return account, err
+		},
+		GetCodeCalled: func(codeHash []byte) []byte {
+			if string(codeHash) != "originalCodeHash" {
+				return nil
+			}
+
+			return []byte("original code")
+		},
+		SaveAccountCalled: func(_ vmcommon.AccountHandler) error {
+			require.Fail(t, "the original state should not be written")
+			return nil
+		},
+		RevertToSnapshotCalled: func(_ int) error {
+			require.Fail(t, "the original state should not be reverted")
+			return nil
+		},
+		GetTrieCalled: func(rootHash []byte) (common.Trie, error) {
+			if string(rootHash) != "pastRoot" {
+				return nil, errors.New("root hash not found")
+			}
+
+			return &trieMock.TrieStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					if string(key) != string(overlaySender) {
+						return nil, nil
+					}
+
+					account, _ := state.NewUserAccount(key)
+					_ = account.AddToBalance(big.NewInt(60))
+
+					return marshalizer.Marshal(account)
+				},
+			}, nil
+		},
+	}
+
+	roAccDb, _ := NewReadOnlyAccountsDB(accDb, marshalizer, &mock.HasherMock{})
+
+	return roAccDb
+}
+
+func deployWithinOverlay(t *testing.T, roAccDb *readOnlyAccountsDB, code []byte) []byte {
+	contract, err := roAccDb.LoadAccount(overlayReceiver)
+	require.Nil(t, err)
+	contractAccount := contract.(state.UserAccountHandler)
+	contractAccount.SetCode(code)
+	require.Nil(t, roAccDb.SaveAccount(contractAccount))
+
+	contract, err = roAccDb.GetExistingAccount(overlayReceiver)
+	require.Nil(t, err)
+
+	return contract.(state.UserAccountHandler).GetCodeHash()
+}
+
+func transferWithinOverlay(t *testing.T, roAccDb *readOnlyAccountsDB, value int64) {
+	sender, err := roAccDb.LoadAccount(overlaySender)
+	require.Nil(t, err)
+	senderAccount := sender.(state.UserAccountHandler)
+	require.Nil(t, senderAccount.SubFromBalance(big.NewInt(value)))
+	senderAccount.IncreaseNonce(1)
+	require.Nil(t, roAccDb.SaveAccount(senderAccount))
+
+	receiver, err := roAccDb.LoadAccount(overlayReceiver)
+	require.Nil(t, err)
+	receiverAccount := receiver.(state.UserAccountHandler)
+	require.Nil(t, receiverAccount.AddToBalance(big.NewInt(value)))
+	require.Nil(t, receiverAccount.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("value")))
+	require.Nil(t, roAccDb.SaveAccount(receiverAccount))
+}
+
+func TestReadOnlyAccountsDB_SaveAccountWithoutOverlayShouldNotKeepTheAccount(t *testing.T) {
+	t.Parallel()
+
+	roAccDb := createReadOnlyAccountsDBForOverlay(t)
+
+	account, _ := state.NewUserAccount(overlayReceiver)
+	_ = account.AddToBalance(big.NewInt(10))
+	err := roAccDb.SaveAccount(account)
+	require.Nil(t, err)
+
+	_, err = roAccDb.GetExistingAccount(overlayReceiver)
+	require.Equal(t, state.ErrAccNotFound, err)
+}
+
+func TestReadOnlyAccountsDB_OverlayShouldKeepTheSavedAccounts(t *testing.T) {
+	t.Parallel()
+
+	roAccDb := createReadOnlyAccountsDBForOverlay(t)
+	roAccDb.StartOverlay()
+
+	transferWithinOverlay(t, roAccDb, 30)
+	transferWithinOverlay(t, roAccDb, 20)
+
+	sender, err := roAccDb.GetExistingAccount(overlaySender)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(50), sender.(state.UserAccountHandler).GetBalance())
+	require.Equal(t, uint64(2), sender.GetNonce())
+
+	receiver, err := roAccDb.LoadAccount(overlayReceiver)
+	require.Nil(t, err)
+	receiverAccount := receiver.(state.UserAccountHandler)
+	require.Equal(t, big.NewInt(50), receiverAccount.GetBalance())
+	value, err := receiverAccount.RetrieveValueFromDataTrieTracker([]byte("key"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("value"), value)
+
+	roAccDb.StopOverlay()
+
+	_, err = roAccDb.GetExistingAccount(overlayReceiver)
+	require.Equal(t, state.ErrAccNotFound, err)
+}
+
+func TestReadOnlyAccountsDB_OverlayNotSavedChangesShouldBeDiscarded(t *testing.T) {
+	t.Parallel()
+
+	roAccDb := createReadOnlyAccountsDBForOverlay(t)
+	roAccDb.StartOverlay()
+	transferWithinOverlay(t, roAccDb, 30)
+
+	sender, _ := roAccDb.LoadAccount(overlaySender)
+	_ = sender.(state.UserAccountHandler).SubFromBalance(big.NewInt(10))
+
+	sender, _ = roAccDb.LoadAccount(overlaySender)
+	require.Equal(t, big.NewInt(70), sender.(state.UserAccountHandler).GetBalance())
+}
+
+func TestReadOnlyAccountsDB_OverlayRevertToSnapshot(t *testing.T) {
+	t.Parallel()
+
+	roAccDb := createReadOnlyAccountsDBForOverlay(t)
+	roAccDb.StartOverlay()
+	require.Equal(t, 0, roAccDb.JournalLen())
+
+	transferWithinOverlay(t, roAccDb, 30)
+	snapshot := roAccDb.JournalLen()
+	require.Equal(t, 2, snapshot)
+
+	transferWithinOverlay(t, roAccDb, 20)
+	require.Equal(t, 4, roAccDb.JournalLen())
+
+	err := roAccDb.RevertToSnapshot(snapshot)
+	require.Nil(t, err)
+	require.Equal(t, snapshot, roAccDb.JournalLen())
+
+	sender, _ := roAccDb.GetExistingAccount(overlaySender)
+	require.Equal(t, big.NewInt(70), sender.(state.UserAccountHandler).GetBalance())
+
+	err = roAccDb.RevertToSnapshot(0)
+	require.Nil(t, err)
+	_, err = roAccDb.GetExistingAccount(overlayReceiver)
+	require.Equal(t, state.ErrAccNotFound, err)
+
+	err = roAccDb.RevertToSnapshot(1)
+	require.True(t, errors.Is(err, state.ErrSnapshotValueOutOfBounds))
+}
+
+func TestReadOnlyAccountsDB_OverlayShouldKeepTheDeployedCode(t *testing.T) {
+	t.Parallel()
+
+	roAccDb := createReadOnlyAccountsDBForOverlay(t)
+	require.Equal(t, []byte("original code"), roAccDb.GetCode([]byte("originalCodeHash")))
+
+	roAccDb.StartOverlay()
+	code := []byte("deployed code")
+	codeHash := deployWithinOverlay(t, roAccDb, code)
+	require.Equal(t, (&mock.HasherMock{}).Compute(string(code)), codeHash)
+	require.Equal(t, code, roAccDb.GetCode(codeHash))
+	require.Equal(t, []byte("original code"), roAccDb.GetCode([]byte("originalCodeHash")))
+
+	roAccDb.StopOverlay()
+	require.Nil(t, roAccDb.GetCode(codeHash))
+}
+
+func TestReadOnlyAccountsDB_OverlayRevertToSnapshotShouldDropTheDeployedCode(t *testing.T) {
+	t.Parallel()
+
+	roAccDb := createReadOnlyAccountsDBForOverlay(t)
+	roAccDb.StartOverlay()
+
+	firstCodeHash := deployWithinOverlay(t, roAccDb, []byte("first code"))
+	snapshot := roAccDb.JournalLen()
+	secondCodeHash := deployWithinOverlay(t, roAccDb, []byte("second code"))
+	require.Equal(t, []byte("second code"), roAccDb.GetCode(secondCodeHash))
+
+	err := roAccDb.RevertToSnapshot(snapshot)
+	require.Nil(t, err)
+	require.Nil(t, roAccDb.GetCode(secondCodeHash))
+	require.Equal(t, []byte("first code"), roAccDb.GetCode(firstCodeHash))
+
+	contract, _ := roAccDb.GetExistingAccount(overlayReceiver)
+	require.Equal(t, firstCodeHash, contract.(state.UserAccountHandler).GetCodeHash())
+
+	err = roAccDb.RevertToSnapshot(0)
+	require.Nil(t, err)
+	require.Nil(t, roAccDb.GetCode(firstCodeHash))
+}
+
+func TestReadOnlyAccountsDB_GetOverlayStateDiffs(t *testing.T) {
+	t.Parallel()
+
+	roAccDb := createReadOnlyAccountsDBForOverlay(t)
+	addressConverter := mock.NewPubkeyConverterMock(32)
+
+	stateDiffs, err := roAccDb.GetOverlayStateDiffs(addressConverter)
+	require.Nil(t, err)
+	require.Empty(t, stateDiffs)
+
+	roAccDb.StartOverlay()
+	transferWithinOverlay(t, roAccDb, 30)
+
+	// saved, but unchanged
+	unchanged, _ := state.NewUserAccount([]byte("unchanged"))
+	require.Nil(t, roAccDb.SaveAccount(unchanged))
+
+	stateDiffs, err = roAccDb.GetOverlayStateDiffs(addressConverter)
+	require.Nil(t, err)
+	require.Len(t, stateDiffs, 2)
+
+	receiverDiff := stateDiffs[0]
+	require.Equal(t, addressConverter.Encode(overlayReceiver), receiverDiff.Address)
+	require.Equal(t, "0", receiverDiff.BalanceBefore)
+	require.Equal(t, "30", receiverDiff.BalanceAfter)
+	require.Equal(t, "30", receiverDiff.BalanceDelta)
+	require.Equal(t, uint64(0), receiverDiff.NonceAfter)
+	require.Len(t, receiverDiff.StorageChanges, 1)
+	require.Equal(t, hex.EncodeToString([]byte("key")), receiverDiff.StorageChanges[0].Key)
+	require.Equal(t, "", receiverDiff.StorageChanges[0].ValueBefore)
+	require.Equal(t, hex.EncodeToString([]byte("value")), receiverDiff.StorageChanges[0].ValueAfter)
+
+	senderDiff := stateDiffs[1]
+	require.Equal(t, addressConverter.Encode(overlaySender), senderDiff.Address)
+	require.Equal(t, "100", senderDiff.BalanceBefore)
+	require.Equal(t, "70", senderDiff.BalanceAfter)
+	require.Equal(t, "-30", senderDiff.BalanceDelta)
+	require.Equal(t, uint64(0), senderDiff.NonceBefore)
+	require.Equal(t, uint64(1), senderDiff.NonceAfter)
+	require.Empty(t, senderDiff.StorageChanges)
+}
+
+func TestReadOnlyAccountsDB_StartOverlayAtRootHashShouldUseThePastState(t *testing.T) {
+	t.Parallel()
+
+	roAccDb := createReadOnlyAccountsDBForOverlay(t)
+	err := roAccDb.StartOverlayAtRootHash([]byte("pastRoot"))
+	require.Nil(t, err)
+
+	sender, err := roAccDb.GetExistingAccount(overlaySender)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(60), sender.(state.UserAccountHandler).GetBalance())
+
+	_, err = roAccDb.GetExistingAccount(overlayReceiver)
+	require.Equal(t, state.ErrAccNotFound, err)
+
+	transferWithinOverlay(t, roAccDb, 30)
+
+	stateDiffs, err := roAccDb.GetOverlayStateDiffs(mock.NewPubkeyConverterMock(32))
+	require.Nil(t, err)
+	require.Len(t, stateDiffs, 2)
+	require.Equal(t, "60", stateDiffs[1].BalanceBefore)
+	require.Equal(t, "30", stateDiffs[1].BalanceAfter)
+
+	roAccDb.StopOverlay()
+
+	sender, err = roAccDb.GetExistingAccount(overlaySender)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(100), sender.(state.UserAccountHandler).GetBalance())
+}
+
+func TestReadOnlyAccountsDB_StartOverlayAtRootHashUnknownRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	roAccDb := createReadOnlyAccountsDBForOverlay(t)
+	err := roAccDb.StartOverlayAtRootHash([]byte("unknownRoot"))
+	require.True(t, errors.Is(err, ErrStateNotAvailable))
+
+	// the overlay was not started
+	account, _ := state.NewUserAccount(overlayReceiver)
+	require.Nil(t, roAccDb.SaveAccount(account))
+	_, err = roAccDb.GetExistingAccount(overlayReceiver)
+	require.Equal(t, state.ErrAccNotFound, err)
+}
+
+func TestReadOnlyAccountsDB_ResetOverlayStateDiffsShouldReportOnlyTheFollowingChanges(t *testing.T) {
+	t.Parallel()
+
+	roAccDb := createReadOnlyAccountsDBForOverlay(t)
+	addressConverter := mock.NewPubkeyConverterMock(32)
+	roAccDb.StartOverlay()
+	transferWithinOverlay(t, roAccDb, 30)
+
+	roAccDb.ResetOverlayStateDiffs()
+	stateDiffs, err := roAccDb.GetOverlayStateDiffs(addressConverter)
+	require.Nil(t, err)
+	require.Empty(t, stateDiffs)
+
+	sender, _ := roAccDb.LoadAccount(overlaySender)
+	senderAccount := sender.(state.UserAccountHandler)
+	require.Nil(t, senderAccount.SubFromBalance(big.NewInt(20)))
+	require.Nil(t, roAccDb.SaveAccount(senderAccount))
+
+	stateDiffs, err = roAccDb.GetOverlayStateDiffs(addressConverter)
+	require.Nil(t, err)
+	require.Len(t, stateDiffs, 1)
+	require.Equal(t, addressConverter.Encode(overlaySender), stateDiffs[0].Address)
+	require.Equal(t, "70", stateDiffs[0].BalanceBefore)
+	require.Equal(t, "50", stateDiffs[0].BalanceAfter)
+	require.Equal(t, uint64(1), stateDiffs[0].NonceBefore)
+}
+
+func TestReadOnlyAccountsDB_GetOverlayStateDiffsShouldDecodeESDTBalances(t *testing.T) {
+	t.Parallel()
+
+	roAccDb := createReadOnlyAccountsDBForOverlay(t)
+	marshalizer := &mock.MarshalizerMock{}
+	esdtPrefix := core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier
+	fungibleKey := []byte(esdtPrefix + "TKN-abcdef")
+	nftKey := append([]byte(esdtPrefix+"NFT-123456"), big.NewInt(10).Bytes()...)
+	roAccDb.StartOverlay()
+
+	receiver, _ := roAccDb.LoadAccount(overlayReceiver)
+	receiverAccount := receiver.(state.UserAccountHandler)
+	fungibleBytes, _ := marshalizer.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(25)})
+	require.Nil(t, receiverAccount.DataTrieTracker().SaveKeyValue(fungibleKey, fungibleBytes))
+	nftBytes, _ := marshalizer.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(1)})
+	require.Nil(t, receiverAccount.DataTrieTracker().SaveKeyValue(nftKey, nftBytes))
+	require.Nil(t, receiverAccount.DataTrieTracker().SaveKeyValue([]byte("not an esdt"), []byte("value")))
+	require.Nil(t, roAccDb.SaveAccount(receiverAccount))
+
+	stateDiffs, err := roAccDb.GetOverlayStateDiffs(mock.NewPubkeyConverterMock(32))
+	require.Nil(t, err)
+	require.Len(t, stateDiffs, 1)
+	require.Len(t, stateDiffs[0].StorageChanges, 3)
+	require.Equal(t, "0", stateDiffs[0].BalanceDelta)
+
+	expectedESDTChanges := []*txSimData.ESDTBalanceDiff{
+		{
+			TokenIdentifier: "NFT-123456-0a",
+			BalanceBefore:   "0",
+			BalanceAfter:    "1",
+		},
+		{
+			TokenIdentifier: "TKN-abcdef",
+			BalanceBefore:   "0",
+			BalanceAfter:    "25",
+		},
+	}
+	require.Equal(t, expectedESDTChanges, stateDiffs[0].ESDTChanges)
+}
+
+func TestFormatESDTTokenIdentifier(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "TKN-abcdef", formatESDTTokenIdentifier([]byte("TKN-abcdef")))
+	require.Equal(t, "NFT-abcdef-01", formatESDTTokenIdentifier([]byte("NFT-abcdef\x01")))
+	require.Equal(t, "NOSEPARATOR", formatESDTTokenIdentifier([]byte("NOSEPARATOR")))
+	require.Equal(t, "TKN-abc", formatESDTTokenIdentifier([]byte("TKN-abc")))
+}
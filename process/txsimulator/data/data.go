@@ -2,15 +2,80 @@ package data
 
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
 // SimulationResults is the data transfer object which will hold results for simulation a transaction's execution
 type SimulationResults struct {
-	Status     transaction.TxStatus                           `json:"status,omitempty"`
-	FailReason string                                         `json:"failReason,omitempty"`
-	ScResults  map[string]*transaction.ApiSmartContractResult `json:"scResults,omitempty"`
-	Receipts   map[string]*transaction.ApiReceipt             `json:"receipts,omitempty"`
-	Hash       string                                         `json:"hash,omitempty"`
-	VMOutput   *vmcommon.VMOutput                             `json:"-"`
+	Status       transaction.TxStatus                           `json:"status,omitempty"`
+	FailReason   string                                         `json:"failReason,omitempty"`
+	ScResults    map[string]*transaction.ApiSmartContractResult `json:"scResults,omitempty"`
+	Receipts     map[string]*transaction.ApiReceipt             `json:"receipts,omitempty"`
+	Hash         string                                         `json:"hash,omitempty"`
+	StateChanges []*AccountStateDiff                            `json:"stateChanges,omitempty"`
+	Trace        []*tracing.CallFrame                           `json:"trace,omitempty"`
+	VMOutput     *vmcommon.VMOutput                             `json:"-"`
+
+	// SkippedPreviousExecutions is set on the results of a replayed transaction if smart contract results or rewards
+	// were executed in its block before it. Those are not re-executed, so the results might differ from the original
+	// execution
+	SkippedPreviousExecutions bool `json:"skippedPreviousExecutions,omitempty"`
+}
+
+// SimulationOptions holds the optional outputs requested when simulating a transaction
+type SimulationOptions struct {
+	WithStateChanges bool
+	WithTrace        bool
+}
+
+// ReplayData holds what is needed for re-executing an already executed transaction: the root hash of the state on top
+// of which its block was executed and the transactions executed in the same block before it. The flag tells whether
+// the block executed other kinds of transactions before it, which cannot be replayed
+type ReplayData struct {
+	RootHash                  []byte
+	PreviousTxs               []*transaction.Transaction
+	Tx                        *transaction.Transaction
+	SkippedPreviousExecutions bool
+}
+
+// BundleSimulationResults is the data transfer object which will hold the results of simulating an ordered list of
+// transactions over the same state
+type BundleSimulationResults struct {
+	Status     transaction.TxStatus         `json:"status"`
+	Results    []*BundleTxSimulationResults `json:"results"`
+	StateDiffs []*AccountStateDiff          `json:"stateDiffs"`
+}
+
+// BundleTxSimulationResults holds the results of a transaction simulated as part of a bundle
+type BundleTxSimulationResults struct {
+	*SimulationResults
+	FailedBecauseOfPreviousTxs bool `json:"failedBecauseOfPreviousTxs"`
+}
+
+// AccountStateDiff holds the cumulative changes brought to an account by the simulated transactions
+type AccountStateDiff struct {
+	Address        string              `json:"address"`
+	NonceBefore    uint64              `json:"nonceBefore"`
+	NonceAfter     uint64              `json:"nonceAfter"`
+	BalanceBefore  string              `json:"balanceBefore"`
+	BalanceAfter   string              `json:"balanceAfter"`
+	BalanceDelta   string              `json:"balanceDelta"`
+	ESDTChanges    []*ESDTBalanceDiff  `json:"esdtBalanceChanges,omitempty"`
+	StorageChanges []*StorageValueDiff `json:"storageChanges,omitempty"`
+}
+
+// ESDTBalanceDiff holds the balances of an ESDT token, before and after the simulated transactions. For the NFTs and
+// SFTs, the token identifier contains the hex encoded nonce
+type ESDTBalanceDiff struct {
+	TokenIdentifier string `json:"tokenIdentifier"`
+	BalanceBefore   string `json:"balanceBefore"`
+	BalanceAfter    string `json:"balanceAfter"`
+}
+
+// StorageValueDiff holds the hex encoded values of a changed account storage key
+type StorageValueDiff struct {
+	Key         string `json:"key"`
+	ValueBefore string `json:"valueBefore"`
+	ValueAfter  string `json:"valueAfter"`
 }
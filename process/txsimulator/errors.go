@@ -25,3 +25,24 @@ var ErrNilMarshalizer = errors.New("nil marshalizer provided")
 
 // ErrNilHasher signals that a nil hasher has been provided
 var ErrNilHasher = errors.New("nil hasher provided")
+
+// ErrNilAccountsOverlayHandler signals that a nil accounts overlay handler has been provided
+var ErrNilAccountsOverlayHandler = errors.New("nil accounts overlay handler")
+
+// ErrEmptyTxBundle signals that an empty bundle of transactions has been provided
+var ErrEmptyTxBundle = errors.New("empty bundle of transactions")
+
+// ErrTxBundleTooLarge signals that the provided bundle holds more transactions than allowed
+var ErrTxBundleTooLarge = errors.New("bundle of transactions too large")
+
+// ErrInvalidMaxTxBundleSize signals that an invalid maximum size of the bundles has been provided
+var ErrInvalidMaxTxBundleSize = errors.New("invalid maximum size of the bundles of transactions")
+
+// ErrNilExecutionTracer signals that a nil execution tracer has been provided
+var ErrNilExecutionTracer = errors.New("nil execution tracer")
+
+// ErrNilReplayData signals that nil replay data has been provided
+var ErrNilReplayData = errors.New("nil replay data")
+
+// ErrStateNotAvailable signals that the state requested for replaying a transaction is no longer available
+var ErrStateNotAvailable = errors.New("state not available")
@@ -1,7 +1,10 @@
 package txsimulator
 
 import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -10,3 +13,29 @@ type TransactionProcessor interface {
 	ProcessTransaction(transaction *transaction.Transaction) (vmcommon.ReturnCode, error)
 	IsInterfaceNil() bool
 }
+
+// AccountsOverlayHandler defines the operations of an accounts adapter able to keep in memory the state changes of
+// the simulated transactions
+type AccountsOverlayHandler interface {
+	StartOverlay()
+	StartOverlayAtRootHash(rootHash []byte) error
+	ResetOverlayStateDiffs()
+	StopOverlay()
+	GetOverlayStateDiffs(addressConverter core.PubkeyConverter) ([]*txSimData.AccountStateDiff, error)
+	IsInterfaceNil() bool
+}
+
+// ExecutionTraceHandler defines the operations of the component which records the call tree of the simulated
+// smart contract executions
+type ExecutionTraceHandler interface {
+	StartTrace()
+	StopTrace() []*tracing.CallFrame
+	IsInterfaceNil() bool
+}
+
+// accountWithNewCodeHandler defines the operations of an account whose code was changed but not yet saved
+type accountWithNewCodeHandler interface {
+	GetCode() []byte
+	HasNewCode() bool
+	SetCodeHash(codeHash []byte)
+}
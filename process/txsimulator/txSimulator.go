@@ -2,6 +2,8 @@ package txsimulator
 
 import (
 	"encoding/hex"
+	"fmt"
+	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
@@ -11,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -18,6 +21,8 @@ import (
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
+var log = logger.GetOrCreate("process/txsimulator")
+
 // ArgsTxSimulator holds the arguments required for creating a new transaction simulator
 type ArgsTxSimulator struct {
 	TransactionProcessor      TransactionProcessor
@@ -27,6 +32,9 @@ type ArgsTxSimulator struct {
 	VMOutputCacher            storage.Cacher
 	Hasher                    hashing.Hasher
 	Marshalizer               marshal.Marshalizer
+	AccountsOverlay           AccountsOverlayHandler
+	ExecutionTracer           ExecutionTraceHandler
+	MaxTxBundleSize           uint32
 }
 
 type transactionSimulator struct {
@@ -37,6 +45,10 @@ type transactionSimulator struct {
 	vmOutputCacher         storage.Cacher
 	hasher                 hashing.Hasher
 	marshalizer            marshal.Marshalizer
+	accountsOverlay        AccountsOverlayHandler
+	executionTracer        ExecutionTraceHandler
+	maxTxBundleSize        int
+	mutOperation           sync.Mutex
 }
 
 // NewTransactionSimulator returns a new instance of a transactionSimulator
@@ -62,6 +74,15 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 	if check.IfNil(args.Hasher) {
 		return nil, ErrNilHasher
 	}
+	if check.IfNil(args.AccountsOverlay) {
+		return nil, ErrNilAccountsOverlayHandler
+	}
+	if check.IfNil(args.ExecutionTracer) {
+		return nil, ErrNilExecutionTracer
+	}
+	if args.MaxTxBundleSize == 0 {
+		return nil, ErrInvalidMaxTxBundleSize
+	}
 
 	return &transactionSimulator{
 		txProcessor:            args.TransactionProcessor,
@@ -71,11 +92,172 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 		vmOutputCacher:         args.VMOutputCacher,
 		marshalizer:            args.Marshalizer,
 		hasher:                 args.Hasher,
+		accountsOverlay:        args.AccountsOverlay,
+		executionTracer:        args.ExecutionTracer,
+		maxTxBundleSize:        int(args.MaxTxBundleSize),
 	}, nil
 }
 
 // ProcessTx will process the transaction in a special environment, where state-writing is not allowed
 func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+	ts.mutOperation.Lock()
+	defer ts.mutOperation.Unlock()
+
+	return ts.processTx(tx)
+}
+
+// ProcessTxWithOptions will process the provided transaction in a special environment, where state-writing is not
+// allowed, and will return the results along with the requested optional outputs: the changes brought to the state of
+// each affected account and the call tree of the smart contract executions
+func (ts *transactionSimulator) ProcessTxWithOptions(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+	ts.mutOperation.Lock()
+	defer ts.mutOperation.Unlock()
+
+	if !options.WithStateChanges {
+		return ts.processTxWithTrace(tx, options.WithTrace)
+	}
+
+	ts.accountsOverlay.StartOverlay()
+	defer ts.accountsOverlay.StopOverlay()
+
+	return ts.processTxOverOverlay(tx, options)
+}
+
+// ReplayTx will re-execute an already executed transaction on top of the state its block was executed on, after
+// re-executing the transactions preceding it in the same block. The results hold the call tree of the smart contract
+// executions and the state changes of the replayed transaction
+func (ts *transactionSimulator) ReplayTx(replayData *txSimData.ReplayData) (*txSimData.SimulationResults, error) {
+	if replayData == nil || replayData.Tx == nil {
+		return nil, ErrNilReplayData
+	}
+
+	ts.mutOperation.Lock()
+	defer ts.mutOperation.Unlock()
+
+	err := ts.accountsOverlay.StartOverlayAtRootHash(replayData.RootHash)
+	if err != nil {
+		return nil, err
+	}
+	defer ts.accountsOverlay.StopOverlay()
+
+	for _, previousTx := range replayData.PreviousTxs {
+		_, err = ts.processTx(previousTx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// only the changes brought by the replayed transaction are reported
+	ts.accountsOverlay.ResetOverlayStateDiffs()
+
+	results, err := ts.processTxOverOverlay(replayData.Tx, txSimData.SimulationOptions{WithStateChanges: true, WithTrace: true})
+	if err != nil {
+		return nil, err
+	}
+	results.SkippedPreviousExecutions = replayData.SkippedPreviousExecutions
+
+	return results, nil
+}
+
+func (ts *transactionSimulator) processTxOverOverlay(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+	results, err := ts.processTxWithTrace(tx, options.WithTrace)
+	if err != nil {
+		return nil, err
+	}
+
+	results.StateChanges, err = ts.accountsOverlay.GetOverlayStateDiffs(ts.addressPubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (ts *transactionSimulator) processTxWithTrace(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResults, error) {
+	if !withTrace {
+		return ts.processTx(tx)
+	}
+
+	ts.executionTracer.StartTrace()
+	results, err := ts.processTx(tx)
+	trace := ts.executionTracer.StopTrace()
+	if err != nil {
+		return nil, err
+	}
+
+	results.Trace = trace
+
+	return results, nil
+}
+
+// ProcessTxBundle will process the transactions, in the provided order, in a special environment where state-writing
+// is not allowed. Each transaction sees the state changes brought by the previous ones. A failed transaction is
+// simulated once more against the current state in order to tell if it failed because of the previous transactions
+func (ts *transactionSimulator) ProcessTxBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	if len(txs) == 0 {
+		return nil, ErrEmptyTxBundle
+	}
+	if len(txs) > ts.maxTxBundleSize {
+		return nil, fmt.Errorf("%w, provided: %d, maximum: %d", ErrTxBundleTooLarge, len(txs), ts.maxTxBundleSize)
+	}
+
+	ts.mutOperation.Lock()
+	defer ts.mutOperation.Unlock()
+
+	bundleResults, err := ts.processTxBundleOverOverlay(txs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, txResults := range bundleResults.Results {
+		if txResults.Status == transaction.TxStatusSuccess {
+			continue
+		}
+
+		bundleResults.Status = transaction.TxStatusFail
+		if i == 0 {
+			continue
+		}
+
+		standaloneResults, errProcess := ts.processTx(txs[i])
+		if errProcess != nil {
+			return nil, errProcess
+		}
+		txResults.FailedBecauseOfPreviousTxs = standaloneResults.Status == transaction.TxStatusSuccess
+	}
+
+	return bundleResults, nil
+}
+
+func (ts *transactionSimulator) processTxBundleOverOverlay(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	ts.accountsOverlay.StartOverlay()
+	defer ts.accountsOverlay.StopOverlay()
+
+	bundleResults := &txSimData.BundleSimulationResults{
+		Status:  transaction.TxStatusSuccess,
+		Results: make([]*txSimData.BundleTxSimulationResults, 0, len(txs)),
+	}
+	for _, tx := range txs {
+		txResults, err := ts.processTx(tx)
+		if err != nil {
+			return nil, err
+		}
+
+		bundleResults.Results = append(bundleResults.Results, &txSimData.BundleTxSimulationResults{
+			SimulationResults: txResults,
+		})
+	}
+
+	stateDiffs, err := ts.accountsOverlay.GetOverlayStateDiffs(ts.addressPubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+	bundleResults.StateDiffs = stateDiffs
+
+	return bundleResults, nil
+}
+
+func (ts *transactionSimulator) processTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
 	txStatus := transaction.TxStatusPending
 	failReason := ""
 
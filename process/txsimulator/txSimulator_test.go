@@ -13,6 +13,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/tracing"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
@@ -89,6 +92,33 @@ func TestNewTransactionSimulator(t *testing.T) {
 			},
 			exError: ErrNilCacher,
 		},
+		{
+			name: "NilAccountsOverlay",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.AccountsOverlay = nil
+				return args
+			},
+			exError: ErrNilAccountsOverlayHandler,
+		},
+		{
+			name: "NilExecutionTracer",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.ExecutionTracer = nil
+				return args
+			},
+			exError: ErrNilExecutionTracer,
+		},
+		{
+			name: "InvalidMaxTxBundleSize",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.MaxTxBundleSize = 0
+				return args
+			},
+			exError: ErrInvalidMaxTxBundleSize,
+		},
 		{
 			name: "Ok",
 			argsFunc: func() ArgsTxSimulator {
@@ -216,6 +246,368 @@ func TestTransactionSimulator_ProcessTxShouldIncludeScrsAndReceipts(t *testing.T
 	)
 }
 
+func TestTransactionSimulator_ProcessTxWithOptionsStateChangesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedStateChanges := []*txSimData.AccountStateDiff{{Address: "addr", BalanceDelta: "-10"}}
+	overlayStarted := false
+	args := getTxSimulatorArgs()
+	args.AccountsOverlay = &mock.AccountsOverlayHandlerStub{
+		StartOverlayCalled: func() {
+			overlayStarted = true
+		},
+		StopOverlayCalled: func() {
+			overlayStarted = false
+		},
+		GetOverlayStateDiffsCalled: func(_ core.PubkeyConverter) ([]*txSimData.AccountStateDiff, error) {
+			require.True(t, overlayStarted)
+			return expectedStateChanges, nil
+		},
+	}
+	args.TransactionProcessor = &testscommon.TxProcessorStub{
+		ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			require.True(t, overlayStarted)
+			return vmcommon.Ok, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTxWithOptions(&transaction.Transaction{Nonce: 37}, txSimData.SimulationOptions{WithStateChanges: true})
+	require.NoError(t, err)
+	require.False(t, overlayStarted)
+	require.Equal(t, transaction.TxStatusSuccess, results.Status)
+	require.Equal(t, expectedStateChanges, results.StateChanges)
+	require.Nil(t, results.Trace)
+}
+
+func TestTransactionSimulator_ProcessTxWithOptionsStateDiffsErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expErr := errors.New("expected error")
+	args := getTxSimulatorArgs()
+	args.AccountsOverlay = &mock.AccountsOverlayHandlerStub{
+		GetOverlayStateDiffsCalled: func(_ core.PubkeyConverter) ([]*txSimData.AccountStateDiff, error) {
+			return nil, expErr
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTxWithOptions(&transaction.Transaction{Nonce: 37}, txSimData.SimulationOptions{WithStateChanges: true})
+	require.Nil(t, results)
+	require.Equal(t, expErr, err)
+}
+
+func TestTransactionSimulator_ProcessTxWithOptionsTraceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedTrace := []*tracing.CallFrame{{Type: tracing.FrameTypeCall, Function: "add"}}
+	isTracing := false
+	args := getTxSimulatorArgs()
+	args.AccountsOverlay = &mock.AccountsOverlayHandlerStub{
+		StartOverlayCalled: func() {
+			require.Fail(t, "the overlay should not be started without the state changes option")
+		},
+	}
+	args.ExecutionTracer = &mock.ExecutionTraceHandlerStub{
+		StartTraceCalled: func() {
+			isTracing = true
+		},
+		StopTraceCalled: func() []*tracing.CallFrame {
+			isTracing = false
+			return expectedTrace
+		},
+	}
+	args.TransactionProcessor = &testscommon.TxProcessorStub{
+		ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			require.True(t, isTracing)
+			return vmcommon.Ok, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTxWithOptions(&transaction.Transaction{Nonce: 37}, txSimData.SimulationOptions{WithTrace: true})
+	require.NoError(t, err)
+	require.False(t, isTracing)
+	require.Equal(t, expectedTrace, results.Trace)
+	require.Nil(t, results.StateChanges)
+}
+
+func TestTransactionSimulator_ReplayTxNilReplayDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := NewTransactionSimulator(getTxSimulatorArgs())
+
+	results, err := ts.ReplayTx(nil)
+	require.Nil(t, results)
+	require.Equal(t, ErrNilReplayData, err)
+
+	results, err = ts.ReplayTx(&txSimData.ReplayData{RootHash: []byte("root")})
+	require.Nil(t, results)
+	require.Equal(t, ErrNilReplayData, err)
+}
+
+func TestTransactionSimulator_ReplayTxStateNotAvailableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getTxSimulatorArgs()
+	args.AccountsOverlay = &mock.AccountsOverlayHandlerStub{
+		StartOverlayAtRootHashCalled: func(_ []byte) error {
+			return ErrStateNotAvailable
+		},
+	}
+	args.TransactionProcessor = &testscommon.TxProcessorStub{
+		ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			require.Fail(t, "should have not been called")
+			return vmcommon.Ok, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ReplayTx(&txSimData.ReplayData{RootHash: []byte("root"), Tx: &transaction.Transaction{}})
+	require.Nil(t, results)
+	require.Equal(t, ErrStateNotAvailable, err)
+}
+
+func TestTransactionSimulator_ReplayTxShouldWork(t *testing.T) {
+	t.Parallel()
+
+	replayData := &txSimData.ReplayData{
+		RootHash:                  []byte("root"),
+		PreviousTxs:               []*transaction.Transaction{{Nonce: 1}, {Nonce: 2}},
+		Tx:                        &transaction.Transaction{Nonce: 3},
+		SkippedPreviousExecutions: true,
+	}
+	expectedTrace := []*tracing.CallFrame{{Type: tracing.FrameTypeCall, Function: "add"}}
+	expectedStateChanges := []*txSimData.AccountStateDiff{{Address: "addr", BalanceDelta: "-10"}}
+
+	startedRootHash := ""
+	stateDiffsReset := false
+	isTracing := false
+	processedNonces := make([]uint64, 0)
+	args := getTxSimulatorArgs()
+	args.AccountsOverlay = &mock.AccountsOverlayHandlerStub{
+		StartOverlayAtRootHashCalled: func(rootHash []byte) error {
+			startedRootHash = string(rootHash)
+			return nil
+		},
+		ResetOverlayStateDiffsCalled: func() {
+			require.Equal(t, []uint64{1, 2}, processedNonces)
+			stateDiffsReset = true
+		},
+		GetOverlayStateDiffsCalled: func(_ core.PubkeyConverter) ([]*txSimData.AccountStateDiff, error) {
+			return expectedStateChanges, nil
+		},
+	}
+	args.ExecutionTracer = &mock.ExecutionTraceHandlerStub{
+		StartTraceCalled: func() {
+			isTracing = true
+		},
+		StopTraceCalled: func() []*tracing.CallFrame {
+			isTracing = false
+			return expectedTrace
+		},
+	}
+	args.TransactionProcessor = &testscommon.TxProcessorStub{
+		ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			require.Equal(t, tx.Nonce == 3, isTracing)
+			processedNonces = append(processedNonces, tx.Nonce)
+			return vmcommon.Ok, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ReplayTx(replayData)
+	require.NoError(t, err)
+	require.Equal(t, "root", startedRootHash)
+	require.True(t, stateDiffsReset)
+	require.Equal(t, []uint64{1, 2, 3}, processedNonces)
+	require.Equal(t, transaction.TxStatusSuccess, results.Status)
+	require.Equal(t, expectedTrace, results.Trace)
+	require.Equal(t, expectedStateChanges, results.StateChanges)
+	require.True(t, results.SkippedPreviousExecutions)
+}
+
+func TestTransactionSimulator_ProcessTxBundleEmptyBundleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := NewTransactionSimulator(getTxSimulatorArgs())
+
+	results, err := ts.ProcessTxBundle(nil)
+	require.Nil(t, results)
+	require.Equal(t, ErrEmptyTxBundle, err)
+}
+
+func TestTransactionSimulator_ProcessTxBundleTooLargeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getTxSimulatorArgs()
+	args.MaxTxBundleSize = 2
+	args.TransactionProcessor = &testscommon.TxProcessorStub{
+		ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			require.Fail(t, "should have not processed the bundle")
+			return vmcommon.Ok, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTxBundle([]*transaction.Transaction{{Nonce: 1}, {Nonce: 2}, {Nonce: 3}})
+	require.Nil(t, results)
+	require.True(t, errors.Is(err, ErrTxBundleTooLarge))
+}
+
+func TestTransactionSimulator_ProcessTxBundleShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedStateDiffs := []*txSimData.AccountStateDiff{{Address: "addr", NonceBefore: 1, NonceAfter: 3}}
+	overlayStarted := false
+	args := getTxSimulatorArgs()
+	args.AccountsOverlay = &mock.AccountsOverlayHandlerStub{
+		StartOverlayCalled: func() {
+			overlayStarted = true
+		},
+		StopOverlayCalled: func() {
+			overlayStarted = false
+		},
+		GetOverlayStateDiffsCalled: func(_ core.PubkeyConverter) ([]*txSimData.AccountStateDiff, error) {
+			require.True(t, overlayStarted)
+			return expectedStateDiffs, nil
+		},
+	}
+	numProcessed := 0
+	args.TransactionProcessor = &testscommon.TxProcessorStub{
+		ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			require.True(t, overlayStarted)
+			numProcessed++
+			return vmcommon.Ok, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTxBundle([]*transaction.Transaction{{Nonce: 1}, {Nonce: 2}})
+	require.NoError(t, err)
+	require.False(t, overlayStarted)
+	require.Equal(t, 2, numProcessed)
+	require.Equal(t, transaction.TxStatusSuccess, results.Status)
+	require.Len(t, results.Results, 2)
+	for _, txResults := range results.Results {
+		require.Equal(t, transaction.TxStatusSuccess, txResults.Status)
+		require.False(t, txResults.FailedBecauseOfPreviousTxs)
+	}
+	require.Equal(t, expectedStateDiffs, results.StateDiffs)
+}
+
+func TestTransactionSimulator_ProcessTxBundleShouldSignalFailuresCausedByPreviousTxs(t *testing.T) {
+	t.Parallel()
+
+	overlayStarted := false
+	args := getTxSimulatorArgs()
+	args.AccountsOverlay = &mock.AccountsOverlayHandlerStub{
+		StartOverlayCalled: func() {
+			overlayStarted = true
+		},
+		StopOverlayCalled: func() {
+			overlayStarted = false
+		},
+	}
+	expErr := errors.New("insufficient funds")
+	args.TransactionProcessor = &testscommon.TxProcessorStub{
+		ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			switch tx.Nonce {
+			case 2:
+				// fails only after the first transaction was applied
+				if overlayStarted {
+					return vmcommon.UserError, expErr
+				}
+			case 3:
+				// fails regardless of the previous transactions
+				return vmcommon.UserError, expErr
+			}
+
+			return vmcommon.Ok, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTxBundle([]*transaction.Transaction{{Nonce: 1}, {Nonce: 2}, {Nonce: 3}})
+	require.NoError(t, err)
+	require.Equal(t, transaction.TxStatusFail, results.Status)
+	require.Len(t, results.Results, 3)
+
+	require.Equal(t, transaction.TxStatusSuccess, results.Results[0].Status)
+	require.False(t, results.Results[0].FailedBecauseOfPreviousTxs)
+
+	require.Equal(t, transaction.TxStatusFail, results.Results[1].Status)
+	require.Equal(t, expErr.Error(), results.Results[1].FailReason)
+	require.True(t, results.Results[1].FailedBecauseOfPreviousTxs)
+
+	require.Equal(t, transaction.TxStatusFail, results.Results[2].Status)
+	require.False(t, results.Results[2].FailedBecauseOfPreviousTxs)
+}
+
+func TestTransactionSimulator_ProcessTxBundleShouldCallTheContractDeployedByAPreviousTx(t *testing.T) {
+	t.Parallel()
+
+	roAccDb := createReadOnlyAccountsDBForOverlay(t)
+	errContractNotFound := errors.New("contract not found")
+	args := getTxSimulatorArgs()
+	args.AccountsOverlay = roAccDb
+	args.TransactionProcessor = &testscommon.TxProcessorStub{
+		ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			contract, err := roAccDb.LoadAccount(tx.RcvAddr)
+			if err != nil {
+				return vmcommon.UserError, err
+			}
+			contractAccount := contract.(state.UserAccountHandler)
+
+			if len(tx.Data) > 0 {
+				// deploy
+				contractAccount.SetCode(tx.Data)
+				return vmcommon.Ok, roAccDb.SaveAccount(contractAccount)
+			}
+
+			// call
+			code := roAccDb.GetCode(contractAccount.GetCodeHash())
+			if len(code) == 0 {
+				return vmcommon.ContractNotFound, errContractNotFound
+			}
+
+			return vmcommon.Ok, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTxBundle([]*transaction.Transaction{
+		{Nonce: 1, RcvAddr: overlayReceiver, Data: []byte("contract code")},
+		{Nonce: 2, RcvAddr: overlayReceiver},
+	})
+	require.NoError(t, err)
+	require.Equal(t, transaction.TxStatusSuccess, results.Status)
+	require.Equal(t, transaction.TxStatusSuccess, results.Results[1].Status)
+
+	// outside the bundle, the contract does not exist
+	results, err = ts.ProcessTxBundle([]*transaction.Transaction{{Nonce: 2, RcvAddr: overlayReceiver}})
+	require.NoError(t, err)
+	require.Equal(t, transaction.TxStatusFail, results.Status)
+	require.Equal(t, errContractNotFound.Error(), results.Results[0].FailReason)
+}
+
+func TestTransactionSimulator_ProcessTxBundleStateDiffsErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expErr := errors.New("expected error")
+	args := getTxSimulatorArgs()
+	args.AccountsOverlay = &mock.AccountsOverlayHandlerStub{
+		GetOverlayStateDiffsCalled: func(_ core.PubkeyConverter) ([]*txSimData.AccountStateDiff, error) {
+			return nil, expErr
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTxBundle([]*transaction.Transaction{{Nonce: 1}})
+	require.Nil(t, results)
+	require.Equal(t, expErr, err)
+}
+
 func getTxSimulatorArgs() ArgsTxSimulator {
 	return ArgsTxSimulator{
 		TransactionProcessor:      &testscommon.TxProcessorStub{},
@@ -225,5 +617,8 @@ func getTxSimulatorArgs() ArgsTxSimulator {
 		VMOutputCacher:            txcache.NewDisabledCache(),
 		Marshalizer:               &mock.MarshalizerMock{},
 		Hasher:                    &mock.HasherMock{},
+		AccountsOverlay:           &mock.AccountsOverlayHandlerStub{},
+		ExecutionTracer:           &mock.ExecutionTraceHandlerStub{},
+		MaxTxBundleSize:           10,
 	}
 }
@@ -1,35 +1,75 @@
 package txsimulator
 
 import (
+	"sync"
+
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
-// readOnlyAccountsDB is a wrapper over an accounts db which works read-only. write operation are disabled
+// readOnlyAccountsDB is a wrapper over an accounts db which works read-only. write operation are disabled. While an
+// overlay is started, the saved accounts are kept in memory so that the following operations see them
 type readOnlyAccountsDB struct {
 	originalAccounts state.AccountsAdapter
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	mutOverlay       sync.RWMutex
+	overlay          *accountsOverlay
 }
 
 // NewReadOnlyAccountsDB returns a new instance of readOnlyAccountsDB
-func NewReadOnlyAccountsDB(accountsDB state.AccountsAdapter) (*readOnlyAccountsDB, error) {
+func NewReadOnlyAccountsDB(
+	accountsDB state.AccountsAdapter,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (*readOnlyAccountsDB, error) {
 	if check.IfNil(accountsDB) {
 		return nil, ErrNilAccountsAdapter
 	}
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
 
-	return &readOnlyAccountsDB{originalAccounts: accountsDB}, nil
+	return &readOnlyAccountsDB{
+		originalAccounts: accountsDB,
+		marshalizer:      marshalizer,
+		hasher:           hasher,
+	}, nil
 }
 
-// GetCode returns the code for the given account
+// GetCode returns the code deployed in the overlay, if any, otherwise it will call the original accounts' function
+// with the same name
 func (r *readOnlyAccountsDB) GetCode(codeHash []byte) []byte {
+	r.mutOverlay.RLock()
+	if r.overlay != nil {
+		code, found := r.overlay.getCode(codeHash)
+		if found {
+			r.mutOverlay.RUnlock()
+			return code
+		}
+	}
+	r.mutOverlay.RUnlock()
+
 	return r.originalAccounts.GetCode(codeHash)
 }
 
-// GetExistingAccount will call the original accounts' function with the same name
+// GetExistingAccount will return the account from the overlay, if any, otherwise it will call the original accounts'
+// function with the same name
 func (r *readOnlyAccountsDB) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
-	return r.originalAccounts.GetExistingAccount(address)
+	account, found, err := r.getOverlayAccount(address)
+	if found {
+		return account, err
+	}
+
+	return r.getExistingBaseAccount(address)
 }
 
 // GetAccountFromBytes will call the original accounts' function with the same name
@@ -37,14 +77,47 @@ func (r *readOnlyAccountsDB) GetAccountFromBytes(address []byte, accountBytes []
 	return r.originalAccounts.GetAccountFromBytes(address, accountBytes)
 }
 
-// LoadAccount will call the original accounts' function with the same name
+// LoadAccount will return the account from the overlay, if any, otherwise it will call the original accounts' function
+// with the same name
 func (r *readOnlyAccountsDB) LoadAccount(address []byte) (vmcommon.AccountHandler, error) {
-	return r.originalAccounts.LoadAccount(address)
+	account, found, err := r.getOverlayAccount(address)
+	if found {
+		return account, err
+	}
+	if !r.isOverlayOnPastState() {
+		return r.originalAccounts.LoadAccount(address)
+	}
+
+	account, err = r.getExistingBaseAccount(address)
+	if err == state.ErrAccNotFound {
+		return state.NewUserAccount(address)
+	}
+
+	return account, err
 }
 
-// SaveAccount won't do anything as write operations are disabled on this component
-func (r *readOnlyAccountsDB) SaveAccount(_ vmcommon.AccountHandler) error {
-	return nil
+func (r *readOnlyAccountsDB) isOverlayOnPastState() bool {
+	r.mutOverlay.RLock()
+	defer r.mutOverlay.RUnlock()
+
+	return r.overlay != nil && !check.IfNil(r.overlay.baseTrie)
+}
+
+// SaveAccount will keep the account in the overlay, if started. Otherwise, it won't do anything as write operations are
+// disabled on this component
+func (r *readOnlyAccountsDB) SaveAccount(account vmcommon.AccountHandler) error {
+	if check.IfNil(account) {
+		return nil
+	}
+
+	r.mutOverlay.Lock()
+	defer r.mutOverlay.Unlock()
+
+	if r.overlay == nil {
+		return nil
+	}
+
+	return r.overlay.saveAccount(account, r.marshalizer, r.hasher)
 }
 
 // RemoveAccount won't do anything as write operations are disabled on this component
@@ -57,14 +130,30 @@ func (r *readOnlyAccountsDB) Commit() ([]byte, error) {
 	return nil, nil
 }
 
-// JournalLen will call the original accounts' function with the same name
+// JournalLen will return the number of accounts saved in the overlay, if started, otherwise it will call the original
+// accounts' function with the same name
 func (r *readOnlyAccountsDB) JournalLen() int {
+	r.mutOverlay.RLock()
+	defer r.mutOverlay.RUnlock()
+
+	if r.overlay != nil {
+		return len(r.overlay.journal)
+	}
+
 	return r.originalAccounts.JournalLen()
 }
 
-// RevertToSnapshot won't do anything as write operations are disabled on this component
-func (r *readOnlyAccountsDB) RevertToSnapshot(_ int) error {
-	return nil
+// RevertToSnapshot will revert the accounts saved in the overlay, if started. Otherwise, it won't do anything as write
+// operations are disabled on this component
+func (r *readOnlyAccountsDB) RevertToSnapshot(snapshot int) error {
+	r.mutOverlay.Lock()
+	defer r.mutOverlay.Unlock()
+
+	if r.overlay == nil {
+		return nil
+	}
+
+	return r.overlay.revertToSnapshot(snapshot)
 }
 
 // GetNumCheckpoints will call the original accounts' function with the same name
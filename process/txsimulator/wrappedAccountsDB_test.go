@@ -16,15 +16,31 @@ import (
 func TestNewReadOnlyAccountsDB_NilOriginalAccountsDBShouldErr(t *testing.T) {
 	t.Parallel()
 
-	roAccDb, err := NewReadOnlyAccountsDB(nil)
+	roAccDb, err := NewReadOnlyAccountsDB(nil, &mock.MarshalizerMock{}, &mock.HasherMock{})
 	require.True(t, check.IfNil(roAccDb))
 	require.Equal(t, ErrNilAccountsAdapter, err)
 }
 
+func TestNewReadOnlyAccountsDB_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	roAccDb, err := NewReadOnlyAccountsDB(&stateMock.AccountsStub{}, nil, &mock.HasherMock{})
+	require.True(t, check.IfNil(roAccDb))
+	require.Equal(t, ErrNilMarshalizer, err)
+}
+
+func TestNewReadOnlyAccountsDB_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	roAccDb, err := NewReadOnlyAccountsDB(&stateMock.AccountsStub{}, &mock.MarshalizerMock{}, nil)
+	require.True(t, check.IfNil(roAccDb))
+	require.Equal(t, ErrNilHasher, err)
+}
+
 func TestNewReadOnlyAccountsDB(t *testing.T) {
 	t.Parallel()
 
-	roAccDb, err := NewReadOnlyAccountsDB(&stateMock.AccountsStub{})
+	roAccDb, err := NewReadOnlyAccountsDB(&stateMock.AccountsStub{}, &mock.MarshalizerMock{}, &mock.HasherMock{})
 	require.False(t, check.IfNil(roAccDb))
 	require.NoError(t, err)
 }
@@ -72,7 +88,7 @@ func TestReadOnlyAccountsDB_WriteOperationsShouldNotCalled(t *testing.T) {
 		},
 	}
 
-	roAccDb, _ := NewReadOnlyAccountsDB(accDb)
+	roAccDb, _ := NewReadOnlyAccountsDB(accDb, &mock.MarshalizerMock{}, &mock.HasherMock{})
 	require.NotNil(t, roAccDb)
 
 	err := roAccDb.SaveAccount(nil)
@@ -135,7 +151,7 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 		},
 	}
 
-	roAccDb, _ := NewReadOnlyAccountsDB(accDb)
+	roAccDb, _ := NewReadOnlyAccountsDB(accDb, &mock.MarshalizerMock{}, &mock.HasherMock{})
 	require.NotNil(t, roAccDb)
 
 	actualAcc, err := roAccDb.GetExistingAccount(nil)
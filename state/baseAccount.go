@@ -53,6 +53,11 @@ func (ba *baseAccount) AccountDataHandler() vmcommon.AccountDataHandler {
 	return ba.dataTrieTracker
 }
 
+// GetCode returns the code set on the account which was not yet saved in the accounts database
+func (ba *baseAccount) GetCode() []byte {
+	return ba.code
+}
+
 // HasNewCode returns true if there was a code change for the account
 func (ba *baseAccount) HasNewCode() bool {
 	return ba.hasNewCode
@@ -124,3 +124,9 @@ var ErrNilStoragePruningManager = errors.New("nil storagePruningManager")
 
 // ErrInvalidKey is raised when the given key is invalid
 var ErrInvalidKey = errors.New("invalid key")
+
+// ErrNilSyncRequestsBudget signals that a nil sync requests budget was provided
+var ErrNilSyncRequestsBudget = errors.New("nil sync requests budget")
+
+// ErrNilMetricsHandler signals that a nil metrics handler was provided
+var ErrNilMetricsHandler = errors.New("nil metrics handler")
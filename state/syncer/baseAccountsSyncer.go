@@ -32,6 +32,8 @@ type baseAccountsSyncer struct {
 	name                      string
 	maxHardCapForMissingNodes int
 	trieSyncerVersion         int
+	requestsBudget            trie.SyncRequestsBudget
+	metricsHandler            common.MetricsHandler
 	numTriesSynced            int32
 	numMaxTries               int32
 }
@@ -49,6 +51,9 @@ type ArgsNewBaseAccountsSyncer struct {
 	MaxTrieLevelInMemory      uint
 	MaxHardCapForMissingNodes int
 	TrieSyncerVersion         int
+	// RequestsBudget is needed only by the trie syncer version which requests the missing nodes through a budget
+	RequestsBudget trie.SyncRequestsBudget
+	MetricsHandler common.MetricsHandler
 }
 
 func checkArgs(args ArgsNewBaseAccountsSyncer) error {
@@ -70,8 +75,18 @@ func checkArgs(args ArgsNewBaseAccountsSyncer) error {
 	if args.MaxHardCapForMissingNodes < 1 {
 		return state.ErrInvalidMaxHardCapForMissingNodes
 	}
+	if check.IfNil(args.MetricsHandler) {
+		return state.ErrNilMetricsHandler
+	}
+	err := trie.CheckTrieSyncerVersion(args.TrieSyncerVersion)
+	if err != nil {
+		return err
+	}
+	if trie.UsesRequestsBudget(args.TrieSyncerVersion) && check.IfNil(args.RequestsBudget) {
+		return state.ErrNilSyncRequestsBudget
+	}
 
-	return trie.CheckTrieSyncerVersion(args.TrieSyncerVersion)
+	return nil
 }
 
 func (b *baseAccountsSyncer) syncMainTrie(
@@ -79,6 +94,7 @@ func (b *baseAccountsSyncer) syncMainTrie(
 	trieTopic string,
 	ssh SyncStatisticsHandler,
 	ctx context.Context,
+	leafValueHandler func(value []byte),
 ) (common.Trie, error) {
 	b.rootHash = rootHash
 	atomic.AddInt32(&b.numMaxTries, 1)
@@ -101,6 +117,8 @@ func (b *baseAccountsSyncer) syncMainTrie(
 		TrieSyncStatistics:        ssh,
 		TimeoutHandler:            b.timeoutHandler,
 		MaxHardCapForMissingNodes: b.maxHardCapForMissingNodes,
+		RequestsBudget:            b.requestsBudget,
+		LeafValueHandler:          leafValueHandler,
 	}
 	trieSyncer, err := trie.CreateTrieSyncer(arg, b.trieSyncerVersion)
 	if err != nil {
@@ -135,13 +153,26 @@ func (b *baseAccountsSyncer) printStatistics(ssh SyncStatisticsHandler, ctx cont
 				"num received", ssh.NumReceived(),
 				"num large nodes", ssh.NumLarge(),
 				"num missing", ssh.NumMissing(),
+				"received per second", fmt.Sprintf("%.1f", ssh.ReceivedPerSecond()),
+				"estimated time left", estimatedTimeLeftString(ssh.EstimatedTimeLeft()),
 				"num tries", fmt.Sprintf("%d/%d", atomic.LoadInt32(&b.numTriesSynced), atomic.LoadInt32(&b.numMaxTries)),
+				"num in flight", b.requestsBudget.NumInFlight(),
+				"request batch size", b.requestsBudget.BatchSize(),
+				"request latency", b.requestsBudget.Latency(),
 				"intercepted trie nodes cache size", core.ConvertBytes(b.cacher.SizeInBytesContained()),
 				"num of intercepted trie nodes", b.cacher.Len())
 		}
 	}
 }
 
+func estimatedTimeLeftString(estimatedTimeLeft time.Duration) string {
+	if estimatedTimeLeft < 0 {
+		return "unknown"
+	}
+
+	return estimatedTimeLeft.Truncate(time.Second).String()
+}
+
 // Deprecated: GetSyncedTries returns the synced map of data trie. This is likely to case OOM exceptions
 //TODO remove this function after fixing the hardfork sync state mechanism
 func (b *baseAccountsSyncer) GetSyncedTries() map[string]common.Trie {
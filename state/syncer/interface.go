@@ -1,5 +1,7 @@
 package syncer
 
+import "time"
+
 // SyncStatisticsHandler defines the methods for a component able to store the sync statistics for a trie
 type SyncStatisticsHandler interface {
 	Reset()
@@ -9,5 +11,7 @@ type SyncStatisticsHandler interface {
 	NumReceived() int
 	NumLarge() int
 	NumMissing() int
+	ReceivedPerSecond() float64
+	EstimatedTimeLeft() time.Duration
 	IsInterfaceNil() bool
 }
@@ -0,0 +1,44 @@
+package syncer
+
+import (
+	"context"
+)
+
+// rootHashesQueue is a bounded FIFO queue of data tries root hashes. Pushing blocks while the queue is full, so the
+// trie syncer filling the queue with the leaves of the main trie waits for the data tries syncs to catch up
+type rootHashesQueue struct {
+	rootHashes chan []byte
+}
+
+func newRootHashesQueue(capacity int) *rootHashesQueue {
+	return &rootHashesQueue{
+		rootHashes: make(chan []byte, capacity),
+	}
+}
+
+// push waits until the root hash is queued. It returns false if the context is done in the meantime
+func (q *rootHashesQueue) push(ctx context.Context, rootHash []byte) bool {
+	select {
+	case q.rootHashes <- rootHash:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// close marks the end of the pushed root hashes. The ones already pushed can still be popped. It should be called
+// only after all the pushes ended
+func (q *rootHashesQueue) close() {
+	close(q.rootHashes)
+}
+
+// pop waits for a root hash and returns it. It returns false when the queue is closed and empty or when the context
+// is done
+func (q *rootHashesQueue) pop(ctx context.Context) ([]byte, bool) {
+	select {
+	case rootHash, ok := <-q.rootHashes:
+		return rootHash, ok
+	case <-ctx.Done():
+		return nil, false
+	}
+}
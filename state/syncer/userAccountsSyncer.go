@@ -25,6 +25,9 @@ var log = logger.GetOrCreate("syncer")
 
 const timeBetweenRetries = 100 * time.Millisecond
 
+// maxQueuedDataTries bounds the data tries found in the main trie and not yet scheduled for syncing
+const maxQueuedDataTries = 10000
+
 type userAccountsSyncer struct {
 	*baseAccountsSyncer
 	throttler   data.GoRoutineThrottler
@@ -68,6 +71,8 @@ func NewUserAccountsSyncer(args ArgsNewUserAccountsSyncer) (*userAccountsSyncer,
 		name:                      fmt.Sprintf("user accounts for shard %s", core.GetShardIDString(args.ShardId)),
 		maxHardCapForMissingNodes: args.MaxHardCapForMissingNodes,
 		trieSyncerVersion:         args.TrieSyncerVersion,
+		requestsBudget:            args.RequestsBudget,
+		metricsHandler:            args.MetricsHandler,
 	}
 
 	u := &userAccountsSyncer{
@@ -78,7 +83,10 @@ func NewUserAccountsSyncer(args ArgsNewUserAccountsSyncer) (*userAccountsSyncer,
 	return u, nil
 }
 
-// SyncAccounts will launch the syncing method to gather all the data needed for userAccounts - it is a blocking method
+// SyncAccounts will launch the syncing method to gather all the data needed for userAccounts - it is a blocking method.
+// The data tries are synced concurrently, starting while the main trie is still syncing if the trie syncer reports the
+// leaves as soon as they are received. The main trie sync waits while too many data tries are pending. The first data
+// trie sync error stops all the syncs and it is returned
 func (u *userAccountsSyncer) SyncAccounts(rootHash []byte) error {
 	u.mutex.Lock()
 	defer u.mutex.Unlock()
@@ -91,65 +99,74 @@ func (u *userAccountsSyncer) SyncAccounts(rootHash []byte) error {
 		cancel()
 	}()
 
-	tss := statistics.NewTrieSyncStatistics()
+	tss, err := statistics.NewTrieSyncStatistics(u.metricsHandler)
+	if err != nil {
+		return err
+	}
 	go u.printStatistics(tss, ctx)
 
-	mainTrie, err := u.syncMainTrie(rootHash, factory.AccountTrieNodesTopic, tss, ctx)
+	queue := newRootHashesQueue(maxQueuedDataTries)
+	chDataTriesErr := make(chan error, 1)
+	go func() {
+		chDataTriesErr <- u.syncQueuedDataTries(queue, tss, ctx, cancel)
+	}()
+
+	mainTrie, err := u.syncMainTrie(rootHash, factory.AccountTrieNodesTopic, tss, ctx, u.createLeafValueHandler(queue, ctx))
 	if err != nil {
-		return err
+		cancel()
+		queue.close()
+		return firstError(<-chDataTriesErr, err)
 	}
 
 	defer func() {
 		_ = mainTrie.Close()
 	}()
 
-	log.Debug("main trie synced, starting to sync data tries", "num data tries", len(u.dataTries))
-
-	return u.syncAccountDataTries(mainTrie, tss, ctx)
-}
+	log.Debug("main trie synced, syncing the remaining data tries", "num tries scheduled", atomic.LoadInt32(&u.numMaxTries))
 
-func (u *userAccountsSyncer) syncDataTrie(rootHash []byte, ssh data.SyncStatisticsHandler, ctx context.Context) error {
-	u.syncerMutex.Lock()
-	_, ok := u.dataTries[string(rootHash)]
-	if ok {
-		u.syncerMutex.Unlock()
-		return nil
+	err = u.queueAccountDataTries(mainTrie, queue, ctx)
+	if err != nil {
+		cancel()
 	}
+	queue.close()
 
-	u.dataTries[string(rootHash)] = struct{}{}
-	u.syncerMutex.Unlock()
+	return firstError(<-chDataTriesErr, err)
+}
 
-	arg := trie.ArgTrieSyncer{
-		RequestHandler:            u.requestHandler,
-		InterceptedNodes:          u.cacher,
-		DB:                        u.trieStorageManager.Database(),
-		Marshalizer:               u.marshalizer,
-		Hasher:                    u.hasher,
-		ShardId:                   u.shardId,
-		Topic:                     factory.AccountTrieNodesTopic,
-		TrieSyncStatistics:        ssh,
-		TimeoutHandler:            u.timeoutHandler,
-		MaxHardCapForMissingNodes: u.maxHardCapForMissingNodes,
+// firstError returns the data tries sync error, if any, as it caused the cancellation of the main trie sync
+func firstError(errDataTries error, errMainTrie error) error {
+	if errDataTries != nil {
+		return errDataTries
 	}
-	trieSyncer, err := trie.CreateTrieSyncer(arg, u.trieSyncerVersion)
-	if err != nil {
 
-		return err
+	return errMainTrie
+}
+
+// createLeafValueHandler returns the function called by the trie syncer with each synced account, so the account data
+// trie can be synced without waiting for the whole main trie. The handler blocks while the queue is full
+func (u *userAccountsSyncer) createLeafValueHandler(queue *rootHashesQueue, ctx context.Context) func(value []byte) {
+	return func(value []byte) {
+		rootHash, ok := u.getDataTrieRootHash(value)
+		if ok {
+			queue.push(ctx, rootHash)
+		}
 	}
+}
 
-	err = trieSyncer.StartSyncing(rootHash, ctx)
+func (u *userAccountsSyncer) getDataTrieRootHash(accountBytes []byte) ([]byte, bool) {
+	account := state.NewEmptyUserAccount()
+	err := u.marshalizer.Unmarshal(account, accountBytes)
 	if err != nil {
-		return err
+		log.Trace("this must be a leaf with code", "err", err)
+		return nil, false
 	}
 
-	return nil
+	return account.RootHash, len(account.RootHash) > 0
 }
 
-func (u *userAccountsSyncer) syncAccountDataTries(
-	mainTrie common.Trie,
-	ssh data.SyncStatisticsHandler,
-	ctx context.Context,
-) error {
+// queueAccountDataTries iterates the synced main trie and queues all the data tries, so none is missed if the trie
+// syncer did not report the leaves. The data tries already synced are skipped
+func (u *userAccountsSyncer) queueAccountDataTries(mainTrie common.Trie, queue *rootHashesQueue, ctx context.Context) error {
 	mainRootHash, err := mainTrie.RootHash()
 	if err != nil {
 		return err
@@ -160,27 +177,60 @@ func (u *userAccountsSyncer) syncAccountDataTries(
 		return err
 	}
 
-	var errFound error
-	errMutex := sync.Mutex{}
-	wg := sync.WaitGroup{}
-
 	for leaf := range leavesChannel {
 		u.resetTimeoutHandlerWatchdog()
 
-		account := state.NewEmptyUserAccount()
-		err = u.marshalizer.Unmarshal(account, leaf.Value())
-		if err != nil {
-			log.Trace("this must be a leaf with code", "err", err)
+		rootHash, ok := u.getDataTrieRootHash(leaf.Value())
+		if !ok {
 			continue
 		}
+		if !queue.push(ctx, rootHash) {
+			drainLeaves(leavesChannel)
+			return ctx.Err()
+		}
+	}
 
-		if len(account.RootHash) == 0 {
+	return nil
+}
+
+func drainLeaves(leavesChannel chan core.KeyValueHolder) {
+	for range leavesChannel {
+	}
+}
+
+// syncQueuedDataTries syncs, concurrently and within the go routines throttler limits, the data tries from the queue
+// until the queue is closed. The first error cancels the provided context, so all the syncs sharing it stop, and it
+// is the returned error
+func (u *userAccountsSyncer) syncQueuedDataTries(
+	queue *rootHashesQueue,
+	ssh data.SyncStatisticsHandler,
+	ctx context.Context,
+	cancel context.CancelFunc,
+) error {
+	var errFound error
+	errMutex := sync.Mutex{}
+	setError := func(err error) {
+		errMutex.Lock()
+		if errFound == nil {
+			errFound = err
+			cancel()
+		}
+		errMutex.Unlock()
+	}
+	wg := sync.WaitGroup{}
+
+	for {
+		rootHash, ok := queue.pop(ctx)
+		if !ok {
+			break
+		}
+		if !u.markDataTrie(rootHash) {
 			continue
 		}
 
-		err = u.checkGoRoutinesThrottler(ctx)
+		err := u.checkGoRoutinesThrottler(ctx)
 		if err != nil {
-			return err
+			break
 		}
 
 		u.throttler.StartProcessing()
@@ -193,14 +243,12 @@ func (u *userAccountsSyncer) syncAccountDataTries(
 			log.Trace("sync data trie", "roothash", trieRootHash)
 			newErr := u.syncDataTrie(trieRootHash, ssh, ctx)
 			if newErr != nil {
-				errMutex.Lock()
-				errFound = newErr
-				errMutex.Unlock()
+				setError(newErr)
 			}
 			atomic.AddInt32(&u.numTriesSynced, 1)
 			log.Trace("finished sync data trie", "roothash", trieRootHash)
 			wg.Done()
-		}(account.RootHash)
+		}(rootHash)
 	}
 
 	wg.Wait()
@@ -208,6 +256,43 @@ func (u *userAccountsSyncer) syncAccountDataTries(
 	return errFound
 }
 
+// markDataTrie returns false if the data trie was already scheduled for syncing
+func (u *userAccountsSyncer) markDataTrie(rootHash []byte) bool {
+	u.syncerMutex.Lock()
+	defer u.syncerMutex.Unlock()
+
+	_, ok := u.dataTries[string(rootHash)]
+	if ok {
+		return false
+	}
+
+	u.dataTries[string(rootHash)] = struct{}{}
+
+	return true
+}
+
+func (u *userAccountsSyncer) syncDataTrie(rootHash []byte, ssh data.SyncStatisticsHandler, ctx context.Context) error {
+	arg := trie.ArgTrieSyncer{
+		RequestHandler:            u.requestHandler,
+		InterceptedNodes:          u.cacher,
+		DB:                        u.trieStorageManager.Database(),
+		Marshalizer:               u.marshalizer,
+		Hasher:                    u.hasher,
+		ShardId:                   u.shardId,
+		Topic:                     factory.AccountTrieNodesTopic,
+		TrieSyncStatistics:        ssh,
+		TimeoutHandler:            u.timeoutHandler,
+		MaxHardCapForMissingNodes: u.maxHardCapForMissingNodes,
+		RequestsBudget:            u.requestsBudget,
+	}
+	trieSyncer, err := trie.CreateTrieSyncer(arg, u.trieSyncerVersion)
+	if err != nil {
+		return err
+	}
+
+	return trieSyncer.StartSyncing(rootHash, ctx)
+}
+
 func (u *userAccountsSyncer) checkGoRoutinesThrottler(ctx context.Context) error {
 	for {
 		if u.throttler.CanProcess() {
@@ -47,6 +47,8 @@ func NewValidatorAccountsSyncer(args ArgsNewValidatorAccountsSyncer) (*validator
 		name:                      "peer accounts",
 		maxHardCapForMissingNodes: args.MaxHardCapForMissingNodes,
 		trieSyncerVersion:         args.TrieSyncerVersion,
+		requestsBudget:            args.RequestsBudget,
+		metricsHandler:            args.MetricsHandler,
 	}
 
 	u := &validatorAccountsSyncer{
@@ -69,10 +71,13 @@ func (v *validatorAccountsSyncer) SyncAccounts(rootHash []byte) error {
 		cancel()
 	}()
 
-	tss := statistics.NewTrieSyncStatistics()
+	tss, err := statistics.NewTrieSyncStatistics(v.metricsHandler)
+	if err != nil {
+		return err
+	}
 	go v.printStatistics(tss, ctx)
 
-	_, err := v.syncMainTrie(rootHash, factory.ValidatorTrieNodesTopic, tss, ctx)
+	_, err = v.syncMainTrie(rootHash, factory.ValidatorTrieNodesTopic, tss, ctx, nil)
 
 	return err
 }
@@ -19,3 +19,6 @@ var ErrNilUint64Converter = errors.New("unit64converter is nil")
 
 // ErrNilStorage signals that a nil storage has been provided
 var ErrNilStorage = errors.New("nil storage")
+
+// ErrNilTypedMetricsGatherer signals that a nil typed metrics gatherer has been provided
+var ErrNilTypedMetricsGatherer = errors.New("nil typed metrics gatherer")
@@ -0,0 +1,66 @@
+package disabled
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+var _ common.MetricsHandler = (*MetricsHandler)(nil)
+
+// MetricsHandler is the disabled implementation of the metrics handler, which does not record anything
+type MetricsHandler struct {
+}
+
+// ObserveBlockOperation does nothing
+func (mh *MetricsHandler) ObserveBlockOperation(_ common.BlockOperation, _ time.Duration) {
+}
+
+// ObserveConsensusSubround does nothing
+func (mh *MetricsHandler) ObserveConsensusSubround(_ string, _ bool, _ time.Duration) {
+}
+
+// AddInterceptedMessage does nothing
+func (mh *MetricsHandler) AddInterceptedMessage(_ string, _ bool, _ int) {
+}
+
+// AddAntifloodRejection does nothing
+func (mh *MetricsHandler) AddAntifloodRejection(_ string, _ common.AntifloodReason) {
+}
+
+// AddTrieSyncReceivedNodes does nothing
+func (mh *MetricsHandler) AddTrieSyncReceivedNodes(_ int) {
+}
+
+// AddTrieSyncLargeNodes does nothing
+func (mh *MetricsHandler) AddTrieSyncLargeNodes(_ int) {
+}
+
+// AddTrieSyncMissingNodes does nothing
+func (mh *MetricsHandler) AddTrieSyncMissingNodes(_ int) {
+}
+
+// SetTrieSyncEstimatedTimeLeft does nothing
+func (mh *MetricsHandler) SetTrieSyncEstimatedTimeLeft(_ time.Duration) {
+}
+
+// AddStorageCacheRequest does nothing
+func (mh *MetricsHandler) AddStorageCacheRequest(_ string, _ bool) {
+}
+
+// SetOutportQueueSize does nothing
+func (mh *MetricsHandler) SetOutportQueueSize(_ string, _ uint64, _ uint64) {
+}
+
+// AddOutportQueueRejection does nothing
+func (mh *MetricsHandler) AddOutportQueueRejection(_ string) {
+}
+
+// SetShardID does nothing
+func (mh *MetricsHandler) SetShardID(_ uint32) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mh *MetricsHandler) IsInterfaceNil() bool {
+	return mh == nil
+}
@@ -0,0 +1,324 @@
+package metrics
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+const (
+	namespace = "elrond"
+
+	// ShardLabel is the label holding the shard the node was in when the value was recorded
+	ShardLabel = "shard"
+	// TopicLabel is the label holding the p2p topic
+	TopicLabel = "topic"
+
+	operationLabel = "operation"
+	subroundLabel  = "subround"
+	statusLabel    = "status"
+	reasonLabel    = "reason"
+	unitLabel      = "unit"
+	resultLabel    = "result"
+	driverLabel    = "driver"
+)
+
+const (
+	statusAccepted    = "accepted"
+	statusRejected    = "rejected"
+	statusDone        = "done"
+	statusExtended    = "extended"
+	resultHit         = "hit"
+	resultMiss        = "miss"
+	unknownShardLabel = "unknown"
+)
+
+var _ common.MetricsHandler = (*prometheusMetricsHandler)(nil)
+
+// prometheusMetricsHandler records the typed metrics of one node in its own prometheus registry. Every value is
+// labelled with the shard the node was in when the value was recorded
+type prometheusMetricsHandler struct {
+	registry *prometheus.Registry
+
+	mutShard sync.RWMutex
+	shard    string
+
+	blockProcessingDuration   *prometheus.HistogramVec
+	consensusSubroundDuration *prometheus.HistogramVec
+	interceptedMessages       *prometheus.CounterVec
+	interceptedBytes          *prometheus.CounterVec
+	antifloodRejections       *prometheus.CounterVec
+	trieSyncReceivedNodes     *prometheus.CounterVec
+	trieSyncLargeNodes        *prometheus.CounterVec
+	trieSyncMissingNodes      *prometheus.GaugeVec
+	trieSyncEstimatedTimeLeft *prometheus.GaugeVec
+	storageCacheRequests      *prometheus.CounterVec
+	outportQueueRecords       *prometheus.GaugeVec
+	outportQueueBytes         *prometheus.GaugeVec
+	outportQueueRejections    *prometheus.CounterVec
+}
+
+// NewPrometheusMetricsHandler creates a metrics handler backed by a new prometheus registry. Until SetShardID is
+// called, the values are labelled with an unknown shard
+func NewPrometheusMetricsHandler() (*prometheusMetricsHandler, error) {
+	pmh := &prometheusMetricsHandler{
+		registry: prometheus.NewRegistry(),
+		shard:    unknownShardLabel,
+		blockProcessingDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Subsystem: "block",
+				Name:      "processing_duration_seconds",
+				Help:      "Duration of the block processing operations",
+				Buckets:   prometheus.ExponentialBuckets(0.01, 2, 12),
+			},
+			[]string{ShardLabel, operationLabel},
+		),
+		consensusSubroundDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Subsystem: "consensus",
+				Name:      "subround_duration_seconds",
+				Help:      "Duration of the consensus subrounds, either done in time or extended",
+				Buckets:   prometheus.ExponentialBuckets(0.005, 2, 12),
+			},
+			[]string{ShardLabel, subroundLabel, statusLabel},
+		),
+		interceptedMessages: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "interceptor",
+				Name:      "messages_total",
+				Help:      "Number of messages received by the interceptors, either accepted or rejected",
+			},
+			[]string{ShardLabel, TopicLabel, statusLabel},
+		),
+		interceptedBytes: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "interceptor",
+				Name:      "received_bytes_total",
+				Help:      "Number of bytes received by the interceptors",
+			},
+			[]string{ShardLabel, TopicLabel},
+		),
+		antifloodRejections: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "antiflood",
+				Name:      "rejected_messages_total",
+				Help:      "Number of messages rejected by the antiflood component",
+			},
+			[]string{ShardLabel, TopicLabel, reasonLabel},
+		),
+		trieSyncReceivedNodes: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "trie_sync",
+				Name:      "received_nodes_total",
+				Help:      "Number of trie nodes received while syncing the tries",
+			},
+			[]string{ShardLabel},
+		),
+		trieSyncLargeNodes: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "trie_sync",
+				Name:      "large_nodes_total",
+				Help:      "Number of large trie nodes received while syncing the tries",
+			},
+			[]string{ShardLabel},
+		),
+		trieSyncMissingNodes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "trie_sync",
+				Name:      "missing_nodes",
+				Help:      "Number of trie nodes still missing while syncing the tries",
+			},
+			[]string{ShardLabel},
+		),
+		trieSyncEstimatedTimeLeft: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "trie_sync",
+				Name:      "estimated_seconds_left",
+				Help:      "Estimated time left to receive the trie nodes currently known to be missing, based on the receive rate",
+			},
+			[]string{ShardLabel},
+		),
+		storageCacheRequests: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "storage",
+				Name:      "cache_requests_total",
+				Help:      "Number of get requests on the caches of the storage units, either hits or misses",
+			},
+			[]string{ShardLabel, unitLabel, resultLabel},
+		),
+		outportQueueRecords: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "outport_queue",
+				Name:      "pending_records",
+				Help:      "Number of records of the outport queues which were not yet delivered to their drivers",
+			},
+			[]string{ShardLabel, driverLabel},
+		),
+		outportQueueBytes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "outport_queue",
+				Name:      "pending_bytes",
+				Help:      "Size of the records of the outport queues which were not yet delivered to their drivers",
+			},
+			[]string{ShardLabel, driverLabel},
+		),
+		outportQueueRejections: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "outport_queue",
+				Name:      "rejected_records_total",
+				Help:      "Number of outport calls rejected because the queue of their driver was full",
+			},
+			[]string{ShardLabel, driverLabel},
+		),
+	}
+
+	err := pmh.registerCollectors()
+	if err != nil {
+		return nil, err
+	}
+
+	return pmh, nil
+}
+
+func (pmh *prometheusMetricsHandler) registerCollectors() error {
+	collectors := []prometheus.Collector{
+		pmh.blockProcessingDuration,
+		pmh.consensusSubroundDuration,
+		pmh.interceptedMessages,
+		pmh.interceptedBytes,
+		pmh.antifloodRejections,
+		pmh.trieSyncReceivedNodes,
+		pmh.trieSyncLargeNodes,
+		pmh.trieSyncMissingNodes,
+		pmh.trieSyncEstimatedTimeLeft,
+		pmh.storageCacheRequests,
+		pmh.outportQueueRecords,
+		pmh.outportQueueBytes,
+		pmh.outportQueueRejections,
+	}
+
+	for _, collector := range collectors {
+		err := pmh.registry.Register(collector)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetShardID sets the shard used to label the values recorded from now on
+func (pmh *prometheusMetricsHandler) SetShardID(shardID uint32) {
+	pmh.mutShard.Lock()
+	pmh.shard = strconv.FormatUint(uint64(shardID), 10)
+	pmh.mutShard.Unlock()
+}
+
+func (pmh *prometheusMetricsHandler) currentShard() string {
+	pmh.mutShard.RLock()
+	defer pmh.mutShard.RUnlock()
+
+	return pmh.shard
+}
+
+// ObserveBlockOperation records the duration of a block operation
+func (pmh *prometheusMetricsHandler) ObserveBlockOperation(operation common.BlockOperation, duration time.Duration) {
+	pmh.blockProcessingDuration.WithLabelValues(pmh.currentShard(), string(operation)).Observe(duration.Seconds())
+}
+
+// ObserveConsensusSubround records the duration of a consensus subround. A subround which did not finish its job in
+// the allotted time is recorded as extended
+func (pmh *prometheusMetricsHandler) ObserveConsensusSubround(subround string, done bool, duration time.Duration) {
+	status := statusDone
+	if !done {
+		status = statusExtended
+	}
+
+	pmh.consensusSubroundDuration.WithLabelValues(pmh.currentShard(), subround, status).Observe(duration.Seconds())
+}
+
+// AddInterceptedMessage records a message received by an interceptor on the provided topic
+func (pmh *prometheusMetricsHandler) AddInterceptedMessage(topic string, accepted bool, size int) {
+	status := statusAccepted
+	if !accepted {
+		status = statusRejected
+	}
+
+	shard := pmh.currentShard()
+	pmh.interceptedMessages.WithLabelValues(shard, topic, status).Inc()
+	pmh.interceptedBytes.WithLabelValues(shard, topic).Add(float64(size))
+}
+
+// AddAntifloodRejection records a message rejected by the antiflood component on the provided topic
+func (pmh *prometheusMetricsHandler) AddAntifloodRejection(topic string, reason common.AntifloodReason) {
+	pmh.antifloodRejections.WithLabelValues(pmh.currentShard(), topic, string(reason)).Inc()
+}
+
+// AddTrieSyncReceivedNodes records trie nodes received while syncing
+func (pmh *prometheusMetricsHandler) AddTrieSyncReceivedNodes(numNodes int) {
+	pmh.trieSyncReceivedNodes.WithLabelValues(pmh.currentShard()).Add(float64(numNodes))
+}
+
+// AddTrieSyncLargeNodes records large trie nodes received while syncing
+func (pmh *prometheusMetricsHandler) AddTrieSyncLargeNodes(numNodes int) {
+	pmh.trieSyncLargeNodes.WithLabelValues(pmh.currentShard()).Add(float64(numNodes))
+}
+
+// AddTrieSyncMissingNodes adjusts the number of trie nodes still missing with the provided difference
+func (pmh *prometheusMetricsHandler) AddTrieSyncMissingNodes(difference int) {
+	pmh.trieSyncMissingNodes.WithLabelValues(pmh.currentShard()).Add(float64(difference))
+}
+
+// SetTrieSyncEstimatedTimeLeft records the estimated time left to receive the missing trie nodes
+func (pmh *prometheusMetricsHandler) SetTrieSyncEstimatedTimeLeft(duration time.Duration) {
+	pmh.trieSyncEstimatedTimeLeft.WithLabelValues(pmh.currentShard()).Set(duration.Seconds())
+}
+
+// AddStorageCacheRequest records a get request on the cache of the provided storage unit
+func (pmh *prometheusMetricsHandler) AddStorageCacheRequest(unit string, hit bool) {
+	result := resultHit
+	if !hit {
+		result = resultMiss
+	}
+
+	pmh.storageCacheRequests.WithLabelValues(pmh.currentShard(), unit, result).Inc()
+}
+
+// SetOutportQueueSize records the number and the size of the records pending in the queue of the provided driver
+func (pmh *prometheusMetricsHandler) SetOutportQueueSize(driver string, numRecords uint64, numBytes uint64) {
+	shard := pmh.currentShard()
+	pmh.outportQueueRecords.WithLabelValues(shard, driver).Set(float64(numRecords))
+	pmh.outportQueueBytes.WithLabelValues(shard, driver).Set(float64(numBytes))
+}
+
+// AddOutportQueueRejection records an outport call rejected because the queue of the provided driver was full
+func (pmh *prometheusMetricsHandler) AddOutportQueueRejection(driver string) {
+	pmh.outportQueueRejections.WithLabelValues(pmh.currentShard(), driver).Inc()
+}
+
+// Gather returns the metric families recorded by this handler
+func (pmh *prometheusMetricsHandler) Gather() ([]*dto.MetricFamily, error) {
+	return pmh.registry.Gather()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pmh *prometheusMetricsHandler) IsInterfaceNil() bool {
+	return pmh == nil
+}
@@ -0,0 +1,167 @@
+package metrics
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func createHandler(t *testing.T) *prometheusMetricsHandler {
+	pmh, err := NewPrometheusMetricsHandler()
+	require.Nil(t, err)
+
+	return pmh
+}
+
+func TestNewPrometheusMetricsHandler(t *testing.T) {
+	t.Parallel()
+
+	pmh, err := NewPrometheusMetricsHandler()
+	require.Nil(t, err)
+	require.False(t, check.IfNil(pmh))
+	require.Equal(t, unknownShardLabel, pmh.currentShard())
+}
+
+func TestNewPrometheusMetricsHandler_HandlersDoNotShareValues(t *testing.T) {
+	t.Parallel()
+
+	first := createHandler(t)
+	second := createHandler(t)
+
+	first.AddTrieSyncReceivedNodes(5)
+
+	require.Equal(t, float64(5), testutil.ToFloat64(first.trieSyncReceivedNodes.WithLabelValues(unknownShardLabel)))
+	require.Equal(t, float64(0), testutil.ToFloat64(second.trieSyncReceivedNodes.WithLabelValues(unknownShardLabel)))
+}
+
+func TestPrometheusMetricsHandler_AddInterceptedMessage(t *testing.T) {
+	t.Parallel()
+
+	pmh := createHandler(t)
+	pmh.SetShardID(1)
+
+	topic := "topic"
+	pmh.AddInterceptedMessage(topic, true, 10)
+	pmh.AddInterceptedMessage(topic, true, 20)
+	pmh.AddInterceptedMessage(topic, false, 5)
+
+	require.Equal(t, float64(2), testutil.ToFloat64(pmh.interceptedMessages.WithLabelValues("1", topic, statusAccepted)))
+	require.Equal(t, float64(1), testutil.ToFloat64(pmh.interceptedMessages.WithLabelValues("1", topic, statusRejected)))
+	require.Equal(t, float64(35), testutil.ToFloat64(pmh.interceptedBytes.WithLabelValues("1", topic)))
+}
+
+func TestPrometheusMetricsHandler_AddAntifloodRejection(t *testing.T) {
+	t.Parallel()
+
+	pmh := createHandler(t)
+	pmh.SetShardID(1)
+
+	topic := "topic"
+	pmh.AddAntifloodRejection(topic, common.AntifloodReasonFlood)
+	pmh.AddAntifloodRejection(topic, common.AntifloodReasonFlood)
+	pmh.AddAntifloodRejection(topic, common.AntifloodReasonTopicFlood)
+
+	flood := string(common.AntifloodReasonFlood)
+	topicFlood := string(common.AntifloodReasonTopicFlood)
+	require.Equal(t, float64(2), testutil.ToFloat64(pmh.antifloodRejections.WithLabelValues("1", topic, flood)))
+	require.Equal(t, float64(1), testutil.ToFloat64(pmh.antifloodRejections.WithLabelValues("1", topic, topicFlood)))
+}
+
+func TestPrometheusMetricsHandler_AddStorageCacheRequest(t *testing.T) {
+	t.Parallel()
+
+	pmh := createHandler(t)
+	pmh.SetShardID(1)
+
+	unit := "unit"
+	pmh.AddStorageCacheRequest(unit, true)
+	pmh.AddStorageCacheRequest(unit, true)
+	pmh.AddStorageCacheRequest(unit, false)
+
+	require.Equal(t, float64(2), testutil.ToFloat64(pmh.storageCacheRequests.WithLabelValues("1", unit, resultHit)))
+	require.Equal(t, float64(1), testutil.ToFloat64(pmh.storageCacheRequests.WithLabelValues("1", unit, resultMiss)))
+}
+
+func TestPrometheusMetricsHandler_SetShardIDKeepsTheShardOfTheRecordedValues(t *testing.T) {
+	t.Parallel()
+
+	pmh := createHandler(t)
+	pmh.AddTrieSyncMissingNodes(3)
+	pmh.SetShardID(common.MetachainShardId)
+	pmh.AddTrieSyncMissingNodes(7)
+
+	metaShard := fmt.Sprintf("%d", common.MetachainShardId)
+	require.Equal(t, float64(3), testutil.ToFloat64(pmh.trieSyncMissingNodes.WithLabelValues(unknownShardLabel)))
+	require.Equal(t, float64(7), testutil.ToFloat64(pmh.trieSyncMissingNodes.WithLabelValues(metaShard)))
+}
+
+func TestPrometheusText(t *testing.T) {
+	t.Parallel()
+
+	pmh := createHandler(t)
+	pmh.SetShardID(2)
+	pmh.ObserveBlockOperation(common.BlockOperationProcess, 30*time.Millisecond)
+	pmh.ObserveConsensusSubround("block", false, time.Second)
+
+	text, err := PrometheusText(pmh, 2, map[string]float64{"erd_nonce": 37})
+	require.Nil(t, err)
+
+	expectedLines := []string{
+		"# HELP elrond_block_processing_duration_seconds Duration of the block processing operations",
+		"# TYPE elrond_block_processing_duration_seconds histogram",
+		`elrond_block_processing_duration_seconds_bucket{operation="process",shard="2",le="0.04"}`,
+		"# TYPE elrond_consensus_subround_duration_seconds histogram",
+		`elrond_consensus_subround_duration_seconds_count{shard="2",status="extended",subround="block"}`,
+		"# HELP erd_nonce Status metric erd_nonce",
+		"# TYPE erd_nonce gauge",
+		fmt.Sprintf(`erd_nonce{%s="2"} 37`, common.MetricShardId),
+	}
+	for _, line := range expectedLines {
+		require.True(t, strings.Contains(text, line), "missing line: "+line)
+	}
+}
+
+func TestPrometheusText_TypedMetricsKeepTheRecordedShard(t *testing.T) {
+	t.Parallel()
+
+	pmh := createHandler(t)
+	pmh.SetShardID(0)
+	pmh.ObserveBlockOperation(common.BlockOperationCommit, 30*time.Millisecond)
+
+	text, err := PrometheusText(pmh, 1, nil)
+	require.Nil(t, err)
+
+	require.True(t, strings.Contains(text, `elrond_block_processing_duration_seconds_count{operation="commit",shard="0"} 1`))
+	require.False(t, strings.Contains(text, `shard="1"`))
+}
+
+func TestPrometheusText_NilGathererExposesOnlyTheStatusGauges(t *testing.T) {
+	t.Parallel()
+
+	text, err := PrometheusText(nil, 0, map[string]float64{"erd_nonce": 37})
+	require.Nil(t, err)
+
+	require.False(t, strings.Contains(text, namespace+"_"))
+	require.True(t, strings.Contains(text, fmt.Sprintf(`erd_nonce{%s="0"} 37`, common.MetricShardId)))
+}
+
+func TestPrometheusMetricsHandler_OutportQueue(t *testing.T) {
+	t.Parallel()
+
+	pmh := createHandler(t)
+	pmh.SetShardID(1)
+
+	pmh.SetOutportQueueSize("grpc", 3, 300)
+	pmh.SetOutportQueueSize("grpc", 2, 200)
+	pmh.AddOutportQueueRejection("grpc")
+
+	require.Equal(t, float64(2), testutil.ToFloat64(pmh.outportQueueRecords.WithLabelValues("1", "grpc")))
+	require.Equal(t, float64(200), testutil.ToFloat64(pmh.outportQueueBytes.WithLabelValues("1", "grpc")))
+	require.Equal(t, float64(1), testutil.ToFloat64(pmh.outportQueueRejections.WithLabelValues("1", "grpc")))
+}
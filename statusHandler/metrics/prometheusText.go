@@ -0,0 +1,84 @@
+package metrics
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+const statusGaugeHelpPrefix = "Status metric "
+
+// PrometheusText returns the typed metrics gathered from the provided gatherer together with the status gauges in the
+// prometheus text exposition format, with HELP and TYPE lines. The typed metrics keep the shard labels they were
+// recorded with, while the status gauges carry the legacy shard label so the existing dashboards still find them.
+// A nil gatherer exposes only the status gauges
+func PrometheusText(typedMetrics prometheus.Gatherer, shardID uint32, statusGauges map[string]float64) (string, error) {
+	var families []*dto.MetricFamily
+	if typedMetrics != nil {
+		gathered, err := typedMetrics.Gather()
+		if err != nil {
+			return "", err
+		}
+		families = gathered
+	}
+
+	shard := strconv.FormatUint(uint64(shardID), 10)
+	families = append(families, createStatusGaugeFamilies(shard, statusGauges)...)
+
+	stringBuilder := strings.Builder{}
+	for _, family := range families {
+		_, err := expfmt.MetricFamilyToText(&stringBuilder, family)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return stringBuilder.String(), nil
+}
+
+func createStatusGaugeFamilies(shard string, statusGauges map[string]float64) []*dto.MetricFamily {
+	keys := make([]string, 0, len(statusGauges))
+	for key := range statusGauges {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	gaugeType := dto.MetricType_GAUGE
+	families := make([]*dto.MetricFamily, 0, len(keys))
+	for _, key := range keys {
+		name := key
+		help := statusGaugeHelpPrefix + key
+		value := statusGauges[key]
+
+		families = append(families, &dto.MetricFamily{
+			Name: &name,
+			Help: &help,
+			Type: &gaugeType,
+			Metric: []*dto.Metric{
+				{
+					Label: appendLabel(nil, common.MetricShardId, shard),
+					Gauge: &dto.Gauge{Value: &value},
+				},
+			},
+		})
+	}
+
+	return families
+}
+
+func appendLabel(labels []*dto.LabelPair, name string, value string) []*dto.LabelPair {
+	labels = append(labels, &dto.LabelPair{
+		Name:  &name,
+		Value: &value,
+	})
+	sort.Slice(labels, func(i, j int) bool {
+		return labels[i].GetName() < labels[j].GetName()
+	})
+
+	return labels
+}
@@ -1,16 +1,21 @@
 package statusHandler
 
 import (
-	"fmt"
 	"strings"
 	"sync"
 
+	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
+	promMetrics "github.com/ElrondNetwork/elrond-go/statusHandler/metrics"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
+var log = logger.GetOrCreate("statusHandler")
+
 // statusMetrics will handle displaying at /node/details all metrics already collected for other status handlers
 type statusMetrics struct {
-	nodeMetrics *sync.Map
+	nodeMetrics  *sync.Map
+	typedMetrics prometheus.Gatherer
 }
 
 // NewStatusMetrics will return an instance of the struct
@@ -20,6 +25,19 @@ func NewStatusMetrics() *statusMetrics {
 	}
 }
 
+// NewStatusMetricsWithTypedMetrics will return an instance of the struct which also exposes, in the prometheus
+// format, the typed metrics gathered from the provided gatherer
+func NewStatusMetricsWithTypedMetrics(typedMetrics prometheus.Gatherer) (*statusMetrics, error) {
+	if typedMetrics == nil {
+		return nil, ErrNilTypedMetricsGatherer
+	}
+
+	return &statusMetrics{
+		nodeMetrics:  &sync.Map{},
+		typedMetrics: typedMetrics,
+	}, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sm *statusMetrics) IsInterfaceNil() bool {
 	return sm == nil
@@ -127,21 +145,27 @@ func (sm *statusMetrics) StatusP2pMetricsMap() map[string]interface{} {
 	return statusMetricsMap
 }
 
-// StatusMetricsWithoutP2PPrometheusString returns the metrics in a string format which respects prometheus style
+// StatusMetricsWithoutP2PPrometheusString returns the metrics in a string format which respects prometheus style.
+// The numeric status metrics are exposed as gauges, together with the typed metrics of the node
 func (sm *statusMetrics) StatusMetricsWithoutP2PPrometheusString() string {
 	shardID := sm.loadUint64Metric(common.MetricShardId)
 	metrics := sm.StatusMetricsMapWithoutP2P()
-	stringBuilder := strings.Builder{}
+	statusGauges := make(map[string]float64, len(metrics))
 	for key, value := range metrics {
-		_, isUint64 := value.(uint64)
-		_, isInt64 := value.(int64)
-		isNumericValue := isUint64 || isInt64
-		if isNumericValue {
-			stringBuilder.WriteString(fmt.Sprintf("%s{%s=\"%d\"} %v\n", key, common.MetricShardId, shardID, value))
+		switch numericValue := value.(type) {
+		case uint64:
+			statusGauges[key] = float64(numericValue)
+		case int64:
+			statusGauges[key] = float64(numericValue)
 		}
 	}
 
-	return stringBuilder.String()
+	prometheusText, err := promMetrics.PrometheusText(sm.typedMetrics, uint32(shardID), statusGauges)
+	if err != nil {
+		log.Warn("statusMetrics.StatusMetricsWithoutP2PPrometheusString", "error", err)
+	}
+
+	return prometheusText
 }
 
 // EconomicsMetrics returns the economics related metrics
@@ -0,0 +1,34 @@
+package badgerdb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// badgerLogger redirects the badger internal log messages to the node's logger
+type badgerLogger struct {
+}
+
+// Errorf logs an error message
+func (bl *badgerLogger) Errorf(format string, args ...interface{}) {
+	log.Error(formatMessage(format, args...))
+}
+
+// Warningf logs a warning message
+func (bl *badgerLogger) Warningf(format string, args ...interface{}) {
+	log.Warn(formatMessage(format, args...))
+}
+
+// Infof logs an info message. Badger is quite verbose at info level so these messages are logged as debug
+func (bl *badgerLogger) Infof(format string, args ...interface{}) {
+	log.Debug(formatMessage(format, args...))
+}
+
+// Debugf logs a debug message
+func (bl *badgerLogger) Debugf(format string, args ...interface{}) {
+	log.Trace(formatMessage(format, args...))
+}
+
+func formatMessage(format string, args ...interface{}) string {
+	return strings.TrimSpace(fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,356 @@
+package badgerdb
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/dgraph-io/badger/v2"
+)
+
+var _ storage.Persister = (*DB)(nil)
+
+// read + write + execute for owner only
+const rwxOwner = 0700
+
+const valueLogGCInterval = 5 * time.Minute
+const valueLogGCDiscardRatio = 0.5
+
+var log = logger.GetOrCreate("storage/badgerdb")
+
+// DB holds a pointer to the badger database and the path to where it is stored.
+type DB struct {
+	db                *badger.DB
+	path              string
+	maxBatchSize      int
+	batchDelaySeconds int
+	sizeBatch         int
+	batch             *batch
+	mutBatch          sync.RWMutex
+	dbClosed          chan struct{}
+	handlerClosed     chan struct{}
+	mutClosed         sync.Mutex
+	closed            bool
+}
+
+// NewDB is a constructor for the badger persister
+// It creates the files in the location given as parameter
+func NewDB(path string, batchDelaySeconds int, maxBatchSize int) (*DB, error) {
+	err := os.MkdirAll(path, rwxOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxBatchSize < 1 {
+		return nil, storage.ErrInvalidBatchSize
+	}
+
+	options := badger.DefaultOptions(path).
+		WithLogger(&badgerLogger{}).
+		WithSyncWrites(true).
+		WithTruncate(true)
+
+	db, err := badger.Open(options)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	dbStore := &DB{
+		db:                db,
+		path:              path,
+		maxBatchSize:      maxBatchSize,
+		batchDelaySeconds: batchDelaySeconds,
+		sizeBatch:         0,
+		batch:             NewBatch(),
+		dbClosed:          make(chan struct{}),
+		handlerClosed:     make(chan struct{}),
+	}
+
+	go dbStore.batchTimeoutHandle()
+
+	runtime.SetFinalizer(dbStore, func(db *DB) {
+		_ = db.Close()
+	})
+
+	log.Debug("opened badger db persister", "path", path)
+
+	return dbStore, nil
+}
+
+func (s *DB) batchTimeoutHandle() {
+	defer close(s.handlerClosed)
+
+	gcTicker := time.NewTicker(valueLogGCInterval)
+	defer gcTicker.Stop()
+
+	for {
+		select {
+		case <-time.After(time.Duration(s.batchDelaySeconds) * time.Second):
+			s.mutBatch.Lock()
+			err := s.putBatch(s.batch)
+			if err != nil {
+				log.Warn("badgerdb putBatch", "error", err.Error())
+				s.mutBatch.Unlock()
+				continue
+			}
+
+			s.batch.Reset()
+			s.sizeBatch = 0
+			s.mutBatch.Unlock()
+		case <-gcTicker.C:
+			s.runValueLogGC()
+		case <-s.dbClosed:
+			log.Debug("closing the timed batch handler", "path", s.path)
+			return
+		}
+	}
+}
+
+// runValueLogGC rewrites value log files until badger reports there is nothing left to reclaim or the persister
+// is being closed
+func (s *DB) runValueLogGC() {
+	for {
+		select {
+		case <-s.dbClosed:
+			return
+		default:
+		}
+
+		err := s.db.RunValueLogGC(valueLogGCDiscardRatio)
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (s *DB) updateBatchWithIncrement() error {
+	s.mutBatch.Lock()
+	defer s.mutBatch.Unlock()
+
+	s.sizeBatch++
+	if s.sizeBatch < s.maxBatchSize {
+		return nil
+	}
+
+	err := s.putBatch(s.batch)
+	if err != nil {
+		log.Warn("badgerdb putBatch", "error", err.Error())
+		return err
+	}
+
+	s.batch.Reset()
+	s.sizeBatch = 0
+
+	return nil
+}
+
+// Put adds the value to the (key, val) storage medium
+func (s *DB) Put(key, val []byte) error {
+	if s.isClosed() {
+		return storage.ErrDBIsClosed
+	}
+
+	err := s.batch.Put(key, val)
+	if err != nil {
+		return err
+	}
+
+	return s.updateBatchWithIncrement()
+}
+
+// Get returns the value associated to the key
+func (s *DB) Get(key []byte) ([]byte, error) {
+	if s.isClosed() {
+		return nil, storage.ErrDBIsClosed
+	}
+
+	data := s.batch.Get(key)
+	if data != nil {
+		if bytes.Equal(data, []byte(removed)) {
+			return nil, storage.ErrKeyNotFound
+		}
+		return data, nil
+	}
+
+	err := s.db.View(func(txn *badger.Txn) error {
+		item, errGet := txn.Get(key)
+		if errGet != nil {
+			return errGet
+		}
+
+		data, errGet = item.ValueCopy(nil)
+		return errGet
+	})
+	if err == badger.ErrKeyNotFound {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *DB) Has(key []byte) error {
+	if s.isClosed() {
+		return storage.ErrDBIsClosed
+	}
+
+	data := s.batch.Get(key)
+	if data != nil {
+		if bytes.Equal(data, []byte(removed)) {
+			return storage.ErrKeyNotFound
+		}
+		return nil
+	}
+
+	err := s.db.View(func(txn *badger.Txn) error {
+		_, errGet := txn.Get(key)
+		return errGet
+	})
+	if err == badger.ErrKeyNotFound {
+		return storage.ErrKeyNotFound
+	}
+
+	return err
+}
+
+// putBatch writes the Batch data into the database
+func (s *DB) putBatch(b *batch) error {
+	if b.len() == 0 {
+		return nil
+	}
+
+	writeBatch := s.db.NewWriteBatch()
+	err := b.applyOperations(func(op batchOperation) error {
+		if op.isDelete {
+			return writeBatch.Delete(op.key)
+		}
+
+		return writeBatch.Set(op.key, op.val)
+	})
+	if err != nil {
+		writeBatch.Cancel()
+		return err
+	}
+
+	return writeBatch.Flush()
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (s *DB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	err := s.db.View(func(txn *badger.Txn) error {
+		iterator := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer iterator.Close()
+
+		for iterator.Rewind(); iterator.Valid(); iterator.Next() {
+			item := iterator.Item()
+			clonedKey := item.KeyCopy(nil)
+			clonedVal, errValue := item.ValueCopy(nil)
+			if errValue != nil {
+				return errValue
+			}
+
+			shouldContinue := handler(clonedKey, clonedVal)
+			if !shouldContinue {
+				return nil
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Warn("badgerdb RangeKeys", "path", s.path, "error", err.Error())
+	}
+}
+
+func (s *DB) isClosed() bool {
+	s.mutClosed.Lock()
+	isClosed := s.closed
+	s.mutClosed.Unlock()
+
+	return isClosed
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *DB) Close() error {
+	s.mutClosed.Lock()
+	defer s.mutClosed.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	s.mutBatch.Lock()
+	_ = s.putBatch(s.batch)
+	s.batch.Reset()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	return s.closeDB()
+}
+
+// closeDB stops the batch handler and waits for it to return, so no batch write or value log GC runs against the
+// closed badger database
+func (s *DB) closeDB() error {
+	close(s.dbClosed)
+	<-s.handlerClosed
+
+	s.closed = true
+
+	return s.db.Close()
+}
+
+// Remove removes the data associated to the given key
+func (s *DB) Remove(key []byte) error {
+	if s.isClosed() {
+		return storage.ErrDBIsClosed
+	}
+
+	s.mutBatch.Lock()
+	_ = s.batch.Delete(key)
+	s.mutBatch.Unlock()
+
+	return s.updateBatchWithIncrement()
+}
+
+// Destroy removes the storage medium stored data
+func (s *DB) Destroy() error {
+	s.mutClosed.Lock()
+	defer s.mutClosed.Unlock()
+
+	s.mutBatch.Lock()
+	s.batch.Reset()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	if !s.closed {
+		err := s.closeDB()
+		if err != nil {
+			return err
+		}
+	}
+
+	return os.RemoveAll(s.path)
+}
+
+// DestroyClosed removes the already closed storage medium stored data
+func (s *DB) DestroyClosed() error {
+	return os.RemoveAll(s.path)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *DB) IsInterfaceNil() bool {
+	return s == nil
+}
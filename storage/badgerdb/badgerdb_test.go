@@ -0,0 +1,245 @@
+package badgerdb_test
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createBadgerDb(t *testing.T, batchDelaySeconds int, maxBatchSize int) *badgerdb.DB {
+	dir, _ := ioutil.TempDir("", "badgerdb_temp")
+	db, err := badgerdb.NewDB(dir, batchDelaySeconds, maxBatchSize)
+	require.Nil(t, err, "Failed creating badger database files")
+
+	return db
+}
+
+func TestNewDB_InvalidBatchSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "badgerdb_temp")
+	db, err := badgerdb.NewDB(dir, 10, 0)
+
+	assert.Nil(t, db)
+	assert.Equal(t, storage.ErrInvalidBatchSize, err)
+}
+
+func TestDB_DoubleOpenShouldError(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "badgerdb_temp")
+	db1, err := badgerdb.NewDB(dir, 10, 1)
+	require.Nil(t, err)
+	defer func() {
+		_ = db1.Destroy()
+	}()
+
+	_, err = badgerdb.NewDB(dir, 10, 1)
+	assert.NotNil(t, err)
+}
+
+func TestDB_GetAfterPutBeforeTimeout(t *testing.T) {
+	t.Parallel()
+
+	key, val := []byte("key"), []byte("value")
+	db := createBadgerDb(t, 10, 100)
+	defer func() {
+		_ = db.Destroy()
+	}()
+
+	err := db.Put(key, val)
+	assert.Nil(t, err)
+
+	v, err := db.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+}
+
+func TestDB_GetAfterPutWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	key, val := []byte("key"), []byte("value")
+	db := createBadgerDb(t, 1, 100)
+	defer func() {
+		_ = db.Destroy()
+	}()
+
+	err := db.Put(key, val)
+	assert.Nil(t, err)
+	time.Sleep(time.Second * 2)
+
+	v, err := db.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+}
+
+func TestDB_GetNotPresent(t *testing.T) {
+	t.Parallel()
+
+	db := createBadgerDb(t, 10, 1)
+	defer func() {
+		_ = db.Destroy()
+	}()
+
+	v, err := db.Get([]byte("key"))
+	assert.Nil(t, v)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestDB_HasPresentAndNotPresent(t *testing.T) {
+	t.Parallel()
+
+	key, val := []byte("key"), []byte("value")
+	db := createBadgerDb(t, 10, 1)
+	defer func() {
+		_ = db.Destroy()
+	}()
+
+	err := db.Put(key, val)
+	assert.Nil(t, err)
+
+	assert.Nil(t, db.Has(key))
+	assert.Equal(t, storage.ErrKeyNotFound, db.Has([]byte("missing key")))
+}
+
+func TestDB_RemoveBeforeAndAfterFlush(t *testing.T) {
+	t.Parallel()
+
+	key1, key2, val := []byte("key1"), []byte("key2"), []byte("value")
+	db := createBadgerDb(t, 10, 1)
+	defer func() {
+		_ = db.Destroy()
+	}()
+
+	_ = db.Put(key1, val)
+	err := db.Remove(key1)
+	assert.Nil(t, err)
+	assert.Equal(t, storage.ErrKeyNotFound, db.Has(key1))
+
+	db2 := createBadgerDb(t, 10, 100)
+	defer func() {
+		_ = db2.Destroy()
+	}()
+
+	_ = db2.Put(key2, val)
+	_ = db2.Remove(key2)
+	assert.Equal(t, storage.ErrKeyNotFound, db2.Has(key2))
+}
+
+func TestDB_CloseShouldPersistPendingBatch(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "badgerdb_temp")
+	key, val := []byte("key"), []byte("value")
+
+	db, err := badgerdb.NewDB(dir, 10, 100)
+	require.Nil(t, err)
+	_ = db.Put(key, val)
+	err = db.Close()
+	assert.Nil(t, err)
+
+	err = db.Close()
+	assert.Nil(t, err)
+
+	reopened, err := badgerdb.NewDB(dir, 10, 100)
+	require.Nil(t, err)
+	defer func() {
+		_ = reopened.Destroy()
+	}()
+
+	v, err := reopened.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+}
+
+func TestDB_OperationsOnClosedDBShouldErr(t *testing.T) {
+	t.Parallel()
+
+	db := createBadgerDb(t, 1, 10)
+	defer func() {
+		_ = db.DestroyClosed()
+	}()
+
+	err := db.Close()
+	require.Nil(t, err)
+
+	err = db.Put([]byte("key"), []byte("value"))
+	assert.Equal(t, storage.ErrDBIsClosed, err)
+
+	v, err := db.Get([]byte("key"))
+	assert.Nil(t, v)
+	assert.Equal(t, storage.ErrDBIsClosed, err)
+
+	err = db.Has([]byte("key"))
+	assert.Equal(t, storage.ErrDBIsClosed, err)
+
+	err = db.Remove([]byte("key"))
+	assert.Equal(t, storage.ErrDBIsClosed, err)
+}
+
+func TestDB_DestroyClosed(t *testing.T) {
+	t.Parallel()
+
+	db := createBadgerDb(t, 10, 1)
+	err := db.Close()
+	assert.Nil(t, err)
+
+	err = db.DestroyClosed()
+	assert.Nil(t, err)
+}
+
+func TestDB_RangeKeys(t *testing.T) {
+	t.Parallel()
+
+	db := createBadgerDb(t, 1, 1)
+	defer func() {
+		_ = db.Destroy()
+	}()
+
+	keysVals := map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+		"key3": []byte("value3"),
+		"key4": []byte("value4"),
+		"key5": []byte("value5"),
+	}
+
+	for key, val := range keysVals {
+		_ = db.Put([]byte(key), val)
+	}
+
+	recovered := make(map[string][]byte)
+	handler := func(key []byte, val []byte) bool {
+		recovered[string(key)] = val
+		return true
+	}
+
+	db.RangeKeys(handler)
+
+	assert.Equal(t, keysVals, recovered)
+}
+
+func TestDB_RangeKeysShouldStopWhenHandlerReturnsFalse(t *testing.T) {
+	t.Parallel()
+
+	db := createBadgerDb(t, 1, 1)
+	defer func() {
+		_ = db.Destroy()
+	}()
+
+	_ = db.Put([]byte("key1"), []byte("value1"))
+	_ = db.Put([]byte("key2"), []byte("value2"))
+
+	numCalls := 0
+	db.RangeKeys(func(key []byte, val []byte) bool {
+		numCalls++
+		return false
+	})
+
+	assert.Equal(t, 1, numCalls)
+}
@@ -0,0 +1,92 @@
+package badgerdb
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ storage.Batcher = (*batch)(nil)
+
+const removed = "removed"
+
+type batchOperation struct {
+	key      []byte
+	val      []byte
+	isDelete bool
+}
+
+type batch struct {
+	operations []batchOperation
+	cachedData map[string][]byte
+	mutBatch   sync.RWMutex
+}
+
+// NewBatch creates a batch
+func NewBatch() *batch {
+	return &batch{
+		operations: make([]batchOperation, 0),
+		cachedData: make(map[string][]byte),
+		mutBatch:   sync.RWMutex{},
+	}
+}
+
+// Put inserts one entry - key, value pair - into the batch
+func (b *batch) Put(key []byte, val []byte) error {
+	b.mutBatch.Lock()
+	b.operations = append(b.operations, batchOperation{key: key, val: val})
+	b.cachedData[string(key)] = val
+	b.mutBatch.Unlock()
+	return nil
+}
+
+// Delete deletes the entry for the provided key from the batch
+func (b *batch) Delete(key []byte) error {
+	b.mutBatch.Lock()
+	b.operations = append(b.operations, batchOperation{key: key, isDelete: true})
+	b.cachedData[string(key)] = []byte(removed)
+	b.mutBatch.Unlock()
+	return nil
+}
+
+// Reset clears the contents of the batch
+func (b *batch) Reset() {
+	b.mutBatch.Lock()
+	b.operations = make([]batchOperation, 0)
+	b.cachedData = make(map[string][]byte)
+	b.mutBatch.Unlock()
+}
+
+// Get returns the value
+func (b *batch) Get(key []byte) []byte {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	return b.cachedData[string(key)]
+}
+
+func (b *batch) len() int {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	return len(b.operations)
+}
+
+func (b *batch) applyOperations(handler func(op batchOperation) error) error {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	for _, op := range b.operations {
+		err := handler(op)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (b *batch) IsInterfaceNil() bool {
+	return b == nil
+}
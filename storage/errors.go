@@ -28,12 +28,18 @@ var ErrKeyNotFound = errors.New("key not found")
 // ErrSerialDBIsClosed is raised when the serialDB is closed
 var ErrSerialDBIsClosed = errors.New("serialDB is closed")
 
+// ErrDBIsClosed is raised when the database is closed
+var ErrDBIsClosed = errors.New("database is closed")
+
 // ErrInvalidBatch is raised when the used batch is invalid
 var ErrInvalidBatch = errors.New("batch is invalid")
 
 // ErrInvalidNumOpenFiles is raised when the max num of open files is less than 1
 var ErrInvalidNumOpenFiles = errors.New("maxOpenFiles is invalid")
 
+// ErrInvalidBatchSize is raised when the max batch size is less than 1
+var ErrInvalidBatchSize = errors.New("maxBatchSize is invalid")
+
 // ErrEmptyKey is raised when a key is empty
 var ErrEmptyKey = errors.New("key is empty")
 
@@ -64,6 +70,9 @@ var ErrNilShardCoordinator = errors.New("nil shard coordinator")
 // ErrNilPathManager signals that a nil path manager has been provided
 var ErrNilPathManager = errors.New("nil path manager")
 
+// ErrNilMetricsHandler signals that a nil metrics handler has been provided
+var ErrNilMetricsHandler = errors.New("nil metrics handler")
+
 // ErrNilStorageListProvider signals that a nil storage list provided has been provided
 var ErrNilStorageListProvider = errors.New("nil storage list provider")
 
@@ -115,6 +124,14 @@ var ErrImmuneItemsCapacityReached = errors.New("capacity reached for immune item
 // ErrItemAlreadyInCache signals that an item is already in cache
 var ErrItemAlreadyInCache = errors.New("item already in cache")
 
+// ErrTxReplacementUnderpriced signals that a transaction has the same sender and nonce as a pending one, but its gas price
+// is not high enough to replace it
+var ErrTxReplacementUnderpriced = errors.New("replacement transaction underpriced")
+
+// ErrTxExpired signals that a transaction was dropped from the pool because it stayed there for longer than the
+// validity window
+var ErrTxExpired = errors.New("transaction expired")
+
 // ErrCacheSizeInvalid signals that size of cache is less than 1
 var ErrCacheSizeInvalid = errors.New("cache size is less than 1")
 
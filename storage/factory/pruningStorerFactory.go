@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -30,6 +31,7 @@ type StorageServiceFactory struct {
 	pathManager                   storage.PathManagerHandler
 	epochStartNotifier            storage.EpochStartNotifier
 	oldDataCleanerProvider        clean.OldDataCleanerProvider
+	metricsHandler                common.MetricsHandler
 	createTrieEpochRootHashStorer bool
 	currentEpoch                  uint32
 }
@@ -42,6 +44,7 @@ func NewStorageServiceFactory(
 	pathManager storage.PathManagerHandler,
 	epochStartNotifier storage.EpochStartNotifier,
 	nodeTypeProvider NodeTypeProviderHandler,
+	metricsHandler common.MetricsHandler,
 	currentEpoch uint32,
 	createTrieEpochRootHashStorer bool,
 ) (*StorageServiceFactory, error) {
@@ -63,6 +66,9 @@ func NewStorageServiceFactory(
 	if check.IfNil(epochStartNotifier) {
 		return nil, storage.ErrNilEpochStartNotifier
 	}
+	if check.IfNil(metricsHandler) {
+		return nil, storage.ErrNilMetricsHandler
+	}
 
 	oldDataCleanProvider, err := clean.NewOldDataCleanerProvider(
 		nodeTypeProvider,
@@ -84,6 +90,7 @@ func NewStorageServiceFactory(
 		currentEpoch:                  currentEpoch,
 		createTrieEpochRootHashStorer: createTrieEpochRootHashStorer,
 		oldDataCleanerProvider:        oldDataCleanProvider,
+		metricsHandler:                metricsHandler,
 	}, nil
 }
 
@@ -533,6 +540,31 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	createdStorers = append(createdStorers, blockHashByRoundUnit)
 	chainStorer.AddStorer(dataRetriever.RoundHdrHashDataUnit, blockHashByRoundUnit)
 
+	// Create the logsIndex (PRUNING) storer
+	logsIndexConfig := psf.generalConfig.DbLookupExtensions.LogsIndexStorageConfig
+	logsIndexPruningStorerArgs := psf.createPruningStorerArgs(logsIndexConfig)
+	logsIndexPruningStorer, err := psf.createPruningPersister(logsIndexPruningStorerArgs)
+	if err != nil {
+		return createdStorers, err
+	}
+
+	createdStorers = append(createdStorers, logsIndexPruningStorer)
+	chainStorer.AddStorer(dataRetriever.LogsIndexUnit, logsIndexPruningStorer)
+
+	// Create the addressHistory (STATIC) storer
+	addressHistoryConfig := psf.generalConfig.DbLookupExtensions.AddressHistoryStorageConfig
+	addressHistoryDbConfig := GetDBFromConfig(addressHistoryConfig.DB)
+	addressHistoryDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, addressHistoryConfig.DB.FilePath)
+	addressHistoryCacherConfig := GetCacherFromConfig(addressHistoryConfig.Cache)
+	addressHistoryBloomFilter := GetBloomFromConfig(addressHistoryConfig.Bloom)
+	addressHistoryUnit, err := storageUnit.NewStorageUnitFromConf(addressHistoryCacherConfig, addressHistoryDbConfig, addressHistoryBloomFilter)
+	if err != nil {
+		return createdStorers, err
+	}
+
+	createdStorers = append(createdStorers, addressHistoryUnit)
+	chainStorer.AddStorer(dataRetriever.AddressHistoryUnit, addressHistoryUnit)
+
 	// Create the epochByHash (STATIC) storer
 	epochByHashConfig := psf.generalConfig.DbLookupExtensions.EpochByHashStorageConfig
 	epochByHashDbConfig := GetDBFromConfig(epochByHashConfig.DB)
@@ -574,6 +606,7 @@ func (psf *StorageServiceFactory) createPruningStorerArgs(storageConfig config.S
 		PruningEnabled:            pruningEnabled,
 		StartingEpoch:             psf.currentEpoch,
 		OldDataCleanerProvider:    psf.oldDataCleanerProvider,
+		MetricsHandler:            psf.metricsHandler,
 		ShardCoordinator:          psf.shardCoordinator,
 		CacheConf:                 GetCacherFromConfig(storageConfig.Cache),
 		PathManager:               psf.pathManager,
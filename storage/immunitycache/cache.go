@@ -138,6 +138,16 @@ func (ic *ImmunityCache) Has(key []byte) bool {
 	return ok
 }
 
+// IsImmuneToEviction checks whether an item exists and is immune to eviction
+func (ic *ImmunityCache) IsImmuneToEviction(key []byte) bool {
+	item, ok := ic.getItem(key)
+	if !ok {
+		return false
+	}
+
+	return item.isImmuneToEviction()
+}
+
 // Peek gets an item
 func (ic *ImmunityCache) Peek(key []byte) (value interface{}, ok bool) {
 	return ic.Get(key)
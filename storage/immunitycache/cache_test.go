@@ -76,6 +76,19 @@ func TestImmunityCache_ImmunizeAgainstEviction(t *testing.T) {
 	require.Equal(t, 2, cache.CountImmune())
 }
 
+func TestImmunityCache_IsImmuneToEviction(t *testing.T) {
+	cache := newCacheToTest(1, 8, maxNumBytesUpperBound)
+
+	cache.addTestItems("a", "b")
+	cache.ImmunizeKeys(keysAsBytes([]string{"a", "c"}))
+	require.True(t, cache.IsImmuneToEviction([]byte("a")))
+	require.False(t, cache.IsImmuneToEviction([]byte("b")))
+	require.False(t, cache.IsImmuneToEviction([]byte("c")))
+
+	cache.addTestItems("c")
+	require.True(t, cache.IsImmuneToEviction([]byte("c")))
+}
+
 func TestImmunityCache_ImmunizeDoesNothingIfCapacityReached(t *testing.T) {
 	cache := newCacheToTest(1, 4, maxNumBytesUpperBound)
 
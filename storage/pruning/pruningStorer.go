@@ -90,6 +90,7 @@ type PruningStorer struct {
 	mutEpochPrepareHdr     sync.RWMutex
 	epochPrepareHdr        *block.MetaBlock
 	oldDataCleanerProvider clean.OldDataCleanerProvider
+	metricsHandler         common.MetricsHandler
 	identifier             string
 	numOfEpochsToKeep      uint32
 	numOfActivePersisters  uint32
@@ -133,6 +134,9 @@ func initPruningStorer(
 	if check.IfNil(args.PathManager) {
 		return nil, storage.ErrNilPathManager
 	}
+	if check.IfNil(args.MetricsHandler) {
+		return nil, storage.ErrNilMetricsHandler
+	}
 	if args.MaxBatchSize > int(args.CacheConf.Capacity) {
 		return nil, storage.ErrCacheSizeIsLowerThanBatchSize
 	}
@@ -168,6 +172,7 @@ func initPruningStorer(
 		numOfEpochsToKeep:      args.NumOfEpochsToKeep,
 		numOfActivePersisters:  args.NumOfActivePersisters,
 		oldDataCleanerProvider: args.OldDataCleanerProvider,
+		metricsHandler:         args.MetricsHandler,
 	}
 
 	if args.BloomFilterConf.Size != 0 { // if size is 0, that means an empty config was used so bloom filter will be nil
@@ -344,6 +349,7 @@ func (ps *PruningStorer) createAndInitPersister(pd *persisterData) (storage.Pers
 // if the key may be in the db. If bloom filter confirms then it further searches in the databases.
 func (ps *PruningStorer) Get(key []byte) ([]byte, error) {
 	v, ok := ps.cacher.Get(key)
+	ps.metricsHandler.AddStorageCacheRequest(ps.identifier, ok)
 	if ok {
 		return v.([]byte), nil
 	}
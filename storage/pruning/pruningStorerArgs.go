@@ -1,6 +1,7 @@
 package pruning
 
 import (
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/clean"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
@@ -17,6 +18,7 @@ type StorerArgs struct {
 	BloomFilterConf           storageUnit.BloomConfig
 	Notifier                  EpochStartNotifier
 	OldDataCleanerProvider    clean.OldDataCleanerProvider
+	MetricsHandler            common.MetricsHandler
 	MaxBatchSize              int
 	NumOfEpochsToKeep         uint32
 	NumOfActivePersisters     uint32
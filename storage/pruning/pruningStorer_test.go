@@ -22,6 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/pruning"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -75,6 +76,7 @@ func getDefaultArgs() *pruning.StorerArgs {
 		NumOfActivePersisters:  2,
 		Notifier:               &mock.EpochStartNotifierStub{},
 		OldDataCleanerProvider: &testscommon.OldDataCleanerProviderStub{},
+		MetricsHandler:         &statusHandler.MetricsHandlerStub{},
 		MaxBatchSize:           10,
 	}
 }
@@ -103,6 +105,7 @@ func getDefaultArgsSerialDB() *pruning.StorerArgs {
 		NumOfActivePersisters:  2,
 		Notifier:               &mock.EpochStartNotifierStub{},
 		OldDataCleanerProvider: &testscommon.OldDataCleanerProviderStub{},
+		MetricsHandler:         &statusHandler.MetricsHandlerStub{},
 		MaxBatchSize:           20,
 	}
 }
@@ -152,6 +155,17 @@ func TestNewPruningStorer_NilPathManagerShouldErr(t *testing.T) {
 	assert.Equal(t, storage.ErrNilPathManager, err)
 }
 
+func TestNewPruningStorer_NilMetricsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getDefaultArgs()
+	args.MetricsHandler = nil
+	ps, err := pruning.NewPruningStorer(args)
+
+	assert.Nil(t, ps)
+	assert.Equal(t, storage.ErrNilMetricsHandler, err)
+}
+
 func TestNewPruningStorer_NilPersisterFactoryShouldErr(t *testing.T) {
 	t.Parallel()
 
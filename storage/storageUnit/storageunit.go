@@ -16,6 +16,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
 	"github.com/ElrondNetwork/elrond-go/storage/bloom"
 	"github.com/ElrondNetwork/elrond-go/storage/fifocache"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
@@ -43,11 +44,11 @@ const (
 
 var log = logger.GetOrCreate("storage/storageUnit")
 
-// LvlDB currently the only supported DBs
-// More to be added
+// LvlDB, LvlDBSerial, BadgerDB and MemoryDB are the currently supported DBs
 const (
 	LvlDB       DBType = "LvlDB"
 	LvlDBSerial DBType = "LvlDBSerial"
+	BadgerDB    DBType = "BadgerDB"
 	MemoryDB    DBType = "MemoryDB"
 )
 
@@ -78,6 +79,11 @@ type CacheConfig struct {
 	Capacity             uint32
 	SizePerSender        uint32
 	Shards               uint32
+	// ReplacementGasPriceBumpPercent is only used by the transactions pool
+	ReplacementGasPriceBumpPercent uint32
+	// ValidityWindowEnabled and ValidityWindowInRounds are only used by the transactions pool
+	ValidityWindowEnabled  bool
+	ValidityWindowInRounds uint64
 }
 
 // String returns a readable representation of the object
@@ -428,6 +434,8 @@ func NewDB(argDB ArgDB) (storage.Persister, error) {
 			db, err = leveldb.NewDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
 		case LvlDBSerial:
 			db, err = leveldb.NewSerialDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
+		case BadgerDB:
+			db, err = badgerdb.NewDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize)
 		case MemoryDB:
 			db = memorydb.New()
 		default:
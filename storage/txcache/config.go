@@ -17,6 +17,8 @@ const maxNumBytesPerSenderLowerBound = maxNumItemsPerSenderLowerBound * 1
 const maxNumBytesPerSenderUpperBound = 33_554_432 // 32 MB
 const numTxsToPreemptivelyEvictLowerBound = 1
 const numSendersToPreemptivelyEvictLowerBound = 1
+const replacementGasPriceBumpPercentUpperBound = 1000
+const validityWindowInRoundsLowerBound = 1
 
 // ConfigSourceMe holds cache configuration
 type ConfigSourceMe struct {
@@ -28,11 +30,19 @@ type ConfigSourceMe struct {
 	CountThreshold                uint32
 	CountPerSenderThreshold       uint32
 	NumSendersToPreemptivelyEvict uint32
+	// ReplacementGasPriceBumpPercent is the minimum gas price increase (in percents) a transaction must have in order
+	// to replace a pending transaction with the same sender and nonce. Zero disables the replacement.
+	ReplacementGasPriceBumpPercent uint32
+	// ValidityWindowEnabled activates the removal of the transactions which stayed in the cache for more than
+	// ValidityWindowInRounds rounds, as notified by NotifyRound
+	ValidityWindowEnabled  bool
+	ValidityWindowInRounds uint64
 }
 
 type senderConstraints struct {
-	maxNumTxs   uint32
-	maxNumBytes uint32
+	maxNumTxs                      uint32
+	maxNumBytes                    uint32
+	replacementGasPriceBumpPercent uint32
 }
 
 // TODO: Upon further analysis and brainstorming, add some sensible minimum accepted values for the appropriate fields.
@@ -49,6 +59,12 @@ func (config *ConfigSourceMe) verify() error {
 	if config.CountPerSenderThreshold < maxNumItemsPerSenderLowerBound {
 		return fmt.Errorf("%w: config.CountPerSenderThreshold is invalid", storage.ErrInvalidConfig)
 	}
+	if config.ReplacementGasPriceBumpPercent > replacementGasPriceBumpPercentUpperBound {
+		return fmt.Errorf("%w: config.ReplacementGasPriceBumpPercent is invalid", storage.ErrInvalidConfig)
+	}
+	if config.ValidityWindowEnabled && config.ValidityWindowInRounds < validityWindowInRoundsLowerBound {
+		return fmt.Errorf("%w: config.ValidityWindowInRounds is invalid", storage.ErrInvalidConfig)
+	}
 	if config.EvictionEnabled {
 		if config.NumBytesThreshold < maxNumBytesLowerBound || config.NumBytesThreshold > maxNumBytesUpperBound {
 			return fmt.Errorf("%w: config.NumBytesThreshold is invalid", storage.ErrInvalidConfig)
@@ -66,8 +82,9 @@ func (config *ConfigSourceMe) verify() error {
 
 func (config *ConfigSourceMe) getSenderConstraints() senderConstraints {
 	return senderConstraints{
-		maxNumBytes: config.NumBytesPerSenderThreshold,
-		maxNumTxs:   config.CountPerSenderThreshold,
+		maxNumBytes:                    config.NumBytesPerSenderThreshold,
+		maxNumTxs:                      config.CountPerSenderThreshold,
+		replacementGasPriceBumpPercent: config.ReplacementGasPriceBumpPercent,
 	}
 }
 
@@ -88,6 +105,8 @@ type ConfigDestinationMe struct {
 	MaxNumItems                 uint32
 	MaxNumBytes                 uint32
 	NumItemsToPreemptivelyEvict uint32
+	// ReplacementGasPriceBumpPercent has the same meaning as in ConfigSourceMe
+	ReplacementGasPriceBumpPercent uint32
 }
 
 // TODO: Upon further analysis and brainstorming, add some sensible minimum accepted values for the appropriate fields.
@@ -107,6 +126,9 @@ func (config *ConfigDestinationMe) verify() error {
 	if config.NumItemsToPreemptivelyEvict < numTxsToPreemptivelyEvictLowerBound {
 		return fmt.Errorf("%w: config.NumItemsToPreemptivelyEvict is invalid", storage.ErrInvalidConfig)
 	}
+	if config.ReplacementGasPriceBumpPercent > replacementGasPriceBumpPercentUpperBound {
+		return fmt.Errorf("%w: config.ReplacementGasPriceBumpPercent is invalid", storage.ErrInvalidConfig)
+	}
 
 	return nil
 }
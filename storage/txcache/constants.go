@@ -7,3 +7,5 @@ const senderGracePeriodLowerBound = 2
 const senderGracePeriodUpperBound = 2
 
 const numEvictedTxsToDisplay = 3
+
+const maxNumRecentlyEvictedTxs = 10000
@@ -1,6 +1,10 @@
 package txcache
 
 import (
+	"bytes"
+	"fmt"
+	"sync"
+
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/immunitycache"
 )
@@ -11,6 +15,10 @@ var _ storage.Cacher = (*CrossTxCache)(nil)
 type CrossTxCache struct {
 	*immunitycache.ImmunityCache
 	config ConfigDestinationMe
+
+	// txsBySenderAndNonce is only maintained if the replacement of transactions is enabled
+	mutexTxsBySenderAndNonce sync.Mutex
+	txsBySenderAndNonce      map[string][]byte
 }
 
 // NewCrossTxCache creates a new transactions cache
@@ -36,8 +44,9 @@ func NewCrossTxCache(config ConfigDestinationMe) (*CrossTxCache, error) {
 	}
 
 	cache := CrossTxCache{
-		ImmunityCache: immunityCache,
-		config:        config,
+		ImmunityCache:       immunityCache,
+		config:              config,
+		txsBySenderAndNonce: make(map[string][]byte),
 	}
 
 	return &cache, nil
@@ -56,8 +65,108 @@ func (cache *CrossTxCache) ImmunizeTxsAgainstEviction(keys [][]byte) {
 }
 
 // AddTx adds a transaction in the cache
+// If the replacement of transactions is enabled, a pending transaction with the same sender and nonce is removed,
+// as long as it is not immune to eviction and the incoming transaction is priced high enough.
 func (cache *CrossTxCache) AddTx(tx *WrappedTransaction) (has, added bool) {
-	return cache.HasOrAdd(tx.TxHash, tx, int(tx.Size))
+	if cache.config.ReplacementGasPriceBumpPercent == 0 {
+		return cache.HasOrAdd(tx.TxHash, tx, int(tx.Size))
+	}
+
+	return cache.addTxWithReplacement(tx)
+}
+
+func (cache *CrossTxCache) addTxWithReplacement(tx *WrappedTransaction) (has, added bool) {
+	key := senderAndNonceKey(tx.Tx.GetSndAddr(), tx.Tx.GetNonce())
+
+	cache.mutexTxsBySenderAndNonce.Lock()
+	defer cache.mutexTxsBySenderAndNonce.Unlock()
+
+	existingTx, ok := cache.getTxBySenderAndNonceNoLock(key)
+	if ok && !existingTx.sameAs(tx) {
+		canReplace := !cache.IsImmuneToEviction(existingTx.TxHash) &&
+			isReplacementGasPriceSufficient(existingTx.Tx.GetGasPrice(), tx.Tx.GetGasPrice(), cache.config.ReplacementGasPriceBumpPercent)
+		if !canReplace {
+			// The incoming transaction is kept alongside the existing one, but it isn't tracked by sender and nonce
+			return cache.HasOrAdd(tx.TxHash, tx, int(tx.Size))
+		}
+
+		log.Trace("CrossTxCache.AddTx(): replaced transaction with same nonce", "name", cache.config.Name, "tx", tx.TxHash, "replaced", existingTx.TxHash)
+		_ = cache.RemoveWithResult(existingTx.TxHash)
+	}
+
+	has, added = cache.HasOrAdd(tx.TxHash, tx, int(tx.Size))
+	if added {
+		cache.txsBySenderAndNonce[key] = tx.TxHash
+		cache.removeStaleEntriesIfNeededNoLock()
+	}
+
+	return has, added
+}
+
+func (cache *CrossTxCache) getTxBySenderAndNonceNoLock(key string) (*WrappedTransaction, bool) {
+	txHash, ok := cache.txsBySenderAndNonce[key]
+	if !ok {
+		return nil, false
+	}
+
+	tx, ok := cache.GetByTxHash(txHash)
+	if !ok {
+		// The transaction has been evicted or removed in the meantime
+		delete(cache.txsBySenderAndNonce, key)
+		return nil, false
+	}
+
+	return tx, true
+}
+
+// The underlying cache does not notify about evicted items, so we occasionally clean up the stale entries
+func (cache *CrossTxCache) removeStaleEntriesIfNeededNoLock() {
+	if len(cache.txsBySenderAndNonce) <= 2*int(cache.config.MaxNumItems) {
+		return
+	}
+
+	for key, txHash := range cache.txsBySenderAndNonce {
+		if !cache.Has(txHash) {
+			delete(cache.txsBySenderAndNonce, key)
+		}
+	}
+}
+
+func (cache *CrossTxCache) removeFromTxsBySenderAndNonce(tx *WrappedTransaction) {
+	key := senderAndNonceKey(tx.Tx.GetSndAddr(), tx.Tx.GetNonce())
+
+	cache.mutexTxsBySenderAndNonce.Lock()
+	defer cache.mutexTxsBySenderAndNonce.Unlock()
+
+	if bytes.Equal(cache.txsBySenderAndNonce[key], tx.TxHash) {
+		delete(cache.txsBySenderAndNonce, key)
+	}
+}
+
+// CheckTxReplacement verifies that a transaction having the same sender and nonce as a pending one (if any) is
+// priced high enough to replace it
+func (cache *CrossTxCache) CheckTxReplacement(txHash []byte, sender []byte, nonce uint64, gasPrice uint64) error {
+	bumpPercent := cache.config.ReplacementGasPriceBumpPercent
+	if bumpPercent == 0 {
+		return nil
+	}
+
+	cache.mutexTxsBySenderAndNonce.Lock()
+	existingTx, ok := cache.getTxBySenderAndNonceNoLock(senderAndNonceKey(sender, nonce))
+	cache.mutexTxsBySenderAndNonce.Unlock()
+
+	if !ok || bytes.Equal(existingTx.TxHash, txHash) {
+		return nil
+	}
+	if !isReplacementGasPriceSufficient(existingTx.Tx.GetGasPrice(), gasPrice, bumpPercent) {
+		return createReplacementUnderpricedError(existingTx, bumpPercent)
+	}
+
+	return nil
+}
+
+func senderAndNonceKey(sender []byte, nonce uint64) string {
+	return fmt.Sprintf("%s_%d", sender, nonce)
 }
 
 // GetByTxHash gets the transaction by hash
@@ -93,6 +202,13 @@ func (cache *CrossTxCache) Peek(key []byte) (value interface{}, ok bool) {
 
 // RemoveTxByHash removes tx by hash
 func (cache *CrossTxCache) RemoveTxByHash(txHash []byte) bool {
+	if cache.config.ReplacementGasPriceBumpPercent > 0 {
+		tx, ok := cache.GetByTxHash(txHash)
+		if ok {
+			cache.removeFromTxsBySenderAndNonce(tx)
+		}
+	}
+
 	return cache.RemoveWithResult(txHash)
 }
 
@@ -109,6 +109,11 @@ func (cache *DisabledCache) UnRegisterHandler(string) {
 func (cache *DisabledCache) NotifyAccountNonce(_ []byte, _ uint64) {
 }
 
+// CheckTxReplacement returns nil
+func (cache *DisabledCache) CheckTxReplacement(_ []byte, _ []byte, _ uint64, _ uint64) error {
+	return nil
+}
+
 // ImmunizeTxsAgainstEviction does nothing
 func (cache *DisabledCache) ImmunizeTxsAgainstEviction(_ [][]byte) {
 }
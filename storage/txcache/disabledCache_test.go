@@ -28,6 +28,13 @@ func TestDisabledCache_DoesNothing(t *testing.T) {
 
 	require.NotPanics(t, func() { cache.ForEachTransaction(func(_ []byte, _ *WrappedTransaction) {}) })
 
+	err := cache.CheckTxReplacement([]byte{}, []byte{}, 0, 0)
+	require.Nil(t, err)
+
+	require.NotPanics(t, func() { cache.NotifyRound(42) })
+	err = cache.CheckTxExpiry([]byte{})
+	require.Nil(t, err)
+
 	cache.Clear()
 
 	evicted := cache.Put(nil, nil, 0)
@@ -59,6 +66,6 @@ func TestDisabledCache_DoesNothing(t *testing.T) {
 	require.NotPanics(t, func() { cache.RegisterHandler(func(_ []byte, _ interface{}) {}, "") })
 	require.False(t, cache.IsInterfaceNil())
 
-	err := cache.Close()
+	err = cache.Close()
 	require.Nil(t, err)
 }
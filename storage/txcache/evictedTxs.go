@@ -0,0 +1,84 @@
+package txcache
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// EvictionReasonPoolFull marks transactions removed because the cache exceeded its capacity
+	EvictionReasonPoolFull = "poolFull"
+	// EvictionReasonSenderLimit marks transactions removed because their sender exceeded its own limits
+	EvictionReasonSenderLimit = "senderLimit"
+	// EvictionReasonSwept marks transactions removed together with a sender that failed too many selections
+	EvictionReasonSwept = "swept"
+	// EvictionReasonReplaced marks transactions replaced by a better priced one having the same sender and nonce
+	EvictionReasonReplaced = "replaced"
+	// EvictionReasonExpired marks transactions which stayed in the cache for longer than the validity window
+	EvictionReasonExpired = "expired"
+)
+
+// EvictedTransaction holds the details about a transaction that was removed from the cache without being selected
+type EvictedTransaction struct {
+	TxHash    []byte
+	Sender    []byte
+	Reason    string
+	Timestamp int64
+}
+
+// evictedTxsIndex keeps the most recently evicted transactions, by hash
+// When full, the oldest records are overwritten
+type evictedTxsIndex struct {
+	mutex     sync.RWMutex
+	records   []*EvictedTransaction
+	nextIndex int
+	byHash    map[string]*EvictedTransaction
+}
+
+func newEvictedTxsIndex(capacity int) *evictedTxsIndex {
+	return &evictedTxsIndex{
+		records: make([]*EvictedTransaction, capacity),
+		byHash:  make(map[string]*EvictedTransaction, capacity),
+	}
+}
+
+func (index *evictedTxsIndex) add(txHashes [][]byte, sender []byte, reason string) {
+	if len(index.records) == 0 || len(txHashes) == 0 {
+		return
+	}
+
+	timestamp := time.Now().Unix()
+
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+
+	for _, txHash := range txHashes {
+		oldRecord := index.records[index.nextIndex]
+		if oldRecord != nil && index.byHash[string(oldRecord.TxHash)] == oldRecord {
+			delete(index.byHash, string(oldRecord.TxHash))
+		}
+
+		record := &EvictedTransaction{
+			TxHash:    txHash,
+			Sender:    sender,
+			Reason:    reason,
+			Timestamp: timestamp,
+		}
+		index.records[index.nextIndex] = record
+		index.byHash[string(txHash)] = record
+		index.nextIndex = (index.nextIndex + 1) % len(index.records)
+	}
+}
+
+func (index *evictedTxsIndex) get(txHash []byte) (*EvictedTransaction, bool) {
+	index.mutex.RLock()
+	defer index.mutex.RUnlock()
+
+	record, ok := index.byHash[string(txHash)]
+	if !ok {
+		return nil, false
+	}
+
+	recordCopy := *record
+	return &recordCopy, true
+}
@@ -0,0 +1,66 @@
+package txcache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvictedTxsIndex_AddAndGet(t *testing.T) {
+	index := newEvictedTxsIndex(3)
+
+	index.add([][]byte{[]byte("a"), []byte("b")}, []byte("alice"), EvictionReasonPoolFull)
+	index.add([][]byte{[]byte("c")}, []byte("bob"), EvictionReasonReplaced)
+
+	record, ok := index.get([]byte("a"))
+	require.True(t, ok)
+	require.Equal(t, []byte("a"), record.TxHash)
+	require.Equal(t, []byte("alice"), record.Sender)
+	require.Equal(t, EvictionReasonPoolFull, record.Reason)
+
+	record, ok = index.get([]byte("c"))
+	require.True(t, ok)
+	require.Equal(t, []byte("bob"), record.Sender)
+	require.Equal(t, EvictionReasonReplaced, record.Reason)
+
+	record, ok = index.get([]byte("d"))
+	require.False(t, ok)
+	require.Nil(t, record)
+}
+
+func TestEvictedTxsIndex_OldestRecordsAreForgotten(t *testing.T) {
+	index := newEvictedTxsIndex(3)
+
+	index.add([][]byte{[]byte("a"), []byte("b"), []byte("c")}, []byte("alice"), EvictionReasonSwept)
+	index.add([][]byte{[]byte("d")}, []byte("bob"), EvictionReasonSwept)
+
+	_, ok := index.get([]byte("a"))
+	require.False(t, ok)
+	for _, txHash := range []string{"b", "c", "d"} {
+		_, ok = index.get([]byte(txHash))
+		require.True(t, ok)
+	}
+	require.Len(t, index.byHash, 3)
+}
+
+func TestEvictedTxsIndex_SameHashEvictedTwice(t *testing.T) {
+	index := newEvictedTxsIndex(2)
+
+	index.add([][]byte{[]byte("a")}, []byte("alice"), EvictionReasonPoolFull)
+	index.add([][]byte{[]byte("a")}, []byte("alice"), EvictionReasonSwept)
+	// overwrites the first record of "a", which must not remove the newer one
+	index.add([][]byte{[]byte("b")}, []byte("bob"), EvictionReasonSwept)
+
+	record, ok := index.get([]byte("a"))
+	require.True(t, ok)
+	require.Equal(t, EvictionReasonSwept, record.Reason)
+}
+
+func TestEvictedTxsIndex_ZeroCapacity(t *testing.T) {
+	index := newEvictedTxsIndex(0)
+
+	index.add([][]byte{[]byte("a")}, []byte("alice"), EvictionReasonPoolFull)
+
+	_, ok := index.get([]byte("a"))
+	require.False(t, ok)
+}
@@ -32,6 +32,7 @@ func (cache *TxCache) doEviction() {
 	journal.passOneNumSteps, journal.passOneNumTxs, journal.passOneNumSenders = cache.evictSendersInLoop()
 	journal.evictionPerformed = true
 	cache.evictionJournal = journal
+	cache.numTxsEvicted.Add(int64(journal.passOneNumTxs))
 
 	cache.monitorEvictionEnd(stopWatch)
 	cache.destroySnapshotOfSenders()
@@ -95,7 +96,7 @@ func (cache *TxCache) evictSendersWhile(shouldContinue func() bool) (step uint32
 		batchEndBounded := core.MinUint32(batchEnd, snapshotLength)
 		batch := snapshot[batchStart:batchEndBounded]
 
-		numTxsEvictedInStep, numSendersEvictedInStep := cache.evictSendersAndTheirTxs(batch)
+		numTxsEvictedInStep, numSendersEvictedInStep := cache.evictSendersAndTheirTxs(batch, EvictionReasonPoolFull)
 
 		numTxs += numTxsEvictedInStep
 		numSenders += numSendersEvictedInStep
@@ -115,13 +116,15 @@ func (cache *TxCache) evictSendersWhile(shouldContinue func() bool) (step uint32
 }
 
 // This is called concurrently by two goroutines: the eviction one and the sweeping one
-func (cache *TxCache) evictSendersAndTheirTxs(listsToEvict []*txListForSender) (uint32, uint32) {
+func (cache *TxCache) evictSendersAndTheirTxs(listsToEvict []*txListForSender, reason string) (uint32, uint32) {
 	sendersToEvict := make([]string, 0, len(listsToEvict))
 	txsToEvict := make([][]byte, 0, approximatelyCountTxInLists(listsToEvict))
 
 	for _, txList := range listsToEvict {
+		txHashes := txList.getTxHashes()
 		sendersToEvict = append(sendersToEvict, txList.sender)
-		txsToEvict = append(txsToEvict, txList.getTxHashes()...)
+		txsToEvict = append(txsToEvict, txHashes...)
+		cache.recentlyEvictedTxs.add(txHashes, []byte(txList.sender), reason)
 	}
 
 	return cache.doEvictItems(txsToEvict, sendersToEvict)
@@ -43,6 +43,10 @@ func TestEviction_EvictSendersWhileTooManyTxs(t *testing.T) {
 	require.Equal(t, uint32(100), nSenders)
 	require.Equal(t, int64(100), cache.txListBySender.counter.Get())
 	require.Equal(t, int64(100), cache.txByHash.counter.Get())
+	require.Len(t, cache.recentlyEvictedTxs.byHash, 100)
+	for _, record := range cache.recentlyEvictedTxs.byHash {
+		require.Equal(t, EvictionReasonPoolFull, record.Reason)
+	}
 }
 
 func TestEviction_EvictSendersWhileTooManyBytes(t *testing.T) {
@@ -293,13 +297,13 @@ func Test_EvictSendersAndTheirTxs_Concurrently(t *testing.T) {
 
 		go func() {
 			snapshot := cache.txListBySender.getSnapshotAscending()
-			cache.evictSendersAndTheirTxs(snapshot)
+			cache.evictSendersAndTheirTxs(snapshot, EvictionReasonPoolFull)
 			wg.Done()
 		}()
 
 		go func() {
 			snapshot := cache.txListBySender.getSnapshotAscending()
-			cache.evictSendersAndTheirTxs(snapshot)
+			cache.evictSendersAndTheirTxs(snapshot, EvictionReasonPoolFull)
 			wg.Done()
 		}()
 	}
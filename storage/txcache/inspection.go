@@ -0,0 +1,109 @@
+package txcache
+
+// SenderSnapshot holds a point-in-time view of the transactions of a sender, ordered by nonce
+type SenderSnapshot struct {
+	Sender              []byte
+	AccountNonce        uint64
+	AccountNonceKnown   bool
+	Score               uint32
+	NumFailedSelections uint64
+	IsInGracePeriod     bool
+	NumBytes            uint64
+	Transactions        []*WrappedTransaction
+}
+
+// Statistics holds aggregate information about the content of a transactions cache
+type Statistics struct {
+	NumTxs         uint64
+	NumBytes       uint64
+	NumSenders     uint64
+	NumEvictedTxs  uint64
+	NumSweptTxs    uint64
+	NumReplacedTxs uint64
+	NumExpiredTxs  uint64
+	// ScoreDistribution holds the number of senders in each score chunk (index = score)
+	ScoreDistribution []uint32
+}
+
+// GetSenderSnapshot returns a snapshot of the transactions of the provided sender, if any
+func (cache *TxCache) GetSenderSnapshot(sender []byte) (*SenderSnapshot, bool) {
+	listForSender, ok := cache.txListBySender.getListForSender(string(sender))
+	if !ok {
+		return nil, false
+	}
+
+	return listForSender.getSnapshot(), true
+}
+
+// GetStatistics returns aggregate information about the content of the cache
+func (cache *TxCache) GetStatistics() Statistics {
+	return Statistics{
+		NumTxs:            cache.CountTx(),
+		NumBytes:          uint64(cache.NumBytes()),
+		NumSenders:        cache.CountSenders(),
+		NumEvictedTxs:     cache.numTxsEvicted.GetUint64(),
+		NumSweptTxs:       cache.numTxsSwept.GetUint64(),
+		NumReplacedTxs:    cache.numTxsReplaced.GetUint64(),
+		NumExpiredTxs:     cache.numTxsExpired.GetUint64(),
+		ScoreDistribution: cache.txListBySender.backingMap.ScoreChunksCounts(),
+	}
+}
+
+// GetEvictedTransaction returns the details about a recently evicted transaction, if it is still remembered by the cache
+func (cache *TxCache) GetEvictedTransaction(txHash []byte) (*EvictedTransaction, bool) {
+	return cache.recentlyEvictedTxs.get(txHash)
+}
+
+// GetSenderSnapshot returns false, since the cross shard cache does not keep transactions by sender
+func (cache *CrossTxCache) GetSenderSnapshot(_ []byte) (*SenderSnapshot, bool) {
+	return nil, false
+}
+
+// GetStatistics returns aggregate information about the content of the cache
+func (cache *CrossTxCache) GetStatistics() Statistics {
+	return Statistics{
+		NumTxs:   uint64(cache.Count()),
+		NumBytes: uint64(cache.NumBytes()),
+	}
+}
+
+// GetEvictedTransaction returns false, since the cross shard cache does not keep track of the evicted transactions
+func (cache *CrossTxCache) GetEvictedTransaction(_ []byte) (*EvictedTransaction, bool) {
+	return nil, false
+}
+
+// GetSenderSnapshot returns false
+func (cache *DisabledCache) GetSenderSnapshot(_ []byte) (*SenderSnapshot, bool) {
+	return nil, false
+}
+
+// GetStatistics returns empty statistics
+func (cache *DisabledCache) GetStatistics() Statistics {
+	return Statistics{}
+}
+
+// GetEvictedTransaction returns false
+func (cache *DisabledCache) GetEvictedTransaction(_ []byte) (*EvictedTransaction, bool) {
+	return nil, false
+}
+
+func (listForSender *txListForSender) getSnapshot() *SenderSnapshot {
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+
+	transactions := make([]*WrappedTransaction, 0, listForSender.countTx())
+	for element := listForSender.items.Front(); element != nil; element = element.Next() {
+		transactions = append(transactions, element.Value.(*WrappedTransaction))
+	}
+
+	return &SenderSnapshot{
+		Sender:              []byte(listForSender.sender),
+		AccountNonce:        listForSender.accountNonce.Get(),
+		AccountNonceKnown:   listForSender.accountNonceKnown.IsSet(),
+		Score:               listForSender.getLastComputedScore(),
+		NumFailedSelections: listForSender.numFailedSelections.GetUint64(),
+		IsInGracePeriod:     listForSender.isInGracePeriod(),
+		NumBytes:            listForSender.totalBytes.GetUint64(),
+		Transactions:        transactions,
+	}
+}
@@ -0,0 +1,141 @@
+package txcache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxCache_GetSenderSnapshot(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+
+	cache.AddTx(createTx([]byte("tx-alice-4"), "alice", 4))
+	cache.AddTx(createTx([]byte("tx-alice-1"), "alice", 1))
+	cache.AddTx(createTx([]byte("tx-alice-2"), "alice", 2))
+	cache.AddTx(createTx([]byte("tx-bob-7"), "bob", 7))
+	cache.NotifyAccountNonce([]byte("alice"), 1)
+
+	snapshot, ok := cache.GetSenderSnapshot([]byte("carol"))
+	require.False(t, ok)
+	require.Nil(t, snapshot)
+
+	snapshot, ok = cache.GetSenderSnapshot([]byte("alice"))
+	require.True(t, ok)
+	require.Equal(t, []byte("alice"), snapshot.Sender)
+	require.True(t, snapshot.AccountNonceKnown)
+	require.Equal(t, uint64(1), snapshot.AccountNonce)
+	require.Len(t, snapshot.Transactions, 3)
+	require.Equal(t, []byte("tx-alice-1"), snapshot.Transactions[0].TxHash)
+	require.Equal(t, []byte("tx-alice-2"), snapshot.Transactions[1].TxHash)
+	require.Equal(t, []byte("tx-alice-4"), snapshot.Transactions[2].TxHash)
+
+	expectedNumBytes := uint64(0)
+	for _, tx := range snapshot.Transactions {
+		expectedNumBytes += uint64(tx.Size)
+	}
+	require.Equal(t, expectedNumBytes, snapshot.NumBytes)
+
+	// the snapshot is not affected by later changes
+	cache.RemoveTxByHash([]byte("tx-alice-1"))
+	require.Len(t, snapshot.Transactions, 3)
+}
+
+func TestTxCache_GetStatistics(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+
+	stats := cache.GetStatistics()
+	require.Equal(t, uint64(0), stats.NumTxs)
+	require.Equal(t, uint64(0), stats.NumSenders)
+	require.Len(t, stats.ScoreDistribution, int(numberOfScoreChunks))
+
+	cache.AddTx(createTx([]byte("tx-alice-1"), "alice", 1))
+	cache.AddTx(createTx([]byte("tx-alice-2"), "alice", 2))
+	cache.AddTx(createTx([]byte("tx-bob-1"), "bob", 1))
+
+	stats = cache.GetStatistics()
+	require.Equal(t, uint64(3), stats.NumTxs)
+	require.Equal(t, uint64(2), stats.NumSenders)
+	require.Equal(t, uint64(cache.NumBytes()), stats.NumBytes)
+	require.Equal(t, uint64(0), stats.NumEvictedTxs)
+
+	numSendersInDistribution := uint32(0)
+	for _, count := range stats.ScoreDistribution {
+		numSendersInDistribution += count
+	}
+	require.Equal(t, uint32(2), numSendersInDistribution)
+}
+
+func TestTxCache_GetStatisticsCountsTxsEvictedWrtSenderLimit(t *testing.T) {
+	cache := newCacheToTest(maxNumBytesPerSenderUpperBound, 2)
+
+	cache.AddTx(createTx([]byte("tx-alice-1"), "alice", 1))
+	cache.AddTx(createTx([]byte("tx-alice-2"), "alice", 2))
+	cache.AddTx(createTx([]byte("tx-alice-3"), "alice", 3))
+
+	stats := cache.GetStatistics()
+	require.Equal(t, uint64(2), stats.NumTxs)
+	require.Equal(t, uint64(1), stats.NumEvictedTxs)
+
+	evicted, ok := cache.GetEvictedTransaction([]byte("tx-alice-3"))
+	require.True(t, ok)
+	require.Equal(t, []byte("alice"), evicted.Sender)
+	require.Equal(t, EvictionReasonSenderLimit, evicted.Reason)
+}
+
+func TestTxCache_GetEvictedTransaction(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+
+	cache.AddTx(createTx([]byte("alice-42"), "alice", 42))
+	cache.AddTx(createTx([]byte("bob-42"), "bob", 42))
+
+	evicted, ok := cache.GetEvictedTransaction([]byte("alice-42"))
+	require.False(t, ok)
+	require.Nil(t, evicted)
+
+	cache.sweepingListOfSenders = []*txListForSender{cache.getListForSender("alice")}
+	cache.sweepSweepable()
+
+	evicted, ok = cache.GetEvictedTransaction([]byte("alice-42"))
+	require.True(t, ok)
+	require.Equal(t, []byte("alice-42"), evicted.TxHash)
+	require.Equal(t, []byte("alice"), evicted.Sender)
+	require.Equal(t, EvictionReasonSwept, evicted.Reason)
+
+	// a removal (e.g. after the transaction got included in a block) is not an eviction
+	cache.RemoveTxByHash([]byte("bob-42"))
+	_, ok = cache.GetEvictedTransaction([]byte("bob-42"))
+	require.False(t, ok)
+}
+
+func TestCrossTxCache_Inspection(t *testing.T) {
+	cache := newCrossTxCacheToTest(1, 8, 8192)
+
+	cache.AddTx(createTx([]byte("a"), "alice", 1))
+	cache.AddTx(createTx([]byte("b"), "bob", 1))
+
+	snapshot, ok := cache.GetSenderSnapshot([]byte("alice"))
+	require.False(t, ok)
+	require.Nil(t, snapshot)
+
+	stats := cache.GetStatistics()
+	require.Equal(t, uint64(2), stats.NumTxs)
+	require.Equal(t, uint64(cache.NumBytes()), stats.NumBytes)
+	require.Equal(t, uint64(0), stats.NumSenders)
+
+	evicted, ok := cache.GetEvictedTransaction([]byte("a"))
+	require.False(t, ok)
+	require.Nil(t, evicted)
+}
+
+func TestDisabledCache_Inspection(t *testing.T) {
+	cache := NewDisabledCache()
+
+	snapshot, ok := cache.GetSenderSnapshot([]byte("alice"))
+	require.False(t, ok)
+	require.Nil(t, snapshot)
+	require.Equal(t, Statistics{}, cache.GetStatistics())
+
+	evicted, ok := cache.GetEvictedTransaction([]byte("a"))
+	require.False(t, ok)
+	require.Nil(t, evicted)
+}
@@ -0,0 +1,125 @@
+package txcache
+
+import (
+	"container/list"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ComputeMinReplacementGasPrice returns the minimum gas price a transaction must have in order to replace a pending
+// transaction (same sender and nonce) having the provided gas price. The result saturates at math.MaxUint64.
+func ComputeMinReplacementGasPrice(gasPrice uint64, bumpPercent uint32) uint64 {
+	minGasPrice := big.NewInt(0).SetUint64(gasPrice)
+	minGasPrice.Mul(minGasPrice, big.NewInt(int64(100+uint64(bumpPercent))))
+	minGasPrice.Div(minGasPrice, big.NewInt(100))
+	if !minGasPrice.IsUint64() {
+		return math.MaxUint64
+	}
+
+	return minGasPrice.Uint64()
+}
+
+func isReplacementGasPriceSufficient(existingGasPrice uint64, incomingGasPrice uint64, bumpPercent uint32) bool {
+	return incomingGasPrice >= ComputeMinReplacementGasPrice(existingGasPrice, bumpPercent)
+}
+
+func createReplacementUnderpricedError(existingTx *WrappedTransaction, bumpPercent uint32) error {
+	return fmt.Errorf("%w: pending transaction %s has gas price %d, a replacement needs at least %d",
+		storage.ErrTxReplacementUnderpriced,
+		hex.EncodeToString(existingTx.TxHash),
+		existingTx.Tx.GetGasPrice(),
+		ComputeMinReplacementGasPrice(existingTx.Tx.GetGasPrice(), bumpPercent),
+	)
+}
+
+// CheckTxReplacement verifies that a transaction having the same sender and nonce as pending ones (if any) is
+// priced high enough to replace them. Transactions already in the cache (same hash) are not checked.
+func (cache *TxCache) CheckTxReplacement(txHash []byte, sender []byte, nonce uint64, gasPrice uint64) error {
+	bumpPercent := cache.config.ReplacementGasPriceBumpPercent
+	if bumpPercent == 0 {
+		return nil
+	}
+
+	listForSender, ok := cache.txListBySender.getListForSender(string(sender))
+	if !ok {
+		return nil
+	}
+
+	return listForSender.checkReplacement(txHash, nonce, gasPrice, bumpPercent)
+}
+
+func (listForSender *txListForSender) checkReplacement(txHash []byte, nonce uint64, gasPrice uint64, bumpPercent uint32) error {
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+
+	incomingTx := &WrappedTransaction{TxHash: txHash}
+	for _, element := range listForSender.findListElementsWithNonce(nonce) {
+		existingTx := element.Value.(*WrappedTransaction)
+		if incomingTx.sameAs(existingTx) {
+			return nil
+		}
+		if !isReplacementGasPriceSufficient(existingTx.Tx.GetGasPrice(), gasPrice, bumpPercent) {
+			return createReplacementUnderpricedError(existingTx, bumpPercent)
+		}
+	}
+
+	return nil
+}
+
+// removeTxsReplacedBy removes the transactions having the same nonce as the incoming one, if the incoming transaction
+// is priced high enough to replace all of them. Otherwise, nothing is removed and the incoming transaction will be
+// stored alongside the existing ones (legacy behavior).
+// This function should only be used in critical section (listForSender.mutex)
+func (listForSender *txListForSender) removeTxsReplacedBy(incomingTx *WrappedTransaction) [][]byte {
+	bumpPercent := listForSender.constraints.replacementGasPriceBumpPercent
+	if bumpPercent == 0 {
+		return nil
+	}
+
+	elements := listForSender.findListElementsWithNonce(incomingTx.Tx.GetNonce())
+	for _, element := range elements {
+		existingTx := element.Value.(*WrappedTransaction)
+		if incomingTx.sameAs(existingTx) {
+			return nil
+		}
+		if !isReplacementGasPriceSufficient(existingTx.Tx.GetGasPrice(), incomingTx.Tx.GetGasPrice(), bumpPercent) {
+			return nil
+		}
+	}
+
+	replacedTxHashes := make([][]byte, 0, len(elements))
+	for _, element := range elements {
+		listForSender.items.Remove(element)
+		listForSender.onRemovedListElement(element)
+
+		value := element.Value.(*WrappedTransaction)
+		replacedTxHashes = append(replacedTxHashes, value.TxHash)
+	}
+
+	return replacedTxHashes
+}
+
+// This function should only be used in critical section (listForSender.mutex)
+func (listForSender *txListForSender) findListElementsWithNonce(nonce uint64) []*list.Element {
+	elements := make([]*list.Element, 0)
+
+	for element := listForSender.items.Front(); element != nil; element = element.Next() {
+		value := element.Value.(*WrappedTransaction)
+		valueNonce := value.Tx.GetNonce()
+
+		if valueNonce == nonce {
+			elements = append(elements, element)
+		}
+
+		// Optimization: stop search at this point, since the list is sorted by nonce
+		if valueNonce > nonce {
+			break
+		}
+	}
+
+	return elements
+}
@@ -0,0 +1,171 @@
+package txcache
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeMinReplacementGasPrice(t *testing.T) {
+	require.Equal(t, uint64(1000), ComputeMinReplacementGasPrice(1000, 0))
+	require.Equal(t, uint64(1100), ComputeMinReplacementGasPrice(1000, 10))
+	require.Equal(t, uint64(1101), ComputeMinReplacementGasPrice(1001, 10))
+	require.Equal(t, uint64(3000), ComputeMinReplacementGasPrice(1000, 200))
+	require.Equal(t, uint64(math.MaxUint64), ComputeMinReplacementGasPrice(math.MaxUint64, 10))
+	require.Equal(t, uint64(math.MaxUint64), ComputeMinReplacementGasPrice(math.MaxUint64, 0))
+}
+
+func TestTxCache_AddTxShouldReplaceWhenGasPriceIsHighEnough(t *testing.T) {
+	cache := newCacheToTestWithReplacement(10)
+
+	cache.AddTx(createTxWithParams([]byte("tx-1"), "alice", 1, 128, 50000, 1000))
+	cache.AddTx(createTxWithParams([]byte("tx-2"), "alice", 2, 128, 50000, 1000))
+	cache.AddTx(createTxWithParams([]byte("tx-2++"), "alice", 2, 128, 50000, 1100))
+
+	require.Equal(t, uint64(2), cache.CountTx())
+	_, ok := cache.GetByTxHash([]byte("tx-2"))
+	require.False(t, ok)
+	_, ok = cache.GetByTxHash([]byte("tx-2++"))
+	require.True(t, ok)
+	require.Equal(t, []string{"tx-1", "tx-2++"}, cache.getHashesForSender("alice"))
+	require.Equal(t, uint64(1), cache.GetStatistics().NumReplacedTxs)
+
+	evicted, ok := cache.GetEvictedTransaction([]byte("tx-2"))
+	require.True(t, ok)
+	require.Equal(t, EvictionReasonReplaced, evicted.Reason)
+}
+
+func TestTxCache_AddTxShouldKeepBothWhenGasPriceIsNotHighEnough(t *testing.T) {
+	cache := newCacheToTestWithReplacement(10)
+
+	cache.AddTx(createTxWithParams([]byte("tx-2"), "alice", 2, 128, 50000, 1000))
+	cache.AddTx(createTxWithParams([]byte("tx-2+"), "alice", 2, 128, 50000, 1099))
+
+	require.Equal(t, uint64(2), cache.CountTx())
+	require.Equal(t, []string{"tx-2+", "tx-2"}, cache.getHashesForSender("alice"))
+	require.Equal(t, uint64(0), cache.GetStatistics().NumReplacedTxs)
+
+	// A transaction priced high enough with respect to all the pending ones replaces all of them
+	cache.AddTx(createTxWithParams([]byte("tx-2++"), "alice", 2, 128, 50000, 1209))
+	require.Equal(t, []string{"tx-2++"}, cache.getHashesForSender("alice"))
+	require.Equal(t, uint64(1), cache.CountTx())
+	require.Equal(t, uint64(2), cache.GetStatistics().NumReplacedTxs)
+}
+
+func TestTxCache_AddTxShouldNotReplaceWhenDisabled(t *testing.T) {
+	cache := newCacheToTestWithReplacement(0)
+
+	cache.AddTx(createTxWithParams([]byte("tx-2"), "alice", 2, 128, 50000, 1000))
+	cache.AddTx(createTxWithParams([]byte("tx-2++"), "alice", 2, 128, 50000, 5000))
+
+	require.Equal(t, uint64(2), cache.CountTx())
+	require.Equal(t, []string{"tx-2++", "tx-2"}, cache.getHashesForSender("alice"))
+}
+
+func TestTxCache_AddTxShouldNotReplaceItself(t *testing.T) {
+	cache := newCacheToTestWithReplacement(10)
+
+	ok, added := cache.AddTx(createTxWithParams([]byte("tx-2"), "alice", 2, 128, 50000, 1000))
+	require.True(t, ok)
+	require.True(t, added)
+
+	ok, added = cache.AddTx(createTxWithParams([]byte("tx-2"), "alice", 2, 128, 50000, 1000))
+	require.True(t, ok)
+	require.False(t, added)
+	require.Equal(t, uint64(1), cache.CountTx())
+	require.Equal(t, uint64(0), cache.GetStatistics().NumReplacedTxs)
+}
+
+func TestTxCache_CheckTxReplacement(t *testing.T) {
+	cache := newCacheToTestWithReplacement(10)
+	cache.AddTx(createTxWithParams([]byte("tx-2"), "alice", 2, 128, 50000, 1000))
+
+	require.Nil(t, cache.CheckTxReplacement([]byte("tx-3"), []byte("alice"), 3, 1))
+	require.Nil(t, cache.CheckTxReplacement([]byte("tx-bob"), []byte("bob"), 2, 1))
+	require.Nil(t, cache.CheckTxReplacement([]byte("tx-2"), []byte("alice"), 2, 1000))
+	require.Nil(t, cache.CheckTxReplacement([]byte("tx-2++"), []byte("alice"), 2, 1100))
+
+	err := cache.CheckTxReplacement([]byte("tx-2+"), []byte("alice"), 2, 1099)
+	require.True(t, errors.Is(err, storage.ErrTxReplacementUnderpriced))
+	require.Contains(t, err.Error(), "1100")
+
+	disabledReplacementCache := newCacheToTestWithReplacement(0)
+	disabledReplacementCache.AddTx(createTxWithParams([]byte("tx-2"), "alice", 2, 128, 50000, 1000))
+	require.Nil(t, disabledReplacementCache.CheckTxReplacement([]byte("tx-2+"), []byte("alice"), 2, 1))
+}
+
+func TestCrossTxCache_AddTxShouldReplace(t *testing.T) {
+	cache := newCrossTxCacheToTestWithReplacement(10)
+
+	cache.AddTx(createTxWithParams([]byte("tx-2"), "alice", 2, 128, 50000, 1000))
+	cache.AddTx(createTxWithParams([]byte("tx-2+"), "alice", 2, 128, 50000, 1099))
+	require.Equal(t, 2, cache.Len())
+
+	cache.AddTx(createTxWithParams([]byte("tx-2++"), "alice", 2, 128, 50000, 1100))
+	require.Equal(t, 2, cache.Len())
+	require.False(t, cache.Has([]byte("tx-2")))
+	require.True(t, cache.Has([]byte("tx-2+")))
+	require.True(t, cache.Has([]byte("tx-2++")))
+
+	err := cache.CheckTxReplacement([]byte("tx-2+++"), []byte("alice"), 2, 1200)
+	require.True(t, errors.Is(err, storage.ErrTxReplacementUnderpriced))
+	require.Nil(t, cache.CheckTxReplacement([]byte("tx-2+++"), []byte("alice"), 2, 1210))
+}
+
+func TestCrossTxCache_AddTxShouldNotReplaceImmuneTx(t *testing.T) {
+	cache := newCrossTxCacheToTestWithReplacement(10)
+
+	cache.AddTx(createTxWithParams([]byte("tx-2"), "alice", 2, 128, 50000, 1000))
+	cache.ImmunizeTxsAgainstEviction([][]byte{[]byte("tx-2")})
+	cache.AddTx(createTxWithParams([]byte("tx-2++"), "alice", 2, 128, 50000, 5000))
+
+	require.True(t, cache.Has([]byte("tx-2")))
+	require.True(t, cache.Has([]byte("tx-2++")))
+}
+
+func TestCrossTxCache_RemoveTxByHashShouldForgetSenderAndNonce(t *testing.T) {
+	cache := newCrossTxCacheToTestWithReplacement(10)
+
+	cache.AddTx(createTxWithParams([]byte("tx-2"), "alice", 2, 128, 50000, 1000))
+	require.NotNil(t, cache.CheckTxReplacement([]byte("tx-2+"), []byte("alice"), 2, 1000))
+
+	cache.RemoveTxByHash([]byte("tx-2"))
+	require.Nil(t, cache.CheckTxReplacement([]byte("tx-2+"), []byte("alice"), 2, 1000))
+	require.Equal(t, 0, len(cache.txsBySenderAndNonce))
+}
+
+func newCacheToTestWithReplacement(bumpPercent uint32) *TxCache {
+	txGasHandler, _ := dummyParams()
+	cache, err := NewTxCache(ConfigSourceMe{
+		Name:                           "test",
+		NumChunks:                      16,
+		NumBytesPerSenderThreshold:     maxNumBytesPerSenderUpperBound,
+		CountPerSenderThreshold:        math.MaxUint32,
+		ReplacementGasPriceBumpPercent: bumpPercent,
+	}, txGasHandler)
+	if err != nil {
+		panic(fmt.Sprintf("newCacheToTestWithReplacement(): %s", err))
+	}
+
+	return cache
+}
+
+func newCrossTxCacheToTestWithReplacement(bumpPercent uint32) *CrossTxCache {
+	cache, err := NewCrossTxCache(ConfigDestinationMe{
+		Name:                           "test",
+		NumChunks:                      1,
+		MaxNumItems:                    8,
+		MaxNumBytes:                    8192,
+		NumItemsToPreemptivelyEvict:    1,
+		ReplacementGasPriceBumpPercent: bumpPercent,
+	})
+	if err != nil {
+		panic(fmt.Sprintf("newCrossTxCacheToTestWithReplacement(): %s", err))
+	}
+
+	return cache
+}
@@ -23,7 +23,8 @@ func (cache *TxCache) sweepSweepable() {
 	}
 
 	stopWatch := cache.monitorSweepingStart()
-	numTxs, numSenders := cache.evictSendersAndTheirTxs(cache.sweepingListOfSenders)
+	numTxs, numSenders := cache.evictSendersAndTheirTxs(cache.sweepingListOfSenders, EvictionReasonSwept)
+	cache.numTxsSwept.Add(int64(numTxs))
 	cache.initSweepable()
 	cache.monitorSweepingEnd(numTxs, numSenders, stopWatch)
 }
@@ -24,6 +24,12 @@ type TxCache struct {
 	numSendersWithInitialGap  atomic.Counter
 	numSendersWithMiddleGap   atomic.Counter
 	numSendersInGracePeriod   atomic.Counter
+	numTxsEvicted             atomic.Counter
+	numTxsSwept               atomic.Counter
+	numTxsReplaced            atomic.Counter
+	numTxsExpired             atomic.Counter
+	currentRound              atomic.Uint64
+	recentlyEvictedTxs        *evictedTxsIndex
 	sweepingMutex             sync.Mutex
 	sweepingListOfSenders     []*txListForSender
 }
@@ -48,11 +54,12 @@ func NewTxCache(config ConfigSourceMe, txGasHandler TxGasHandler) (*TxCache, err
 	scoreComputerObj := newDefaultScoreComputer(txFeeHelper)
 
 	txCache := &TxCache{
-		name:            config.Name,
-		txListBySender:  newTxListBySenderMap(numChunks, senderConstraintsObj, scoreComputerObj, txGasHandler, txFeeHelper),
-		txByHash:        newTxByHashMap(numChunks),
-		config:          config,
-		evictionJournal: evictionJournal{},
+		name:               config.Name,
+		txListBySender:     newTxListBySenderMap(numChunks, senderConstraintsObj, scoreComputerObj, txGasHandler, txFeeHelper),
+		txByHash:           newTxByHashMap(numChunks),
+		config:             config,
+		evictionJournal:    evictionJournal{},
+		recentlyEvictedTxs: newEvictedTxsIndex(maxNumRecentlyEvictedTxs),
 	}
 
 	txCache.initSweepable()
@@ -70,8 +77,12 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 		cache.doEviction()
 	}
 
+	if tx.AddedInRound == 0 {
+		tx.AddedInRound = cache.currentRound.Get()
+	}
+
 	addedInByHash := cache.txByHash.addTx(tx)
-	addedInBySender, evicted := cache.txListBySender.addTx(tx)
+	addedInBySender, evicted, replaced := cache.txListBySender.addTx(tx)
 	if addedInByHash != addedInBySender {
 		// This can happen  when two go-routines concur to add the same transaction:
 		// - A adds to "txByHash"
@@ -83,7 +94,16 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 
 	if len(evicted) > 0 {
 		cache.monitorEvictionWrtSenderLimit(tx.Tx.GetSndAddr(), evicted)
+		cache.numTxsEvicted.Add(int64(len(evicted)))
 		cache.txByHash.RemoveTxsBulk(evicted)
+		cache.recentlyEvictedTxs.add(evicted, tx.Tx.GetSndAddr(), EvictionReasonSenderLimit)
+	}
+
+	if len(replaced) > 0 {
+		log.Trace("TxCache.AddTx(): replaced transactions with same nonce", "name", cache.name, "tx", tx.TxHash, "sender", tx.Tx.GetSndAddr(), "replaced", replaced)
+		cache.numTxsReplaced.Add(int64(len(replaced)))
+		cache.txByHash.RemoveTxsBulk(replaced)
+		cache.recentlyEvictedTxs.add(replaced, tx.Tx.GetSndAddr(), EvictionReasonReplaced)
 	}
 
 	// The return value "added" is true even if transaction added, but then removed due to limits be sender.
@@ -55,6 +55,14 @@ func Test_NewTxCache(t *testing.T) {
 	badConfig.CountPerSenderThreshold = 0
 	requireErrorOnNewTxCache(t, badConfig, storage.ErrInvalidConfig, "config.CountPerSenderThreshold", txGasHandler)
 
+	badConfig = config
+	badConfig.ReplacementGasPriceBumpPercent = replacementGasPriceBumpPercentUpperBound + 1
+	requireErrorOnNewTxCache(t, badConfig, storage.ErrInvalidConfig, "config.ReplacementGasPriceBumpPercent", txGasHandler)
+
+	badConfig = config
+	badConfig.ValidityWindowEnabled = true
+	requireErrorOnNewTxCache(t, badConfig, storage.ErrInvalidConfig, "config.ValidityWindowInRounds", txGasHandler)
+
 	badConfig = config
 	cache, err = NewTxCache(config, nil)
 	require.Nil(t, cache)
@@ -49,14 +49,18 @@ func newTxListForSender(sender string, constraints *senderConstraints, onScoreCh
 
 // AddTx adds a transaction in sender's list
 // This is a "sorted" insert
-func (listForSender *txListForSender) AddTx(tx *WrappedTransaction, gasHandler TxGasHandler, txFeeHelper feeHelper) (bool, [][]byte) {
+// It returns the hashes of the transactions evicted due to sender constraints and the hashes of the transactions
+// replaced by the incoming one (same nonce, gas price high enough)
+func (listForSender *txListForSender) AddTx(tx *WrappedTransaction, gasHandler TxGasHandler, txFeeHelper feeHelper) (bool, [][]byte, [][]byte) {
 	// We don't allow concurrent interceptor goroutines to mutate a given sender's list
 	listForSender.mutex.Lock()
 	defer listForSender.mutex.Unlock()
 
+	replaced := listForSender.removeTxsReplacedBy(tx)
+
 	insertionPlace, err := listForSender.findInsertionPlace(tx)
 	if err != nil {
-		return false, nil
+		return false, nil, nil
 	}
 
 	if insertionPlace == nil {
@@ -68,7 +72,7 @@ func (listForSender *txListForSender) AddTx(tx *WrappedTransaction, gasHandler T
 	listForSender.onAddedTransaction(tx, gasHandler, txFeeHelper)
 	evicted := listForSender.applySizeConstraints()
 	listForSender.triggerScoreChange()
-	return true, evicted
+	return true, evicted, replaced
 }
 
 // This function should only be used in critical section (listForSender.mutex)
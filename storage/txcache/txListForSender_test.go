@@ -53,13 +53,13 @@ func TestListForSender_AddTx_IgnoresDuplicates(t *testing.T) {
 	list := newUnconstrainedListToTest()
 	txGasHandler, txFeeHelper := dummyParams()
 
-	added, _ := list.AddTx(createTx([]byte("tx1"), ".", 1), txGasHandler, txFeeHelper)
+	added, _, _ := list.AddTx(createTx([]byte("tx1"), ".", 1), txGasHandler, txFeeHelper)
 	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
+	added, _, _ = list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
 	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx3"), ".", 3), txGasHandler, txFeeHelper)
+	added, _, _ = list.AddTx(createTx([]byte("tx3"), ".", 3), txGasHandler, txFeeHelper)
 	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
+	added, _, _ = list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
 	require.False(t, added)
 }
 
@@ -73,17 +73,17 @@ func TestListForSender_AddTx_AppliesSizeConstraintsForNumTransactions(t *testing
 	list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx4"}, list.getTxHashesAsStrings())
 
-	_, evicted := list.AddTx(createTx([]byte("tx3"), ".", 3), txGasHandler, txFeeHelper)
+	_, evicted, _ := list.AddTx(createTx([]byte("tx3"), ".", 3), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx4"}, hashesAsStrings(evicted))
 
 	// Gives priority to higher gas - though undesirably to some extent, "tx3" is evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx2++"), ".", 2, 128, 42, 42), txGasHandler, txFeeHelper)
+	_, evicted, _ = list.AddTx(createTxWithParams([]byte("tx2++"), ".", 2, 128, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2++", "tx2"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx3"}, hashesAsStrings(evicted))
 
 	// Though Undesirably to some extent, "tx3++"" is added, then evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 128, 42, 42), txGasHandler, txFeeHelper)
+	_, evicted, _ = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 128, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2++", "tx2"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx3++"}, hashesAsStrings(evicted))
 }
@@ -95,20 +95,20 @@ func TestListForSender_AddTx_AppliesSizeConstraintsForNumBytes(t *testing.T) {
 	list.AddTx(createTxWithParams([]byte("tx1"), ".", 1, 128, 42, 42), txGasHandler, txFeeHelper)
 	list.AddTx(createTxWithParams([]byte("tx2"), ".", 2, 512, 42, 42), txGasHandler, txFeeHelper)
 	list.AddTx(createTxWithParams([]byte("tx3"), ".", 3, 256, 42, 42), txGasHandler, txFeeHelper)
-	_, evicted := list.AddTx(createTxWithParams([]byte("tx5"), ".", 4, 256, 42, 42), txGasHandler, txFeeHelper)
+	_, evicted, _ := list.AddTx(createTxWithParams([]byte("tx5"), ".", 4, 256, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx5"}, hashesAsStrings(evicted))
 
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx5--"), ".", 4, 128, 42, 42), txGasHandler, txFeeHelper)
+	_, evicted, _ = list.AddTx(createTxWithParams([]byte("tx5--"), ".", 4, 128, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3", "tx5--"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{}, hashesAsStrings(evicted))
 
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx4"), ".", 4, 128, 42, 42), txGasHandler, txFeeHelper)
+	_, evicted, _ = list.AddTx(createTxWithParams([]byte("tx4"), ".", 4, 128, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3", "tx4"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx5--"}, hashesAsStrings(evicted))
 
 	// Gives priority to higher gas - though undesirably to some extent, "tx4" is evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 256, 42, 100), txGasHandler, txFeeHelper)
+	_, evicted, _ = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 256, 42, 100), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3++", "tx3"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx4"}, hashesAsStrings(evicted))
 }
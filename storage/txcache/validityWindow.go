@@ -0,0 +1,85 @@
+package txcache
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// NotifyRound should be called by external components (such as the block processor) in order to inform the cache about
+// the round of the last committed block. The transactions added afterwards are stamped with this round. If the validity
+// window is enabled, the transactions which stayed in the cache for more than the window are removed.
+func (cache *TxCache) NotifyRound(round uint64) {
+	cache.currentRound.Set(round)
+	if !cache.config.ValidityWindowEnabled {
+		return
+	}
+
+	cache.removeExpiredTxs(round)
+}
+
+func (cache *TxCache) removeExpiredTxs(round uint64) {
+	expiredTxs := make([]*WrappedTransaction, 0)
+	cache.txByHash.forEach(func(_ []byte, tx *WrappedTransaction) {
+		if cache.isExpired(tx, round) {
+			expiredTxs = append(expiredTxs, tx)
+		}
+	})
+
+	numRemoved := 0
+	for _, tx := range expiredTxs {
+		if !cache.RemoveTxByHash(tx.TxHash) {
+			continue
+		}
+
+		cache.recentlyEvictedTxs.add([][]byte{tx.TxHash}, tx.Tx.GetSndAddr(), EvictionReasonExpired)
+		numRemoved++
+	}
+
+	if numRemoved > 0 {
+		cache.numTxsExpired.Add(int64(numRemoved))
+		log.Debug("TxCache.removeExpiredTxs()", "name", cache.name, "round", round, "num removed", numRemoved)
+	}
+}
+
+func (cache *TxCache) isExpired(tx *WrappedTransaction, round uint64) bool {
+	return tx.AddedInRound+cache.config.ValidityWindowInRounds < round
+}
+
+// CheckTxExpiry returns an error if the transaction was recently removed from the cache because it stayed there for
+// more than the validity window
+func (cache *TxCache) CheckTxExpiry(txHash []byte) error {
+	if !cache.config.ValidityWindowEnabled {
+		return nil
+	}
+
+	evicted, ok := cache.recentlyEvictedTxs.get(txHash)
+	if !ok || evicted.Reason != EvictionReasonExpired {
+		return nil
+	}
+
+	return fmt.Errorf("%w: transaction %s stayed in the pool for more than %d rounds",
+		storage.ErrTxExpired,
+		hex.EncodeToString(txHash),
+		cache.config.ValidityWindowInRounds,
+	)
+}
+
+// NotifyRound does nothing, since the validity window only applies to the transactions of the own shard
+func (cache *CrossTxCache) NotifyRound(_ uint64) {
+}
+
+// CheckTxExpiry returns nil, since the validity window only applies to the transactions of the own shard
+func (cache *CrossTxCache) CheckTxExpiry(_ []byte) error {
+	return nil
+}
+
+// NotifyRound does nothing
+func (cache *DisabledCache) NotifyRound(_ uint64) {
+}
+
+// CheckTxExpiry returns nil
+func (cache *DisabledCache) CheckTxExpiry(_ []byte) error {
+	return nil
+}
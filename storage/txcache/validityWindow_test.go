@@ -0,0 +1,104 @@
+package txcache
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxCache_NotifyRoundShouldRemoveTheExpiredTxs(t *testing.T) {
+	cache := newCacheToTestWithValidityWindow(true, 2)
+
+	cache.NotifyRound(10)
+	cache.AddTx(createTx([]byte("tx-alice-1"), "alice", 1))
+	cache.NotifyRound(11)
+	cache.AddTx(createTx([]byte("tx-alice-2"), "alice", 2))
+	cache.AddTx(createTx([]byte("tx-bob-1"), "bob", 1))
+
+	tx, _ := cache.GetByTxHash([]byte("tx-alice-1"))
+	require.Equal(t, uint64(10), tx.AddedInRound)
+	tx, _ = cache.GetByTxHash([]byte("tx-bob-1"))
+	require.Equal(t, uint64(11), tx.AddedInRound)
+
+	// The transactions are kept for 2 rounds after the round they were added in
+	cache.NotifyRound(12)
+	require.Equal(t, uint64(3), cache.CountTx())
+
+	cache.NotifyRound(13)
+	require.Equal(t, uint64(2), cache.CountTx())
+	require.False(t, cache.Has([]byte("tx-alice-1")))
+	require.Equal(t, []string{"tx-alice-2"}, cache.getHashesForSender("alice"))
+	require.Equal(t, uint64(1), cache.GetStatistics().NumExpiredTxs)
+
+	evicted, ok := cache.GetEvictedTransaction([]byte("tx-alice-1"))
+	require.True(t, ok)
+	require.Equal(t, EvictionReasonExpired, evicted.Reason)
+	require.Equal(t, []byte("alice"), evicted.Sender)
+
+	cache.NotifyRound(20)
+	require.Equal(t, uint64(0), cache.CountTx())
+	require.Equal(t, uint64(0), cache.CountSenders())
+	require.Equal(t, uint64(3), cache.GetStatistics().NumExpiredTxs)
+}
+
+func TestTxCache_NotifyRoundShouldNotRemoveWhenDisabled(t *testing.T) {
+	cache := newCacheToTestWithValidityWindow(false, 0)
+
+	cache.NotifyRound(10)
+	cache.AddTx(createTx([]byte("tx-alice-1"), "alice", 1))
+	cache.NotifyRound(1000)
+
+	require.True(t, cache.Has([]byte("tx-alice-1")))
+	require.Equal(t, uint64(0), cache.GetStatistics().NumExpiredTxs)
+	require.Nil(t, cache.CheckTxExpiry([]byte("tx-alice-1")))
+}
+
+func TestTxCache_CheckTxExpiry(t *testing.T) {
+	cache := newCacheToTestWithValidityWindow(true, 2)
+
+	cache.AddTx(createTx([]byte("tx-alice-1"), "alice", 1))
+	cache.AddTx(createTx([]byte("tx-alice-2"), "alice", 2))
+	cache.NotifyRound(1)
+	require.Nil(t, cache.CheckTxExpiry([]byte("tx-alice-1")))
+
+	cache.NotifyRound(3)
+	err := cache.CheckTxExpiry([]byte("tx-alice-1"))
+	require.True(t, errors.Is(err, storage.ErrTxExpired))
+	require.Contains(t, err.Error(), "2 rounds")
+
+	// Transactions removed for other reasons are not reported as expired
+	cache.recentlyEvictedTxs.add([][]byte{[]byte("tx-bob-1")}, []byte("bob"), EvictionReasonSwept)
+	require.Nil(t, cache.CheckTxExpiry([]byte("tx-bob-1")))
+	require.Nil(t, cache.CheckTxExpiry([]byte("tx-unknown")))
+}
+
+func TestCrossTxCache_ValidityWindowDoesNothing(t *testing.T) {
+	cache := newCrossTxCacheToTestWithReplacement(0)
+
+	cache.AddTx(createTx([]byte("tx-alice-1"), "alice", 1))
+	cache.NotifyRound(1000)
+
+	require.True(t, cache.Has([]byte("tx-alice-1")))
+	require.Nil(t, cache.CheckTxExpiry([]byte("tx-alice-1")))
+}
+
+func newCacheToTestWithValidityWindow(enabled bool, windowInRounds uint64) *TxCache {
+	txGasHandler, _ := dummyParams()
+	cache, err := NewTxCache(ConfigSourceMe{
+		Name:                       "test",
+		NumChunks:                  16,
+		NumBytesPerSenderThreshold: maxNumBytesPerSenderUpperBound,
+		CountPerSenderThreshold:    math.MaxUint32,
+		ValidityWindowEnabled:      enabled,
+		ValidityWindowInRounds:     windowInRounds,
+	}, txGasHandler)
+	if err != nil {
+		panic(fmt.Sprintf("newCacheToTestWithValidityWindow(): %s", err))
+	}
+
+	return cache
+}
@@ -16,6 +16,8 @@ type WrappedTransaction struct {
 	ReceiverShardID      uint32
 	Size                 int64
 	TxFeeScoreNormalized uint64
+	// AddedInRound is the round notified to the cache when the transaction was added
+	AddedInRound uint64
 }
 
 func (wrappedTx *WrappedTransaction) sameAs(another *WrappedTransaction) bool {
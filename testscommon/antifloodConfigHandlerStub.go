@@ -0,0 +1,32 @@
+package testscommon
+
+import "github.com/ElrondNetwork/elrond-go/config"
+
+// AntifloodConfigHandlerStub -
+type AntifloodConfigHandlerStub struct {
+	CheckAntifloodConfigCalled func(cfg config.AntifloodConfig) error
+	ApplyAntifloodConfigCalled func(cfg config.AntifloodConfig) error
+}
+
+// CheckAntifloodConfig -
+func (stub *AntifloodConfigHandlerStub) CheckAntifloodConfig(cfg config.AntifloodConfig) error {
+	if stub.CheckAntifloodConfigCalled != nil {
+		return stub.CheckAntifloodConfigCalled(cfg)
+	}
+
+	return nil
+}
+
+// ApplyAntifloodConfig -
+func (stub *AntifloodConfigHandlerStub) ApplyAntifloodConfig(cfg config.AntifloodConfig) error {
+	if stub.ApplyAntifloodConfigCalled != nil {
+		return stub.ApplyAntifloodConfigCalled(cfg)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *AntifloodConfigHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,22 @@
+package testscommon
+
+import "github.com/ElrondNetwork/elrond-go/config"
+
+// AntifloodConfigReloaderStub -
+type AntifloodConfigReloaderStub struct {
+	ReloadCalled func() (*config.AntifloodConfig, error)
+}
+
+// Reload -
+func (stub *AntifloodConfigReloaderStub) Reload() (*config.AntifloodConfig, error) {
+	if stub.ReloadCalled != nil {
+		return stub.ReloadCalled()
+	}
+
+	return &config.AntifloodConfig{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *AntifloodConfigReloaderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
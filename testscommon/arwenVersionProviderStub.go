@@ -0,0 +1,20 @@
+package testscommon
+
+// ArwenVersionProviderStub -
+type ArwenVersionProviderStub struct {
+	GetArwenVersionCalled func(epoch uint32) string
+}
+
+// GetArwenVersion -
+func (stub *ArwenVersionProviderStub) GetArwenVersion(epoch uint32) string {
+	if stub.GetArwenVersionCalled != nil {
+		return stub.GetArwenVersionCalled(epoch)
+	}
+
+	return ""
+}
+
+// IsInterfaceNil -
+func (stub *ArwenVersionProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
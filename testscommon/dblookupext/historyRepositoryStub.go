@@ -9,11 +9,13 @@ import (
 
 // HistoryRepositoryStub -
 type HistoryRepositoryStub struct {
-	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler, logs map[string]data.LogHandler) error
+	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, txsPool map[string]data.TransactionHandler, scrsPool map[string]data.TransactionHandler, rewardsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler, logs map[string]data.LogHandler) error
 	OnNotarizedBlocksCalled            func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
 	GetMiniblockMetadataByTxHashCalled func(hash []byte) (*dblookupext.MiniblockMetadata, error)
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
+	GetLogsTxHashesCalled              func(address []byte, identifier []byte, blockNonce uint64, epoch uint32) (*dblookupext.LogsIndexRecord, error)
+	GetAddressHistoryCalled            func(address []byte, numSkipped uint64, maxEntries uint64) ([]*dblookupext.AddressHistoryEntry, uint64, error)
 	IsEnabledCalled                    func() bool
 }
 
@@ -22,12 +24,14 @@ func (hp *HistoryRepositoryStub) RecordBlock(
 	blockHeaderHash []byte,
 	blockHeader data.HeaderHandler,
 	blockBody data.BodyHandler,
+	txsPool map[string]data.TransactionHandler,
 	scrsPool map[string]data.TransactionHandler,
+	rewardsPool map[string]data.TransactionHandler,
 	receipts map[string]data.TransactionHandler,
 	logs map[string]data.LogHandler,
 ) error {
 	if hp.RecordBlockCalled != nil {
-		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, scrsPool, receipts, logs)
+		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, txsPool, scrsPool, rewardsPool, receipts, logs)
 	}
 	return nil
 }
@@ -68,6 +72,22 @@ func (hp *HistoryRepositoryStub) GetResultsHashesByTxHash(hash []byte, epoch uin
 	return nil, nil
 }
 
+// GetLogsTxHashes -
+func (hp *HistoryRepositoryStub) GetLogsTxHashes(address []byte, identifier []byte, blockNonce uint64, epoch uint32) (*dblookupext.LogsIndexRecord, error) {
+	if hp.GetLogsTxHashesCalled != nil {
+		return hp.GetLogsTxHashesCalled(address, identifier, blockNonce, epoch)
+	}
+	return nil, fmt.Errorf("logs index record not found")
+}
+
+// GetAddressHistory -
+func (hp *HistoryRepositoryStub) GetAddressHistory(address []byte, numSkipped uint64, maxEntries uint64) ([]*dblookupext.AddressHistoryEntry, uint64, error) {
+	if hp.GetAddressHistoryCalled != nil {
+		return hp.GetAddressHistoryCalled(address, numSkipped, maxEntries)
+	}
+	return make([]*dblookupext.AddressHistoryEntry, 0), 0, nil
+}
+
 // RevertBlock -
 func (hp *HistoryRepositoryStub) RevertBlock(_ data.HeaderHandler, _ data.BodyHandler) error {
 	return nil
@@ -334,9 +334,14 @@ func GetGeneralConfig() config.Config {
 			PollingIntervalInMinutes: 30,
 		},
 		TrieSync: config.TrieSyncConfig{
-			NumConcurrentTrieSyncers:  50,
-			MaxHardCapForMissingNodes: 500,
-			TrieSyncerVersion:         2,
+			NumConcurrentTrieSyncers:           50,
+			MaxHardCapForMissingNodes:          500,
+			TrieSyncerVersion:                  2,
+			MaxInFlightTrieNodes:               5000,
+			MinRequestBatchSize:                50,
+			MaxRequestBatchSize:                500,
+			TargetRequestLatencyInMilliseconds: 500,
+			RequestTimeoutInMilliseconds:       5000,
 		},
 		Antiflood: config.AntifloodConfig{
 			NumConcurrentResolverJobs: 2,
@@ -366,6 +371,9 @@ func GetGeneralConfig() config.Config {
 			Capacity: 10000,
 			Name:     "VMOutputCacher",
 		},
+		TxSimulator: config.TxSimulatorConfig{
+			MaxTxBundleSize: 10,
+		},
 	}
 }
 
@@ -2,7 +2,6 @@ package genericMocks
 
 import (
 	"encoding/hex"
-	"errors"
 	"fmt"
 	"sync"
 
@@ -160,8 +159,10 @@ func (sm *StorerMock) Has(key []byte) error {
 }
 
 // Remove -
-func (sm *StorerMock) Remove(_ []byte) error {
-	return errors.New("not implemented")
+func (sm *StorerMock) Remove(key []byte) error {
+	data := sm.GetCurrentEpochData()
+	data.Remove(string(key))
+	return nil
 }
 
 // ClearCache -
@@ -12,6 +12,8 @@ type OutportStub struct {
 	SaveValidatorsRatingCalled  func(index string, validatorsInfo []*indexer.ValidatorRatingInfo)
 	SaveValidatorsPubKeysCalled func(shardPubKeys map[uint32][][]byte, epoch uint32)
 	HasDriversCalled            func() bool
+	ReplayBlockCalled           func(args *indexer.ArgsSaveBlockData)
+	HasReplayDriversCalled      func() bool
 }
 
 // SaveBlock -
@@ -48,6 +50,21 @@ func (as *OutportStub) HasDrivers() bool {
 	return false
 }
 
+// HasReplayDrivers -
+func (as *OutportStub) HasReplayDrivers() bool {
+	if as.HasReplayDriversCalled != nil {
+		return as.HasReplayDriversCalled()
+	}
+	return false
+}
+
+// ReplayBlock -
+func (as *OutportStub) ReplayBlock(args *indexer.ArgsSaveBlockData) {
+	if as.ReplayBlockCalled != nil {
+		as.ReplayBlockCalled(args)
+	}
+}
+
 // RevertIndexedBlock -
 func (as *OutportStub) RevertIndexedBlock(_ data.HeaderHandler, _ data.BodyHandler) {
 
@@ -73,6 +90,11 @@ func (as *OutportStub) SubscribeDriver(_ outport.Driver) error {
 	return nil
 }
 
+// SubscribeLiveDriver -
+func (as *OutportStub) SubscribeLiveDriver(_ outport.Driver) error {
+	return nil
+}
+
 // FinalizedBlock -
 func (as *OutportStub) FinalizedBlock(_ []byte) {
 }
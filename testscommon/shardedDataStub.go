@@ -21,6 +21,9 @@ type ShardedDataStub struct {
 	ImmunizeSetOfDataAgainstEvictionCalled func(keys [][]byte, cacheID string)
 	CreateShardStoreCalled                 func(destCacheID string)
 	GetCountsCalled                        func() counting.CountsWithSize
+	CheckTxReplacementCalled               func(txHash []byte, sender []byte, nonce uint64, gasPrice uint64) error
+	NotifyRoundCalled                      func(round uint64)
+	CheckTxExpiryCalled                    func(txHash []byte) error
 }
 
 // NewShardedDataStub -
@@ -98,6 +101,31 @@ func (sd *ShardedDataStub) GetCounts() counting.CountsWithSize {
 	return &counting.NullCounts{}
 }
 
+// CheckTxReplacement -
+func (sd *ShardedDataStub) CheckTxReplacement(txHash []byte, sender []byte, nonce uint64, gasPrice uint64) error {
+	if sd.CheckTxReplacementCalled != nil {
+		return sd.CheckTxReplacementCalled(txHash, sender, nonce, gasPrice)
+	}
+
+	return nil
+}
+
+// NotifyRound -
+func (sd *ShardedDataStub) NotifyRound(round uint64) {
+	if sd.NotifyRoundCalled != nil {
+		sd.NotifyRoundCalled(round)
+	}
+}
+
+// CheckTxExpiry -
+func (sd *ShardedDataStub) CheckTxExpiry(txHash []byte) error {
+	if sd.CheckTxExpiryCalled != nil {
+		return sd.CheckTxExpiryCalled(txHash)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (shardedData *ShardedDataStub) IsInterfaceNil() bool {
 	return shardedData == nil
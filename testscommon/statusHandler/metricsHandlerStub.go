@@ -0,0 +1,112 @@
+package statusHandler
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// MetricsHandlerStub -
+type MetricsHandlerStub struct {
+	ObserveBlockOperationCalled        func(operation common.BlockOperation, duration time.Duration)
+	ObserveConsensusSubroundCalled     func(subround string, done bool, duration time.Duration)
+	AddInterceptedMessageCalled        func(topic string, accepted bool, size int)
+	AddAntifloodRejectionCalled        func(topic string, reason common.AntifloodReason)
+	AddTrieSyncReceivedNodesCalled     func(numNodes int)
+	AddTrieSyncLargeNodesCalled        func(numNodes int)
+	AddTrieSyncMissingNodesCalled      func(difference int)
+	SetTrieSyncEstimatedTimeLeftCalled func(duration time.Duration)
+	AddStorageCacheRequestCalled       func(unit string, hit bool)
+	SetOutportQueueSizeCalled          func(driver string, numRecords uint64, numBytes uint64)
+	AddOutportQueueRejectionCalled     func(driver string)
+	SetShardIDCalled                   func(shardID uint32)
+}
+
+// ObserveBlockOperation -
+func (stub *MetricsHandlerStub) ObserveBlockOperation(operation common.BlockOperation, duration time.Duration) {
+	if stub.ObserveBlockOperationCalled != nil {
+		stub.ObserveBlockOperationCalled(operation, duration)
+	}
+}
+
+// ObserveConsensusSubround -
+func (stub *MetricsHandlerStub) ObserveConsensusSubround(subround string, done bool, duration time.Duration) {
+	if stub.ObserveConsensusSubroundCalled != nil {
+		stub.ObserveConsensusSubroundCalled(subround, done, duration)
+	}
+}
+
+// AddInterceptedMessage -
+func (stub *MetricsHandlerStub) AddInterceptedMessage(topic string, accepted bool, size int) {
+	if stub.AddInterceptedMessageCalled != nil {
+		stub.AddInterceptedMessageCalled(topic, accepted, size)
+	}
+}
+
+// AddAntifloodRejection -
+func (stub *MetricsHandlerStub) AddAntifloodRejection(topic string, reason common.AntifloodReason) {
+	if stub.AddAntifloodRejectionCalled != nil {
+		stub.AddAntifloodRejectionCalled(topic, reason)
+	}
+}
+
+// AddTrieSyncReceivedNodes -
+func (stub *MetricsHandlerStub) AddTrieSyncReceivedNodes(numNodes int) {
+	if stub.AddTrieSyncReceivedNodesCalled != nil {
+		stub.AddTrieSyncReceivedNodesCalled(numNodes)
+	}
+}
+
+// AddTrieSyncLargeNodes -
+func (stub *MetricsHandlerStub) AddTrieSyncLargeNodes(numNodes int) {
+	if stub.AddTrieSyncLargeNodesCalled != nil {
+		stub.AddTrieSyncLargeNodesCalled(numNodes)
+	}
+}
+
+// AddTrieSyncMissingNodes -
+func (stub *MetricsHandlerStub) AddTrieSyncMissingNodes(difference int) {
+	if stub.AddTrieSyncMissingNodesCalled != nil {
+		stub.AddTrieSyncMissingNodesCalled(difference)
+	}
+}
+
+// SetTrieSyncEstimatedTimeLeft -
+func (stub *MetricsHandlerStub) SetTrieSyncEstimatedTimeLeft(duration time.Duration) {
+	if stub.SetTrieSyncEstimatedTimeLeftCalled != nil {
+		stub.SetTrieSyncEstimatedTimeLeftCalled(duration)
+	}
+}
+
+// AddStorageCacheRequest -
+func (stub *MetricsHandlerStub) AddStorageCacheRequest(unit string, hit bool) {
+	if stub.AddStorageCacheRequestCalled != nil {
+		stub.AddStorageCacheRequestCalled(unit, hit)
+	}
+}
+
+// SetOutportQueueSize -
+func (stub *MetricsHandlerStub) SetOutportQueueSize(driver string, numRecords uint64, numBytes uint64) {
+	if stub.SetOutportQueueSizeCalled != nil {
+		stub.SetOutportQueueSizeCalled(driver, numRecords, numBytes)
+	}
+}
+
+// AddOutportQueueRejection -
+func (stub *MetricsHandlerStub) AddOutportQueueRejection(driver string) {
+	if stub.AddOutportQueueRejectionCalled != nil {
+		stub.AddOutportQueueRejectionCalled(driver)
+	}
+}
+
+// SetShardID -
+func (stub *MetricsHandlerStub) SetShardID(shardID uint32) {
+	if stub.SetShardIDCalled != nil {
+		stub.SetShardIDCalled(shardID)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *MetricsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
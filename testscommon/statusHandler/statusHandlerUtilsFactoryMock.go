@@ -13,6 +13,7 @@ type StatusHandlersFactoryMock struct {
 // Create -
 func (shfm *StatusHandlersFactoryMock) Create(_ marshal.Marshalizer, _ typeConverters.Uint64ByteSliceConverter) (factory.StatusHandlersUtils, error) {
 	return &StatusHandlersUtilsMock{
-		AppStatusHandler: NewAppStatusHandlerMock(),
+		AppStatusHandler:    NewAppStatusHandlerMock(),
+		MetricsHandlerField: &MetricsHandlerStub{},
 	}, nil
 }
@@ -2,13 +2,15 @@ package statusHandler
 
 import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 // StatusHandlersUtilsMock -
 type StatusHandlersUtilsMock struct {
-	AppStatusHandler core.AppStatusHandler
+	AppStatusHandler    core.AppStatusHandler
+	MetricsHandlerField common.MetricsHandler
 }
 
 // UpdateStorerAndMetricsForPersistentHandler -
@@ -26,6 +28,11 @@ func (shum *StatusHandlersUtilsMock) Metrics() external.StatusMetricsHandler {
 	return nil
 }
 
+// MetricsHandler -
+func (shum *StatusHandlersUtilsMock) MetricsHandler() common.MetricsHandler {
+	return shum.MetricsHandlerField
+}
+
 // IsInterfaceNil -
 func (shum *StatusHandlersUtilsMock) IsInterfaceNil() bool {
 	return shum == nil
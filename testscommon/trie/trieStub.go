@@ -24,6 +24,9 @@ type TrieStub struct {
 	GetAllLeavesOnChannelCalled func(rootHash []byte) (chan core.KeyValueHolder, error)
 	GetProofCalled              func(key []byte) ([][]byte, []byte, error)
 	VerifyProofCalled           func(rootHash []byte, key []byte, proof [][]byte) (bool, error)
+	GetMultiProofCalled         func(keys [][]byte) ([][]byte, [][]byte, error)
+	GetRangeProofCalled         func(startKey []byte, endKey []byte, maxNumLeaves int) (*common.RangeProof, error)
+	CollectStatisticsCalled     func(rootHash []byte, handler common.TrieStatisticsHandler) error
 	GetStorageManagerCalled     func() common.StorageManager
 	GetSerializedNodeCalled     func(bytes []byte) ([]byte, error)
 	GetNumNodesCalled           func() common.NumNodesDTO
@@ -49,6 +52,15 @@ func (ts *TrieStub) GetProof(key []byte) ([][]byte, []byte, error) {
 	return nil, nil, nil
 }
 
+// CollectStatistics -
+func (ts *TrieStub) CollectStatistics(rootHash []byte, handler common.TrieStatisticsHandler) error {
+	if ts.CollectStatisticsCalled != nil {
+		return ts.CollectStatisticsCalled(rootHash, handler)
+	}
+
+	return nil
+}
+
 // VerifyProof -
 func (ts *TrieStub) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error) {
 	if ts.VerifyProofCalled != nil {
@@ -58,6 +70,24 @@ func (ts *TrieStub) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bo
 	return false, nil
 }
 
+// GetMultiProof -
+func (ts *TrieStub) GetMultiProof(keys [][]byte) ([][]byte, [][]byte, error) {
+	if ts.GetMultiProofCalled != nil {
+		return ts.GetMultiProofCalled(keys)
+	}
+
+	return nil, nil, nil
+}
+
+// GetRangeProof -
+func (ts *TrieStub) GetRangeProof(startKey []byte, endKey []byte, maxNumLeaves int) (*common.RangeProof, error) {
+	if ts.GetRangeProofCalled != nil {
+		return ts.GetRangeProofCalled(startKey, endKey, maxNumLeaves)
+	}
+
+	return &common.RangeProof{}, nil
+}
+
 // GetAllLeavesOnChannel -
 func (ts *TrieStub) GetAllLeavesOnChannel(rootHash []byte) (chan core.KeyValueHolder, error) {
 	if ts.GetAllLeavesOnChannelCalled != nil {
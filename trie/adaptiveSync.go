@@ -0,0 +1,238 @@
+package trie
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const adaptiveSyncWaitTimeBetweenChecks = time.Millisecond * 20
+
+type adaptiveTrieSyncer struct {
+	shardId                   uint32
+	topic                     string
+	rootHash                  []byte
+	waitTimeBetweenChecks     time.Duration
+	marshalizer               marshal.Marshalizer
+	hasher                    hashing.Hasher
+	db                        common.DBWriteCacher
+	requestHandler            RequestHandler
+	interceptedNodesCacher    storage.Cacher
+	mutOperation              sync.RWMutex
+	trieSyncStatistics        data.SyncStatisticsHandler
+	timeoutHandler            TimeoutHandler
+	maxHardCapForMissingNodes int
+	requestsBudget            SyncRequestsBudget
+	leafValueHandler          func(value []byte)
+	existingNodes             map[string]node
+	missingHashes             map[string]struct{}
+}
+
+// NewAdaptiveTrieSyncer creates a new instance of trieSyncer that keeps the "margin" nodes in 2 lists, as the double list
+// trie syncer does, but requests the missing nodes through a requests budget shared with all the other syncers. This way
+// many tries can be synced concurrently without flooding the peers, while the size of each request follows the response
+// latency of the network
+func NewAdaptiveTrieSyncer(arg ArgTrieSyncer) (*adaptiveTrieSyncer, error) {
+	err := checkArguments(arg)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(arg.RequestsBudget) {
+		return nil, ErrNilSyncRequestsBudget
+	}
+
+	a := &adaptiveTrieSyncer{
+		requestHandler:            arg.RequestHandler,
+		interceptedNodesCacher:    arg.InterceptedNodes,
+		db:                        arg.DB,
+		marshalizer:               arg.Marshalizer,
+		hasher:                    arg.Hasher,
+		topic:                     arg.Topic,
+		shardId:                   arg.ShardId,
+		waitTimeBetweenChecks:     adaptiveSyncWaitTimeBetweenChecks,
+		trieSyncStatistics:        arg.TrieSyncStatistics,
+		timeoutHandler:            arg.TimeoutHandler,
+		maxHardCapForMissingNodes: arg.MaxHardCapForMissingNodes,
+		requestsBudget:            arg.RequestsBudget,
+		leafValueHandler:          arg.LeafValueHandler,
+	}
+
+	return a, nil
+}
+
+// StartSyncing completes the trie, asking for missing trie nodes on the network. All concurrent calls will be serialized
+// so this function is treated as a large critical section. The hashes still reserved in the requests budget are released
+// when the function returns
+func (a *adaptiveTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context) error {
+	if len(rootHash) == 0 || bytes.Equal(rootHash, EmptyTrieHash) {
+		return nil
+	}
+	if ctx == nil {
+		return ErrNilContext
+	}
+
+	a.mutOperation.Lock()
+	defer a.mutOperation.Unlock()
+
+	a.existingNodes = make(map[string]node)
+	a.missingHashes = make(map[string]struct{})
+	a.rootHash = rootHash
+
+	a.missingHashes[string(rootHash)] = struct{}{}
+	defer func() {
+		a.requestsBudget.Release(a.missingHashesSlice())
+	}()
+
+	for {
+		isSynced, err := a.checkIsSyncedWhileProcessingMissingAndExisting()
+		if err != nil {
+			return err
+		}
+		if isSynced {
+			a.trieSyncStatistics.SetNumMissing(a.rootHash, 0)
+			return nil
+		}
+
+		select {
+		case <-time.After(a.waitTimeBetweenChecks):
+			continue
+		case <-ctx.Done():
+			return ErrContextClosing
+		}
+	}
+}
+
+func (a *adaptiveTrieSyncer) checkIsSyncedWhileProcessingMissingAndExisting() (bool, error) {
+	if a.timeoutHandler.IsTimeout() {
+		return false, ErrTrieSyncTimeout
+	}
+
+	err := a.processMissingAndExisting()
+	if err != nil {
+		return false, err
+	}
+
+	if len(a.missingHashes) > 0 {
+		a.request(a.missingHashesSlice())
+
+		return false, nil
+	}
+
+	return len(a.existingNodes) == 0, nil
+}
+
+func (a *adaptiveTrieSyncer) missingHashesSlice() [][]byte {
+	hashes := make([][]byte, 0, len(a.missingHashes))
+	for hash := range a.missingHashes {
+		hashes = append(hashes, []byte(hash))
+	}
+
+	return hashes
+}
+
+func (a *adaptiveTrieSyncer) request(hashes [][]byte) {
+	a.trieSyncStatistics.SetNumMissing(a.rootHash, len(hashes))
+
+	hashesToRequest := a.requestsBudget.Reserve(hashes)
+	if len(hashesToRequest) == 0 {
+		return
+	}
+
+	a.requestHandler.RequestTrieNodes(a.shardId, hashesToRequest, a.topic)
+}
+
+func (a *adaptiveTrieSyncer) processMissingAndExisting() error {
+	a.processMissingHashes()
+
+	return a.processExistingNodes()
+}
+
+func (a *adaptiveTrieSyncer) processMissingHashes() {
+	for hash := range a.missingHashes {
+		n, err := a.getNode([]byte(hash))
+		if err != nil {
+			continue
+		}
+
+		delete(a.missingHashes, hash)
+		a.requestsBudget.MarkReceived([]byte(hash))
+
+		a.existingNodes[string(n.getHash())] = n
+	}
+}
+
+func (a *adaptiveTrieSyncer) processExistingNodes() error {
+	for hash, element := range a.existingNodes {
+		numBytes, err := encodeNodeAndCommitToDB(element, a.db)
+		if err != nil {
+			return err
+		}
+
+		a.trieSyncStatistics.AddNumReceived(1)
+		if numBytes > core.MaxBufferSizeToSendTrieNodes {
+			a.trieSyncStatistics.AddNumLarge(1)
+		}
+		a.timeoutHandler.ResetWatchdog()
+
+		var children []node
+		var missingChildrenHashes [][]byte
+		missingChildrenHashes, children, err = element.loadChildren(a.getNode)
+		if err != nil {
+			return err
+		}
+
+		if len(missingChildrenHashes) > 0 && len(a.missingHashes) > a.maxHardCapForMissingNodes {
+			break
+		}
+
+		delete(a.existingNodes, hash)
+		a.handleLeaf(element)
+
+		for _, child := range children {
+			a.existingNodes[string(child.getHash())] = child
+		}
+
+		for _, missingHash := range missingChildrenHashes {
+			a.missingHashes[string(missingHash)] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func (a *adaptiveTrieSyncer) handleLeaf(element node) {
+	if a.leafValueHandler == nil {
+		return
+	}
+
+	ln, isLeaf := element.(*leafNode)
+	if !isLeaf {
+		return
+	}
+
+	a.leafValueHandler(ln.Value)
+}
+
+func (a *adaptiveTrieSyncer) getNode(hash []byte) (node, error) {
+	return getNodeFromStorage(
+		hash,
+		a.interceptedNodesCacher,
+		a.db,
+		a.marshalizer,
+		a.hasher,
+	)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (a *adaptiveTrieSyncer) IsInterfaceNil() bool {
+	return a == nil
+}
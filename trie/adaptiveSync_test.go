@@ -0,0 +1,178 @@
+package trie
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/trie/syncBudget"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createAdaptiveSyncerArgument(timeout time.Duration) ArgTrieSyncer {
+	arg := createMockArgument(timeout)
+	arg.RequestsBudget, _ = syncBudget.NewSyncRequestsBudget(syncBudget.ArgsSyncRequestsBudget{
+		MaxInFlightNodes: 100,
+		MinBatchSize:     5,
+		MaxBatchSize:     50,
+		TargetLatency:    time.Millisecond * 200,
+		RequestTimeout:   time.Second,
+	})
+
+	return arg
+}
+
+func TestNewAdaptiveTrieSyncer_InvalidParametersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createAdaptiveSyncerArgument(time.Minute)
+	arg.RequestHandler = nil
+	a, err := NewAdaptiveTrieSyncer(arg)
+	assert.True(t, check.IfNil(a))
+	assert.Equal(t, ErrNilRequestHandler, err)
+
+	arg = createAdaptiveSyncerArgument(time.Minute)
+	arg.RequestsBudget = nil
+	a, err = NewAdaptiveTrieSyncer(arg)
+	assert.True(t, check.IfNil(a))
+	assert.Equal(t, ErrNilSyncRequestsBudget, err)
+}
+
+func TestNewAdaptiveTrieSyncer(t *testing.T) {
+	t.Parallel()
+
+	a, err := NewAdaptiveTrieSyncer(createAdaptiveSyncerArgument(time.Minute))
+	assert.False(t, check.IfNil(a))
+	assert.Nil(t, err)
+}
+
+func TestAdaptiveTrieSyncer_StartSyncingEmptyRootHashOrNilContext(t *testing.T) {
+	t.Parallel()
+
+	a, _ := NewAdaptiveTrieSyncer(createAdaptiveSyncerArgument(time.Minute))
+
+	assert.Nil(t, a.StartSyncing(nil, context.Background()))
+	assert.Nil(t, a.StartSyncing(EmptyTrieHash, context.Background()))
+	assert.Equal(t, ErrNilContext, a.StartSyncing(bytes.Repeat([]byte{1}, len(EmptyTrieHash)), nil))
+}
+
+func TestAdaptiveTrieSyncer_StartSyncingCanTimeoutAndShouldReleaseTheBudget(t *testing.T) {
+	t.Parallel()
+
+	trSource, _ := createInMemoryTrie()
+	addDataToTrie(10, trSource)
+	_ = trSource.Commit()
+	roothash, _ := trSource.RootHash()
+
+	arg := createAdaptiveSyncerArgument(time.Second)
+	a, _ := NewAdaptiveTrieSyncer(arg)
+
+	err := a.StartSyncing(roothash, context.Background())
+	require.Equal(t, ErrTrieSyncTimeout, err)
+	assert.Equal(t, 0, arg.RequestsBudget.NumInFlight())
+}
+
+func TestAdaptiveTrieSyncer_StartSyncingNewTrieShouldWork(t *testing.T) {
+	t.Parallel()
+
+	numKeysValues := 100
+	trSource, _ := createInMemoryTrie()
+	addDataToTrie(numKeysValues, trSource)
+	_ = trSource.Commit()
+	roothash, _ := trSource.RootHash()
+
+	arg := createAdaptiveSyncerArgument(time.Minute)
+	maxRequested := 0
+	requestResolver := createRequesterResolver(trSource, arg.InterceptedNodes, nil)
+	arg.RequestHandler = &testscommon.RequestHandlerStub{
+		RequestTrieNodesCalled: func(destShardID uint32, hashes [][]byte, topic string) {
+			if len(hashes) > maxRequested {
+				maxRequested = len(hashes)
+			}
+			requestResolver.RequestTrieNodes(destShardID, hashes, topic)
+		},
+	}
+	leafValues := make(map[string]struct{})
+	arg.LeafValueHandler = func(value []byte) {
+		leafValues[string(value)] = struct{}{}
+	}
+
+	a, _ := NewAdaptiveTrieSyncer(arg)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancelFunc()
+
+	err := a.StartSyncing(roothash, ctx)
+	require.Nil(t, err)
+	assert.True(t, maxRequested <= 50)
+	assert.Equal(t, 0, arg.RequestsBudget.NumInFlight())
+	assert.Equal(t, numKeysValues, len(leafValues))
+
+	trie, _ := createInMemoryTrieFromDB(arg.DB.(*testscommon.MemDbMock))
+	trie, _ = trie.Recreate(roothash)
+	require.False(t, check.IfNil(trie))
+
+	var val []byte
+	for i := 0; i < numKeysValues; i++ {
+		keyVal := hasher.Compute(fmt.Sprintf("%d", i))
+		val, err = trie.Get(keyVal)
+		require.Nil(t, err)
+		require.Equal(t, keyVal, val)
+
+		_, found := leafValues[string(keyVal)]
+		assert.True(t, found)
+	}
+}
+
+func TestAdaptiveTrieSyncer_ConcurrentSyncersShouldShareTheBudget(t *testing.T) {
+	t.Parallel()
+
+	numTries := 5
+	numKeysValues := 50
+	arg := createAdaptiveSyncerArgument(time.Minute)
+	rootHashes := make([][]byte, 0, numTries)
+	wg := sync.WaitGroup{}
+	wg.Add(numTries)
+	for i := 0; i < numTries; i++ {
+		trSource, _ := createInMemoryTrie()
+		for j := 0; j < numKeysValues; j++ {
+			keyVal := hasher.Compute(fmt.Sprintf("%d_%d", i, j))
+			_ = trSource.Update(keyVal, keyVal)
+		}
+		_ = trSource.Commit()
+		roothash, _ := trSource.RootHash()
+		rootHashes = append(rootHashes, roothash)
+
+		syncerArg := arg
+		syncerArg.InterceptedNodes = testscommon.NewCacherMock()
+		syncerArg.RequestHandler = createRequesterResolver(trSource, syncerArg.InterceptedNodes, nil)
+		a, _ := NewAdaptiveTrieSyncer(syncerArg)
+
+		go func() {
+			defer wg.Done()
+
+			errSync := a.StartSyncing(roothash, context.Background())
+			assert.Nil(t, errSync)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 0, arg.RequestsBudget.NumInFlight())
+	for i := 0; i < numTries; i++ {
+		trie, _ := createInMemoryTrieFromDB(arg.DB.(*testscommon.MemDbMock))
+		trie, _ = trie.Recreate(rootHashes[i])
+		require.False(t, check.IfNil(trie))
+
+		for j := 0; j < numKeysValues; j++ {
+			keyVal := hasher.Compute(fmt.Sprintf("%d_%d", i, j))
+			val, err := trie.Get(keyVal)
+			require.Nil(t, err)
+			require.Equal(t, keyVal, val)
+		}
+	}
+}
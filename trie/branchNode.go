@@ -804,6 +804,74 @@ func (bn *branchNode) getAllLeavesOnChannel(
 	return nil
 }
 
+func (bn *branchNode) collectStats(handler common.TrieStatisticsHandler, depth int, db common.DBWriteCacher) error {
+	err := bn.isEmptyOrNil()
+	if err != nil {
+		return fmt.Errorf("collectStats error: %w", err)
+	}
+
+	encodedNode, err := bn.getEncodedNode()
+	if err != nil {
+		return err
+	}
+	handler.AddBranchNode(depth, uint64(len(encodedNode)))
+
+	for i := range bn.children {
+		err = resolveIfCollapsed(bn, byte(i), db)
+		if err != nil {
+			return err
+		}
+
+		if bn.children[i] == nil {
+			continue
+		}
+
+		err = bn.children[i].collectStats(handler, depth+1, db)
+		if err != nil {
+			return err
+		}
+
+		bn.children[i] = nil
+	}
+
+	return nil
+}
+
+func (bn *branchNode) collectProof(path []byte, collector *proofCollector, db common.DBWriteCacher) error {
+	err := bn.isEmptyOrNil()
+	if err != nil {
+		return fmt.Errorf("collectProof error: %w", err)
+	}
+
+	err = collector.addNode(bn)
+	if err != nil {
+		return err
+	}
+
+	for i := range bn.children {
+		if len(bn.EncodedChildren[i]) == 0 && bn.children[i] == nil {
+			continue
+		}
+
+		childPath := concat(path, byte(i))
+		if !collector.intersects(childPath) {
+			continue
+		}
+
+		err = resolveIfCollapsed(bn, byte(i), db)
+		if err != nil {
+			return err
+		}
+
+		err = bn.children[i].collectProof(childPath, collector, db)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (bn *branchNode) getAllHashes(db common.DBWriteCacher) ([][]byte, error) {
 	err := bn.isEmptyOrNil()
 	if err != nil {
@@ -102,3 +102,12 @@ var ErrNilCheckpointHashesHolder = errors.New("nil checkpoint hashes holder")
 
 // ErrTrieSyncTimeout signals that a timeout occurred while syncing the trie
 var ErrTrieSyncTimeout = errors.New("trie sync timeout")
+
+// ErrNilTrieStatisticsHandler signals that a nil trie statistics handler was provided
+var ErrNilTrieStatisticsHandler = errors.New("nil trie statistics handler")
+
+// ErrNilSyncRequestsBudget signals that a nil sync requests budget was provided
+var ErrNilSyncRequestsBudget = errors.New("nil sync requests budget")
+
+// ErrNoKeysProvided signals that no keys were provided
+var ErrNoKeysProvided = errors.New("no keys provided")
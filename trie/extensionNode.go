@@ -664,6 +664,57 @@ func (en *extensionNode) getAllLeavesOnChannel(
 	return nil
 }
 
+func (en *extensionNode) collectStats(handler common.TrieStatisticsHandler, depth int, db common.DBWriteCacher) error {
+	err := en.isEmptyOrNil()
+	if err != nil {
+		return fmt.Errorf("collectStats error: %w", err)
+	}
+
+	encodedNode, err := en.getEncodedNode()
+	if err != nil {
+		return err
+	}
+	handler.AddExtensionNode(depth, uint64(len(encodedNode)))
+
+	err = resolveIfCollapsed(en, 0, db)
+	if err != nil {
+		return err
+	}
+
+	err = en.child.collectStats(handler, depth+1, db)
+	if err != nil {
+		return err
+	}
+
+	en.child = nil
+
+	return nil
+}
+
+func (en *extensionNode) collectProof(path []byte, collector *proofCollector, db common.DBWriteCacher) error {
+	err := en.isEmptyOrNil()
+	if err != nil {
+		return fmt.Errorf("collectProof error: %w", err)
+	}
+
+	err = collector.addNode(en)
+	if err != nil {
+		return err
+	}
+
+	childPath := concat(path, en.Key...)
+	if !collector.intersects(childPath) {
+		return nil
+	}
+
+	err = resolveIfCollapsed(en, 0, db)
+	if err != nil {
+		return err
+	}
+
+	return en.child.collectProof(childPath, collector, db)
+}
+
 func (en *extensionNode) getAllHashes(db common.DBWriteCacher) ([][]byte, error) {
 	err := en.isEmptyOrNil()
 	if err != nil {
@@ -0,0 +1,28 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/ElrondNetwork/elrond-go/trie/syncBudget"
+	"github.com/ElrondNetwork/elrond-go/trie/syncBudget/disabled"
+)
+
+// CreateSyncRequestsBudget creates the requests budget to be shared by the trie syncers. As only some of the trie
+// syncer versions use the budget, a disabled one is returned for the others
+func CreateSyncRequestsBudget(cfg config.TrieSyncConfig) (trie.SyncRequestsBudget, error) {
+	if !trie.UsesRequestsBudget(cfg.TrieSyncerVersion) {
+		return disabled.NewDisabledSyncRequestsBudget(), nil
+	}
+
+	args := syncBudget.ArgsSyncRequestsBudget{
+		MaxInFlightNodes: cfg.MaxInFlightTrieNodes,
+		MinBatchSize:     cfg.MinRequestBatchSize,
+		MaxBatchSize:     cfg.MaxRequestBatchSize,
+		TargetLatency:    time.Duration(cfg.TargetRequestLatencyInMilliseconds) * time.Millisecond,
+		RequestTimeout:   time.Duration(cfg.RequestTimeoutInMilliseconds) * time.Millisecond,
+	}
+
+	return syncBudget.NewSyncRequestsBudget(args)
+}
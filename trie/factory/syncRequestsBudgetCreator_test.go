@@ -0,0 +1,47 @@
+package factory_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/trie/syncBudget"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSyncRequestsBudget(t *testing.T) {
+	t.Parallel()
+
+	t.Run("syncer version not using the budget should return a disabled budget", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.TrieSyncConfig{TrieSyncerVersion: 2}
+		budget, err := factory.CreateSyncRequestsBudget(cfg)
+		assert.Nil(t, err)
+		assert.Equal(t, "*disabled.disabledSyncRequestsBudget", fmt.Sprintf("%T", budget))
+	})
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := testscommon.GetGeneralConfig().TrieSync
+		cfg.TrieSyncerVersion = 3
+		cfg.MaxInFlightTrieNodes = 0
+		budget, err := factory.CreateSyncRequestsBudget(cfg)
+		assert.True(t, check.IfNil(budget))
+		assert.True(t, errors.Is(err, syncBudget.ErrInvalidMaxInFlightNodes))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := testscommon.GetGeneralConfig().TrieSync
+		cfg.TrieSyncerVersion = 3
+		budget, err := factory.CreateSyncRequestsBudget(cfg)
+		assert.Nil(t, err)
+		assert.Equal(t, "*syncBudget.syncRequestsBudget", fmt.Sprintf("%T", budget))
+		assert.Equal(t, cfg.MinRequestBatchSize, budget.BatchSize())
+	})
+}
@@ -40,6 +40,8 @@ type node interface {
 	loadChildren(func([]byte) (node, error)) ([][]byte, []node, error)
 	getAllLeavesOnChannel(chan core.KeyValueHolder, []byte, common.DBWriteCacher, marshal.Marshalizer, chan struct{}) error
 	getAllHashes(db common.DBWriteCacher) ([][]byte, error)
+	collectStats(handler common.TrieStatisticsHandler, depth int, db common.DBWriteCacher) error
+	collectProof(path []byte, collector *proofCollector, db common.DBWriteCacher) error
 	getNextHashAndKey([]byte) (bool, []byte, []byte)
 	getNumNodes() common.NumNodesDTO
 	getValue() []byte
@@ -84,3 +86,15 @@ type TimeoutHandler interface {
 	IsTimeout() bool
 	IsInterfaceNil() bool
 }
+
+// SyncRequestsBudget limits the number of trie nodes requested and not yet received by all the trie syncers sharing it
+// and adapts the number of nodes requested at once to the response latency
+type SyncRequestsBudget interface {
+	Reserve(hashes [][]byte) [][]byte
+	MarkReceived(hash []byte)
+	Release(hashes [][]byte)
+	BatchSize() int
+	NumInFlight() int
+	Latency() time.Duration
+	IsInterfaceNil() bool
+}
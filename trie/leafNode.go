@@ -450,6 +450,40 @@ func (ln *leafNode) getAllLeavesOnChannel(
 	}
 }
 
+func (ln *leafNode) collectStats(handler common.TrieStatisticsHandler, depth int, _ common.DBWriteCacher) error {
+	err := ln.isEmptyOrNil()
+	if err != nil {
+		return fmt.Errorf("collectStats error: %w", err)
+	}
+
+	encodedNode, err := ln.getEncodedNode()
+	if err != nil {
+		return err
+	}
+	handler.AddLeafNode(depth, uint64(len(encodedNode)))
+
+	return nil
+}
+
+func (ln *leafNode) collectProof(path []byte, collector *proofCollector, _ common.DBWriteCacher) error {
+	err := ln.isEmptyOrNil()
+	if err != nil {
+		return fmt.Errorf("collectProof error: %w", err)
+	}
+
+	err = collector.addNode(ln)
+	if err != nil {
+		return err
+	}
+
+	leafPath := concat(path, ln.Key...)
+	if collector.selects(leafPath) {
+		collector.addLeaf(leafPath, ln.Value)
+	}
+
+	return nil
+}
+
 func (ln *leafNode) getAllHashes(_ common.DBWriteCacher) ([][]byte, error) {
 	err := ln.isEmptyOrNil()
 	if err != nil {
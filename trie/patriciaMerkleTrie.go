@@ -14,6 +14,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/trie/proof"
 )
 
 var log = logger.GetOrCreate("trie")
@@ -525,6 +526,38 @@ func (tr *patriciaMerkleTrie) GetAllLeavesOnChannel(rootHash []byte) (chan core.
 	return leavesChannel, nil
 }
 
+// CollectStatistics walks, synchronously, all the nodes of the trie having the provided root hash and reports them
+// to the statistics handler. The nodes are loaded from the storage and released as soon as they are visited, so the
+// whole trie is never kept in memory
+func (tr *patriciaMerkleTrie) CollectStatistics(rootHash []byte, handler common.TrieStatisticsHandler) error {
+	if check.IfNil(handler) {
+		return ErrNilTrieStatisticsHandler
+	}
+
+	tr.mutOperation.RLock()
+	newTrie, err := tr.recreate(rootHash)
+	if err != nil {
+		tr.mutOperation.RUnlock()
+		return err
+	}
+
+	if check.IfNil(newTrie) || newTrie.root == nil {
+		tr.mutOperation.RUnlock()
+		return nil
+	}
+
+	tr.trieStorage.EnterPruningBufferingMode()
+	tr.mutOperation.RUnlock()
+
+	err = newTrie.root.collectStats(handler, 0, tr.trieStorage.Database())
+
+	tr.mutOperation.Lock()
+	tr.trieStorage.ExitPruningBufferingMode()
+	tr.mutOperation.Unlock()
+
+	return err
+}
+
 // GetAllHashes returns all the hashes from the trie
 func (tr *patriciaMerkleTrie) GetAllHashes() ([][]byte, error) {
 	tr.mutOperation.Lock()
@@ -601,6 +634,94 @@ func (tr *patriciaMerkleTrie) GetProof(key []byte) ([][]byte, []byte, error) {
 	}
 }
 
+// GetMultiProof computes a single Merkle proof for all the given keys. The nodes shared by the paths of several keys
+// are added only once. The returned values are in the order of the given keys, a nil value meaning that the key is
+// not present in the trie
+func (tr *patriciaMerkleTrie) GetMultiProof(keys [][]byte) ([][]byte, [][]byte, error) {
+	if len(keys) == 0 {
+		return nil, nil, ErrNoKeysProvided
+	}
+
+	collector, err := tr.collectProof(proof.NewKeysSelector(keys), 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	valuesByPath := make(map[string][]byte, len(collector.paths))
+	for i, path := range collector.paths {
+		valuesByPath[string(path)] = collector.values[i]
+	}
+
+	values := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, valuesByPath[string(keyBytesToHex(key))])
+	}
+
+	return collector.encodedNodes, values, nil
+}
+
+// GetRangeProof computes a Merkle proof for all the keys placed between the start key and the end key, both included,
+// in the order in which they are stored in the trie. An empty start or end key leaves the range unbounded on that side.
+// If more than maxNumLeaves keys are in range, the proof is truncated after the last returned key, which becomes the
+// end key of the proven range. A zero maxNumLeaves means no limit
+func (tr *patriciaMerkleTrie) GetRangeProof(startKey []byte, endKey []byte, maxNumLeaves int) (*common.RangeProof, error) {
+	selector, err := proof.NewRangeSelector(startKey, endKey)
+	if err != nil {
+		return nil, err
+	}
+
+	collector, err := tr.collectProof(selector, maxNumLeaves)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([][]byte, 0, len(collector.paths))
+	for _, path := range collector.paths {
+		key, errConvert := hexToKeyBytes(path)
+		if errConvert != nil {
+			return nil, errConvert
+		}
+
+		keys = append(keys, key)
+	}
+
+	rangeProof := &common.RangeProof{
+		Proof:    collector.encodedNodes,
+		Keys:     keys,
+		Values:   collector.values,
+		StartKey: startKey,
+		EndKey:   endKey,
+	}
+	if collector.isTruncated() {
+		rangeProof.EndKey = keys[len(keys)-1]
+		rangeProof.IsTruncated = true
+	}
+
+	return rangeProof, nil
+}
+
+func (tr *patriciaMerkleTrie) collectProof(selector proof.PathSelector, maxNumLeaves int) (*proofCollector, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	if tr.root == nil {
+		return nil, ErrNilNode
+	}
+
+	err := tr.root.setRootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	collector := newProofCollector(selector, maxNumLeaves)
+	err = tr.root.collectProof(make([]byte, 0), collector, tr.trieStorage.Database())
+	if err != nil {
+		return nil, err
+	}
+
+	return collector, nil
+}
+
 // VerifyProof verifies the given Merkle proof
 func (tr *patriciaMerkleTrie) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error) {
 	tr.mutOperation.Lock()
@@ -2,9 +2,11 @@ package trie_test
 
 import (
 	cryptoRand "crypto/rand"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
+	"sort"
 	"strconv"
 	"sync"
 	"testing"
@@ -18,6 +20,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/trie"
 	"github.com/ElrondNetwork/elrond-go/trie/hashesHolder"
+	"github.com/ElrondNetwork/elrond-go/trie/proof"
+	"github.com/ElrondNetwork/elrond-go/trie/statistics"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -760,6 +764,160 @@ func dumpTrieContents(tr common.Trie, values [][]byte) {
 	}
 }
 
+func initTrieWithRandomKeys(numKeys int) (common.Trie, [][]byte) {
+	tr := emptyTrie()
+
+	keys := make([][]byte, numKeys)
+	for i := 0; i < numKeys; i++ {
+		keys[i] = make([]byte, 32)
+		_, _ = cryptoRand.Read(keys[i])
+		_ = tr.Update(keys[i], append([]byte("value"), keys[i]...))
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return proof.CompareKeys(keys[i], keys[j]) < 0
+	})
+
+	return tr, keys
+}
+
+func TestPatriciaMerkleTrie_GetMultiProofNoKeysShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+
+	computedProof, values, err := tr.GetMultiProof(nil)
+	assert.Nil(t, computedProof)
+	assert.Nil(t, values)
+	assert.Equal(t, trie.ErrNoKeysProvided, err)
+}
+
+func TestPatriciaMerkleTrie_GetMultiProofNilRootShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+
+	computedProof, values, err := tr.GetMultiProof([][]byte{[]byte("dog")})
+	assert.Nil(t, computedProof)
+	assert.Nil(t, values)
+	assert.Equal(t, trie.ErrNilNode, err)
+}
+
+func TestPatriciaMerkleTrie_GetMultiProofShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tr, keys := initTrieWithRandomKeys(1000)
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+
+	provenKeys := make([][]byte, 0)
+	for i := 0; i < len(keys); i += 20 {
+		provenKeys = append(provenKeys, keys[i])
+	}
+	missingKey := []byte("missing key")
+	provenKeys = append(provenKeys, missingKey)
+
+	multiProof, values, err := tr.GetMultiProof(provenKeys)
+	require.Nil(t, err)
+	require.Equal(t, len(provenKeys), len(values))
+
+	sizeOfSingleProofs := 0
+	for _, key := range provenKeys[:len(provenKeys)-1] {
+		singleProof, _, _ := tr.GetProof(key)
+		sizeOfSingleProofs += len(singleProof)
+	}
+	assert.Less(t, len(multiProof), sizeOfSingleProofs)
+
+	verifier, _ := proof.NewProofVerifier(&testscommon.ProtobufMarshalizerMock{}, &testscommon.KeccakMock{})
+	provenValues, err := verifier.VerifyMultiKeyProof(rootHash, provenKeys, multiProof)
+	require.Nil(t, err)
+	require.Equal(t, len(provenKeys), len(provenValues))
+	for i, key := range provenKeys[:len(provenKeys)-1] {
+		assert.Equal(t, append([]byte("value"), key...), values[i])
+		assert.Equal(t, values[i], provenValues[i].Value)
+	}
+	assert.Nil(t, values[len(values)-1])
+	assert.Nil(t, provenValues[len(provenValues)-1].Value)
+}
+
+func TestPatriciaMerkleTrie_GetRangeProofInvalidRangeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr, keys := initTrieWithRandomKeys(10)
+
+	rangeProof, err := tr.GetRangeProof(keys[5], keys[2], 0)
+	assert.Nil(t, rangeProof)
+	assert.Equal(t, proof.ErrInvalidRange, err)
+}
+
+func TestPatriciaMerkleTrie_GetRangeProofShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tr, keys := initTrieWithRandomKeys(1000)
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+	verifier, _ := proof.NewProofVerifier(&testscommon.ProtobufMarshalizerMock{}, &testscommon.KeccakMock{})
+
+	t.Run("bounded range", func(t *testing.T) {
+		rangeProof, err := tr.GetRangeProof(keys[100], keys[299], 0)
+		require.Nil(t, err)
+		assert.False(t, rangeProof.IsTruncated)
+		assert.Equal(t, keys[100:300], rangeProof.Keys)
+
+		provenValues, err := verifier.VerifyRangeProof(rootHash, keys[100], keys[299], rangeProof.Proof)
+		require.Nil(t, err)
+		require.Equal(t, 200, len(provenValues))
+		for i, provenValue := range provenValues {
+			assert.Equal(t, rangeProof.Keys[i], provenValue.Key)
+			assert.Equal(t, rangeProof.Values[i], provenValue.Value)
+		}
+	})
+	t.Run("unbounded range", func(t *testing.T) {
+		rangeProof, err := tr.GetRangeProof(nil, nil, 0)
+		require.Nil(t, err)
+		assert.Equal(t, keys, rangeProof.Keys)
+
+		provenValues, err := verifier.VerifyRangeProof(rootHash, nil, nil, rangeProof.Proof)
+		require.Nil(t, err)
+		assert.Equal(t, len(keys), len(provenValues))
+	})
+	t.Run("truncated range", func(t *testing.T) {
+		rangeProof, err := tr.GetRangeProof(keys[10], nil, 50)
+		require.Nil(t, err)
+		assert.True(t, rangeProof.IsTruncated)
+		assert.Equal(t, keys[10:60], rangeProof.Keys)
+		assert.Equal(t, keys[59], rangeProof.EndKey)
+
+		provenValues, err := verifier.VerifyRangeProof(rootHash, keys[10], rangeProof.EndKey, rangeProof.Proof)
+		require.Nil(t, err)
+		assert.Equal(t, 50, len(provenValues))
+
+		_, err = verifier.VerifyRangeProof(rootHash, keys[10], nil, rangeProof.Proof)
+		assert.True(t, errors.Is(err, proof.ErrMissingProofNode))
+	})
+	t.Run("range exactly filled by the maximum number of leaves", func(t *testing.T) {
+		rangeProof, err := tr.GetRangeProof(keys[950], nil, 50)
+		require.Nil(t, err)
+		assert.False(t, rangeProof.IsTruncated)
+		assert.Equal(t, keys[950:], rangeProof.Keys)
+		assert.Nil(t, rangeProof.EndKey)
+
+		provenValues, err := verifier.VerifyRangeProof(rootHash, keys[950], nil, rangeProof.Proof)
+		require.Nil(t, err)
+		assert.Equal(t, 50, len(provenValues))
+	})
+	t.Run("collapsed trie", func(t *testing.T) {
+		recreatedTrie, err := tr.Recreate(rootHash)
+		require.Nil(t, err)
+
+		expectedProof, err := tr.GetRangeProof(keys[500], keys[700], 0)
+		require.Nil(t, err)
+		rangeProof, err := recreatedTrie.GetRangeProof(keys[500], keys[700], 0)
+		require.Nil(t, err)
+		assert.Equal(t, expectedProof, rangeProof)
+	})
+}
+
 func TestPatriciaMerkleTrie_GetNumNodesNilRootShouldReturnEmpty(t *testing.T) {
 	t.Parallel()
 
@@ -784,6 +942,58 @@ func TestPatriciaMerkleTrie_GetNumNodes(t *testing.T) {
 	assert.Equal(t, 2, numNodes.Branches)
 }
 
+func TestPatriciaMerkleTrie_CollectStatisticsNilHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+
+	err := tr.CollectStatistics(rootHash, nil)
+	assert.Equal(t, trie.ErrNilTrieStatisticsHandler, err)
+}
+
+func TestPatriciaMerkleTrie_CollectStatisticsEmptyTrie(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+	trieStatistics := statistics.NewTrieStatistics()
+
+	err := tr.CollectStatistics(trie.EmptyTrieHash, trieStatistics)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), trieStatistics.NumNodes())
+}
+
+func TestPatriciaMerkleTrie_CollectStatistics(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+	_ = tr.Update([]byte("eod"), []byte("reindeer"))
+	_ = tr.Update([]byte("god"), []byte("puppy"))
+	_ = tr.Update([]byte("eggod"), []byte("cat"))
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+	trieStatistics := statistics.NewTrieStatistics()
+
+	err := tr.CollectStatistics(rootHash, trieStatistics)
+	assert.Nil(t, err)
+
+	stats := trieStatistics.GetTrieStatistics()
+	assert.Equal(t, uint64(3), stats.NumLeafNodes)
+	assert.Equal(t, uint64(2), stats.NumExtensionNodes)
+	assert.Equal(t, uint64(2), stats.NumBranchNodes)
+	assert.Equal(t, uint32(4), stats.MaxDepth)
+	assert.Equal(t, []uint64{1, 1, 2, 1, 2}, stats.DepthHistogram)
+
+	expectedSize := uint64(0)
+	hashes, _ := tr.GetAllHashes()
+	for _, hash := range hashes {
+		encodedNode, _ := tr.GetSerializedNode(hash)
+		expectedSize += uint64(len(encodedNode))
+	}
+	assert.Equal(t, expectedSize, stats.TotalSizeInBytes)
+}
+
 func TestPatriciaMerkleTrie_GetOldRoot(t *testing.T) {
 	t.Parallel()
 
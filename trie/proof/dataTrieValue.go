@@ -0,0 +1,15 @@
+package proof
+
+import "bytes"
+
+// TrimDataTrieValue returns the value saved by an account for the given key, out of the value stored in the leaf of
+// its data trie. The stored value is suffixed with the key and the address of the account, so that the proven value
+// is bound to the account it belongs to
+func TrimDataTrieValue(leafValue []byte, key []byte, address []byte) ([]byte, error) {
+	suffix := concat(key, address...)
+	if !bytes.HasSuffix(leafValue, suffix) {
+		return nil, ErrInvalidDataTrieValue
+	}
+
+	return leafValue[:len(leafValue)-len(suffix)], nil
+}
@@ -0,0 +1,30 @@
+package proof_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/trie/proof"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrimDataTrieValue(t *testing.T) {
+	t.Parallel()
+
+	key := []byte("key")
+	address := []byte("address")
+
+	t.Run("value not bound to the account should error", func(t *testing.T) {
+		t.Parallel()
+
+		value, err := proof.TrimDataTrieValue([]byte("valuekeyother address"), key, address)
+		assert.Nil(t, value)
+		assert.Equal(t, proof.ErrInvalidDataTrieValue, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		value, err := proof.TrimDataTrieValue([]byte("valuekeyaddress"), key, address)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("value"), value)
+	})
+}
@@ -0,0 +1,30 @@
+package proof
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer was provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrEmptyRootHash signals that an empty root hash was provided
+var ErrEmptyRootHash = errors.New("empty root hash")
+
+// ErrNoKeys signals that no keys were provided
+var ErrNoKeys = errors.New("no keys provided")
+
+// ErrInvalidRange signals that the start key of a range is placed after its end key
+var ErrInvalidRange = errors.New("invalid range: the start key is placed after the end key")
+
+// ErrMissingProofNode signals that a node needed for the verification is not part of the proof
+var ErrMissingProofNode = errors.New("missing proof node")
+
+// ErrInvalidEncodedNode signals that an encoded node from the proof could not be decoded
+var ErrInvalidEncodedNode = errors.New("invalid encoded node")
+
+// ErrInvalidPath signals that a path can not be converted back into a key
+var ErrInvalidPath = errors.New("invalid path")
+
+// ErrInvalidDataTrieValue signals that a data trie value does not end with the expected key and address
+var ErrInvalidDataTrieValue = errors.New("invalid data trie value")
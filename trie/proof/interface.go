@@ -0,0 +1,8 @@
+package proof
+
+// Verifier verifies the multi-key and the range proofs generated by the Patricia Merkle trie
+type Verifier interface {
+	VerifyMultiKeyProof(rootHash []byte, keys [][]byte, proof [][]byte) ([]KeyValue, error)
+	VerifyRangeProof(rootHash []byte, startKey []byte, endKey []byte, proof [][]byte) ([]KeyValue, error)
+	IsInterfaceNil() bool
+}
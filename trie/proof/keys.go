@@ -0,0 +1,57 @@
+package proof
+
+import "bytes"
+
+const (
+	hexTerminator = 16
+	nibbleSize    = 4
+	nibbleMask    = 0x0f
+)
+
+// KeyToPath transforms the key bytes into the path followed in the trie. The key nibbles are reversed, meaning that
+// the last key nibble will be the first in the path, and a terminator is added at the end of the path
+func KeyToPath(key []byte) []byte {
+	pathLength := len(key)*2 + 1
+	path := make([]byte, pathLength)
+	path[pathLength-1] = hexTerminator
+
+	keyIndex := 0
+	for i := pathLength - 2; i > 0; i -= 2 {
+		path[i] = key[keyIndex] >> nibbleSize
+		path[i-1] = key[keyIndex] & nibbleMask
+		keyIndex++
+	}
+
+	return path
+}
+
+// PathToKey transforms a full trie path, as returned by KeyToPath, back into the key bytes
+func PathToKey(path []byte) ([]byte, error) {
+	if len(path) == 0 || path[len(path)-1] != hexTerminator {
+		return nil, ErrInvalidPath
+	}
+
+	path = path[:len(path)-1]
+	if len(path)%2 != 0 {
+		return nil, ErrInvalidPath
+	}
+
+	key := make([]byte, len(path)/2)
+	pathIndex := 0
+	for i := len(key) - 1; i >= 0; i-- {
+		if path[pathIndex] > nibbleMask || path[pathIndex+1] > nibbleMask {
+			return nil, ErrInvalidPath
+		}
+
+		key[i] = path[pathIndex+1]<<nibbleSize | path[pathIndex]
+		pathIndex += 2
+	}
+
+	return key, nil
+}
+
+// CompareKeys compares two keys in the order in which they are stored in the trie. The result is 0 if a == b,
+// -1 if a is placed before b and +1 if a is placed after b
+func CompareKeys(a []byte, b []byte) int {
+	return bytes.Compare(KeyToPath(a), KeyToPath(b))
+}
@@ -0,0 +1,59 @@
+package proof_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/trie/proof"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyToPath(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []byte{16}, proof.KeyToPath(nil))
+	assert.Equal(t, []byte{4, 3, 2, 1, 16}, proof.KeyToPath([]byte{0x12, 0x34}))
+}
+
+func TestPathToKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing terminator should error", func(t *testing.T) {
+		t.Parallel()
+
+		key, err := proof.PathToKey([]byte{4, 3, 2, 1})
+		assert.Nil(t, key)
+		assert.Equal(t, proof.ErrInvalidPath, err)
+	})
+	t.Run("odd length should error", func(t *testing.T) {
+		t.Parallel()
+
+		key, err := proof.PathToKey([]byte{3, 2, 1, 16})
+		assert.Nil(t, key)
+		assert.Equal(t, proof.ErrInvalidPath, err)
+	})
+	t.Run("invalid nibble should error", func(t *testing.T) {
+		t.Parallel()
+
+		key, err := proof.PathToKey([]byte{4, 17, 2, 1, 16})
+		assert.Nil(t, key)
+		assert.Equal(t, proof.ErrInvalidPath, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		key := []byte("key of the data trie")
+		convertedKey, err := proof.PathToKey(proof.KeyToPath(key))
+		require.Nil(t, err)
+		assert.Equal(t, key, convertedKey)
+	})
+}
+
+func TestCompareKeys(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 0, proof.CompareKeys([]byte{0x12}, []byte{0x12}))
+	assert.Equal(t, -1, proof.CompareKeys([]byte{0x21}, []byte{0x12}))
+	assert.Equal(t, 1, proof.CompareKeys([]byte{0x12}, []byte{0x21}))
+	assert.Equal(t, -1, proof.CompareKeys([]byte{0x01, 0x12}, []byte{0x12}))
+}
@@ -0,0 +1,251 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. proofNodes.proto
+package proof
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+)
+
+const (
+	extensionNodeType = iota
+	leafNodeType
+	branchNodeType
+)
+
+var emptyTrieHash = make([]byte, 32)
+
+// KeyValue holds a key together with the value proven for it. A nil value means that the key is not present in the trie
+type KeyValue struct {
+	Key   []byte
+	Value []byte
+}
+
+type proofVerifier struct {
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+// NewProofVerifier creates a verifier for the multi-key and range proofs generated by the Patricia Merkle trie.
+// The verifier only needs the marshalizer and the hasher used by the trie, so it can be used by light clients
+func NewProofVerifier(marshalizer marshal.Marshalizer, hasher hashing.Hasher) (*proofVerifier, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &proofVerifier{
+		marshalizer: marshalizer,
+		hasher:      hasher,
+	}, nil
+}
+
+// VerifyMultiKeyProof verifies that the provided proof covers all the given keys and returns the proven values,
+// in the order of the given keys. The value of a key which is not present in the trie is nil
+func (pv *proofVerifier) VerifyMultiKeyProof(rootHash []byte, keys [][]byte, proof [][]byte) ([]KeyValue, error) {
+	if len(rootHash) == 0 {
+		return nil, ErrEmptyRootHash
+	}
+	if len(keys) == 0 {
+		return nil, ErrNoKeys
+	}
+
+	ps := pv.newProofStorage(proof)
+	result := make([]KeyValue, 0, len(keys))
+	for _, key := range keys {
+		value, err := ps.getValue(rootHash, KeyToPath(key))
+		if err != nil {
+			return nil, fmt.Errorf("%w for key %x", err, key)
+		}
+
+		result = append(result, KeyValue{
+			Key:   key,
+			Value: value,
+		})
+	}
+
+	return result, nil
+}
+
+// VerifyRangeProof verifies that the provided proof contains all the keys placed between the start key and the
+// end key, both included, and returns them in the order in which they are stored in the trie. The range follows the
+// trie order, as given by CompareKeys, and not the byte order of the keys. An empty start key leaves the range
+// unbounded at the beginning and an empty end key leaves the range unbounded at the end
+func (pv *proofVerifier) VerifyRangeProof(rootHash []byte, startKey []byte, endKey []byte, proof [][]byte) ([]KeyValue, error) {
+	if len(rootHash) == 0 {
+		return nil, ErrEmptyRootHash
+	}
+
+	selector, err := NewRangeSelector(startKey, endKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ps := pv.newProofStorage(proof)
+	result := make([]KeyValue, 0)
+	err = ps.collectLeaves(rootHash, make([]byte, 0), selector, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pv *proofVerifier) IsInterfaceNil() bool {
+	return pv == nil
+}
+
+type proofStorage struct {
+	marshalizer  marshal.Marshalizer
+	encodedNodes map[string][]byte
+}
+
+func (pv *proofVerifier) newProofStorage(proof [][]byte) *proofStorage {
+	encodedNodes := make(map[string][]byte, len(proof))
+	for _, encodedNode := range proof {
+		encodedNodes[string(pv.hasher.Compute(string(encodedNode)))] = encodedNode
+	}
+
+	return &proofStorage{
+		marshalizer:  pv.marshalizer,
+		encodedNodes: encodedNodes,
+	}
+}
+
+func (ps *proofStorage) getNode(hash []byte) (interface{}, error) {
+	encodedNode, ok := ps.encodedNodes[string(hash)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %x", ErrMissingProofNode, hash)
+	}
+	if len(encodedNode) < 1 {
+		return nil, ErrInvalidEncodedNode
+	}
+
+	var decodedNode interface{}
+	nodeType := encodedNode[len(encodedNode)-1]
+	switch nodeType {
+	case extensionNodeType:
+		decodedNode = &ExtensionNodeData{}
+	case leafNodeType:
+		decodedNode = &LeafNodeData{}
+	case branchNodeType:
+		decodedNode = &BranchNodeData{}
+	default:
+		return nil, fmt.Errorf("%w: unknown node type %d", ErrInvalidEncodedNode, nodeType)
+	}
+
+	err := ps.marshalizer.Unmarshal(decodedNode, encodedNode[:len(encodedNode)-1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEncodedNode, err)
+	}
+
+	return decodedNode, nil
+}
+
+func (ps *proofStorage) getValue(rootHash []byte, path []byte) ([]byte, error) {
+	if bytes.Equal(rootHash, emptyTrieHash) {
+		return nil, nil
+	}
+
+	hash := rootHash
+	for {
+		decodedNode, err := ps.getNode(hash)
+		if err != nil {
+			return nil, err
+		}
+
+		switch n := decodedNode.(type) {
+		case *BranchNodeData:
+			if len(path) == 0 || int(path[0]) >= len(n.EncodedChildren) {
+				return nil, ErrInvalidEncodedNode
+			}
+
+			hash = n.EncodedChildren[path[0]]
+			if len(hash) == 0 {
+				return nil, nil
+			}
+			path = path[1:]
+		case *ExtensionNodeData:
+			if !bytes.HasPrefix(path, n.Key) {
+				return nil, nil
+			}
+
+			hash = n.EncodedChild
+			path = path[len(n.Key):]
+		case *LeafNodeData:
+			if !bytes.Equal(path, n.Key) {
+				return nil, nil
+			}
+
+			return n.Value, nil
+		}
+	}
+}
+
+func (ps *proofStorage) collectLeaves(hash []byte, prefix []byte, selector PathSelector, result *[]KeyValue) error {
+	if bytes.Equal(hash, emptyTrieHash) && len(prefix) == 0 {
+		return nil
+	}
+
+	decodedNode, err := ps.getNode(hash)
+	if err != nil {
+		return err
+	}
+
+	switch n := decodedNode.(type) {
+	case *BranchNodeData:
+		for i, childHash := range n.EncodedChildren {
+			if len(childHash) == 0 {
+				continue
+			}
+
+			childPrefix := concat(prefix, byte(i))
+			if !selector.Intersects(childPrefix) {
+				continue
+			}
+
+			err = ps.collectLeaves(childHash, childPrefix, selector, result)
+			if err != nil {
+				return err
+			}
+		}
+	case *ExtensionNodeData:
+		childPrefix := concat(prefix, n.Key...)
+		if !selector.Intersects(childPrefix) {
+			return nil
+		}
+
+		return ps.collectLeaves(n.EncodedChild, childPrefix, selector, result)
+	case *LeafNodeData:
+		path := concat(prefix, n.Key...)
+		if !selector.Selects(path) {
+			return nil
+		}
+
+		key, errConvert := PathToKey(path)
+		if errConvert != nil {
+			return errConvert
+		}
+
+		*result = append(*result, KeyValue{
+			Key:   key,
+			Value: n.Value,
+		})
+	}
+
+	return nil
+}
+
+func concat(s1 []byte, s2 ...byte) []byte {
+	r := make([]byte, len(s1)+len(s2))
+	copy(r, s1)
+	copy(r[len(s1):], s2)
+
+	return r
+}
@@ -0,0 +1,186 @@
+package proof_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/ElrondNetwork/elrond-go/trie/proof"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var emptyTrieHash = make([]byte, 32)
+
+func createTrie(keys ...string) common.Trie {
+	trieStorageManager, _ := trie.NewTrieStorageManagerWithoutPruning(testscommon.NewMemDbMock())
+	tr, _ := trie.NewTrie(trieStorageManager, &testscommon.ProtobufMarshalizerMock{}, &testscommon.KeccakMock{}, 5)
+	for _, key := range keys {
+		_ = tr.Update([]byte(key), []byte(key+" value"))
+	}
+
+	return tr
+}
+
+func createProofVerifier() proof.Verifier {
+	verifier, _ := proof.NewProofVerifier(&testscommon.ProtobufMarshalizerMock{}, &testscommon.KeccakMock{})
+
+	return verifier
+}
+
+func TestNewProofVerifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier, err := proof.NewProofVerifier(nil, &testscommon.KeccakMock{})
+		assert.True(t, check.IfNil(verifier))
+		assert.Equal(t, proof.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier, err := proof.NewProofVerifier(&testscommon.ProtobufMarshalizerMock{}, nil)
+		assert.True(t, check.IfNil(verifier))
+		assert.Equal(t, proof.ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		verifier, err := proof.NewProofVerifier(&testscommon.ProtobufMarshalizerMock{}, &testscommon.KeccakMock{})
+		assert.False(t, check.IfNil(verifier))
+		assert.Nil(t, err)
+	})
+}
+
+func TestProofVerifier_VerifyMultiKeyProof(t *testing.T) {
+	t.Parallel()
+
+	keys := [][]byte{[]byte("doe"), []byte("dog"), []byte("dogglesworth"), []byte("horse")}
+	tr := createTrie("doe", "dog", "dogglesworth", "cat", "ddog")
+	rootHash, _ := tr.RootHash()
+	multiProof, _, _ := tr.GetMultiProof(keys)
+
+	t.Run("empty root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		provenValues, err := createProofVerifier().VerifyMultiKeyProof(nil, keys, multiProof)
+		assert.Nil(t, provenValues)
+		assert.Equal(t, proof.ErrEmptyRootHash, err)
+	})
+	t.Run("no keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		provenValues, err := createProofVerifier().VerifyMultiKeyProof(rootHash, nil, multiProof)
+		assert.Nil(t, provenValues)
+		assert.Equal(t, proof.ErrNoKeys, err)
+	})
+	t.Run("key not covered by the proof should error", func(t *testing.T) {
+		t.Parallel()
+
+		provenValues, err := createProofVerifier().VerifyMultiKeyProof(rootHash, [][]byte{[]byte("cat")}, multiProof)
+		assert.Nil(t, provenValues)
+		assert.True(t, errors.Is(err, proof.ErrMissingProofNode))
+	})
+	t.Run("proof of a different trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		otherTrie := createTrie("doe", "dog", "dogglesworth")
+		otherProof, _, _ := otherTrie.GetMultiProof(keys)
+
+		provenValues, err := createProofVerifier().VerifyMultiKeyProof(rootHash, keys, otherProof)
+		assert.Nil(t, provenValues)
+		assert.True(t, errors.Is(err, proof.ErrMissingProofNode))
+	})
+	t.Run("empty trie should prove the absence of the keys", func(t *testing.T) {
+		t.Parallel()
+
+		provenValues, err := createProofVerifier().VerifyMultiKeyProof(emptyTrieHash, keys, nil)
+		require.Nil(t, err)
+		require.Equal(t, len(keys), len(provenValues))
+		for _, provenValue := range provenValues {
+			assert.Nil(t, provenValue.Value)
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		provenValues, err := createProofVerifier().VerifyMultiKeyProof(rootHash, keys, multiProof)
+		require.Nil(t, err)
+		expectedValues := []proof.KeyValue{
+			{Key: []byte("doe"), Value: []byte("doe value")},
+			{Key: []byte("dog"), Value: []byte("dog value")},
+			{Key: []byte("dogglesworth"), Value: []byte("dogglesworth value")},
+			{Key: []byte("horse"), Value: nil},
+		}
+		assert.Equal(t, expectedValues, provenValues)
+	})
+}
+
+func TestProofVerifier_VerifyRangeProof(t *testing.T) {
+	t.Parallel()
+
+	keys := []string{"doe", "dog", "dogglesworth", "cat", "ddog", "horse"}
+	tr := createTrie(keys...)
+	rootHash, _ := tr.RootHash()
+	rangeProof, _ := tr.GetRangeProof(nil, nil, 0)
+
+	t.Run("empty root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		provenValues, err := createProofVerifier().VerifyRangeProof(nil, nil, nil, rangeProof.Proof)
+		assert.Nil(t, provenValues)
+		assert.Equal(t, proof.ErrEmptyRootHash, err)
+	})
+	t.Run("invalid range should error", func(t *testing.T) {
+		t.Parallel()
+
+		provenValues, err := createProofVerifier().VerifyRangeProof(rootHash, []byte{0x12}, []byte{0x21}, rangeProof.Proof)
+		assert.Nil(t, provenValues)
+		assert.Equal(t, proof.ErrInvalidRange, err)
+	})
+	t.Run("incomplete proof should error", func(t *testing.T) {
+		t.Parallel()
+
+		incompleteProof := rangeProof.Proof[:len(rangeProof.Proof)-1]
+		provenValues, err := createProofVerifier().VerifyRangeProof(rootHash, nil, nil, incompleteProof)
+		assert.Nil(t, provenValues)
+		assert.True(t, errors.Is(err, proof.ErrMissingProofNode))
+	})
+	t.Run("empty trie should prove an empty range", func(t *testing.T) {
+		t.Parallel()
+
+		provenValues, err := createProofVerifier().VerifyRangeProof(emptyTrieHash, nil, nil, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(provenValues))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		provenValues, err := createProofVerifier().VerifyRangeProof(rootHash, nil, nil, rangeProof.Proof)
+		require.Nil(t, err)
+		require.Equal(t, len(keys), len(provenValues))
+		for i, provenValue := range provenValues {
+			assert.Equal(t, rangeProof.Keys[i], provenValue.Key)
+			assert.Equal(t, append(provenValue.Key, []byte(" value")...), provenValue.Value)
+		}
+	})
+	t.Run("sub range should work", func(t *testing.T) {
+		t.Parallel()
+
+		subRangeProof, err := tr.GetRangeProof(rangeProof.Keys[1], rangeProof.Keys[3], 0)
+		require.Nil(t, err)
+		assert.Less(t, len(subRangeProof.Proof), len(rangeProof.Proof))
+
+		provenValues, err := createProofVerifier().VerifyRangeProof(rootHash, rangeProof.Keys[1], rangeProof.Keys[3], subRangeProof.Proof)
+		require.Nil(t, err)
+		require.Equal(t, 3, len(provenValues))
+		for i, provenValue := range provenValues {
+			assert.Equal(t, rangeProof.Keys[i+1], provenValue.Key)
+		}
+	})
+}
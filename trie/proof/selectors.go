@@ -0,0 +1,100 @@
+package proof
+
+import (
+	"bytes"
+	"sort"
+)
+
+// PathSelector decides which parts of a trie are covered by a proof
+type PathSelector interface {
+	Intersects(prefix []byte) bool
+	Selects(path []byte) bool
+}
+
+type keysSelector struct {
+	paths [][]byte
+}
+
+// NewKeysSelector creates a selector that covers the provided keys
+func NewKeysSelector(keys [][]byte) *keysSelector {
+	paths := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		paths = append(paths, KeyToPath(key))
+	}
+
+	sort.Slice(paths, func(i, j int) bool {
+		return bytes.Compare(paths[i], paths[j]) < 0
+	})
+
+	return &keysSelector{
+		paths: paths,
+	}
+}
+
+// Intersects returns true if the path of at least one of the keys starts with the provided prefix
+func (ks *keysSelector) Intersects(prefix []byte) bool {
+	index := sort.Search(len(ks.paths), func(i int) bool {
+		return bytes.Compare(ks.paths[i], prefix) >= 0
+	})
+
+	return index < len(ks.paths) && bytes.HasPrefix(ks.paths[index], prefix)
+}
+
+// Selects returns true if the provided path belongs to one of the keys
+func (ks *keysSelector) Selects(path []byte) bool {
+	index := sort.Search(len(ks.paths), func(i int) bool {
+		return bytes.Compare(ks.paths[i], path) >= 0
+	})
+
+	return index < len(ks.paths) && bytes.Equal(ks.paths[index], path)
+}
+
+type rangeSelector struct {
+	startPath []byte
+	endPath   []byte
+}
+
+// NewRangeSelector creates a selector that covers all the keys placed between the start key and the end key,
+// both included. An empty start key leaves the range unbounded at the beginning and an empty end key leaves
+// the range unbounded at the end
+func NewRangeSelector(startKey []byte, endKey []byte) (*rangeSelector, error) {
+	rs := &rangeSelector{}
+	if len(startKey) > 0 {
+		rs.startPath = KeyToPath(startKey)
+	}
+	if len(endKey) > 0 {
+		rs.endPath = KeyToPath(endKey)
+	}
+
+	isInvalidRange := len(rs.startPath) > 0 && len(rs.endPath) > 0 && bytes.Compare(rs.startPath, rs.endPath) > 0
+	if isInvalidRange {
+		return nil, ErrInvalidRange
+	}
+
+	return rs, nil
+}
+
+// Intersects returns true if at least one path starting with the provided prefix is placed inside the range
+func (rs *rangeSelector) Intersects(prefix []byte) bool {
+	if len(rs.startPath) > 0 {
+		startPrefix := rs.startPath
+		if len(prefix) < len(startPrefix) {
+			startPrefix = startPrefix[:len(prefix)]
+		}
+
+		if bytes.Compare(prefix, startPrefix) < 0 {
+			return false
+		}
+	}
+
+	return len(rs.endPath) == 0 || bytes.Compare(prefix, rs.endPath) <= 0
+}
+
+// Selects returns true if the provided path is placed inside the range
+func (rs *rangeSelector) Selects(path []byte) bool {
+	if len(rs.startPath) > 0 && bytes.Compare(path, rs.startPath) < 0 {
+		return false
+	}
+
+	return len(rs.endPath) == 0 || bytes.Compare(path, rs.endPath) <= 0
+}
@@ -0,0 +1,80 @@
+package proof_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/trie/proof"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeysSelector(t *testing.T) {
+	t.Parallel()
+
+	ks := proof.NewKeysSelector([][]byte{{0x12, 0x34}, {0x56}})
+
+	assert.True(t, ks.Intersects(nil))
+	assert.True(t, ks.Intersects([]byte{4, 3}))
+	assert.True(t, ks.Intersects([]byte{6, 5}))
+	assert.False(t, ks.Intersects([]byte{4, 4}))
+	assert.False(t, ks.Intersects([]byte{7}))
+
+	assert.True(t, ks.Selects([]byte{4, 3, 2, 1, 16}))
+	assert.True(t, ks.Selects([]byte{6, 5, 16}))
+	assert.False(t, ks.Selects([]byte{4, 3, 16}))
+}
+
+func TestNewRangeSelector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("start key after end key should error", func(t *testing.T) {
+		t.Parallel()
+
+		rs, err := proof.NewRangeSelector([]byte{0x12}, []byte{0x21})
+		assert.Nil(t, rs)
+		assert.Equal(t, proof.ErrInvalidRange, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rs, err := proof.NewRangeSelector([]byte{0x21}, []byte{0x12})
+		assert.Nil(t, err)
+		assert.NotNil(t, rs)
+	})
+}
+
+func TestRangeSelector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("bounded range", func(t *testing.T) {
+		t.Parallel()
+
+		rs, err := proof.NewRangeSelector([]byte{0x31}, []byte{0x15})
+		require.Nil(t, err)
+
+		assert.True(t, rs.Intersects(nil))
+		assert.True(t, rs.Intersects([]byte{1}))
+		assert.True(t, rs.Intersects([]byte{3}))
+		assert.True(t, rs.Intersects([]byte{5}))
+		assert.False(t, rs.Intersects([]byte{0}))
+		assert.False(t, rs.Intersects([]byte{1, 2}))
+		assert.False(t, rs.Intersects([]byte{6}))
+
+		assert.True(t, rs.Selects([]byte{1, 3, 16}))
+		assert.True(t, rs.Selects([]byte{2, 0, 16}))
+		assert.True(t, rs.Selects([]byte{5, 1, 16}))
+		assert.False(t, rs.Selects([]byte{1, 2, 16}))
+		assert.False(t, rs.Selects([]byte{5, 2, 16}))
+	})
+	t.Run("unbounded range", func(t *testing.T) {
+		t.Parallel()
+
+		rs, err := proof.NewRangeSelector(nil, nil)
+		require.Nil(t, err)
+
+		assert.True(t, rs.Intersects([]byte{0}))
+		assert.True(t, rs.Intersects([]byte{16}))
+		assert.True(t, rs.Selects([]byte{0, 0, 16}))
+		assert.True(t, rs.Selects([]byte{15, 15, 16}))
+	})
+}
@@ -0,0 +1,87 @@
+package trie
+
+import (
+	"github.com/ElrondNetwork/elrond-go/trie/proof"
+)
+
+// proofCollector gathers the encoded nodes needed to prove the leaves covered by a path selector. Every node is
+// added only once, no matter how many of the proven keys share it. When a maximum number of leaves is set, the
+// covered range stops at the last leaf that fits. The traversal then continues until a further leaf is found in the
+// range, which marks the range as truncated. If no such leaf exists, the collected nodes prove the whole range
+type proofCollector struct {
+	selector      proof.PathSelector
+	maxNumLeaves  int
+	lastPath      []byte
+	hasMoreLeaves bool
+	encodedNodes  [][]byte
+	addedHashes   map[string]struct{}
+	paths         [][]byte
+	values        [][]byte
+}
+
+func newProofCollector(selector proof.PathSelector, maxNumLeaves int) *proofCollector {
+	return &proofCollector{
+		selector:     selector,
+		maxNumLeaves: maxNumLeaves,
+		encodedNodes: make([][]byte, 0),
+		addedHashes:  make(map[string]struct{}),
+		paths:        make([][]byte, 0),
+		values:       make([][]byte, 0),
+	}
+}
+
+func (pc *proofCollector) addNode(n node) error {
+	hash := string(n.getHash())
+	_, isAdded := pc.addedHashes[hash]
+	if isAdded {
+		return nil
+	}
+
+	encodedNode, err := n.getEncodedNode()
+	if err != nil {
+		return err
+	}
+
+	pc.addedHashes[hash] = struct{}{}
+	pc.encodedNodes = append(pc.encodedNodes, encodedNode)
+
+	return nil
+}
+
+func (pc *proofCollector) intersects(prefix []byte) bool {
+	if pc.hasMoreLeaves {
+		return false
+	}
+
+	return pc.selector.Intersects(prefix)
+}
+
+func (pc *proofCollector) selects(path []byte) bool {
+	if pc.hasMoreLeaves {
+		return false
+	}
+
+	return pc.selector.Selects(path)
+}
+
+func (pc *proofCollector) addLeaf(path []byte, value []byte) {
+	if pc.isLimitReached() {
+		pc.hasMoreLeaves = true
+		return
+	}
+
+	pc.paths = append(pc.paths, path)
+	pc.values = append(pc.values, value)
+
+	if pc.maxNumLeaves > 0 && len(pc.paths) >= pc.maxNumLeaves {
+		pc.lastPath = path
+	}
+}
+
+func (pc *proofCollector) isLimitReached() bool {
+	return len(pc.lastPath) > 0
+}
+
+func (pc *proofCollector) isTruncated() bool {
+	return pc.hasMoreLeaves
+}
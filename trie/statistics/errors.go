@@ -0,0 +1,21 @@
+package statistics
+
+import "errors"
+
+// ErrNilTrie signals that a nil trie was provided
+var ErrNilTrie = errors.New("nil trie")
+
+// ErrNilMarshalizer signals that a nil marshalizer was provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilPubkeyConverter signals that a nil public key converter was provided
+var ErrNilPubkeyConverter = errors.New("nil public key converter")
+
+// ErrInvalidNumLargestDataTries signals that an invalid number of reported data tries was provided
+var ErrInvalidNumLargestDataTries = errors.New("invalid number of largest data tries")
+
+// ErrIncompleteIteration signals that not all the leaves of the accounts trie could be iterated
+var ErrIncompleteIteration = errors.New("the accounts trie leaves iteration is incomplete")
+
+// ErrNilMetricsHandler signals that a nil metrics handler was provided
+var ErrNilMetricsHandler = errors.New("nil metrics handler")
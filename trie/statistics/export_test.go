@@ -0,0 +1,7 @@
+package statistics
+
+import "time"
+
+func (tss *trieSyncStatistics) SetTimeHandler(handler func() time.Time) {
+	tss.getTimeHandler = handler
+}
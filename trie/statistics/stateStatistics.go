@@ -0,0 +1,218 @@
+package statistics
+
+import (
+	"bytes"
+	"container/heap"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+var log = logger.GetOrCreate("trie/statistics")
+
+const progressLogInterval = 100000
+
+// emptyTrieHash is the root hash of an empty trie
+var emptyTrieHash = make([]byte, 32)
+
+// ArgsStateStatisticsCollector holds the arguments needed to create a state statistics collector
+type ArgsStateStatisticsCollector struct {
+	AccountsTrie        common.Trie
+	Marshalizer         marshal.Marshalizer
+	AddressConverter    core.PubkeyConverter
+	NumLargestDataTries int
+	// DataTrieHandler, if set, is called for the data trie of every account, not only for the largest ones
+	DataTrieHandler func(dataTrieStatistics *common.AccountDataTrieStatistics)
+}
+
+// stateStatisticsCollector walks an accounts trie and the data tries of its accounts, collecting their structure and
+// size and keeping track of the accounts having the largest data tries
+type stateStatisticsCollector struct {
+	accountsTrie        common.Trie
+	marshalizer         marshal.Marshalizer
+	addressConverter    core.PubkeyConverter
+	numLargestDataTries int
+	dataTrieHandler     func(dataTrieStatistics *common.AccountDataTrieStatistics)
+}
+
+// NewStateStatisticsCollector creates a new state statistics collector
+func NewStateStatisticsCollector(args ArgsStateStatisticsCollector) (*stateStatisticsCollector, error) {
+	if check.IfNil(args.AccountsTrie) {
+		return nil, ErrNilTrie
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.AddressConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if args.NumLargestDataTries < 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidNumLargestDataTries, args.NumLargestDataTries)
+	}
+
+	return &stateStatisticsCollector{
+		accountsTrie:        args.AccountsTrie,
+		marshalizer:         args.Marshalizer,
+		addressConverter:    args.AddressConverter,
+		numLargestDataTries: args.NumLargestDataTries,
+		dataTrieHandler:     args.DataTrieHandler,
+	}, nil
+}
+
+// Collect walks the accounts trie having the provided root hash and all the data tries of its accounts. The accounts
+// trie is walked twice: once node by node, for its structure, and once leaf by leaf, for the accounts
+func (ssc *stateStatisticsCollector) Collect(rootHash []byte) (*common.StateStatistics, error) {
+	accountsTrieStatistics := NewTrieStatistics()
+	err := ssc.accountsTrie.CollectStatistics(rootHash, accountsTrieStatistics)
+	if err != nil {
+		return nil, err
+	}
+
+	leavesChannel, err := ssc.accountsTrie.GetAllLeavesOnChannel(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	dataTriesStatistics := NewTrieStatistics()
+	largestDataTries := &dataTriesHeap{}
+	numAccounts := uint64(0)
+	numAccountsWithDataTrie := uint64(0)
+	for leaf := range leavesChannel {
+		numAccounts++
+		if numAccounts%progressLogInterval == 0 {
+			log.Info("state statistics in progress", "num accounts", numAccounts, "num data tries", numAccountsWithDataTrie)
+		}
+		if err != nil {
+			// the channel must be drained so the iterating go routine can finish
+			continue
+		}
+
+		var dataTrieStatistics *common.AccountDataTrieStatistics
+		dataTrieStatistics, err = ssc.collectDataTrie(leaf.Key(), leaf.Value(), dataTriesStatistics)
+		if err != nil || dataTrieStatistics == nil {
+			continue
+		}
+
+		numAccountsWithDataTrie++
+		if ssc.dataTrieHandler != nil {
+			ssc.dataTrieHandler(dataTrieStatistics)
+		}
+		largestDataTries.add(dataTrieStatistics, ssc.numLargestDataTries)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if numAccounts != accountsTrieStatistics.NumLeafNodes() {
+		return nil, fmt.Errorf("%w: %d accounts iterated out of %d", ErrIncompleteIteration, numAccounts, accountsTrieStatistics.NumLeafNodes())
+	}
+
+	return &common.StateStatistics{
+		RootHash:                hex.EncodeToString(rootHash),
+		AccountsTrie:            accountsTrieStatistics.GetTrieStatistics(),
+		DataTries:               dataTriesStatistics.GetTrieStatistics(),
+		NumAccounts:             numAccounts,
+		NumAccountsWithDataTrie: numAccountsWithDataTrie,
+		LargestDataTries:        largestDataTries.sorted(),
+	}, nil
+}
+
+// collectDataTrie walks the data trie of the account, if any, and adds its statistics to the aggregated ones
+func (ssc *stateStatisticsCollector) collectDataTrie(
+	address []byte,
+	accountBytes []byte,
+	dataTriesStatistics *trieStatistics,
+) (*common.AccountDataTrieStatistics, error) {
+	account := &state.UserAccountData{}
+	err := ssc.marshalizer.Unmarshal(account, accountBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the account %s", err, hex.EncodeToString(address))
+	}
+	if len(account.RootHash) == 0 || bytes.Equal(account.RootHash, emptyTrieHash) {
+		return nil, nil
+	}
+
+	dataTrieStatistics := NewTrieStatistics()
+	err = ssc.accountsTrie.CollectStatistics(account.RootHash, dataTrieStatistics)
+	if err != nil {
+		return nil, fmt.Errorf("%w while walking the data trie of the account %s", err, hex.EncodeToString(address))
+	}
+	dataTriesStatistics.merge(dataTrieStatistics)
+
+	return &common.AccountDataTrieStatistics{
+		Address:          ssc.addressConverter.Encode(address),
+		RootHash:         hex.EncodeToString(account.RootHash),
+		NumKeys:          dataTrieStatistics.NumLeafNodes(),
+		NumNodes:         dataTrieStatistics.NumNodes(),
+		TotalSizeInBytes: dataTrieStatistics.TotalSizeInBytes(),
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ssc *stateStatisticsCollector) IsInterfaceNil() bool {
+	return ssc == nil
+}
+
+// dataTriesHeap is a min heap, by size, of the largest data tries found so far
+type dataTriesHeap []*common.AccountDataTrieStatistics
+
+// Len returns the number of data tries in the heap
+func (dth dataTriesHeap) Len() int {
+	return len(dth)
+}
+
+// Less returns true if the data trie at index i is smaller than the one at index j
+func (dth dataTriesHeap) Less(i, j int) bool {
+	return dth[i].TotalSizeInBytes < dth[j].TotalSizeInBytes
+}
+
+// Swap swaps the data tries at the provided indexes
+func (dth dataTriesHeap) Swap(i, j int) {
+	dth[i], dth[j] = dth[j], dth[i]
+}
+
+// Push adds a data trie to the heap
+func (dth *dataTriesHeap) Push(x interface{}) {
+	*dth = append(*dth, x.(*common.AccountDataTrieStatistics))
+}
+
+// Pop removes the last data trie from the heap
+func (dth *dataTriesHeap) Pop() interface{} {
+	old := *dth
+	last := old[len(old)-1]
+	*dth = old[:len(old)-1]
+
+	return last
+}
+
+func (dth *dataTriesHeap) add(dataTrieStatistics *common.AccountDataTrieStatistics, maxLen int) {
+	if maxLen == 0 {
+		return
+	}
+	if dth.Len() < maxLen {
+		heap.Push(dth, dataTrieStatistics)
+		return
+	}
+	if (*dth)[0].TotalSizeInBytes >= dataTrieStatistics.TotalSizeInBytes {
+		return
+	}
+
+	(*dth)[0] = dataTrieStatistics
+	heap.Fix(dth, 0)
+}
+
+func (dth *dataTriesHeap) sorted() []*common.AccountDataTrieStatistics {
+	sortedDataTries := make([]*common.AccountDataTrieStatistics, dth.Len())
+	copy(sortedDataTries, *dth)
+	sort.SliceStable(sortedDataTries, func(i, j int) bool {
+		return sortedDataTries[i].TotalSizeInBytes > sortedDataTries[j].TotalSizeInBytes
+	})
+
+	return sortedDataTries
+}
@@ -0,0 +1,187 @@
+package statistics
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMarshalizer = &marshal.GogoProtoMarshalizer{}
+
+func createMockArgsStateStatisticsCollector() ArgsStateStatisticsCollector {
+	return ArgsStateStatisticsCollector{
+		AccountsTrie:        &trieMock.TrieStub{},
+		Marshalizer:         testMarshalizer,
+		AddressConverter:    testscommon.NewPubkeyConverterMock(32),
+		NumLargestDataTries: 2,
+	}
+}
+
+// createAccountsTrie creates an accounts trie holding 10 accounts, 3 of them having data tries with 1, 5 and 20 keys
+func createAccountsTrie(t *testing.T) (common.Trie, []byte) {
+	storageManager, err := trie.NewTrieStorageManagerWithoutPruning(testscommon.NewMemDbMock())
+	require.Nil(t, err)
+	accountsTrie, err := trie.NewTrie(storageManager, testMarshalizer, blake2b.NewBlake2b(), 5)
+	require.Nil(t, err)
+
+	numKeysInDataTries := map[int]int{2: 1, 5: 5, 7: 20}
+	for i := 0; i < 10; i++ {
+		account := &state.UserAccountData{
+			Nonce:   uint64(i),
+			Address: []byte(fmt.Sprintf("address%25d", i)),
+		}
+
+		numKeys, hasDataTrie := numKeysInDataTries[i]
+		if hasDataTrie {
+			dataTrie, errCreate := trie.NewTrie(storageManager, testMarshalizer, blake2b.NewBlake2b(), 5)
+			require.Nil(t, errCreate)
+			for j := 0; j < numKeys; j++ {
+				_ = dataTrie.Update([]byte(fmt.Sprintf("key%d", j)), []byte(fmt.Sprintf("value%d", j)))
+			}
+			require.Nil(t, dataTrie.Commit())
+			account.RootHash, _ = dataTrie.RootHash()
+		}
+
+		accountBytes, errMarshal := testMarshalizer.Marshal(account)
+		require.Nil(t, errMarshal)
+		require.Nil(t, accountsTrie.Update(account.Address, accountBytes))
+	}
+
+	require.Nil(t, accountsTrie.Commit())
+	rootHash, err := accountsTrie.RootHash()
+	require.Nil(t, err)
+
+	return accountsTrie, rootHash
+}
+
+func TestNewStateStatisticsCollector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateStatisticsCollector()
+		args.AccountsTrie = nil
+
+		ssc, err := NewStateStatisticsCollector(args)
+		assert.True(t, check.IfNil(ssc))
+		assert.Equal(t, ErrNilTrie, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateStatisticsCollector()
+		args.Marshalizer = nil
+
+		ssc, err := NewStateStatisticsCollector(args)
+		assert.True(t, check.IfNil(ssc))
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("nil address converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateStatisticsCollector()
+		args.AddressConverter = nil
+
+		ssc, err := NewStateStatisticsCollector(args)
+		assert.True(t, check.IfNil(ssc))
+		assert.Equal(t, ErrNilPubkeyConverter, err)
+	})
+	t.Run("negative number of data tries should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateStatisticsCollector()
+		args.NumLargestDataTries = -1
+
+		ssc, err := NewStateStatisticsCollector(args)
+		assert.True(t, check.IfNil(ssc))
+		assert.True(t, errors.Is(err, ErrInvalidNumLargestDataTries))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ssc, err := NewStateStatisticsCollector(createMockArgsStateStatisticsCollector())
+		assert.False(t, check.IfNil(ssc))
+		assert.Nil(t, err)
+	})
+}
+
+func TestStateStatisticsCollector_Collect(t *testing.T) {
+	t.Parallel()
+
+	accountsTrie, rootHash := createAccountsTrie(t)
+	args := createMockArgsStateStatisticsCollector()
+	args.AccountsTrie = accountsTrie
+	handledDataTries := make([]*common.AccountDataTrieStatistics, 0)
+	args.DataTrieHandler = func(dataTrieStatistics *common.AccountDataTrieStatistics) {
+		handledDataTries = append(handledDataTries, dataTrieStatistics)
+	}
+	ssc, _ := NewStateStatisticsCollector(args)
+
+	stats, err := ssc.Collect(rootHash)
+	require.Nil(t, err)
+
+	assert.Equal(t, uint64(10), stats.NumAccounts)
+	assert.Equal(t, uint64(3), stats.NumAccountsWithDataTrie)
+	assert.Equal(t, uint64(10), stats.AccountsTrie.NumLeafNodes)
+	assert.Equal(t, uint64(26), stats.DataTries.NumLeafNodes)
+	assert.Equal(t, 3, len(handledDataTries))
+
+	require.Equal(t, 2, len(stats.LargestDataTries))
+	assert.Equal(t, uint64(20), stats.LargestDataTries[0].NumKeys)
+	assert.Equal(t, uint64(5), stats.LargestDataTries[1].NumKeys)
+	assert.True(t, stats.LargestDataTries[0].TotalSizeInBytes > stats.LargestDataTries[1].TotalSizeInBytes)
+
+	totalSize := uint64(0)
+	for _, dataTrieStatistics := range handledDataTries {
+		totalSize += dataTrieStatistics.TotalSizeInBytes
+	}
+	assert.Equal(t, totalSize, stats.DataTries.TotalSizeInBytes)
+}
+
+func TestStateStatisticsCollector_CollectErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsStateStatisticsCollector()
+	args.AccountsTrie = &trieMock.TrieStub{
+		CollectStatisticsCalled: func(_ []byte, _ common.TrieStatisticsHandler) error {
+			return expectedErr
+		},
+	}
+	ssc, _ := NewStateStatisticsCollector(args)
+
+	stats, err := ssc.Collect([]byte("root hash"))
+	assert.Nil(t, stats)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestDataTriesHeap_ShouldKeepTheLargest(t *testing.T) {
+	t.Parallel()
+
+	dth := &dataTriesHeap{}
+	for _, size := range []uint64{5, 1, 9, 3, 7, 9, 2} {
+		dth.add(&common.AccountDataTrieStatistics{TotalSizeInBytes: size}, 3)
+	}
+
+	sorted := dth.sorted()
+	require.Equal(t, 3, len(sorted))
+	assert.Equal(t, uint64(9), sorted[0].TotalSizeInBytes)
+	assert.Equal(t, uint64(9), sorted[1].TotalSizeInBytes)
+	assert.Equal(t, uint64(7), sorted[2].TotalSizeInBytes)
+
+	empty := &dataTriesHeap{}
+	empty.add(&common.AccountDataTrieStatistics{TotalSizeInBytes: 1}, 0)
+	assert.Equal(t, 0, len(empty.sorted()))
+}
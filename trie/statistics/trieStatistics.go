@@ -0,0 +1,102 @@
+package statistics
+
+import (
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// trieStatistics collects the number of nodes by type, the depth histogram and the size of the visited tries. It is
+// fed by a single trie walk at a time, so it is not safe for concurrent use
+type trieStatistics struct {
+	numBranchNodes    uint64
+	numExtensionNodes uint64
+	numLeafNodes      uint64
+	totalSizeInBytes  uint64
+	depthHistogram    []uint64
+}
+
+// NewTrieStatistics returns a structure able to collect the statistics of one or more tries
+func NewTrieStatistics() *trieStatistics {
+	return &trieStatistics{
+		depthHistogram: make([]uint64, 0),
+	}
+}
+
+// AddBranchNode adds a branch node found at the provided depth
+func (ts *trieStatistics) AddBranchNode(depth int, size uint64) {
+	ts.numBranchNodes++
+	ts.addNode(depth, size)
+}
+
+// AddExtensionNode adds an extension node found at the provided depth
+func (ts *trieStatistics) AddExtensionNode(depth int, size uint64) {
+	ts.numExtensionNodes++
+	ts.addNode(depth, size)
+}
+
+// AddLeafNode adds a leaf node found at the provided depth
+func (ts *trieStatistics) AddLeafNode(depth int, size uint64) {
+	ts.numLeafNodes++
+	ts.addNode(depth, size)
+}
+
+func (ts *trieStatistics) addNode(depth int, size uint64) {
+	ts.totalSizeInBytes += size
+	for len(ts.depthHistogram) <= depth {
+		ts.depthHistogram = append(ts.depthHistogram, 0)
+	}
+	ts.depthHistogram[depth]++
+}
+
+// merge adds the statistics collected by another instance to the current ones
+func (ts *trieStatistics) merge(other *trieStatistics) {
+	ts.numBranchNodes += other.numBranchNodes
+	ts.numExtensionNodes += other.numExtensionNodes
+	ts.numLeafNodes += other.numLeafNodes
+	ts.totalSizeInBytes += other.totalSizeInBytes
+	for depth, numNodes := range other.depthHistogram {
+		if len(ts.depthHistogram) <= depth {
+			ts.depthHistogram = append(ts.depthHistogram, 0)
+		}
+		ts.depthHistogram[depth] += numNodes
+	}
+}
+
+// NumNodes returns the number of nodes of all types
+func (ts *trieStatistics) NumNodes() uint64 {
+	return ts.numBranchNodes + ts.numExtensionNodes + ts.numLeafNodes
+}
+
+// NumLeafNodes returns the number of leaf nodes
+func (ts *trieStatistics) NumLeafNodes() uint64 {
+	return ts.numLeafNodes
+}
+
+// TotalSizeInBytes returns the size of all the nodes, as encoded in the storage
+func (ts *trieStatistics) TotalSizeInBytes() uint64 {
+	return ts.totalSizeInBytes
+}
+
+// GetTrieStatistics returns the collected statistics
+func (ts *trieStatistics) GetTrieStatistics() *common.TrieStatistics {
+	depthHistogram := make([]uint64, len(ts.depthHistogram))
+	copy(depthHistogram, ts.depthHistogram)
+
+	maxDepth := uint32(0)
+	if len(depthHistogram) > 0 {
+		maxDepth = uint32(len(depthHistogram) - 1)
+	}
+
+	return &common.TrieStatistics{
+		NumBranchNodes:    ts.numBranchNodes,
+		NumExtensionNodes: ts.numExtensionNodes,
+		NumLeafNodes:      ts.numLeafNodes,
+		TotalSizeInBytes:  ts.totalSizeInBytes,
+		MaxDepth:          maxDepth,
+		DepthHistogram:    depthHistogram,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ts *trieStatistics) IsInterfaceNil() bool {
+	return ts == nil
+}
@@ -0,0 +1,81 @@
+package statistics
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTrieStatistics_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ts := NewTrieStatistics()
+
+	assert.False(t, check.IfNil(ts))
+	assert.Equal(t, &common.TrieStatistics{DepthHistogram: make([]uint64, 0)}, ts.GetTrieStatistics())
+}
+
+func TestTrieStatistics_AddNodes(t *testing.T) {
+	t.Parallel()
+
+	ts := NewTrieStatistics()
+	ts.AddExtensionNode(0, 40)
+	ts.AddBranchNode(1, 100)
+	ts.AddLeafNode(2, 30)
+	ts.AddLeafNode(2, 35)
+	ts.AddBranchNode(2, 90)
+	ts.AddLeafNode(3, 20)
+
+	expectedStatistics := &common.TrieStatistics{
+		NumBranchNodes:    2,
+		NumExtensionNodes: 1,
+		NumLeafNodes:      3,
+		TotalSizeInBytes:  315,
+		MaxDepth:          3,
+		DepthHistogram:    []uint64{1, 1, 3, 1},
+	}
+	assert.Equal(t, expectedStatistics, ts.GetTrieStatistics())
+	assert.Equal(t, uint64(6), ts.NumNodes())
+	assert.Equal(t, uint64(3), ts.NumLeafNodes())
+	assert.Equal(t, uint64(315), ts.TotalSizeInBytes())
+}
+
+func TestTrieStatistics_Merge(t *testing.T) {
+	t.Parallel()
+
+	ts := NewTrieStatistics()
+	ts.AddBranchNode(0, 100)
+	ts.AddLeafNode(1, 30)
+
+	other := NewTrieStatistics()
+	other.AddExtensionNode(0, 40)
+	other.AddBranchNode(1, 90)
+	other.AddLeafNode(2, 20)
+	other.AddLeafNode(2, 25)
+
+	ts.merge(other)
+
+	expectedStatistics := &common.TrieStatistics{
+		NumBranchNodes:    2,
+		NumExtensionNodes: 1,
+		NumLeafNodes:      3,
+		TotalSizeInBytes:  305,
+		MaxDepth:          2,
+		DepthHistogram:    []uint64{2, 2, 2},
+	}
+	assert.Equal(t, expectedStatistics, ts.GetTrieStatistics())
+}
+
+func TestTrieStatistics_GetTrieStatisticsShouldReturnACopy(t *testing.T) {
+	t.Parallel()
+
+	ts := NewTrieStatistics()
+	ts.AddLeafNode(0, 30)
+
+	stats := ts.GetTrieStatistics()
+	stats.DepthHistogram[0] = 100
+
+	assert.Equal(t, []uint64{1}, ts.GetTrieStatistics().DepthHistogram)
+}
@@ -1,36 +1,67 @@
 package statistics
 
-import "sync"
+import (
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+const (
+	receiveRateWindow = time.Second
+	// receiveRateSmoothingFactor is the weight of the last window in the moving average of the receive rate
+	receiveRateSmoothingFactor = 0.3
+)
 
 type trieSyncStatistics struct {
 	sync.RWMutex
-	numReceived int
-	numMissing  int
-	numLarge    int
-	missingMap  map[string]int
+	numReceived         int
+	numMissing          int
+	numLarge            int
+	missingMap          map[string]int
+	windowStart         time.Time
+	numReceivedInWindow int
+	receivedPerSecond   float64
+	getTimeHandler      func() time.Time
+	metricsHandler      common.MetricsHandler
 }
 
 // NewTrieSyncStatistics returns a structure able to collect sync statistics from a trie and store them
-func NewTrieSyncStatistics() *trieSyncStatistics {
-	return &trieSyncStatistics{
-		missingMap: make(map[string]int),
+func NewTrieSyncStatistics(metricsHandler common.MetricsHandler) (*trieSyncStatistics, error) {
+	if check.IfNil(metricsHandler) {
+		return nil, ErrNilMetricsHandler
 	}
+
+	return &trieSyncStatistics{
+		missingMap:     make(map[string]int),
+		getTimeHandler: time.Now,
+		metricsHandler: metricsHandler,
+	}, nil
 }
 
 // Reset will reset the contained values to 0
 func (tss *trieSyncStatistics) Reset() {
 	tss.Lock()
+	tss.metricsHandler.AddTrieSyncMissingNodes(-tss.numMissing)
 	tss.numReceived = 0
 	tss.numMissing = 0
 	tss.numLarge = 0
+	tss.windowStart = time.Time{}
+	tss.numReceivedInWindow = 0
+	tss.receivedPerSecond = 0
 	tss.Unlock()
 }
 
 // AddNumReceived will add the provided value to the existing numReceived
 func (tss *trieSyncStatistics) AddNumReceived(value int) {
 	tss.Lock()
+	tss.updateReceiveRate()
 	tss.numReceived += value
+	tss.numReceivedInWindow += value
 	tss.Unlock()
+
+	tss.metricsHandler.AddTrieSyncReceivedNodes(value)
 }
 
 // AddNumLarge will add the provided value to the existing numLarge
@@ -38,6 +69,8 @@ func (tss *trieSyncStatistics) AddNumLarge(value int) {
 	tss.Lock()
 	tss.numLarge += value
 	tss.Unlock()
+
+	tss.metricsHandler.AddTrieSyncLargeNodes(value)
 }
 
 // SetNumMissing will write the provided value on the existing numMissing
@@ -53,10 +86,12 @@ func (tss *trieSyncStatistics) SetNumMissing(rootHash []byte, value int) {
 
 		delete(tss.missingMap, string(rootHash))
 		tss.numMissing -= existing
+		tss.metricsHandler.AddTrieSyncMissingNodes(-existing)
 		return
 	}
 
 	tss.numMissing += value - existing
+	tss.metricsHandler.AddTrieSyncMissingNodes(value - existing)
 	tss.missingMap[string(rootHash)] = value
 }
 
@@ -84,6 +119,68 @@ func (tss *trieSyncStatistics) NumMissing() int {
 	return tss.numMissing
 }
 
+// ReceivedPerSecond returns the moving average of the number of nodes received per second
+func (tss *trieSyncStatistics) ReceivedPerSecond() float64 {
+	tss.Lock()
+	defer tss.Unlock()
+
+	tss.updateReceiveRate()
+
+	return tss.receivedPerSecond
+}
+
+// EstimatedTimeLeft returns the time needed to receive, at the current rate, the nodes currently known to be missing.
+// As the children of the missing nodes are not known yet, the estimation grows while the tries are discovered. It
+// returns 0 if there are no missing nodes and a negative value if the receive rate is not known yet
+func (tss *trieSyncStatistics) EstimatedTimeLeft() time.Duration {
+	tss.Lock()
+	defer tss.Unlock()
+
+	tss.updateReceiveRate()
+
+	return tss.estimatedTimeLeft()
+}
+
+// updateReceiveRate closes the current window, if it is over, and adds its receive rate to the moving average.
+// It should be called under mutex protection
+func (tss *trieSyncStatistics) updateReceiveRate() {
+	now := tss.getTimeHandler()
+	if tss.windowStart.IsZero() {
+		tss.windowStart = now
+		return
+	}
+
+	elapsed := now.Sub(tss.windowStart)
+	if elapsed < receiveRateWindow {
+		return
+	}
+
+	windowRate := float64(tss.numReceivedInWindow) / elapsed.Seconds()
+	if tss.receivedPerSecond == 0 {
+		tss.receivedPerSecond = windowRate
+	} else {
+		tss.receivedPerSecond = receiveRateSmoothingFactor*windowRate + (1-receiveRateSmoothingFactor)*tss.receivedPerSecond
+	}
+	tss.windowStart = now
+	tss.numReceivedInWindow = 0
+
+	estimatedTimeLeft := tss.estimatedTimeLeft()
+	if estimatedTimeLeft >= 0 {
+		tss.metricsHandler.SetTrieSyncEstimatedTimeLeft(estimatedTimeLeft)
+	}
+}
+
+func (tss *trieSyncStatistics) estimatedTimeLeft() time.Duration {
+	if tss.numMissing <= 0 {
+		return 0
+	}
+	if tss.receivedPerSecond <= 0 {
+		return -1
+	}
+
+	return time.Duration(float64(tss.numMissing) / tss.receivedPerSecond * float64(time.Second))
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (tss *trieSyncStatistics) IsInterfaceNil() bool {
 	return tss == nil
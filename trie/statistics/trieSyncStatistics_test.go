@@ -2,23 +2,36 @@ package statistics
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/metrics/disabled"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
 )
 
+func TestNewTrieSyncStatistics_NilMetricsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tss, err := NewTrieSyncStatistics(nil)
+
+	assert.True(t, check.IfNil(tss))
+	assert.Equal(t, ErrNilMetricsHandler, err)
+}
+
 func TestNewTrieSyncStatistics_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	tss := NewTrieSyncStatistics()
+	tss, err := NewTrieSyncStatistics(&disabled.MetricsHandler{})
 
 	assert.False(t, check.IfNil(tss))
+	assert.Nil(t, err)
 }
 
 func TestTrieSyncStatistics_Received(t *testing.T) {
 	t.Parallel()
 
-	tss := NewTrieSyncStatistics()
+	tss, _ := NewTrieSyncStatistics(&disabled.MetricsHandler{})
 
 	assert.Equal(t, 0, tss.NumReceived())
 
@@ -35,7 +48,7 @@ func TestTrieSyncStatistics_Received(t *testing.T) {
 func TestTrieSyncStatistics_Missing(t *testing.T) {
 	t.Parallel()
 
-	tss := NewTrieSyncStatistics()
+	tss, _ := NewTrieSyncStatistics(&disabled.MetricsHandler{})
 
 	assert.Equal(t, 0, tss.NumMissing())
 
@@ -67,7 +80,7 @@ func TestTrieSyncStatistics_Missing(t *testing.T) {
 func TestTrieSyncStatistics_Large(t *testing.T) {
 	t.Parallel()
 
-	tss := NewTrieSyncStatistics()
+	tss, _ := NewTrieSyncStatistics(&disabled.MetricsHandler{})
 
 	assert.Equal(t, 0, tss.NumLarge())
 
@@ -80,3 +93,64 @@ func TestTrieSyncStatistics_Large(t *testing.T) {
 	tss.Reset()
 	assert.Equal(t, 0, tss.NumLarge())
 }
+
+func TestTrieSyncStatistics_ReceiveRateAndEstimatedTimeLeft(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	tss, _ := NewTrieSyncStatistics(&disabled.MetricsHandler{})
+	tss.SetTimeHandler(func() time.Time {
+		return currentTime
+	})
+
+	assert.Equal(t, time.Duration(0), tss.EstimatedTimeLeft())
+	tss.SetNumMissing([]byte("rh1"), 40)
+	assert.True(t, tss.EstimatedTimeLeft() < 0, "the receive rate is not known yet")
+
+	tss.AddNumReceived(10)
+	currentTime = currentTime.Add(time.Second)
+	tss.AddNumReceived(5)
+	assert.Equal(t, float64(10), tss.ReceivedPerSecond())
+	assert.Equal(t, 4*time.Second, tss.EstimatedTimeLeft())
+
+	currentTime = currentTime.Add(time.Second)
+	assert.InDelta(t, 8.5, tss.ReceivedPerSecond(), 0.001)
+
+	tss.SetNumMissing([]byte("rh1"), 0)
+	assert.Equal(t, time.Duration(0), tss.EstimatedTimeLeft())
+
+	tss.Reset()
+	assert.Equal(t, float64(0), tss.ReceivedPerSecond())
+}
+
+func TestTrieSyncStatistics_ReportsToTheMetricsHandler(t *testing.T) {
+	t.Parallel()
+
+	numReceived := 0
+	numLarge := 0
+	numMissing := 0
+	metricsHandler := &statusHandler.MetricsHandlerStub{
+		AddTrieSyncReceivedNodesCalled: func(numNodes int) {
+			numReceived += numNodes
+		},
+		AddTrieSyncLargeNodesCalled: func(numNodes int) {
+			numLarge += numNodes
+		},
+		AddTrieSyncMissingNodesCalled: func(difference int) {
+			numMissing += difference
+		},
+	}
+	tss, _ := NewTrieSyncStatistics(metricsHandler)
+
+	tss.AddNumReceived(3)
+	tss.AddNumLarge(1)
+	tss.SetNumMissing([]byte("root hash 1"), 5)
+	tss.SetNumMissing([]byte("root hash 2"), 2)
+	tss.SetNumMissing([]byte("root hash 1"), 0)
+	assert.Equal(t, 3, numReceived)
+	assert.Equal(t, 1, numLarge)
+	assert.Equal(t, 2, numMissing)
+
+	tss.Reset()
+	assert.Equal(t, 0, numMissing)
+}
@@ -54,6 +54,10 @@ type ArgTrieSyncer struct {
 	TrieSyncStatistics        data.SyncStatisticsHandler
 	MaxHardCapForMissingNodes int
 	TimeoutHandler            TimeoutHandler
+	// RequestsBudget is needed only by the adaptive trie syncer and it should be shared by all the concurrent syncers
+	RequestsBudget SyncRequestsBudget
+	// LeafValueHandler, if set, is called by the adaptive trie syncer with the value of each synced leaf
+	LeafValueHandler func(value []byte)
 }
 
 // NewTrieSyncer creates a new instance of trieSyncer
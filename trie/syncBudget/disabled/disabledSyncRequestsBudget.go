@@ -0,0 +1,44 @@
+package disabled
+
+import "time"
+
+type disabledSyncRequestsBudget struct {
+}
+
+// NewDisabledSyncRequestsBudget creates a new instance of disabledSyncRequestsBudget
+func NewDisabledSyncRequestsBudget() *disabledSyncRequestsBudget {
+	return &disabledSyncRequestsBudget{}
+}
+
+// Reserve returns all the provided hashes
+func (d *disabledSyncRequestsBudget) Reserve(hashes [][]byte) [][]byte {
+	return hashes
+}
+
+// MarkReceived does nothing for this implementation
+func (d *disabledSyncRequestsBudget) MarkReceived(_ []byte) {
+}
+
+// Release does nothing for this implementation
+func (d *disabledSyncRequestsBudget) Release(_ [][]byte) {
+}
+
+// BatchSize returns 0
+func (d *disabledSyncRequestsBudget) BatchSize() int {
+	return 0
+}
+
+// NumInFlight returns 0
+func (d *disabledSyncRequestsBudget) NumInFlight() int {
+	return 0
+}
+
+// Latency returns 0
+func (d *disabledSyncRequestsBudget) Latency() time.Duration {
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledSyncRequestsBudget) IsInterfaceNil() bool {
+	return d == nil
+}
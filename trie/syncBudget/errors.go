@@ -0,0 +1,15 @@
+package syncBudget
+
+import "errors"
+
+// ErrInvalidMaxInFlightNodes signals that an invalid maximum number of in flight nodes was provided
+var ErrInvalidMaxInFlightNodes = errors.New("invalid max in flight nodes")
+
+// ErrInvalidBatchSize signals that an invalid batch size was provided
+var ErrInvalidBatchSize = errors.New("invalid batch size")
+
+// ErrInvalidTargetLatency signals that an invalid target latency was provided
+var ErrInvalidTargetLatency = errors.New("invalid target latency")
+
+// ErrInvalidRequestTimeout signals that an invalid request timeout was provided
+var ErrInvalidRequestTimeout = errors.New("invalid request timeout")
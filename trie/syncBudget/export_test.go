@@ -0,0 +1,7 @@
+package syncBudget
+
+import "time"
+
+func (srb *syncRequestsBudget) SetTimeHandler(handler func() time.Time) {
+	srb.getTimeHandler = handler
+}
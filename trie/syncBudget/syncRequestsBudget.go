@@ -0,0 +1,271 @@
+package syncBudget
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+var log = logger.GetOrCreate("trie/syncBudget")
+
+// latencySmoothingFactor is the weight of the newest sample in the moving average of the response latency
+const latencySmoothingFactor = 0.2
+
+// ArgsSyncRequestsBudget is the DTO used to create a new sync requests budget
+type ArgsSyncRequestsBudget struct {
+	MaxInFlightNodes int
+	MinBatchSize     int
+	MaxBatchSize     int
+	TargetLatency    time.Duration
+	RequestTimeout   time.Duration
+}
+
+type requestedHash struct {
+	hash        string
+	requestTime time.Time
+}
+
+// syncRequestsBudget is shared by all the trie syncers of a node. It caps the number of trie nodes requested and not
+// yet received and adapts the number of nodes a syncer can request at once to the measured response latency: the batch
+// grows by a quarter each time a whole batch was received under the target latency and it is halved when the latency
+// exceeds twice the target or when the requests time out. The nodes not received in a few average latencies are handed
+// out again, so that lost requests are retried without waiting for the request timeout
+type syncRequestsBudget struct {
+	mut              sync.Mutex
+	inFlight         map[string]time.Time
+	requests         []requestedHash
+	maxInFlightNodes int
+	minBatchSize     int
+	maxBatchSize     int
+	batchSize        int
+	numFastReceived  int
+	targetLatency    time.Duration
+	requestTimeout   time.Duration
+	latency          time.Duration
+	lastDecrease     time.Time
+	getTimeHandler   func() time.Time
+}
+
+// NewSyncRequestsBudget creates a new sync requests budget
+func NewSyncRequestsBudget(args ArgsSyncRequestsBudget) (*syncRequestsBudget, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &syncRequestsBudget{
+		inFlight:         make(map[string]time.Time),
+		requests:         make([]requestedHash, 0),
+		maxInFlightNodes: args.MaxInFlightNodes,
+		minBatchSize:     args.MinBatchSize,
+		maxBatchSize:     args.MaxBatchSize,
+		batchSize:        args.MinBatchSize,
+		targetLatency:    args.TargetLatency,
+		requestTimeout:   args.RequestTimeout,
+		getTimeHandler:   time.Now,
+	}, nil
+}
+
+func checkArgs(args ArgsSyncRequestsBudget) error {
+	if args.MaxInFlightNodes < 1 {
+		return fmt.Errorf("%w, provided %d", ErrInvalidMaxInFlightNodes, args.MaxInFlightNodes)
+	}
+	if args.MinBatchSize < 1 {
+		return fmt.Errorf("%w, provided min %d", ErrInvalidBatchSize, args.MinBatchSize)
+	}
+	if args.MaxBatchSize < args.MinBatchSize || args.MaxBatchSize > args.MaxInFlightNodes {
+		return fmt.Errorf("%w, provided max %d, min %d, max in flight nodes %d",
+			ErrInvalidBatchSize, args.MaxBatchSize, args.MinBatchSize, args.MaxInFlightNodes)
+	}
+	if args.TargetLatency <= 0 {
+		return fmt.Errorf("%w, provided %v", ErrInvalidTargetLatency, args.TargetLatency)
+	}
+	if args.RequestTimeout <= args.TargetLatency {
+		return fmt.Errorf("%w, provided %v, it should be greater than the target latency %v",
+			ErrInvalidRequestTimeout, args.RequestTimeout, args.TargetLatency)
+	}
+
+	return nil
+}
+
+// Reserve returns the hashes, from the provided ones, that can be requested now: the hashes not yet requested, while
+// there is free budget, and the hashes requested too long ago to still expect an answer. The other hashes, already
+// requested by this or by other syncers, are skipped and the result is limited to the current batch size. The returned
+// hashes are considered in flight until received, released or timed out
+func (srb *syncRequestsBudget) Reserve(hashes [][]byte) [][]byte {
+	srb.mut.Lock()
+	defer srb.mut.Unlock()
+
+	now := srb.getTimeHandler()
+	srb.removeTimedOut(now)
+
+	retryTimeout := srb.retryTimeout()
+	available := srb.maxInFlightNodes - len(srb.inFlight)
+	reserved := make([][]byte, 0)
+	for _, hash := range hashes {
+		if len(reserved) >= srb.batchSize {
+			break
+		}
+
+		requestTime, isInFlight := srb.inFlight[string(hash)]
+		if isInFlight && now.Sub(requestTime) < retryTimeout {
+			continue
+		}
+		if !isInFlight {
+			if available <= 0 {
+				continue
+			}
+			available--
+		}
+
+		srb.inFlight[string(hash)] = now
+		srb.requests = append(srb.requests, requestedHash{
+			hash:        string(hash),
+			requestTime: now,
+		})
+		reserved = append(reserved, hash)
+	}
+
+	return reserved
+}
+
+// retryTimeout returns the time after which a hash not yet received can be requested again. It is 3 times the average
+// latency but not less than the target latency, so that a slow network is not flooded with duplicate requests, and not
+// more than the request timeout
+func (srb *syncRequestsBudget) retryTimeout() time.Duration {
+	retryTimeout := 3 * srb.latency
+	if retryTimeout < srb.targetLatency {
+		return srb.targetLatency
+	}
+	if retryTimeout > srb.requestTimeout {
+		return srb.requestTimeout
+	}
+
+	return retryTimeout
+}
+
+// removeTimedOut frees the budget taken by the hashes that were not received, nor requested again, in the request
+// timeout. The requests are kept in the order they were made so only the oldest ones need to be checked
+func (srb *syncRequestsBudget) removeTimedOut(now time.Time) {
+	numTimedOut := 0
+	for len(srb.requests) > 0 {
+		request := srb.requests[0]
+		if now.Sub(request.requestTime) < srb.requestTimeout {
+			break
+		}
+
+		srb.requests = srb.requests[1:]
+		requestTime, isInFlight := srb.inFlight[request.hash]
+		if !isInFlight || !requestTime.Equal(request.requestTime) {
+			// already received, released or requested again
+			continue
+		}
+
+		delete(srb.inFlight, request.hash)
+		numTimedOut++
+	}
+
+	if numTimedOut > 0 {
+		log.Trace("syncRequestsBudget: trie nodes requests timed out", "num", numTimedOut)
+		srb.decreaseBatchSize(now)
+	}
+}
+
+// MarkReceived frees the budget taken by the provided hash and uses its response latency to adapt the batch size
+func (srb *syncRequestsBudget) MarkReceived(hash []byte) {
+	srb.mut.Lock()
+	defer srb.mut.Unlock()
+
+	requestTime, isInFlight := srb.inFlight[string(hash)]
+	if !isInFlight {
+		return
+	}
+	delete(srb.inFlight, string(hash))
+
+	now := srb.getTimeHandler()
+	latency := now.Sub(requestTime)
+	srb.latency = time.Duration(latencySmoothingFactor*float64(latency) + (1-latencySmoothingFactor)*float64(srb.latency))
+
+	if latency > 2*srb.targetLatency {
+		srb.decreaseBatchSize(now)
+		return
+	}
+	if latency > srb.targetLatency {
+		return
+	}
+
+	srb.numFastReceived++
+	if srb.numFastReceived >= srb.batchSize {
+		srb.increaseBatchSize()
+	}
+}
+
+func (srb *syncRequestsBudget) increaseBatchSize() {
+	srb.numFastReceived = 0
+
+	increase := srb.batchSize / 4
+	if increase == 0 {
+		increase = 1
+	}
+	srb.batchSize += increase
+	if srb.batchSize > srb.maxBatchSize {
+		srb.batchSize = srb.maxBatchSize
+	}
+}
+
+// decreaseBatchSize halves the batch size, at most once in a target latency interval, as all the requests made before
+// the decrease will report the same slow latency
+func (srb *syncRequestsBudget) decreaseBatchSize(now time.Time) {
+	srb.numFastReceived = 0
+	if now.Sub(srb.lastDecrease) < srb.targetLatency {
+		return
+	}
+
+	srb.lastDecrease = now
+	srb.batchSize /= 2
+	if srb.batchSize < srb.minBatchSize {
+		srb.batchSize = srb.minBatchSize
+	}
+}
+
+// Release frees the budget taken by the provided hashes without measuring their latency. It should be called for the
+// hashes a syncer no longer waits for
+func (srb *syncRequestsBudget) Release(hashes [][]byte) {
+	srb.mut.Lock()
+	defer srb.mut.Unlock()
+
+	for _, hash := range hashes {
+		delete(srb.inFlight, string(hash))
+	}
+}
+
+// BatchSize returns the current maximum number of trie nodes that can be requested at once
+func (srb *syncRequestsBudget) BatchSize() int {
+	srb.mut.Lock()
+	defer srb.mut.Unlock()
+
+	return srb.batchSize
+}
+
+// NumInFlight returns the number of trie nodes requested and not yet received
+func (srb *syncRequestsBudget) NumInFlight() int {
+	srb.mut.Lock()
+	defer srb.mut.Unlock()
+
+	return len(srb.inFlight)
+}
+
+// Latency returns the moving average of the trie nodes response latency
+func (srb *syncRequestsBudget) Latency() time.Duration {
+	srb.mut.Lock()
+	defer srb.mut.Unlock()
+
+	return srb.latency
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (srb *syncRequestsBudget) IsInterfaceNil() bool {
+	return srb == nil
+}
@@ -0,0 +1,299 @@
+package syncBudget
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgs() ArgsSyncRequestsBudget {
+	return ArgsSyncRequestsBudget{
+		MaxInFlightNodes: 20,
+		MinBatchSize:     4,
+		MaxBatchSize:     10,
+		TargetLatency:    time.Second,
+		RequestTimeout:   5 * time.Second,
+	}
+}
+
+func createHashes(num int) [][]byte {
+	hashes := make([][]byte, 0, num)
+	for i := 0; i < num; i++ {
+		hashes = append(hashes, []byte(fmt.Sprintf("hash%d", i)))
+	}
+
+	return hashes
+}
+
+type mockClock struct {
+	mut  sync.Mutex
+	time time.Time
+}
+
+func (mc *mockClock) now() time.Time {
+	mc.mut.Lock()
+	defer mc.mut.Unlock()
+
+	return mc.time
+}
+
+func (mc *mockClock) advance(duration time.Duration) {
+	mc.mut.Lock()
+	mc.time = mc.time.Add(duration)
+	mc.mut.Unlock()
+}
+
+func createBudgetWithClock(args ArgsSyncRequestsBudget) (*syncRequestsBudget, *mockClock) {
+	clock := &mockClock{time: time.Unix(1000, 0)}
+	srb, _ := NewSyncRequestsBudget(args)
+	srb.SetTimeHandler(clock.now)
+
+	return srb, clock
+}
+
+func TestNewSyncRequestsBudget(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max in flight nodes should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.MaxInFlightNodes = 0
+
+		srb, err := NewSyncRequestsBudget(args)
+		assert.True(t, check.IfNil(srb))
+		assert.True(t, errors.Is(err, ErrInvalidMaxInFlightNodes))
+	})
+	t.Run("invalid min batch size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.MinBatchSize = 0
+
+		srb, err := NewSyncRequestsBudget(args)
+		assert.True(t, check.IfNil(srb))
+		assert.True(t, errors.Is(err, ErrInvalidBatchSize))
+	})
+	t.Run("max batch size lower than min should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.MaxBatchSize = args.MinBatchSize - 1
+
+		srb, err := NewSyncRequestsBudget(args)
+		assert.True(t, check.IfNil(srb))
+		assert.True(t, errors.Is(err, ErrInvalidBatchSize))
+	})
+	t.Run("max batch size higher than max in flight nodes should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.MaxBatchSize = args.MaxInFlightNodes + 1
+
+		srb, err := NewSyncRequestsBudget(args)
+		assert.True(t, check.IfNil(srb))
+		assert.True(t, errors.Is(err, ErrInvalidBatchSize))
+	})
+	t.Run("invalid target latency should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.TargetLatency = 0
+
+		srb, err := NewSyncRequestsBudget(args)
+		assert.True(t, check.IfNil(srb))
+		assert.True(t, errors.Is(err, ErrInvalidTargetLatency))
+	})
+	t.Run("request timeout not greater than the target latency should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.RequestTimeout = args.TargetLatency
+
+		srb, err := NewSyncRequestsBudget(args)
+		assert.True(t, check.IfNil(srb))
+		assert.True(t, errors.Is(err, ErrInvalidRequestTimeout))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		srb, err := NewSyncRequestsBudget(createMockArgs())
+		assert.False(t, check.IfNil(srb))
+		assert.Nil(t, err)
+		assert.Equal(t, 4, srb.BatchSize())
+		assert.Equal(t, 0, srb.NumInFlight())
+	})
+}
+
+func TestSyncRequestsBudget_ReserveShouldLimitToBatchSizeAndSkipInFlight(t *testing.T) {
+	t.Parallel()
+
+	srb, _ := createBudgetWithClock(createMockArgs())
+	hashes := createHashes(6)
+
+	reserved := srb.Reserve(hashes)
+	assert.Equal(t, hashes[:4], reserved)
+	assert.Equal(t, 4, srb.NumInFlight())
+
+	reserved = srb.Reserve(hashes)
+	assert.Equal(t, hashes[4:], reserved)
+	assert.Equal(t, 6, srb.NumInFlight())
+
+	reserved = srb.Reserve(hashes)
+	assert.Equal(t, 0, len(reserved))
+}
+
+func TestSyncRequestsBudget_ReserveShouldLimitToFreeBudget(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.MaxInFlightNodes = 6
+	args.MaxBatchSize = 6
+	srb, _ := createBudgetWithClock(args)
+	hashes := createHashes(10)
+
+	assert.Equal(t, 4, len(srb.Reserve(hashes)))
+	reserved := srb.Reserve(hashes)
+	assert.Equal(t, hashes[4:6], reserved)
+	assert.Equal(t, 0, len(srb.Reserve(hashes)))
+
+	srb.Release(hashes[:2])
+	assert.Equal(t, hashes[:2], srb.Reserve(hashes))
+}
+
+func TestSyncRequestsBudget_FastResponsesShouldIncreaseTheBatchSize(t *testing.T) {
+	t.Parallel()
+
+	srb, clock := createBudgetWithClock(createMockArgs())
+	for i := 0; i < 10; i++ {
+		hashes := createHashes(srb.BatchSize())
+		reserved := srb.Reserve(hashes)
+		clock.advance(time.Millisecond * 100)
+		for _, hash := range reserved {
+			srb.MarkReceived(hash)
+		}
+	}
+
+	assert.Equal(t, 10, srb.BatchSize())
+	assert.Equal(t, 0, srb.NumInFlight())
+	assert.True(t, srb.Latency() > 0)
+	assert.True(t, srb.Latency() <= time.Millisecond*100)
+}
+
+func TestSyncRequestsBudget_SlowResponsesShouldDecreaseTheBatchSize(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.MinBatchSize = 2
+	srb, clock := createBudgetWithClock(args)
+	srb.batchSize = 8
+
+	reserved := srb.Reserve(createHashes(8))
+	clock.advance(3 * time.Second)
+	for _, hash := range reserved {
+		srb.MarkReceived(hash)
+	}
+	assert.Equal(t, 4, srb.BatchSize(), "should have been halved once for the whole batch")
+
+	reserved = srb.Reserve(createHashes(4))
+	clock.advance(3 * time.Second)
+	srb.MarkReceived(reserved[0])
+	assert.Equal(t, 2, srb.BatchSize())
+
+	reserved = srb.Reserve(createHashes(6)[4:])
+	clock.advance(3 * time.Second)
+	srb.MarkReceived(reserved[0])
+	assert.Equal(t, 2, srb.BatchSize(), "should not go under the min batch size")
+}
+
+func TestSyncRequestsBudget_TimeoutsShouldFreeTheBudgetAndDecreaseTheBatchSize(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.MaxInFlightNodes = 8
+	args.MaxBatchSize = 8
+	srb, clock := createBudgetWithClock(args)
+	srb.batchSize = 8
+	hashes := createHashes(8)
+
+	assert.Equal(t, 8, len(srb.Reserve(hashes)))
+	srb.MarkReceived(hashes[0])
+	assert.Equal(t, 7, srb.NumInFlight())
+
+	clock.advance(args.RequestTimeout)
+	reserved := srb.Reserve(hashes)
+	assert.Equal(t, 4, srb.BatchSize())
+	assert.Equal(t, hashes[:4], reserved)
+	assert.Equal(t, 4, srb.NumInFlight())
+
+	// a late response for a timed out request is ignored
+	srb.MarkReceived(hashes[7])
+	assert.Equal(t, 4, srb.NumInFlight())
+}
+
+func TestSyncRequestsBudget_ReserveShouldRetryTheHashesNotReceivedInTime(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	srb, clock := createBudgetWithClock(args)
+	hashes := createHashes(4)
+
+	reserved := srb.Reserve(hashes)
+	require.Equal(t, hashes, reserved)
+	clock.advance(time.Millisecond * 100)
+	srb.MarkReceived(hashes[0])
+
+	pending := hashes[1:]
+	clock.advance(args.TargetLatency / 2)
+	assert.Equal(t, 0, len(srb.Reserve(pending)), "should wait for the retry timeout")
+
+	clock.advance(args.TargetLatency)
+	reserved = srb.Reserve(pending)
+	assert.Equal(t, pending, reserved)
+	assert.Equal(t, 3, srb.NumInFlight(), "the retried hashes should not take extra budget")
+	assert.Equal(t, 4, srb.BatchSize(), "a retry should not decrease the batch size")
+
+	clock.advance(time.Millisecond * 100)
+	srb.MarkReceived(hashes[1])
+	assert.Equal(t, 2, srb.NumInFlight())
+}
+
+func TestSyncRequestsBudget_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	srb, _ := NewSyncRequestsBudget(createMockArgs())
+	hashes := createHashes(100)
+
+	numCalls := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			switch idx % 6 {
+			case 0:
+				srb.Reserve(hashes)
+			case 1:
+				srb.MarkReceived(hashes[idx%len(hashes)])
+			case 2:
+				srb.Release(hashes[:idx%len(hashes)])
+			case 3:
+				_ = srb.BatchSize()
+			case 4:
+				_ = srb.NumInFlight()
+			case 5:
+				_ = srb.Latency()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	require.True(t, srb.NumInFlight() <= 20)
+}
@@ -8,6 +8,7 @@ import (
 const (
 	initialVersion = 1
 	secondVersion  = 2
+	thirdVersion   = 3
 )
 
 // TrieSyncer synchronizes the trie, asking on the network for the missing nodes
@@ -24,6 +25,8 @@ func CreateTrieSyncer(arg ArgTrieSyncer, trieSyncerVersion int) (TrieSyncer, err
 		return NewTrieSyncer(arg)
 	case secondVersion:
 		return NewDoubleListTrieSyncer(arg)
+	case thirdVersion:
+		return NewAdaptiveTrieSyncer(arg)
 	default:
 		return nil, fmt.Errorf("%w, unknown value %d", ErrInvalidTrieSyncerVersion, trieSyncerVersion)
 	}
@@ -31,10 +34,15 @@ func CreateTrieSyncer(arg ArgTrieSyncer, trieSyncerVersion int) (TrieSyncer, err
 
 // CheckTrieSyncerVersion can check if the syncer version has a correct value
 func CheckTrieSyncerVersion(trieSyncerVersion int) error {
-	isCorrectVersion := trieSyncerVersion >= initialVersion && trieSyncerVersion <= secondVersion
+	isCorrectVersion := trieSyncerVersion >= initialVersion && trieSyncerVersion <= thirdVersion
 	if isCorrectVersion {
 		return nil
 	}
 
 	return fmt.Errorf("%w, unknown value %d", ErrInvalidTrieSyncerVersion, trieSyncerVersion)
 }
+
+// UsesRequestsBudget returns true if the trie syncer version requests the missing nodes through a requests budget
+func UsesRequestsBudget(trieSyncerVersion int) bool {
+	return trieSyncerVersion == thirdVersion
+}
@@ -8,13 +8,17 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data"
+	disabledMetrics "github.com/ElrondNetwork/elrond-go/statusHandler/metrics/disabled"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/trie/statistics"
+	"github.com/ElrondNetwork/elrond-go/trie/syncBudget/disabled"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func createMockArgument(timeout time.Duration) ArgTrieSyncer {
+	tss, _ := statistics.NewTrieSyncStatistics(&disabledMetrics.MetricsHandler{})
+
 	return ArgTrieSyncer{
 		RequestHandler:            &testscommon.RequestHandlerStub{},
 		InterceptedNodes:          testscommon.NewCacherMock(),
@@ -23,9 +27,10 @@ func createMockArgument(timeout time.Duration) ArgTrieSyncer {
 		Marshalizer:               &testscommon.MarshalizerMock{},
 		ShardId:                   0,
 		Topic:                     "topic",
-		TrieSyncStatistics:        statistics.NewTrieSyncStatistics(),
+		TrieSyncStatistics:        tss,
 		TimeoutHandler:            testscommon.NewTimeoutHandlerMock(timeout),
 		MaxHardCapForMissingNodes: 500,
+		RequestsBudget:            disabled.NewDisabledSyncRequestsBudget(),
 	}
 }
 
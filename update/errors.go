@@ -277,3 +277,9 @@ var ErrInvalidMaxHardCapForMissingNodes = errors.New("invalid max hardcap for mi
 
 // ErrInvalidNumConcurrentTrieSyncers signals that the number of concurrent trie syncers is invalid
 var ErrInvalidNumConcurrentTrieSyncers = errors.New("invalid num concurrent trie syncers")
+
+// ErrNilSyncRequestsBudget signals that a nil sync requests budget was provided
+var ErrNilSyncRequestsBudget = errors.New("nil sync requests budget")
+
+// ErrNilMetricsHandler signals that a nil metrics handler was provided
+var ErrNilMetricsHandler = errors.New("nil metrics handler")
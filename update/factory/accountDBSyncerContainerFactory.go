@@ -31,6 +31,8 @@ type ArgsNewAccountsDBSyncersContainerFactory struct {
 	NumConcurrentTrieSyncers  int
 	MaxHardCapForMissingNodes int
 	TrieSyncerVersion         int
+	RequestsBudget            trie.SyncRequestsBudget
+	MetricsHandler            common.MetricsHandler
 }
 
 type accountDBSyncersContainerFactory struct {
@@ -46,6 +48,8 @@ type accountDBSyncersContainerFactory struct {
 	numConcurrentTrieSyncers  int
 	maxHardCapForMissingNodes int
 	trieSyncerVersion         int
+	requestsBudget            trie.SyncRequestsBudget
+	metricsHandler            common.MetricsHandler
 }
 
 // NewAccountsDBSContainerFactory creates a factory for trie syncers container
@@ -78,6 +82,12 @@ func NewAccountsDBSContainerFactory(args ArgsNewAccountsDBSyncersContainerFactor
 	if err != nil {
 		return nil, err
 	}
+	if trie.UsesRequestsBudget(args.TrieSyncerVersion) && check.IfNil(args.RequestsBudget) {
+		return nil, update.ErrNilSyncRequestsBudget
+	}
+	if check.IfNil(args.MetricsHandler) {
+		return nil, update.ErrNilMetricsHandler
+	}
 
 	t := &accountDBSyncersContainerFactory{
 		shardCoordinator:          args.ShardCoordinator,
@@ -91,6 +101,8 @@ func NewAccountsDBSContainerFactory(args ArgsNewAccountsDBSyncersContainerFactor
 		numConcurrentTrieSyncers:  args.NumConcurrentTrieSyncers,
 		maxHardCapForMissingNodes: args.MaxHardCapForMissingNodes,
 		trieSyncerVersion:         args.TrieSyncerVersion,
+		requestsBudget:            args.RequestsBudget,
+		metricsHandler:            args.MetricsHandler,
 	}
 
 	return t, nil
@@ -137,6 +149,8 @@ func (a *accountDBSyncersContainerFactory) createUserAccountsSyncer(shardId uint
 			MaxTrieLevelInMemory:      a.maxTrieLevelinMemory,
 			MaxHardCapForMissingNodes: a.maxHardCapForMissingNodes,
 			TrieSyncerVersion:         a.trieSyncerVersion,
+			RequestsBudget:            a.requestsBudget,
+			MetricsHandler:            a.metricsHandler,
 		},
 		ShardId:   shardId,
 		Throttler: thr,
@@ -162,6 +176,8 @@ func (a *accountDBSyncersContainerFactory) createValidatorAccountsSyncer(shardId
 			MaxTrieLevelInMemory:      a.maxTrieLevelinMemory,
 			MaxHardCapForMissingNodes: a.maxHardCapForMissingNodes,
 			TrieSyncerVersion:         a.trieSyncerVersion,
+			RequestsBudget:            a.requestsBudget,
+			MetricsHandler:            a.metricsHandler,
 		},
 	}
 	accountSyncer, err := syncer.NewValidatorAccountsSyncer(args)
@@ -66,6 +66,7 @@ type ArgsExporter struct {
 	MaxHardCapForMissingNodes int
 	NumConcurrentTrieSyncers  int
 	TrieSyncerVersion         int
+	TrieSyncRequestsBudget    trie.SyncRequestsBudget
 }
 
 type exportHandlerFactory struct {
@@ -102,6 +103,7 @@ type exportHandlerFactory struct {
 	maxHardCapForMissingNodes int
 	numConcurrentTrieSyncers  int
 	trieSyncerVersion         int
+	trieSyncRequestsBudget    trie.SyncRequestsBudget
 }
 
 // NewExportHandlerFactory creates an exporter factory
@@ -215,6 +217,9 @@ func NewExportHandlerFactory(args ArgsExporter) (*exportHandlerFactory, error) {
 	if err != nil {
 		return nil, err
 	}
+	if trie.UsesRequestsBudget(args.TrieSyncerVersion) && check.IfNil(args.TrieSyncRequestsBudget) {
+		return nil, update.ErrNilSyncRequestsBudget
+	}
 
 	e := &exportHandlerFactory{
 		CoreComponents:            args.CoreComponents,
@@ -248,6 +253,7 @@ func NewExportHandlerFactory(args ArgsExporter) (*exportHandlerFactory, error) {
 		maxHardCapForMissingNodes: args.MaxHardCapForMissingNodes,
 		numConcurrentTrieSyncers:  args.NumConcurrentTrieSyncers,
 		trieSyncerVersion:         args.TrieSyncerVersion,
+		trieSyncRequestsBudget:    args.TrieSyncRequestsBudget,
 	}
 	log.Debug("exportHandlerFactory: enable epoch for transaction signed with tx hash", "epoch", e.enableSignTxWithHashEpoch)
 
@@ -363,6 +369,8 @@ func (e *exportHandlerFactory) Create() (update.ExportHandler, error) {
 		MaxHardCapForMissingNodes: e.maxHardCapForMissingNodes,
 		NumConcurrentTrieSyncers:  e.numConcurrentTrieSyncers,
 		TrieSyncerVersion:         e.trieSyncerVersion,
+		RequestsBudget:            e.trieSyncRequestsBudget,
+		MetricsHandler:            e.CoreComponents.MetricsHandler(),
 	}
 	accountsDBSyncerFactory, err := NewAccountsDBSContainerFactory(argsAccountsSyncers)
 	if err != nil {
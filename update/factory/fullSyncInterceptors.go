@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	throughputDisabled "github.com/ElrondNetwork/elrond-go/dataRetriever/throughput/disabled"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
@@ -42,6 +43,7 @@ type fullSyncInterceptorsContainerFactory struct {
 	whiteListerVerifiedTxs update.WhiteListHandler
 	antifloodHandler       process.P2PAntifloodHandler
 	preferredPeersHolder   update.PreferredPeersHolderHandler
+	metricsHandler         common.MetricsHandler
 }
 
 // ArgsNewFullSyncInterceptorsContainerFactory holds the arguments needed for fullSyncInterceptorsContainerFactory
@@ -150,6 +152,7 @@ func NewFullSyncInterceptorsContainerFactory(
 		whiteListHandler:       args.WhiteListHandler,
 		whiteListerVerifiedTxs: args.WhiteListerVerifiedTxs,
 		antifloodHandler:       args.AntifloodHandler,
+		metricsHandler:         args.CoreComponents.MetricsHandler(),
 		//TODO: inject the real peers holder once we have the peers mapping before epoch bootstrap finishes
 		preferredPeersHolder: disabled.NewPreferredPeersHolder(),
 	}
@@ -335,6 +338,7 @@ func (ficf *fullSyncInterceptorsContainerFactory) createOneShardHeaderIntercepto
 			Throttler:        ficf.globalThrottler,
 			AntifloodHandler: ficf.antifloodHandler,
 			WhiteListRequest: ficf.whiteListHandler,
+			MetricsHandler:   ficf.metricsHandler,
 			CurrentPeerId:    ficf.messenger.ID(),
 		},
 	)
@@ -485,6 +489,8 @@ func (ficf *fullSyncInterceptorsContainerFactory) createOneTxInterceptor(topic s
 		ficf.accounts,
 		ficf.shardCoordinator,
 		ficf.whiteListHandler,
+		ficf.dataPool.Transactions(),
+		ficf.dataPool.Transactions(),
 		ficf.addressPubkeyConv,
 		ficf.maxTxNonceDeltaAllowed,
 	)
@@ -515,6 +521,7 @@ func (ficf *fullSyncInterceptorsContainerFactory) createOneTxInterceptor(topic s
 			Throttler:            ficf.globalThrottler,
 			AntifloodHandler:     ficf.antifloodHandler,
 			WhiteListRequest:     ficf.whiteListHandler,
+			MetricsHandler:       ficf.metricsHandler,
 			CurrentPeerId:        ficf.messenger.ID(),
 			PreferredPeersHolder: ficf.preferredPeersHolder,
 		},
@@ -550,6 +557,7 @@ func (ficf *fullSyncInterceptorsContainerFactory) createOneUnsignedTxInterceptor
 			Throttler:            ficf.globalThrottler,
 			AntifloodHandler:     ficf.antifloodHandler,
 			WhiteListRequest:     ficf.whiteListHandler,
+			MetricsHandler:       ficf.metricsHandler,
 			CurrentPeerId:        ficf.messenger.ID(),
 			PreferredPeersHolder: ficf.preferredPeersHolder,
 		},
@@ -585,6 +593,7 @@ func (ficf *fullSyncInterceptorsContainerFactory) createOneRewardTxInterceptor(t
 			Throttler:            ficf.globalThrottler,
 			AntifloodHandler:     ficf.antifloodHandler,
 			WhiteListRequest:     ficf.whiteListHandler,
+			MetricsHandler:       ficf.metricsHandler,
 			CurrentPeerId:        ficf.messenger.ID(),
 			PreferredPeersHolder: ficf.preferredPeersHolder,
 		},
@@ -657,6 +666,7 @@ func (ficf *fullSyncInterceptorsContainerFactory) createOneMiniBlocksInterceptor
 			Throttler:            ficf.globalThrottler,
 			AntifloodHandler:     ficf.antifloodHandler,
 			WhiteListRequest:     ficf.whiteListHandler,
+			MetricsHandler:       ficf.metricsHandler,
 			CurrentPeerId:        ficf.messenger.ID(),
 			PreferredPeersHolder: ficf.preferredPeersHolder,
 		},
@@ -697,6 +707,7 @@ func (ficf *fullSyncInterceptorsContainerFactory) generateMetachainHeaderInterce
 			Throttler:            ficf.globalThrottler,
 			AntifloodHandler:     ficf.antifloodHandler,
 			WhiteListRequest:     ficf.whiteListHandler,
+			MetricsHandler:       ficf.metricsHandler,
 			CurrentPeerId:        ficf.messenger.ID(),
 			PreferredPeersHolder: ficf.preferredPeersHolder,
 		},
@@ -714,7 +725,7 @@ func (ficf *fullSyncInterceptorsContainerFactory) generateMetachainHeaderInterce
 }
 
 func (ficf *fullSyncInterceptorsContainerFactory) createOneTrieNodesInterceptor(topic string) (process.Interceptor, error) {
-	trieNodesProcessor, err := processor.NewTrieNodesInterceptorProcessor(ficf.dataPool.TrieNodes())
+	trieNodesProcessor, err := processor.NewTrieNodesInterceptorProcessor(ficf.dataPool.TrieNodes(), throughputDisabled.NewDisabledPeersThroughputTracker())
 	if err != nil {
 		return nil, err
 	}
@@ -733,6 +744,7 @@ func (ficf *fullSyncInterceptorsContainerFactory) createOneTrieNodesInterceptor(
 			Throttler:            ficf.globalThrottler,
 			AntifloodHandler:     ficf.antifloodHandler,
 			WhiteListRequest:     ficf.whiteListHandler,
+			MetricsHandler:       ficf.metricsHandler,
 			CurrentPeerId:        ficf.messenger.ID(),
 			PreferredPeersHolder: ficf.preferredPeersHolder,
 		},
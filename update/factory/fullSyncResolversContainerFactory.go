@@ -11,6 +11,7 @@ import (
 	factoryDataRetriever "github.com/ElrondNetwork/elrond-go/dataRetriever/factory/resolverscontainer"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers/topicResolverSender"
+	throughputDisabled "github.com/ElrondNetwork/elrond-go/dataRetriever/throughput/disabled"
 	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -180,6 +181,7 @@ func (rcf *resolversContainerFactory) createTrieNodesResolver(baseTopic string,
 		CurrentNetworkEpochProvider: disabled.NewCurrentNetworkEpochProviderHandler(),
 		PreferredPeersHolder:        disabled.NewPreferredPeersHolder(),
 		SelfShardIdProvider:         rcf.shardCoordinator,
+		PeersThroughputHandler:      throughputDisabled.NewDisabledPeersThroughputTracker(),
 	}
 	resolverSender, err := topicResolverSender.NewTopicResolverSender(arg)
 	if err != nil {